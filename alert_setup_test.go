@@ -0,0 +1,170 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestStoreAlertSetup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/alert/store_setup.json" {
+			t.Errorf("expected /alert/store_setup.json, got %s", r.URL.Path)
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+		if body["alert_type"] != "speeding" {
+			t.Errorf("expected alert_type speeding, got %v", body["alert_type"])
+		}
+		fields, _ := body["fields"].(map[string]interface{})
+		if fields["speed"] != float64(120) {
+			t.Errorf("expected speed field 120, got %v", fields["speed"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"id":                "MzY3MjE6c3BlZWRpbmdfZXZlbnQ6ZXZlbnQ=",
+				"success":           true,
+				"validation_errors": nil,
+			},
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	fields, err := structpb.NewStruct(map[string]interface{}{"speed": 120, "car_type": "all_cars"})
+	if err != nil {
+		t.Fatalf("failed to create fields: %v", err)
+	}
+	req := &maponv1.StoreAlertSetupRequest{}
+	req.SetAlertType("speeding")
+	req.SetFields(fields)
+	resp, err := client.StoreAlertSetup(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetSetupId() != "MzY3MjE6c3BlZWRpbmdfZXZlbnQ6ZXZlbnQ=" {
+		t.Errorf("unexpected setup ID %s", resp.GetSetupId())
+	}
+}
+
+func TestStoreAlertSetupValidationError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"id":      nil,
+				"success": false,
+				"validation_errors": map[string]interface{}{
+					"objects":           []string{"can not be empty!"},
+					"additional_emails": []string{"Invalid e-mail"},
+				},
+			},
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	req := &maponv1.StoreAlertSetupRequest{}
+	req.SetAlertType("in_object")
+	req.SetFields(&structpb.Struct{})
+	_, err = client.StoreAlertSetup(context.Background(), req)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	want := "additional_emails: Invalid e-mail; objects: can not be empty!"
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("expected error to contain %q, got %q", want, err.Error())
+	}
+}
+
+func TestGetAlertSetupFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("alert_type") != "in_object" {
+			t.Errorf("expected alert_type in_object, got %s", r.URL.Query().Get("alert_type"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"fields": {
+					"other": [
+						{"type": "text", "title": "Additional reminder text", "required": true, "field": "alert_text"}
+					],
+					"objects": [
+						{
+							"field": "objects",
+							"field_group": "objects",
+							"title": "Objects",
+							"type": "select",
+							"multiple": true,
+							"required": true,
+							"options": {
+								"631336": {"title": "56000 Vannes", "value": 631336},
+								"631312": {"title": "A4 Gorlitz", "value": 631312}
+							}
+						},
+						{"field": "speed", "field_group": "objects", "type": "integer", "range": [1, 250]}
+					]
+				},
+				"field_groups": ["objects", "other"]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	req := &maponv1.GetAlertSetupFieldsRequest{}
+	req.SetAlertType("in_object")
+	resp, err := client.GetAlertSetupFields(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fields := resp.GetFields()
+	if len(fields) != 3 {
+		t.Fatalf("expected 3 fields, got %d", len(fields))
+	}
+	if fields[0].GetField() != "objects" || fields[0].GetType() != maponv1.AlertSetupField_TYPE_SELECT {
+		t.Errorf("unexpected first field %s of type %v", fields[0].GetField(), fields[0].GetType())
+	}
+	options := fields[0].GetOptions()
+	if len(options) != 2 || options[0].GetValue().GetNumberValue() != 631312 {
+		t.Errorf("unexpected options %v", options)
+	}
+	if fields[1].GetRangeMax() != 250 {
+		t.Errorf("expected range max 250, got %v", fields[1].GetRangeMax())
+	}
+	if fields[2].GetFieldGroup() != "other" {
+		t.Errorf("expected field group other, got %s", fields[2].GetFieldGroup())
+	}
+}
@@ -0,0 +1,206 @@
+package mapon
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BLE tag times are returned in the "2019-04-08T12:00:00Z" format.
+
+type jsonBleTag struct {
+	ID                      int64                `json:"id"`
+	BeaconID                string               `json:"beacon_id"`
+	SerialNr                string               `json:"serial_nr"`
+	Name                    *string              `json:"name"`
+	LocationPrecisionRadius *int32               `json:"location_precision_radius"`
+	FirstScan               *string              `json:"first_scan"`
+	LastScan                *string              `json:"last_scan"`
+	CreatedAt               string               `json:"created_at"`
+	ScanCount               int64                `json:"scan_count"`
+	LocationChanged         *string              `json:"location_changed"`
+	Location                jsonBleTagCoordinate `json:"location"`
+}
+
+type jsonBleTagCoordinate struct {
+	Lat     *float64 `json:"lat"`
+	Lng     *float64 `json:"lng"`
+	Address *string  `json:"address"`
+}
+
+type jsonBleTagScan struct {
+	GMT      string `json:"gmt"`
+	CarID    *int64 `json:"car_id"`
+	CarState string `json:"car_state"`
+	jsonBleTagCoordinate
+	RSSI        *int32   `json:"rssi"`
+	Voltage     *int32   `json:"voltage"`
+	Temperature *float64 `json:"temperature"`
+	Humidity    *float64 `json:"humidity"`
+}
+
+type jsonBleTagLocation struct {
+	GMTFrom string  `json:"gmt_from"`
+	GMTTill *string `json:"gmt_till"`
+	jsonBleTagCoordinate
+	Bounds [][]float64 `json:"bounds"` // [lat, lng] pairs
+}
+
+type jsonBleTagMeta struct {
+	Total   int32 `json:"total"`
+	Pages   int32 `json:"pages"`
+	PerPage int32 `json:"per_page"`
+	Page    int32 `json:"page"`
+}
+
+func mapJSONBleTagMetaToProto(j jsonBleTagMeta) *maponv1.Pagination {
+	p := &maponv1.Pagination{}
+	p.SetTotal(j.Total)
+	p.SetTotalPages(j.Pages)
+	p.SetPerPage(j.PerPage)
+	p.SetPage(j.Page)
+	return p
+}
+
+// parseBleTagTime parses an optional BLE tag timestamp.
+func parseBleTagTime(s *string) *timestamppb.Timestamp {
+	if s == nil {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// location returns the coordinate as a location, or nil if the coordinate is not set.
+func (j jsonBleTagCoordinate) location() *maponv1.Location {
+	if j.Lat == nil || j.Lng == nil {
+		return nil
+	}
+	l := &maponv1.Location{}
+	l.SetLatitude(*j.Lat)
+	l.SetLongitude(*j.Lng)
+	if j.Address != nil {
+		l.SetAddress(*j.Address)
+	}
+	return l
+}
+
+func mapJSONBleTagToProto(j *jsonBleTag) *maponv1.BleTag {
+	t := &maponv1.BleTag{}
+	t.SetTagId(j.ID)
+	t.SetBeaconId(j.BeaconID)
+	t.SetSerialNumber(j.SerialNr)
+	if j.Name != nil {
+		t.SetName(*j.Name)
+	}
+	if j.LocationPrecisionRadius != nil {
+		t.SetLocationPrecisionRadiusM(*j.LocationPrecisionRadius)
+	}
+	if ts := parseBleTagTime(j.FirstScan); ts != nil {
+		t.SetFirstScanAt(ts)
+	}
+	if ts := parseBleTagTime(j.LastScan); ts != nil {
+		t.SetLastScanAt(ts)
+	}
+	if ts := parseBleTagTime(&j.CreatedAt); ts != nil {
+		t.SetCreatedAt(ts)
+	}
+	t.SetScanCount(j.ScanCount)
+	if ts := parseBleTagTime(j.LocationChanged); ts != nil {
+		t.SetLocationChangedAt(ts)
+	}
+	if l := j.Location.location(); l != nil {
+		t.SetLocation(l)
+	}
+	return t
+}
+
+func mapJSONBleTagScanToProto(j *jsonBleTagScan) *maponv1.BleTagScan {
+	s := &maponv1.BleTagScan{}
+	if ts := parseBleTagTime(&j.GMT); ts != nil {
+		s.SetTime(ts)
+	}
+	if j.CarID != nil {
+		s.SetUnitId(*j.CarID)
+	}
+	s.SetUnitState(j.CarState)
+	if l := j.location(); l != nil {
+		s.SetLocation(l)
+	}
+	if j.RSSI != nil {
+		s.SetRssi(*j.RSSI)
+	}
+	if j.Voltage != nil {
+		s.SetVoltageMv(*j.Voltage)
+	}
+	if j.Temperature != nil {
+		s.SetTemperatureC(*j.Temperature)
+	}
+	if j.Humidity != nil {
+		s.SetHumidityPercent(*j.Humidity)
+	}
+	return s
+}
+
+func mapJSONBleTagLocationToProto(j *jsonBleTagLocation) *maponv1.BleTagLocation {
+	l := &maponv1.BleTagLocation{}
+	if ts := parseBleTagTime(&j.GMTFrom); ts != nil {
+		l.SetStartTime(ts)
+	}
+	if ts := parseBleTagTime(j.GMTTill); ts != nil {
+		l.SetEndTime(ts)
+	}
+	if location := j.location(); location != nil {
+		l.SetLocation(location)
+	}
+	bounds := make([]*maponv1.Location, 0, len(j.Bounds))
+	for _, point := range j.Bounds {
+		if len(point) != 2 {
+			continue
+		}
+		b := &maponv1.Location{}
+		b.SetLatitude(point[0])
+		b.SetLongitude(point[1])
+		bounds = append(bounds, b)
+	}
+	l.SetBounds(bounds)
+	return l
+}
+
+// addBleTagParams adds the tag selector as query parameters. The tag ID takes precedence over the beacon ID.
+func addBleTagParams(params url.Values, tagID int64, beaconID string) error {
+	switch {
+	case tagID != 0:
+		params.Add("id", strconv.FormatInt(tagID, 10))
+	case beaconID != "":
+		params.Add("beacon_id", beaconID)
+	default:
+		return fmt.Errorf("tag ID or beacon ID required")
+	}
+	return nil
+}
+
+// LocateBleTag returns the location of a BLE tag at the given time.
+// The location closest in time, within 30 days, is returned.
+func (c *Client) LocateBleTag(ctx context.Context, tagID int64, at time.Time) (*maponv1.BleTagLocation, error) {
+	response, err := c.GetBleTagHistoryPoint(ctx, maponv1.GetBleTagHistoryPointRequest_builder{
+		TagId:           new(tagID),
+		Time:            timestamppb.New(at),
+		IncludeLocation: new(true),
+	}.Build())
+	if err != nil {
+		return nil, err
+	}
+	if !response.HasLocation() || !response.GetLocation().HasLocation() {
+		return nil, fmt.Errorf("mapon: locate BLE tag: no location of tag %d near %s", tagID, at.UTC().Format(time.RFC3339))
+	}
+	return response.GetLocation(), nil
+}
@@ -0,0 +1,161 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListBleTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ble_tags/list.json" {
+			t.Errorf("expected /ble_tags/list.json, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"tags": [
+					{
+						"id": 3,
+						"beacon_id": "142516384b514e87f34f0bab15d480f1245e71ec",
+						"serial_nr": "00145673",
+						"name": "Trailer 1",
+						"location_precision_radius": 156,
+						"first_scan": "2020-12-18T09:47:33Z",
+						"last_scan": "2020-12-31T07:54:47Z",
+						"created_at": "2020-12-11T14:23:37Z",
+						"scan_count": 2287,
+						"location_changed": "2020-12-31T07:54:47Z",
+						"location": {"lat": 28.45721, "lng": -43.2719, "address": "123 Main Street"}
+					},
+					{
+						"id": 51,
+						"beacon_id": "829118e8c9bed05dc589d0d7b5731ca2627e747b",
+						"serial_nr": "3355667743",
+						"name": null,
+						"location_precision_radius": null,
+						"first_scan": null,
+						"last_scan": null,
+						"created_at": "2021-01-07T11:07:54Z",
+						"scan_count": 0,
+						"location_changed": null,
+						"location": {"lat": null, "lng": null, "address": null}
+					}
+				],
+				"_meta": {"total": 12, "pages": 2, "per_page": 10, "page": 1}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListBleTags(context.Background(), &maponv1.ListBleTagsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetTags()) != 2 || resp.GetPagination().GetTotalPages() != 2 {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	tag := resp.GetTags()[0]
+	if tag.GetSerialNumber() != "00145673" || tag.GetLocation().GetAddress() != "123 Main Street" || tag.GetScanCount() != 2287 {
+		t.Errorf("unexpected tag: %v", tag)
+	}
+	if !tag.GetLastScanAt().AsTime().Equal(time.Date(2020, 12, 31, 7, 54, 47, 0, time.UTC)) {
+		t.Errorf("unexpected last scan: %v", tag.GetLastScanAt().AsTime())
+	}
+	if unscanned := resp.GetTags()[1]; unscanned.HasLocation() || unscanned.HasLastScanAt() {
+		t.Errorf("expected unscanned tag without location, got %v", unscanned)
+	}
+}
+
+func TestListBleTagScans(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("beacon_id") != "abc" || query.Get("from") != "2020-12-31T00:00:00Z" || query.Get("till") != "2021-01-01T00:00:00Z" {
+			t.Errorf("unexpected query: %v", query)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"scans": [
+					{"gmt": "2020-12-31T07:54:47Z", "car_id": 141116, "car_state": "ignition", "lat": 28.45721, "lng": -43.2719,
+					 "address": "123 Main Street", "rssi": -52, "voltage": 523, "temperature": 17, "humidity": null}
+				],
+				"_meta": {"total": 1, "pages": 1, "per_page": 10, "page": 1}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListBleTagScans(context.Background(), maponv1.ListBleTagScansRequest_builder{
+		BeaconId: new("abc"),
+		FromTime: timestamppb.New(time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)),
+		ToTime:   timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetScans()) != 1 {
+		t.Fatalf("expected 1 scan, got %d", len(resp.GetScans()))
+	}
+	scan := resp.GetScans()[0]
+	if scan.GetRssi() != -52 || scan.GetUnitId() != 141116 || scan.GetVoltageMv() != 523 || scan.HasHumidityPercent() {
+		t.Errorf("unexpected scan: %v", scan)
+	}
+}
+
+func TestLocateBleTag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ble_tags/history_point.json" {
+			t.Errorf("expected /ble_tags/history_point.json, got %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("id") != "3" || query.Get("datetime") != "2021-01-01T12:00:00Z" || query.Get("includes[]") != "location" {
+			t.Errorf("unexpected query: %v", query)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"location": {
+					"gmt_from": "2020-12-31T07:54:47Z",
+					"gmt_till": null,
+					"lat": 28.45721,
+					"lng": -43.2719,
+					"address": "123 Main Street",
+					"bounds": [[28.4573, -43.2719], [28.4571, -43.2720]]
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	location, err := client.LocateBleTag(context.Background(), 3, time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if location.GetLocation().GetLatitude() != 28.45721 || location.HasEndTime() || len(location.GetBounds()) != 2 {
+		t.Errorf("unexpected location: %v", location)
+	}
+}
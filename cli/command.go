@@ -1,11 +1,23 @@
 package cli
 
 import (
+	"bufio"
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
+	"maps"
 	"os"
+	"path/filepath"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -13,6 +25,7 @@ import (
 	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
 	"golang.org/x/term"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -51,25 +64,68 @@ func NewCommand(opts ...Option) *cobra.Command {
 	cmd.AddCommand(newGetUnitFieldsCommand(&cfg))
 	cmd.AddCommand(newGetUnitDebugInfoCommand(&cfg))
 	cmd.AddCommand(newGetDrivingTimeExtendedCommand(&cfg))
+	cmd.AddCommand(newCustomTargetsCommand(&cfg))
 
 	cmd.AddGroup(&cobra.Group{ID: "unit-groups", Title: "Unit Groups"})
 	cmd.AddCommand(newUnitGroupsCommand(&cfg))
 
+	cmd.AddGroup(&cobra.Group{ID: "devices", Title: "Devices"})
+	cmd.AddCommand(newDevicesCommand(&cfg))
+
 	cmd.AddGroup(&cobra.Group{ID: "drivers", Title: "Drivers"})
 	cmd.AddCommand(newListDriversCommand(&cfg))
+	cmd.AddCommand(newDriverBehaviourCommand(&cfg))
+	cmd.AddCommand(newPinAuthCommand(&cfg))
+
+	cmd.AddGroup(&cobra.Group{ID: "driver-groups", Title: "Driver Groups"})
+	cmd.AddCommand(newDriverGroupsCommand(&cfg))
+
+	cmd.AddGroup(&cobra.Group{ID: "fuel", Title: "Fuel"})
+	cmd.AddCommand(newFuelCommand(&cfg))
+	cmd.AddCommand(newFuelChecksCommand(&cfg))
+
+	cmd.AddGroup(&cobra.Group{ID: "reefer", Title: "Reefer"})
+	cmd.AddCommand(newReeferCommand(&cfg))
+
+	cmd.AddGroup(&cobra.Group{ID: "tachograph", Title: "Tachograph"})
+	cmd.AddCommand(newTachographCommand(&cfg))
+
+	cmd.AddGroup(&cobra.Group{ID: "inspections", Title: "Vehicle Inspections"})
+	cmd.AddCommand(newListVehicleInspectionsCommand(&cfg))
 
 	cmd.AddGroup(&cobra.Group{ID: "routes", Title: "Routes"})
 	cmd.AddCommand(newListRoutesCommand(&cfg))
+	cmd.AddCommand(newRoutePlanningCommand(&cfg))
+	cmd.AddCommand(newTasksCommand(&cfg))
 
 	cmd.AddGroup(&cobra.Group{ID: "objects", Title: "Objects"})
 	cmd.AddCommand(newListObjectsCommand(&cfg))
 
+	cmd.AddGroup(&cobra.Group{ID: "layers", Title: "Custom Layers"})
+	cmd.AddCommand(newLayersCommand(&cfg))
+
+	cmd.AddGroup(&cobra.Group{ID: "ble-tags", Title: "BLE Tags"})
+	cmd.AddCommand(newBleTagsCommand(&cfg))
+
+	cmd.AddGroup(&cobra.Group{ID: "tracking", Title: "Tracking Links"})
+	cmd.AddCommand(newTrackingLinksCommand(&cfg))
+
+	cmd.AddGroup(&cobra.Group{ID: "messaging", Title: "Messaging"})
+	cmd.AddCommand(newMessagesCommand(&cfg))
+
 	cmd.AddGroup(&cobra.Group{ID: "alerts", Title: "Alerts"})
 	cmd.AddCommand(newListAlertsCommand(&cfg))
 
 	cmd.AddGroup(&cobra.Group{ID: "data-forward", Title: "Data Forwarding"})
 	cmd.AddCommand(newDataForwardCommand(&cfg))
 
+	cmd.AddGroup(&cobra.Group{ID: "company", Title: "Company"})
+	cmd.AddCommand(newCompanyCommand(&cfg))
+
+	cmd.AddGroup(&cobra.Group{ID: "users", Title: "Users"})
+	cmd.AddCommand(newUsersCommand(&cfg))
+	cmd.AddCommand(newPresetsCommand(&cfg))
+
 	cmd.AddGroup(&cobra.Group{ID: "auth", Title: "Authentication"})
 	cmd.AddCommand(newAuthCommand(&cfg))
 
@@ -197,6 +253,413 @@ func newListUnitsCommand(cfg *config) *cobra.Command {
 		}
 		return nil
 	}
+	cmd.AddCommand(newEditUnitCommand(cfg))
+	cmd.AddCommand(newInstallUnitCommand(cfg))
+	cmd.AddCommand(newUninstallUnitCommand(cfg))
+	cmd.AddCommand(newChangeUnitDeviceCommand(cfg))
+	cmd.AddCommand(newUnitRelayCommand(cfg))
+	cmd.AddCommand(newListUnitIconsCommand(cfg))
+	cmd.AddCommand(newUnitCustomFieldsCommand(cfg))
+	cmd.AddCommand(newUnitCommandCommand(cfg))
+	return cmd
+}
+
+func newEditUnitCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit <unit-id>",
+		Short: "Edit a unit",
+		Long:  "Edit a unit. Only the given flags are updated.",
+		Args:  cobra.ExactArgs(1),
+	}
+	number := cmd.Flags().String("number", "", "Registration number")
+	label := cmd.Flags().String("label", "", "Unit label")
+	shortcut := cmd.Flags().String("shortcut", "", "Unit shortcut")
+	title := cmd.Flags().String("title", "", "Vehicle title")
+	vehicleType := cmd.Flags().String("type", "", "Vehicle type (car, trailer, ship)")
+	depotID := cmd.Flags().Int64("depot-id", 0, "Depot ID")
+	carRegCertificate := cmd.Flags().String("car-reg-certificate", "", "Registration certificate number")
+	serviceTill := cmd.Flags().String("service-till", "", "Service date (YYYY-MM-DD)")
+	fuelType := cmd.Flags().String("fuel-type", "", "Fuel type (e.g. DIESEL, PETROL, ELECTRIC)")
+	fuelTank := cmd.Flags().Int32("fuel-tank", 0, "Fuel tank capacity in liters")
+	odometer := cmd.Flags().Int64("odometer", 0, "Odometer reading in meters")
+	driverID := cmd.Flags().Int64("driver-id", 0, "Driver ID (0 removes the driver)")
+	codriverID := cmd.Flags().Int64("codriver-id", 0, "Co-driver ID (0 removes the co-driver)")
+	icon := cmd.Flags().String("icon", "", "Vehicle icon")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		unitID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+		}
+		req := &maponv1.EditUnitRequest{}
+		req.SetUnitId(unitID)
+		flags := cmd.Flags()
+		if flags.Changed("number") {
+			req.SetNumber(*number)
+		}
+		if flags.Changed("label") {
+			req.SetLabel(*label)
+		}
+		if flags.Changed("shortcut") {
+			req.SetShortcut(*shortcut)
+		}
+		if flags.Changed("title") {
+			req.SetVehicleTitle(*title)
+		}
+		if flags.Changed("type") {
+			req.SetVehicleType(*vehicleType)
+		}
+		if flags.Changed("depot-id") {
+			req.SetDepotId(*depotID)
+		}
+		if flags.Changed("car-reg-certificate") {
+			req.SetCarRegCertificate(*carRegCertificate)
+		}
+		if flags.Changed("service-till") {
+			req.SetServiceTill(*serviceTill)
+		}
+		if flags.Changed("fuel-type") {
+			value, ok := maponv1.FuelType_value[strings.ToUpper(*fuelType)]
+			if !ok {
+				return fmt.Errorf("invalid fuel type %s", *fuelType)
+			}
+			req.SetFuelType(maponv1.FuelType(value))
+		}
+		if flags.Changed("fuel-tank") {
+			req.SetFuelTankL(*fuelTank)
+		}
+		if flags.Changed("odometer") {
+			req.SetOdometerM(*odometer)
+		}
+		if flags.Changed("driver-id") {
+			req.SetDriverId(*driverID)
+		}
+		if flags.Changed("codriver-id") {
+			req.SetCodriverId(*codriverID)
+		}
+		if flags.Changed("icon") {
+			req.SetIcon(*icon)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.EditUnit(cmd.Context(), req); err != nil {
+			return err
+		}
+		fmt.Printf("updated unit id=%d\n", unitID)
+		return nil
+	}
+	return cmd
+}
+
+func newInstallUnitCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "install",
+		Short: "Create a unit for a device",
+	}
+	boxID := cmd.Flags().Int64("box-id", 0, "Device ID")
+	number := cmd.Flags().String("number", "", "Registration number")
+	label := cmd.Flags().String("label", "", "Unit label")
+	odometer := cmd.Flags().Int64("odometer", 0, "Odometer reading in meters")
+	notes := cmd.Flags().String("notes", "", "Notes")
+	_ = cmd.MarkFlagRequired("box-id")
+	_ = cmd.MarkFlagRequired("number")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := maponv1.InstallUnitRequest_builder{
+			BoxId:  new(*boxID),
+			Number: new(*number),
+			Label:  new(*label),
+			Notes:  new(*notes),
+		}.Build()
+		if cmd.Flags().Changed("odometer") {
+			req.SetOdometerM(*odometer)
+		}
+		res, err := client.InstallUnit(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("installed unit id=%d\n", res.GetUnitId())
+		return nil
+	}
+	return cmd
+}
+
+func newUninstallUnitCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uninstall <unit-id>",
+		Short: "Remove the device from a unit",
+		Args:  cobra.ExactArgs(1),
+	}
+	notes := cmd.Flags().String("notes", "", "Notes")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		unitID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.UninstallUnit(cmd.Context(), maponv1.UninstallUnitRequest_builder{
+			UnitId: new(unitID),
+			Notes:  new(*notes),
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("uninstalled unit id=%d\n", unitID)
+		return nil
+	}
+	return cmd
+}
+
+func newChangeUnitDeviceCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-device <unit-id>",
+		Short: "Change the device of a unit",
+		Args:  cobra.ExactArgs(1),
+	}
+	boxID := cmd.Flags().Int64("box-id", 0, "New device ID (must be in the company storage)")
+	notes := cmd.Flags().String("notes", "", "Notes")
+	_ = cmd.MarkFlagRequired("box-id")
+	_ = cmd.MarkFlagRequired("notes")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		unitID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.ChangeUnitDevice(cmd.Context(), maponv1.ChangeUnitDeviceRequest_builder{
+			UnitId: new(unitID),
+			BoxId:  new(*boxID),
+			Notes:  new(*notes),
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("changed device of unit id=%d to box id=%d\n", unitID, *boxID)
+		return nil
+	}
+	return cmd
+}
+
+func newUnitRelayCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay",
+		Short: "Control unit relays",
+	}
+	cmd.AddCommand(newChangeUnitRelayCommand(cfg, "on", true))
+	cmd.AddCommand(newChangeUnitRelayCommand(cfg, "off", false))
+	return cmd
+}
+
+func newChangeUnitRelayCommand(cfg *config, use string, state bool) *cobra.Command {
+	return &cobra.Command{
+		Use:   use + " <unit-id> <relay-id>",
+		Short: "Turn a unit relay " + use,
+		Long: "Turn a unit relay " + use + ". The change is only requested, " +
+			"list units to verify the new relay state.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			unitID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+			}
+			relayID, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid relay ID %s: %w", args[1], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.ChangeUnitRelay(cmd.Context(), maponv1.ChangeUnitRelayRequest_builder{
+				UnitId:     new(unitID),
+				RelayId:    new(relayID),
+				RelayState: new(state),
+			}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("requested relay id=%d of unit id=%d %s\n", relayID, unitID, use)
+			return nil
+		},
+	}
+}
+
+func newListUnitIconsCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "icons",
+		Short: "List available vehicle icons",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			res, err := client.ListAvailableUnitIcons(cmd.Context(), &maponv1.ListAvailableUnitIconsRequest{})
+			if err != nil {
+				return err
+			}
+			for _, icon := range res.GetIcons() {
+				if icon != "" {
+					fmt.Println(icon)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func newUnitCustomFieldsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "custom-fields",
+		Short: "Manage unit custom fields",
+	}
+	cmd.AddCommand(newListUnitCustomFieldsCommand(cfg))
+	cmd.AddCommand(newSetUnitCustomFieldsCommand(cfg))
+	return cmd
+}
+
+func newListUnitCustomFieldsCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <unit-id>",
+		Short: "List custom fields and values of a unit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			unitID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			response, err := client.ListUnitCustomFields(cmd.Context(), maponv1.ListUnitCustomFieldsRequest_builder{
+				UnitId: new(unitID),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			for _, field := range response.GetFields() {
+				fmt.Println(protojson.Format(field))
+			}
+			return nil
+		},
+	}
+}
+
+func newSetUnitCustomFieldsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <unit-id>",
+		Short: "Set custom field values of a unit",
+		Args:  cobra.ExactArgs(1),
+	}
+	values := cmd.Flags().StringToString("value", nil, "Custom field value as <field-id>=<value>")
+	_ = cmd.MarkFlagRequired("value")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		unitID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+		}
+		fieldValues, err := parseCustomFieldValues(*values)
+		if err != nil {
+			return err
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.SaveUnitCustomFieldValues(cmd.Context(), maponv1.SaveUnitCustomFieldValuesRequest_builder{
+			UnitId: new(unitID),
+			Values: fieldValues,
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("updated custom fields of unit id=%d\n", unitID)
+		return nil
+	}
+	return cmd
+}
+
+func newUnitCommandCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "command",
+		Short: "Discover and execute remote unit commands",
+	}
+	cmd.AddCommand(newListUnitCommandsCommand(cfg))
+	cmd.AddCommand(newExecuteUnitCommandCommand(cfg))
+	return cmd
+}
+
+func newListUnitCommandsCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <unit-id>",
+		Short: "List commands available for a unit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			unitID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			res, err := client.GetAvailableUnitCommands(cmd.Context(), maponv1.GetAvailableUnitCommandsRequest_builder{
+				UnitId: new(unitID),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			for _, command := range res.GetCommands() {
+				fmt.Println(protojson.Format(command))
+			}
+			return nil
+		},
+	}
+}
+
+func newExecuteUnitCommandCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec <unit-id> <command>",
+		Short: "Execute a command on a unit",
+		Args:  cobra.ExactArgs(2),
+	}
+	params := cmd.Flags().StringToString("param", nil, "Command parameter as <name>=<value>")
+	yes := cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		unitID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+		}
+		command := args[1]
+		if !*yes {
+			ok, err := confirm(cmd, fmt.Sprintf("Execute command %s on unit id=%d?", command, unitID))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("aborted")
+			}
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.ExecuteUnitCommand(cmd.Context(), maponv1.ExecuteUnitCommandRequest_builder{
+			UnitId:     new(unitID),
+			Command:    new(command),
+			Parameters: *params,
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("executed command %s on unit id=%d\n", command, unitID)
+		return nil
+	}
 	return cmd
 }
 
@@ -598,33 +1061,136 @@ func newGetDrivingTimeExtendedCommand(cfg *config) *cobra.Command {
 	return cmd
 }
 
-// --- Unit Groups ---
+// --- Custom Targets ---
 
-func newUnitGroupsCommand(cfg *config) *cobra.Command {
+func newCustomTargetsCommand(cfg *config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "unit-groups",
-		Short:   "Unit group commands",
-		GroupID: "unit-groups",
+		Use:     "custom-targets",
+		Short:   "List custom targets and their values",
+		GroupID: "unit-data",
 	}
-	cmd.AddCommand(newListUnitGroupsCommand(cfg))
-	cmd.AddCommand(newListUnitsInGroupCommand(cfg))
+	cmd.AddCommand(newListCustomTargetsCommand(cfg))
+	cmd.AddCommand(newListCustomTargetValuesCommand(cfg))
 	return cmd
 }
 
-func newListUnitGroupsCommand(cfg *config) *cobra.Command {
-	cmd := &cobra.Command{
+func newListCustomTargetsCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
 		Use:   "list",
-		Short: "List unit groups",
+		Short: "List the custom targets defined for the company",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			response, err := client.ListCustomTargets(cmd.Context(), &maponv1.ListCustomTargetsRequest{})
+			if err != nil {
+				return err
+			}
+			for _, target := range response.GetTargets() {
+				fmt.Println(protojson.Format(target))
+			}
+			return nil
+		},
 	}
-	unitID := cmd.Flags().Int64("unit-id", 0, "Filter by Unit ID")
-	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
-		client, err := newClient(cmd, cfg)
-		if err != nil {
-			return err
-		}
-		req := &maponv1.ListUnitGroupsRequest{}
-		req.SetUnitId(*unitID)
-		res, err := client.ListUnitGroups(cmd.Context(), req)
+}
+
+func newListCustomTargetValuesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "values <target-id>",
+		Short: "List the values of a custom target recorded for units",
+		Long:  "List the values of a custom target recorded for units, for a period of up to 31 days.",
+		Args:  cobra.ExactArgs(1),
+	}
+	unitIDs := cmd.Flags().Int64Slice("unit-id", nil, "Units to list values for")
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	format := cmd.Flags().String("format", "csv", "Output format (csv, json)")
+	_ = cmd.MarkFlagRequired("unit-id")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		targetID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid target ID %s: %w", args[0], err)
+		}
+		if *format != "csv" && *format != "json" {
+			return fmt.Errorf("unsupported format %q", *format)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ListCustomTargetValues(cmd.Context(), maponv1.ListCustomTargetValuesRequest_builder{
+			UnitIds:        *unitIDs,
+			CustomTargetId: new(targetID),
+			FromTime:       timestamppb.New(*from),
+			ToTime:         timestamppb.New(*to),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		if *format == "json" {
+			for _, value := range response.GetValues() {
+				fmt.Println(protojson.Format(value))
+			}
+			return nil
+		}
+		w := csv.NewWriter(cmd.OutOrStdout())
+		_ = w.Write([]string{"unit_id", "time", "value"})
+		for _, v := range response.GetValues() {
+			var value string
+			switch {
+			case v.HasNumberValue():
+				value = strconv.FormatFloat(v.GetNumberValue(), 'f', -1, 64)
+			case v.HasStringValue():
+				value = v.GetStringValue()
+			case v.HasBoolValue():
+				value = strconv.FormatBool(v.GetBoolValue())
+			}
+			_ = w.Write([]string{
+				strconv.FormatInt(v.GetUnitId(), 10),
+				v.GetTime().AsTime().UTC().Format(time.RFC3339),
+				value,
+			})
+		}
+		w.Flush()
+		return w.Error()
+	}
+	return cmd
+}
+
+// --- Unit Groups ---
+
+func newUnitGroupsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unit-groups",
+		Short:   "Unit group commands",
+		GroupID: "unit-groups",
+	}
+	cmd.AddCommand(newListUnitGroupsCommand(cfg))
+	cmd.AddCommand(newListUnitsInGroupCommand(cfg))
+	cmd.AddCommand(newSaveUnitGroupCommand(cfg))
+	cmd.AddCommand(newDeleteUnitGroupCommand(cfg))
+	cmd.AddCommand(newAddUnitToGroupCommand(cfg))
+	cmd.AddCommand(newRemoveUnitFromGroupCommand(cfg))
+	cmd.AddCommand(newClearUnitGroupsCommand(cfg))
+	cmd.AddCommand(newSyncUnitGroupCommand(cfg))
+	return cmd
+}
+
+func newListUnitGroupsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List unit groups",
+	}
+	unitID := cmd.Flags().Int64("unit-id", 0, "Filter by Unit ID")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.ListUnitGroupsRequest{}
+		req.SetUnitId(*unitID)
+		res, err := client.ListUnitGroups(cmd.Context(), req)
 		if err != nil {
 			return err
 		}
@@ -662,155 +1228,5730 @@ func newListUnitsInGroupCommand(cfg *config) *cobra.Command {
 	return cmd
 }
 
-// --- Drivers ---
-
-func newListDriversCommand(cfg *config) *cobra.Command {
+func newSaveUnitGroupCommand(cfg *config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "drivers",
-		Short:   "List drivers",
-		GroupID: "drivers",
+		Use:   "save",
+		Short: "Create or update a unit group",
 	}
-	id := cmd.Flags().Int64("id", 0, "Filter by driver ID")
+	groupID := cmd.Flags().Int64("id", 0, "Group ID to update (creates a new group if unset)")
+	name := cmd.Flags().String("name", "", "Group name")
+	parentID := cmd.Flags().Int64("parent-id", 0, "Parent group ID (0 moves the group to the root)")
+	disableEdit := cmd.Flags().Bool("disable-edit", false, "Disable editing of the group in the user interface")
+	_ = cmd.MarkFlagRequired("name")
 	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
 		client, err := newClient(cmd, cfg)
 		if err != nil {
 			return err
 		}
-		req := &maponv1.ListDriversRequest{}
-		req.SetId(*id)
-		response, err := client.ListDrivers(cmd.Context(), req)
+		req := maponv1.SaveUnitGroupRequest_builder{
+			GroupId: new(*groupID),
+			Name:    new(*name),
+		}.Build()
+		if cmd.Flags().Changed("parent-id") {
+			req.SetParentId(*parentID)
+		}
+		if cmd.Flags().Changed("disable-edit") {
+			req.SetDisableEdit(*disableEdit)
+		}
+		res, err := client.SaveUnitGroup(cmd.Context(), req)
 		if err != nil {
 			return err
 		}
-		for _, driver := range response.GetDrivers() {
-			fmt.Println(protojson.Format(driver))
-		}
+		fmt.Printf("saved unit group id=%d\n", res.GetGroupId())
 		return nil
 	}
 	return cmd
 }
 
-// --- Routes ---
+func newDeleteUnitGroupCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <group-id>",
+		Short: "Delete a unit group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid group ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteUnitGroup(cmd.Context(),
+				maponv1.DeleteUnitGroupRequest_builder{
+					GroupId: new(groupID),
+				}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted unit group id=%d\n", groupID)
+			return nil
+		},
+	}
+}
 
-func newListRoutesCommand(cfg *config) *cobra.Command {
+func newAddUnitToGroupCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add <group-id> <unit-id>...",
+		Short: "Add units to a group",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid group ID %s: %w", args[0], err)
+			}
+			unitIDs, err := parseUnitIDs(args[1:])
+			if err != nil {
+				return err
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			for _, unitID := range unitIDs {
+				if _, err := client.AttachUnitToGroup(cmd.Context(),
+					maponv1.AttachUnitToGroupRequest_builder{
+						GroupId: new(groupID),
+						UnitId:  new(unitID),
+					}.Build()); err != nil {
+					return err
+				}
+				fmt.Printf("added unit id=%d to group id=%d\n", unitID, groupID)
+			}
+			return nil
+		},
+	}
+}
+
+func newRemoveUnitFromGroupCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <group-id> <unit-id>...",
+		Short: "Remove units from a group",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid group ID %s: %w", args[0], err)
+			}
+			unitIDs, err := parseUnitIDs(args[1:])
+			if err != nil {
+				return err
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			for _, unitID := range unitIDs {
+				if _, err := client.DetachUnitFromGroup(cmd.Context(),
+					maponv1.DetachUnitFromGroupRequest_builder{
+						GroupId: new(groupID),
+						UnitId:  new(unitID),
+					}.Build()); err != nil {
+					return err
+				}
+				fmt.Printf("removed unit id=%d from group id=%d\n", unitID, groupID)
+			}
+			return nil
+		},
+	}
+}
+
+func newClearUnitGroupsCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear <unit-id>",
+		Short: "Remove a unit from all groups",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			unitID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.ClearUnitGroups(cmd.Context(),
+				maponv1.ClearUnitGroupsRequest_builder{
+					UnitId: new(unitID),
+				}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("removed unit id=%d from all groups\n", unitID)
+			return nil
+		},
+	}
+}
+
+func newSyncUnitGroupCommand(cfg *config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "routes",
-		Short:   "List routes",
-		GroupID: "routes",
+		Use:   "sync <group-id> [unit-id]...",
+		Short: "Make the units of a group match the given units",
+		Long: `Make the units of a group match the given units.
+
+Units that are not given are removed from the group. Removing every unit requires
+--allow-empty and a confirmation.`,
+		Args: cobra.MinimumNArgs(1),
 	}
-	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
-	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
-	ids := cmd.Flags().StringSlice("unit-id", nil, "Filter by unit ID")
-	include := cmd.Flags().StringSlice("include", nil, "Include additional data (polyline)")
-	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
-		client, err := newClient(cmd, cfg)
+	allowEmpty := cmd.Flags().Bool("allow-empty", false, "Allow syncing without unit IDs, which removes every unit")
+	yes := cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		groupID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid group ID %s: %w", args[0], err)
+		}
+		unitIDs, err := parseUnitIDs(args[1:])
 		if err != nil {
 			return err
 		}
-		var unitIDs []int64
-		for _, idStr := range *ids {
-			id, err := strconv.ParseInt(idStr, 10, 64)
-			if err != nil {
-				return fmt.Errorf("invalid unit ID %s: %w", idStr, err)
+		if len(unitIDs) == 0 {
+			if !*allowEmpty {
+				return fmt.Errorf("no unit IDs given, use --allow-empty to remove every unit")
+			}
+			if !*yes {
+				ok, err := confirm(cmd, fmt.Sprintf("Remove every unit from group id=%d?", groupID))
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return fmt.Errorf("aborted")
+				}
 			}
-			unitIDs = append(unitIDs, id)
 		}
-		req := &maponv1.ListRoutesRequest{}
-		req.SetFromTime(timestamppb.New(*from))
-		req.SetToTime(timestamppb.New(*to))
-		req.SetUnitIds(unitIDs)
-		req.SetInclude(*include)
-		response, err := client.ListRoutes(cmd.Context(), req)
+		client, err := newClient(cmd, cfg)
 		if err != nil {
 			return err
 		}
-		for _, route := range response.GetRoutes() {
-			fmt.Println(protojson.Format(route))
+		added, removed, err := client.SyncUnitGroupMembership(cmd.Context(), groupID, unitIDs)
+		for _, unitID := range added {
+			fmt.Printf("added unit id=%d to group id=%d\n", unitID, groupID)
 		}
-		return nil
+		for _, unitID := range removed {
+			fmt.Printf("removed unit id=%d from group id=%d\n", unitID, groupID)
+		}
+		return err
 	}
 	return cmd
 }
 
-// --- Objects ---
+// --- Devices ---
 
-func newListObjectsCommand(cfg *config) *cobra.Command {
+func newDevicesCommand(cfg *config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "objects",
-		Short:   "List objects",
-		GroupID: "objects",
+		Use:     "devices",
+		Short:   "Manage tracking devices",
+		GroupID: "devices",
+	}
+	cmd.AddCommand(newListDevicesCommand(cfg))
+	cmd.AddCommand(newCreateDeviceCommand(cfg))
+	cmd.AddCommand(newListDeviceModelsCommand(cfg))
+	cmd.AddCommand(newListDeviceCommandsCommand(cfg))
+	cmd.AddCommand(newSendDeviceCommandCommand(cfg))
+	cmd.AddCommand(newListDeviceSmsHistoryCommand(cfg))
+	return cmd
+}
+
+func newListDevicesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List devices",
 	}
+	boxID := cmd.Flags().Int64("box-id", 0, "Filter by device ID")
+	serial := cmd.Flags().String("serial", "", "Filter by serial number")
+	imei := cmd.Flags().String("imei", "", "Filter by IMEI")
+	model := cmd.Flags().String("model", "", "Filter by model")
+	modelVersion := cmd.Flags().String("model-version", "", "Filter by model version (requires --model)")
+	installed := cmd.Flags().Bool("installed", false, "Only list devices installed on units")
 	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
 		client, err := newClient(cmd, cfg)
 		if err != nil {
 			return err
 		}
-		response, err := client.ListObjects(cmd.Context(), &maponv1.ListObjectsRequest{})
+		res, err := client.ListDevices(cmd.Context(), maponv1.ListDevicesRequest_builder{
+			BoxId:         new(*boxID),
+			SerialNumber:  new(*serial),
+			Imei:          new(*imei),
+			Model:         new(*model),
+			ModelVersion:  new(*modelVersion),
+			InstalledOnly: new(*installed),
+		}.Build())
 		if err != nil {
 			return err
 		}
-		for _, object := range response.GetObjects() {
-			fmt.Println(protojson.Format(object))
+		for _, device := range res.GetDevices() {
+			fmt.Println(protojson.Format(device))
 		}
 		return nil
 	}
 	return cmd
 }
 
-// --- Alerts ---
-
-func newListAlertsCommand(cfg *config) *cobra.Command {
+func newCreateDeviceCommand(cfg *config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "alerts",
-		Short:   "List alerts",
-		GroupID: "alerts",
+		Use:   "create",
+		Short: "Create a device in the company storage",
 	}
-	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
-	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
-	ids := cmd.Flags().StringSlice("unit-id", nil, "Filter by unit ID")
-	driver := cmd.Flags().Int64("driver-id", 0, "Filter by driver ID")
+	model := cmd.Flags().String("model", "", "Device model")
+	modelVersion := cmd.Flags().String("model-version", "", "Device model version")
+	imei := cmd.Flags().String("imei", "", "Device IMEI")
+	serial := cmd.Flags().String("serial", "", "Device serial number")
+	sim := cmd.Flags().String("sim", "", "SIM card phone number")
+	firmware := cmd.Flags().String("firmware", "", "Firmware version")
+	customFields := cmd.Flags().StringToString("custom-field", nil, "Model custom field as <name>=<value>")
+	_ = cmd.MarkFlagRequired("model")
 	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
 		client, err := newClient(cmd, cfg)
 		if err != nil {
 			return err
 		}
-		var unitIDs []int64
-		for _, idStr := range *ids {
-			id, err := strconv.ParseInt(idStr, 10, 64)
-			if err != nil {
-				return fmt.Errorf("invalid unit ID %s: %w", idStr, err)
-			}
-			unitIDs = append(unitIDs, id)
+		res, err := client.CreateDevice(cmd.Context(), maponv1.CreateDeviceRequest_builder{
+			Model:           new(*model),
+			ModelVersion:    new(*modelVersion),
+			Imei:            new(*imei),
+			SerialNumber:    new(*serial),
+			Sim:             new(*sim),
+			FirmwareVersion: new(*firmware),
+			CustomFields:    *customFields,
+		}.Build())
+		if err != nil {
+			return err
 		}
-		req := &maponv1.ListAlertsRequest{}
-		req.SetFromTime(timestamppb.New(*from))
-		req.SetToTime(timestamppb.New(*to))
-		req.SetUnitIds(unitIDs)
-		req.SetDriver(*driver)
-		response, err := client.ListAlerts(cmd.Context(), req)
+		fmt.Printf("created device box_id=%d\n", res.GetBoxId())
+		return nil
+	}
+	return cmd
+}
+
+func newListDeviceModelsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "models",
+		Short: "List supported device models",
+	}
+	customFields := cmd.Flags().Bool("custom-fields", false, "Include supported custom fields")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
 		if err != nil {
 			return err
 		}
-		for _, alert := range response.GetAlerts() {
-			fmt.Println(protojson.Format(alert))
+		res, err := client.ListDeviceModels(cmd.Context(), maponv1.ListDeviceModelsRequest_builder{
+			IncludeCustomFields: new(*customFields),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		for _, model := range res.GetModels() {
+			fmt.Println(protojson.Format(model))
 		}
 		return nil
 	}
 	return cmd
 }
 
-// --- Helpers ---
-
-func parseUnitIDs(args []string) ([]int64, error) {
-	var unitIDs []int64
-	for _, idStr := range args {
-		id, err := strconv.ParseInt(idStr, 10, 64)
+func newListDeviceCommandsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commands <device-id>",
+		Short: "List SMS or TCP commands supported by a device",
+		Args:  cobra.ExactArgs(1),
+	}
+	transport := cmd.Flags().String("transport", "sms", "Command transport (sms, tcp)")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		deviceID, err := strconv.ParseInt(args[0], 10, 64)
 		if err != nil {
-			return nil, fmt.Errorf("invalid unit ID %s: %w", idStr, err)
+			return fmt.Errorf("invalid device ID %s: %w", args[0], err)
 		}
-		unitIDs = append(unitIDs, id)
+		commandTransport, err := parseDeviceCommandTransport(*transport)
+		if err != nil {
+			return err
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		res, err := client.GetDeviceCommands(cmd.Context(), maponv1.GetDeviceCommandsRequest_builder{
+			DeviceId:  new(deviceID),
+			Transport: new(commandTransport),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		for _, command := range res.GetCommands() {
+			fmt.Println(protojson.Format(command))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newSendDeviceCommandCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send <device-id> <command>",
+		Short: "Send an SMS or TCP command to a device",
+		Args:  cobra.ExactArgs(2),
+	}
+	transport := cmd.Flags().String("transport", "sms", "Command transport (sms, tcp)")
+	params := cmd.Flags().StringToString("param", nil, "Command parameter as <name>=<value>")
+	yes := cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		deviceID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid device ID %s: %w", args[0], err)
+		}
+		command := args[1]
+		commandTransport, err := parseDeviceCommandTransport(*transport)
+		if err != nil {
+			return err
+		}
+		if !*yes {
+			ok, err := confirm(cmd, fmt.Sprintf("Send %s command %s to device id=%d?", *transport, command, deviceID))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("aborted")
+			}
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if commandTransport == maponv1.DeviceCommand_TRANSPORT_TCP {
+			res, err := client.SendDeviceTcpCommand(cmd.Context(), maponv1.SendDeviceTcpCommandRequest_builder{
+				DeviceId:   new(deviceID),
+				Command:    new(command),
+				Parameters: *params,
+			}.Build())
+			if err != nil {
+				return err
+			}
+			fmt.Println(res.GetResult())
+			return nil
+		}
+		if _, err := client.SendDeviceSmsCommand(cmd.Context(), maponv1.SendDeviceSmsCommandRequest_builder{
+			DeviceId:   new(deviceID),
+			Command:    new(command),
+			Parameters: *params,
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("sent SMS command %s to device id=%d\n", command, deviceID)
+		return nil
+	}
+	return cmd
+}
+
+func newListDeviceSmsHistoryCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sms-history <device-id>",
+		Short: "List SMS messages received from a device",
+		Args:  cobra.ExactArgs(1),
+	}
+	from := cmd.Flags().Time("from", time.Time{}, []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Time{}, []string{time.DateOnly, time.RFC3339}, "To time")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		deviceID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid device ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.ListDeviceSmsHistoryRequest{}
+		req.SetDeviceId(deviceID)
+		if cmd.Flags().Changed("from") {
+			req.SetFromTime(timestamppb.New(*from))
+		}
+		if cmd.Flags().Changed("to") {
+			req.SetToTime(timestamppb.New(*to))
+		}
+		res, err := client.ListDeviceSmsHistory(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, message := range res.GetMessages() {
+			fmt.Println(protojson.Format(message))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func parseDeviceCommandTransport(s string) (maponv1.DeviceCommand_Transport, error) {
+	switch s {
+	case "sms":
+		return maponv1.DeviceCommand_TRANSPORT_SMS, nil
+	case "tcp":
+		return maponv1.DeviceCommand_TRANSPORT_TCP, nil
+	default:
+		return maponv1.DeviceCommand_TRANSPORT_UNSPECIFIED, fmt.Errorf("unsupported transport %q", s)
+	}
+}
+
+// --- Drivers ---
+
+func newListDriversCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "drivers",
+		Short:   "List drivers",
+		GroupID: "drivers",
+	}
+	id := cmd.Flags().Int64("id", 0, "Filter by driver ID")
+	customFields := cmd.Flags().Bool("custom-fields", false, "Include custom field values")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.ListDriversRequest{}
+		req.SetId(*id)
+		req.SetIncludeCustomFields(*customFields)
+		response, err := client.ListDrivers(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, driver := range response.GetDrivers() {
+			fmt.Println(protojson.Format(driver))
+		}
+		return nil
+	}
+	cmd.AddCommand(newCreateDriverCommand(cfg))
+	cmd.AddCommand(newUpdateDriverCommand(cfg))
+	cmd.AddCommand(newDeleteDriverCommand(cfg))
+	cmd.AddCommand(newUpsertDriverCommand(cfg))
+	cmd.AddCommand(newDriverCustomFieldsCommand(cfg))
+	cmd.AddCommand(newListDriverActivitiesCommand(cfg))
+	return cmd
+}
+
+func newCreateDriverCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a driver",
+	}
+	name := cmd.Flags().String("name", "", "First name")
+	surname := cmd.Flags().String("surname", "", "Last name")
+	email := cmd.Flags().String("email", "", "Unique email address")
+	phone := cmd.Flags().String("phone", "", "Phone number with country code")
+	ibutton := cmd.Flags().String("ibutton", "", "iButton ID")
+	tacho := cmd.Flags().String("tacho", "", "Tachograph card ID")
+	unitID := cmd.Flags().Int64("unit-id", 0, "Assigned unit ID")
+	sendPassword := cmd.Flags().Bool("send-password", false, "Send the generated password to the driver email")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("surname")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.CreateDriver(cmd.Context(), maponv1.CreateDriverRequest_builder{
+			Name:                new(*name),
+			Surname:             new(*surname),
+			Email:               new(*email),
+			Phone:               new(*phone),
+			IbuttonValue:        new(*ibutton),
+			TachographId:        new(*tacho),
+			UnitId:              new(*unitID),
+			SendPasswordToEmail: new(*sendPassword),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Println(protojson.Format(response))
+		return nil
+	}
+	return cmd
+}
+
+func newUpdateDriverCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <driver-id>",
+		Short: "Update a driver",
+		Long:  "Update a driver. Only the given flags are updated.",
+		Args:  cobra.ExactArgs(1),
+	}
+	name := cmd.Flags().String("name", "", "First name")
+	surname := cmd.Flags().String("surname", "", "Last name")
+	email := cmd.Flags().String("email", "", "Unique email address")
+	phone := cmd.Flags().String("phone", "", "Phone number with country code")
+	ibutton := cmd.Flags().String("ibutton", "", "iButton ID")
+	tacho := cmd.Flags().String("tacho", "", "Tachograph card ID")
+	unitID := cmd.Flags().Int64("unit-id", 0, "Assigned unit ID (0 removes the driver from its unit)")
+	blocked := cmd.Flags().Bool("blocked", false, "Block or unblock the driver")
+	blockedReason := cmd.Flags().String("blocked-reason", "", "Reason for blocking the driver")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		driverID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid driver ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.UpdateDriverRequest{}
+		req.SetDriverId(driverID)
+		flags := cmd.Flags()
+		if flags.Changed("name") {
+			req.SetName(*name)
+		}
+		if flags.Changed("surname") {
+			req.SetSurname(*surname)
+		}
+		if flags.Changed("email") {
+			req.SetEmail(*email)
+		}
+		if flags.Changed("phone") {
+			req.SetPhone(*phone)
+		}
+		if flags.Changed("ibutton") {
+			req.SetIbuttonValue(*ibutton)
+		}
+		if flags.Changed("tacho") {
+			req.SetTachographId(*tacho)
+		}
+		if flags.Changed("unit-id") {
+			req.SetUnitId(*unitID)
+		}
+		if flags.Changed("blocked") {
+			req.SetBlocked(*blocked)
+		}
+		if flags.Changed("blocked-reason") {
+			req.SetBlockedReason(*blockedReason)
+		}
+		if _, err := client.UpdateDriver(cmd.Context(), req); err != nil {
+			return err
+		}
+		fmt.Printf("updated driver id=%d\n", driverID)
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteDriverCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <driver-id>",
+		Short: "Delete a driver",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			driverID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid driver ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteDriver(cmd.Context(),
+				maponv1.DeleteDriverRequest_builder{
+					DriverId: new(driverID),
+				}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted driver id=%d\n", driverID)
+			return nil
+		},
+	}
+}
+
+func newUpsertDriverCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upsert",
+		Short: "Create or update a driver identified by an external ID",
+		Long: `Create or update a driver identified by an external ID.
+
+The external ID is stored in the driver custom field given by --external-id-field,
+which is used to find the driver when the command is run again.`,
+	}
+	fieldID := cmd.Flags().Int64("external-id-field", 0, "Custom field ID holding the external ID")
+	externalID := cmd.Flags().String("external-id", "", "External ID of the driver")
+	name := cmd.Flags().String("name", "", "First name")
+	surname := cmd.Flags().String("surname", "", "Last name")
+	email := cmd.Flags().String("email", "", "Unique email address")
+	phone := cmd.Flags().String("phone", "", "Phone number with country code")
+	ibutton := cmd.Flags().String("ibutton", "", "iButton ID")
+	tacho := cmd.Flags().String("tacho", "", "Tachograph card ID")
+	_ = cmd.MarkFlagRequired("external-id-field")
+	_ = cmd.MarkFlagRequired("external-id")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("surname")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		driverID, created, err := client.UpsertDriverByExternalID(cmd.Context(), *fieldID, *externalID,
+			maponv1.Driver_builder{
+				Name:         new(*name),
+				Surname:      new(*surname),
+				Email:        new(*email),
+				Phone:        new(*phone),
+				IbuttonValue: new(*ibutton),
+				TachographId: new(*tacho),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		if created {
+			fmt.Printf("created driver id=%d\n", driverID)
+		} else {
+			fmt.Printf("synchronized driver id=%d\n", driverID)
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newListDriverActivitiesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "activities <driver-id>",
+		Short: "List daily activities of a driver",
+		Long: `List daily activities of a driver.
+
+Activities are selected for whole days in the company timezone, for a period of up to 31 days.`,
+		Args: cobra.ExactArgs(1),
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24*7), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	cardEvents := cmd.Flags().Bool("card-events", false, "Include driver card insertion and removal events")
+	workPeriodEvents := cmd.Flags().Bool("work-period-events", false, "Include work period start and finish events")
+	format := cmd.Flags().String("format", "csv", "Output format (csv, json)")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		driverID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid driver ID %s: %w", args[0], err)
+		}
+		if *format != "csv" && *format != "json" {
+			return fmt.Errorf("unsupported format %q", *format)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ListDriverDailyActivities(cmd.Context(), maponv1.ListDriverDailyActivitiesRequest_builder{
+			DriverId:                new(driverID),
+			FromTime:                timestamppb.New(*from),
+			ToTime:                  timestamppb.New(*to),
+			IncludeCardEvents:       new(*cardEvents),
+			IncludeWorkPeriodEvents: new(*workPeriodEvents),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		if *format == "json" {
+			for _, day := range response.GetDays() {
+				fmt.Println(protojson.Format(day))
+			}
+			return nil
+		}
+		w := csv.NewWriter(cmd.OutOrStdout())
+		_ = w.Write([]string{"driver_id", "date", "unit_id", "status", "source", "start_time", "end_time", "duration_s"})
+		for _, day := range response.GetDays() {
+			for _, a := range day.GetActivities() {
+				_ = w.Write([]string{
+					strconv.FormatInt(driverID, 10),
+					day.GetDate(),
+					strconv.FormatInt(a.GetUnitId(), 10),
+					strings.TrimPrefix(a.GetStatus().String(), "STATUS_"),
+					strings.TrimPrefix(a.GetSource().String(), "SOURCE_"),
+					a.GetStartTime().AsTime().UTC().Format(time.RFC3339),
+					a.GetEndTime().AsTime().UTC().Format(time.RFC3339),
+					strconv.FormatInt(a.GetDurationS(), 10),
+				})
+			}
+		}
+		w.Flush()
+		return w.Error()
+	}
+	return cmd
+}
+
+func newDriverCustomFieldsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "custom-fields",
+		Short: "Manage driver custom fields",
+	}
+	cmd.AddCommand(newListDriverCustomFieldsCommand(cfg))
+	cmd.AddCommand(newSetDriverCustomFieldsCommand(cfg))
+	return cmd
+}
+
+func newListDriverCustomFieldsCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <driver-id>...",
+		Short: "List custom fields and values of drivers",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			driverIDs, err := parseIDs(args, "driver")
+			if err != nil {
+				return err
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			response, err := client.ListDriverCustomFields(cmd.Context(), maponv1.ListDriverCustomFieldsRequest_builder{
+				DriverIds: driverIDs,
+			}.Build())
+			if err != nil {
+				return err
+			}
+			for _, driver := range response.GetDrivers() {
+				fmt.Println(protojson.Format(driver))
+			}
+			return nil
+		},
+	}
+}
+
+func newSetDriverCustomFieldsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <driver-id>",
+		Short: "Set custom field values of a driver",
+		Args:  cobra.ExactArgs(1),
+	}
+	values := cmd.Flags().StringToString("value", nil, "Custom field value as <field-id>=<value>")
+	_ = cmd.MarkFlagRequired("value")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		driverID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid driver ID %s: %w", args[0], err)
+		}
+		fieldValues, err := parseCustomFieldValues(*values)
+		if err != nil {
+			return err
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.SaveDriverCustomFieldValues(cmd.Context(), maponv1.SaveDriverCustomFieldValuesRequest_builder{
+			DriverId: new(driverID),
+			Values:   fieldValues,
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("updated custom fields of driver id=%d\n", driverID)
+		return nil
+	}
+	return cmd
+}
+
+// --- Driver Behaviour ---
+
+func newDriverBehaviourCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "driver-behaviour",
+		Short:   "Driver behaviour results",
+		GroupID: "drivers",
+	}
+	cmd.AddCommand(newDriverBehaviourReportCommand(cfg))
+	return cmd
+}
+
+func newDriverBehaviourReportCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report driver behaviour results of drivers or units",
+		Long: `Report driver behaviour results of drivers or units.
+
+Results are calculated for whole days, for a period of up to 31 days.
+Distances are in kilometers and durations in seconds.`,
+	}
+	from := cmd.Flags().Time("from", time.Now().AddDate(0, 0, -7), []string{time.DateOnly}, "From date")
+	to := cmd.Flags().Time("to", time.Now().AddDate(0, 0, -1), []string{time.DateOnly}, "To date, inclusive")
+	units := cmd.Flags().Bool("units", false, "Report units instead of drivers")
+	ids := cmd.Flags().Int64Slice("id", nil, "Filter by driver or unit ID")
+	groupID := cmd.Flags().Int64("group-id", 0, "Filter by driver or unit group ID")
+	metrics := cmd.Flags().StringSlice("metrics", nil, "Metrics for the overall result (e.g. harsh_braking,speeding), defaults to all")
+	format := cmd.Flags().String("format", "csv", "Output format (csv, json)")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		if *format != "csv" && *format != "json" {
+			return fmt.Errorf("unsupported format %q", *format)
+		}
+		var selected []maponv1.DriverBehaviourMetric
+		for _, name := range *metrics {
+			metric, ok := mapon.ParseDriverBehaviourMetric(name)
+			if !ok {
+				return fmt.Errorf("invalid metric %q", name)
+			}
+			selected = append(selected, metric)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		type row struct {
+			id     int64
+			name   string
+			result *maponv1.DriverBehaviourResult
+		}
+		var rows []row
+		for page := int32(1); ; page++ {
+			var pagination *maponv1.Pagination
+			if *units {
+				response, err := client.GetDriverBehaviourReportUnits(cmd.Context(), maponv1.GetDriverBehaviourReportUnitsRequest_builder{
+					FromDate: new(from.Format(time.DateOnly)),
+					ToDate:   new(to.Format(time.DateOnly)),
+					GroupId:  new(*groupID),
+					UnitIds:  *ids,
+					Metrics:  selected,
+					Page:     new(page),
+				}.Build())
+				if err != nil {
+					return err
+				}
+				for _, u := range response.GetUnits() {
+					if *format == "json" {
+						fmt.Println(protojson.Format(u))
+					}
+					rows = append(rows, row{u.GetUnitId(), u.GetTitle(), u.GetResult()})
+				}
+				pagination = response.GetPagination()
+			} else {
+				response, err := client.GetDriverBehaviourReportDrivers(cmd.Context(), maponv1.GetDriverBehaviourReportDriversRequest_builder{
+					FromDate:  new(from.Format(time.DateOnly)),
+					ToDate:    new(to.Format(time.DateOnly)),
+					GroupId:   new(*groupID),
+					DriverIds: *ids,
+					Metrics:   selected,
+					Page:      new(page),
+				}.Build())
+				if err != nil {
+					return err
+				}
+				for _, d := range response.GetDrivers() {
+					if *format == "json" {
+						fmt.Println(protojson.Format(d))
+					}
+					rows = append(rows, row{d.GetDriverId(), d.GetName(), d.GetResult()})
+				}
+				pagination = response.GetPagination()
+			}
+			if page >= pagination.GetTotalPages() {
+				break
+			}
+		}
+		if *format == "json" {
+			return nil
+		}
+		idColumn := "driver_id"
+		if *units {
+			idColumn = "unit_id"
+		}
+		header := []string{idColumn, "name", "from", "to", "overall_grade", "overall_score"}
+		for _, metric := range driverBehaviourReportMetrics {
+			header = append(header, metric.name+"_grade", metric.name+"_score", metric.name+"_"+metric.measure)
+		}
+		header = append(header,
+			"distance_gps_km", "driving_duration_s", "avg_speed_kmh",
+			"fuel_avg_consumption", "fuel_avg_consumption_unit", "stop_count",
+		)
+		w := csv.NewWriter(cmd.OutOrStdout())
+		_ = w.Write(header)
+		for _, r := range rows {
+			res := r.result
+			record := []string{
+				strconv.FormatInt(r.id, 10),
+				r.name,
+				from.Format(time.DateOnly),
+				to.Format(time.DateOnly),
+				res.GetOverall().GetGrade(),
+				formatOptionalFloat(res.GetOverall().HasScore(), res.GetOverall().GetScore()),
+			}
+			for _, metric := range driverBehaviourReportMetrics {
+				score := metric.score(res)
+				record = append(record, score.GetGrade(), formatOptionalFloat(score.HasScore(), score.GetScore()))
+				switch metric.measure {
+				case "count":
+					record = append(record, formatOptionalFloat(score.HasCount(), float64(score.GetCount())))
+				case "duration_s":
+					record = append(record, formatOptionalFloat(score.HasDurationS(), float64(score.GetDurationS())))
+				}
+			}
+			record = append(record,
+				formatOptionalFloat(res.HasDistanceGpsKm(), res.GetDistanceGpsKm()),
+				formatOptionalFloat(res.HasDrivingDurationS(), float64(res.GetDrivingDurationS())),
+				formatOptionalFloat(res.HasAvgSpeedKmh(), res.GetAvgSpeedKmh()),
+				formatOptionalFloat(res.HasFuelAvgConsumption(), res.GetFuelAvgConsumption()),
+				res.GetFuelAvgConsumptionUnit(),
+				formatOptionalFloat(res.HasStopCount(), float64(res.GetStopCount())),
+			)
+			_ = w.Write(record)
+		}
+		w.Flush()
+		return w.Error()
+	}
+	return cmd
+}
+
+// driverBehaviourReportMetrics are the metric columns of the driver behaviour CSV report,
+// with the measured value written next to the grade and score.
+var driverBehaviourReportMetrics = []struct {
+	name    string
+	measure string
+	score   func(*maponv1.DriverBehaviourResult) *maponv1.DriverBehaviourScore
+}{
+	{"harsh_braking", "count", (*maponv1.DriverBehaviourResult).GetHarshBraking},
+	{"harsh_acceleration", "count", (*maponv1.DriverBehaviourResult).GetHarshAcceleration},
+	{"harsh_cornering", "count", (*maponv1.DriverBehaviourResult).GetHarshCornering},
+	{"speeding", "duration_s", (*maponv1.DriverBehaviourResult).GetSpeeding},
+	{"excessive_idling", "duration_s", (*maponv1.DriverBehaviourResult).GetExcessiveIdling},
+	{"effective_speed", "duration_s", (*maponv1.DriverBehaviourResult).GetEffectiveSpeed},
+	{"cruise_control", "duration_s", (*maponv1.DriverBehaviourResult).GetCruiseControl},
+	{"coasting", "duration_s", (*maponv1.DriverBehaviourResult).GetCoasting},
+	{"green_rpm", "duration_s", (*maponv1.DriverBehaviourResult).GetGreenRpm},
+}
+
+// formatOptionalFloat formats a value for CSV output, or returns an empty string if the value is not set.
+func formatOptionalFloat(ok bool, v float64) string {
+	if !ok {
+		return ""
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// --- Vehicle PIN Auth ---
+
+func newPinAuthCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pin-auth",
+		Short:   "Manage vehicle keypad PIN authentication",
+		GroupID: "drivers",
+	}
+	cmd.AddCommand(newMapPinDevicesCommand(cfg))
+	cmd.AddCommand(newUnmapPinDevicesCommand(cfg))
+	cmd.AddCommand(newSetDriverPinsCommand(cfg, "create-pins", "Create PIN codes for drivers without a PIN", false))
+	cmd.AddCommand(newSetDriverPinsCommand(cfg, "update-pins", "Update the PIN codes of drivers with a PIN", true))
+	return cmd
+}
+
+func newMapPinDevicesCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "map-devices <unit-id>=<device-id>...",
+		Short: "Map PIN keypad devices to units",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mappings := make([]*maponv1.VehiclePinDeviceMapping, 0, len(args))
+			for _, arg := range args {
+				unit, deviceID, ok := strings.Cut(arg, "=")
+				if !ok {
+					return fmt.Errorf("invalid mapping %q, expected <unit-id>=<device-id>", arg)
+				}
+				unitID, err := strconv.ParseInt(unit, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid unit ID %s: %w", unit, err)
+				}
+				mappings = append(mappings, maponv1.VehiclePinDeviceMapping_builder{
+					UnitId:   new(unitID),
+					DeviceId: new(deviceID),
+				}.Build())
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			response, err := client.CreateVehiclePinDeviceMappings(cmd.Context(), maponv1.CreateVehiclePinDeviceMappingsRequest_builder{
+				Mappings: mappings,
+			}.Build())
+			if err != nil {
+				return err
+			}
+			return printPinAuthResults(response.GetSummary(), response.GetResults())
+		},
+	}
+}
+
+func newUnmapPinDevicesCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unmap-devices <device-id>...",
+		Short: "Delete the unit mappings of PIN keypad devices",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			response, err := client.DeleteVehiclePinDeviceMappings(cmd.Context(), maponv1.DeleteVehiclePinDeviceMappingsRequest_builder{
+				DeviceIds: args,
+			}.Build())
+			if err != nil {
+				return err
+			}
+			return printPinAuthResults(response.GetSummary(), response.GetResults())
+		},
+	}
+}
+
+func newSetDriverPinsCommand(cfg *config, use, short string, update bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [driver-id]...",
+		Short: short,
+		Long: short + `.
+
+PINs are never taken as arguments, to keep them out of the shell history and the process list.
+The PIN of each given driver is prompted for, or all PINs are read from --file as
+<driver-id>=<pin> lines.`,
+	}
+	file := cmd.Flags().String("file", "", "File with <driver-id>=<pin> lines, or - for standard input")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var pins []*maponv1.DriverPin
+		switch {
+		case *file != "" && len(args) > 0:
+			return fmt.Errorf("driver IDs can not be combined with --file")
+		case *file != "":
+			var r io.Reader = cmd.InOrStdin()
+			if *file != "-" {
+				f, err := os.Open(*file)
+				if err != nil {
+					return err
+				}
+				defer func() { _ = f.Close() }()
+				r = f
+			}
+			var err error
+			if pins, err = readDriverPins(r); err != nil {
+				return fmt.Errorf("%s: %w", *file, err)
+			}
+		case len(args) > 0:
+			for _, arg := range args {
+				driverID, err := strconv.ParseInt(arg, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid driver ID %s: %w", arg, err)
+				}
+				pin, err := promptSecret(cmd, fmt.Sprintf("PIN of driver id=%d: ", driverID))
+				if err != nil {
+					return err
+				}
+				pins = append(pins, maponv1.DriverPin_builder{
+					DriverId: new(driverID),
+					Pin:      new(pin),
+				}.Build())
+			}
+		default:
+			return fmt.Errorf("no driver IDs or --file given")
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if update {
+			response, err := client.UpdateDriverPins(cmd.Context(), maponv1.UpdateDriverPinsRequest_builder{
+				Pins: pins,
+			}.Build())
+			if err != nil {
+				return err
+			}
+			return printPinAuthResults(response.GetSummary(), response.GetResults())
+		}
+		response, err := client.CreateDriverPins(cmd.Context(), maponv1.CreateDriverPinsRequest_builder{
+			Pins: pins,
+		}.Build())
+		if err != nil {
+			return err
+		}
+		return printPinAuthResults(response.GetSummary(), response.GetResults())
+	}
+	return cmd
+}
+
+// readDriverPins reads driver PINs from <driver-id>=<pin> lines, skipping empty lines.
+func readDriverPins(r io.Reader) ([]*maponv1.DriverPin, error) {
+	var pins []*maponv1.DriverPin
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		driver, pin, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected <driver-id>=<pin>", line)
+		}
+		driverID, err := strconv.ParseInt(driver, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid driver ID %s: %w", line, driver, err)
+		}
+		pins = append(pins, maponv1.DriverPin_builder{
+			DriverId: new(driverID),
+			Pin:      new(pin),
+		}.Build())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(pins) == 0 {
+		return nil, fmt.Errorf("no PINs")
+	}
+	return pins, nil
+}
+
+// printPinAuthResults prints the results of a batch PIN authentication operation,
+// and returns an error if any operation failed.
+func printPinAuthResults(summary *maponv1.VehiclePinAuthSummary, results []*maponv1.VehiclePinAuthResult) error {
+	for _, r := range results {
+		var subject string
+		if r.GetDriverId() != 0 {
+			subject = fmt.Sprintf("driver id=%d", r.GetDriverId())
+		} else {
+			subject = fmt.Sprintf("device id=%s unit id=%d", r.GetDeviceId(), r.GetUnitId())
+		}
+		if r.GetSuccess() {
+			fmt.Printf("%s: ok\n", subject)
+		} else {
+			fmt.Printf("%s: failed: %s\n", subject, r.GetMessage())
+		}
+	}
+	if summary.GetFailed() > 0 {
+		return fmt.Errorf("%d of %d operations failed", summary.GetFailed(), summary.GetTotal())
+	}
+	return nil
+}
+
+// --- Driver Groups ---
+
+func newDriverGroupsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "driver-groups",
+		Short:   "Manage driver groups",
+		GroupID: "driver-groups",
+	}
+	cmd.AddCommand(newListDriverGroupsCommand(cfg))
+	cmd.AddCommand(newListDriversInGroupCommand(cfg))
+	cmd.AddCommand(newSaveDriverGroupCommand(cfg))
+	cmd.AddCommand(newDeleteDriverGroupCommand(cfg))
+	cmd.AddCommand(newAddDriverToGroupCommand(cfg))
+	cmd.AddCommand(newRemoveDriverFromGroupCommand(cfg))
+	cmd.AddCommand(newClearDriverGroupsCommand(cfg))
+	return cmd
+}
+
+func newListDriverGroupsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List driver groups",
+	}
+	driverID := cmd.Flags().Int64("driver-id", 0, "Filter by driver ID")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.ListDriverGroupsRequest{}
+		req.SetDriverId(*driverID)
+		res, err := client.ListDriverGroups(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, g := range res.GetGroups() {
+			fmt.Println(protojson.Format(g))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newListDriversInGroupCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drivers",
+		Short: "List drivers in a group",
+	}
+	groupID := cmd.Flags().Int64("group-id", 0, "Group ID")
+	_ = cmd.MarkFlagRequired("group-id")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.ListDriversInGroupRequest{}
+		req.SetGroupId(*groupID)
+		res, err := client.ListDriversInGroup(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		fmt.Println(protojson.Format(res))
+		return nil
+	}
+	return cmd
+}
+
+func newSaveDriverGroupCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save",
+		Short: "Create or update a driver group",
+	}
+	groupID := cmd.Flags().Int64("id", 0, "Group ID to update (creates a new group if unset)")
+	name := cmd.Flags().String("name", "", "Group name")
+	parentID := cmd.Flags().Int64("parent-id", 0, "Parent group ID")
+	_ = cmd.MarkFlagRequired("name")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		res, err := client.SaveDriverGroup(cmd.Context(), maponv1.SaveDriverGroupRequest_builder{
+			GroupId:  new(*groupID),
+			Name:     new(*name),
+			ParentId: new(*parentID),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("saved driver group id=%d\n", res.GetGroupId())
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteDriverGroupCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <group-id>",
+		Short: "Delete a driver group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid group ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteDriverGroup(cmd.Context(),
+				maponv1.DeleteDriverGroupRequest_builder{
+					GroupId: new(groupID),
+				}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted driver group id=%d\n", groupID)
+			return nil
+		},
+	}
+}
+
+func newAddDriverToGroupCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add <group-id> <driver-id>...",
+		Short: "Add drivers to a group",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid group ID %s: %w", args[0], err)
+			}
+			driverIDs, err := parseIDs(args[1:], "driver")
+			if err != nil {
+				return err
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			for _, driverID := range driverIDs {
+				if _, err := client.AddDriverToGroup(cmd.Context(),
+					maponv1.AddDriverToGroupRequest_builder{
+						GroupId:  new(groupID),
+						DriverId: new(driverID),
+					}.Build()); err != nil {
+					return err
+				}
+				fmt.Printf("added driver id=%d to group id=%d\n", driverID, groupID)
+			}
+			return nil
+		},
+	}
+}
+
+func newRemoveDriverFromGroupCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <group-id> <driver-id>...",
+		Short: "Remove drivers from a group",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid group ID %s: %w", args[0], err)
+			}
+			driverIDs, err := parseIDs(args[1:], "driver")
+			if err != nil {
+				return err
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			for _, driverID := range driverIDs {
+				if _, err := client.RemoveDriverFromGroup(cmd.Context(),
+					maponv1.RemoveDriverFromGroupRequest_builder{
+						GroupId:  new(groupID),
+						DriverId: new(driverID),
+					}.Build()); err != nil {
+					return err
+				}
+				fmt.Printf("removed driver id=%d from group id=%d\n", driverID, groupID)
+			}
+			return nil
+		},
+	}
+}
+
+func newClearDriverGroupsCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear <driver-id>",
+		Short: "Remove a driver from all groups",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			driverID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid driver ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.ClearDriverGroups(cmd.Context(),
+				maponv1.ClearDriverGroupsRequest_builder{
+					DriverId: new(driverID),
+				}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("removed driver id=%d from all groups\n", driverID)
+			return nil
+		},
+	}
+}
+
+// --- Fuel ---
+
+func newFuelCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fuel",
+		Short:   "Fuel commands",
+		GroupID: "fuel",
+	}
+	cmd.AddCommand(newListFuelDataCommand(cfg))
+	cmd.AddCommand(newListFuelChangesCommand(cfg))
+	cmd.AddCommand(newGetFuelSummaryCommand(cfg))
+	return cmd
+}
+
+func newListFuelDataCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "data <unit-id>",
+		Short: "List fuel levels and flow rates",
+		Args:  cobra.ExactArgs(1),
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	sources := cmd.Flags().StringSlice("source", nil, "Data sources to include (sensor, can, flow)")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		unitID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+		}
+		var dataSources []maponv1.FuelDataSource
+		for _, source := range *sources {
+			switch source {
+			case "sensor":
+				dataSources = append(dataSources, maponv1.FuelDataSource_FUEL_DATA_SOURCE_SENSOR)
+			case "can":
+				dataSources = append(dataSources, maponv1.FuelDataSource_FUEL_DATA_SOURCE_CAN)
+			case "flow":
+				dataSources = append(dataSources, maponv1.FuelDataSource_FUEL_DATA_SOURCE_FLOW)
+			default:
+				return fmt.Errorf("invalid data source %s", source)
+			}
+		}
+		req := &maponv1.ListFuelDataRequest{}
+		req.SetUnitId(unitID)
+		req.SetFromTime(timestamppb.New(*from))
+		req.SetToTime(timestamppb.New(*to))
+		req.SetDataSources(dataSources)
+		res, err := client.ListFuelData(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		fmt.Println(protojson.Format(res.GetData()))
+		return nil
+	}
+	return cmd
+}
+
+func newListFuelChangesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "changes <unit-id ...>",
+		Short: "List refuel and fuel drain events",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		unitIDs, err := parseUnitIDs(args)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.ListFuelChangesRequest{}
+		req.SetUnitIds(unitIDs)
+		req.SetFromTime(timestamppb.New(*from))
+		req.SetToTime(timestamppb.New(*to))
+		res, err := client.ListFuelChanges(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, u := range res.GetUnits() {
+			fmt.Println(protojson.Format(u))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newGetFuelSummaryCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "summary <unit-id ...>",
+		Short: "Get fuel summary for a period",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		unitIDs, err := parseUnitIDs(args)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.GetFuelSummaryRequest{}
+		req.SetUnitIds(unitIDs)
+		req.SetFromTime(timestamppb.New(*from))
+		req.SetToTime(timestamppb.New(*to))
+		res, err := client.GetFuelSummary(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, u := range res.GetUnits() {
+			fmt.Println(protojson.Format(u))
+		}
+		return nil
+	}
+	return cmd
+}
+
+// --- Fuel Checks ---
+
+func newFuelChecksCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fuel-checks",
+		Short:   "Manage fuel checks and fuel cards",
+		GroupID: "fuel",
+	}
+	cmd.AddCommand(newListFuelChecksCommand(cfg))
+	cmd.AddCommand(newAddFuelCheckCommand(cfg))
+	cmd.AddCommand(newEditFuelCheckCommand(cfg))
+	cmd.AddCommand(newDeleteFuelCheckCommand(cfg))
+	cmd.AddCommand(newImportFuelChecksCommand(cfg))
+	cmd.AddCommand(newFuelCardsCommand(cfg))
+	return cmd
+}
+
+func newListFuelChecksCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [unit-id ...]",
+		Short: "List fuel checks",
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		unitIDs, err := parseUnitIDs(args)
+		if err != nil {
+			return err
+		}
+		resp, err := client.ListFuelChecks(cmd.Context(),
+			maponv1.ListFuelChecksRequest_builder{
+				FromTime: timestamppb.New(*from),
+				ToTime:   timestamppb.New(*to),
+				UnitIds:  unitIDs,
+			}.Build())
+		if err != nil {
+			return err
+		}
+		for _, check := range resp.GetChecks() {
+			fmt.Println(protojson.Format(check))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newAddFuelCheckCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a fuel check",
+	}
+	date := cmd.Flags().Time("time", time.Now(), []string{time.RFC3339, time.DateTime}, "Time of the purchase")
+	fuelStation := cmd.Flags().String("fuel-station", "", "Fuel station name")
+	_ = cmd.MarkFlagRequired("fuel-station")
+	fuelType := cmd.Flags().String("fuel-type", "", "Fuel type code (D, 95, 98, 100, G, A, L, CNG)")
+	_ = cmd.MarkFlagRequired("fuel-type")
+	volume := cmd.Flags().Float64("volume", 0, "Fuel volume in liters")
+	_ = cmd.MarkFlagRequired("volume")
+	amount := cmd.Flags().Float64("amount", 0, "Total amount paid")
+	_ = cmd.MarkFlagRequired("amount")
+	unitID := cmd.Flags().Int64("unit-id", 0, "Unit ID")
+	cardID := cmd.Flags().String("card-id", "", "Fuel card identifier")
+	cardName := cmd.Flags().String("card-name", "", "Fuel card name")
+	checkNumber := cmd.Flags().String("check-no", "", "Receipt number")
+	currency := cmd.Flags().String("currency", "", "ISO 4217 currency code")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		resp, err := client.AddFuelCheck(cmd.Context(),
+			maponv1.AddFuelCheckRequest_builder{
+				Time:        timestamppb.New(*date),
+				FuelStation: new(*fuelStation),
+				FuelType:    new(*fuelType),
+				Volume:      new(*volume),
+				TotalAmount: new(*amount),
+				UnitId:      new(*unitID),
+				CardId:      new(*cardID),
+				CardName:    new(*cardName),
+				CheckNumber: new(*checkNumber),
+				Currency:    new(*currency),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("added fuel check id=%d\n", resp.GetCheckId())
+		return nil
+	}
+	return cmd
+}
+
+func newEditFuelCheckCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit <check-id>",
+		Short: "Edit a fuel check",
+		Args:  cobra.ExactArgs(1),
+	}
+	date := cmd.Flags().Time("time", time.Time{}, []string{time.RFC3339, time.DateTime}, "Time of the purchase")
+	fuelStation := cmd.Flags().String("fuel-station", "", "Fuel station name")
+	fuelType := cmd.Flags().String("fuel-type", "", "Fuel type code (D, 95, 98, 100, G, A, L, CNG)")
+	volume := cmd.Flags().Float64("volume", 0, "Fuel volume in liters")
+	amount := cmd.Flags().Float64("amount", 0, "Total amount paid")
+	unitID := cmd.Flags().Int64("unit-id", 0, "Unit ID")
+	cardID := cmd.Flags().String("card-id", "", "Fuel card identifier")
+	cardName := cmd.Flags().String("card-name", "", "Fuel card name")
+	checkNumber := cmd.Flags().String("check-no", "", "Receipt number")
+	currency := cmd.Flags().String("currency", "", "ISO 4217 currency code")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		checkID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid check ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.EditFuelCheckRequest{}
+		req.SetCheckId(checkID)
+		flags := cmd.Flags()
+		if flags.Changed("time") {
+			req.SetTime(timestamppb.New(*date))
+		}
+		if flags.Changed("fuel-station") {
+			req.SetFuelStation(*fuelStation)
+		}
+		if flags.Changed("fuel-type") {
+			req.SetFuelType(*fuelType)
+		}
+		if flags.Changed("volume") {
+			req.SetVolume(*volume)
+		}
+		if flags.Changed("amount") {
+			req.SetTotalAmount(*amount)
+		}
+		if flags.Changed("unit-id") {
+			req.SetUnitId(*unitID)
+		}
+		if flags.Changed("card-id") {
+			req.SetCardId(*cardID)
+		}
+		if flags.Changed("card-name") {
+			req.SetCardName(*cardName)
+		}
+		if flags.Changed("check-no") {
+			req.SetCheckNumber(*checkNumber)
+		}
+		if flags.Changed("currency") {
+			req.SetCurrency(*currency)
+		}
+		if _, err := client.EditFuelCheck(cmd.Context(), req); err != nil {
+			return err
+		}
+		fmt.Printf("updated fuel check id=%d\n", checkID)
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteFuelCheckCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <check-id>",
+		Short: "Delete a fuel check",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			checkID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid check ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteFuelCheck(cmd.Context(),
+				maponv1.DeleteFuelCheckRequest_builder{
+					CheckId: new(checkID),
+				}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted fuel check id=%d\n", checkID)
+			return nil
+		},
+	}
+}
+
+func newImportFuelChecksCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file.csv>",
+		Short: "Import fuel checks from a CSV file",
+		Long: `Import fuel checks from a CSV file.
+
+The first row must be a header. Recognized columns are: date, fuel_station,
+fuel_type, liters, amount, unit_id, card_id, card_name, check_no and currency.
+Dates are parsed as RFC 3339 or "YYYY-MM-DD HH:MM:SS" in UTC.`,
+		Args: cobra.ExactArgs(1),
+	}
+	dryRun := cmd.Flags().Bool("dry-run", false, "Validate the file without adding fuel checks")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		requests, err := parseFuelChecksCSV(f)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", args[0], err)
+		}
+		if *dryRun {
+			fmt.Printf("parsed %d fuel checks\n", len(requests))
+			return nil
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		var failed int
+		for i, req := range requests {
+			resp, err := client.AddFuelCheck(cmd.Context(), req)
+			if err != nil {
+				failed++
+				cmd.PrintErrf("row %d: %v\n", i+2, err)
+				continue
+			}
+			fmt.Printf("row %d: added fuel check id=%d\n", i+2, resp.GetCheckId())
+		}
+		if failed > 0 {
+			return fmt.Errorf("failed to import %d of %d fuel checks", failed, len(requests))
+		}
+		return nil
+	}
+	return cmd
+}
+
+// parseFuelChecksCSV parses fuel check rows from CSV with a header row.
+func parseFuelChecksCSV(r io.Reader) ([]*maponv1.AddFuelCheckRequest, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[name] = i
+	}
+	for _, required := range []string{"date", "fuel_station", "fuel_type", "liters", "amount"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing required column %q", required)
+		}
+	}
+	var result []*maponv1.AddFuelCheckRequest
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+		var date time.Time
+		for _, layout := range []string{time.RFC3339, time.DateTime, "2006-01-02T15:04:05"} {
+			if date, err = time.Parse(layout, field("date")); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("row %d: invalid date %q", line, field("date"))
+		}
+		volume, err := strconv.ParseFloat(field("liters"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: invalid liters %q", line, field("liters"))
+		}
+		amount, err := strconv.ParseFloat(field("amount"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: invalid amount %q", line, field("amount"))
+		}
+		req := &maponv1.AddFuelCheckRequest{}
+		req.SetTime(timestamppb.New(date))
+		req.SetFuelStation(field("fuel_station"))
+		req.SetFuelType(field("fuel_type"))
+		req.SetVolume(volume)
+		req.SetTotalAmount(amount)
+		if v := field("unit_id"); v != "" {
+			unitID, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid unit_id %q", line, v)
+			}
+			req.SetUnitId(unitID)
+		}
+		req.SetCardId(field("card_id"))
+		req.SetCardName(field("card_name"))
+		req.SetCheckNumber(field("check_no"))
+		req.SetCurrency(field("currency"))
+		result = append(result, req)
+	}
+	return result, nil
+}
+
+func newFuelCardsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cards",
+		Short: "Manage fuel cards",
+	}
+	cmd.AddCommand(newAddFuelCardCommand(cfg))
+	cmd.AddCommand(newUpdateFuelCardCommand(cfg))
+	cmd.AddCommand(newDeleteFuelCardCommand(cfg))
+	return cmd
+}
+
+func newAddFuelCardCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a fuel card",
+	}
+	cardID := cmd.Flags().String("card-id", "", "Fuel card identifier")
+	_ = cmd.MarkFlagRequired("card-id")
+	cardName := cmd.Flags().String("card-name", "", "Fuel card name")
+	unitID := cmd.Flags().Int64("unit-id", 0, "Unit ID the card is assigned to")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		resp, err := client.AddFuelCard(cmd.Context(),
+			maponv1.AddFuelCardRequest_builder{
+				CardId:   new(*cardID),
+				CardName: new(*cardName),
+				UnitId:   new(*unitID),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("added fuel card id=%d\n", resp.GetFuelCardId())
+		return nil
+	}
+	return cmd
+}
+
+func newUpdateFuelCardCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a fuel card",
+	}
+	id := cmd.Flags().Int64("id", 0, "Fuel card ID to update")
+	_ = cmd.MarkFlagRequired("id")
+	cardID := cmd.Flags().String("card-id", "", "Fuel card identifier")
+	_ = cmd.MarkFlagRequired("card-id")
+	cardName := cmd.Flags().String("card-name", "", "Fuel card name")
+	unitID := cmd.Flags().Int64("unit-id", 0, "Unit ID the card is assigned to")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.UpdateFuelCard(cmd.Context(),
+			maponv1.UpdateFuelCardRequest_builder{
+				FuelCardId: new(*id),
+				CardId:     new(*cardID),
+				CardName:   new(*cardName),
+				UnitId:     new(*unitID),
+			}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("updated fuel card id=%d\n", *id)
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteFuelCardCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a fuel card",
+	}
+	id := cmd.Flags().Int64("id", 0, "Fuel card ID to delete")
+	_ = cmd.MarkFlagRequired("id")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.DeleteFuelCard(cmd.Context(),
+			maponv1.DeleteFuelCardRequest_builder{
+				FuelCardId: new(*id),
+			}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("deleted fuel card id=%d\n", *id)
+		return nil
+	}
+	return cmd
+}
+
+// --- Reefer ---
+
+func newReeferCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reefer",
+		Short:   "Reefer data and control commands",
+		GroupID: "reefer",
+	}
+	cmd.AddCommand(newGetReeferHistoricPeriodCommand(cfg))
+	cmd.AddCommand(newGetReeferHistoricPointCommand(cfg))
+	cmd.AddCommand(newListReeferTemperatureDataCommand(cfg))
+	cmd.AddCommand(newListReeferRunModesCommand(cfg))
+	cmd.AddCommand(newChangeReeferSetpointCommand(cfg))
+	cmd.AddCommand(newChangeReeferRunModeCommand(cfg))
+	cmd.AddCommand(newReeferAlertsCommand(cfg))
+	return cmd
+}
+
+func newGetReeferHistoricPeriodCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history <unit-id>",
+		Short: "Get historic reefer readings for a period",
+		Args:  cobra.ExactArgs(1),
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		unitID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+		}
+		res, err := client.GetReeferHistoricPeriod(cmd.Context(),
+			maponv1.GetReeferHistoricPeriodRequest_builder{
+				UnitId:   new(unitID),
+				FromTime: timestamppb.New(*from),
+				ToTime:   timestamppb.New(*to),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Println(protojson.Format(res.GetData()))
+		return nil
+	}
+	return cmd
+}
+
+func newGetReeferHistoricPointCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "point <unit-id>",
+		Short: "Get reefer readings at a point in time",
+		Args:  cobra.ExactArgs(1),
+	}
+	at := cmd.Flags().Time("time", time.Now(), []string{time.DateOnly, time.RFC3339}, "Point in time")
+	nearest := cmd.Flags().Bool("nearest", false, "Select the nearest readings instead of the previous ones")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		unitID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+		}
+		selection := maponv1.ReeferHistoricPointSelection_REEFER_HISTORIC_POINT_SELECTION_PREVIOUS
+		if *nearest {
+			selection = maponv1.ReeferHistoricPointSelection_REEFER_HISTORIC_POINT_SELECTION_NEAREST
+		}
+		res, err := client.GetReeferHistoricPoint(cmd.Context(),
+			maponv1.GetReeferHistoricPointRequest_builder{
+				UnitId:    new(unitID),
+				Time:      timestamppb.New(*at),
+				Selection: selection.Enum(),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Println(protojson.Format(res.GetData()))
+		return nil
+	}
+	return cmd
+}
+
+func newListReeferTemperatureDataCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "temperatures <unit-id>",
+		Short: "List reefer compartment temperatures",
+		Args:  cobra.ExactArgs(1),
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		unitID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+		}
+		res, err := client.ListReeferTemperatureData(cmd.Context(),
+			maponv1.ListReeferTemperatureDataRequest_builder{
+				UnitId:   new(unitID),
+				FromTime: timestamppb.New(*from),
+				ToTime:   timestamppb.New(*to),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		for _, c := range res.GetCompartments() {
+			fmt.Println(protojson.Format(c))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newListReeferRunModesCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "runmodes <unit-id ...>",
+		Short: "List current and supported refrigerator run modes",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			unitIDs, err := parseUnitIDs(args)
+			if err != nil {
+				return err
+			}
+			res, err := client.ListReeferRunModes(cmd.Context(),
+				maponv1.ListReeferRunModesRequest_builder{
+					UnitIds: unitIDs,
+				}.Build())
+			if err != nil {
+				return err
+			}
+			for _, u := range res.GetUnits() {
+				fmt.Println(protojson.Format(u))
+			}
+			return nil
+		},
+	}
+}
+
+func newChangeReeferSetpointCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-setpoint <unit-id> <celsius>",
+		Short: "Change the setpoint temperature of a refrigerator compartment",
+		Args:  cobra.ExactArgs(2),
+	}
+	compartment := cmd.Flags().Int32("compartment", 0, "Compartment number")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		unitID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+		}
+		setpoint, err := strconv.ParseFloat(args[1], 64)
+		if err != nil {
+			return fmt.Errorf("invalid setpoint %s: %w", args[1], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.ChangeReeferSetpoint(cmd.Context(),
+			maponv1.ChangeReeferSetpointRequest_builder{
+				UnitId:          new(unitID),
+				Compartment:     new(*compartment),
+				SetpointCelsius: new(setpoint),
+			}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("changed setpoint unit=%d compartment=%d setpoint=%v\n", unitID, *compartment, setpoint)
+		return nil
+	}
+	return cmd
+}
+
+func newChangeReeferRunModeCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-runmode <unit-id> <runmode-id>",
+		Short: "Change the run mode of a refrigerator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			unitID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+			}
+			runModeID, err := strconv.ParseInt(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid run mode ID %s: %w", args[1], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.ChangeReeferRunMode(cmd.Context(),
+				maponv1.ChangeReeferRunModeRequest_builder{
+					UnitId:    new(unitID),
+					RunModeId: new(int32(runModeID)),
+				}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("changed run mode unit=%d runmode=%d\n", unitID, runModeID)
+			return nil
+		},
+	}
+}
+
+func newReeferAlertsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alerts",
+		Short: "Manage reefer temperature alerts",
+	}
+	cmd.AddCommand(newListReeferAlertsCommand(cfg))
+	cmd.AddCommand(newSetReeferAlertCommand(cfg))
+	cmd.AddCommand(newDeleteReeferAlertCommand(cfg))
+	cmd.AddCommand(newChangeReeferAlertUserCommand(cfg))
+	return cmd
+}
+
+func newListReeferAlertsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [unit-id ...]",
+		Short: "List reefer temperature alerts",
+	}
+	alertID := cmd.Flags().Int64("id", 0, "Alert ID")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		unitIDs, err := parseUnitIDs(args)
+		if err != nil {
+			return err
+		}
+		res, err := client.ListReeferAlerts(cmd.Context(),
+			maponv1.ListReeferAlertsRequest_builder{
+				AlertId: new(*alertID),
+				UnitIds: unitIDs,
+			}.Build())
+		if err != nil {
+			return err
+		}
+		for _, alert := range res.GetAlerts() {
+			fmt.Println(protojson.Format(alert))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newSetReeferAlertCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <unit-id>",
+		Short: "Create a reefer temperature alert",
+		Args:  cobra.ExactArgs(1),
+	}
+	compartment := cmd.Flags().Int32("compartment", 0, "Compartment number")
+	userID := cmd.Flags().Int64("user-id", 0, "Alert owner user ID")
+	_ = cmd.MarkFlagRequired("user-id")
+	from := cmd.Flags().Time("from", time.Now(), []string{time.DateOnly, time.RFC3339}, "Active from")
+	to := cmd.Flags().Time("to", time.Now().Add(time.Hour*24), []string{time.DateOnly, time.RFC3339}, "Active to")
+	runMode := cmd.Flags().Int32("runmode", 0, "Expected run mode (-1, 0, 1)")
+	setpoint := cmd.Flags().Float64("setpoint", 0, "Setpoint temperature in Celsius")
+	overSetpoint := cmd.Flags().Float64("over-setpoint", 0, "Maximum temperature over setpoint in Celsius")
+	underSetpoint := cmd.Flags().Float64("under-setpoint", 0, "Maximum temperature under setpoint in Celsius")
+	channels := cmd.Flags().StringSlice("channel", []string{"email"}, "Notification channels (sms, email, a3)")
+	cargoCooling := cmd.Flags().Bool("cargo-cooling", false, "Enable cargo cooling monitoring")
+	cargoCoolingRange := cmd.Flags().Int32("cargo-cooling-range", 2, "Cargo cooling range in Celsius (2-7)")
+	notes := cmd.Flags().String("notes", "", "Cargo notes")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		unitID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unit ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		res, err := client.SetReeferAlert(cmd.Context(),
+			maponv1.SetReeferAlertRequest_builder{
+				UnitId:                   new(unitID),
+				Compartment:              new(*compartment),
+				UserId:                   new(*userID),
+				ActiveFrom:               timestamppb.New(*from),
+				ActiveTo:                 timestamppb.New(*to),
+				RunMode:                  new(*runMode),
+				SetpointCelsius:          new(*setpoint),
+				OverSetpointCelsius:      new(*overSetpoint),
+				UnderSetpointCelsius:     new(*underSetpoint),
+				Channels:                 *channels,
+				CargoCooling:             new(*cargoCooling),
+				CargoCoolingRangeCelsius: new(*cargoCoolingRange),
+				Notes:                    new(*notes),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("created reefer alert id=%d\n", res.GetAlertId())
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteReeferAlertCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <alert-id>",
+		Short: "Delete a reefer temperature alert",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			alertID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid alert ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteReeferAlert(cmd.Context(),
+				maponv1.DeleteReeferAlertRequest_builder{
+					AlertId: new(alertID),
+				}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted reefer alert id=%d\n", alertID)
+			return nil
+		},
+	}
+}
+
+func newChangeReeferAlertUserCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-user <alert-id>",
+		Short: "Transfer a reefer temperature alert to another user",
+		Args:  cobra.ExactArgs(1),
+	}
+	oldUserID := cmd.Flags().Int64("old-user-id", 0, "Current alert owner user ID")
+	_ = cmd.MarkFlagRequired("old-user-id")
+	newUserID := cmd.Flags().Int64("new-user-id", 0, "New alert owner user ID")
+	_ = cmd.MarkFlagRequired("new-user-id")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		alertID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid alert ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.ChangeReeferAlertUser(cmd.Context(),
+			maponv1.ChangeReeferAlertUserRequest_builder{
+				AlertId:   new(alertID),
+				OldUserId: new(*oldUserID),
+				NewUserId: new(*newUserID),
+			}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("changed reefer alert id=%d user=%d\n", alertID, *newUserID)
+		return nil
+	}
+	return cmd
+}
+
+// --- Tachograph ---
+
+func newTachographCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tachograph",
+		Short:   "Tachograph DDD file commands",
+		GroupID: "tachograph",
+	}
+	cmd.AddCommand(newListDriverDddFilesCommand(cfg))
+	cmd.AddCommand(newListVehicleDddFilesCommand(cfg))
+	cmd.AddCommand(newDownloadDddFilesCommand(cfg))
+	return cmd
+}
+
+func newListDriverDddFilesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drivers",
+		Short: "List driver card DDD files",
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24*7), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		res, err := client.ListDriverDddFiles(cmd.Context(),
+			maponv1.ListDriverDddFilesRequest_builder{
+				FromTime: timestamppb.New(*from),
+				ToTime:   timestamppb.New(*to),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		for _, f := range res.GetFiles() {
+			fmt.Println(protojson.Format(f))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newListVehicleDddFilesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vehicles",
+		Short: "List vehicle unit DDD files",
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24*7), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		res, err := client.ListVehicleDddFiles(cmd.Context(),
+			maponv1.ListVehicleDddFilesRequest_builder{
+				FromTime: timestamppb.New(*from),
+				ToTime:   timestamppb.New(*to),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		for _, f := range res.GetFiles() {
+			fmt.Println(protojson.Format(f))
+		}
+		return nil
+	}
+	return cmd
+}
+
+// dddManifestFilename is the name of the manifest file in a DDD mirror directory.
+const dddManifestFilename = "manifest.json"
+
+// dddManifest records the DDD files mirrored into a local directory.
+type dddManifest struct {
+	// LastSync is the time of the last completed sync.
+	LastSync time.Time `json:"last_sync"`
+	// Files are the mirrored files, in download order.
+	Files []dddManifestFile `json:"files"`
+}
+
+type dddManifestFile struct {
+	Type         string    `json:"type"` // "driver" or "vehicle"
+	FileID       int64     `json:"file_id"`
+	UnitID       int64     `json:"unit_id"`
+	Path         string    `json:"path"` // Relative to the mirror directory
+	Size         int       `json:"size"`
+	SHA256       string    `json:"sha256"`
+	CreatedAt    time.Time `json:"created_at"`
+	DownloadedAt time.Time `json:"downloaded_at"`
+}
+
+func readDddManifest(dir string) (*dddManifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, dddManifestFilename))
+	if errors.Is(err, fs.ErrNotExist) {
+		return &dddManifest{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m dddManifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	return &m, nil
+}
+
+func writeDddManifest(dir string, m *dddManifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(dir, dddManifestFilename), data)
+}
+
+// writeFileAtomic writes data to a temporary file and renames it into place.
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func newDownloadDddFilesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "download",
+		Short: "Mirror DDD files into a local directory",
+		Long: `Mirror driver card and vehicle unit DDD files into a local directory.
+
+Files are stored under drivers/ and vehicles/ and recorded in manifest.json.
+Files already in the manifest are skipped, so re-runs only fetch new files.
+Without --since, the sync resumes from the last completed sync.`,
+	}
+	dir := cmd.Flags().String("dir", ".", "Directory to mirror files into")
+	since := cmd.Flags().Time("since", time.Now().AddDate(0, -1, 0), []string{time.DateOnly, time.RFC3339}, "Fetch files created since this time")
+	drivers := cmd.Flags().Bool("drivers", true, "Download driver card files")
+	vehicles := cmd.Flags().Bool("vehicles", true, "Download vehicle unit files")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		manifest, err := readDddManifest(*dir)
+		if err != nil {
+			return err
+		}
+		from := *since
+		if !cmd.Flags().Changed("since") && !manifest.LastSync.IsZero() {
+			// Overlap with the previous sync to pick up files that were listed late.
+			from = manifest.LastSync.Add(-time.Hour * 24)
+		}
+		to := time.Now()
+		downloaded := map[string]bool{}
+		for _, f := range manifest.Files {
+			downloaded[fmt.Sprintf("%s/%d", f.Type, f.FileID)] = true
+		}
+		var count int
+		save := func(fileType string, fileID, unitID int64, filename string, createdAt time.Time, content []byte) error {
+			subdir := fileType + "s"
+			if err := os.MkdirAll(filepath.Join(*dir, subdir), 0o755); err != nil {
+				return err
+			}
+			relPath := filepath.Join(subdir, dddFileName(fileID, filename))
+			if err := writeFileAtomic(filepath.Join(*dir, relPath), content); err != nil {
+				return err
+			}
+			sum := sha256.Sum256(content)
+			manifest.Files = append(manifest.Files, dddManifestFile{
+				Type:         fileType,
+				FileID:       fileID,
+				UnitID:       unitID,
+				Path:         filepath.ToSlash(relPath),
+				Size:         len(content),
+				SHA256:       hex.EncodeToString(sum[:]),
+				CreatedAt:    createdAt,
+				DownloadedAt: time.Now().UTC(),
+			})
+			downloaded[fmt.Sprintf("%s/%d", fileType, fileID)] = true
+			count++
+			fmt.Printf("downloaded %s\n", relPath)
+			// Persist progress after every file so an interrupted sync can resume.
+			return writeDddManifest(*dir, manifest)
+		}
+		// The API allows listing at most one month at a time. Fixed windows of 28 days stay
+		// within that limit, unlike month arithmetic which overflows at the end of a month.
+		const window = 28 * 24 * time.Hour
+		for start := from; start.Before(to); start = start.Add(window) {
+			end := start.Add(window)
+			if end.After(to) {
+				end = to
+			}
+			if *drivers {
+				if err := mirrorDriverDddFiles(cmd.Context(), client, start, end, downloaded, save); err != nil {
+					return err
+				}
+			}
+			if *vehicles {
+				if err := mirrorVehicleDddFiles(cmd.Context(), client, start, end, downloaded, save); err != nil {
+					return err
+				}
+			}
+		}
+		manifest.LastSync = to.UTC()
+		if err := writeDddManifest(*dir, manifest); err != nil {
+			return err
+		}
+		fmt.Printf("downloaded %d new files\n", count)
+		return nil
+	}
+	return cmd
+}
+
+// dddFileName returns the local name of a mirrored DDD file, prefixed with its ID to keep names unique.
+// Files without a name returned by the API are named after their ID.
+func dddFileName(fileID int64, filename string) string {
+	base := filepath.Base(filename)
+	if filename == "" || base == "." || base == string(filepath.Separator) {
+		return strconv.FormatInt(fileID, 10) + ".ddd"
+	}
+	return strconv.FormatInt(fileID, 10) + "_" + base
+}
+
+type dddSaveFunc func(fileType string, fileID, unitID int64, filename string, createdAt time.Time, content []byte) error
+
+func mirrorDriverDddFiles(ctx context.Context, client *mapon.Client, from, to time.Time, downloaded map[string]bool, save dddSaveFunc) error {
+	res, err := client.ListDriverDddFiles(ctx,
+		maponv1.ListDriverDddFilesRequest_builder{
+			FromTime: timestamppb.New(from),
+			ToTime:   timestamppb.New(to),
+		}.Build())
+	if err != nil {
+		return err
+	}
+	for _, f := range res.GetFiles() {
+		if downloaded[fmt.Sprintf("driver/%d", f.GetFileId())] {
+			continue
+		}
+		file, err := client.DownloadDriverDdd(ctx,
+			maponv1.DownloadDriverDddRequest_builder{
+				FileId: new(f.GetFileId()),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		if err := save("driver", f.GetFileId(), f.GetUnitId(), f.GetFilename(), f.GetCreatedAt().AsTime(), file.GetContent()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mirrorVehicleDddFiles(ctx context.Context, client *mapon.Client, from, to time.Time, downloaded map[string]bool, save dddSaveFunc) error {
+	res, err := client.ListVehicleDddFiles(ctx,
+		maponv1.ListVehicleDddFilesRequest_builder{
+			FromTime: timestamppb.New(from),
+			ToTime:   timestamppb.New(to),
+		}.Build())
+	if err != nil {
+		return err
+	}
+	for _, f := range res.GetFiles() {
+		if downloaded[fmt.Sprintf("vehicle/%d", f.GetFileId())] {
+			continue
+		}
+		file, err := client.DownloadVehicleDdd(ctx,
+			maponv1.DownloadVehicleDddRequest_builder{
+				FileId: new(f.GetFileId()),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		if err := save("vehicle", f.GetFileId(), f.GetUnitId(), f.GetFilename(), f.GetCreatedAt().AsTime(), file.GetContent()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// --- Vehicle Inspections ---
+
+func newListVehicleInspectionsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "inspections",
+		Short:   "List vehicle inspections with their checklist items",
+		GroupID: "inspections",
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	unitIDs := cmd.Flags().Int64Slice("unit-id", nil, "Filter by unit ID")
+	failedOnly := cmd.Flags().Bool("failed-only", false, "Only list failed inspections")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		var statuses []maponv1.VehicleInspection_Status
+		if *failedOnly {
+			statuses = append(statuses, maponv1.VehicleInspection_STATUS_FAILED)
+		}
+		for page := int32(1); ; page++ {
+			response, err := client.ListVehicleInspections(cmd.Context(), maponv1.ListVehicleInspectionsRequest_builder{
+				Statuses:     statuses,
+				FromTime:     timestamppb.New(*from),
+				ToTime:       timestamppb.New(*to),
+				UnitIds:      *unitIDs,
+				IncludeItems: new(true),
+				Page:         new(page),
+				PerPage:      new(int32(250)),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			for _, inspection := range response.GetInspections() {
+				fmt.Println(protojson.Format(inspection))
+			}
+			if page >= response.GetPagination().GetTotalPages() {
+				return nil
+			}
+		}
+	}
+	return cmd
+}
+
+// --- Routes ---
+
+func newListRoutesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "routes",
+		Short:   "List routes",
+		GroupID: "routes",
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	ids := cmd.Flags().StringSlice("unit-id", nil, "Filter by unit ID")
+	include := cmd.Flags().StringSlice("include", nil, "Include additional data (polyline)")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		var unitIDs []int64
+		for _, idStr := range *ids {
+			id, err := strconv.ParseInt(idStr, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid unit ID %s: %w", idStr, err)
+			}
+			unitIDs = append(unitIDs, id)
+		}
+		req := &maponv1.ListRoutesRequest{}
+		req.SetFromTime(timestamppb.New(*from))
+		req.SetToTime(timestamppb.New(*to))
+		req.SetUnitIds(unitIDs)
+		req.SetInclude(*include)
+		response, err := client.ListRoutes(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, route := range response.GetRoutes() {
+			fmt.Println(protojson.Format(route))
+		}
+		return nil
+	}
+	return cmd
+}
+
+// --- Route Planning ---
+
+func newRoutePlanningCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "route-planning",
+		Short:   "Manage route planning orders, places and routes",
+		GroupID: "routes",
+	}
+	cmd.AddCommand(newRoutePlanningOrdersCommand(cfg))
+	cmd.AddCommand(newRoutePlanningPlacesCommand(cfg))
+	cmd.AddCommand(newRoutePlanningRoutesCommand(cfg))
+	return cmd
+}
+
+func newRoutePlanningOrdersCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders",
+		Short: "Manage route planning orders",
+	}
+	cmd.AddCommand(newListRoutePlanningOrdersCommand(cfg))
+	cmd.AddCommand(newGetRoutePlanningOrderCommand(cfg))
+	cmd.AddCommand(newDeleteRoutePlanningOrdersCommand(cfg))
+	return cmd
+}
+
+func newListRoutePlanningOrdersCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List route planning orders",
+	}
+	hasRoute := cmd.Flags().Bool("has-route", false, "Filter by whether the order is assigned to a route")
+	clientName := cmd.Flags().String("client-name", "", "Filter by client name")
+	deliveryDate := cmd.Flags().String("delivery-date", "", "Filter by delivery date (YYYY-MM-DD)")
+	page := cmd.Flags().Int32("page", 0, "Page number")
+	perPage := cmd.Flags().Int32("per-page", 0, "Orders per page")
+	polyline := cmd.Flags().Bool("polyline", false, "Include the order polyline")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.ListRoutePlanningOrdersRequest{}
+		if cmd.Flags().Changed("has-route") {
+			req.SetHasRoute(*hasRoute)
+		}
+		req.SetClientName(*clientName)
+		req.SetDeliveryDate(*deliveryDate)
+		req.SetPage(*page)
+		req.SetPerPage(*perPage)
+		req.SetIncludePolyline(*polyline)
+		response, err := client.ListRoutePlanningOrders(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, order := range response.GetOrders() {
+			fmt.Println(protojson.Format(order))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newGetRoutePlanningOrderCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <order-id>",
+		Short: "Get a route planning order",
+		Args:  cobra.ExactArgs(1),
+	}
+	polyline := cmd.Flags().Bool("polyline", false, "Include the order polyline")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		orderID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid order ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.GetRoutePlanningOrder(cmd.Context(), maponv1.GetRoutePlanningOrderRequest_builder{
+			OrderId:         new(orderID),
+			IncludePolyline: new(*polyline),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Println(protojson.Format(response.GetOrder()))
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteRoutePlanningOrdersCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <order-id>...",
+		Short: "Delete route planning orders",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orderIDs, err := parseIDs(args, "order")
+			if err != nil {
+				return err
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteRoutePlanningOrders(cmd.Context(),
+				maponv1.DeleteRoutePlanningOrdersRequest_builder{
+					OrderIds: orderIDs,
+				}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted route planning orders ids=%v\n", orderIDs)
+			return nil
+		},
+	}
+}
+
+func newRoutePlanningPlacesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "places",
+		Short: "Manage route planning places",
+	}
+	cmd.AddCommand(newListRoutePlanningPlacesCommand(cfg))
+	cmd.AddCommand(newGetRoutePlanningPlaceCommand(cfg))
+	cmd.AddCommand(newDeleteRoutePlanningPlacesCommand(cfg))
+	return cmd
+}
+
+func newListRoutePlanningPlacesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list <route-id>...",
+		Short: "List places of planned routes",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	page := cmd.Flags().Int32("page", 0, "Page number")
+	perPage := cmd.Flags().Int32("per-page", 0, "Places per page")
+	polyline := cmd.Flags().Bool("polyline", false, "Include the polyline to each place")
+	files := cmd.Flags().Bool("files", false, "Include files attached to each place")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		routeIDs, err := parseIDs(args, "route")
+		if err != nil {
+			return err
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ListRoutePlanningPlaces(cmd.Context(), maponv1.ListRoutePlanningPlacesRequest_builder{
+			RouteIds:        routeIDs,
+			Page:            new(*page),
+			PerPage:         new(*perPage),
+			IncludePolyline: new(*polyline),
+			IncludeFiles:    new(*files),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		for _, place := range response.GetPlaces() {
+			fmt.Println(protojson.Format(place))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newGetRoutePlanningPlaceCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <place-id>",
+		Short: "Get a route planning place",
+		Args:  cobra.ExactArgs(1),
+	}
+	polyline := cmd.Flags().Bool("polyline", false, "Include the polyline to the place")
+	files := cmd.Flags().Bool("files", false, "Include files attached to the place")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		placeID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid place ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.GetRoutePlanningPlace(cmd.Context(), maponv1.GetRoutePlanningPlaceRequest_builder{
+			PlaceId:         new(placeID),
+			IncludePolyline: new(*polyline),
+			IncludeFiles:    new(*files),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Println(protojson.Format(response.GetPlace()))
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteRoutePlanningPlacesCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <place-id>...",
+		Short: "Delete route planning places",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			placeIDs, err := parseIDs(args, "place")
+			if err != nil {
+				return err
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteRoutePlanningPlaces(cmd.Context(),
+				maponv1.DeleteRoutePlanningPlacesRequest_builder{
+					PlaceIds: placeIDs,
+				}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted route planning places ids=%v\n", placeIDs)
+			return nil
+		},
+	}
+}
+
+func newRoutePlanningRoutesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "routes",
+		Short: "Manage planned routes",
+	}
+	cmd.AddCommand(newListRoutePlanningRoutesCommand(cfg))
+	cmd.AddCommand(newGetRoutePlanningRouteCommand(cfg))
+	cmd.AddCommand(newSaveRoutePlanningRouteCommand(cfg))
+	cmd.AddCommand(newDeleteRoutePlanningRoutesCommand(cfg))
+	cmd.AddCommand(newSendRoutePlanningRouteCommand(cfg))
+	cmd.AddCommand(newSetRoutePlanningRouteStartCommand(cfg))
+	cmd.AddCommand(newSetRoutePlanningRouteEndCommand(cfg))
+	cmd.AddCommand(newOptimizeRoutePlanningRouteCommand(cfg))
+	return cmd
+}
+
+func newListRoutePlanningRoutesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List planned routes",
+	}
+	unitIDs := cmd.Flags().Int64Slice("unit-id", nil, "Filter by assigned unit ID")
+	driverIDs := cmd.Flags().Int64Slice("driver-id", nil, "Filter by assigned driver ID")
+	inProgress := cmd.Flags().Bool("in-progress", false, "Filter by whether the route is in progress")
+	page := cmd.Flags().Int32("page", 0, "Page number")
+	perPage := cmd.Flags().Int32("per-page", 0, "Routes per page")
+	polyline := cmd.Flags().Bool("polyline", false, "Include the route polyline")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.ListRoutePlanningRoutesRequest{}
+		req.SetUnitIds(*unitIDs)
+		req.SetDriverIds(*driverIDs)
+		if cmd.Flags().Changed("in-progress") {
+			req.SetInProgress(*inProgress)
+		}
+		req.SetPage(*page)
+		req.SetPerPage(*perPage)
+		req.SetIncludePolyline(*polyline)
+		response, err := client.ListRoutePlanningRoutes(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, route := range response.GetRoutes() {
+			fmt.Println(protojson.Format(route))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newGetRoutePlanningRouteCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <route-id>",
+		Short: "Get a planned route",
+		Args:  cobra.ExactArgs(1),
+	}
+	polyline := cmd.Flags().Bool("polyline", false, "Include the route polyline")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		routeID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid route ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.GetRoutePlanningRoute(cmd.Context(), maponv1.GetRoutePlanningRouteRequest_builder{
+			RouteId:         new(routeID),
+			IncludePolyline: new(*polyline),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Println(protojson.Format(response.GetRoute()))
+		return nil
+	}
+	return cmd
+}
+
+func newSaveRoutePlanningRouteCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save",
+		Short: "Create or update a planned route",
+	}
+	routeID := cmd.Flags().Int64("id", 0, "Route ID to update (creates a new route if unset)")
+	name := cmd.Flags().String("name", "", "Route name")
+	unitID := cmd.Flags().Int64("unit-id", 0, "Assigned unit ID")
+	driverID := cmd.Flags().Int64("driver-id", 0, "Assigned driver ID")
+	driverUnitID := cmd.Flags().Int64("driver-unit-id", 0, "Unit ID of the assigned driver")
+	departure := cmd.Flags().Time("departure", time.Time{}, []string{time.DateTime, time.RFC3339}, "Departure time")
+	startAddress := cmd.Flags().String("start-address", "", "Start address")
+	endAddress := cmd.Flags().String("end-address", "", "End address")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.SaveRoutePlanningRouteRequest{}
+		req.SetRouteId(*routeID)
+		req.SetName(*name)
+		req.SetAssigneeUnitId(*unitID)
+		req.SetAssigneeDriverId(*driverID)
+		req.SetAssigneeDriverUnitId(*driverUnitID)
+		if cmd.Flags().Changed("departure") {
+			req.SetDepartureAt(timestamppb.New(*departure))
+		}
+		req.SetStartAddress(*startAddress)
+		req.SetEndAddress(*endAddress)
+		response, err := client.SaveRoutePlanningRoute(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("saved route id=%d\n", response.GetRouteId())
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteRoutePlanningRoutesCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <route-id>...",
+		Short: "Delete planned routes",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			routeIDs, err := parseIDs(args, "route")
+			if err != nil {
+				return err
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteRoutePlanningRoutes(cmd.Context(),
+				maponv1.DeleteRoutePlanningRoutesRequest_builder{
+					RouteIds: routeIDs,
+				}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted routes ids=%v\n", routeIDs)
+			return nil
+		},
+	}
+}
+
+func newSendRoutePlanningRouteCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "send <route-id>",
+		Short: "Send a planned route to the driver application of its assignee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			routeID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid route ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.SendRoutePlanningRouteToAssignee(cmd.Context(),
+				maponv1.SendRoutePlanningRouteToAssigneeRequest_builder{
+					RouteId: new(routeID),
+				}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("sent route id=%d\n", routeID)
+			return nil
+		},
+	}
+}
+
+func newSetRoutePlanningRouteStartCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-start <route-id>",
+		Short: "Set the start address and departure time of a planned route",
+		Args:  cobra.ExactArgs(1),
+	}
+	address := cmd.Flags().String("address", "", "Start address")
+	departure := cmd.Flags().Time("departure", time.Time{}, []string{time.DateTime, time.RFC3339}, "Departure time")
+	_ = cmd.MarkFlagRequired("address")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		routeID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid route ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.SetRoutePlanningRouteStartAddressRequest{}
+		req.SetRouteId(routeID)
+		req.SetAddress(*address)
+		if cmd.Flags().Changed("departure") {
+			req.SetDepartureAt(timestamppb.New(*departure))
+		}
+		if _, err := client.SetRoutePlanningRouteStartAddress(cmd.Context(), req); err != nil {
+			return err
+		}
+		fmt.Printf("set start address of route id=%d\n", routeID)
+		return nil
+	}
+	return cmd
+}
+
+func newSetRoutePlanningRouteEndCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-end <route-id>",
+		Short: "Set the end address of a planned route",
+		Args:  cobra.ExactArgs(1),
+	}
+	address := cmd.Flags().String("address", "", "End address")
+	_ = cmd.MarkFlagRequired("address")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		routeID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid route ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.SetRoutePlanningRouteEndAddress(cmd.Context(),
+			maponv1.SetRoutePlanningRouteEndAddressRequest_builder{
+				RouteId: new(routeID),
+				Address: new(*address),
+			}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("set end address of route id=%d\n", routeID)
+		return nil
+	}
+	return cmd
+}
+
+func newOptimizeRoutePlanningRouteCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "optimize <route-id>",
+		Short: "Optimize a planned route and wait for the result",
+		Args:  cobra.ExactArgs(1),
+	}
+	truckRestrictions := cmd.Flags().Bool("truck-restrictions", false, "Apply the truck driving restrictions of the vehicle")
+	noWait := cmd.Flags().Bool("no-wait", false, "Start the optimization without waiting for it to finish")
+	timeout := cmd.Flags().Duration("timeout", 10*time.Minute, "Maximum time to wait for the optimization")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		routeID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid route ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := maponv1.OptimizeRoutePlanningRouteRequest_builder{
+			RouteId:                  new(routeID),
+			TruckRestrictionsEnabled: new(*truckRestrictions),
+		}.Build()
+		if *noWait {
+			response, err := client.OptimizeRoutePlanningRoute(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			fmt.Println(protojson.Format(response))
+			return nil
+		}
+		ctx, cancel := context.WithTimeout(cmd.Context(), *timeout)
+		defer cancel()
+		response, err := client.OptimizeRouteAndWait(ctx, req)
+		if err != nil {
+			return err
+		}
+		fmt.Println(protojson.Format(response))
+		return nil
+	}
+	return cmd
+}
+
+// --- Tasks ---
+
+func newTasksCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tasks",
+		Short:   "Manage unit tasks and task routes",
+		GroupID: "routes",
+	}
+	cmd.AddCommand(newListTasksCommand(cfg))
+	cmd.AddCommand(newCreateTaskCommand(cfg))
+	cmd.AddCommand(newEditTaskCommand(cfg))
+	cmd.AddCommand(newDeleteTaskCommand(cfg))
+	cmd.AddCommand(newDeleteTaskRouteCommand(cfg))
+	return cmd
+}
+
+func newListTasksCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the tasks of units",
+	}
+	unitIDs := cmd.Flags().Int64Slice("unit-id", nil, "Units to list tasks for")
+	statuses := cmd.Flags().StringSlice("status", nil, "Filter by status (not_started, in_progress, waiting, completed)")
+	since := cmd.Flags().Time("since", time.Time{}, []string{time.DateOnly, time.RFC3339}, "Only list tasks updated after this time")
+	_ = cmd.MarkFlagRequired("unit-id")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		var filter []maponv1.Task_Status
+		for _, name := range *statuses {
+			status, ok := mapon.ParseTaskStatus(name)
+			if !ok {
+				return fmt.Errorf("unsupported task status %q", name)
+			}
+			filter = append(filter, status)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		for _, unitID := range *unitIDs {
+			req := &maponv1.ListTasksRequest{}
+			req.SetUnitId(unitID)
+			if cmd.Flags().Changed("since") {
+				req.SetUpdatedSince(timestamppb.New(*since))
+			}
+			response, err := client.ListTasks(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			for _, task := range response.GetTasks() {
+				if len(filter) > 0 && !slices.Contains(filter, task.GetStatus()) {
+					continue
+				}
+				fmt.Println(protojson.Format(task))
+			}
+		}
+		return nil
+	}
+	return cmd
+}
+
+// taskFlags are the settings shared by the create and edit task commands.
+type taskFlags struct {
+	unitID        *int64
+	arrival       *time.Time
+	lat           *float64
+	lng           *float64
+	taskType      *string
+	instructions  *string
+	orderID       *string
+	clientID      *string
+	routeID       *int64
+	trailerID     *int64
+	documentTypes *[]string
+}
+
+func addTaskFlags(cmd *cobra.Command) *taskFlags {
+	f := &taskFlags{
+		unitID:        cmd.Flags().Int64("unit-id", 0, "Unit to assign the task to"),
+		arrival:       cmd.Flags().Time("arrival", time.Time{}, []string{time.DateTime, time.RFC3339}, "Planned arrival time"),
+		lat:           cmd.Flags().Float64("lat", 0, "Destination latitude"),
+		lng:           cmd.Flags().Float64("lng", 0, "Destination longitude"),
+		taskType:      cmd.Flags().String("type", "", "Task type (e.g. loading, unloading, fueling)"),
+		instructions:  cmd.Flags().String("instructions", "", "Instructions for the driver"),
+		orderID:       cmd.Flags().String("order-id", "", "Order ID"),
+		clientID:      cmd.Flags().String("client-id", "", "Client ID"),
+		routeID:       cmd.Flags().Int64("route-id", 0, "Task route to add the task to"),
+		trailerID:     cmd.Flags().Int64("trailer-id", 0, "Trailer ID"),
+		documentTypes: cmd.Flags().StringSlice("document-type", nil, "Document types the driver must submit (e.g. cmr, pod)"),
+	}
+	_ = cmd.MarkFlagRequired("unit-id")
+	_ = cmd.MarkFlagRequired("arrival")
+	_ = cmd.MarkFlagRequired("lat")
+	_ = cmd.MarkFlagRequired("lng")
+	return f
+}
+
+// task returns the task set by the flags. Instructions, order ID and client ID are only set
+// when their flags are given, so that an empty flag value clears them.
+func (f *taskFlags) task(cmd *cobra.Command) (*maponv1.Task, error) {
+	t := maponv1.Task_builder{
+		UnitId:             new(*f.unitID),
+		PlannedArrivalTime: timestamppb.New(*f.arrival),
+		Destination: maponv1.TaskDestination_builder{
+			Location: maponv1.Location_builder{Latitude: new(*f.lat), Longitude: new(*f.lng)}.Build(),
+		}.Build(),
+		RouteId:               new(*f.routeID),
+		TrailerId:             new(*f.trailerID),
+		RequiredDocumentTypes: *f.documentTypes,
+	}.Build()
+	if cmd.Flags().Changed("instructions") {
+		t.SetInstructions(*f.instructions)
+	}
+	if cmd.Flags().Changed("order-id") {
+		t.SetOrderId(*f.orderID)
+	}
+	if cmd.Flags().Changed("client-id") {
+		t.SetClientId(*f.clientID)
+	}
+	if *f.taskType != "" {
+		taskType, ok := mapon.ParseTaskType(*f.taskType)
+		if !ok {
+			return nil, fmt.Errorf("unsupported task type %q", *f.taskType)
+		}
+		t.SetType(taskType)
+	}
+	return t, nil
+}
+
+func newCreateTaskCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a task for a unit",
+	}
+	flags := addTaskFlags(cmd)
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		task, err := flags.task(cmd)
+		if err != nil {
+			return err
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.CreateTask(cmd.Context(), maponv1.CreateTaskRequest_builder{
+			Task: task,
+		}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("created task id=%d\n", response.GetTaskId())
+		return nil
+	}
+	return cmd
+}
+
+func newEditTaskCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit <task-id>",
+		Short: "Replace the settings of a task",
+		Args:  cobra.ExactArgs(1),
+	}
+	flags := addTaskFlags(cmd)
+	status := cmd.Flags().String("status", "", "Task status (not_started, in_progress, waiting, completed)")
+	clearDocumentTypes := cmd.Flags().Bool("clear-document-types", false, "Remove all required document types")
+	cmd.MarkFlagsMutuallyExclusive("document-type", "clear-document-types")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid task ID %s: %w", args[0], err)
+		}
+		task, err := flags.task(cmd)
+		if err != nil {
+			return err
+		}
+		task.SetTaskId(id)
+		if *status != "" {
+			taskStatus, ok := mapon.ParseTaskStatus(*status)
+			if !ok {
+				return fmt.Errorf("unsupported task status %q", *status)
+			}
+			task.SetStatus(taskStatus)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.EditTask(cmd.Context(), maponv1.EditTaskRequest_builder{
+			Task:                       task,
+			ClearRequiredDocumentTypes: new(*clearDocumentTypes),
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("updated task id=%d\n", id)
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteTaskCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <task-id>",
+		Short: "Delete a task",
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid task ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.DeleteTask(cmd.Context(), maponv1.DeleteTaskRequest_builder{
+			TaskId: new(id),
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("deleted task id=%d\n", id)
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteTaskRouteCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-route <route-id>",
+		Short: "Delete a task route and all of its tasks",
+		Args:  cobra.ExactArgs(1),
+	}
+	yes := cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid route ID %s: %w", args[0], err)
+		}
+		if !*yes {
+			ok, err := confirm(cmd, fmt.Sprintf("Delete task route id=%d and all of its tasks?", id))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("aborted")
+			}
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.DeleteTaskRoute(cmd.Context(), maponv1.DeleteTaskRouteRequest_builder{
+			RouteId: new(id),
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("deleted task route id=%d\n", id)
+		return nil
+	}
+	return cmd
+}
+
+// --- Objects ---
+
+func newListObjectsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "objects",
+		Short:   "List objects",
+		GroupID: "objects",
+	}
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ListObjects(cmd.Context(), &maponv1.ListObjectsRequest{})
+		if err != nil {
+			return err
+		}
+		for _, object := range response.GetObjects() {
+			fmt.Println(protojson.Format(object))
+		}
+		return nil
+	}
+	cmd.AddCommand(newSaveObjectCommand(cfg))
+	cmd.AddCommand(newDeleteObjectCommand(cfg))
+	cmd.AddCommand(newImportObjectsCommand(cfg))
+	cmd.AddCommand(newObjectGroupsCommand(cfg))
+	cmd.AddCommand(newObjectCustomFieldsCommand(cfg))
+	return cmd
+}
+
+func newSaveObjectCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save",
+		Short: "Create or update an object",
+	}
+	objectID := cmd.Flags().Int64("id", 0, "Object ID to update (creates a new object if unset)")
+	name := cmd.Flags().String("name", "", "Object name")
+	wkt := cmd.Flags().String("wkt", "", "Object geometry in WKT format")
+	lonFirst := cmd.Flags().Bool("wkt-lon-first", false, "WKT points are longitude first")
+	groupID := cmd.Flags().Int64("group-id", 0, "Object group ID")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("wkt")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		res, err := client.SaveObject(cmd.Context(), maponv1.SaveObjectRequest_builder{
+			ObjectId:    new(*objectID),
+			Name:        new(*name),
+			Wkt:         new(*wkt),
+			WktLonFirst: new(*lonFirst),
+			GroupId:     new(*groupID),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("saved object id=%d\n", res.GetObjectId())
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteObjectCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <object-id>",
+		Short: "Delete an object",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			objectID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid object ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteObject(cmd.Context(), maponv1.DeleteObjectRequest_builder{
+				ObjectId: new(objectID),
+			}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted object id=%d\n", objectID)
+			return nil
+		},
+	}
+}
+
+func newImportObjectsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Create objects from GeoJSON features",
+		Long: `Create objects from the features of a GeoJSON file.
+
+Each feature becomes a new object named after the feature property given by --name-property.
+All geometries are validated before any object is created.`,
+	}
+	geojsonFile := cmd.Flags().String("geojson", "", "GeoJSON file with a FeatureCollection or a Feature")
+	nameProperty := cmd.Flags().String("name-property", "name", "Feature property used as object name")
+	groupID := cmd.Flags().Int64("group-id", 0, "Object group ID")
+	dryRun := cmd.Flags().Bool("dry-run", false, "Validate and print the objects without creating them")
+	_ = cmd.MarkFlagRequired("geojson")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		data, err := os.ReadFile(*geojsonFile)
+		if err != nil {
+			return err
+		}
+		requests, err := parseGeoJSONObjects(data, *nameProperty)
+		if err != nil {
+			return fmt.Errorf("%s: %w", *geojsonFile, err)
+		}
+		if *dryRun {
+			for _, req := range requests {
+				fmt.Printf("%s\t%s\n", req.GetName(), req.GetWkt())
+			}
+			return nil
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		for _, req := range requests {
+			req.SetGroupId(*groupID)
+			res, err := client.SaveObject(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("object %q: %w", req.GetName(), err)
+			}
+			fmt.Printf("created object id=%d name=%q\n", res.GetObjectId(), req.GetName())
+		}
+		return nil
+	}
+	return cmd
+}
+
+// parseGeoJSONFeatures returns the features of a GeoJSON FeatureCollection or Feature.
+func parseGeoJSONFeatures(data []byte) ([]geoJSONFeature, error) {
+	var doc struct {
+		Type     string           `json:"type"`
+		Features []geoJSONFeature `json:"features"`
+		geoJSONFeature
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+	switch doc.Type {
+	case "FeatureCollection":
+		return doc.Features, nil
+	case "Feature":
+		return []geoJSONFeature{doc.geoJSONFeature}, nil
+	default:
+		return nil, fmt.Errorf("unsupported GeoJSON type %q", doc.Type)
+	}
+}
+
+type geoJSONFeature struct {
+	Geometry   json.RawMessage `json:"geometry"`
+	Properties map[string]any  `json:"properties"`
+}
+
+// geoJSONToWKT converts a GeoJSON geometry to WKT with longitude first and validates its
+// positions, lines and polygon rings.
+// Point, LineString, Polygon, MultiPoint, MultiLineString and MultiPolygon geometries are supported.
+func geoJSONToWKT(geometry json.RawMessage) (string, error) {
+	var g struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	}
+	if err := json.Unmarshal(geometry, &g); err != nil {
+		return "", fmt.Errorf("invalid GeoJSON geometry: %w", err)
+	}
+	var depth int
+	switch g.Type {
+	case "Point":
+		depth = 0
+	case "LineString", "MultiPoint":
+		depth = 1
+	case "Polygon", "MultiLineString":
+		depth = 2
+	case "MultiPolygon":
+		depth = 3
+	default:
+		return "", fmt.Errorf("unsupported GeoJSON geometry type %q", g.Type)
+	}
+	var b strings.Builder
+	b.WriteString(strings.ToUpper(g.Type))
+	if err := writeGeoJSONCoordinatesWKT(&b, g.Coordinates, depth, true); err != nil {
+		return "", fmt.Errorf("invalid GeoJSON %s coordinates: %w", g.Type, err)
+	}
+	if err := checkGeoJSONShape(g.Type, g.Coordinates); err != nil {
+		return "", fmt.Errorf("invalid GeoJSON %s coordinates: %w", g.Type, err)
+	}
+	return b.String(), nil
+}
+
+// checkGeoJSONShape checks that lines have at least 2 positions and that polygon rings
+// have at least 4 positions and are closed.
+func checkGeoJSONShape(typ string, coordinates json.RawMessage) error {
+	switch typ {
+	case "LineString":
+		var line [][]float64
+		if err := json.Unmarshal(coordinates, &line); err != nil {
+			return err
+		}
+		return checkGeoJSONLine(line)
+	case "MultiLineString":
+		var lines [][][]float64
+		if err := json.Unmarshal(coordinates, &lines); err != nil {
+			return err
+		}
+		for i, line := range lines {
+			if err := checkGeoJSONLine(line); err != nil {
+				return fmt.Errorf("line %d: %w", i, err)
+			}
+		}
+	case "Polygon":
+		var rings [][][]float64
+		if err := json.Unmarshal(coordinates, &rings); err != nil {
+			return err
+		}
+		return checkGeoJSONPolygon(rings)
+	case "MultiPolygon":
+		var polygons [][][][]float64
+		if err := json.Unmarshal(coordinates, &polygons); err != nil {
+			return err
+		}
+		for i, rings := range polygons {
+			if err := checkGeoJSONPolygon(rings); err != nil {
+				return fmt.Errorf("polygon %d: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+func checkGeoJSONLine(line [][]float64) error {
+	if len(line) < 2 {
+		return fmt.Errorf("expected at least 2 positions, got %d", len(line))
+	}
+	return nil
+}
+
+func checkGeoJSONPolygon(rings [][][]float64) error {
+	for i, ring := range rings {
+		if len(ring) < 4 {
+			return fmt.Errorf("ring %d: expected at least 4 positions, got %d", i, len(ring))
+		}
+		first, last := ring[0], ring[len(ring)-1]
+		if first[0] != last[0] || first[1] != last[1] {
+			return fmt.Errorf("ring %d: must start and end at the same position", i)
+		}
+	}
+	return nil
+}
+
+func writeGeoJSONCoordinatesWKT(b *strings.Builder, data json.RawMessage, depth int, wrap bool) error {
+	if depth == 0 {
+		var position []float64
+		if err := json.Unmarshal(data, &position); err != nil {
+			return err
+		}
+		if len(position) < 2 {
+			return fmt.Errorf("expected position with at least 2 values, got %d", len(position))
+		}
+		if lon, lat := position[0], position[1]; lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+			return fmt.Errorf("position (%v %v) is out of WGS84 bounds", lon, lat)
+		}
+		// Altitude and other values beyond longitude and latitude are dropped.
+		if wrap {
+			b.WriteByte('(')
+		}
+		b.WriteString(strconv.FormatFloat(position[0], 'f', -1, 64))
+		b.WriteByte(' ')
+		b.WriteString(strconv.FormatFloat(position[1], 'f', -1, 64))
+		if wrap {
+			b.WriteByte(')')
+		}
+		return nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("empty coordinates")
+	}
+	b.WriteByte('(')
+	for i, item := range items {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		if err := writeGeoJSONCoordinatesWKT(b, item, depth-1, false); err != nil {
+			return err
+		}
+	}
+	b.WriteByte(')')
+	return nil
+}
+
+func parseGeoJSONObjects(data []byte, nameProperty string) ([]*maponv1.SaveObjectRequest, error) {
+	features, err := parseGeoJSONFeatures(data)
+	if err != nil {
+		return nil, err
+	}
+	requests := make([]*maponv1.SaveObjectRequest, 0, len(features))
+	for i, feature := range features {
+		name, ok := feature.Properties[nameProperty].(string)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("feature %d: missing %q property", i, nameProperty)
+		}
+		wkt, err := geoJSONToWKT(feature.Geometry)
+		if err != nil {
+			return nil, fmt.Errorf("feature %d (%s): %w", i, name, err)
+		}
+		requests = append(requests, maponv1.SaveObjectRequest_builder{
+			Name:        new(name),
+			Wkt:         new(wkt),
+			WktLonFirst: new(true),
+		}.Build())
+	}
+	return requests, nil
+}
+
+func newObjectGroupsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups",
+		Short: "Manage object groups",
+	}
+	cmd.AddCommand(newListObjectGroupsCommand(cfg))
+	cmd.AddCommand(newSaveObjectGroupCommand(cfg))
+	cmd.AddCommand(newDeleteObjectGroupCommand(cfg))
+	return cmd
+}
+
+func newListObjectGroupsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List object groups",
+	}
+	groupID := cmd.Flags().Int64("id", 0, "Filter by group ID")
+	name := cmd.Flags().String("name", "", "Filter by group name")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		res, err := client.ListObjectGroups(cmd.Context(), maponv1.ListObjectGroupsRequest_builder{
+			GroupId: new(*groupID),
+			Name:    new(*name),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		for _, g := range res.GetGroups() {
+			fmt.Println(protojson.Format(g))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newSaveObjectGroupCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save",
+		Short: "Create or update an object group",
+	}
+	groupID := cmd.Flags().Int64("id", 0, "Group ID to update (creates a new group if unset)")
+	name := cmd.Flags().String("name", "", "Group name")
+	color := cmd.Flags().String("color", "", "Group color as hex (e.g. FF0000)")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("color")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		res, err := client.SaveObjectGroup(cmd.Context(), maponv1.SaveObjectGroupRequest_builder{
+			GroupId:  new(*groupID),
+			Name:     new(*name),
+			ColorHex: new(*color),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("saved object group id=%d\n", res.GetGroupId())
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteObjectGroupCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <group-id>",
+		Short: "Delete an object group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid group ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteObjectGroup(cmd.Context(), maponv1.DeleteObjectGroupRequest_builder{
+				GroupId: new(groupID),
+			}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted object group id=%d\n", groupID)
+			return nil
+		},
+	}
+}
+
+func newObjectCustomFieldsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "custom-fields",
+		Short: "Manage object custom fields",
+	}
+	cmd.AddCommand(newListObjectCustomFieldsCommand(cfg))
+	cmd.AddCommand(newSetObjectCustomFieldsCommand(cfg))
+	return cmd
+}
+
+func newListObjectCustomFieldsCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <object-id>",
+		Short: "List custom fields and values of an object",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			objectID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid object ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			response, err := client.GetObjectCustomFields(cmd.Context(), maponv1.GetObjectCustomFieldsRequest_builder{
+				ObjectId: new(objectID),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			for _, field := range response.GetFields() {
+				fmt.Println(protojson.Format(field))
+			}
+			return nil
+		},
+	}
+}
+
+func newSetObjectCustomFieldsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <object-id>",
+		Short: "Set custom field values of an object",
+		Args:  cobra.ExactArgs(1),
+	}
+	values := cmd.Flags().StringToString("value", nil, "Custom field value as <field-id>=<value>")
+	_ = cmd.MarkFlagRequired("value")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		objectID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid object ID %s: %w", args[0], err)
+		}
+		fieldValues, err := parseCustomFieldValues(*values)
+		if err != nil {
+			return err
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.SaveObjectCustomFieldsValues(cmd.Context(), maponv1.SaveObjectCustomFieldsValuesRequest_builder{
+			ObjectId: new(objectID),
+			Values:   fieldValues,
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("updated custom fields of object id=%d\n", objectID)
+		return nil
+	}
+	return cmd
+}
+
+// --- Custom Layers ---
+
+func newLayersCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "layers",
+		Short:   "Manage custom map layers",
+		GroupID: "layers",
+	}
+	cmd.AddCommand(newListLayersCommand(cfg))
+	cmd.AddCommand(newSaveLayerCommand(cfg))
+	cmd.AddCommand(newDeleteLayerCommand(cfg))
+	cmd.AddCommand(newLayerGeometriesCommand(cfg))
+	cmd.AddCommand(newSyncLayersCommand(cfg))
+	return cmd
+}
+
+func newListLayersCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List custom layers",
+	}
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		layers, err := listAllLayers(cmd, client)
+		if err != nil {
+			return err
+		}
+		for _, layer := range layers {
+			fmt.Println(protojson.Format(layer))
+		}
+		return nil
+	}
+	return cmd
+}
+
+// listAllLayers returns all custom layers, following pagination.
+func listAllLayers(cmd *cobra.Command, client *mapon.Client) ([]*maponv1.CustomLayer, error) {
+	const pageSize = 250
+	var layers []*maponv1.CustomLayer
+	for {
+		response, err := client.ListCustomLayers(cmd.Context(), maponv1.ListCustomLayersRequest_builder{
+			Limit:  new(int32(pageSize)),
+			Offset: new(int32(len(layers))),
+		}.Build())
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, response.GetLayers()...)
+		if len(response.GetLayers()) < pageSize {
+			return layers, nil
+		}
+	}
+}
+
+func newSaveLayerCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save",
+		Short: "Create or update a custom layer",
+	}
+	layerID := cmd.Flags().Int64("id", 0, "Layer ID to update (creates a new layer if unset)")
+	name := cmd.Flags().String("name", "", "Layer name")
+	color := cmd.Flags().String("color", "", "Layer color as hex (e.g. #FF0000)")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("color")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		res, err := client.SaveCustomLayer(cmd.Context(), maponv1.SaveCustomLayerRequest_builder{
+			LayerId:  new(*layerID),
+			Name:     new(*name),
+			ColorHex: new(*color),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("saved layer id=%d\n", res.GetLayerId())
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteLayerCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <layer-id>",
+		Short: "Delete a custom layer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			layerID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid layer ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteCustomLayer(cmd.Context(), maponv1.DeleteCustomLayerRequest_builder{
+				LayerId: new(layerID),
+			}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted layer id=%d\n", layerID)
+			return nil
+		},
+	}
+}
+
+func newLayerGeometriesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "geometries",
+		Short: "Manage custom layer geometries",
+	}
+	cmd.AddCommand(newListLayerGeometriesCommand(cfg))
+	cmd.AddCommand(newDeleteLayerGeometryCommand(cfg))
+	return cmd
+}
+
+func newListLayerGeometriesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list <layer-id>",
+		Short: "List the geometries of a custom layer",
+		Args:  cobra.ExactArgs(1),
+	}
+	limit := cmd.Flags().Int32("limit", 100, "Maximum number of geometries (1-250)")
+	offset := cmd.Flags().Int32("offset", 0, "Number of geometries to skip")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		layerID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid layer ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ListCustomLayerGeometries(cmd.Context(), maponv1.ListCustomLayerGeometriesRequest_builder{
+			LayerId: new(layerID),
+			Limit:   new(*limit),
+			Offset:  new(*offset),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Println(protojson.Format(response))
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteLayerGeometryCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <geometry-id>",
+		Short: "Delete a custom layer geometry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			geometryID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid geometry ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteCustomLayerGeometry(cmd.Context(), maponv1.DeleteCustomLayerGeometryRequest_builder{
+				GeometryId: new(geometryID),
+			}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted geometry id=%d\n", geometryID)
+			return nil
+		},
+	}
+}
+
+func newSyncLayersCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync <dir>",
+		Short: "Synchronize custom layers with local GeoJSON files",
+		Long: `Synchronize custom layers with the GeoJSON files in a directory.
+
+Each .geojson file is a layer named after the file without its extension, and is created
+with --color when it does not exist. Each feature is a point geometry named after the
+feature property given by --name-property; custom layers do not accept other geometry types.
+All files are read and validated before any layer is changed. Geometries are added, updated
+and deleted so that the layer matches the file. Layers without a file are left untouched.
+
+A file without features deletes every geometry of its layer, and is refused unless
+--allow-empty is given. Use --dry-run to print the changes without making them.`,
+		Args: cobra.ExactArgs(1),
+	}
+	nameProperty := cmd.Flags().String("name-property", "name", "Feature property used as geometry name")
+	color := cmd.Flags().String("color", "#FF0000", "Color of created layers")
+	allowEmpty := cmd.Flags().Bool("allow-empty", false, "Allow files without features, which delete every geometry of their layer")
+	dryRun := cmd.Flags().Bool("dry-run", false, "Print the changes without making them")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		files, err := filepath.Glob(filepath.Join(args[0], "*.geojson"))
+		if err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return fmt.Errorf("no .geojson files in %s", args[0])
+		}
+		desired := make(map[string][]*maponv1.CustomLayerGeometry, len(files))
+		for _, file := range files {
+			geometries, err := readLayerGeometries(file, *nameProperty)
+			if err != nil {
+				return fmt.Errorf("%s: %w", file, err)
+			}
+			if len(geometries) == 0 && !*allowEmpty {
+				return fmt.Errorf("%s: no features, use --allow-empty to delete every geometry of the layer", file)
+			}
+			desired[strings.TrimSuffix(filepath.Base(file), ".geojson")] = geometries
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		layers, err := listAllLayers(cmd, client)
+		if err != nil {
+			return err
+		}
+		layerIDs := make(map[string]int64, len(layers))
+		for _, layer := range layers {
+			_, wanted := desired[layer.GetName()]
+			if id, ok := layerIDs[layer.GetName()]; ok && wanted {
+				return fmt.Errorf("layers id=%d and id=%d are both named %q", id, layer.GetLayerId(), layer.GetName())
+			}
+			layerIDs[layer.GetName()] = layer.GetLayerId()
+		}
+		names := slices.Sorted(maps.Keys(desired))
+		// Plan every layer first, so that invalid geometries are reported before any layer changes.
+		for _, name := range names {
+			added, updated, deleted, err := client.PlanCustomLayerGeometrySync(cmd.Context(), layerIDs[name], desired[name])
+			if err != nil {
+				return fmt.Errorf("layer %q: %w", name, err)
+			}
+			if *dryRun {
+				if _, ok := layerIDs[name]; !ok {
+					fmt.Printf("would create layer name=%q\n", name)
+				}
+				fmt.Printf("would sync layer name=%q add=%v update=%v delete=%v\n", name, added, updated, deleted)
+			}
+		}
+		if *dryRun {
+			return nil
+		}
+		for _, name := range names {
+			layerID, ok := layerIDs[name]
+			if !ok {
+				res, err := client.SaveCustomLayer(cmd.Context(), maponv1.SaveCustomLayerRequest_builder{
+					Name:     new(name),
+					ColorHex: new(*color),
+				}.Build())
+				if err != nil {
+					return fmt.Errorf("layer %q: %w", name, err)
+				}
+				layerID = res.GetLayerId()
+				fmt.Printf("created layer id=%d name=%q\n", layerID, name)
+			}
+			added, updated, deleted, err := client.SyncCustomLayerGeometries(cmd.Context(), layerID, desired[name])
+			if err != nil {
+				return err
+			}
+			fmt.Printf("synced layer id=%d name=%q added=%d updated=%d deleted=%d\n",
+				layerID, name, len(added), len(updated), len(deleted))
+		}
+		return nil
+	}
+	return cmd
+}
+
+// readLayerGeometries returns the named custom layer geometries of the features in a GeoJSON file.
+func readLayerGeometries(file, nameProperty string) ([]*maponv1.CustomLayerGeometry, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	features, err := parseGeoJSONFeatures(data)
+	if err != nil {
+		return nil, err
+	}
+	geometries := make([]*maponv1.CustomLayerGeometry, 0, len(features))
+	for i, feature := range features {
+		name, ok := feature.Properties[nameProperty].(string)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("feature %d: missing %q property", i, nameProperty)
+		}
+		g, err := customLayerPointFromGeoJSON(name, feature.Geometry)
+		if err != nil {
+			return nil, fmt.Errorf("feature %d (%s): %w", i, name, err)
+		}
+		geometries = append(geometries, g)
+	}
+	return geometries, nil
+}
+
+// customLayerPointFromGeoJSON returns a named custom layer point for a GeoJSON Point geometry.
+// Custom layers only accept points.
+func customLayerPointFromGeoJSON(name string, geometry json.RawMessage) (*maponv1.CustomLayerGeometry, error) {
+	var g struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	}
+	if err := json.Unmarshal(geometry, &g); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON geometry: %w", err)
+	}
+	if g.Type != "Point" {
+		return nil, fmt.Errorf("unsupported GeoJSON geometry type %q, custom layers only accept points", g.Type)
+	}
+	var position []float64
+	if err := json.Unmarshal(g.Coordinates, &position); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON Point coordinates: %w", err)
+	}
+	if len(position) < 2 {
+		return nil, fmt.Errorf("invalid GeoJSON Point coordinates: expected position with at least 2 values, got %d", len(position))
+	}
+	if lon, lat := position[0], position[1]; lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return nil, fmt.Errorf("invalid GeoJSON Point coordinates: (%v %v) is out of WGS84 bounds", lon, lat)
+	}
+	return maponv1.CustomLayerGeometry_builder{
+		Name: new(name),
+		Type: new(maponv1.CustomLayerGeometry_TYPE_POINT),
+		Points: []*maponv1.Location{
+			maponv1.Location_builder{Latitude: new(position[1]), Longitude: new(position[0])}.Build(),
+		},
+	}.Build(), nil
+}
+
+// --- BLE Tags ---
+
+func newBleTagsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ble-tags",
+		Short:   "Print the last known location of every BLE tag",
+		GroupID: "ble-tags",
+		Args:    cobra.NoArgs,
+	}
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		w := csv.NewWriter(cmd.OutOrStdout())
+		_ = w.Write([]string{"tag_id", "beacon_id", "serial_number", "name", "latitude", "longitude", "address", "location_changed_at", "last_scan_at"})
+		for page := int32(1); ; page++ {
+			response, err := client.ListBleTags(cmd.Context(), maponv1.ListBleTagsRequest_builder{
+				Page:    new(page),
+				PerPage: new(int32(150)),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			for _, tag := range response.GetTags() {
+				location := tag.GetLocation()
+				_ = w.Write([]string{
+					strconv.FormatInt(tag.GetTagId(), 10),
+					tag.GetBeaconId(),
+					tag.GetSerialNumber(),
+					tag.GetName(),
+					formatOptionalFloat(tag.HasLocation(), location.GetLatitude()),
+					formatOptionalFloat(tag.HasLocation(), location.GetLongitude()),
+					location.GetAddress(),
+					formatOptionalTime(tag.GetLocationChangedAt()),
+					formatOptionalTime(tag.GetLastScanAt()),
+				})
+			}
+			if page >= response.GetPagination().GetTotalPages() {
+				break
+			}
+		}
+		w.Flush()
+		return w.Error()
+	}
+	cmd.AddCommand(newListBleTagScansCommand(cfg))
+	cmd.AddCommand(newListBleTagLocationsCommand(cfg))
+	cmd.AddCommand(newLocateBleTagCommand(cfg))
+	return cmd
+}
+
+func newListBleTagScansCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scans <tag-id>",
+		Short: "List the scans of a BLE tag",
+		Args:  cobra.ExactArgs(1),
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		tagID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tag ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		for page := int32(1); ; page++ {
+			response, err := client.ListBleTagScans(cmd.Context(), maponv1.ListBleTagScansRequest_builder{
+				TagId:    new(tagID),
+				FromTime: timestamppb.New(*from),
+				ToTime:   timestamppb.New(*to),
+				Page:     new(page),
+				PerPage:  new(int32(150)),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			for _, scan := range response.GetScans() {
+				fmt.Println(protojson.Format(scan))
+			}
+			if page >= response.GetPagination().GetTotalPages() {
+				return nil
+			}
+		}
+	}
+	return cmd
+}
+
+func newListBleTagLocationsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locations <tag-id>",
+		Short: "List the location history of a BLE tag",
+		Args:  cobra.ExactArgs(1),
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24*7), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		tagID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tag ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		for page := int32(1); ; page++ {
+			response, err := client.ListBleTagLocations(cmd.Context(), maponv1.ListBleTagLocationsRequest_builder{
+				TagId:    new(tagID),
+				FromTime: timestamppb.New(*from),
+				ToTime:   timestamppb.New(*to),
+				Page:     new(page),
+				PerPage:  new(int32(150)),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			for _, location := range response.GetLocations() {
+				fmt.Println(protojson.Format(location))
+			}
+			if page >= response.GetPagination().GetTotalPages() {
+				return nil
+			}
+		}
+	}
+	return cmd
+}
+
+func newLocateBleTagCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locate <tag-id>",
+		Short: "Print the location of a BLE tag at a point in time",
+		Args:  cobra.ExactArgs(1),
+	}
+	at := cmd.Flags().Time("at", time.Now(), []string{time.DateOnly, time.RFC3339}, "Point in time")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		tagID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tag ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		location, err := client.LocateBleTag(cmd.Context(), tagID, *at)
+		if err != nil {
+			return err
+		}
+		fmt.Println(protojson.Format(location))
+		return nil
+	}
+	return cmd
+}
+
+// formatOptionalTime formats a timestamp for CSV output, or returns an empty string if it is not set.
+func formatOptionalTime(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().UTC().Format(time.RFC3339)
+}
+
+// --- Tracking Links ---
+
+func newTrackingLinksCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tracking-links",
+		Short:   "Manage public tracking links",
+		GroupID: "tracking",
+	}
+	cmd.AddCommand(newListTrackingLinksCommand(cfg))
+	cmd.AddCommand(newCreateTrackingLinkCommand(cfg))
+	cmd.AddCommand(newEditTrackingLinkCommand(cfg))
+	cmd.AddCommand(newDeleteTrackingLinkCommand(cfg))
+	return cmd
+}
+
+func newListTrackingLinksCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List tracking links created in a period",
+	}
+	from := cmd.Flags().Time("from", time.Now().AddDate(0, -1, 0), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ListTrackingLinks(cmd.Context(), maponv1.ListTrackingLinksRequest_builder{
+			FromTime: timestamppb.New(*from),
+			ToTime:   timestamppb.New(*to),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		for _, link := range response.GetLinks() {
+			fmt.Println(protojson.Format(link))
+		}
+		return nil
+	}
+	return cmd
+}
+
+// trackingLinkFlags are the settings shared by the create and edit tracking link commands.
+type trackingLinkFlags struct {
+	unitIDs  *[]int64
+	from     *time.Time
+	until    *time.Time
+	routes   *bool
+	comment  *string
+	language *string
+}
+
+func addTrackingLinkFlags(cmd *cobra.Command) *trackingLinkFlags {
+	f := &trackingLinkFlags{
+		unitIDs:  cmd.Flags().Int64Slice("unit-id", nil, "Units to share"),
+		from:     cmd.Flags().Time("from", time.Now(), []string{time.DateOnly, time.RFC3339}, "Time the link becomes available"),
+		until:    cmd.Flags().Time("until", time.Time{}, []string{time.DateOnly, time.RFC3339}, "Time the link expires"),
+		routes:   cmd.Flags().Bool("routes", false, "Show the routes of the units"),
+		comment:  cmd.Flags().String("comment", "", "Comment shown on the tracking page"),
+		language: cmd.Flags().String("language", "", "Language of the tracking page (e.g. en)"),
+	}
+	_ = cmd.MarkFlagRequired("unit-id")
+	_ = cmd.MarkFlagRequired("until")
+	return f
+}
+
+func (f *trackingLinkFlags) link() *maponv1.TrackingLink {
+	return maponv1.TrackingLink_builder{
+		UnitIds:        *f.unitIDs,
+		AvailableFrom:  timestamppb.New(*f.from),
+		ExpireTime:     timestamppb.New(*f.until),
+		ShowRoutes:     new(*f.routes),
+		Comment:        new(*f.comment),
+		CommentVisible: new(*f.comment != ""),
+		Language:       new(*f.language),
+	}.Build()
+}
+
+func newCreateTrackingLinkCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a public tracking link for units",
+	}
+	flags := addTrackingLinkFlags(cmd)
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.CreateTrackingLink(cmd.Context(), maponv1.CreateTrackingLinkRequest_builder{
+			Link: flags.link(),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		link := response.GetLink()
+		fmt.Printf("created tracking link hash=%s expires=%s\n", link.GetHash(), link.GetExpireTime().AsTime().Local().Format(time.DateTime))
+		return nil
+	}
+	return cmd
+}
+
+func newEditTrackingLinkCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit <tracking-link-id>",
+		Short: "Replace the settings of a tracking link",
+		Args:  cobra.ExactArgs(1),
+	}
+	flags := addTrackingLinkFlags(cmd)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tracking link ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		link := flags.link()
+		link.SetTrackingLinkId(id)
+		if _, err := client.EditTrackingLink(cmd.Context(), maponv1.EditTrackingLinkRequest_builder{
+			Link: link,
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("updated tracking link id=%d\n", id)
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteTrackingLinkCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <tracking-link-id>",
+		Short: "Delete a tracking link",
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tracking link ID %s: %w", args[0], err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.DeleteTrackingLink(cmd.Context(), maponv1.DeleteTrackingLinkRequest_builder{
+			TrackingLinkId: new(id),
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("deleted tracking link id=%d\n", id)
+		return nil
+	}
+	return cmd
+}
+
+// --- Messaging ---
+
+func newMessagesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "messages",
+		Short:   "Send and receive messages",
+		GroupID: "messaging",
+	}
+	cmd.AddCommand(newListMessagingChannelsCommand(cfg))
+	cmd.AddCommand(newListMessagingConversationsCommand(cfg))
+	cmd.AddCommand(newListMessagesCommand(cfg))
+	cmd.AddCommand(newTailMessagesCommand(cfg))
+	cmd.AddCommand(newSendMessageCommand(cfg))
+	return cmd
+}
+
+func newListMessagingChannelsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channels",
+		Short: "List the messaging channels of a user",
+	}
+	userID := cmd.Flags().Int64("user-id", 0, "User ID")
+	_ = cmd.MarkFlagRequired("user-id")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ListMessagingChannels(cmd.Context(), maponv1.ListMessagingChannelsRequest_builder{
+			UserId: new(*userID),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		for _, channel := range response.GetChannels() {
+			fmt.Println(protojson.Format(channel))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newListMessagingConversationsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversations",
+		Short: "List the messaging conversations of a user",
+	}
+	userID := cmd.Flags().Int64("user-id", 0, "User ID")
+	medium := cmd.Flags().String("medium", "general", "Conversation medium (general, sms, garmin)")
+	channelID := cmd.Flags().Int64("channel-id", 0, "Filter by channel ID")
+	_ = cmd.MarkFlagRequired("user-id")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		m, err := parseMessagingMedium(*medium)
+		if err != nil {
+			return err
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ListMessagingConversations(cmd.Context(), maponv1.ListMessagingConversationsRequest_builder{
+			UserId:           new(*userID),
+			Medium:           new(m),
+			BelongsToChannel: new(*channelID != 0),
+			ChannelId:        new(*channelID),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		for _, conversation := range response.GetConversations() {
+			fmt.Println(protojson.Format(conversation))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newListMessagesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the latest messages of a conversation",
+	}
+	userID := cmd.Flags().Int64("user-id", 0, "User ID")
+	conversationID := cmd.Flags().Int64("conversation", 0, "Conversation ID")
+	before := cmd.Flags().Int64("before", 0, "Only list messages older than this message ID")
+	limit := cmd.Flags().Int32("limit", 20, "Maximum number of messages (1-100)")
+	_ = cmd.MarkFlagRequired("user-id")
+	_ = cmd.MarkFlagRequired("conversation")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ListMessages(cmd.Context(), maponv1.ListMessagesRequest_builder{
+			UserId:          new(*userID),
+			ConversationId:  new(*conversationID),
+			BeforeMessageId: new(*before),
+			Limit:           new(*limit),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		for _, message := range response.GetMessages() {
+			fmt.Println(protojson.Format(message))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newTailMessagesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tail",
+		Short: "Print the messages of a conversation and poll for new ones",
+	}
+	userID := cmd.Flags().Int64("user-id", 0, "User ID")
+	conversationID := cmd.Flags().Int64("conversation", 0, "Conversation ID")
+	interval := cmd.Flags().Duration("interval", 10*time.Second, "Polling interval")
+	_ = cmd.MarkFlagRequired("user-id")
+	_ = cmd.MarkFlagRequired("conversation")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		if *interval <= 0 {
+			return fmt.Errorf("invalid interval %s, must be positive", *interval)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		var lastID int64
+		for {
+			response, err := client.ListMessages(cmd.Context(), maponv1.ListMessagesRequest_builder{
+				UserId:         new(*userID),
+				ConversationId: new(*conversationID),
+				AfterMessageId: new(lastID),
+				Limit:          new(int32(100)),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			messages := response.GetMessages()
+			slices.SortFunc(messages, func(a, b *maponv1.Message) int {
+				return cmp.Compare(a.GetMessageId(), b.GetMessageId())
+			})
+			for _, message := range messages {
+				fmt.Println(formatMessageLine(message))
+				lastID = max(lastID, message.GetMessageId())
+			}
+			if len(messages) == 100 {
+				continue
+			}
+			select {
+			case <-cmd.Context().Done():
+				return nil
+			case <-time.After(*interval):
+			}
+		}
+	}
+	return cmd
+}
+
+func newSendMessageCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send",
+		Short: "Send a message to a conversation or a unit",
+		Long: "Send a message to an existing conversation, or to the in-cab terminal of a unit.\n" +
+			"When sending to a unit, the existing conversation with the unit is used or a new one is created.",
+	}
+	userID := cmd.Flags().Int64("user-id", 0, "Sender user ID")
+	conversationID := cmd.Flags().Int64("conversation", 0, "Conversation ID")
+	unitID := cmd.Flags().Int64("unit-id", 0, "Unit ID, instead of a conversation")
+	text := cmd.Flags().String("text", "", "Message text")
+	attachments := cmd.Flags().StringSlice("attach", nil, "Files to attach")
+	_ = cmd.MarkFlagRequired("user-id")
+	cmd.MarkFlagsOneRequired("conversation", "unit-id")
+	cmd.MarkFlagsMutuallyExclusive("conversation", "unit-id")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		uploads := make([]*maponv1.MessageAttachmentUpload, 0, len(*attachments))
+		for _, file := range *attachments {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			uploads = append(uploads, maponv1.MessageAttachmentUpload_builder{
+				Name:    new(filepath.Base(file)),
+				Content: data,
+			}.Build())
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if *unitID != 0 {
+			response, err := client.CreateMessagingConversation(cmd.Context(), maponv1.CreateMessagingConversationRequest_builder{
+				UserId: new(*userID),
+				Receivers: []*maponv1.CreateMessagingConversationRequest_Receiver{
+					maponv1.CreateMessagingConversationRequest_Receiver_builder{UnitId: new(*unitID)}.Build(),
+				},
+				Text:        new(*text),
+				Attachments: uploads,
+			}.Build())
+			if err != nil {
+				return err
+			}
+			for _, conversation := range response.GetConversations() {
+				fmt.Printf("sent message to conversation id=%d\n", conversation.GetConversationId())
+			}
+			return nil
+		}
+		response, err := client.SendMessage(cmd.Context(), maponv1.SendMessageRequest_builder{
+			UserId:         new(*userID),
+			ConversationId: new(*conversationID),
+			Text:           new(*text),
+			Attachments:    uploads,
+		}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("sent message id=%d\n", response.GetMessage().GetMessageId())
+		return nil
+	}
+	return cmd
+}
+
+// formatMessageLine formats a message as a single line for the terminal.
+func formatMessageLine(m *maponv1.Message) string {
+	arrow := "->"
+	if m.GetDirection() == maponv1.Message_DIRECTION_INBOUND {
+		arrow = "<-"
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s %s: %s", m.GetCreatedAt().AsTime().Local().Format(time.DateTime), arrow, m.GetSender().GetTitle(), m.GetText())
+	if m.HasLocation() {
+		fmt.Fprintf(&sb, " [location %f,%f %s]", m.GetLocation().GetLatitude(), m.GetLocation().GetLongitude(), m.GetLocation().GetAddress())
+	}
+	for _, attachment := range m.GetAttachments() {
+		fmt.Fprintf(&sb, " [attachment %s %s]", attachment.GetName(), attachment.GetUrl())
+	}
+	return sb.String()
+}
+
+func parseMessagingMedium(s string) (maponv1.MessagingMedium, error) {
+	switch s {
+	case "general":
+		return maponv1.MessagingMedium_MESSAGING_MEDIUM_GENERAL, nil
+	case "sms":
+		return maponv1.MessagingMedium_MESSAGING_MEDIUM_SMS, nil
+	case "garmin":
+		return maponv1.MessagingMedium_MESSAGING_MEDIUM_GARMIN, nil
+	default:
+		return 0, fmt.Errorf("invalid medium %q (want general, sms or garmin)", s)
+	}
+}
+
+// --- Alerts ---
+
+func newListAlertsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alerts",
+		Short:   "List alerts",
+		GroupID: "alerts",
+	}
+	from := cmd.Flags().Time("from", time.Now().Add(-time.Hour*24), []string{time.DateOnly, time.RFC3339}, "From time")
+	to := cmd.Flags().Time("to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time")
+	ids := cmd.Flags().StringSlice("unit-id", nil, "Filter by unit ID")
+	driver := cmd.Flags().Int64("driver-id", 0, "Filter by driver ID")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		var unitIDs []int64
+		for _, idStr := range *ids {
+			id, err := strconv.ParseInt(idStr, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid unit ID %s: %w", idStr, err)
+			}
+			unitIDs = append(unitIDs, id)
+		}
+		req := &maponv1.ListAlertsRequest{}
+		req.SetFromTime(timestamppb.New(*from))
+		req.SetToTime(timestamppb.New(*to))
+		req.SetUnitIds(unitIDs)
+		req.SetDriver(*driver)
+		response, err := client.ListAlerts(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, alert := range response.GetAlerts() {
+			fmt.Println(protojson.Format(alert))
+		}
+		return nil
+	}
+	cmd.AddCommand(newAlertSetupsCommand(cfg))
+	return cmd
+}
+
+func newAlertSetupsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "setups",
+		Short: "Manage alert setups",
+	}
+	cmd.AddCommand(newListAlertSetupsCommand(cfg))
+	cmd.AddCommand(newGetAlertSetupTypesCommand(cfg))
+	cmd.AddCommand(newGetAlertSetupFieldsCommand(cfg))
+	cmd.AddCommand(newStoreAlertSetupCommand(cfg))
+	cmd.AddCommand(newDeleteAlertSetupCommand(cfg))
+	return cmd
+}
+
+func newListAlertSetupsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List alert setups",
+	}
+	alertType := cmd.Flags().String("alert-type", "", "Filter by alert type")
+	setupID := cmd.Flags().String("setup-id", "", "Filter by setup ID")
+	unitIDs := cmd.Flags().Int64Slice("unit-id", nil, "Filter by unit ID")
+	showInactive := cmd.Flags().Bool("show-inactive", false, "Include expired and not yet active setups")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.ListAlertSetupsRequest{}
+		req.SetAlertType(*alertType)
+		req.SetSetupId(*setupID)
+		req.SetUnitIds(*unitIDs)
+		req.SetShowInactive(*showInactive)
+		res, err := client.ListAlertSetups(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, setup := range res.GetSetups() {
+			fmt.Println(protojson.Format(setup))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newGetAlertSetupTypesCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "types",
+		Short: "List alert types that can be managed via the API",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			res, err := client.GetAlertSetupTypes(cmd.Context(), &maponv1.GetAlertSetupTypesRequest{})
+			if err != nil {
+				return err
+			}
+			for _, g := range res.GetGroups() {
+				fmt.Println(protojson.Format(g))
+			}
+			return nil
+		},
+	}
+}
+
+func newGetAlertSetupFieldsCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fields <alert-type>",
+		Short: "List fields supported by an alert type",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			req := &maponv1.GetAlertSetupFieldsRequest{}
+			req.SetAlertType(args[0])
+			res, err := client.GetAlertSetupFields(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			for _, f := range res.GetFields() {
+				fmt.Println(protojson.Format(f))
+			}
+			return nil
+		},
+	}
+}
+
+func newStoreAlertSetupCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Create or update an alert setup",
+	}
+	setupID := cmd.Flags().String("setup-id", "", "Existing setup ID to update (omit to create new)")
+	alertType := cmd.Flags().String("alert-type", "", "Alert type of a new setup")
+	fieldsJSON := cmd.Flags().String("fields", "", "Setup field values as a JSON object")
+	fieldsFile := cmd.Flags().String("fields-file", "", "Path to a JSON file with setup field values")
+	cmd.MarkFlagsOneRequired("fields", "fields-file")
+	cmd.MarkFlagsMutuallyExclusive("fields", "fields-file")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		data := []byte(*fieldsJSON)
+		if *fieldsFile != "" {
+			var err error
+			if data, err = os.ReadFile(*fieldsFile); err != nil {
+				return fmt.Errorf("read fields file: %w", err)
+			}
+		}
+		var fields structpb.Struct
+		if err := protojson.Unmarshal(data, &fields); err != nil {
+			return fmt.Errorf("invalid fields: %w", err)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.StoreAlertSetupRequest{}
+		req.SetSetupId(*setupID)
+		req.SetAlertType(*alertType)
+		req.SetFields(&fields)
+		res, err := client.StoreAlertSetup(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("stored alert setup id=%s\n", res.GetSetupId())
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteAlertSetupCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <setup-id>",
+		Short: "Delete an alert setup",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			req := &maponv1.DeleteAlertSetupRequest{}
+			req.SetSetupId(args[0])
+			if _, err := client.DeleteAlertSetup(cmd.Context(), req); err != nil {
+				return err
+			}
+			fmt.Printf("deleted alert setup id=%s\n", args[0])
+			return nil
+		},
+	}
+}
+
+// --- Company ---
+
+func newCompanyCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "company",
+		Short:   "Show the company data",
+		GroupID: "company",
+		Args:    cobra.NoArgs,
+	}
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.GetCompany(cmd.Context(), &maponv1.GetCompanyRequest{})
+		if err != nil {
+			return err
+		}
+		for _, company := range response.GetCompanies() {
+			fmt.Println(protojson.Format(company))
+		}
+		return nil
+	}
+	cmd.AddCommand(newCompanyClientsCommand(cfg))
+	return cmd
+}
+
+func newCompanyClientsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clients",
+		Short: "Manage company clients",
+	}
+	cmd.AddCommand(newListCompanyClientsCommand(cfg))
+	cmd.AddCommand(newCreateCompanyClientCommand(cfg))
+	return cmd
+}
+
+func newListCompanyClientsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List company clients",
+	}
+	name := cmd.Flags().String("name", "", "Filter by exact client name")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		for page := int32(1); ; page++ {
+			response, err := client.ListCompanyClients(cmd.Context(), maponv1.ListCompanyClientsRequest_builder{
+				Page:    new(page),
+				PerPage: new(int32(200)),
+				Name:    new(*name),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			for _, c := range response.GetClients() {
+				fmt.Println(protojson.Format(c))
+			}
+			if page >= response.GetPagination().GetTotalPages() {
+				return nil
+			}
+		}
+	}
+	return cmd
+}
+
+func newCreateCompanyClientCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a company client",
+	}
+	name := cmd.Flags().String("name", "", "Client name")
+	address := cmd.Flags().String("address", "", "Client address")
+	email := cmd.Flags().String("email", "", "Contact email address")
+	phone := cmd.Flags().String("phone", "", "Contact phone number")
+	comments := cmd.Flags().String("comments", "", "Comments")
+	workingDays := cmd.Flags().StringSlice("working-days", nil, "Working days (e.g. monday,tuesday), defaults to Monday to Friday")
+	workingTimes := cmd.Flags().String("working-times", "", "Daily working time as HH:MM-HH:MM, defaults to all day")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("address")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		req := maponv1.CreateCompanyClientRequest_builder{
+			Name:     new(*name),
+			Address:  new(*address),
+			Email:    new(*email),
+			Phone:    new(*phone),
+			Comments: new(*comments),
+		}.Build()
+		if cmd.Flags().Changed("working-days") {
+			days := &maponv1.CompanyClient_WorkingDays{}
+			for _, day := range *workingDays {
+				switch strings.ToLower(day) {
+				case "monday":
+					days.SetMonday(true)
+				case "tuesday":
+					days.SetTuesday(true)
+				case "wednesday":
+					days.SetWednesday(true)
+				case "thursday":
+					days.SetThursday(true)
+				case "friday":
+					days.SetFriday(true)
+				case "saturday":
+					days.SetSaturday(true)
+				case "sunday":
+					days.SetSunday(true)
+				default:
+					return fmt.Errorf("invalid working day %q", day)
+				}
+			}
+			req.SetWorkingDays(days)
+		}
+		if *workingTimes != "" {
+			from, to, ok := strings.Cut(*workingTimes, "-")
+			if !ok {
+				return fmt.Errorf("invalid working times %q, expected HH:MM-HH:MM", *workingTimes)
+			}
+			req.SetWorkingTimeFrom(from)
+			req.SetWorkingTimeTo(to)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.CreateCompanyClient(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("created company client id=%d\n", response.GetClientId())
+		return nil
+	}
+	return cmd
+}
+
+// --- Users ---
+
+func newUsersCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "users",
+		Short:   "Manage users",
+		GroupID: "users",
+	}
+	cmd.AddCommand(newListUsersCommand(cfg))
+	cmd.AddCommand(newCreateUserCommand(cfg))
+	cmd.AddCommand(newUpdateUserCommand(cfg))
+	cmd.AddCommand(newDeleteUserCommand(cfg))
+	cmd.AddCommand(newChangeUserPasswordCommand(cfg))
+	cmd.AddCommand(newLinkUserDriverCommand(cfg))
+	cmd.AddCommand(newUnlinkUserDriverCommand(cfg))
+	return cmd
+}
+
+func newListUsersCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List users",
+	}
+	id := cmd.Flags().Int64("id", 0, "Filter by user ID")
+	userType := cmd.Flags().String("type", "", "Filter by user type (admin, user_all, user, driver)")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		req := &maponv1.ListUsersRequest{}
+		req.SetUserId(*id)
+		if *userType != "" {
+			t, err := parseUserType(*userType)
+			if err != nil {
+				return err
+			}
+			req.SetType(t)
+		}
+		response, err := client.ListUsers(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, user := range response.GetUsers() {
+			fmt.Println(protojson.Format(user))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newCreateUserCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a user",
+	}
+	name := cmd.Flags().String("name", "", "First name")
+	surname := cmd.Flags().String("surname", "", "Last name")
+	email := cmd.Flags().String("email", "", "Unique email address")
+	language := cmd.Flags().String("lang", "en", "2 letter language code")
+	phone := cmd.Flags().String("phone", "", "Phone number with country code")
+	userType := cmd.Flags().String("type", "", "User type (user_all, user)")
+	presetID := cmd.Flags().Int64("preset-id", 0, "Preset ID")
+	sendPassword := cmd.Flags().Bool("send-password", false, "Send the generated password to the user email")
+	forcePasswordChange := cmd.Flags().Bool("force-password-change", false, "Force a password change on the next login")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("surname")
+	_ = cmd.MarkFlagRequired("email")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		req := maponv1.CreateUserRequest_builder{
+			Name:                new(*name),
+			Surname:             new(*surname),
+			Email:               new(*email),
+			Language:            new(*language),
+			Phone:               new(*phone),
+			PresetId:            new(*presetID),
+			SendPasswordToEmail: new(*sendPassword),
+			ForcePasswordChange: new(*forcePasswordChange),
+		}.Build()
+		if *userType != "" {
+			t, err := parseUserType(*userType)
+			if err != nil {
+				return err
+			}
+			req.SetType(t)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.CreateUser(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		fmt.Println(protojson.Format(response))
+		return nil
+	}
+	return cmd
+}
+
+func newUpdateUserCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <user-id>",
+		Short: "Update a user",
+		Long:  "Update a user. Only the given flags are updated.",
+		Args:  cobra.ExactArgs(1),
+	}
+	name := cmd.Flags().String("name", "", "First name")
+	surname := cmd.Flags().String("surname", "", "Last name")
+	email := cmd.Flags().String("email", "", "Unique email address")
+	language := cmd.Flags().String("lang", "", "2 letter language code")
+	phone := cmd.Flags().String("phone", "", "Phone number with country code")
+	userType := cmd.Flags().String("type", "", "User type (user_all, user)")
+	presetID := cmd.Flags().Int64("preset-id", 0, "Preset ID")
+	blocked := cmd.Flags().Bool("blocked", false, "Block or unblock the user")
+	blockedReason := cmd.Flags().String("blocked-reason", "", "Reason for blocking the user")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		userID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid user ID %s: %w", args[0], err)
+		}
+		req := &maponv1.UpdateUserRequest{}
+		req.SetUserId(userID)
+		flags := cmd.Flags()
+		if flags.Changed("name") {
+			req.SetName(*name)
+		}
+		if flags.Changed("surname") {
+			req.SetSurname(*surname)
+		}
+		if flags.Changed("email") {
+			req.SetEmail(*email)
+		}
+		if flags.Changed("lang") {
+			req.SetLanguage(*language)
+		}
+		if flags.Changed("phone") {
+			req.SetPhone(*phone)
+		}
+		if flags.Changed("type") {
+			t, err := parseUserType(*userType)
+			if err != nil {
+				return err
+			}
+			req.SetType(t)
+		}
+		if flags.Changed("preset-id") {
+			req.SetPresetId(*presetID)
+		}
+		if flags.Changed("blocked") {
+			req.SetBlocked(*blocked)
+		}
+		if flags.Changed("blocked-reason") {
+			req.SetBlockedReason(*blockedReason)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.UpdateUser(cmd.Context(), req); err != nil {
+			return err
+		}
+		fmt.Printf("updated user id=%d\n", userID)
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteUserCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <user-id>",
+		Short: "Delete a user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid user ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteUser(cmd.Context(), maponv1.DeleteUserRequest_builder{
+				UserId: new(userID),
+			}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted user id=%d\n", userID)
+			return nil
+		},
+	}
+}
+
+func newChangeUserPasswordCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-password <user-id>",
+		Short: "Change the password of a user",
+		Args:  cobra.ExactArgs(1),
+	}
+	force := cmd.Flags().Bool("force", false, "Skip the password complexity rules")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		userID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid user ID %s: %w", args[0], err)
+		}
+		password, err := promptSecret(cmd, "New password: ")
+		if err != nil {
+			return err
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.ChangeUserPassword(cmd.Context(), maponv1.ChangeUserPasswordRequest_builder{
+			UserId:   new(userID),
+			Password: new(password),
+			Force:    new(*force),
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("changed password of user id=%d\n", userID)
+		return nil
+	}
+	return cmd
+}
+
+func newLinkUserDriverCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "link-driver <user-id> <driver-id>",
+		Short: "Assign a user to a driver",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid user ID %s: %w", args[0], err)
+			}
+			driverID, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid driver ID %s: %w", args[1], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.LinkUserToDriver(cmd.Context(), maponv1.LinkUserToDriverRequest_builder{
+				UserId:   new(userID),
+				DriverId: new(driverID),
+			}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("linked user id=%d to driver id=%d\n", userID, driverID)
+			return nil
+		},
+	}
+}
+
+func newUnlinkUserDriverCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unlink-driver <driver-id>",
+		Short: "Remove the user assigned to a driver",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			driverID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid driver ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.UnlinkUserFromDriver(cmd.Context(), maponv1.UnlinkUserFromDriverRequest_builder{
+				DriverId: new(driverID),
+			}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("unlinked user from driver id=%d\n", driverID)
+			return nil
+		},
+	}
+}
+
+func parseUserType(s string) (maponv1.User_Type, error) {
+	switch s {
+	case "admin":
+		return maponv1.User_TYPE_ADMIN, nil
+	case "user_all":
+		return maponv1.User_TYPE_USER_ALL, nil
+	case "user":
+		return maponv1.User_TYPE_USER, nil
+	case "driver":
+		return maponv1.User_TYPE_DRIVER, nil
+	default:
+		return maponv1.User_TYPE_UNSPECIFIED, fmt.Errorf("unsupported user type %q", s)
+	}
+}
+
+// --- Presets ---
+
+func newPresetsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "presets",
+		Short:   "Manage user permission presets",
+		GroupID: "users",
+	}
+	cmd.AddCommand(newListPresetsCommand(cfg))
+	cmd.AddCommand(newGetPresetCommand(cfg))
+	cmd.AddCommand(newCreatePresetCommand(cfg))
+	cmd.AddCommand(newEditPresetCommand(cfg))
+	cmd.AddCommand(newDeletePresetCommand(cfg))
+	cmd.AddCommand(newListPresetPermissionsCommand(cfg))
+	return cmd
+}
+
+func newListPresetsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List presets",
+	}
+	includeCustom := cmd.Flags().Bool("include-custom", false, "Include presets customized for a single user")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ListPresets(cmd.Context(), maponv1.ListPresetsRequest_builder{
+			IncludeCustom: new(*includeCustom),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		for _, preset := range response.GetPresets() {
+			fmt.Println(protojson.Format(preset))
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newGetPresetCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <preset-id>",
+		Short: "Get the permissions and accessible resources of a preset",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			presetID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid preset ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			response, err := client.GetPreset(cmd.Context(), maponv1.GetPresetRequest_builder{
+				PresetId: new(presetID),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			fmt.Println(protojson.Format(response.GetPreset()))
+			return nil
+		},
+	}
+}
+
+func newCreatePresetCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a preset",
+	}
+	title := cmd.Flags().String("title", "", "Preset title")
+	menuIDs := cmd.Flags().Int64Slice("menu-id", nil, "Accessible menu IDs")
+	unitIDs := cmd.Flags().Int64Slice("unit-id", nil, "Accessible unit IDs")
+	unitGroupIDs := cmd.Flags().Int64Slice("unit-group-id", nil, "Accessible unit group IDs")
+	allUnits := cmd.Flags().Bool("all-units", false, "Grant access to all units")
+	allDrivers := cmd.Flags().Bool("all-drivers", false, "Grant access to all drivers")
+	allObjects := cmd.Flags().Bool("all-objects", false, "Grant access to all objects")
+	allMenus := cmd.Flags().Bool("all-menus", false, "Grant access to all menus")
+	permissions := cmd.Flags().StringToString("permission", nil, "Permission value as <name>=<value>")
+	_ = cmd.MarkFlagRequired("title")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		presetPermissions := parsePresetPermissions(*permissions)
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.CreatePreset(cmd.Context(), maponv1.CreatePresetRequest_builder{
+			Preset: maponv1.Preset_builder{
+				Title:                 new(*title),
+				MenuIds:               *menuIDs,
+				UnitIds:               *unitIDs,
+				UnitGroupIds:          *unitGroupIDs,
+				HasAccessToAllUnits:   new(*allUnits),
+				HasAccessToAllDrivers: new(*allDrivers),
+				HasAccessToAllObjects: new(*allObjects),
+				HasAccessToAllMenus:   new(*allMenus),
+				Permissions:           presetPermissions,
+			}.Build(),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("created preset id=%d\n", response.GetPresetId())
+		return nil
+	}
+	return cmd
+}
+
+func newEditPresetCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit <preset-id>",
+		Short: "Edit a preset",
+		Long: `Edit a preset. Only the given flags are updated.
+
+The current preset is fetched first, so the accessible resources that are not given are kept.`,
+		Args: cobra.ExactArgs(1),
+	}
+	title := cmd.Flags().String("title", "", "Preset title")
+	menuIDs := cmd.Flags().Int64Slice("menu-id", nil, "Accessible menu IDs")
+	unitIDs := cmd.Flags().Int64Slice("unit-id", nil, "Accessible unit IDs")
+	unitGroupIDs := cmd.Flags().Int64Slice("unit-group-id", nil, "Accessible unit group IDs")
+	permissions := cmd.Flags().StringToString("permission", nil, "Permission value as <name>=<value>")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		presetID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid preset ID %s: %w", args[0], err)
+		}
+		changedPermissions := parsePresetPermissions(*permissions)
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		current, err := client.GetPreset(cmd.Context(), maponv1.GetPresetRequest_builder{
+			PresetId: new(presetID),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		preset := current.GetPreset()
+		flags := cmd.Flags()
+		if flags.Changed("title") {
+			preset.SetTitle(*title)
+		}
+		if flags.Changed("menu-id") {
+			preset.SetMenuIds(*menuIDs)
+		}
+		if flags.Changed("unit-id") {
+			preset.SetUnitIds(*unitIDs)
+		}
+		if flags.Changed("unit-group-id") {
+			preset.SetUnitGroupIds(*unitGroupIDs)
+		}
+		for _, changed := range changedPermissions {
+			i := slices.IndexFunc(preset.GetPermissions(), func(p *maponv1.PresetPermission) bool {
+				return p.GetPermission() == changed.GetPermission() &&
+					p.GetUnrecognizedPermission() == changed.GetUnrecognizedPermission()
+			})
+			if i >= 0 {
+				preset.GetPermissions()[i] = changed
+			} else {
+				preset.SetPermissions(append(preset.GetPermissions(), changed))
+			}
+		}
+		if _, err := client.EditPreset(cmd.Context(), maponv1.EditPresetRequest_builder{
+			Preset: preset,
+		}.Build()); err != nil {
+			return err
+		}
+		fmt.Printf("updated preset id=%d\n", presetID)
+		return nil
+	}
+	return cmd
+}
+
+func newDeletePresetCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <preset-id>",
+		Short: "Delete a preset",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			presetID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid preset ID %s: %w", args[0], err)
+			}
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeletePreset(cmd.Context(), maponv1.DeletePresetRequest_builder{
+				PresetId: new(presetID),
+			}.Build()); err != nil {
+				return err
+			}
+			fmt.Printf("deleted preset id=%d\n", presetID)
+			return nil
+		},
+	}
+}
+
+func newListPresetPermissionsCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "permissions",
+		Short: "List the permissions available for presets with their default values",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := newClient(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			response, err := client.GetAvailablePresetPermissions(cmd.Context(), &maponv1.GetAvailablePresetPermissionsRequest{})
+			if err != nil {
+				return err
+			}
+			for _, permission := range response.GetPermissions() {
+				fmt.Println(protojson.Format(permission))
+			}
+			return nil
+		},
+	}
+}
+
+// parsePresetPermissions parses permission values given as name=value pairs.
+// Values "true" and "false" are booleans, other values are strings.
+func parsePresetPermissions(values map[string]string) []*maponv1.PresetPermission {
+	result := make([]*maponv1.PresetPermission, 0, len(values))
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		p := &maponv1.PresetPermission{}
+		if permission, ok := mapon.ParsePermission(name); ok {
+			p.SetPermission(permission)
+		} else {
+			p.SetPermission(maponv1.Permission_PERMISSION_UNRECOGNIZED)
+			p.SetUnrecognizedPermission(name)
+		}
+		if b, err := strconv.ParseBool(values[name]); err == nil {
+			p.SetValue(structpb.NewBoolValue(b))
+		} else {
+			p.SetValue(structpb.NewStringValue(values[name]))
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// --- Helpers ---
+
+func parseUnitIDs(args []string) ([]int64, error) {
+	var unitIDs []int64
+	for _, idStr := range args {
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid unit ID %s: %w", idStr, err)
+		}
+		unitIDs = append(unitIDs, id)
+	}
+	return unitIDs, nil
+}
+
+func parseIDs(args []string, kind string) ([]int64, error) {
+	ids := make([]int64, 0, len(args))
+	for _, idStr := range args {
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s ID %s: %w", kind, idStr, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func parseCustomFieldValues(values map[string]string) (map[int64]string, error) {
+	fieldValues := make(map[int64]string, len(values))
+	for key, value := range values {
+		fieldID, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom field ID %s: %w", key, err)
+		}
+		fieldValues[fieldID] = value
+	}
+	return fieldValues, nil
+}
+
+// confirm asks the user a yes/no question and reports whether the answer was yes.
+func confirm(cmd *cobra.Command, question string) (bool, error) {
+	cmd.Printf("%s [y/N] ", question)
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
 	}
-	return unitIDs, nil
 }
 
 func promptSecret(cmd *cobra.Command, prompt string) (string, error) {
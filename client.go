@@ -40,12 +40,14 @@ type clientConfig struct {
 	retryCount   int
 	timeout      time.Duration
 	interceptors []func(http.RoundTripper) http.RoundTripper
+	pollInterval time.Duration
 }
 
 func newClientConfig() clientConfig {
 	return clientConfig{
-		retryCount: 3,
-		timeout:    30 * time.Second,
+		retryCount:   3,
+		timeout:      30 * time.Second,
+		pollInterval: 2 * time.Second,
 	}
 }
 
@@ -88,6 +90,16 @@ func WithInterceptor(interceptor func(http.RoundTripper) http.RoundTripper) Clie
 	}
 }
 
+// WithPollInterval sets the interval between progress checks of long-running operations,
+// such as [Client.OptimizeRouteAndWait]. Intervals that are not positive are ignored.
+func WithPollInterval(interval time.Duration) ClientOption {
+	return func(config *clientConfig) {
+		if interval > 0 {
+			config.pollInterval = interval
+		}
+	}
+}
+
 func (c *Client) httpClient(cfg clientConfig) *http.Client {
 	transport := http.RoundTripper(http.DefaultTransport)
 	timeout := cfg.timeout
@@ -0,0 +1,87 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/01-method-alert.html
+
+// DeleteAlertSetup deletes an alert setup.
+func (c *Client) DeleteAlertSetup(
+	ctx context.Context,
+	request *maponv1.DeleteAlertSetupRequest,
+) (_ *maponv1.DeleteAlertSetupResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: delete alert setup: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("setup_id", request.GetSetupId())
+
+	requestURL, err := url.Parse(c.baseURL + "/alert/delete_setup.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonAlertSetupDeleteResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	if !responseBody.Data.Success {
+		return nil, errors.New("alert setup was not deleted")
+	}
+
+	return &maponv1.DeleteAlertSetupResponse{}, nil
+}
+
+type jsonAlertSetupDeleteResponse struct {
+	Data struct {
+		Success bool `json:"success"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,161 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/01-method-alert.html
+
+// ListAlertSetups lists the configured alert setups.
+// Private alert setups are not returned by the API.
+func (c *Client) ListAlertSetups(
+	ctx context.Context,
+	request *maponv1.ListAlertSetupsRequest,
+) (_ *maponv1.ListAlertSetupsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list alert setups: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.GetAlertType() != "" {
+		params.Add("alert_type", request.GetAlertType())
+	}
+	if request.GetSetupId() != "" {
+		params.Add("setup_id", request.GetSetupId())
+	}
+	for _, id := range request.GetUnitIds() {
+		params.Add("unit_ids[]", strconv.FormatInt(id, 10))
+	}
+	if request.GetShowInactive() {
+		params.Add("show_inactive", "1")
+	}
+	// Always include details
+	params.Add("include[]", "condition")
+	params.Add("include[]", "units_title")
+	params.Add("include[]", "created")
+	params.Add("include[]", "modified")
+	params.Add("include[]", "fields")
+
+	requestURL, err := url.Parse(c.baseURL + "/alert/list_setups.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonAlertSetupListResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	setups := make([]*maponv1.AlertSetup, 0, len(responseBody.Data.Setups))
+	for _, s := range responseBody.Data.Setups {
+		setup, err := mapJSONAlertSetupToProto(s)
+		if err != nil {
+			return nil, err
+		}
+		setups = append(setups, setup)
+	}
+
+	resp := &maponv1.ListAlertSetupsResponse{}
+	resp.SetSetups(setups)
+	return resp, nil
+}
+
+type jsonAlertSetupListResponse struct {
+	Data struct {
+		Setups []jsonAlertSetup `json:"setups"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+type jsonAlertSetup struct {
+	SetupID    string `json:"setup_id"`
+	AlertType  string `json:"alert_type"`
+	Title      string `json:"title"`
+	Expired    bool   `json:"expired"`
+	Active     bool   `json:"active"`
+	Condition  string `json:"condition"`
+	UnitsTitle string `json:"units_title"`
+	Created    *struct {
+		CreatedAt string `json:"created_at"`
+		CreatedBy string `json:"created_by"`
+	} `json:"created"`
+	Modified *struct {
+		ModifiedAt string `json:"modified_at"`
+		ModifiedBy string `json:"modified_by"`
+	} `json:"modified"`
+	Fields map[string]any `json:"fields"`
+}
+
+func mapJSONAlertSetupToProto(j jsonAlertSetup) (*maponv1.AlertSetup, error) {
+	s := &maponv1.AlertSetup{}
+	s.SetSetupId(j.SetupID)
+	s.SetAlertType(j.AlertType)
+	s.SetTitle(j.Title)
+	s.SetExpired(j.Expired)
+	s.SetActive(j.Active)
+	s.SetCondition(j.Condition)
+	s.SetUnitsTitle(j.UnitsTitle)
+
+	if j.Created != nil {
+		if t, err := time.Parse(time.RFC3339, j.Created.CreatedAt); err == nil {
+			s.SetCreatedAt(timestamppb.New(t))
+		}
+		s.SetCreatedBy(j.Created.CreatedBy)
+	}
+	if j.Modified != nil {
+		if t, err := time.Parse(time.RFC3339, j.Modified.ModifiedAt); err == nil {
+			s.SetModifiedAt(timestamppb.New(t))
+		}
+		s.SetModifiedBy(j.Modified.ModifiedBy)
+	}
+
+	if j.Fields != nil {
+		fields, err := structpb.NewStruct(j.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fields of setup %s: %w", j.SetupID, err)
+		}
+		s.SetFields(fields)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,117 @@
+package mapon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/01-method-alert.html
+
+// StoreAlertSetup creates or updates an alert setup.
+// Returns an error describing the failing fields if the setup does not pass validation.
+func (c *Client) StoreAlertSetup(
+	ctx context.Context,
+	request *maponv1.StoreAlertSetupRequest,
+) (_ *maponv1.StoreAlertSetupResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: store alert setup: %w", err)
+		}
+	}()
+
+	body := map[string]any{
+		"key":    c.config.apiKey,
+		"fields": request.GetFields().AsMap(),
+	}
+	if request.GetSetupId() != "" {
+		body["setup_id"] = request.GetSetupId()
+	}
+	if request.GetAlertType() != "" {
+		body["alert_type"] = request.GetAlertType()
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/alert/store_setup.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	respBytes, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonAlertSetupStoreResponse
+	if err := json.Unmarshal(respBytes, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	if !responseBody.Data.Success {
+		return nil, newAlertSetupValidationError(responseBody.Data.ValidationErrors)
+	}
+
+	resp := &maponv1.StoreAlertSetupResponse{}
+	resp.SetSetupId(responseBody.Data.ID)
+	return resp, nil
+}
+
+type jsonAlertSetupStoreResponse struct {
+	Data struct {
+		ID               string              `json:"id"`
+		Success          bool                `json:"success"`
+		ValidationErrors map[string][]string `json:"validation_errors"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+func newAlertSetupValidationError(validationErrors map[string][]string) error {
+	if len(validationErrors) == 0 {
+		return errors.New("alert setup was not saved")
+	}
+	fields := make([]string, 0, len(validationErrors))
+	for field := range validationErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	msgs := make([]string, 0, len(fields))
+	for _, field := range fields {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", field, strings.Join(validationErrors[field], ", ")))
+	}
+	return fmt.Errorf("validation failed: %s", strings.Join(msgs, "; "))
+}
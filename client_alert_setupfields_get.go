@@ -0,0 +1,218 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/01-method-alert.html
+
+// GetAlertSetupFields returns the fields supported by an alert type.
+// The same fields are expected when storing an alert setup of that type.
+func (c *Client) GetAlertSetupFields(
+	ctx context.Context,
+	request *maponv1.GetAlertSetupFieldsRequest,
+) (_ *maponv1.GetAlertSetupFieldsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get alert setup fields: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("alert_type", request.GetAlertType())
+
+	requestURL, err := url.Parse(c.baseURL + "/alert/setup_fields.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonAlertSetupFieldsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	// Fields are grouped by field group in the response. Flatten them in the
+	// order of field_groups, followed by any groups not listed there.
+	groupOrder := slices.Clone(responseBody.Data.FieldGroups)
+	var extraGroups []string
+	for group := range responseBody.Data.Fields {
+		if !slices.Contains(groupOrder, group) {
+			extraGroups = append(extraGroups, group)
+		}
+	}
+	sort.Strings(extraGroups)
+	groupOrder = append(groupOrder, extraGroups...)
+
+	var fields []*maponv1.AlertSetupField
+	for _, group := range groupOrder {
+		for _, f := range responseBody.Data.Fields[group] {
+			field, err := mapJSONAlertSetupFieldToProto(f, group)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, field)
+		}
+	}
+
+	resp := &maponv1.GetAlertSetupFieldsResponse{}
+	resp.SetFields(fields)
+	resp.SetFieldGroups(responseBody.Data.FieldGroups)
+	return resp, nil
+}
+
+type jsonAlertSetupFieldsResponse struct {
+	Data struct {
+		Fields      map[string][]jsonAlertSetupField `json:"fields"`
+		FieldGroups []string                         `json:"field_groups"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+type jsonAlertSetupField struct {
+	Field       string          `json:"field"`
+	FieldGroup  string          `json:"field_group"`
+	Type        string          `json:"type"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	Required    bool            `json:"required"`
+	RequiredIf  string          `json:"required_if"`
+	Multiple    bool            `json:"multiple"`
+	Options     json.RawMessage `json:"options"` // Array of options, or object of options keyed by value
+	Range       []float64       `json:"range"`   // [min, max]
+}
+
+type jsonAlertSetupFieldOption struct {
+	Title string `json:"title"`
+	Value any    `json:"value"`
+}
+
+func mapJSONAlertSetupFieldToProto(j jsonAlertSetupField, group string) (*maponv1.AlertSetupField, error) {
+	f := &maponv1.AlertSetupField{}
+	f.SetField(j.Field)
+	if j.FieldGroup != "" {
+		f.SetFieldGroup(j.FieldGroup)
+	} else {
+		f.SetFieldGroup(group)
+	}
+	fieldType := mapAlertSetupFieldType(j.Type)
+	f.SetType(fieldType)
+	if fieldType == maponv1.AlertSetupField_TYPE_UNRECOGNIZED {
+		f.SetUnrecognizedType(j.Type)
+	}
+	f.SetTitle(j.Title)
+	f.SetDescription(j.Description)
+	f.SetRequired(j.Required)
+	f.SetRequiredIf(j.RequiredIf)
+	f.SetMultiple(j.Multiple)
+	if len(j.Range) == 2 {
+		f.SetRangeMin(j.Range[0])
+		f.SetRangeMax(j.Range[1])
+	}
+
+	rawOptions, err := parseAlertSetupFieldOptions(j.Options)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options of field %s: %w", j.Field, err)
+	}
+	options := make([]*maponv1.AlertSetupField_Option, 0, len(rawOptions))
+	for _, o := range rawOptions {
+		value, err := structpb.NewValue(o.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid option value of field %s: %w", j.Field, err)
+		}
+		option := &maponv1.AlertSetupField_Option{}
+		option.SetTitle(o.Title)
+		option.SetValue(value)
+		options = append(options, option)
+	}
+	f.SetOptions(options)
+
+	return f, nil
+}
+
+// parseAlertSetupFieldOptions parses field options, which the API returns
+// either as an array or as an object keyed by option value.
+func parseAlertSetupFieldOptions(data json.RawMessage) ([]jsonAlertSetupFieldOption, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var list []jsonAlertSetupFieldOption
+	if err := json.Unmarshal(data, &list); err == nil {
+		return list, nil
+	}
+	var byKey map[string]jsonAlertSetupFieldOption
+	if err := json.Unmarshal(data, &byKey); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(byKey))
+	for k := range byKey {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	list = make([]jsonAlertSetupFieldOption, 0, len(keys))
+	for _, k := range keys {
+		list = append(list, byKey[k])
+	}
+	return list, nil
+}
+
+func mapAlertSetupFieldType(t string) maponv1.AlertSetupField_Type {
+	switch t {
+	case "":
+		return maponv1.AlertSetupField_TYPE_UNSPECIFIED
+	case "select":
+		return maponv1.AlertSetupField_TYPE_SELECT
+	case "date":
+		return maponv1.AlertSetupField_TYPE_DATE
+	case "phone":
+		return maponv1.AlertSetupField_TYPE_PHONE
+	case "email":
+		return maponv1.AlertSetupField_TYPE_EMAIL
+	case "checkbox":
+		return maponv1.AlertSetupField_TYPE_CHECKBOX
+	case "text":
+		return maponv1.AlertSetupField_TYPE_TEXT
+	case "numeric":
+		return maponv1.AlertSetupField_TYPE_NUMERIC
+	case "integer":
+		return maponv1.AlertSetupField_TYPE_INTEGER
+	default:
+		return maponv1.AlertSetupField_TYPE_UNRECOGNIZED
+	}
+}
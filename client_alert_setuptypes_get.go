@@ -0,0 +1,99 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/01-method-alert.html
+
+// GetAlertSetupTypes returns the alert types that can be managed via the API.
+// Access to alert types is derived from the permissions of the user that created the API key.
+func (c *Client) GetAlertSetupTypes(
+	ctx context.Context,
+	request *maponv1.GetAlertSetupTypesRequest,
+) (_ *maponv1.GetAlertSetupTypesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get alert setup types: %w", err)
+		}
+	}()
+
+	requestURL, err := url.Parse(c.baseURL + "/alert/setup_types.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonAlertSetupTypesResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	groups := make([]*maponv1.AlertSetupTypeGroup, 0, len(responseBody.Data.Groups))
+	for _, g := range responseBody.Data.Groups {
+		types := make([]*maponv1.AlertSetupType, 0, len(g.AlertTypes))
+		for _, t := range g.AlertTypes {
+			alertType := &maponv1.AlertSetupType{}
+			alertType.SetType(t.Type)
+			alertType.SetTitle(t.Title)
+			alertType.SetDescription(t.Description)
+			types = append(types, alertType)
+		}
+		group := &maponv1.AlertSetupTypeGroup{}
+		group.SetGroupId(g.GroupID)
+		group.SetName(g.GroupName)
+		group.SetAlertTypes(types)
+		groups = append(groups, group)
+	}
+
+	resp := &maponv1.GetAlertSetupTypesResponse{}
+	resp.SetGroups(groups)
+	return resp, nil
+}
+
+type jsonAlertSetupTypesResponse struct {
+	Data struct {
+		Groups []struct {
+			GroupID    string `json:"group_id"`
+			GroupName  string `json:"group_name"`
+			AlertTypes []struct {
+				Type        string `json:"type"`
+				Title       string `json:"title"`
+				Description string `json:"description"`
+			} `json:"alert_types"`
+		} `json:"groups"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
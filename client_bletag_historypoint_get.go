@@ -0,0 +1,97 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/31-method-ble_tags.html
+
+// GetBleTagHistoryPoint returns the scan and location of a BLE tag closest to a point in time,
+// looking within 30 days of it.
+func (c *Client) GetBleTagHistoryPoint(
+	ctx context.Context,
+	request *maponv1.GetBleTagHistoryPointRequest,
+) (_ *maponv1.GetBleTagHistoryPointResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get BLE tag history point: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if err := addBleTagParams(params, request.GetTagId(), request.GetBeaconId()); err != nil {
+		return nil, err
+	}
+	params.Add("datetime", request.GetTime().AsTime().UTC().Format(time.RFC3339))
+	if !request.GetIncludeScan() && !request.GetIncludeLocation() {
+		return nil, fmt.Errorf("include scan or location required")
+	}
+	if request.GetIncludeScan() {
+		params.Add("includes[]", "scan")
+	}
+	if request.GetIncludeLocation() {
+		params.Add("includes[]", "location")
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/ble_tags/history_point.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonBleTagHistoryPointResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.GetBleTagHistoryPointResponse{}
+	if responseBody.Data.Scan != nil {
+		resp.SetScan(mapJSONBleTagScanToProto(responseBody.Data.Scan))
+	}
+	if responseBody.Data.Location != nil {
+		resp.SetLocation(mapJSONBleTagLocationToProto(responseBody.Data.Location))
+	}
+	return resp, nil
+}
+
+type jsonBleTagHistoryPointResponse struct {
+	Data struct {
+		Scan     *jsonBleTagScan     `json:"scan"`
+		Location *jsonBleTagLocation `json:"location"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,98 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/31-method-ble_tags.html
+
+// ListBleTags lists the active BLE tags of the company with their last known location.
+func (c *Client) ListBleTags(
+	ctx context.Context,
+	request *maponv1.ListBleTagsRequest,
+) (_ *maponv1.ListBleTagsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list BLE tags: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.GetSortBy() != "" {
+		params.Add("sort_by", request.GetSortBy())
+		if request.GetSortDescending() {
+			params.Add("sort_order", "DESC")
+		} else {
+			params.Add("sort_order", "ASC")
+		}
+	}
+	if request.GetPage() > 0 {
+		params.Add("page", strconv.Itoa(int(request.GetPage())))
+	}
+	if request.GetPerPage() > 0 {
+		params.Add("per_page", strconv.Itoa(int(request.GetPerPage())))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/ble_tags/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonBleTagsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	tags := make([]*maponv1.BleTag, 0, len(responseBody.Data.Tags))
+	for _, j := range responseBody.Data.Tags {
+		tags = append(tags, mapJSONBleTagToProto(&j))
+	}
+
+	resp := &maponv1.ListBleTagsResponse{}
+	resp.SetTags(tags)
+	resp.SetPagination(mapJSONBleTagMetaToProto(responseBody.Data.Meta))
+	return resp, nil
+}
+
+type jsonBleTagsResponse struct {
+	Data struct {
+		Tags []jsonBleTag   `json:"tags"`
+		Meta jsonBleTagMeta `json:"_meta"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
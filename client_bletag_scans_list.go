@@ -0,0 +1,96 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/31-method-ble_tags.html
+
+// ListBleTagScans lists the scans of a BLE tag in a period of up to 31 days.
+func (c *Client) ListBleTagScans(
+	ctx context.Context,
+	request *maponv1.ListBleTagScansRequest,
+) (_ *maponv1.ListBleTagScansResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list BLE tag scans: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if err := addBleTagParams(params, request.GetTagId(), request.GetBeaconId()); err != nil {
+		return nil, err
+	}
+	params.Add("from", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	params.Add("till", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+	if request.GetPage() > 0 {
+		params.Add("page", strconv.Itoa(int(request.GetPage())))
+	}
+	if request.GetPerPage() > 0 {
+		params.Add("per_page", strconv.Itoa(int(request.GetPerPage())))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/ble_tags/scans.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonBleTagScansResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	scans := make([]*maponv1.BleTagScan, 0, len(responseBody.Data.Scans))
+	for _, j := range responseBody.Data.Scans {
+		scans = append(scans, mapJSONBleTagScanToProto(&j))
+	}
+
+	resp := &maponv1.ListBleTagScansResponse{}
+	resp.SetScans(scans)
+	resp.SetPagination(mapJSONBleTagMetaToProto(responseBody.Data.Meta))
+	return resp, nil
+}
+
+type jsonBleTagScansResponse struct {
+	Data struct {
+		Scans []jsonBleTagScan `json:"scans"`
+		Meta  jsonBleTagMeta   `json:"_meta"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,104 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/02-method-company.html
+
+// GetCompany returns the basic data of the companies available for the API key.
+// The company ID matches the company ID of push messages.
+func (c *Client) GetCompany(
+	ctx context.Context,
+	request *maponv1.GetCompanyRequest,
+) (_ *maponv1.GetCompanyResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get company: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+
+	requestURL, err := url.Parse(c.baseURL + "/company/get.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonCompanyResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	companies := make([]*maponv1.Company, 0, len(responseBody.Data.Companies))
+	for _, j := range responseBody.Data.Companies {
+		company := &maponv1.Company{}
+		company.SetCompanyId(j.ID)
+		company.SetName(j.Name)
+		company.SetLegalName(j.LegalName)
+		company.SetRegistrationNumber(formatCustomFieldValue(j.RegNo))
+		company.SetAddress(j.Address)
+		company.SetTimezone(j.Timezone)
+		company.SetCurrency(j.Currency)
+		if unit, ok := companyDistanceUnits[j.DistanceUnit]; ok {
+			company.SetDistanceUnit(unit)
+		} else if j.DistanceUnit != "" {
+			company.SetDistanceUnit(maponv1.Company_DISTANCE_UNIT_UNRECOGNIZED)
+		}
+		companies = append(companies, company)
+	}
+
+	resp := &maponv1.GetCompanyResponse{}
+	resp.SetCompanies(companies)
+	return resp, nil
+}
+
+type jsonCompanyResponse struct {
+	Data struct {
+		Companies []struct {
+			ID           int64  `json:"id"`
+			Name         string `json:"name"`
+			LegalName    string `json:"legal_name"`
+			RegNo        any    `json:"reg_no"` // String or number
+			Address      string `json:"address"`
+			Timezone     string `json:"timezone"`
+			Currency     string `json:"currency"`
+			DistanceUnit string `json:"distance_unit"`
+		} `json:"companies"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
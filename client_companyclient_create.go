@@ -0,0 +1,109 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/03-method-company_clients.html
+
+// CreateCompanyClient creates a client of the company.
+// The client works Monday to Friday, all day, unless the working days and times are set.
+func (c *Client) CreateCompanyClient(
+	ctx context.Context,
+	request *maponv1.CreateCompanyClientRequest,
+) (_ *maponv1.CreateCompanyClientResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: create company client: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("name", request.GetName())
+	params.Add("address", request.GetAddress())
+	if request.GetEmail() != "" {
+		params.Add("email", request.GetEmail())
+	}
+	if request.GetPhone() != "" {
+		params.Add("phone", request.GetPhone())
+	}
+	if request.GetComments() != "" {
+		params.Add("comments", request.GetComments())
+	}
+	if request.HasWorkingDays() {
+		days := request.GetWorkingDays()
+		params.Add("working_days[monday]", formatBoolInt(days.GetMonday()))
+		params.Add("working_days[tuesday]", formatBoolInt(days.GetTuesday()))
+		params.Add("working_days[wednesday]", formatBoolInt(days.GetWednesday()))
+		params.Add("working_days[thursday]", formatBoolInt(days.GetThursday()))
+		params.Add("working_days[friday]", formatBoolInt(days.GetFriday()))
+		params.Add("working_days[saturday]", formatBoolInt(days.GetSaturday()))
+		params.Add("working_days[sunday]", formatBoolInt(days.GetSunday()))
+	}
+	if request.GetWorkingTimeFrom() != "" || request.GetWorkingTimeTo() != "" {
+		params.Add("working_times[from]", request.GetWorkingTimeFrom())
+		params.Add("working_times[to]", request.GetWorkingTimeTo())
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/company_clients/create.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonCompanyClientCreateResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.CreateCompanyClientResponse{}
+	resp.SetClientId(responseBody.Data.ID)
+	return resp, nil
+}
+
+type jsonCompanyClientCreateResponse struct {
+	Data struct {
+		ID int64 `json:"id"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
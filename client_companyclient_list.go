@@ -0,0 +1,91 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/03-method-company_clients.html
+
+// ListCompanyClients returns a page of the clients of the company.
+func (c *Client) ListCompanyClients(
+	ctx context.Context,
+	request *maponv1.ListCompanyClientsRequest,
+) (_ *maponv1.ListCompanyClientsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list company clients: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.GetPage() != 0 {
+		params.Add("page", strconv.Itoa(int(request.GetPage())))
+	}
+	if request.GetPerPage() != 0 {
+		params.Add("per_page", strconv.Itoa(int(request.GetPerPage())))
+	}
+	if request.GetName() != "" {
+		params.Add("name", request.GetName())
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/company_clients/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonCompanyClientsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	clients := make([]*maponv1.CompanyClient, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		clients = append(clients, mapJSONCompanyClientToProto(j))
+	}
+
+	resp := &maponv1.ListCompanyClientsResponse{}
+	resp.SetClients(clients)
+	resp.SetPagination(mapJSONPageMetaToProto(responseBody.Meta))
+	return resp, nil
+}
+
+type jsonCompanyClientsResponse struct {
+	Data  []jsonCompanyClient `json:"data"`
+	Meta  jsonPageMeta        `json:"_meta"`
+	Error *jsonError          `json:"error"`
+}
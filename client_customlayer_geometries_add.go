@@ -0,0 +1,91 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/06-method-customlayers_geometries.html
+
+// AddCustomLayerGeometries adds geometries to a custom map layer.
+// The WKT of geometries without one is built from their typed coordinates.
+func (c *Client) AddCustomLayerGeometries(
+	ctx context.Context,
+	request *maponv1.AddCustomLayerGeometriesRequest,
+) (_ *maponv1.AddCustomLayerGeometriesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: add custom layer geometries: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	if len(request.GetGeometries()) > maxCustomLayerGeometriesPerAdd {
+		return nil, fmt.Errorf("at most %d geometries are allowed per request, got %d", maxCustomLayerGeometriesPerAdd, len(request.GetGeometries()))
+	}
+	params.Add("layer_id", strconv.FormatInt(request.GetLayerId(), 10))
+	for i, g := range request.GetGeometries() {
+		wkt, err := formatCustomLayerGeometryWKT(g)
+		if err != nil {
+			return nil, fmt.Errorf("geometry %d: %w", i, err)
+		}
+		prefix := "geometries[" + strconv.Itoa(i) + "]"
+		if g.GetName() != "" {
+			params.Add(prefix+"[name]", g.GetName())
+		}
+		params.Add(prefix+"[wkt]", wkt)
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/customlayers_geometries/add.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonCustomLayerStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	return &maponv1.AddCustomLayerGeometriesResponse{}, nil
+}
@@ -0,0 +1,86 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/06-method-customlayers_geometries.html
+
+// EditCustomLayerGeometry updates the name and shape of a custom map layer geometry.
+// The WKT is built from the typed coordinates when it is not set.
+func (c *Client) EditCustomLayerGeometry(
+	ctx context.Context,
+	request *maponv1.EditCustomLayerGeometryRequest,
+) (_ *maponv1.EditCustomLayerGeometryResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: edit custom layer geometry: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	g := request.GetGeometry()
+	wkt, err := formatCustomLayerGeometryWKT(g)
+	if err != nil {
+		return nil, err
+	}
+	params.Add("id", strconv.FormatInt(g.GetGeometryId(), 10))
+	if g.GetName() != "" {
+		params.Add("name", g.GetName())
+	}
+	params.Add("wkt", wkt)
+
+	requestURL, err := url.Parse(c.baseURL + "/customlayers_geometries/edit.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonCustomLayerStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	return &maponv1.EditCustomLayerGeometryResponse{}, nil
+}
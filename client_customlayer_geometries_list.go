@@ -0,0 +1,99 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/06-method-customlayers_geometries.html
+
+// ListCustomLayerGeometries returns a page of the geometries of a custom map layer.
+// The typed points, lines and polygons are parsed from the WKT of each geometry.
+func (c *Client) ListCustomLayerGeometries(
+	ctx context.Context,
+	request *maponv1.ListCustomLayerGeometriesRequest,
+) (_ *maponv1.ListCustomLayerGeometriesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list custom layer geometries: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("layer_id", strconv.FormatInt(request.GetLayerId(), 10))
+	if request.GetLimit() != 0 {
+		params.Add("limit", strconv.Itoa(int(request.GetLimit())))
+	}
+	if request.GetOffset() != 0 {
+		params.Add("offset", strconv.Itoa(int(request.GetOffset())))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/customlayers_geometries/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonCustomLayerGeometriesResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	geometries := make([]*maponv1.CustomLayerGeometry, 0, len(responseBody.Data.Geometries))
+	for _, j := range responseBody.Data.Geometries {
+		geometries = append(geometries, mapJSONCustomLayerGeometryToProto(j))
+	}
+
+	resp := &maponv1.ListCustomLayerGeometriesResponse{}
+	resp.SetGeometries(geometries)
+	resp.SetTotal(responseBody.Data.Total)
+	return resp, nil
+}
+
+type jsonCustomLayerGeometriesResponse struct {
+	Data struct {
+		Geometries []jsonCustomLayerGeometry `json:"geometries"`
+		Total      int32                     `json:"total"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
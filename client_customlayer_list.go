@@ -0,0 +1,96 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/05-method-customlayers.html
+
+// ListCustomLayers lists the custom map layers.
+func (c *Client) ListCustomLayers(
+	ctx context.Context,
+	request *maponv1.ListCustomLayersRequest,
+) (_ *maponv1.ListCustomLayersResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list custom layers: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.GetLimit() != 0 {
+		params.Add("limit", strconv.Itoa(int(request.GetLimit())))
+	}
+	if request.GetOffset() != 0 {
+		params.Add("offset", strconv.Itoa(int(request.GetOffset())))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/customlayers/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonCustomLayersResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	layers := make([]*maponv1.CustomLayer, 0, len(responseBody.Data.Layers))
+	for _, j := range responseBody.Data.Layers {
+		l := &maponv1.CustomLayer{}
+		l.SetLayerId(j.ID)
+		l.SetName(j.Name)
+		l.SetColorHex(j.Color)
+		layers = append(layers, l)
+	}
+
+	resp := &maponv1.ListCustomLayersResponse{}
+	resp.SetLayers(layers)
+	return resp, nil
+}
+
+type jsonCustomLayersResponse struct {
+	Data struct {
+		Layers []struct {
+			ID    int64  `json:"id"`
+			Name  string `json:"name"`
+			Color string `json:"color"` // Hex like "#FF5733"
+		} `json:"layers"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,89 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/05-method-customlayers.html
+
+// SaveCustomLayer creates a custom map layer, or updates it when a layer ID is set.
+func (c *Client) SaveCustomLayer(
+	ctx context.Context,
+	request *maponv1.SaveCustomLayerRequest,
+) (_ *maponv1.SaveCustomLayerResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: save custom layer: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	if request.GetLayerId() != 0 {
+		params.Add("id", strconv.FormatInt(request.GetLayerId(), 10))
+	}
+	params.Add("name", request.GetName())
+	params.Add("color", request.GetColorHex())
+
+	requestURL, err := url.Parse(c.baseURL + "/customlayers/save.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonCustomLayerSaveResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.SaveCustomLayerResponse{}
+	resp.SetLayerId(responseBody.Data.ID)
+	return resp, nil
+}
+
+type jsonCustomLayerSaveResponse struct {
+	Data struct {
+		ID int64 `json:"id"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,78 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/34-method-custom_targets.html
+
+// ListCustomTargets lists the custom targets defined for the company.
+func (c *Client) ListCustomTargets(
+	ctx context.Context,
+	request *maponv1.ListCustomTargetsRequest,
+) (_ *maponv1.ListCustomTargetsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list custom targets: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+
+	requestURL, err := url.Parse(c.baseURL + "/custom_targets/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonCustomTargetsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	targets := make([]*maponv1.CustomTarget, 0, len(responseBody.Data))
+	for _, t := range responseBody.Data {
+		targets = append(targets, mapJSONCustomTargetToProto(t))
+	}
+	resp := &maponv1.ListCustomTargetsResponse{}
+	resp.SetTargets(targets)
+	return resp, nil
+}
+
+type jsonCustomTargetsResponse struct {
+	Data  []jsonCustomTarget `json:"data"`
+	Error *jsonError         `json:"error"`
+}
@@ -0,0 +1,94 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/34-method-custom_targets.html
+
+// ListCustomTargetValues returns the values of a custom target recorded for units in the specified period.
+// The period can not exceed 31 days.
+func (c *Client) ListCustomTargetValues(
+	ctx context.Context,
+	request *maponv1.ListCustomTargetValuesRequest,
+) (_ *maponv1.ListCustomTargetValuesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list custom target values: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if len(request.GetUnitIds()) == 0 {
+		return nil, fmt.Errorf("no unit IDs")
+	}
+	for _, id := range request.GetUnitIds() {
+		params.Add("unit_id[]", strconv.FormatInt(id, 10))
+	}
+	params.Add("target_id", strconv.FormatInt(request.GetCustomTargetId(), 10))
+	params.Add("from", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	params.Add("till", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+
+	requestURL, err := url.Parse(c.baseURL + "/custom_targets/values.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonCustomTargetValuesResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	var units map[string][]jsonCustomTargetValue
+	if err := unmarshalCustomFieldObject(responseBody.Data, &units); err != nil {
+		return nil, err
+	}
+	values, err := mapJSONCustomTargetValuesToProto(units)
+	if err != nil {
+		return nil, err
+	}
+	resp := &maponv1.ListCustomTargetValuesResponse{}
+	resp.SetValues(values)
+	return resp, nil
+}
+
+type jsonCustomTargetValuesResponse struct {
+	Data  json.RawMessage `json:"data"` // Object keyed by unit ID, or an empty array
+	Error *jsonError      `json:"error"`
+}
@@ -0,0 +1,108 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/18-method-device.html
+
+// GetDeviceCommands lists the SMS or TCP commands supported by a device.
+func (c *Client) GetDeviceCommands(
+	ctx context.Context,
+	request *maponv1.GetDeviceCommandsRequest,
+) (_ *maponv1.GetDeviceCommandsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get device commands: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("device_id", strconv.FormatInt(request.GetDeviceId(), 10))
+	switch request.GetTransport() {
+	case maponv1.DeviceCommand_TRANSPORT_SMS:
+		params.Add("transport", "sms")
+	case maponv1.DeviceCommand_TRANSPORT_TCP:
+		params.Add("transport", "tcp")
+	default:
+		return nil, fmt.Errorf("unsupported transport %s", request.GetTransport())
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/device/get_commands.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDeviceCommandsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	commands := make([]*maponv1.DeviceCommand, 0, len(responseBody.Data.Commands))
+	for _, j := range responseBody.Data.Commands {
+		var parameters map[string]interface{}
+		if err := unmarshalCustomFieldObject(j.Params, &parameters); err != nil {
+			return nil, fmt.Errorf("command %s parameters: %w", j.Command, err)
+		}
+		command := &maponv1.DeviceCommand{}
+		command.SetCommand(j.Command)
+		if len(parameters) > 0 {
+			values := make(map[string]string, len(parameters))
+			for name, value := range parameters {
+				values[name] = formatCustomFieldValue(value)
+			}
+			command.SetParameters(values)
+		}
+		commands = append(commands, command)
+	}
+
+	resp := &maponv1.GetDeviceCommandsResponse{}
+	resp.SetCommands(commands)
+	return resp, nil
+}
+
+type jsonDeviceCommandsResponse struct {
+	Data struct {
+		Status   string `json:"status"`
+		Commands []struct {
+			Command string          `json:"command"`
+			Params  json.RawMessage `json:"params"` // Empty array when the command has no parameters
+		} `json:"commands"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
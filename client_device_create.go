@@ -0,0 +1,102 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/18-method-device.html
+
+// CreateDevice creates a device in the company storage.
+func (c *Client) CreateDevice(
+	ctx context.Context,
+	request *maponv1.CreateDeviceRequest,
+) (_ *maponv1.CreateDeviceResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: create device: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("model", request.GetModel())
+	for _, field := range []struct{ key, value string }{
+		{"model_ver", request.GetModelVersion()},
+		{"imei", request.GetImei()},
+		{"sn", request.GetSerialNumber()},
+		{"sim", request.GetSim()},
+		{"hardware", request.GetHardwareVersion()},
+		{"software", request.GetSoftwareVersion()},
+		{"firmware", request.GetFirmwareVersion()},
+		{"imsi", request.GetImsi()},
+	} {
+		if field.value != "" {
+			params.Add(field.key, field.value)
+		}
+	}
+	for name, value := range request.GetCustomFields() {
+		params.Add("customFields["+name+"]", value)
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/device/create.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDeviceCreateResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.CreateDeviceResponse{}
+	resp.SetBoxId(responseBody.Data.BoxID)
+	return resp, nil
+}
+
+type jsonDeviceCreateResponse struct {
+	Data struct {
+		Status string `json:"status"`
+		BoxID  int64  `json:"box_id"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
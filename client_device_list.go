@@ -0,0 +1,100 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/18-method-device.html
+
+// ListDevices lists the devices of the company, installed on units or in the company storage.
+func (c *Client) ListDevices(
+	ctx context.Context,
+	request *maponv1.ListDevicesRequest,
+) (_ *maponv1.ListDevicesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list devices: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.GetBoxId() != 0 {
+		params.Add("box_id", strconv.FormatInt(request.GetBoxId(), 10))
+	}
+	if request.GetSerialNumber() != "" {
+		params.Add("serial", request.GetSerialNumber())
+	}
+	if request.GetImei() != "" {
+		params.Add("imei", request.GetImei())
+	}
+	if request.GetModel() != "" {
+		params.Add("model", request.GetModel())
+	}
+	if request.GetModelVersion() != "" {
+		params.Add("model_ver", request.GetModelVersion())
+	}
+	if request.GetInstalledOnly() {
+		params.Add("installed", "1")
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/device/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDevicesResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	devices := make([]*maponv1.Device, 0, len(responseBody.Data.Devices))
+	for _, j := range responseBody.Data.Devices {
+		devices = append(devices, mapJSONDeviceToProto(j))
+	}
+
+	resp := &maponv1.ListDevicesResponse{}
+	resp.SetDevices(devices)
+	return resp, nil
+}
+
+type jsonDevicesResponse struct {
+	Data struct {
+		Devices []jsonDevice `json:"devices"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
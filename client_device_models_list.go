@@ -0,0 +1,130 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/18-method-device.html
+
+// ListDeviceModels lists the supported device models, ordered by model name.
+func (c *Client) ListDeviceModels(
+	ctx context.Context,
+	request *maponv1.ListDeviceModelsRequest,
+) (_ *maponv1.ListDeviceModelsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list device models: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.GetIncludeCustomFields() {
+		params.Add("custom_fields", "1")
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/device/models.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDeviceModelsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	models := make([]*maponv1.DeviceModel, 0, len(responseBody.Data))
+	for _, name := range slices.Sorted(maps.Keys(responseBody.Data)) {
+		m, err := mapJSONDeviceModelToProto(responseBody.Data[name])
+		if err != nil {
+			return nil, fmt.Errorf("model %s: %w", name, err)
+		}
+		models = append(models, m)
+	}
+
+	resp := &maponv1.ListDeviceModelsResponse{}
+	resp.SetModels(models)
+	return resp, nil
+}
+
+type jsonDeviceModelsResponse struct {
+	Data  map[string]jsonDeviceModel `json:"data"`
+	Error *jsonError                 `json:"error"`
+}
+
+type jsonDeviceModel struct {
+	Model            string          `json:"model"`
+	Title            string          `json:"title"`
+	DisplayID        string          `json:"display_id"`
+	Versions         json.RawMessage `json:"versions"` // Empty array when the model has no versions
+	RequiredFields   []string        `json:"required_fields"`
+	AdditionalFields []string        `json:"additional_fields"`
+	CustomFields     []struct {
+		Name     string      `json:"name"`
+		Type     string      `json:"type"`
+		ModelVer string      `json:"model_ver"`
+		Options  []string    `json:"options"`
+		Value    interface{} `json:"value"` // String or number
+	} `json:"custom_fields"`
+}
+
+func mapJSONDeviceModelToProto(j jsonDeviceModel) (*maponv1.DeviceModel, error) {
+	m := &maponv1.DeviceModel{}
+	m.SetModel(j.Model)
+	m.SetTitle(j.Title)
+	m.SetDisplayId(j.DisplayID)
+	var versions map[string]string
+	if err := unmarshalCustomFieldObject(j.Versions, &versions); err != nil {
+		return nil, fmt.Errorf("versions: %w", err)
+	}
+	m.SetVersions(versions)
+	m.SetRequiredFields(j.RequiredFields)
+	m.SetAdditionalFields(j.AdditionalFields)
+	customFields := make([]*maponv1.DeviceModelCustomField, 0, len(j.CustomFields))
+	for _, jf := range j.CustomFields {
+		f := &maponv1.DeviceModelCustomField{}
+		f.SetName(jf.Name)
+		f.SetType(jf.Type)
+		f.SetModelVersion(jf.ModelVer)
+		f.SetOptions(jf.Options)
+		f.SetDefaultValue(formatCustomFieldValue(jf.Value))
+		customFields = append(customFields, f)
+	}
+	m.SetCustomFields(customFields)
+	return m, nil
+}
@@ -0,0 +1,97 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/18-method-device.html
+
+// ListDeviceSmsHistory lists the SMS messages received from a device.
+// The API returns the full history, so the time range of the request is applied by the client.
+func (c *Client) ListDeviceSmsHistory(
+	ctx context.Context,
+	request *maponv1.ListDeviceSmsHistoryRequest,
+) (_ *maponv1.ListDeviceSmsHistoryResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list device SMS history: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("device_id", strconv.FormatInt(request.GetDeviceId(), 10))
+
+	requestURL, err := url.Parse(c.baseURL + "/device/sms_history.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDeviceSmsHistoryResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	history, err := parseDeviceSmsHistory(responseBody.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*maponv1.DeviceSmsMessage, 0, len(history))
+	for _, j := range history {
+		m, err := mapJSONDeviceSmsMessageToProto(j)
+		if err != nil {
+			return nil, err
+		}
+		if request.HasFromTime() && m.GetReceivedAt().AsTime().Before(request.GetFromTime().AsTime()) {
+			continue
+		}
+		if request.HasToTime() && !m.GetReceivedAt().AsTime().Before(request.GetToTime().AsTime()) {
+			continue
+		}
+		messages = append(messages, m)
+	}
+
+	resp := &maponv1.ListDeviceSmsHistoryResponse{}
+	resp.SetMessages(messages)
+	return resp, nil
+}
+
+type jsonDeviceSmsHistoryResponse struct {
+	Data  json.RawMessage `json:"data"`
+	Error *jsonError      `json:"error"`
+}
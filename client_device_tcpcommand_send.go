@@ -0,0 +1,80 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/18-method-device.html
+
+// SendDeviceTcpCommand sends a TCP command to a device and returns the device response.
+func (c *Client) SendDeviceTcpCommand(
+	ctx context.Context,
+	request *maponv1.SendDeviceTcpCommandRequest,
+) (_ *maponv1.SendDeviceTcpCommandResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: send device TCP command: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("device_id", strconv.FormatInt(request.GetDeviceId(), 10))
+	params.Add("command", request.GetCommand())
+	addDeviceCommandParams(params, request.GetParameters())
+
+	requestURL, err := url.Parse(c.baseURL + "/device/send_tcp_command.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDeviceStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.SendDeviceTcpCommandResponse{}
+	resp.SetResult(responseBody.Data.Result)
+	return resp, nil
+}
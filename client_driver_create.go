@@ -0,0 +1,139 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/19-method-driver.html
+
+// CreateDriver creates a driver and returns its ID and generated password.
+func (c *Client) CreateDriver(
+	ctx context.Context,
+	request *maponv1.CreateDriverRequest,
+) (_ *maponv1.CreateDriverResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: create driver: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("name", request.GetName())
+	params.Add("surname", request.GetSurname())
+	if request.GetEmail() != "" {
+		params.Add("email", request.GetEmail())
+	}
+	if request.GetPhone() != "" {
+		params.Add("phone", request.GetPhone())
+	}
+	if request.GetLanguage() != "" {
+		params.Add("lang", request.GetLanguage())
+	}
+	if request.GetIbuttonValue() != "" {
+		params.Add("ibutton", request.GetIbuttonValue())
+	}
+	if request.GetTachographId() != "" {
+		params.Add("tacho", request.GetTachographId())
+	}
+	if request.GetAccessToRouteSheet() {
+		params.Add("access_to_route_sheet", "1")
+	}
+	if request.GetDriverlinkAccess() {
+		params.Add("driverlink_access", "1")
+	}
+	if request.GetDepotId() != 0 {
+		params.Add("depot", strconv.FormatInt(request.GetDepotId(), 10))
+	}
+	if request.GetUnitId() != 0 {
+		params.Add("unit", strconv.FormatInt(request.GetUnitId(), 10))
+	}
+	if request.GetAccessAllUnits() {
+		params.Add("access_all_units", "1")
+	}
+	if len(request.GetAccessibleUnitIds()) > 0 {
+		params.Add("unit_ids", joinInt64s(request.GetAccessibleUnitIds()))
+	}
+	if len(request.GetAccessibleUnitGroupIds()) > 0 {
+		params.Add("unit_group_ids", joinInt64s(request.GetAccessibleUnitGroupIds()))
+	}
+	if request.GetSendPasswordToEmail() {
+		params.Add("send_password_to_email", "1")
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/driver/create.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDriverCreateResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	// The created driver is documented at the top level of the response,
+	// but other endpoints wrap results in a data object.
+	driverID, password := responseBody.ID, responseBody.Password
+	if driverID == 0 {
+		driverID, password = responseBody.Data.ID, responseBody.Data.Password
+	}
+	if driverID == 0 {
+		return nil, fmt.Errorf("missing driver ID in response: %s", strings.TrimSpace(string(data)))
+	}
+
+	resp := &maponv1.CreateDriverResponse{}
+	resp.SetDriverId(driverID)
+	resp.SetPassword(password)
+	return resp, nil
+}
+
+type jsonDriverCreateResponse struct {
+	ID       int64  `json:"id"`
+	Password string `json:"password"`
+	Data     struct {
+		ID       int64  `json:"id"`
+		Password string `json:"password"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,99 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/19-method-driver.html
+
+// ListDriverCustomFields returns the custom fields and values of drivers.
+func (c *Client) ListDriverCustomFields(
+	ctx context.Context,
+	request *maponv1.ListDriverCustomFieldsRequest,
+) (_ *maponv1.ListDriverCustomFieldsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list driver custom fields: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	for _, id := range request.GetDriverIds() {
+		params.Add("ids[]", strconv.FormatInt(id, 10))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/driver/custom_fields.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDriverCustomFieldsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	drivers := make([]*maponv1.DriverCustomFields, 0, len(responseBody.Data.Drivers))
+	for _, j := range responseBody.Data.Drivers {
+		fields := make([]*maponv1.CustomField, 0, len(j.Fields))
+		for _, jf := range j.Fields {
+			f, err := mapJSONCustomFieldToProto(jf)
+			if err != nil {
+				return nil, fmt.Errorf("driver %d: %w", j.ID, err)
+			}
+			fields = append(fields, f)
+		}
+		d := &maponv1.DriverCustomFields{}
+		d.SetDriverId(j.ID)
+		d.SetFields(fields)
+		drivers = append(drivers, d)
+	}
+
+	resp := &maponv1.ListDriverCustomFieldsResponse{}
+	resp.SetDrivers(drivers)
+	return resp, nil
+}
+
+type jsonDriverCustomFieldsResponse struct {
+	Data struct {
+		Drivers []struct {
+			ID     int64             `json:"id"`
+			Fields []jsonCustomField `json:"fields"`
+		} `json:"drivers"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,177 @@
+package mapon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/19-method-driver.html
+
+// ListDriverDailyActivities returns the activity intervals of a driver grouped by day,
+// for a period of up to 31 days. Data is selected for whole days in the company timezone.
+func (c *Client) ListDriverDailyActivities(
+	ctx context.Context,
+	request *maponv1.ListDriverDailyActivitiesRequest,
+) (_ *maponv1.ListDriverDailyActivitiesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list driver daily activities: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("driver", strconv.FormatInt(request.GetDriverId(), 10))
+	params.Add("from", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	params.Add("till", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+	var include []string
+	if request.GetIncludeCardEvents() {
+		include = append(include, "card_events")
+	}
+	if request.GetIncludeWorkPeriodEvents() {
+		include = append(include, "work_place_events")
+	}
+	if len(include) > 0 {
+		params.Add("include", strings.Join(include, ","))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/driver/daily_activities.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDriverDailyActivitiesResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	days := make([]*maponv1.DriverDailyActivities, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		days = append(days, mapJSONDriverDayToProto(j))
+	}
+
+	resp := &maponv1.ListDriverDailyActivitiesResponse{}
+	resp.SetDays(days)
+	return resp, nil
+}
+
+// jsonDriverDailyActivitiesResponse accepts both a bare array of days, as documented,
+// and the data object used by other endpoints.
+type jsonDriverDailyActivitiesResponse struct {
+	Data  []jsonDriverDay `json:"data"`
+	Error *jsonError      `json:"error"`
+}
+
+func (r *jsonDriverDailyActivitiesResponse) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		return json.Unmarshal(trimmed, &r.Data)
+	}
+	type plain jsonDriverDailyActivitiesResponse
+	return json.Unmarshal(data, (*plain)(r))
+}
+
+type jsonDriverDay struct {
+	Day     string `json:"day"` // "2023-07-02 00:00:00"
+	Summary struct {
+		Shift   int64 `json:"shift"`
+		Driving int64 `json:"driving"`
+		Rest    int64 `json:"rest"`
+	} `json:"summary"`
+	Activities []struct {
+		Start    int64  `json:"start"` // Unix timestamp
+		End      int64  `json:"end"`   // Unix timestamp
+		Duration int64  `json:"duration"`
+		Status   string `json:"status"`
+		Source   string `json:"source"`
+		UnitID   int64  `json:"unitId"`
+	} `json:"activities"`
+}
+
+var driverActivityStatuses = map[string]maponv1.DriverActivity_Status{
+	"DRIVING":              maponv1.DriverActivity_STATUS_DRIVING,
+	"REST":                 maponv1.DriverActivity_STATUS_REST,
+	"WORK":                 maponv1.DriverActivity_STATUS_WORK,
+	"AVAILABLE":            maponv1.DriverActivity_STATUS_AVAILABLE,
+	"CARD_INSERTED":        maponv1.DriverActivity_STATUS_CARD_INSERTED,
+	"CARD_REMOVED":         maponv1.DriverActivity_STATUS_CARD_REMOVED,
+	"WORK_PERIOD_STARTED":  maponv1.DriverActivity_STATUS_WORK_PERIOD_STARTED,
+	"WORK_PERIOD_FINISHED": maponv1.DriverActivity_STATUS_WORK_PERIOD_FINISHED,
+}
+
+var driverActivitySources = map[string]maponv1.DriverActivity_Source{
+	"ddd":  maponv1.DriverActivity_SOURCE_DDD,
+	"can":  maponv1.DriverActivity_SOURCE_CAN,
+	"unkn": maponv1.DriverActivity_SOURCE_UNKNOWN,
+}
+
+func mapJSONDriverDayToProto(j jsonDriverDay) *maponv1.DriverDailyActivities {
+	d := &maponv1.DriverDailyActivities{}
+	if t, err := time.Parse(time.DateTime, j.Day); err == nil {
+		d.SetDate(t.Format(time.DateOnly))
+	} else {
+		d.SetDate(j.Day)
+	}
+	d.SetShiftS(j.Summary.Shift)
+	d.SetDrivingS(j.Summary.Driving)
+	d.SetRestS(j.Summary.Rest)
+	activities := make([]*maponv1.DriverActivity, 0, len(j.Activities))
+	for _, ja := range j.Activities {
+		a := &maponv1.DriverActivity{}
+		a.SetStartTime(timestamppb.New(time.Unix(ja.Start, 0)))
+		a.SetEndTime(timestamppb.New(time.Unix(ja.End, 0)))
+		a.SetDurationS(ja.Duration)
+		if status, ok := driverActivityStatuses[ja.Status]; ok {
+			a.SetStatus(status)
+		} else if ja.Status != "" {
+			a.SetStatus(maponv1.DriverActivity_STATUS_UNRECOGNIZED)
+			a.SetUnrecognizedStatus(ja.Status)
+		}
+		if source, ok := driverActivitySources[ja.Source]; ok {
+			a.SetSource(source)
+		} else if ja.Source != "" {
+			a.SetSource(maponv1.DriverActivity_SOURCE_UNRECOGNIZED)
+			a.SetUnrecognizedSource(ja.Source)
+		}
+		a.SetUnitId(ja.UnitID)
+		activities = append(activities, a)
+	}
+	d.SetActivities(activities)
+	return d
+}
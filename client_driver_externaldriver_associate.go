@@ -0,0 +1,115 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/19-method-driver.html
+
+// AssociateExternalDriver associates external drivers, such as employees of an HR system, with Mapon drivers.
+// Failed associations are reported in the response results rather than as an error.
+func (c *Client) AssociateExternalDriver(
+	ctx context.Context,
+	request *maponv1.AssociateExternalDriverRequest,
+) (_ *maponv1.AssociateExternalDriverResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: associate external driver: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	for i, a := range request.GetAssociations() {
+		prefix := "associations[" + strconv.Itoa(i) + "]"
+		params.Add(prefix+"[driverId]", strconv.FormatInt(a.GetDriverId(), 10))
+		params.Add(prefix+"[employeeId]", a.GetEmployeeId())
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/driver/associate_external_driver.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonExternalDriverAssociationResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	results := make([]*maponv1.ExternalDriverAssociationResult, 0, len(responseBody.Data.Results))
+	for _, j := range responseBody.Data.Results {
+		r := &maponv1.ExternalDriverAssociationResult{}
+		r.SetDriverId(j.DriverID)
+		r.SetEmployeeId(j.EmployeeID)
+		r.SetCompanyId(j.CompanyID)
+		r.SetSuccess(j.Status == "success")
+		r.SetMessage(j.Message)
+		results = append(results, r)
+	}
+
+	resp := &maponv1.AssociateExternalDriverResponse{}
+	resp.SetTotal(responseBody.Data.Summary.Total)
+	resp.SetSuccessful(responseBody.Data.Summary.Successful)
+	resp.SetFailed(responseBody.Data.Summary.Failed)
+	resp.SetResults(results)
+	return resp, nil
+}
+
+type jsonExternalDriverAssociationResponse struct {
+	Data struct {
+		Summary struct {
+			Total      int32 `json:"total"`
+			Successful int32 `json:"successful"`
+			Failed     int32 `json:"failed"`
+		} `json:"summary"`
+		Results []struct {
+			Status     string `json:"status"` // "success" or "failed"
+			DriverID   int64  `json:"driverId"`
+			EmployeeID string `json:"employeeId"`
+			CompanyID  int64  `json:"companyId"`
+			Message    string `json:"message"`
+		} `json:"results"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -32,6 +32,9 @@ func (c *Client) ListDrivers(
 	if request.GetId() != 0 {
 		params.Add("id", strconv.FormatInt(request.GetId(), 10))
 	}
+	if request.GetIncludeCustomFields() {
+		params.Add("include", "fields")
+	}
 
 	requestURL, err := url.Parse(c.baseURL + "/driver/list.json")
 	if err != nil {
@@ -70,8 +73,14 @@ func (c *Client) ListDrivers(
 	}
 
 	drivers := make([]*maponv1.Driver, 0, len(responseBody.Data.Drivers))
-	for _, d := range responseBody.Data.Drivers {
-		drivers = append(drivers, mapJSONDriverToProto(d))
+	for _, j := range responseBody.Data.Drivers {
+		d := mapJSONDriverToProto(j)
+		customFields, err := parseCustomFieldValues(j.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("driver %d custom fields: %w", j.ID, err)
+		}
+		d.SetCustomFields(customFields)
+		drivers = append(drivers, d)
 	}
 
 	resp := &maponv1.ListDriversResponse{}
@@ -87,15 +96,18 @@ type jsonDriverResponse struct {
 }
 
 type jsonDriver struct {
-	ID      int64  `json:"id"`
-	Name    string `json:"name"`
-	Surname string `json:"surname"`
-	Email   string `json:"email"`
-	Phone   string `json:"phone"`
-	IButton string `json:"ibutton"`
-	Tacho   string `json:"tacho"`
-	Blocked bool   `json:"blocked"`
-	Created string `json:"created"` // "2016-08-10 12:50:56"
+	ID                 int64           `json:"id"`
+	Name               string          `json:"name"`
+	Surname            string          `json:"surname"`
+	Email              string          `json:"email"`
+	Phone              string          `json:"phone"`
+	Language           string          `json:"language"`
+	IButton            string          `json:"ibutton"`
+	Tacho              string          `json:"tacho"`
+	AccessToRouteSheet interface{}     `json:"access_to_route_sheet"` // Number or bool
+	Blocked            bool            `json:"blocked"`
+	Created            string          `json:"created"` // "2016-08-10 12:50:56"
+	Fields             json.RawMessage `json:"fields"`
 }
 
 func mapJSONDriverToProto(j jsonDriver) *maponv1.Driver {
@@ -107,6 +119,13 @@ func mapJSONDriverToProto(j jsonDriver) *maponv1.Driver {
 	d.SetPhone(j.Phone)
 	d.SetIbuttonValue(j.IButton)
 	d.SetTachographId(j.Tacho)
+	d.SetLanguage(j.Language)
+	switch v := j.AccessToRouteSheet.(type) {
+	case bool:
+		d.SetAccessToRouteSheet(v)
+	case float64:
+		d.SetAccessToRouteSheet(v != 0)
+	}
 	d.SetBlocked(j.Blocked)
 
 	// Time format "2006-01-02 15:04:05"
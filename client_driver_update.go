@@ -0,0 +1,124 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/19-method-driver.html
+
+// UpdateDriver updates the fields of a driver that are set in the request.
+func (c *Client) UpdateDriver(
+	ctx context.Context,
+	request *maponv1.UpdateDriverRequest,
+) (_ *maponv1.UpdateDriverResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: update driver: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("driver_id", strconv.FormatInt(request.GetDriverId(), 10))
+	if request.HasName() {
+		params.Add("name", request.GetName())
+	}
+	if request.HasSurname() {
+		params.Add("surname", request.GetSurname())
+	}
+	if request.HasEmail() {
+		params.Add("email", request.GetEmail())
+	}
+	if request.HasPhone() {
+		params.Add("phone", request.GetPhone())
+	}
+	if request.HasLanguage() {
+		params.Add("lang", request.GetLanguage())
+	}
+	if request.HasIbuttonValue() {
+		params.Add("ibutton", request.GetIbuttonValue())
+	}
+	if request.HasTachographId() {
+		params.Add("tacho", request.GetTachographId())
+	}
+	if request.HasAccessToRouteSheet() {
+		params.Add("access_to_route_sheet", formatBoolInt(request.GetAccessToRouteSheet()))
+	}
+	if request.HasDriverlinkAccess() {
+		params.Add("driverlink_access", formatBoolInt(request.GetDriverlinkAccess()))
+	}
+	if request.HasBlocked() {
+		params.Add("blocked", formatBoolInt(request.GetBlocked()))
+	}
+	if request.HasBlockedReason() {
+		params.Add("blocked_text", request.GetBlockedReason())
+	}
+	if request.HasDepotId() {
+		params.Add("depot", formatOptionalID(request.GetDepotId()))
+	}
+	if request.HasUnitId() {
+		params.Add("unit", formatOptionalID(request.GetUnitId()))
+	}
+	if request.HasAccessAllUnits() {
+		params.Add("access_all_units", formatBoolInt(request.GetAccessAllUnits()))
+	}
+	if request.HasAccessibleUnitIds() {
+		params.Add("unit_ids", joinInt64s(request.GetAccessibleUnitIds().GetIds()))
+	}
+	if request.HasAccessibleUnitGroupIds() {
+		params.Add("unit_group_ids", joinInt64s(request.GetAccessibleUnitGroupIds().GetIds()))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/driver/update.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDriverStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	return &maponv1.UpdateDriverResponse{}, nil
+}
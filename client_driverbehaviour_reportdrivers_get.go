@@ -0,0 +1,111 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/26-method-driver_behaviour.html
+
+// GetDriverBehaviourReportDrivers returns the driver behaviour results of drivers for a period of up to 31 days.
+func (c *Client) GetDriverBehaviourReportDrivers(
+	ctx context.Context,
+	request *maponv1.GetDriverBehaviourReportDriversRequest,
+) (_ *maponv1.GetDriverBehaviourReportDriversResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get driver behaviour report of drivers: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("date_from", request.GetFromDate())
+	params.Add("date_till", request.GetToDate())
+	if request.GetDepotId() != 0 {
+		params.Add("depot_id", strconv.FormatInt(request.GetDepotId(), 10))
+	}
+	if request.GetGroupId() != 0 {
+		params.Add("group_id", strconv.FormatInt(request.GetGroupId(), 10))
+	}
+	if len(request.GetDriverIds()) > 0 {
+		params.Add("driver_ids", joinInt64s(request.GetDriverIds()))
+	}
+	if len(request.GetMetrics()) > 0 {
+		metrics, err := formatDriverBehaviourMetrics(request.GetMetrics())
+		if err != nil {
+			return nil, err
+		}
+		params.Add("metrics", metrics)
+	}
+	if request.GetPage() > 0 {
+		params.Add("page", strconv.Itoa(int(request.GetPage())))
+	}
+	if request.GetPerPage() > 0 {
+		params.Add("per_page", strconv.Itoa(int(request.GetPerPage())))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/driver_behaviour/report_drivers.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDriverBehaviourReportDriversResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	drivers := make([]*maponv1.DriverBehaviourDriverReport, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		d := &maponv1.DriverBehaviourDriverReport{}
+		d.SetDriverId(j.UserID)
+		d.SetName(j.Title)
+		d.SetUnitIds(j.DrivenUnitsIDs)
+		d.SetResult(mapJSONDriverBehaviourResultToProto(&j.jsonDriverBehaviourResult))
+		drivers = append(drivers, d)
+	}
+
+	resp := &maponv1.GetDriverBehaviourReportDriversResponse{}
+	resp.SetDrivers(drivers)
+	resp.SetPagination(mapJSONPageMetaToProto(responseBody.Meta))
+	return resp, nil
+}
+
+type jsonDriverBehaviourReportDriversResponse struct {
+	Data  []jsonDriverBehaviourDriverReport `json:"data"`
+	Meta  jsonPageMeta                      `json:"_meta"`
+	Error *jsonError                        `json:"error"`
+}
@@ -0,0 +1,93 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/12-method-driver_groups.html
+
+// ListDriverGroups lists driver groups.
+func (c *Client) ListDriverGroups(
+	ctx context.Context,
+	request *maponv1.ListDriverGroupsRequest,
+) (_ *maponv1.ListDriverGroupsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list driver groups: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.GetDriverId() != 0 {
+		params.Add("driver", strconv.FormatInt(request.GetDriverId(), 10))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/driver_groups/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDriverGroupsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	groups := make([]*maponv1.DriverGroup, 0, len(responseBody.Data))
+	for _, g := range responseBody.Data {
+		grp := &maponv1.DriverGroup{}
+		grp.SetGroupId(g.ID)
+		grp.SetName(g.Name)
+		if parentID, err := parseFloat(g.ParentID); err == nil {
+			grp.SetParentId(int64(parentID))
+		}
+		groups = append(groups, grp)
+	}
+
+	resp := &maponv1.ListDriverGroupsResponse{}
+	resp.SetGroups(groups)
+	return resp, nil
+}
+
+type jsonDriverGroupsResponse struct {
+	Data []struct {
+		ID       int64       `json:"id"`
+		Name     string      `json:"name"`
+		ParentID interface{} `json:"parent_id"` // Number, string or null
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,85 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/12-method-driver_groups.html
+
+// ListDriversInGroup lists drivers in a group.
+func (c *Client) ListDriversInGroup(
+	ctx context.Context,
+	request *maponv1.ListDriversInGroupRequest,
+) (_ *maponv1.ListDriversInGroupResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list drivers in group: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("id", strconv.FormatInt(request.GetGroupId(), 10))
+
+	requestURL, err := url.Parse(c.baseURL + "/driver_groups/list_drivers.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDriverGroupDriversResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	driverIDs := make([]int64, 0, len(responseBody.Data.Drivers))
+	for _, d := range responseBody.Data.Drivers {
+		driverIDs = append(driverIDs, d.ID)
+	}
+
+	resp := &maponv1.ListDriversInGroupResponse{}
+	resp.SetDriverIds(driverIDs)
+	return resp, nil
+}
+
+type jsonDriverGroupDriversResponse struct {
+	Data struct {
+		Drivers []struct {
+			ID int64 `json:"id"`
+		} `json:"drivers"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,92 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/12-method-driver_groups.html
+
+// SaveDriverGroup creates a driver group, or updates it when a group ID is set.
+func (c *Client) SaveDriverGroup(
+	ctx context.Context,
+	request *maponv1.SaveDriverGroupRequest,
+) (_ *maponv1.SaveDriverGroupResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: save driver group: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	if request.GetGroupId() != 0 {
+		params.Add("id", strconv.FormatInt(request.GetGroupId(), 10))
+	}
+	params.Add("name", request.GetName())
+	if request.GetParentId() != 0 {
+		params.Add("parent_id", strconv.FormatInt(request.GetParentId(), 10))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/driver_groups/save.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDriverGroupSaveResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.SaveDriverGroupResponse{}
+	resp.SetGroupId(responseBody.Data.GroupID)
+	return resp, nil
+}
+
+type jsonDriverGroupSaveResponse struct {
+	Data struct {
+		Status  string `json:"status"`
+		GroupID int64  `json:"group_id"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
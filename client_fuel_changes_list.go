@@ -0,0 +1,148 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/14-method-fuel.html
+
+// ListFuelChanges returns refuel and fuel drain events for units in the specified period.
+func (c *Client) ListFuelChanges(
+	ctx context.Context,
+	request *maponv1.ListFuelChangesRequest,
+) (_ *maponv1.ListFuelChangesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list fuel changes: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("from", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	params.Add("till", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+	for _, id := range request.GetUnitIds() {
+		params.Add("unit_id[]", strconv.FormatInt(id, 10))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/fuel/changes.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonFuelChangesResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	units := make([]*maponv1.UnitFuelChanges, 0, len(responseBody.Data))
+	for _, u := range responseBody.Data {
+		changes := make([]*maponv1.FuelChange, 0, len(u.Sensor)+len(u.Can))
+		for _, fc := range u.Sensor {
+			changes = append(changes, mapJSONFuelChangeToProto(fc, maponv1.FuelDataSource_FUEL_DATA_SOURCE_SENSOR))
+		}
+		for _, fc := range u.Can {
+			changes = append(changes, mapJSONFuelChangeToProto(fc, maponv1.FuelDataSource_FUEL_DATA_SOURCE_CAN))
+		}
+		slices.SortStableFunc(changes, func(a, b *maponv1.FuelChange) int {
+			return a.GetTime().AsTime().Compare(b.GetTime().AsTime())
+		})
+
+		unit := &maponv1.UnitFuelChanges{}
+		unit.SetUnitId(u.UnitID)
+		unit.SetNumber(u.Number)
+		unit.SetFuelConsumptionMeasurement(u.FuelConsumptionMeasurement)
+		unit.SetChanges(changes)
+		units = append(units, unit)
+	}
+
+	resp := &maponv1.ListFuelChangesResponse{}
+	resp.SetUnits(units)
+	return resp, nil
+}
+
+type jsonFuelChangesResponse struct {
+	Data []struct {
+		UnitID                     int64            `json:"unit_id"`
+		Number                     string           `json:"number"`
+		FuelConsumptionMeasurement string           `json:"fuel_consumption_measurement"`
+		Sensor                     []jsonFuelChange `json:"sensor"`
+		Can                        []jsonFuelChange `json:"can"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+type jsonFuelChange struct {
+	FuelChange float64 `json:"fuel_change"` // Positive for refuels, negative for drains
+	FuelBefore float64 `json:"fuel_before"`
+	Lat        float64 `json:"lat"`
+	Lng        float64 `json:"lng"`
+	GMT        string  `json:"gmt"`
+	Driver     string  `json:"driver"`
+	DriverID   int64   `json:"driver_id"`
+	Address    string  `json:"address"`
+}
+
+func mapJSONFuelChangeToProto(j jsonFuelChange, source maponv1.FuelDataSource) *maponv1.FuelChange {
+	fc := &maponv1.FuelChange{}
+	if j.FuelChange < 0 {
+		fc.SetType(maponv1.FuelChange_TYPE_DRAIN)
+	} else {
+		fc.SetType(maponv1.FuelChange_TYPE_REFUEL)
+	}
+	fc.SetSource(source)
+	fc.SetVolume(math.Abs(j.FuelChange))
+	fc.SetLevelBefore(j.FuelBefore)
+
+	if t, err := time.Parse(time.RFC3339, j.GMT); err == nil {
+		fc.SetTime(timestamppb.New(t))
+	}
+
+	loc := &maponv1.Location{}
+	loc.SetLatitude(j.Lat)
+	loc.SetLongitude(j.Lng)
+	loc.SetAddress(j.Address)
+	fc.SetLocation(loc)
+
+	fc.SetDriverId(j.DriverID)
+	fc.SetDriverName(j.Driver)
+	return fc
+}
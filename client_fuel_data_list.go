@@ -0,0 +1,152 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/14-method-fuel.html
+
+// ListFuelData returns fuel levels and flow rates for a unit in the specified period.
+func (c *Client) ListFuelData(
+	ctx context.Context,
+	request *maponv1.ListFuelDataRequest,
+) (_ *maponv1.ListFuelDataResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list fuel data: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+	params.Add("from", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	params.Add("till", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+	for _, source := range request.GetDataSources() {
+		value, ok := fuelDataSourceValues[source]
+		if !ok {
+			return nil, fmt.Errorf("unsupported data source: %v", source)
+		}
+		params.Add("data_source[]", value)
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/fuel/data.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonFuelDataResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	d := responseBody.Data
+	fuelData := &maponv1.UnitFuelData{}
+	fuelData.SetUnitId(d.UnitID)
+	fuelData.SetFuelConsumptionMeasurement(d.FuelConsumptionMeasurement)
+	if d.Sensor != nil {
+		fuelData.SetSensorTotal(mapJSONFuelValuesToProto(d.Sensor.Total.Values))
+		tanks := make([]*maponv1.FuelTankValues, 0, len(d.Sensor.Tanks))
+		for _, t := range d.Sensor.Tanks {
+			tank := &maponv1.FuelTankValues{}
+			tank.SetTank(t.Tank)
+			tank.SetValues(mapJSONFuelValuesToProto(t.Values))
+			tanks = append(tanks, tank)
+		}
+		fuelData.SetSensorTanks(tanks)
+	}
+	if d.Can != nil {
+		fuelData.SetCan(mapJSONFuelValuesToProto(d.Can.Values))
+	}
+	if d.Flow != nil {
+		fuelData.SetFlow(mapJSONFuelValuesToProto(d.Flow.Values))
+	}
+
+	resp := &maponv1.ListFuelDataResponse{}
+	resp.SetData(fuelData)
+	return resp, nil
+}
+
+// fuelDataSourceValues maps fuel data sources to their Mapon API values.
+var fuelDataSourceValues = map[maponv1.FuelDataSource]string{
+	maponv1.FuelDataSource_FUEL_DATA_SOURCE_SENSOR: "sensor",
+	maponv1.FuelDataSource_FUEL_DATA_SOURCE_CAN:    "can",
+	maponv1.FuelDataSource_FUEL_DATA_SOURCE_FLOW:   "flow",
+}
+
+type jsonFuelDataResponse struct {
+	Data struct {
+		UnitID                     int64  `json:"unit_id"`
+		FuelConsumptionMeasurement string `json:"fuel_consumption_measurement"`
+		Sensor                     *struct {
+			Total struct {
+				Values []jsonFuelValue `json:"values"`
+			} `json:"total"`
+			Tanks []struct {
+				Tank   int32           `json:"tank"`
+				Values []jsonFuelValue `json:"values"`
+			} `json:"tanks"`
+		} `json:"sensor"`
+		Can *struct {
+			Values []jsonFuelValue `json:"values"`
+		} `json:"can"`
+		Flow *struct {
+			Values []jsonFuelValue `json:"values"`
+		} `json:"flow"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+type jsonFuelValue struct {
+	GMT   string  `json:"gmt"`
+	Value float64 `json:"value"`
+}
+
+func mapJSONFuelValuesToProto(values []jsonFuelValue) []*maponv1.FuelValue {
+	result := make([]*maponv1.FuelValue, 0, len(values))
+	for _, v := range values {
+		fv := &maponv1.FuelValue{}
+		if t, err := time.Parse(time.RFC3339, v.GMT); err == nil {
+			fv.SetTime(timestamppb.New(t))
+		}
+		fv.SetValue(v.Value)
+		result = append(result, fv)
+	}
+	return result
+}
@@ -0,0 +1,122 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/14-method-fuel.html
+
+// GetFuelSummary returns fuel totals for units in the specified period.
+func (c *Client) GetFuelSummary(
+	ctx context.Context,
+	request *maponv1.GetFuelSummaryRequest,
+) (_ *maponv1.GetFuelSummaryResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get fuel summary: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("from", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	params.Add("till", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+	for _, id := range request.GetUnitIds() {
+		params.Add("unit_id[]", strconv.FormatInt(id, 10))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/fuel/summary.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonFuelSummaryResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	units := make([]*maponv1.FuelSummary, 0, len(responseBody.Data))
+	for _, u := range responseBody.Data {
+		summary := &maponv1.FuelSummary{}
+		summary.SetUnitId(u.UnitID)
+		summary.SetFuelConsumptionMeasurement(u.FuelConsumptionMeasurement)
+		if u.Sensor != nil {
+			summary.SetSensor(mapJSONFuelSummaryValuesToProto(u.Sensor))
+		}
+		if u.Can != nil {
+			summary.SetCan(mapJSONFuelSummaryValuesToProto(u.Can))
+		}
+		units = append(units, summary)
+	}
+
+	resp := &maponv1.GetFuelSummaryResponse{}
+	resp.SetUnits(units)
+	return resp, nil
+}
+
+type jsonFuelSummaryResponse struct {
+	Data []struct {
+		UnitID                     int64                  `json:"unit_id"`
+		FuelConsumptionMeasurement string                 `json:"fuel_consumption_measurement"`
+		Sensor                     *jsonFuelSummaryValues `json:"sensor"`
+		Can                        *jsonFuelSummaryValues `json:"can"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+type jsonFuelSummaryValues struct {
+	Start              float64 `json:"start"`
+	End                float64 `json:"end"`
+	TotalConsumed      float64 `json:"total_consumed"`
+	Fueled             float64 `json:"fueled"`
+	Drained            float64 `json:"drained"`
+	AvgConsumption     float64 `json:"avg_consumption"`
+	AvgConsumptionType string  `json:"avg_consumption_type"`
+}
+
+func mapJSONFuelSummaryValuesToProto(j *jsonFuelSummaryValues) *maponv1.FuelSummaryValues {
+	v := &maponv1.FuelSummaryValues{}
+	v.SetStartLevel(j.Start)
+	v.SetEndLevel(j.End)
+	v.SetTotalConsumed(j.TotalConsumed)
+	v.SetFueled(j.Fueled)
+	v.SetDrained(j.Drained)
+	v.SetAvgConsumption(j.AvgConsumption)
+	v.SetAvgConsumptionType(j.AvgConsumptionType)
+	return v
+}
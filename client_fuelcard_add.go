@@ -0,0 +1,83 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/15-method-fuel_check.html
+
+// AddFuelCard creates a new fuel card.
+func (c *Client) AddFuelCard(
+	ctx context.Context,
+	request *maponv1.AddFuelCardRequest,
+) (_ *maponv1.AddFuelCardResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: add fuel card: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("card_id", request.GetCardId())
+	if request.GetCardName() != "" {
+		params.Add("card_name", request.GetCardName())
+	}
+	if request.GetUnitId() != 0 {
+		params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+	}
+	requestURL, err := url.Parse(c.baseURL + "/fuel_check/add_card.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonFuelCheckSaveResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.AddFuelCardResponse{}
+	resp.SetFuelCardId(responseBody.Data.ID)
+	return resp, nil
+}
@@ -0,0 +1,108 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/15-method-fuel_check.html
+
+// fuelCheckTimeLayout is the datetime format of fuel check dates in requests ("Y-m-dTH:i:s" in UTC).
+const fuelCheckTimeLayout = "2006-01-02T15:04:05"
+
+// AddFuelCheck adds a new fuel check.
+func (c *Client) AddFuelCheck(
+	ctx context.Context,
+	request *maponv1.AddFuelCheckRequest,
+) (_ *maponv1.AddFuelCheckResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: add fuel check: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("date", request.GetTime().AsTime().UTC().Format(fuelCheckTimeLayout))
+	params.Add("fuel_station", request.GetFuelStation())
+	params.Add("fuel_type", request.GetFuelType())
+	params.Add("liters", strconv.FormatFloat(request.GetVolume(), 'f', -1, 64))
+	params.Add("amount", strconv.FormatFloat(request.GetTotalAmount(), 'f', -1, 64))
+	if request.GetUnitId() != 0 {
+		params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+	}
+	if request.GetCardId() != "" {
+		params.Add("card_id", request.GetCardId())
+	}
+	if request.GetCardName() != "" {
+		params.Add("card_name", request.GetCardName())
+	}
+	if request.GetCheckNumber() != "" {
+		params.Add("check_no", request.GetCheckNumber())
+	}
+	if request.GetCurrency() != "" {
+		params.Add("currency", request.GetCurrency())
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/fuel_check/add.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonFuelCheckSaveResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.AddFuelCheckResponse{}
+	resp.SetCheckId(responseBody.Data.ID)
+	return resp, nil
+}
+
+type jsonFuelCheckSaveResponse struct {
+	Data struct {
+		Status string `json:"status"`
+		ID     int64  `json:"id"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,107 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/15-method-fuel_check.html
+
+// EditFuelCheck updates an existing fuel check. Only the fields that are set on the request are updated.
+// Fuel checks created by an integration only allow changing the unit and, in some cases, the card name.
+func (c *Client) EditFuelCheck(
+	ctx context.Context,
+	request *maponv1.EditFuelCheckRequest,
+) (_ *maponv1.EditFuelCheckResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: edit fuel check: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("id", strconv.FormatInt(request.GetCheckId(), 10))
+	if request.HasTime() {
+		params.Add("date", request.GetTime().AsTime().UTC().Format(fuelCheckTimeLayout))
+	}
+	if request.HasFuelStation() {
+		params.Add("fuel_station", request.GetFuelStation())
+	}
+	if request.HasFuelType() {
+		params.Add("fuel_type", request.GetFuelType())
+	}
+	if request.HasUnitId() {
+		params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+	}
+	if request.HasCardId() {
+		params.Add("card_id", request.GetCardId())
+	}
+	if request.HasCardName() {
+		params.Add("card_name", request.GetCardName())
+	}
+	if request.HasCheckNumber() {
+		params.Add("check_no", request.GetCheckNumber())
+	}
+	if request.HasVolume() {
+		params.Add("liters", strconv.FormatFloat(request.GetVolume(), 'f', -1, 64))
+	}
+	if request.HasTotalAmount() {
+		params.Add("amount", strconv.FormatFloat(request.GetTotalAmount(), 'f', -1, 64))
+	}
+	if request.HasCurrency() {
+		params.Add("currency", request.GetCurrency())
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/fuel_check/edit.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonFuelCheckSaveResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	return &maponv1.EditFuelCheckResponse{}, nil
+}
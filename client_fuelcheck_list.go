@@ -0,0 +1,152 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/15-method-fuel_check.html
+
+// ListFuelChecks returns fuel checks in the specified period.
+func (c *Client) ListFuelChecks(
+	ctx context.Context,
+	request *maponv1.ListFuelChecksRequest,
+) (_ *maponv1.ListFuelChecksResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list fuel checks: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("from", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	params.Add("till", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+	for _, id := range request.GetUnitIds() {
+		params.Add("unit_id[]", strconv.FormatInt(id, 10))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/fuel_check/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonFuelCheckListResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	checks := make([]*maponv1.FuelCheck, 0, len(responseBody.Data.Checks))
+	for _, fc := range responseBody.Data.Checks {
+		checks = append(checks, mapJSONFuelCheckToProto(fc))
+	}
+
+	resp := &maponv1.ListFuelChecksResponse{}
+	resp.SetChecks(checks)
+	return resp, nil
+}
+
+type jsonFuelCheckListResponse struct {
+	Data struct {
+		Checks []jsonFuelCheck `json:"checks"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+type jsonFuelCheck struct {
+	ID          int64  `json:"id"`
+	UnitID      int64  `json:"unit_id"`
+	CardID      string `json:"card_id"`
+	CardName    string `json:"card_name"`
+	GMT         string `json:"gmt"` // "2018-07-24 13:00:00"
+	CheckNo     string `json:"check_no"`
+	FuelStation string `json:"fuel_station"`
+	FuelType    string `json:"fuel_type"`
+	Liters      string `json:"liters"`    // API returns string "20.000000"
+	TotalSum    string `json:"total_sum"` // API returns string "120.000000"
+	Currency    string `json:"currency"`
+	Location    struct {
+		Place       string `json:"place"`
+		Coordinates struct {
+			Lat float64 `json:"lat"`
+			Lng float64 `json:"lng"`
+		} `json:"coordinates"`
+		Object struct {
+			ID   *int64  `json:"id"`
+			Name *string `json:"name"`
+		} `json:"object"`
+	} `json:"location"`
+	OdometerGPS int64 `json:"odometer_gps"`
+	OdometerCAN int64 `json:"odometer_can"`
+}
+
+func mapJSONFuelCheckToProto(j jsonFuelCheck) *maponv1.FuelCheck {
+	fc := &maponv1.FuelCheck{}
+	fc.SetCheckId(j.ID)
+	fc.SetUnitId(j.UnitID)
+	fc.SetCardId(j.CardID)
+	fc.SetCardName(j.CardName)
+	if t, err := time.Parse("2006-01-02 15:04:05", j.GMT); err == nil {
+		fc.SetTime(timestamppb.New(t))
+	}
+	fc.SetCheckNumber(j.CheckNo)
+	fc.SetFuelStation(j.FuelStation)
+	fc.SetFuelType(j.FuelType)
+	volume, _ := strconv.ParseFloat(j.Liters, 64)
+	fc.SetVolume(volume)
+	totalAmount, _ := strconv.ParseFloat(j.TotalSum, 64)
+	fc.SetTotalAmount(totalAmount)
+	fc.SetCurrency(j.Currency)
+
+	loc := &maponv1.Location{}
+	loc.SetLatitude(j.Location.Coordinates.Lat)
+	loc.SetLongitude(j.Location.Coordinates.Lng)
+	loc.SetAddress(j.Location.Place)
+	fc.SetLocation(loc)
+
+	if j.Location.Object.ID != nil {
+		fc.SetObjectId(*j.Location.Object.ID)
+	}
+	if j.Location.Object.Name != nil {
+		fc.SetObjectName(*j.Location.Object.Name)
+	}
+
+	fc.SetOdometerGpsM(j.OdometerGPS)
+	fc.SetOdometerCanM(j.OdometerCAN)
+	return fc
+}
@@ -0,0 +1,81 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/23-method-messaging_channels.html
+
+// ListMessagingChannels lists the messaging channels of a user.
+func (c *Client) ListMessagingChannels(
+	ctx context.Context,
+	request *maponv1.ListMessagingChannelsRequest,
+) (_ *maponv1.ListMessagingChannelsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list messaging channels: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("user_id", strconv.FormatInt(request.GetUserId(), 10))
+
+	requestURL, err := url.Parse(c.baseURL + "/messaging_channels/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonMessagingChannelsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	channels := make([]*maponv1.MessagingChannel, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		channels = append(channels, mapJSONMessagingChannelToProto(&j))
+	}
+
+	resp := &maponv1.ListMessagingChannelsResponse{}
+	resp.SetChannels(channels)
+	return resp, nil
+}
+
+type jsonMessagingChannelsResponse struct {
+	Data  []jsonMessagingChannel `json:"data"`
+	Error *jsonError             `json:"error"`
+}
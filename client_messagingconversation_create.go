@@ -0,0 +1,129 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/24-method-messaging_conversations.html
+
+// CreateMessagingConversation creates a conversation with users or units, starting with a first message.
+// If a conversation with a receiver already exists, the existing conversation is returned.
+func (c *Client) CreateMessagingConversation(
+	ctx context.Context,
+	request *maponv1.CreateMessagingConversationRequest,
+) (_ *maponv1.CreateMessagingConversationResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: create messaging conversation: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("user_id", strconv.FormatInt(request.GetUserId(), 10))
+	if request.GetChannelId() != 0 {
+		params.Add("channel_id", strconv.FormatInt(request.GetChannelId(), 10))
+	}
+	if request.GetGroupTitle() != "" {
+		params.Add("group_title", request.GetGroupTitle())
+	}
+	medium, err := formatMessagingMedium(request.GetMedium())
+	if err != nil {
+		return nil, err
+	}
+	params.Add("medium", medium)
+	conversationType := "individual"
+	if request.GetType() != maponv1.MessagingConversation_TYPE_UNSPECIFIED {
+		if conversationType, err = formatMessagingConversationType(request.GetType()); err != nil {
+			return nil, err
+		}
+	}
+	params.Add("type", conversationType)
+	for i, receiver := range request.GetReceivers() {
+		prefix := "receivers[" + strconv.Itoa(i) + "]"
+		switch {
+		case receiver.GetUserId() != 0 && receiver.GetUnitId() != 0:
+			return nil, fmt.Errorf("receiver %d: both user and unit set", i)
+		case receiver.GetUserId() != 0:
+			params.Add(prefix+"[type]", "user")
+			params.Add(prefix+"[id]", strconv.FormatInt(receiver.GetUserId(), 10))
+		case receiver.GetUnitId() != 0:
+			params.Add(prefix+"[type]", "car")
+			params.Add(prefix+"[id]", strconv.FormatInt(receiver.GetUnitId(), 10))
+		default:
+			return nil, fmt.Errorf("receiver %d: user or unit required", i)
+		}
+	}
+	if err := addMessageParams(
+		params, "message_", request.GetText(), request.GetLocation(), request.GetAttachments(),
+	); err != nil {
+		return nil, err
+	}
+	body, contentType, err := newMessageRequestBody(params, "message_attachments", request.GetAttachments())
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/messaging_conversations/create.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		body,
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", contentType)
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonMessagingConversationsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	conversations := make([]*maponv1.MessagingConversation, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		conversation, err := mapJSONMessagingConversationToProto(&j)
+		if err != nil {
+			return nil, err
+		}
+		conversations = append(conversations, conversation)
+	}
+
+	resp := &maponv1.CreateMessagingConversationResponse{}
+	resp.SetConversations(conversations)
+	return resp, nil
+}
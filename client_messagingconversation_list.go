@@ -0,0 +1,101 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/24-method-messaging_conversations.html
+
+// ListMessagingConversations lists the messaging conversations of a user.
+func (c *Client) ListMessagingConversations(
+	ctx context.Context,
+	request *maponv1.ListMessagingConversationsRequest,
+) (_ *maponv1.ListMessagingConversationsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list messaging conversations: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("user_id", strconv.FormatInt(request.GetUserId(), 10))
+	medium, err := formatMessagingMedium(request.GetMedium())
+	if err != nil {
+		return nil, err
+	}
+	params.Add("medium", medium)
+	if request.GetBelongsToChannel() {
+		params.Add("belongs_to_channel", "1")
+	}
+	if request.GetChannelId() != 0 {
+		params.Add("channel_id", strconv.FormatInt(request.GetChannelId(), 10))
+	}
+	if len(request.GetConversationIds()) > 0 {
+		params.Add("ids", joinInt64s(request.GetConversationIds()))
+	}
+	if request.GetType() != maponv1.MessagingConversation_TYPE_UNSPECIFIED {
+		conversationType, err := formatMessagingConversationType(request.GetType())
+		if err != nil {
+			return nil, err
+		}
+		params.Add("type", conversationType)
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/messaging_conversations/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonMessagingConversationsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	conversations := make([]*maponv1.MessagingConversation, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		conversation, err := mapJSONMessagingConversationToProto(&j)
+		if err != nil {
+			return nil, err
+		}
+		conversations = append(conversations, conversation)
+	}
+
+	resp := &maponv1.ListMessagingConversationsResponse{}
+	resp.SetConversations(conversations)
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/25-method-messaging_messages.html
+
+// ListMessages lists the messages of a conversation.
+// Use before_message_id and after_message_id to page through older messages or poll for newer ones.
+func (c *Client) ListMessages(
+	ctx context.Context,
+	request *maponv1.ListMessagesRequest,
+) (_ *maponv1.ListMessagesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list messages: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("user_id", strconv.FormatInt(request.GetUserId(), 10))
+	params.Add("conversation_id", strconv.FormatInt(request.GetConversationId(), 10))
+	switch {
+	case request.GetBeforeMessageId() != 0 && request.GetAfterMessageId() != 0:
+		return nil, fmt.Errorf("before and after message ID are mutually exclusive")
+	case request.GetBeforeMessageId() != 0:
+		params.Add("cursor", strconv.FormatInt(request.GetBeforeMessageId(), 10))
+		params.Add("cursor_direction", "prev")
+	case request.GetAfterMessageId() != 0:
+		params.Add("cursor", strconv.FormatInt(request.GetAfterMessageId(), 10))
+		params.Add("cursor_direction", "next")
+	}
+	if request.GetLimit() > 0 {
+		params.Add("limit", strconv.Itoa(int(request.GetLimit())))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/messaging_messages/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonMessagesResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	messages := make([]*maponv1.Message, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		messages = append(messages, mapJSONMessageToProto(&j))
+	}
+
+	resp := &maponv1.ListMessagesResponse{}
+	resp.SetMessages(messages)
+	return resp, nil
+}
@@ -0,0 +1,89 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/25-method-messaging_messages.html
+
+// SendMessage sends a message to an existing conversation.
+func (c *Client) SendMessage(
+	ctx context.Context,
+	request *maponv1.SendMessageRequest,
+) (_ *maponv1.SendMessageResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: send message: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("user_id", strconv.FormatInt(request.GetUserId(), 10))
+	params.Add("conversation_id", strconv.FormatInt(request.GetConversationId(), 10))
+	if err := addMessageParams(
+		params, "", request.GetText(), request.GetLocation(), request.GetAttachments(),
+	); err != nil {
+		return nil, err
+	}
+	body, contentType, err := newMessageRequestBody(params, "attachments", request.GetAttachments())
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/messaging_messages/send.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		body,
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", contentType)
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonMessagesResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.SendMessageResponse{}
+	if len(responseBody.Data) > 0 {
+		resp.SetMessage(mapJSONMessageToProto(&responseBody.Data[0]))
+	}
+	return resp, nil
+}
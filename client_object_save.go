@@ -0,0 +1,114 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/16-method-object.html
+
+// SaveObject creates a geofence object, or updates it when an object ID is set.
+// The WKT geometry is validated before the request is sent.
+func (c *Client) SaveObject(
+	ctx context.Context,
+	request *maponv1.SaveObjectRequest,
+) (_ *maponv1.SaveObjectResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: save object: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	if err := validateWKT(request.GetWkt(), request.GetWktLonFirst()); err != nil {
+		return nil, err
+	}
+	if request.GetObjectId() != 0 {
+		params.Add("id", strconv.FormatInt(request.GetObjectId(), 10))
+	}
+	params.Add("name", request.GetName())
+	params.Add("wkt", request.GetWkt())
+	if request.GetWktLonFirst() {
+		params.Add("wkt_lon_first", "1")
+	}
+	if request.GetGroupId() != 0 {
+		params.Add("group_id", strconv.FormatInt(request.GetGroupId(), 10))
+	}
+	if request.GetUserId() != 0 {
+		params.Add("user_id", strconv.FormatInt(request.GetUserId(), 10))
+	}
+	if request.HasIsPrivate() {
+		params.Add("private", formatYesNo(request.GetIsPrivate()))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/object/save.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonObjectSaveResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.SaveObjectResponse{}
+	resp.SetObjectId(responseBody.Data.ID)
+	return resp, nil
+}
+
+type jsonObjectSaveResponse struct {
+	Data struct {
+		Status string `json:"status"`
+		ID     int64  `json:"id"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+// formatYesNo returns "Y" for true and "N" for false.
+func formatYesNo(b bool) string {
+	if b {
+		return "Y"
+	}
+	return "N"
+}
@@ -0,0 +1,101 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/16-method-object.html
+
+// ListObjectGroups lists the object groups.
+func (c *Client) ListObjectGroups(
+	ctx context.Context,
+	request *maponv1.ListObjectGroupsRequest,
+) (_ *maponv1.ListObjectGroupsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list object groups: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.GetGroupId() != 0 {
+		params.Add("id", strconv.FormatInt(request.GetGroupId(), 10))
+	}
+	if request.GetName() != "" {
+		params.Add("name", request.GetName())
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/object/list_groups.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonObjectGroupsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	groups := make([]*maponv1.ObjectGroup, 0, len(responseBody.Data.Groups))
+	for _, j := range responseBody.Data.Groups {
+		userID, _ := strconv.ParseInt(j.UserID, 10, 64)
+		g := &maponv1.ObjectGroup{}
+		g.SetGroupId(j.ID)
+		g.SetName(j.Name)
+		g.SetUserId(userID)
+		g.SetIsPrivate(j.Private == "Y")
+		g.SetColorHex(j.Color)
+		groups = append(groups, g)
+	}
+
+	resp := &maponv1.ListObjectGroupsResponse{}
+	resp.SetGroups(groups)
+	return resp, nil
+}
+
+type jsonObjectGroupsResponse struct {
+	Data struct {
+		Groups []struct {
+			ID      int64  `json:"id"`
+			Name    string `json:"name"`
+			UserID  string `json:"user_id"` // API returns string "0"
+			Private string `json:"private"` // "N" or "Y"
+			Color   string `json:"color"`   // Hex like "FF0000"
+		} `json:"groups"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,80 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/04-method-preset.html
+
+// GetAvailablePresetPermissions returns the permissions that can be set in presets, with their default values.
+// The available permissions depend on company and distributor settings.
+func (c *Client) GetAvailablePresetPermissions(
+	ctx context.Context,
+	request *maponv1.GetAvailablePresetPermissionsRequest,
+) (_ *maponv1.GetAvailablePresetPermissionsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get available preset permissions: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+
+	requestURL, err := url.Parse(c.baseURL + "/preset/availablepermissions.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonPresetPermissionsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	permissions, err := mapJSONPresetPermissionsToProto(responseBody.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &maponv1.GetAvailablePresetPermissionsResponse{}
+	resp.SetPermissions(permissions)
+	return resp, nil
+}
+
+type jsonPresetPermissionsResponse struct {
+	Data  map[string]any `json:"data"`
+	Error *jsonError     `json:"error"`
+}
@@ -0,0 +1,86 @@
+package mapon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/04-method-preset.html
+
+// CreatePreset creates a permission preset.
+func (c *Client) CreatePreset(
+	ctx context.Context,
+	request *maponv1.CreatePresetRequest,
+) (_ *maponv1.CreatePresetResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: create preset: %w", err)
+		}
+	}()
+
+	body, err := presetRequestBody(request.GetPreset())
+	if err != nil {
+		return nil, err
+	}
+	body["key"] = c.config.apiKey
+	if request.GetPreset().GetIsCustom() {
+		body["custom"] = 1
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/preset/create.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonPresetStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	if err := checkPresetStatus(&responseBody); err != nil {
+		return nil, err
+	}
+
+	resp := &maponv1.CreatePresetResponse{}
+	resp.SetPresetId(responseBody.ID)
+	return resp, nil
+}
@@ -0,0 +1,82 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/04-method-preset.html
+
+// GetPreset returns the permissions and accessible resources of a preset.
+func (c *Client) GetPreset(
+	ctx context.Context,
+	request *maponv1.GetPresetRequest,
+) (_ *maponv1.GetPresetResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get preset: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("id", strconv.FormatInt(request.GetPresetId(), 10))
+
+	requestURL, err := url.Parse(c.baseURL + "/preset/one.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonPresetResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	responseBody.Data.ID = request.GetPresetId()
+	preset, err := mapJSONPresetToProto(responseBody.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &maponv1.GetPresetResponse{}
+	resp.SetPreset(preset)
+	return resp, nil
+}
+
+type jsonPresetResponse struct {
+	Data  jsonPreset `json:"data"`
+	Error *jsonError `json:"error"`
+}
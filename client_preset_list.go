@@ -0,0 +1,90 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/04-method-preset.html
+
+// ListPresets lists the permission presets of the company.
+func (c *Client) ListPresets(
+	ctx context.Context,
+	request *maponv1.ListPresetsRequest,
+) (_ *maponv1.ListPresetsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list presets: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.GetIncludeCustom() {
+		params.Add("with_custom", "1")
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/preset/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonPresetsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	presets := make([]*maponv1.Preset, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		p := &maponv1.Preset{}
+		p.SetPresetId(j.ID)
+		p.SetTitle(j.Title)
+		p.SetIsCustom(j.IsCustom)
+		presets = append(presets, p)
+	}
+
+	resp := &maponv1.ListPresetsResponse{}
+	resp.SetPresets(presets)
+	return resp, nil
+}
+
+type jsonPresetsResponse struct {
+	Data []struct {
+		ID       int64  `json:"id"`
+		Title    string `json:"title"`
+		IsCustom bool   `json:"is_custom"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,182 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/20-method-reefer.html
+
+// ListReeferAlerts returns reefer temperature alerts together with the current refrigerator readings.
+func (c *Client) ListReeferAlerts(
+	ctx context.Context,
+	request *maponv1.ListReeferAlertsRequest,
+) (_ *maponv1.ListReeferAlertsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list reefer alerts: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.GetAlertId() != 0 {
+		params.Add("id", strconv.FormatInt(request.GetAlertId(), 10))
+	}
+	for _, id := range request.GetUnitIds() {
+		params.Add("unit_id[]", strconv.FormatInt(id, 10))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/reefer/alert_list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonReeferAlertListResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	alerts := make([]*maponv1.ReeferAlert, 0, len(responseBody.Data.Alerts))
+	for _, a := range responseBody.Data.Alerts {
+		alerts = append(alerts, mapJSONReeferAlertToProto(a))
+	}
+
+	resp := &maponv1.ListReeferAlertsResponse{}
+	resp.SetAlerts(alerts)
+	return resp, nil
+}
+
+type jsonReeferAlertListResponse struct {
+	Data struct {
+		Alerts []jsonReeferAlert `json:"alerts"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+// Most alert settings are returned as strings, e.g. "runmode": "1".
+type jsonReeferAlert struct {
+	AlertID  int64 `json:"alert_id"`
+	CarID    int64 `json:"car_id"`
+	Settings struct {
+		RunMode           interface{} `json:"runmode"`
+		SetpointTemp      interface{} `json:"setpoint_temp"`
+		OverSetpointTemp  interface{} `json:"over_setpoint_temp"`
+		UnderSetpointTemp interface{} `json:"under_setpoint_temp"`
+		GMTFrom           string      `json:"gmt_from"`
+		GMTTo             string      `json:"gmt_to"`
+		UserID            interface{} `json:"user_id"`
+		Compartment       interface{} `json:"compartment"`
+		CargoCooling      interface{} `json:"cargo_cooling"`
+		CargoCoolingRange interface{} `json:"cargo_cooling_range"`
+		Channels          []string    `json:"channels"`
+		Notes             string      `json:"notes"`
+	} `json:"settings"`
+	LastDataGMT                string      `json:"last_data_gmt"`
+	CurrentFlags               interface{} `json:"current_flags"`
+	CurrentFlagsGMT            string      `json:"current_flags_gmt"`
+	CurrentRunMode             interface{} `json:"current_runmode"`
+	CurrentRunModeGMT          string      `json:"current_runmode_gmt"`
+	CurrentSetpointTemp        interface{} `json:"current_setpoint_temp"`
+	CurrentSetpointTempGMT     string      `json:"current_setpoint_temp_gmt"`
+	CurrentReturnTemp          interface{} `json:"current_return_temp"`
+	CurrentReturnTempGMT       string      `json:"current_return_temp_gmt"`
+	CurrentCompartmentState    interface{} `json:"current_compartment_state"`
+	CurrentCompartmentStateGMT string      `json:"current_compartment_state_gmt"`
+}
+
+func mapJSONReeferAlertToProto(j jsonReeferAlert) *maponv1.ReeferAlert {
+	a := &maponv1.ReeferAlert{}
+	a.SetAlertId(j.AlertID)
+	a.SetUnitId(j.CarID)
+	if v, err := parseFloat(j.Settings.RunMode); err == nil {
+		a.SetRunMode(int32(v))
+	}
+	if v, err := parseFloat(j.Settings.SetpointTemp); err == nil {
+		a.SetSetpointCelsius(v)
+	}
+	if v, err := parseFloat(j.Settings.OverSetpointTemp); err == nil {
+		a.SetOverSetpointCelsius(v)
+	}
+	if v, err := parseFloat(j.Settings.UnderSetpointTemp); err == nil {
+		a.SetUnderSetpointCelsius(v)
+	}
+	if t, ok := parseReeferTime(j.Settings.GMTFrom); ok {
+		a.SetActiveFrom(timestamppb.New(t))
+	}
+	if t, ok := parseReeferTime(j.Settings.GMTTo); ok {
+		a.SetActiveTo(timestamppb.New(t))
+	}
+	if v, err := parseFloat(j.Settings.UserID); err == nil {
+		a.SetUserId(int64(v))
+	}
+	if v, err := parseFloat(j.Settings.Compartment); err == nil {
+		a.SetCompartment(int32(v))
+	}
+	switch v := j.Settings.CargoCooling.(type) {
+	case bool:
+		a.SetCargoCooling(v)
+	default:
+		if f, err := parseFloat(v); err == nil {
+			a.SetCargoCooling(f != 0)
+		}
+	}
+	if v, err := parseFloat(j.Settings.CargoCoolingRange); err == nil {
+		a.SetCargoCoolingRangeCelsius(int32(v))
+	}
+	a.SetChannels(j.Settings.Channels)
+	a.SetNotes(j.Settings.Notes)
+	if t, ok := parseReeferTime(j.LastDataGMT); ok {
+		a.SetLastDataTime(timestamppb.New(t))
+	}
+	if j.CurrentFlagsGMT != "" {
+		a.SetCurrentFlags(mapJSONReeferValueToProto(jsonReeferValue{Value: j.CurrentFlags, GMT: j.CurrentFlagsGMT}))
+	}
+	if j.CurrentRunModeGMT != "" {
+		a.SetCurrentRunMode(mapJSONReeferValueToProto(jsonReeferValue{Value: j.CurrentRunMode, GMT: j.CurrentRunModeGMT}))
+	}
+	if j.CurrentSetpointTempGMT != "" {
+		a.SetCurrentSetpointTemperature(mapJSONReeferTemperatureToProto(jsonReeferValue{Value: j.CurrentSetpointTemp, GMT: j.CurrentSetpointTempGMT}))
+	}
+	if j.CurrentReturnTempGMT != "" {
+		a.SetCurrentReturnTemperature(mapJSONReeferTemperatureToProto(jsonReeferValue{Value: j.CurrentReturnTemp, GMT: j.CurrentReturnTempGMT}))
+	}
+	if j.CurrentCompartmentStateGMT != "" {
+		a.SetCurrentCompartmentState(mapJSONReeferStateToProto(jsonReeferValue{Value: j.CurrentCompartmentState, GMT: j.CurrentCompartmentStateGMT}))
+	}
+	return a
+}
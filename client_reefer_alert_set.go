@@ -0,0 +1,95 @@
+package mapon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/20-method-reefer.html
+
+// SetReeferAlert creates a new reefer temperature alert.
+func (c *Client) SetReeferAlert(
+	ctx context.Context,
+	request *maponv1.SetReeferAlertRequest,
+) (_ *maponv1.SetReeferAlertResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: set reefer alert: %w", err)
+		}
+	}()
+
+	channels := request.GetChannels()
+	if channels == nil {
+		channels = []string{}
+	}
+	body := map[string]any{
+		"key":                 c.config.apiKey,
+		"unit_id":             request.GetUnitId(),
+		"compartment":         request.GetCompartment(),
+		"user_id":             request.GetUserId(),
+		"from":                request.GetActiveFrom().AsTime().UTC().Format(time.RFC3339),
+		"to":                  request.GetActiveTo().AsTime().UTC().Format(time.RFC3339),
+		"runmode":             request.GetRunMode(),
+		"setpoint":            request.GetSetpointCelsius(),
+		"over_setpoint":       request.GetOverSetpointCelsius(),
+		"under_setpoint":      request.GetUnderSetpointCelsius(),
+		"channels":            channels,
+		"cargo_cooling":       request.GetCargoCooling(),
+		"cargo_cooling_range": request.GetCargoCoolingRangeCelsius(),
+		"notes":               request.GetNotes(),
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/reefer/set_alert.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonReeferStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.SetReeferAlertResponse{}
+	resp.SetAlertId(responseBody.Data.AlertID)
+	return resp, nil
+}
@@ -0,0 +1,78 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/20-method-reefer.html
+
+// ChangeReeferAlertUser transfers a reefer temperature alert from one user to another.
+func (c *Client) ChangeReeferAlertUser(
+	ctx context.Context,
+	request *maponv1.ChangeReeferAlertUserRequest,
+) (_ *maponv1.ChangeReeferAlertUserResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: change reefer alert user: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("alert_id", strconv.FormatInt(request.GetAlertId(), 10))
+	params.Add("old_user", strconv.FormatInt(request.GetOldUserId(), 10))
+	params.Add("new_user", strconv.FormatInt(request.GetNewUserId(), 10))
+
+	requestURL, err := url.Parse(c.baseURL + "/reefer/alert_change_user.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonReeferStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	return &maponv1.ChangeReeferAlertUserResponse{}, nil
+}
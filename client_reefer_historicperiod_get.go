@@ -0,0 +1,84 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/20-method-reefer.html
+
+// GetReeferHistoricPeriod returns historic reefer readings of a unit for a period of up to 30 days.
+func (c *Client) GetReeferHistoricPeriod(
+	ctx context.Context,
+	request *maponv1.GetReeferHistoricPeriodRequest,
+) (_ *maponv1.GetReeferHistoricPeriodResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get reefer historic period: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+	params.Add("from", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	params.Add("till", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+
+	requestURL, err := url.Parse(c.baseURL + "/reefer/historic_period.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonReeferHistoricResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	period, err := mapJSONReeferHistoricPeriodToProto(responseBody.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &maponv1.GetReeferHistoricPeriodResponse{}
+	resp.SetData(period)
+	return resp, nil
+}
+
+type jsonReeferHistoricResponse struct {
+	Data  jsonReeferHistoricData `json:"data"`
+	Error *jsonError             `json:"error"`
+}
@@ -0,0 +1,84 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/20-method-reefer.html
+
+// GetReeferHistoricPoint returns reefer readings of a unit at a point in time.
+// Only readings within one day of the requested time are returned.
+func (c *Client) GetReeferHistoricPoint(
+	ctx context.Context,
+	request *maponv1.GetReeferHistoricPointRequest,
+) (_ *maponv1.GetReeferHistoricPointResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get reefer historic point: %w", err)
+		}
+	}()
+
+	selection := "previous"
+	if request.GetSelection() == maponv1.ReeferHistoricPointSelection_REEFER_HISTORIC_POINT_SELECTION_NEAREST {
+		selection = "nearest"
+	}
+	params := url.Values{}
+	params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+	params.Add("datetime", request.GetTime().AsTime().UTC().Format(time.RFC3339))
+	params.Add("select", selection)
+
+	requestURL, err := url.Parse(c.baseURL + "/reefer/historic_point.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonReeferHistoricResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	point, err := mapJSONReeferHistoricPointToProto(responseBody.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &maponv1.GetReeferHistoricPointResponse{}
+	resp.SetData(point)
+	return resp, nil
+}
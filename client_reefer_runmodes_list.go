@@ -0,0 +1,113 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/20-method-reefer.html
+
+// ListReeferRunModes returns the current and supported run modes of the units' refrigerators.
+func (c *Client) ListReeferRunModes(
+	ctx context.Context,
+	request *maponv1.ListReeferRunModesRequest,
+) (_ *maponv1.ListReeferRunModesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list reefer run modes: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	for _, id := range request.GetUnitIds() {
+		params.Add("unit_id[]", strconv.FormatInt(id, 10))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/reefer/runmodes.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonReeferRunModesResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	units := make([]*maponv1.UnitReeferRunModes, 0, len(responseBody.Data.RunModes))
+	for _, j := range responseBody.Data.RunModes {
+		u := &maponv1.UnitReeferRunModes{}
+		if unitID, err := parseFloat(j.UnitID); err == nil {
+			u.SetUnitId(int64(unitID))
+		}
+		if j.Current != nil {
+			u.SetCurrent(mapJSONReeferRunModeToProto(*j.Current))
+		}
+		supported := make([]*maponv1.ReeferRunMode, 0, len(j.Supported))
+		for _, m := range j.Supported {
+			supported = append(supported, mapJSONReeferRunModeToProto(m))
+		}
+		u.SetSupported(supported)
+		units = append(units, u)
+	}
+
+	resp := &maponv1.ListReeferRunModesResponse{}
+	resp.SetUnits(units)
+	return resp, nil
+}
+
+type jsonReeferRunModesResponse struct {
+	Data struct {
+		RunModes []struct {
+			UnitID    interface{}         `json:"unit_id"` // API returns string "1"
+			Current   *jsonReeferRunMode  `json:"current"`
+			Supported []jsonReeferRunMode `json:"supported"`
+		} `json:"runmodes"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+type jsonReeferRunMode struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+func mapJSONReeferRunModeToProto(j jsonReeferRunMode) *maponv1.ReeferRunMode {
+	m := &maponv1.ReeferRunMode{}
+	m.SetId(j.ID)
+	m.SetName(j.Name)
+	return m
+}
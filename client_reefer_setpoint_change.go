@@ -0,0 +1,79 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/20-method-reefer.html
+
+// ChangeReeferSetpoint changes the setpoint temperature of a refrigerator compartment.
+// The refrigerator must support two-way communication.
+func (c *Client) ChangeReeferSetpoint(
+	ctx context.Context,
+	request *maponv1.ChangeReeferSetpointRequest,
+) (_ *maponv1.ChangeReeferSetpointResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: change reefer setpoint: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+	params.Add("compartment", strconv.FormatInt(int64(request.GetCompartment()), 10))
+	params.Add("setpoint", strconv.FormatFloat(request.GetSetpointCelsius(), 'f', -1, 64))
+
+	requestURL, err := url.Parse(c.baseURL + "/reefer/change_setpoint.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonReeferStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	return &maponv1.ChangeReeferSetpointResponse{}, nil
+}
@@ -0,0 +1,140 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/20-method-reefer.html
+
+// ListReeferTemperatureData returns supply, return and setpoint temperatures of a unit's reefer compartments
+// for a period of up to 31 days.
+func (c *Client) ListReeferTemperatureData(
+	ctx context.Context,
+	request *maponv1.ListReeferTemperatureDataRequest,
+) (_ *maponv1.ListReeferTemperatureDataResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list reefer temperature data: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+	params.Add("from", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	params.Add("till", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+
+	requestURL, err := url.Parse(c.baseURL + "/reefer/list_temperature_data.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonReeferTemperatureDataResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	compartments := make(map[int32]*maponv1.ReeferCompartmentPeriod)
+	compartment := func(key string) *maponv1.ReeferCompartmentPeriod {
+		n, ok := parseReeferCompartment(key)
+		if !ok {
+			return nil
+		}
+		c, ok := compartments[n]
+		if !ok {
+			c = &maponv1.ReeferCompartmentPeriod{}
+			c.SetCompartment(n)
+			compartments[n] = c
+		}
+		return c
+	}
+	for _, unit := range responseBody.Data.Units {
+		for key, values := range unit.Temperatures.Supply {
+			if c := compartment(key); c != nil {
+				c.SetSupplyTemperatures(mapJSONReeferTemperatureRecordsToProto(values))
+			}
+		}
+		for key, values := range unit.Temperatures.Return {
+			if c := compartment(key); c != nil {
+				c.SetReturnTemperatures(mapJSONReeferTemperatureRecordsToProto(values))
+			}
+		}
+		for key, values := range unit.Temperatures.Setpoint {
+			if c := compartment(key); c != nil {
+				c.SetSetpointTemperatures(mapJSONReeferTemperatureRecordsToProto(values))
+			}
+		}
+	}
+
+	resp := &maponv1.ListReeferTemperatureDataResponse{}
+	resp.SetCompartments(sortedReeferCompartments(compartments))
+	return resp, nil
+}
+
+type jsonReeferTemperatureDataResponse struct {
+	Data struct {
+		Units []struct {
+			UnitID       int64 `json:"unit_id"`
+			Temperatures struct {
+				Supply   map[string][]jsonReeferTemperatureRecord `json:"supply"`
+				Return   map[string][]jsonReeferTemperatureRecord `json:"return"`
+				Setpoint map[string][]jsonReeferTemperatureRecord `json:"setpoint"`
+			} `json:"temperatures"`
+		} `json:"units"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+type jsonReeferTemperatureRecord struct {
+	Temperature float64 `json:"temperature"`
+	GMT         string  `json:"gmt"` // "2017-03-07 16:26:31"
+}
+
+func mapJSONReeferTemperatureRecordsToProto(values []jsonReeferTemperatureRecord) []*maponv1.TemperatureRecord {
+	result := make([]*maponv1.TemperatureRecord, 0, len(values))
+	for _, v := range values {
+		r := &maponv1.TemperatureRecord{}
+		if t, ok := parseReeferTime(v.GMT); ok {
+			r.SetTime(timestamppb.New(t))
+		}
+		r.SetValueCelsius(v.Temperature)
+		result = append(result, r)
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/30-method-routeplanning_routes.html
+
+// GetRoutePlanningOptimizationProgress returns the status and progress of a route optimization.
+func (c *Client) GetRoutePlanningOptimizationProgress(
+	ctx context.Context,
+	request *maponv1.GetRoutePlanningOptimizationProgressRequest,
+) (_ *maponv1.GetRoutePlanningOptimizationProgressResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get route planning optimization progress: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("optimization_id", strconv.FormatInt(request.GetOptimizationId(), 10))
+
+	requestURL, err := url.Parse(c.baseURL + "/routeplanning_routes/optimize_check_progress.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonRoutePlanningOptimizationProgressResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.GetRoutePlanningOptimizationProgressResponse{}
+	status := mapRoutePlanningOptimizationStatus(responseBody.Data.Status)
+	resp.SetStatus(status)
+	if status == maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_UNRECOGNIZED {
+		resp.SetUnrecognizedStatus(responseBody.Data.Status)
+	}
+	if progress, err := parseFloat(responseBody.Data.Progress); err == nil {
+		resp.SetProgressPercent(int32(progress))
+	}
+	return resp, nil
+}
+
+type jsonRoutePlanningOptimizationProgressResponse struct {
+	Data struct {
+		Status   string      `json:"status"`
+		Progress interface{} `json:"progress"` // String or number
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
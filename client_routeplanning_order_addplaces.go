@@ -0,0 +1,87 @@
+package mapon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/28-method-routeplanning_orders.html
+
+// AddRoutePlanningOrderPlaces adds pickup and delivery places to an existing route planning order.
+func (c *Client) AddRoutePlanningOrderPlaces(
+	ctx context.Context,
+	request *maponv1.AddRoutePlanningOrderPlacesRequest,
+) (_ *maponv1.AddRoutePlanningOrderPlacesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: add route planning order places: %w", err)
+		}
+	}()
+
+	body := map[string]any{
+		"key":           c.config.apiKey,
+		"id":            request.GetOrderId(),
+		"pickup_places": mapRoutePlanningPlaceInputsToJSON(request.GetPickupPlaces()),
+		"places":        mapRoutePlanningPlaceInputsToJSON(request.GetPlaces()),
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/routeplanning_orders/add_places.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonRoutePlanningOrderAddPlacesResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.AddRoutePlanningOrderPlacesResponse{}
+	resp.SetPlaceIds(responseBody.Data.PlaceIDs)
+	return resp, nil
+}
+
+type jsonRoutePlanningOrderAddPlacesResponse struct {
+	Data struct {
+		PlaceIDs []int64 `json:"place_ids"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
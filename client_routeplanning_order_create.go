@@ -0,0 +1,116 @@
+package mapon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/28-method-routeplanning_orders.html
+
+// CreateRoutePlanningOrder creates a route planning order with its pickup and delivery places.
+func (c *Client) CreateRoutePlanningOrder(
+	ctx context.Context,
+	request *maponv1.CreateRoutePlanningOrderRequest,
+) (_ *maponv1.CreateRoutePlanningOrderResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: create route planning order: %w", err)
+		}
+	}()
+
+	body := map[string]any{
+		"key":           c.config.apiKey,
+		"pickup_places": mapRoutePlanningPlaceInputsToJSON(request.GetPickupPlaces()),
+		"places":        mapRoutePlanningPlaceInputsToJSON(request.GetPlaces()),
+	}
+	if request.GetOrderNumber() != "" {
+		body["order_no"] = request.GetOrderNumber()
+	}
+	if request.GetRouteId() != 0 {
+		body["route_id"] = request.GetRouteId()
+	}
+	if request.GetRouteName() != "" {
+		body["route_name"] = request.GetRouteName()
+	}
+	if request.GetAssigneeUnitId() != 0 {
+		body["assignee_unit_id"] = request.GetAssigneeUnitId()
+	}
+	if request.GetAssigneeDriverId() != 0 {
+		body["assignee_driver_id"] = request.GetAssigneeDriverId()
+	}
+	if request.GetAssigneeDriverUnitId() != 0 {
+		body["assignee_driver_unit_id"] = request.GetAssigneeDriverUnitId()
+	}
+	if request.GetPriority() != "" {
+		body["priority"] = request.GetPriority()
+	}
+	if request.HasPrice() {
+		body["price"] = request.GetPrice()
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/routeplanning_orders/create.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonRoutePlanningOrderCreateResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.CreateRoutePlanningOrderResponse{}
+	resp.SetOrderId(responseBody.Data.OrderID)
+	resp.SetPlaceIds(responseBody.Data.PlaceIDs)
+	if responseBody.Data.RouteID != nil {
+		resp.SetRouteId(*responseBody.Data.RouteID)
+	}
+	return resp, nil
+}
+
+type jsonRoutePlanningOrderCreateResponse struct {
+	Data struct {
+		OrderID  int64   `json:"order_id"`
+		PlaceIDs []int64 `json:"place_ids"`
+		RouteID  *int64  `json:"route_id"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
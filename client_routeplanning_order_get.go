@@ -0,0 +1,79 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/28-method-routeplanning_orders.html
+
+// GetRoutePlanningOrder returns a route planning order.
+func (c *Client) GetRoutePlanningOrder(
+	ctx context.Context,
+	request *maponv1.GetRoutePlanningOrderRequest,
+) (_ *maponv1.GetRoutePlanningOrderResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get route planning order: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("id", strconv.FormatInt(request.GetOrderId(), 10))
+	if includes := routePlanningIncludes(map[string]bool{"polyline": request.GetIncludePolyline()}); includes != "" {
+		params.Add("includes", includes)
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/routeplanning_orders/get.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonRoutePlanningOrderGetResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.GetRoutePlanningOrderResponse{}
+	resp.SetOrder(mapJSONRoutePlanningOrderToProto(responseBody.Data))
+	return resp, nil
+}
+
+type jsonRoutePlanningOrderGetResponse struct {
+	Data  jsonRoutePlanningOrder `json:"data"`
+	Error *jsonError             `json:"error"`
+}
@@ -0,0 +1,104 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/28-method-routeplanning_orders.html
+
+// ListRoutePlanningOrders returns a page of route planning orders.
+func (c *Client) ListRoutePlanningOrders(
+	ctx context.Context,
+	request *maponv1.ListRoutePlanningOrdersRequest,
+) (_ *maponv1.ListRoutePlanningOrdersResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list route planning orders: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.HasHasRoute() {
+		if request.GetHasRoute() {
+			params.Add("has_route", "1")
+		} else {
+			params.Add("has_route", "0")
+		}
+	}
+	if request.GetClientName() != "" {
+		params.Add("client_name", request.GetClientName())
+	}
+	if request.GetDeliveryDate() != "" {
+		params.Add("delivery_date", request.GetDeliveryDate())
+	}
+	if request.GetPage() > 0 {
+		params.Add("page", strconv.FormatInt(int64(request.GetPage()), 10))
+	}
+	if request.GetPerPage() > 0 {
+		params.Add("per_page", strconv.FormatInt(int64(request.GetPerPage()), 10))
+	}
+	if includes := routePlanningIncludes(map[string]bool{"polyline": request.GetIncludePolyline()}); includes != "" {
+		params.Add("includes", includes)
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/routeplanning_orders/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonRoutePlanningOrderListResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	orders := make([]*maponv1.RoutePlanningOrder, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		orders = append(orders, mapJSONRoutePlanningOrderToProto(j))
+	}
+
+	resp := &maponv1.ListRoutePlanningOrdersResponse{}
+	resp.SetOrders(orders)
+	resp.SetPagination(mapJSONPageMetaToProto(responseBody.Meta))
+	return resp, nil
+}
+
+type jsonRoutePlanningOrderListResponse struct {
+	Data  []jsonRoutePlanningOrder `json:"data"`
+	Meta  jsonPageMeta             `json:"_meta"`
+	Error *jsonError               `json:"error"`
+}
@@ -0,0 +1,82 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/29-method-routeplanning_places.html
+
+// GetRoutePlanningPlace returns a route planning place.
+func (c *Client) GetRoutePlanningPlace(
+	ctx context.Context,
+	request *maponv1.GetRoutePlanningPlaceRequest,
+) (_ *maponv1.GetRoutePlanningPlaceResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get route planning place: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("id", strconv.FormatInt(request.GetPlaceId(), 10))
+	if includes := routePlanningIncludes(map[string]bool{
+		"polyline_to": request.GetIncludePolyline(),
+		"files":       request.GetIncludeFiles(),
+	}); includes != "" {
+		params.Add("includes", includes)
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/routeplanning_places/get.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonRoutePlanningPlaceGetResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.GetRoutePlanningPlaceResponse{}
+	resp.SetPlace(mapJSONRoutePlanningPlaceToProto(responseBody.Data))
+	return resp, nil
+}
+
+type jsonRoutePlanningPlaceGetResponse struct {
+	Data  jsonRoutePlanningPlace `json:"data"`
+	Error *jsonError             `json:"error"`
+}
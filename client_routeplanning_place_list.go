@@ -0,0 +1,102 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/29-method-routeplanning_places.html
+
+// ListRoutePlanningPlaces returns a page of route planning places of the given routes.
+func (c *Client) ListRoutePlanningPlaces(
+	ctx context.Context,
+	request *maponv1.ListRoutePlanningPlacesRequest,
+) (_ *maponv1.ListRoutePlanningPlacesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list route planning places: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if len(request.GetRouteIds()) > 0 {
+		routeIDs := make([]string, 0, len(request.GetRouteIds()))
+		for _, id := range request.GetRouteIds() {
+			routeIDs = append(routeIDs, strconv.FormatInt(id, 10))
+		}
+		params.Add("route_ids", strings.Join(routeIDs, ","))
+	}
+	if request.GetPage() > 0 {
+		params.Add("page", strconv.FormatInt(int64(request.GetPage()), 10))
+	}
+	if request.GetPerPage() > 0 {
+		params.Add("per_page", strconv.FormatInt(int64(request.GetPerPage()), 10))
+	}
+	if includes := routePlanningIncludes(map[string]bool{
+		"polyline_to": request.GetIncludePolyline(),
+		"files":       request.GetIncludeFiles(),
+	}); includes != "" {
+		params.Add("includes", includes)
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/routeplanning_places/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonRoutePlanningPlaceListResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	places := make([]*maponv1.RoutePlanningPlace, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		places = append(places, mapJSONRoutePlanningPlaceToProto(j))
+	}
+
+	resp := &maponv1.ListRoutePlanningPlacesResponse{}
+	resp.SetPlaces(places)
+	resp.SetPagination(mapJSONPageMetaToProto(responseBody.Meta))
+	return resp, nil
+}
+
+type jsonRoutePlanningPlaceListResponse struct {
+	Data  []jsonRoutePlanningPlace `json:"data"`
+	Meta  jsonPageMeta             `json:"_meta"`
+	Error *jsonError               `json:"error"`
+}
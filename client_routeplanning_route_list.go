@@ -0,0 +1,113 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/30-method-routeplanning_routes.html
+
+// ListRoutePlanningRoutes returns a page of planned routes.
+func (c *Client) ListRoutePlanningRoutes(
+	ctx context.Context,
+	request *maponv1.ListRoutePlanningRoutesRequest,
+) (_ *maponv1.ListRoutePlanningRoutesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list route planning routes: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if len(request.GetUnitIds()) > 0 {
+		unitIDs := make([]string, 0, len(request.GetUnitIds()))
+		for _, id := range request.GetUnitIds() {
+			unitIDs = append(unitIDs, strconv.FormatInt(id, 10))
+		}
+		params.Add("unit_ids", strings.Join(unitIDs, ","))
+	}
+	if len(request.GetDriverIds()) > 0 {
+		driverIDs := make([]string, 0, len(request.GetDriverIds()))
+		for _, id := range request.GetDriverIds() {
+			driverIDs = append(driverIDs, strconv.FormatInt(id, 10))
+		}
+		params.Add("driver_ids", strings.Join(driverIDs, ","))
+	}
+	if request.HasInProgress() {
+		if request.GetInProgress() {
+			params.Add("is_in_progress", "1")
+		} else {
+			params.Add("is_in_progress", "0")
+		}
+	}
+	if request.GetPage() > 0 {
+		params.Add("page", strconv.FormatInt(int64(request.GetPage()), 10))
+	}
+	if request.GetPerPage() > 0 {
+		params.Add("per_page", strconv.FormatInt(int64(request.GetPerPage()), 10))
+	}
+	if includes := routePlanningIncludes(map[string]bool{"polyline": request.GetIncludePolyline()}); includes != "" {
+		params.Add("includes", includes)
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/routeplanning_routes/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonRoutePlanningRouteListResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	routes := make([]*maponv1.RoutePlanningRoute, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		routes = append(routes, mapJSONRoutePlanningRouteToProto(j))
+	}
+
+	resp := &maponv1.ListRoutePlanningRoutesResponse{}
+	resp.SetRoutes(routes)
+	resp.SetPagination(mapJSONPageMetaToProto(responseBody.Meta))
+	return resp, nil
+}
+
+type jsonRoutePlanningRouteListResponse struct {
+	Data  []jsonRoutePlanningRoute `json:"data"`
+	Meta  jsonPageMeta             `json:"_meta"`
+	Error *jsonError               `json:"error"`
+}
@@ -0,0 +1,93 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/30-method-routeplanning_routes.html
+
+// OptimizeRoutePlanningRoute starts the optimization of a planned route.
+// Use [Client.GetRoutePlanningOptimizationProgress] or [Client.OptimizeRouteAndWait] to follow it.
+func (c *Client) OptimizeRoutePlanningRoute(
+	ctx context.Context,
+	request *maponv1.OptimizeRoutePlanningRouteRequest,
+) (_ *maponv1.OptimizeRoutePlanningRouteResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: optimize route planning route: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("id", strconv.FormatInt(request.GetRouteId(), 10))
+	if request.GetTruckRestrictionsEnabled() {
+		params.Add("truck_restrictions_enabled", "1")
+	} else {
+		params.Add("truck_restrictions_enabled", "0")
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/routeplanning_routes/optimize.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonRoutePlanningOptimizeResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.OptimizeRoutePlanningRouteResponse{}
+	resp.SetOptimizationId(responseBody.Data.OptimizationID)
+	resp.SetStatus(mapRoutePlanningOptimizationStatus(responseBody.Data.Status))
+	return resp, nil
+}
+
+type jsonRoutePlanningOptimizeResponse struct {
+	Data struct {
+		Status         string `json:"status"`
+		OptimizationID int64  `json:"optimization_id"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
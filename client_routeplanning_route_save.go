@@ -0,0 +1,108 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/30-method-routeplanning_routes.html
+
+// SaveRoutePlanningRoute creates a planned route, or updates it when a route ID is set.
+func (c *Client) SaveRoutePlanningRoute(
+	ctx context.Context,
+	request *maponv1.SaveRoutePlanningRouteRequest,
+) (_ *maponv1.SaveRoutePlanningRouteResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: save route planning route: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	if request.GetRouteId() != 0 {
+		params.Add("id", strconv.FormatInt(request.GetRouteId(), 10))
+	}
+	if request.GetName() != "" {
+		params.Add("name", request.GetName())
+	}
+	if request.GetAssigneeUnitId() != 0 {
+		params.Add("assignee_unit_id", strconv.FormatInt(request.GetAssigneeUnitId(), 10))
+	}
+	if request.GetAssigneeDriverId() != 0 {
+		params.Add("assignee_driver_id", strconv.FormatInt(request.GetAssigneeDriverId(), 10))
+	}
+	if request.GetAssigneeDriverUnitId() != 0 {
+		params.Add("assignee_driver_unit_id", strconv.FormatInt(request.GetAssigneeDriverUnitId(), 10))
+	}
+	if request.HasDepartureAt() {
+		params.Add("departure_at", request.GetDepartureAt().AsTime().UTC().Format(routePlanningTimeLayout))
+	}
+	if request.GetStartAddress() != "" {
+		params.Add("start_address", request.GetStartAddress())
+	}
+	if request.GetEndAddress() != "" {
+		params.Add("end_address", request.GetEndAddress())
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/routeplanning_routes/save.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonRoutePlanningRouteSaveResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.SaveRoutePlanningRouteResponse{}
+	resp.SetRouteId(responseBody.Data.ID)
+	return resp, nil
+}
+
+type jsonRoutePlanningRouteSaveResponse struct {
+	Data struct {
+		ID int64 `json:"id"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
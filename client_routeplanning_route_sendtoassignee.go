@@ -0,0 +1,86 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/30-method-routeplanning_routes.html
+
+// SendRoutePlanningRouteToAssignee makes a planned route available in the driver application of its assignee.
+func (c *Client) SendRoutePlanningRouteToAssignee(
+	ctx context.Context,
+	request *maponv1.SendRoutePlanningRouteToAssigneeRequest,
+) (_ *maponv1.SendRoutePlanningRouteToAssigneeResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: send route planning route to assignee: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("id", strconv.FormatInt(request.GetRouteId(), 10))
+
+	requestURL, err := url.Parse(c.baseURL + "/routeplanning_routes/send_to_assignee.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonRoutePlanningRouteSendResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	if !responseBody.Data.Sent {
+		return nil, fmt.Errorf("route %d was not sent", request.GetRouteId())
+	}
+	return &maponv1.SendRoutePlanningRouteToAssigneeResponse{}, nil
+}
+
+type jsonRoutePlanningRouteSendResponse struct {
+	Data struct {
+		Sent bool `json:"sent"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
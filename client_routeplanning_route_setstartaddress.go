@@ -0,0 +1,80 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/30-method-routeplanning_routes.html
+
+// SetRoutePlanningRouteStartAddress sets the start address and departure time of a planned route.
+func (c *Client) SetRoutePlanningRouteStartAddress(
+	ctx context.Context,
+	request *maponv1.SetRoutePlanningRouteStartAddressRequest,
+) (_ *maponv1.SetRoutePlanningRouteStartAddressResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: set route planning route start address: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("id", strconv.FormatInt(request.GetRouteId(), 10))
+	params.Add("address", request.GetAddress())
+	if request.HasDepartureAt() {
+		params.Add("departure_at", request.GetDepartureAt().AsTime().UTC().Format(routePlanningTimeLayout))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/routeplanning_routes/set_start_address.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonRoutePlanningStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	return &maponv1.SetRoutePlanningRouteStartAddressResponse{}, nil
+}
@@ -0,0 +1,33 @@
+package mapon
+
+import (
+	"context"
+	"fmt"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/21-method-tachograph.html
+
+// DownloadDriverDdd downloads the raw contents of a driver card DDD file.
+func (c *Client) DownloadDriverDdd(
+	ctx context.Context,
+	request *maponv1.DownloadDriverDddRequest,
+) (_ *maponv1.DownloadDriverDddResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: download driver DDD: %w", err)
+		}
+	}()
+
+	content, filename, err := c.downloadDDD(ctx, "/tachograph/download_ddd_driver.json", request.GetFileId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &maponv1.DownloadDriverDddResponse{}
+	resp.SetContent(content)
+	resp.SetFilename(filename)
+	return resp, nil
+}
@@ -0,0 +1,105 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/21-method-tachograph.html
+
+// ListDriverDddFiles returns driver card DDD files created in the specified period of up to 1 month.
+func (c *Client) ListDriverDddFiles(
+	ctx context.Context,
+	request *maponv1.ListDriverDddFilesRequest,
+) (_ *maponv1.ListDriverDddFilesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list driver DDD files: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("from", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	params.Add("till", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+
+	requestURL, err := url.Parse(c.baseURL + "/tachograph/list_ddd_driver.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonDriverDddFileListResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	files := make([]*maponv1.DriverDddFile, 0, len(responseBody.Data.Files))
+	for _, j := range responseBody.Data.Files {
+		f := &maponv1.DriverDddFile{}
+		f.SetFileId(j.ID)
+		f.SetUnitId(j.CarID)
+		f.SetCardNumber(j.CardNumber)
+		f.SetCardName(j.CardName)
+		f.SetCardSurname(j.CardSurname)
+		f.SetCardBirthDate(j.CardBirthday)
+		if t, err := time.Parse(time.DateTime, j.Created); err == nil {
+			f.SetCreatedAt(timestamppb.New(t))
+		}
+		f.SetFilename(j.Filename)
+		files = append(files, f)
+	}
+
+	resp := &maponv1.ListDriverDddFilesResponse{}
+	resp.SetFiles(files)
+	return resp, nil
+}
+
+type jsonDriverDddFileListResponse struct {
+	Data struct {
+		Files []struct {
+			ID           int64  `json:"id"`
+			CarID        int64  `json:"car_id"`
+			CardNumber   string `json:"card_number"`
+			CardName     string `json:"card_name"`
+			CardSurname  string `json:"card_surname"`
+			CardBirthday string `json:"card_birthday"` // "1966-07-17"
+			Created      string `json:"created"`       // "2018-01-01 00:09:03"
+			Filename     string `json:"filename"`
+		} `json:"files"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,33 @@
+package mapon
+
+import (
+	"context"
+	"fmt"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/21-method-tachograph.html
+
+// DownloadVehicleDdd downloads the raw contents of a vehicle unit DDD file.
+func (c *Client) DownloadVehicleDdd(
+	ctx context.Context,
+	request *maponv1.DownloadVehicleDddRequest,
+) (_ *maponv1.DownloadVehicleDddResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: download vehicle DDD: %w", err)
+		}
+	}()
+
+	content, filename, err := c.downloadDDD(ctx, "/tachograph/download_ddd_vehicle.json", request.GetFileId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &maponv1.DownloadVehicleDddResponse{}
+	resp.SetContent(content)
+	resp.SetFilename(filename)
+	return resp, nil
+}
@@ -0,0 +1,82 @@
+package mapon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/33-method-tasks.html
+
+// CreateTask creates a task for a unit.
+func (c *Client) CreateTask(
+	ctx context.Context,
+	request *maponv1.CreateTaskRequest,
+) (_ *maponv1.CreateTaskResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: create task: %w", err)
+		}
+	}()
+
+	if request.GetTask().GetStatus() != maponv1.Task_STATUS_UNSPECIFIED {
+		return nil, fmt.Errorf("status can not be set when creating a task")
+	}
+	body, err := taskRequestBody(request.GetTask())
+	if err != nil {
+		return nil, err
+	}
+	body["key"] = c.config.apiKey
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/tasks/create.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonTaskStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.CreateTaskResponse{}
+	resp.SetTaskId(responseBody.Data.ID)
+	return resp, nil
+}
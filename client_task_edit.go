@@ -0,0 +1,98 @@
+package mapon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/33-method-tasks.html
+
+// EditTask updates a task.
+// The required document types of the task are left unchanged when empty,
+// unless the request clears them.
+func (c *Client) EditTask(
+	ctx context.Context,
+	request *maponv1.EditTaskRequest,
+) (_ *maponv1.EditTaskResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: edit task: %w", err)
+		}
+	}()
+
+	task := request.GetTask()
+	if task.GetTaskId() == 0 {
+		return nil, fmt.Errorf("no task ID")
+	}
+	body, err := taskRequestBody(task)
+	if err != nil {
+		return nil, err
+	}
+	body["key"] = c.config.apiKey
+	body["id"] = task.GetTaskId()
+	if task.GetStatus() != maponv1.Task_STATUS_UNSPECIFIED {
+		status, ok := taskStatusName(task.GetStatus())
+		if !ok {
+			return nil, fmt.Errorf("unsupported task status %s", task.GetStatus())
+		}
+		body["status"] = status
+	}
+	if request.GetClearRequiredDocumentTypes() {
+		if len(task.GetRequiredDocumentTypes()) > 0 {
+			return nil, fmt.Errorf("required document types can not be both set and cleared")
+		}
+		// An empty array removes all required document types, while a missing one leaves them unchanged.
+		body["required_document_types"] = []string{}
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/tasks/edit.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonTaskStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	return &maponv1.EditTaskResponse{}, nil
+}
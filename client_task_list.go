@@ -0,0 +1,89 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/33-method-tasks.html
+
+// ListTasks lists the tasks of a unit.
+func (c *Client) ListTasks(
+	ctx context.Context,
+	request *maponv1.ListTasksRequest,
+) (_ *maponv1.ListTasksResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list tasks: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.GetUnitId() == 0 {
+		return nil, fmt.Errorf("no unit ID")
+	}
+	params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+	if request.HasUpdatedSince() {
+		params.Add("get_since", request.GetUpdatedSince().AsTime().UTC().Format(time.RFC3339))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/tasks/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonTasksResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	tasks := make([]*maponv1.Task, 0, len(responseBody.Data.Tasks))
+	for _, t := range responseBody.Data.Tasks {
+		tasks = append(tasks, mapJSONTaskToProto(t))
+	}
+	resp := &maponv1.ListTasksResponse{}
+	resp.SetTasks(tasks)
+	return resp, nil
+}
+
+type jsonTasksResponse struct {
+	Data struct {
+		Tasks []jsonTask `json:"tasks"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,87 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/22-method-tracking.html
+
+// CreateTrackingLink creates a public tracking link for units.
+// The returned link has its hash set. Mapon does not return the ID
+// of the created link, use ListTrackingLinks to find it by hash.
+func (c *Client) CreateTrackingLink(
+	ctx context.Context,
+	request *maponv1.CreateTrackingLinkRequest,
+) (_ *maponv1.CreateTrackingLinkResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: create tracking link: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	if err := addTrackingLinkParams(params, request.GetLink()); err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/tracking/create.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonTrackingStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	link := &maponv1.TrackingLink{}
+	link.SetHash(responseBody.Data.TrackingID)
+	link.SetUnitIds(request.GetLink().GetUnitIds())
+	link.SetAvailableFrom(request.GetLink().GetAvailableFrom())
+	link.SetExpireTime(request.GetLink().GetExpireTime())
+
+	resp := &maponv1.CreateTrackingLinkResponse{}
+	resp.SetLink(link)
+	return resp, nil
+}
@@ -0,0 +1,86 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/22-method-tracking.html
+
+// ListTrackingLinks lists the enabled tracking links created in the specified period.
+func (c *Client) ListTrackingLinks(
+	ctx context.Context,
+	request *maponv1.ListTrackingLinksRequest,
+) (_ *maponv1.ListTrackingLinksResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list tracking links: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("from", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	params.Add("till", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+
+	requestURL, err := url.Parse(c.baseURL + "/tracking/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonTrackingLinksResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	links := make([]*maponv1.TrackingLink, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		l, err := mapJSONTrackingLinkToProto(j)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, l)
+	}
+
+	resp := &maponv1.ListTrackingLinksResponse{}
+	resp.SetLinks(links)
+	return resp, nil
+}
+
+type jsonTrackingLinksResponse struct {
+	Data  []jsonTrackingLink `json:"data"`
+	Error *jsonError         `json:"error"`
+}
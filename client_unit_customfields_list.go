@@ -0,0 +1,85 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/08-method-unit.html
+
+// ListUnitCustomFields returns the custom fields and values of a unit.
+func (c *Client) ListUnitCustomFields(
+	ctx context.Context,
+	request *maponv1.ListUnitCustomFieldsRequest,
+) (_ *maponv1.ListUnitCustomFieldsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list unit custom fields: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+
+	requestURL, err := url.Parse(c.baseURL + "/unit/custom_fields.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonUnitCustomFieldsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	fields := make([]*maponv1.CustomField, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		f, err := mapJSONCustomFieldToProto(j)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+
+	resp := &maponv1.ListUnitCustomFieldsResponse{}
+	resp.SetFields(fields)
+	return resp, nil
+}
+
+type jsonUnitCustomFieldsResponse struct {
+	Data  []jsonCustomField `json:"data"`
+	Error *jsonError        `json:"error"`
+}
@@ -0,0 +1,160 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/08-method-unit.html
+
+// EditUnit edits the details of a unit that are set in the request.
+func (c *Client) EditUnit(
+	ctx context.Context,
+	request *maponv1.EditUnitRequest,
+) (_ *maponv1.EditUnitResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: edit unit: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	if request.GetUnitId() != 0 {
+		params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+	}
+	if request.GetVin() != "" {
+		params.Add("vin", request.GetVin())
+	}
+	if request.HasNumber() {
+		params.Add("number", request.GetNumber())
+	}
+	if request.HasLabel() {
+		params.Add("label", request.GetLabel())
+	}
+	if request.HasShortcut() {
+		params.Add("shortcut", request.GetShortcut())
+	}
+	if request.HasVehicleTitle() {
+		params.Add("vehicle_title", request.GetVehicleTitle())
+	}
+	if request.HasVehicleType() {
+		params.Add("vehicle_type", request.GetVehicleType())
+	}
+	if request.HasDepotId() {
+		params.Add("depot_id", strconv.FormatInt(request.GetDepotId(), 10))
+	}
+	if request.HasReeferType() {
+		params.Add("reefer_type", request.GetReeferType())
+	}
+	if request.HasReeferCommunicationLevel() {
+		params.Add("reefer_communication_level", strconv.Itoa(int(request.GetReeferCommunicationLevel())))
+	}
+	if request.HasReeferCompartmentCount() {
+		params.Add("reefer_compartment_count", strconv.Itoa(int(request.GetReeferCompartmentCount())))
+	}
+	if request.HasCarRegCertificate() {
+		params.Add("car_reg_certificate", request.GetCarRegCertificate())
+	}
+	if request.HasLicenceDate() {
+		params.Add("licence_date", request.GetLicenceDate())
+	}
+	if request.HasServiceTill() {
+		params.Add("service_till", request.GetServiceTill())
+	}
+	if request.HasFuelType() {
+		fuelType, ok := formatFuelType(request.GetFuelType())
+		if !ok {
+			return nil, fmt.Errorf("unsupported fuel type %s", request.GetFuelType())
+		}
+		params.Add("fuel_type", fuelType)
+	}
+	if request.HasFuelTankL() {
+		params.Add("fuel_tank", strconv.Itoa(int(request.GetFuelTankL())))
+	}
+	if request.HasFuelTank1L() {
+		params.Add("fuel_tank_1", strconv.Itoa(int(request.GetFuelTank1L())))
+	}
+	if request.HasFuelTank2L() {
+		params.Add("fuel_tank_2", strconv.Itoa(int(request.GetFuelTank2L())))
+	}
+	if request.HasFuelTank3L() {
+		params.Add("fuel_tank_3", strconv.Itoa(int(request.GetFuelTank3L())))
+	}
+	if request.HasFuelTank4L() {
+		params.Add("fuel_tank_4", strconv.Itoa(int(request.GetFuelTank4L())))
+	}
+	if request.HasCatalystTankL() {
+		params.Add("catalyst_tank", strconv.Itoa(int(request.GetCatalystTankL())))
+	}
+	if request.HasOdometerM() {
+		params.Add("odometer", strconv.FormatInt(request.GetOdometerM(), 10))
+	}
+	if request.HasOdometerDate() {
+		params.Add("odometer_date", request.GetOdometerDate())
+	}
+	if request.HasDriverId() {
+		params.Add("driver_id", strconv.FormatInt(request.GetDriverId(), 10))
+	}
+	if request.HasCodriverId() {
+		params.Add("codriver_id", strconv.FormatInt(request.GetCodriverId(), 10))
+	}
+	if request.HasIcon() {
+		params.Add("icon", request.GetIcon())
+	}
+	if request.HasFuelConsumptionMeasurement() {
+		params.Add("fuel_consumption_measurement", request.GetFuelConsumptionMeasurement())
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/unit/edit.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonUnitStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	return &maponv1.EditUnitResponse{}, nil
+}
@@ -0,0 +1,77 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/08-method-unit.html
+
+// ListAvailableUnitIcons lists the vehicle icons that can be assigned to units.
+func (c *Client) ListAvailableUnitIcons(
+	ctx context.Context,
+	request *maponv1.ListAvailableUnitIconsRequest,
+) (_ *maponv1.ListAvailableUnitIconsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list available unit icons: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+
+	requestURL, err := url.Parse(c.baseURL + "/unit/available_icons.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonUnitIconsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.ListAvailableUnitIconsResponse{}
+	resp.SetIcons(responseBody.Data.Icons)
+	return resp, nil
+}
+
+type jsonUnitIconsResponse struct {
+	Data struct {
+		Status string   `json:"status"`
+		Icons  []string `json:"icons"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
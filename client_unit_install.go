@@ -0,0 +1,96 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/08-method-unit.html
+
+// InstallUnit creates a unit for a device.
+func (c *Client) InstallUnit(
+	ctx context.Context,
+	request *maponv1.InstallUnitRequest,
+) (_ *maponv1.InstallUnitResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: install unit: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("box_id", strconv.FormatInt(request.GetBoxId(), 10))
+	params.Add("number", request.GetNumber())
+	if request.GetLabel() != "" {
+		params.Add("label", request.GetLabel())
+	}
+	if request.HasOdometerM() {
+		params.Add("odometer", strconv.FormatInt(request.GetOdometerM(), 10))
+	}
+	if request.GetNotes() != "" {
+		params.Add("notes", request.GetNotes())
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/unit/install.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonUnitInstallResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.InstallUnitResponse{}
+	resp.SetUnitId(responseBody.Data.UnitID)
+	return resp, nil
+}
+
+type jsonUnitInstallResponse struct {
+	Data struct {
+		Status string `json:"status"`
+		UnitID int64  `json:"unit_id"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,87 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/11-method-unit_commands.html
+
+// ExecuteUnitCommand executes a remote command on a unit.
+// It returns an error if the unit reports that the command failed.
+func (c *Client) ExecuteUnitCommand(
+	ctx context.Context,
+	request *maponv1.ExecuteUnitCommandRequest,
+) (_ *maponv1.ExecuteUnitCommandResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: execute unit command: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+	params.Add("command", request.GetCommand())
+	for name, value := range request.GetParameters() {
+		if params.Has(name) {
+			return nil, fmt.Errorf("reserved parameter name %q", name)
+		}
+		params.Add(name, value)
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/unit_commands/execute.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonUnitStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	if responseBody.Data.Status == "failed" {
+		return nil, fmt.Errorf("command %s failed", request.GetCommand())
+	}
+	return &maponv1.ExecuteUnitCommandResponse{}, nil
+}
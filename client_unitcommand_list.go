@@ -0,0 +1,81 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/11-method-unit_commands.html
+
+// GetAvailableUnitCommands lists the remote commands available for a unit.
+func (c *Client) GetAvailableUnitCommands(
+	ctx context.Context,
+	request *maponv1.GetAvailableUnitCommandsRequest,
+) (_ *maponv1.GetAvailableUnitCommandsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: get available unit commands: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("unit_id", strconv.FormatInt(request.GetUnitId(), 10))
+
+	requestURL, err := url.Parse(c.baseURL + "/unit_commands/get_available.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonUnitCommandsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	commands := make([]*maponv1.UnitCommand, 0, len(responseBody.Data))
+	for _, name := range responseBody.Data {
+		commands = append(commands, mapUnitCommandToProto(name))
+	}
+
+	resp := &maponv1.GetAvailableUnitCommandsResponse{}
+	resp.SetCommands(commands)
+	return resp, nil
+}
+
+type jsonUnitCommandsResponse struct {
+	Data  []string   `json:"data"`
+	Error *jsonError `json:"error"`
+}
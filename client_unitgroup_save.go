@@ -0,0 +1,95 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/10-method-unit_groups.html
+
+// SaveUnitGroup creates a unit group, or updates it when a group ID is set.
+func (c *Client) SaveUnitGroup(
+	ctx context.Context,
+	request *maponv1.SaveUnitGroupRequest,
+) (_ *maponv1.SaveUnitGroupResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: save unit group: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	if request.GetGroupId() != 0 {
+		params.Add("id", strconv.FormatInt(request.GetGroupId(), 10))
+	}
+	params.Add("name", request.GetName())
+	if request.HasParentId() {
+		params.Add("parent_id", strconv.FormatInt(request.GetParentId(), 10))
+	}
+	if request.HasDisableEdit() {
+		params.Add("disable_edit", formatBoolInt(request.GetDisableEdit()))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/unit_groups/save.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonUnitGroupSaveResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.SaveUnitGroupResponse{}
+	resp.SetGroupId(responseBody.Data.GroupID)
+	return resp, nil
+}
+
+type jsonUnitGroupSaveResponse struct {
+	Data struct {
+		Status  string `json:"status"`
+		GroupID int64  `json:"group_id"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
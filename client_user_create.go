@@ -0,0 +1,108 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/17-method-user.html
+
+// CreateUser creates a user.
+// The generated password is returned, and can also be sent to the email of the user.
+func (c *Client) CreateUser(
+	ctx context.Context,
+	request *maponv1.CreateUserRequest,
+) (_ *maponv1.CreateUserResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: create user: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("name", request.GetName())
+	params.Add("surname", request.GetSurname())
+	params.Add("email", request.GetEmail())
+	params.Add("lang", request.GetLanguage())
+	if request.GetPhone() != "" {
+		params.Add("phone", request.GetPhone())
+	}
+	if request.HasType() {
+		typeName, ok := userTypeName(request.GetType())
+		if !ok {
+			return nil, fmt.Errorf("unsupported user type %s", request.GetType())
+		}
+		params.Add("type", typeName)
+	}
+	if request.GetPresetId() != 0 {
+		params.Add("preset_id", strconv.FormatInt(request.GetPresetId(), 10))
+	}
+	if request.GetSendPasswordToEmail() {
+		params.Add("send_password_to_email", "1")
+	}
+	if request.GetForcePasswordChange() {
+		params.Add("force_password_change", "1")
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/user/create.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonUserCreateResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	resp := &maponv1.CreateUserResponse{}
+	resp.SetUserId(responseBody.ID)
+	resp.SetPassword(responseBody.Password)
+	return resp, nil
+}
+
+type jsonUserCreateResponse struct {
+	ID       int64      `json:"id"`
+	Password string     `json:"password"`
+	Error    *jsonError `json:"error"`
+}
@@ -0,0 +1,96 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/17-method-user.html
+
+// ListUsers lists the users of the company.
+func (c *Client) ListUsers(
+	ctx context.Context,
+	request *maponv1.ListUsersRequest,
+) (_ *maponv1.ListUsersResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list users: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if request.GetUserId() != 0 {
+		params.Add("id", strconv.FormatInt(request.GetUserId(), 10))
+	}
+	if request.HasType() {
+		typeName, ok := userTypeName(request.GetType())
+		if !ok {
+			return nil, fmt.Errorf("unsupported user type %s", request.GetType())
+		}
+		params.Add("type", typeName)
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/user/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonUsersResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	users := make([]*maponv1.User, 0, len(responseBody.Data.Users))
+	for _, j := range responseBody.Data.Users {
+		u, err := mapJSONUserToProto(j)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+
+	resp := &maponv1.ListUsersResponse{}
+	resp.SetUsers(users)
+	return resp, nil
+}
+
+type jsonUsersResponse struct {
+	Data struct {
+		Users []jsonUser `json:"users"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
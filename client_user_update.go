@@ -0,0 +1,108 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/17-method-user.html
+
+// UpdateUser updates the fields of a user that are set in the request.
+// Set blocked to block or unblock the user, a reason is required when blocking.
+func (c *Client) UpdateUser(
+	ctx context.Context,
+	request *maponv1.UpdateUserRequest,
+) (_ *maponv1.UpdateUserResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: update user: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	params.Add("key", c.config.apiKey)
+	params.Add("user_id", strconv.FormatInt(request.GetUserId(), 10))
+	if request.HasName() {
+		params.Add("name", request.GetName())
+	}
+	if request.HasSurname() {
+		params.Add("surname", request.GetSurname())
+	}
+	if request.HasPhone() {
+		params.Add("phone", request.GetPhone())
+	}
+	if request.HasLanguage() {
+		params.Add("lang", request.GetLanguage())
+	}
+	if request.HasEmail() {
+		params.Add("email", request.GetEmail())
+	}
+	if request.HasType() {
+		typeName, ok := userTypeName(request.GetType())
+		if !ok {
+			return nil, fmt.Errorf("unsupported user type %s", request.GetType())
+		}
+		params.Add("type", typeName)
+	}
+	if request.HasPresetId() {
+		params.Add("preset_id", strconv.FormatInt(request.GetPresetId(), 10))
+	}
+	if request.HasBlocked() {
+		params.Add("blocked", formatBoolInt(request.GetBlocked()))
+	}
+	if request.HasBlockedReason() {
+		params.Add("blocked_text", request.GetBlockedReason())
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/user/update.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		requestURL.String(),
+		strings.NewReader(params.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonUserStatusResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	return &maponv1.UpdateUserResponse{}, nil
+}
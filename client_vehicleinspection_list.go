@@ -0,0 +1,116 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/27-method-vehicle_inspections.html
+
+// ListVehicleInspections lists vehicle inspections with their checklist results.
+// Set include_items to get the checklist items with their attachments.
+func (c *Client) ListVehicleInspections(
+	ctx context.Context,
+	request *maponv1.ListVehicleInspectionsRequest,
+) (_ *maponv1.ListVehicleInspectionsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: list vehicle inspections: %w", err)
+		}
+	}()
+
+	params := url.Values{}
+	if len(request.GetStatuses()) > 0 {
+		statuses, err := formatVehicleInspectionStatuses(request.GetStatuses())
+		if err != nil {
+			return nil, err
+		}
+		params.Add("status", statuses)
+	}
+	if request.HasFromTime() || request.HasToTime() {
+		params.Add("inspection_date_from", request.GetFromTime().AsTime().UTC().Format(time.DateTime))
+		params.Add("inspection_date_to", request.GetToTime().AsTime().UTC().Format(time.DateTime))
+	}
+	if request.GetDepotId() != 0 {
+		params.Add("depot_id", strconv.FormatInt(request.GetDepotId(), 10))
+	}
+	if request.GetGroupId() != 0 {
+		params.Add("group_id", strconv.FormatInt(request.GetGroupId(), 10))
+	}
+	if len(request.GetUnitIds()) > 0 {
+		params.Add("unit_ids", joinInt64s(request.GetUnitIds()))
+	}
+	if request.GetIncludeUnitsWithoutDevice() {
+		params.Add("empty_box_id", "1")
+	}
+	if request.GetIncludeItems() {
+		params.Add("includes", "items")
+	}
+	if request.GetPage() > 0 {
+		params.Add("page", strconv.Itoa(int(request.GetPage())))
+	}
+	if request.GetPerPage() > 0 {
+		params.Add("per_page", strconv.Itoa(int(request.GetPerPage())))
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/vehicle_inspections/list.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonVehicleInspectionsResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	inspections := make([]*maponv1.VehicleInspection, 0, len(responseBody.Data))
+	for _, j := range responseBody.Data {
+		inspections = append(inspections, mapJSONVehicleInspectionToProto(&j))
+	}
+
+	resp := &maponv1.ListVehicleInspectionsResponse{}
+	resp.SetInspections(inspections)
+	resp.SetPagination(mapJSONPageMetaToProto(responseBody.Meta))
+	return resp, nil
+}
+
+type jsonVehicleInspectionsResponse struct {
+	Data  []jsonVehicleInspection `json:"data"`
+	Meta  jsonPageMeta            `json:"_meta"`
+	Error *jsonError              `json:"error"`
+}
@@ -0,0 +1,94 @@
+package mapon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/32-method-vehicle_pin_auth.html
+
+// CreateVehiclePinDeviceMappings maps PIN keypad devices to units.
+// Mappings that fail, e.g. because they already exist, are reported in the results.
+func (c *Client) CreateVehiclePinDeviceMappings(
+	ctx context.Context,
+	request *maponv1.CreateVehiclePinDeviceMappingsRequest,
+) (_ *maponv1.CreateVehiclePinDeviceMappingsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: create vehicle PIN device mappings: %w", err)
+		}
+	}()
+
+	if len(request.GetMappings()) == 0 {
+		return nil, fmt.Errorf("no device mappings")
+	}
+	mappings := make([]map[string]any, 0, len(request.GetMappings()))
+	for _, m := range request.GetMappings() {
+		if m.GetDeviceId() == "" || len(m.GetDeviceId()) > 255 {
+			return nil, fmt.Errorf("unit %d: invalid device ID %q", m.GetUnitId(), m.GetDeviceId())
+		}
+		mappings = append(mappings, map[string]any{
+			"unitId":   m.GetUnitId(),
+			"deviceId": m.GetDeviceId(),
+		})
+	}
+	body := map[string]any{
+		"key":      c.config.apiKey,
+		"mappings": mappings,
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/vehicle_pin_auth/device_mappings_create.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonVehiclePinAuthResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	summary, results := mapJSONVehiclePinAuthResponseToProto(&responseBody)
+	resp := &maponv1.CreateVehiclePinDeviceMappingsResponse{}
+	resp.SetSummary(summary)
+	resp.SetResults(results)
+	return resp, nil
+}
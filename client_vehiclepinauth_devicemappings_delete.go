@@ -0,0 +1,88 @@
+package mapon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/32-method-vehicle_pin_auth.html
+
+// DeleteVehiclePinDeviceMappings deletes the mappings of PIN keypad devices.
+// Devices that fail, e.g. because they are not mapped, are reported in the results.
+func (c *Client) DeleteVehiclePinDeviceMappings(
+	ctx context.Context,
+	request *maponv1.DeleteVehiclePinDeviceMappingsRequest,
+) (_ *maponv1.DeleteVehiclePinDeviceMappingsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: delete vehicle PIN device mappings: %w", err)
+		}
+	}()
+
+	if len(request.GetDeviceIds()) == 0 {
+		return nil, fmt.Errorf("no device IDs")
+	}
+	devices := make([]map[string]any, 0, len(request.GetDeviceIds()))
+	for _, deviceID := range request.GetDeviceIds() {
+		devices = append(devices, map[string]any{"deviceId": deviceID})
+	}
+	body := map[string]any{
+		"key":     c.config.apiKey,
+		"devices": devices,
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/vehicle_pin_auth/device_mappings_delete.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonVehiclePinAuthResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	summary, results := mapJSONVehiclePinAuthResponseToProto(&responseBody)
+	resp := &maponv1.DeleteVehiclePinDeviceMappingsResponse{}
+	resp.SetSummary(summary)
+	resp.SetResults(results)
+	return resp, nil
+}
@@ -0,0 +1,82 @@
+package mapon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// This API endpoint is documented in:
+// docs/api/methods/32-method-vehicle_pin_auth.html
+
+// CreateDriverPins creates PIN codes for drivers without a PIN.
+// PINs that fail, e.g. because the driver already has a PIN, are reported in the results.
+func (c *Client) CreateDriverPins(
+	ctx context.Context,
+	request *maponv1.CreateDriverPinsRequest,
+) (_ *maponv1.CreateDriverPinsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: create driver PINs: %w", err)
+		}
+	}()
+
+	body, err := driverPinsRequestBody(request.GetPins())
+	if err != nil {
+		return nil, err
+	}
+	body["key"] = c.config.apiKey
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL, err := url.Parse(c.baseURL + "/vehicle_pin_auth/driver_pins_create.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody jsonVehiclePinAuthResponse
+	if err := json.Unmarshal(data, &responseBody); err != nil {
+		return nil, err
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+	}
+
+	summary, results := mapJSONVehiclePinAuthResponseToProto(&responseBody)
+	resp := &maponv1.CreateDriverPinsResponse{}
+	resp.SetSummary(summary)
+	resp.SetResults(results)
+	return resp, nil
+}
@@ -0,0 +1,67 @@
+package mapon
+
+import (
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+type jsonCompanyClientWorkingDays struct {
+	Monday    bool `json:"monday"`
+	Tuesday   bool `json:"tuesday"`
+	Wednesday bool `json:"wednesday"`
+	Thursday  bool `json:"thursday"`
+	Friday    bool `json:"friday"`
+	Saturday  bool `json:"saturday"`
+	Sunday    bool `json:"sunday"`
+}
+
+type jsonCompanyClient struct {
+	ID           int64                         `json:"id"`
+	Name         string                        `json:"name"`
+	Address      string                        `json:"address"`
+	AddressLat   float64                       `json:"address_lat"`
+	AddressLng   float64                       `json:"address_lng"`
+	Phone        string                        `json:"phone"`
+	Email        string                        `json:"email"`
+	Comments     string                        `json:"comments"`
+	WorkingDays  *jsonCompanyClientWorkingDays `json:"working_days"`
+	WorkingTimes *struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	} `json:"working_times"`
+}
+
+var companyDistanceUnits = map[string]maponv1.Company_DistanceUnit{
+	"km":      maponv1.Company_DISTANCE_UNIT_KILOMETER,
+	"mile":    maponv1.Company_DISTANCE_UNIT_MILE,
+	"mile_us": maponv1.Company_DISTANCE_UNIT_MILE_US,
+}
+
+func mapJSONCompanyClientToProto(j jsonCompanyClient) *maponv1.CompanyClient {
+	c := &maponv1.CompanyClient{}
+	c.SetClientId(j.ID)
+	c.SetName(j.Name)
+	location := &maponv1.Location{}
+	location.SetLatitude(j.AddressLat)
+	location.SetLongitude(j.AddressLng)
+	location.SetAddress(j.Address)
+	c.SetLocation(location)
+	c.SetPhone(j.Phone)
+	c.SetEmail(j.Email)
+	c.SetComments(j.Comments)
+	if j.WorkingDays != nil {
+		c.SetWorkingDays(maponv1.CompanyClient_WorkingDays_builder{
+			Monday:    new(j.WorkingDays.Monday),
+			Tuesday:   new(j.WorkingDays.Tuesday),
+			Wednesday: new(j.WorkingDays.Wednesday),
+			Thursday:  new(j.WorkingDays.Thursday),
+			Friday:    new(j.WorkingDays.Friday),
+			Saturday:  new(j.WorkingDays.Saturday),
+			Sunday:    new(j.WorkingDays.Sunday),
+		}.Build())
+	}
+	if j.WorkingTimes != nil {
+		c.SetWorkingTimeFrom(j.WorkingTimes.From)
+		c.SetWorkingTimeTo(j.WorkingTimes.To)
+	}
+	return c
+}
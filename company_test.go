@@ -0,0 +1,112 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+func TestGetCompany(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/company/get.json" {
+			t.Errorf("expected /company/get.json, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"companies": [
+					{
+						"id": 1,
+						"name": "Acme",
+						"legal_name": "Acme Ltd",
+						"reg_no": 10714348,
+						"address": "123 Main Street",
+						"timezone": "UTC",
+						"currency": "EUR",
+						"distance_unit": "mile_us",
+						"application_fields": []
+					}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.GetCompany(context.Background(), &maponv1.GetCompanyRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetCompanies()) != 1 {
+		t.Fatalf("expected 1 company, got %d", len(resp.GetCompanies()))
+	}
+	company := resp.GetCompanies()[0]
+	if company.GetCompanyId() != 1 || company.GetLegalName() != "Acme Ltd" || company.GetRegistrationNumber() != "10714348" {
+		t.Errorf("unexpected company: %v", company)
+	}
+	if company.GetDistanceUnit() != maponv1.Company_DISTANCE_UNIT_MILE_US {
+		t.Errorf("expected distance unit MILE_US, got %v", company.GetDistanceUnit())
+	}
+}
+
+func TestListCompanyClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/company_clients/list.json" {
+			t.Errorf("expected /company_clients/list.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("page"); got != "2" {
+			t.Errorf("expected page 2, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [
+				{
+					"id": 1,
+					"name": "A",
+					"address": "123 Main Street",
+					"address_lat": 28.45721,
+					"address_lng": -43.2719,
+					"working_days": {"monday": true, "tuesday": true, "wednesday": true, "thursday": true, "friday": true, "saturday": false, "sunday": false},
+					"working_times": {"from": "09:00", "to": "18:59"}
+				},
+				{"id": 2, "name": "B", "address": "", "working_days": null, "working_times": null}
+			],
+			"_meta": {"total": 102, "total_pages": 2, "per_page": 100, "page": 2}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListCompanyClients(context.Background(), maponv1.ListCompanyClientsRequest_builder{
+		Page: new(int32(2)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetClients()) != 2 || resp.GetPagination().GetTotal() != 102 {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	first := resp.GetClients()[0]
+	if first.GetLocation().GetLatitude() != 28.45721 || first.GetLocation().GetAddress() != "123 Main Street" {
+		t.Errorf("unexpected location: %v", first.GetLocation())
+	}
+	if !first.GetWorkingDays().GetFriday() || first.GetWorkingDays().GetSaturday() || first.GetWorkingTimeTo() != "18:59" {
+		t.Errorf("unexpected working hours: %v", first)
+	}
+	if second := resp.GetClients()[1]; second.HasWorkingDays() || second.GetWorkingTimeFrom() != "" {
+		t.Errorf("expected no working hours, got %v", second)
+	}
+}
@@ -0,0 +1,144 @@
+package mapon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// Custom fields are shared by drivers, units and objects.
+// Their settings and value lists are returned as an empty JSON array instead of an object when empty.
+
+type jsonCustomField struct {
+	ID       int64           `json:"id"`
+	Label    string          `json:"label"`
+	Type     string          `json:"type"`
+	Settings json.RawMessage `json:"settings"`
+	Value    interface{}     `json:"value"` // String or number
+	Title    string          `json:"title"`
+}
+
+var customFieldTypes = map[string]maponv1.CustomField_Type{
+	"text":      maponv1.CustomField_TYPE_TEXT,
+	"select":    maponv1.CustomField_TYPE_SELECT,
+	"file":      maponv1.CustomField_TYPE_FILE,
+	"image":     maponv1.CustomField_TYPE_IMAGE,
+	"hyperlink": maponv1.CustomField_TYPE_HYPERLINK,
+	"date":      maponv1.CustomField_TYPE_DATE,
+}
+
+func customFieldTypeName(t maponv1.CustomField_Type) (string, bool) {
+	for name, value := range customFieldTypes {
+		if value == t {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// unmarshalCustomFieldObject decodes a custom field JSON object, leaving v untouched when it is empty.
+func unmarshalCustomFieldObject(data json.RawMessage, v any) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] == '[' || bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+// formatCustomFieldValue returns the string representation of a custom field value.
+func formatCustomFieldValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+}
+
+func mapJSONCustomFieldToProto(j jsonCustomField) (*maponv1.CustomField, error) {
+	f := &maponv1.CustomField{}
+	f.SetFieldId(j.ID)
+	f.SetLabel(j.Label)
+	if t, ok := customFieldTypes[j.Type]; ok {
+		f.SetType(t)
+	} else if j.Type != "" {
+		f.SetType(maponv1.CustomField_TYPE_UNRECOGNIZED)
+		f.SetUnrecognizedType(j.Type)
+	}
+	f.SetValue(formatCustomFieldValue(j.Value))
+	f.SetTitle(j.Title)
+	var settings struct {
+		Options map[string]interface{} `json:"options"`
+	}
+	if err := unmarshalCustomFieldObject(j.Settings, &settings); err != nil {
+		return nil, fmt.Errorf("custom field %d settings: %w", j.ID, err)
+	}
+	if len(settings.Options) > 0 {
+		options := make(map[string]string, len(settings.Options))
+		for id, label := range settings.Options {
+			options[id] = formatCustomFieldValue(label)
+		}
+		f.SetOptions(options)
+	}
+	return f, nil
+}
+
+// parseCustomFieldValues decodes custom field values keyed by custom field ID.
+func parseCustomFieldValues(data json.RawMessage) (map[int64]string, error) {
+	var values map[string]interface{}
+	if err := unmarshalCustomFieldObject(data, &values); err != nil {
+		return nil, err
+	}
+	result := make(map[int64]string, len(values))
+	for key, value := range values {
+		id, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom field ID %q: %w", key, err)
+		}
+		result[id] = formatCustomFieldValue(value)
+	}
+	return result, nil
+}
+
+// addCustomFieldColumnParams adds custom field definitions as "columns" form parameters.
+func addCustomFieldColumnParams(params url.Values, fields []*maponv1.CustomField) error {
+	for i, f := range fields {
+		prefix := "columns[" + strconv.Itoa(i) + "]"
+		if f.GetFieldId() != 0 {
+			params.Add(prefix+"[id]", strconv.FormatInt(f.GetFieldId(), 10))
+		}
+		params.Add(prefix+"[label]", f.GetLabel())
+		typeName, ok := customFieldTypeName(f.GetType())
+		if !ok {
+			return fmt.Errorf("custom field %q: unsupported type %s", f.GetLabel(), f.GetType())
+		}
+		params.Add(prefix+"[type]", typeName)
+		for j, value := range slices.Sorted(maps.Keys(f.GetOptions())) {
+			params.Add(prefix+"[selectedOptions]["+strconv.Itoa(j)+"]["+value+"]", f.GetOptions()[value])
+		}
+	}
+	return nil
+}
+
+// addCustomFieldValueParams adds custom field values as "fields" form parameters.
+func addCustomFieldValueParams(params url.Values, values map[int64]string) {
+	for id, value := range values {
+		params.Add("fields["+strconv.FormatInt(id, 10)+"]", value)
+	}
+}
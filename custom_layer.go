@@ -0,0 +1,320 @@
+package mapon
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// Custom layer geometries are exchanged as WKT with latitude first.
+// The typed points, lines and polygons of a geometry are derived from its WKT.
+// Layers may contain any geometry type, but only points can be added or edited.
+
+// maxCustomLayerGeometriesPerAdd is the maximum number of geometries accepted by a single add request.
+const maxCustomLayerGeometriesPerAdd = 1000
+
+// maxCustomLayerGeometriesPerPage is the maximum number of geometries returned by a single list request.
+const maxCustomLayerGeometriesPerPage = 250
+
+type jsonCustomLayerGeometry struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	WKT  string `json:"wkt"`
+}
+
+type jsonCustomLayerStatusResponse struct {
+	Data struct {
+		Status bool `json:"status"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+var customLayerGeometryTypes = map[string]maponv1.CustomLayerGeometry_Type{
+	"POINT":           maponv1.CustomLayerGeometry_TYPE_POINT,
+	"LINESTRING":      maponv1.CustomLayerGeometry_TYPE_LINE_STRING,
+	"POLYGON":         maponv1.CustomLayerGeometry_TYPE_POLYGON,
+	"MULTIPOINT":      maponv1.CustomLayerGeometry_TYPE_MULTI_POINT,
+	"MULTILINESTRING": maponv1.CustomLayerGeometry_TYPE_MULTI_LINE_STRING,
+	"MULTIPOLYGON":    maponv1.CustomLayerGeometry_TYPE_MULTI_POLYGON,
+}
+
+func mapJSONCustomLayerGeometryToProto(j jsonCustomLayerGeometry) *maponv1.CustomLayerGeometry {
+	g := &maponv1.CustomLayerGeometry{}
+	g.SetGeometryId(j.ID)
+	g.SetName(j.Name)
+	g.SetWkt(j.WKT)
+	if err := setCustomLayerGeometryShape(g, j.WKT); err != nil {
+		g.SetType(maponv1.CustomLayerGeometry_TYPE_UNRECOGNIZED)
+	}
+	return g
+}
+
+// setCustomLayerGeometryShape sets the type and typed coordinates of g from a latitude first WKT geometry.
+func setCustomLayerGeometryShape(g *maponv1.CustomLayerGeometry, wkt string) error {
+	parsed, err := parseWKT(wkt)
+	if err != nil {
+		return err
+	}
+	g.SetType(customLayerGeometryTypes[parsed.typ])
+	root := parsed.root
+	switch parsed.typ {
+	case "POINT":
+		g.SetPoints([]*maponv1.Location{wktLocation(root.children[0].coord)})
+	case "MULTIPOINT":
+		points := make([]*maponv1.Location, 0, len(root.children))
+		for _, child := range root.children {
+			points = append(points, wktLocation(child.coords()[0]))
+		}
+		g.SetPoints(points)
+	case "LINESTRING":
+		g.SetLines([]*maponv1.CustomLayerGeometry_Line{wktLine(root)})
+	case "MULTILINESTRING":
+		lines := make([]*maponv1.CustomLayerGeometry_Line, 0, len(root.children))
+		for _, child := range root.children {
+			lines = append(lines, wktLine(child))
+		}
+		g.SetLines(lines)
+	case "POLYGON":
+		g.SetPolygons([]*maponv1.CustomLayerGeometry_Polygon{wktPolygon(root)})
+	case "MULTIPOLYGON":
+		polygons := make([]*maponv1.CustomLayerGeometry_Polygon, 0, len(root.children))
+		for _, child := range root.children {
+			polygons = append(polygons, wktPolygon(child))
+		}
+		g.SetPolygons(polygons)
+	}
+	return nil
+}
+
+func wktLocation(coord []float64) *maponv1.Location {
+	l := &maponv1.Location{}
+	l.SetLatitude(coord[0])
+	l.SetLongitude(coord[1])
+	return l
+}
+
+func wktLine(n wktNode) *maponv1.CustomLayerGeometry_Line {
+	points := make([]*maponv1.Location, 0, len(n.children))
+	for _, c := range n.children {
+		points = append(points, wktLocation(c.coord))
+	}
+	l := &maponv1.CustomLayerGeometry_Line{}
+	l.SetPoints(points)
+	return l
+}
+
+func wktPolygon(n wktNode) *maponv1.CustomLayerGeometry_Polygon {
+	rings := make([]*maponv1.CustomLayerGeometry_Line, 0, len(n.children))
+	for _, ring := range n.children {
+		rings = append(rings, wktLine(ring))
+	}
+	p := &maponv1.CustomLayerGeometry_Polygon{}
+	p.SetRings(rings)
+	return p
+}
+
+// formatCustomLayerGeometryWKT returns the latitude first WKT of a point geometry, built from
+// its typed point when the WKT is not set. The API only accepts points when adding or editing
+// geometries.
+func formatCustomLayerGeometryWKT(g *maponv1.CustomLayerGeometry) (string, error) {
+	if g.GetWkt() != "" {
+		if err := validateWKT(g.GetWkt(), false); err != nil {
+			return "", err
+		}
+		if parsed, _ := parseWKT(g.GetWkt()); parsed.typ != "POINT" {
+			return "", fmt.Errorf("unsupported %s geometry, only points are allowed", parsed.typ)
+		}
+		return g.GetWkt(), nil
+	}
+	if g.GetType() != maponv1.CustomLayerGeometry_TYPE_POINT {
+		return "", fmt.Errorf("unsupported geometry type %s, only points are allowed", g.GetType())
+	}
+	if len(g.GetPoints()) != 1 {
+		return "", fmt.Errorf("point geometry must have exactly 1 point, got %d", len(g.GetPoints()))
+	}
+	p := g.GetPoints()[0]
+	wkt := "POINT(" + strconv.FormatFloat(p.GetLatitude(), 'f', -1, 64) + " " +
+		strconv.FormatFloat(p.GetLongitude(), 'f', -1, 64) + ")"
+	if err := validateWKT(wkt, false); err != nil {
+		return "", err
+	}
+	return wkt, nil
+}
+
+// normalizeCustomLayerGeometryWKT returns the WKT of g in a canonical form for comparison.
+func normalizeCustomLayerGeometryWKT(g *maponv1.CustomLayerGeometry) (string, error) {
+	wkt, err := formatCustomLayerGeometryWKT(g)
+	if err != nil {
+		return "", err
+	}
+	typed := &maponv1.CustomLayerGeometry{}
+	if err := setCustomLayerGeometryShape(typed, wkt); err != nil {
+		return "", err
+	}
+	return formatCustomLayerGeometryWKT(typed)
+}
+
+// listAllCustomLayerGeometries returns all geometries of a custom layer, following pagination.
+func (c *Client) listAllCustomLayerGeometries(ctx context.Context, layerID int64) ([]*maponv1.CustomLayerGeometry, error) {
+	var result []*maponv1.CustomLayerGeometry
+	for {
+		page, err := c.ListCustomLayerGeometries(ctx, maponv1.ListCustomLayerGeometriesRequest_builder{
+			LayerId: new(layerID),
+			Limit:   new(int32(maxCustomLayerGeometriesPerPage)),
+			Offset:  new(int32(len(result))),
+		}.Build())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page.GetGeometries()...)
+		if len(page.GetGeometries()) == 0 || len(result) >= int(page.GetTotal()) {
+			return result, nil
+		}
+	}
+}
+
+// customLayerGeometrySync is the set of changes that makes a custom layer match its desired geometries.
+type customLayerGeometrySync struct {
+	toAdd, toEdit, toDelete []*maponv1.CustomLayerGeometry
+}
+
+// planCustomLayerGeometrySync validates the desired geometries and compares them with the
+// current geometries of the layer. A zero layer ID stands for a new, empty layer.
+func (c *Client) planCustomLayerGeometrySync(
+	ctx context.Context,
+	layerID int64,
+	desired []*maponv1.CustomLayerGeometry,
+) (*customLayerGeometrySync, error) {
+	desiredByName := make(map[string]*maponv1.CustomLayerGeometry, len(desired))
+	desiredWKT := make(map[string]string, len(desired))
+	for _, g := range desired {
+		if g.GetName() == "" {
+			return nil, fmt.Errorf("geometry without name")
+		}
+		if _, ok := desiredByName[g.GetName()]; ok {
+			return nil, fmt.Errorf("duplicate geometry name %q", g.GetName())
+		}
+		wkt, err := normalizeCustomLayerGeometryWKT(g)
+		if err != nil {
+			return nil, fmt.Errorf("geometry %q: %w", g.GetName(), err)
+		}
+		desiredByName[g.GetName()] = g
+		desiredWKT[g.GetName()] = wkt
+	}
+	var existing []*maponv1.CustomLayerGeometry
+	if layerID != 0 {
+		var err error
+		if existing, err = c.listAllCustomLayerGeometries(ctx, layerID); err != nil {
+			return nil, err
+		}
+	}
+	plan := &customLayerGeometrySync{}
+	seen := make(map[string]bool, len(existing))
+	for _, g := range existing {
+		wkt, ok := desiredWKT[g.GetName()]
+		if !ok || seen[g.GetName()] {
+			plan.toDelete = append(plan.toDelete, g)
+			continue
+		}
+		seen[g.GetName()] = true
+		if current, err := normalizeCustomLayerGeometryWKT(g); err == nil && current == wkt {
+			continue
+		}
+		plan.toEdit = append(plan.toEdit, maponv1.CustomLayerGeometry_builder{
+			GeometryId: new(g.GetGeometryId()),
+			Name:       new(g.GetName()),
+			Wkt:        new(wkt),
+		}.Build())
+	}
+	for name, g := range desiredByName {
+		if !seen[name] {
+			plan.toAdd = append(plan.toAdd, g)
+		}
+	}
+	byName := func(a, b *maponv1.CustomLayerGeometry) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	}
+	slices.SortFunc(plan.toAdd, byName)
+	slices.SortStableFunc(plan.toEdit, byName)
+	slices.SortStableFunc(plan.toDelete, byName)
+	return plan, nil
+}
+
+func customLayerGeometryNames(geometries []*maponv1.CustomLayerGeometry) []string {
+	names := make([]string, 0, len(geometries))
+	for _, g := range geometries {
+		names = append(names, g.GetName())
+	}
+	return names
+}
+
+// PlanCustomLayerGeometrySync returns the names of the geometries that [Client.SyncCustomLayerGeometries]
+// would add, update and delete, without changing the layer. A zero layer ID plans the sync of a
+// new, empty layer.
+func (c *Client) PlanCustomLayerGeometrySync(
+	ctx context.Context,
+	layerID int64,
+	desired []*maponv1.CustomLayerGeometry,
+) (added, updated, deleted []string, err error) {
+	plan, err := c.planCustomLayerGeometrySync(ctx, layerID, desired)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("mapon: plan custom layer %d geometries sync: %w", layerID, err)
+	}
+	return customLayerGeometryNames(plan.toAdd), customLayerGeometryNames(plan.toEdit), customLayerGeometryNames(plan.toDelete), nil
+}
+
+// SyncCustomLayerGeometries makes the geometries of a custom layer match the desired geometries.
+//
+// Geometries are matched by name, which must be unique within desired. All desired geometries
+// must be points and are validated before the layer is changed. Missing geometries are added
+// first, then geometries with a different shape are edited, and finally geometries that are
+// not desired are deleted, so an empty desired list deletes every geometry of the layer.
+// The names of the added, updated and deleted geometries are returned in sorted order.
+func (c *Client) SyncCustomLayerGeometries(
+	ctx context.Context,
+	layerID int64,
+	desired []*maponv1.CustomLayerGeometry,
+) (added, updated, deleted []string, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: sync custom layer %d geometries: %w", layerID, err)
+		}
+	}()
+	if layerID == 0 {
+		return nil, nil, nil, fmt.Errorf("no layer ID")
+	}
+	plan, err := c.planCustomLayerGeometrySync(ctx, layerID, desired)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for batch := range slices.Chunk(plan.toAdd, maxCustomLayerGeometriesPerAdd) {
+		if _, err := c.AddCustomLayerGeometries(ctx, maponv1.AddCustomLayerGeometriesRequest_builder{
+			LayerId:    new(layerID),
+			Geometries: batch,
+		}.Build()); err != nil {
+			return nil, nil, nil, err
+		}
+		added = append(added, customLayerGeometryNames(batch)...)
+	}
+	for _, g := range plan.toEdit {
+		if _, err := c.EditCustomLayerGeometry(ctx, maponv1.EditCustomLayerGeometryRequest_builder{
+			Geometry: g,
+		}.Build()); err != nil {
+			return nil, nil, nil, err
+		}
+		updated = append(updated, g.GetName())
+	}
+	for _, g := range plan.toDelete {
+		if _, err := c.DeleteCustomLayerGeometry(ctx, maponv1.DeleteCustomLayerGeometryRequest_builder{
+			GeometryId: new(g.GetGeometryId()),
+		}.Build()); err != nil {
+			return nil, nil, nil, err
+		}
+		deleted = append(deleted, g.GetName())
+	}
+	return added, updated, deleted, nil
+}
@@ -0,0 +1,228 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+func TestListCustomLayerGeometries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/customlayers_geometries/list.json" {
+			t.Errorf("expected /customlayers_geometries/list.json, got %s", r.URL.Path)
+		}
+		_ = r.ParseForm()
+		if r.PostForm.Get("layer_id") != "7" || r.PostForm.Get("limit") != "2" {
+			t.Errorf("unexpected form: %v", r.PostForm)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"geometries": [
+					{"id": 1, "name": "Gas station 1", "wkt": "POINT(51.49318 -0.15068)"},
+					{"id": 2, "name": "Closure", "wkt": "POLYGON((56 24, 56 25, 57 25, 56 24))"},
+					{"id": 3, "name": "Broken", "wkt": "CIRCLE(1 2)"}
+				],
+				"total": 123
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListCustomLayerGeometries(context.Background(), maponv1.ListCustomLayerGeometriesRequest_builder{
+		LayerId: new(int64(7)),
+		Limit:   new(int32(2)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetTotal() != 123 || len(resp.GetGeometries()) != 3 {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	point := resp.GetGeometries()[0]
+	if point.GetType() != maponv1.CustomLayerGeometry_TYPE_POINT || len(point.GetPoints()) != 1 {
+		t.Fatalf("unexpected point geometry: %v", point)
+	}
+	if point.GetPoints()[0].GetLatitude() != 51.49318 || point.GetPoints()[0].GetLongitude() != -0.15068 {
+		t.Errorf("unexpected point location: %v", point.GetPoints()[0])
+	}
+	polygon := resp.GetGeometries()[1]
+	if polygon.GetType() != maponv1.CustomLayerGeometry_TYPE_POLYGON || len(polygon.GetPolygons()) != 1 {
+		t.Fatalf("unexpected polygon geometry: %v", polygon)
+	}
+	if rings := polygon.GetPolygons()[0].GetRings(); len(rings) != 1 || len(rings[0].GetPoints()) != 4 {
+		t.Errorf("unexpected polygon rings: %v", rings)
+	}
+	if broken := resp.GetGeometries()[2]; broken.GetType() != maponv1.CustomLayerGeometry_TYPE_UNRECOGNIZED || broken.GetWkt() != "CIRCLE(1 2)" {
+		t.Errorf("expected unrecognized geometry with WKT, got %v", broken)
+	}
+}
+
+func TestSyncCustomLayerGeometries(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.URL.Path)
+		_ = r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/customlayers_geometries/list.json":
+			_, _ = w.Write([]byte(`{
+				"data": {
+					"geometries": [
+						{"id": 1, "name": "Unchanged", "wkt": "POINT(56.90 24.10)"},
+						{"id": 2, "name": "Moved", "wkt": "POINT(56 24)"},
+						{"id": 3, "name": "Removed", "wkt": "POINT(1 2)"}
+					],
+					"total": 3
+				}
+			}`))
+			return
+		case "/customlayers_geometries/edit.json":
+			if r.PostForm.Get("id") != "2" || r.PostForm.Get("wkt") != "POINT(57 25)" {
+				t.Errorf("unexpected edit form: %v", r.PostForm)
+			}
+		case "/customlayers_geometries/delete.json":
+			if r.PostForm.Get("id") != "3" {
+				t.Errorf("unexpected delete form: %v", r.PostForm)
+			}
+		case "/customlayers_geometries/add.json":
+			if r.PostForm.Get("layer_id") != "7" || r.PostForm.Get("geometries[0][name]") != "New" ||
+				r.PostForm.Get("geometries[0][wkt]") != "POINT(58 26)" {
+				t.Errorf("unexpected add form: %v", r.PostForm)
+			}
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"data": {"status": true}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	point := func(name string, lat, lon float64) *maponv1.CustomLayerGeometry {
+		return maponv1.CustomLayerGeometry_builder{
+			Name: new(name),
+			Type: new(maponv1.CustomLayerGeometry_TYPE_POINT),
+			Points: []*maponv1.Location{
+				maponv1.Location_builder{Latitude: new(lat), Longitude: new(lon)}.Build(),
+			},
+		}.Build()
+	}
+	added, updated, deleted, err := client.SyncCustomLayerGeometries(context.Background(), 7, []*maponv1.CustomLayerGeometry{
+		point("Unchanged", 56.9, 24.1),
+		point("Moved", 57, 25),
+		point("New", 58, 26),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(added) != 1 || added[0] != "New" {
+		t.Errorf("expected added [New], got %v", added)
+	}
+	if len(updated) != 1 || updated[0] != "Moved" {
+		t.Errorf("expected updated [Moved], got %v", updated)
+	}
+	if len(deleted) != 1 || deleted[0] != "Removed" {
+		t.Errorf("expected deleted [Removed], got %v", deleted)
+	}
+	want := []string{
+		"/customlayers_geometries/list.json",
+		"/customlayers_geometries/add.json",
+		"/customlayers_geometries/edit.json",
+		"/customlayers_geometries/delete.json",
+	}
+	if !slices.Equal(calls, want) {
+		t.Errorf("expected calls %v, got %v", want, calls)
+	}
+}
+
+func TestSyncCustomLayerGeometriesRejectsNonPoints(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	_, _, _, err = client.SyncCustomLayerGeometries(context.Background(), 7, []*maponv1.CustomLayerGeometry{
+		maponv1.CustomLayerGeometry_builder{
+			Name: new("Station"),
+			Wkt:  new("POINT(56.9 24.1)"),
+		}.Build(),
+		maponv1.CustomLayerGeometry_builder{
+			Name: new("Zone"),
+			Wkt:  new("POLYGON((56 24, 56 25, 57 25, 56 24))"),
+		}.Build(),
+	})
+	if err == nil {
+		t.Fatal("expected error for polygon geometry")
+	}
+}
+
+func TestPlanCustomLayerGeometrySync(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"geometries": [
+					{"id": 1, "name": "Moved", "wkt": "POINT(56 24)"},
+					{"id": 2, "name": "Removed", "wkt": "POINT(1 2)"}
+				],
+				"total": 2
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	desired := []*maponv1.CustomLayerGeometry{
+		maponv1.CustomLayerGeometry_builder{Name: new("Moved"), Wkt: new("POINT(57 25)")}.Build(),
+		maponv1.CustomLayerGeometry_builder{Name: new("New"), Wkt: new("POINT(58 26)")}.Build(),
+	}
+	added, updated, deleted, err := client.PlanCustomLayerGeometrySync(context.Background(), 7, desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(added, []string{"New"}) || !slices.Equal(updated, []string{"Moved"}) || !slices.Equal(deleted, []string{"Removed"}) {
+		t.Errorf("unexpected plan: added %v, updated %v, deleted %v", added, updated, deleted)
+	}
+	if !slices.Equal(calls, []string{"/customlayers_geometries/list.json"}) {
+		t.Errorf("expected only a list call, got %v", calls)
+	}
+
+	added, updated, deleted, err = client.PlanCustomLayerGeometrySync(context.Background(), 0, desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(added, []string{"Moved", "New"}) || len(updated) != 0 || len(deleted) != 0 {
+		t.Errorf("unexpected plan for new layer: added %v, updated %v, deleted %v", added, updated, deleted)
+	}
+	if len(calls) != 1 {
+		t.Errorf("expected no calls for new layer, got %v", calls)
+	}
+}
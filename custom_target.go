@@ -0,0 +1,98 @@
+package mapon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type jsonCustomTarget struct {
+	ID    int64  `json:"id"`
+	Label string `json:"label"`
+	Units string `json:"units"`
+}
+
+type jsonCustomTargetValue struct {
+	TargetID int64           `json:"target_id"`
+	Value    json.RawMessage `json:"value"` // String, number or boolean
+	GMT      string          `json:"gmt"`   // "2025-05-14T10:00:00Z"
+}
+
+func mapJSONCustomTargetToProto(j jsonCustomTarget) *maponv1.CustomTarget {
+	t := &maponv1.CustomTarget{}
+	t.SetCustomTargetId(j.ID)
+	t.SetLabel(j.Label)
+	t.SetUnits(j.Units)
+	return t
+}
+
+func mapJSONCustomTargetValueToProto(unitID int64, j jsonCustomTargetValue) (*maponv1.CustomTargetValue, error) {
+	v := &maponv1.CustomTargetValue{}
+	v.SetUnitId(unitID)
+	v.SetCustomTargetId(j.TargetID)
+	t, err := time.Parse(time.RFC3339, j.GMT)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q: %w", j.GMT, err)
+	}
+	v.SetTime(timestamppb.New(t))
+	var value any
+	if len(j.Value) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(j.Value))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("invalid value %s: %w", j.Value, err)
+		}
+	}
+	switch value := value.(type) {
+	case nil:
+	case json.Number:
+		f, err := value.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %s: %w", value, err)
+		}
+		v.SetNumberValue(f)
+	case string:
+		v.SetStringValue(value)
+	case bool:
+		v.SetBoolValue(value)
+	default:
+		return nil, fmt.Errorf("unsupported value %s", j.Value)
+	}
+	return v, nil
+}
+
+// mapJSONCustomTargetValuesToProto returns the values of a response keyed by unit ID,
+// ordered by unit ID and time.
+func mapJSONCustomTargetValuesToProto(data map[string][]jsonCustomTargetValue) ([]*maponv1.CustomTargetValue, error) {
+	units := make(map[int64][]jsonCustomTargetValue, len(data))
+	for key, values := range data {
+		unitID, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid unit ID %q: %w", key, err)
+		}
+		units[unitID] = values
+	}
+	var result []*maponv1.CustomTargetValue
+	for _, unitID := range slices.Sorted(maps.Keys(units)) {
+		values := make([]*maponv1.CustomTargetValue, 0, len(units[unitID]))
+		for _, j := range units[unitID] {
+			v, err := mapJSONCustomTargetValueToProto(unitID, j)
+			if err != nil {
+				return nil, fmt.Errorf("unit %d: %w", unitID, err)
+			}
+			values = append(values, v)
+		}
+		slices.SortStableFunc(values, func(a, b *maponv1.CustomTargetValue) int {
+			return a.GetTime().AsTime().Compare(b.GetTime().AsTime())
+		})
+		result = append(result, values...)
+	}
+	return result, nil
+}
@@ -0,0 +1,97 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListCustomTargetValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/custom_targets/values.json" {
+			t.Errorf("expected /custom_targets/values.json, got %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if ids := query["unit_id[]"]; len(ids) != 3 || ids[0] != "533385" {
+			t.Errorf("unexpected unit IDs: %v", ids)
+		}
+		if query.Get("target_id") != "101" || query.Get("from") != "2025-05-14T00:00:00Z" || query.Get("till") != "2025-05-15T00:00:00Z" {
+			t.Errorf("unexpected query: %v", query)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"533385": [
+					{"target_id": 101, "value": "Active", "gmt": "2025-05-14T09:30:00Z"}
+				],
+				"533384": [
+					{"target_id": 101, "value": true, "gmt": "2025-05-14T11:09:55Z"},
+					{"target_id": 101, "value": 123.45, "gmt": "2025-05-14T10:00:00Z"}
+				],
+				"533386": []
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListCustomTargetValues(context.Background(), maponv1.ListCustomTargetValuesRequest_builder{
+		UnitIds:        []int64{533385, 533384, 533386},
+		CustomTargetId: new(int64(101)),
+		FromTime:       timestamppb.New(time.Date(2025, 5, 14, 0, 0, 0, 0, time.UTC)),
+		ToTime:         timestamppb.New(time.Date(2025, 5, 15, 0, 0, 0, 0, time.UTC)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	values := resp.GetValues()
+	if len(values) != 3 {
+		t.Fatalf("expected 3 values, got %d", len(values))
+	}
+	if values[0].GetUnitId() != 533384 || !values[0].HasNumberValue() || values[0].GetNumberValue() != 123.45 {
+		t.Errorf("expected first numeric value of unit 533384, got %v", values[0])
+	}
+	if !values[1].HasBoolValue() || !values[1].GetBoolValue() || values[1].HasNumberValue() {
+		t.Errorf("expected boolean value, got %v", values[1])
+	}
+	if values[2].GetUnitId() != 533385 || values[2].GetStringValue() != "Active" || values[2].GetCustomTargetId() != 101 {
+		t.Errorf("expected string value of unit 533385, got %v", values[2])
+	}
+	if !values[2].GetTime().AsTime().Equal(time.Date(2025, 5, 14, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected time: %v", values[2].GetTime().AsTime())
+	}
+}
+
+func TestListCustomTargetValuesInvalidTime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"533385": [{"target_id": 101, "value": "Active", "gmt": "2025-05-14 09:30:00"}]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	_, err = client.ListCustomTargetValues(context.Background(), maponv1.ListCustomTargetValuesRequest_builder{
+		UnitIds:        []int64{533385},
+		CustomTargetId: new(int64(101)),
+		FromTime:       timestamppb.New(time.Date(2025, 5, 14, 0, 0, 0, 0, time.UTC)),
+		ToTime:         timestamppb.New(time.Date(2025, 5, 15, 0, 0, 0, 0, time.UTC)),
+	}.Build())
+	if err == nil {
+		t.Fatal("expected error for invalid time")
+	}
+}
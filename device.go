@@ -0,0 +1,100 @@
+package mapon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type jsonDevice struct {
+	BoxID     int64       `json:"box_id"`
+	UnitID    *int64      `json:"unit_id"`
+	Model     string      `json:"model"`
+	ModelVer  string      `json:"model_ver"`
+	Installed bool        `json:"installed"`
+	IMEI      interface{} `json:"imei"` // Can be int or string in JSON
+	Serial    string      `json:"serial"`
+	Phone     string      `json:"phone"`
+}
+
+func mapJSONDeviceToProto(j jsonDevice) *maponv1.Device {
+	d := &maponv1.Device{}
+	d.SetBoxId(j.BoxID)
+	if j.UnitID != nil {
+		d.SetUnitId(*j.UnitID)
+	}
+	d.SetModel(j.Model)
+	d.SetModelVersion(j.ModelVer)
+	d.SetInstalled(j.Installed)
+	d.SetImei(formatCustomFieldValue(j.IMEI))
+	d.SetSerialNumber(j.Serial)
+	d.SetPhone(j.Phone)
+	return d
+}
+
+type jsonDeviceSmsMessage struct {
+	Phone      string `json:"phone"`
+	Response   string `json:"response"`
+	ReceivedAt string `json:"received_at"`
+}
+
+// parseDeviceSmsHistory decodes SMS messages from the history response,
+// which nests the messages in an arbitrary number of arrays.
+func parseDeviceSmsHistory(data json.RawMessage) ([]jsonDeviceSmsMessage, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+	if data[0] != '[' {
+		var message jsonDeviceSmsMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return []jsonDeviceSmsMessage{message}, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	var result []jsonDeviceSmsMessage
+	for _, item := range items {
+		messages, err := parseDeviceSmsHistory(item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, messages...)
+	}
+	return result, nil
+}
+
+func mapJSONDeviceSmsMessageToProto(j jsonDeviceSmsMessage) (*maponv1.DeviceSmsMessage, error) {
+	m := &maponv1.DeviceSmsMessage{}
+	m.SetPhone(j.Phone)
+	m.SetResponse(j.Response)
+	t, err := time.Parse(time.DateTime, j.ReceivedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMS receive time %q: %w", j.ReceivedAt, err)
+	}
+	m.SetReceivedAt(timestamppb.New(t))
+	return m, nil
+}
+
+// addDeviceCommandParams adds device command parameters as "params" form parameters.
+func addDeviceCommandParams(params url.Values, parameters map[string]string) {
+	for name, value := range parameters {
+		params.Add("params["+name+"]", value)
+	}
+}
+
+type jsonDeviceStatusResponse struct {
+	Data struct {
+		Status string `json:"status"`
+		Result string `json:"result"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,174 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListDevices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/device/list.json" {
+			t.Errorf("expected /device/list.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("installed"); got != "1" {
+			t.Errorf("expected installed 1, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"devices": [
+					{"box_id": 163129, "unit_id": null, "model": "G-BOX", "model_ver": "", "installed": false, "imei": "", "serial": "", "phone": "12345678"},
+					{"box_id": 26046442, "unit_id": 117660, "model": "TELTONIKA", "model_ver": "FMB640", "installed": true, "imei": 518992226807892, "serial": "1110863003", "phone": ""}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListDevices(context.Background(), maponv1.ListDevicesRequest_builder{
+		InstalledOnly: new(true),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetDevices()) != 2 {
+		t.Fatalf("expected 2 devices, got %d", len(resp.GetDevices()))
+	}
+	if d := resp.GetDevices()[0]; d.GetUnitId() != 0 || d.GetInstalled() {
+		t.Errorf("unexpected storage device: %v", d)
+	}
+	d := resp.GetDevices()[1]
+	if d.GetImei() != "518992226807892" || d.GetModelVersion() != "FMB640" || d.GetUnitId() != 117660 {
+		t.Errorf("unexpected installed device: %v", d)
+	}
+}
+
+func TestListDeviceModels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("custom_fields"); got != "1" {
+			t.Errorf("expected custom_fields 1, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"WEBTECH": {"model": "WEBTECH", "title": "WEBTECH", "display_id": "imei", "versions": [], "required_fields": ["imei"], "additional_fields": [], "custom_fields": []},
+				"LEVEL": {
+					"model": "LEVEL",
+					"title": "Level",
+					"display_id": "sn",
+					"versions": {"GC75": "GC 75"},
+					"required_fields": ["sn", "model_ver"],
+					"additional_fields": [],
+					"custom_fields": [{"name": "ciphered", "type": "text", "model_ver": "", "options": [], "value": 0}]
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListDeviceModels(context.Background(), maponv1.ListDeviceModelsRequest_builder{
+		IncludeCustomFields: new(true),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	models := resp.GetModels()
+	if len(models) != 2 || models[0].GetModel() != "LEVEL" || models[1].GetModel() != "WEBTECH" {
+		t.Fatalf("expected models LEVEL and WEBTECH, got %v", models)
+	}
+	if models[0].GetVersions()["GC75"] != "GC 75" {
+		t.Errorf("unexpected versions: %v", models[0].GetVersions())
+	}
+	if len(models[0].GetCustomFields()) != 1 || models[0].GetCustomFields()[0].GetDefaultValue() != "0" {
+		t.Errorf("unexpected custom fields: %v", models[0].GetCustomFields())
+	}
+	if len(models[1].GetVersions()) != 0 {
+		t.Errorf("expected no versions, got %v", models[1].GetVersions())
+	}
+}
+
+func TestListDeviceSmsHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/device/sms_history.json" {
+			t.Errorf("expected /device/sms_history.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("device_id"); got != "42" {
+			t.Errorf("expected device_id 42, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [
+				[
+					[
+						{"phone": "123456789", "response": "Data Link: 1 GPRS: 1", "received_at": "2017-04-06 10:21:03"},
+						{"phone": "123456789", "response": "Param ID:2004 New Val:1234", "received_at": "2017-04-06 10:25:59"}
+					]
+				],
+				[{"phone": "123456789", "response": "Version 1.0.0", "received_at": "2017-04-07 08:00:00"}]
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListDeviceSmsHistory(context.Background(), maponv1.ListDeviceSmsHistoryRequest_builder{
+		DeviceId: new(int64(42)),
+		FromTime: timestamppb.New(time.Date(2017, 4, 6, 10, 25, 0, 0, time.UTC)),
+		ToTime:   timestamppb.New(time.Date(2017, 4, 7, 0, 0, 0, 0, time.UTC)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetMessages()) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(resp.GetMessages()))
+	}
+	m := resp.GetMessages()[0]
+	if m.GetResponse() != "Param ID:2004 New Val:1234" || !m.GetReceivedAt().AsTime().Equal(time.Date(2017, 4, 6, 10, 25, 59, 0, time.UTC)) {
+		t.Errorf("unexpected message: %v", m)
+	}
+}
+
+func TestListDeviceSmsHistoryInvalidTime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [{"phone": "123456789", "response": "Version 1.0.0", "received_at": ""}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	_, err = client.ListDeviceSmsHistory(context.Background(), maponv1.ListDeviceSmsHistoryRequest_builder{
+		DeviceId: new(int64(42)),
+		FromTime: timestamppb.New(time.Date(2017, 4, 6, 0, 0, 0, 0, time.UTC)),
+	}.Build())
+	if err == nil {
+		t.Fatal("expected error for invalid receive time")
+	}
+}
@@ -0,0 +1,136 @@
+package mapon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+type jsonDriverStatusResponse struct {
+	Error *jsonError `json:"error"`
+}
+
+// joinInt64s returns the comma separated list of the given IDs.
+func joinInt64s(ids []int64) string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, strconv.FormatInt(id, 10))
+	}
+	return strings.Join(result, ",")
+}
+
+// formatBoolInt returns "1" for true and "0" for false.
+func formatBoolInt(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// formatOptionalID returns the ID as a string, or an empty string for zero to clear the value.
+func formatOptionalID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// UpsertDriverByExternalID creates or updates the driver identified by an external ID,
+// such as an employee ID from an HR system.
+//
+// The external ID is stored in the driver custom field with ID externalIDFieldID,
+// which is used to find the driver on subsequent calls. The name, surname, email, phone,
+// iButton and tachograph ID of driver are synchronized, and only changed fields are updated,
+// so repeated calls with the same driver are no-ops. If the external ID can not be stored
+// on a new driver, the driver is deleted again so that a retry does not create a duplicate.
+//
+// A custom field is used instead of driver/associate_external_driver.json because the
+// association made by that endpoint is not returned when listing drivers, so it can not be
+// used to find the driver again.
+func (c *Client) UpsertDriverByExternalID(
+	ctx context.Context,
+	externalIDFieldID int64,
+	externalID string,
+	driver *maponv1.Driver,
+) (driverID int64, created bool, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: upsert driver %q: %w", externalID, err)
+		}
+	}()
+	if externalID == "" {
+		return 0, false, fmt.Errorf("empty external ID")
+	}
+	list, err := c.ListDrivers(ctx, maponv1.ListDriversRequest_builder{
+		IncludeCustomFields: new(true),
+	}.Build())
+	if err != nil {
+		return 0, false, err
+	}
+	var existing *maponv1.Driver
+	for _, d := range list.GetDrivers() {
+		if d.GetCustomFields()[externalIDFieldID] != externalID {
+			continue
+		}
+		if existing != nil {
+			return 0, false, fmt.Errorf("drivers %d and %d have the same external ID", existing.GetDriverId(), d.GetDriverId())
+		}
+		existing = d
+	}
+	if existing == nil {
+		createRequest := &maponv1.CreateDriverRequest{}
+		createRequest.SetName(driver.GetName())
+		createRequest.SetSurname(driver.GetSurname())
+		createRequest.SetEmail(driver.GetEmail())
+		createRequest.SetPhone(driver.GetPhone())
+		createRequest.SetIbuttonValue(driver.GetIbuttonValue())
+		createRequest.SetTachographId(driver.GetTachographId())
+		createResponse, err := c.CreateDriver(ctx, createRequest)
+		if err != nil {
+			return 0, false, err
+		}
+		driverID = createResponse.GetDriverId()
+		if _, err := c.SaveDriverCustomFieldValues(ctx, maponv1.SaveDriverCustomFieldValuesRequest_builder{
+			DriverId: new(driverID),
+			Values:   map[int64]string{externalIDFieldID: externalID},
+		}.Build()); err != nil {
+			if _, deleteErr := c.DeleteDriver(ctx, maponv1.DeleteDriverRequest_builder{
+				DriverId: new(driverID),
+			}.Build()); deleteErr != nil {
+				return driverID, true, fmt.Errorf("created driver %d without external ID: %w", driverID, errors.Join(err, deleteErr))
+			}
+			return 0, false, fmt.Errorf("set external ID, new driver %d was deleted: %w", driverID, err)
+		}
+		return driverID, true, nil
+	}
+	updateRequest := &maponv1.UpdateDriverRequest{}
+	updateRequest.SetDriverId(existing.GetDriverId())
+	var changed bool
+	for _, field := range []struct {
+		current, desired string
+		set              func(string)
+	}{
+		{existing.GetName(), driver.GetName(), updateRequest.SetName},
+		{existing.GetSurname(), driver.GetSurname(), updateRequest.SetSurname},
+		{existing.GetEmail(), driver.GetEmail(), updateRequest.SetEmail},
+		{existing.GetPhone(), driver.GetPhone(), updateRequest.SetPhone},
+		{existing.GetIbuttonValue(), driver.GetIbuttonValue(), updateRequest.SetIbuttonValue},
+		{existing.GetTachographId(), driver.GetTachographId(), updateRequest.SetTachographId},
+	} {
+		// Both sides are trimmed so that repeated upserts make no changes.
+		if desired := strings.TrimSpace(field.desired); strings.TrimSpace(field.current) != desired {
+			field.set(desired)
+			changed = true
+		}
+	}
+	if changed {
+		if _, err := c.UpdateDriver(ctx, updateRequest); err != nil {
+			return existing.GetDriverId(), false, err
+		}
+	}
+	return existing.GetDriverId(), false, nil
+}
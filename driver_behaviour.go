@@ -0,0 +1,181 @@
+package mapon
+
+import (
+	"fmt"
+	"strings"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// Distances are returned in kilometers and durations in seconds.
+// All values except the unit or driver ID and title may be null.
+
+type jsonDriverBehaviourScore struct {
+	Grade    *string  `json:"grade"`
+	Score    *float64 `json:"score"`
+	Distance *float64 `json:"distance"`
+	Duration *float64 `json:"duration"`
+	Count    *float64 `json:"count"`
+}
+
+type jsonDriverBehaviourResult struct {
+	Overall                       *jsonDriverBehaviourScore `json:"overall"`
+	EffectiveSpeed                *jsonDriverBehaviourScore `json:"effective_speed"`
+	ExcessiveIdling               *jsonDriverBehaviourScore `json:"excessive_idling"`
+	CruiseControl                 *jsonDriverBehaviourScore `json:"cruise_control"`
+	Coasting                      *jsonDriverBehaviourScore `json:"coasting"`
+	HarshBraking                  *jsonDriverBehaviourScore `json:"harsh_braking"`
+	HarshAcceleration             *jsonDriverBehaviourScore `json:"harsh_acceleration"`
+	HarshCornering                *jsonDriverBehaviourScore `json:"harsh_cornering"`
+	GreenRPM                      *jsonDriverBehaviourScore `json:"green_rpm"`
+	Speeding                      *jsonDriverBehaviourScore `json:"speeding"`
+	DistanceGPS                   *float64                  `json:"distance_gps"`
+	DistanceCAN                   *float64                  `json:"distance_can"`
+	DrivingDuration               *float64                  `json:"driving_duration"`
+	ExcessiveIdlingPTODuration    *float64                  `json:"excessive_idling_pto_duration"`
+	FuelAvgConsumption            *float64                  `json:"fuel_avg_consumption"`
+	FuelAvgConsumptionMeasure     *string                   `json:"fuel_avg_consumption_measure"`
+	FuelAvgConsumptionByNorm      *float64                  `json:"fuel_avg_consumption_by_norm"`
+	FuelAvgConsumptionRatio       *float64                  `json:"fuel_avg_consumption_ratio"`
+	FuelConsumption               *float64                  `json:"fuel_consumption"`
+	FuelConsumptionCAN            *float64                  `json:"fuel_consumption_can"`
+	ExcessiveIdlingConsumptionCAN *float64                  `json:"excessive_idling_consumption_can"`
+	PTOIdleFuelConsumptionCAN     *float64                  `json:"pto_idle_fuel_consumption_can"`
+	StopCount                     *float64                  `json:"stop_count"`
+	StopDuration                  *float64                  `json:"stop_duration"`
+	AvgSpeed                      *float64                  `json:"avg_speed"`
+}
+
+type jsonDriverBehaviourDriverReport struct {
+	UserID         int64   `json:"user_id"`
+	Title          string  `json:"title"`
+	DrivenUnitsIDs []int64 `json:"driven_units_ids"`
+	jsonDriverBehaviourResult
+}
+
+type jsonDriverBehaviourUnitReport struct {
+	UnitID        int64   `json:"unit_id"`
+	Title         string  `json:"title"`
+	DrivenUserIDs []int64 `json:"driven_user_ids"`
+	jsonDriverBehaviourResult
+}
+
+var driverBehaviourMetrics = map[string]maponv1.DriverBehaviourMetric{
+	"effective_speed":    maponv1.DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_EFFECTIVE_SPEED,
+	"excessive_idling":   maponv1.DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_EXCESSIVE_IDLING,
+	"cruise_control":     maponv1.DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_CRUISE_CONTROL,
+	"coasting":           maponv1.DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_COASTING,
+	"harsh_braking":      maponv1.DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_HARSH_BRAKING,
+	"harsh_acceleration": maponv1.DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_HARSH_ACCELERATION,
+	"harsh_cornering":    maponv1.DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_HARSH_CORNERING,
+	"green_rpm":          maponv1.DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_GREEN_RPM,
+	"speeding":           maponv1.DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_SPEEDING,
+}
+
+// ParseDriverBehaviourMetric returns the driver behaviour metric with the given API name, e.g. "harsh_braking".
+func ParseDriverBehaviourMetric(name string) (maponv1.DriverBehaviourMetric, bool) {
+	m, ok := driverBehaviourMetrics[name]
+	return m, ok
+}
+
+// formatDriverBehaviourMetrics returns the metrics as a comma separated list of API names.
+func formatDriverBehaviourMetrics(metrics []maponv1.DriverBehaviourMetric) (string, error) {
+	names := make([]string, 0, len(metrics))
+	for _, metric := range metrics {
+		var name string
+		for n, value := range driverBehaviourMetrics {
+			if value == metric {
+				name = n
+				break
+			}
+		}
+		if name == "" {
+			return "", fmt.Errorf("unsupported driver behaviour metric %s", metric)
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ","), nil
+}
+
+func mapJSONDriverBehaviourScoreToProto(j *jsonDriverBehaviourScore) *maponv1.DriverBehaviourScore {
+	if j == nil {
+		return nil
+	}
+	s := &maponv1.DriverBehaviourScore{}
+	if j.Grade != nil {
+		s.SetGrade(*j.Grade)
+	}
+	if j.Score != nil {
+		s.SetScore(*j.Score)
+	}
+	if j.Distance != nil {
+		s.SetDistanceKm(*j.Distance)
+	}
+	if j.Duration != nil {
+		s.SetDurationS(int64(*j.Duration))
+	}
+	if j.Count != nil {
+		s.SetCount(int64(*j.Count))
+	}
+	return s
+}
+
+func mapJSONDriverBehaviourResultToProto(j *jsonDriverBehaviourResult) *maponv1.DriverBehaviourResult {
+	r := &maponv1.DriverBehaviourResult{}
+	r.SetOverall(mapJSONDriverBehaviourScoreToProto(j.Overall))
+	r.SetEffectiveSpeed(mapJSONDriverBehaviourScoreToProto(j.EffectiveSpeed))
+	r.SetExcessiveIdling(mapJSONDriverBehaviourScoreToProto(j.ExcessiveIdling))
+	r.SetCruiseControl(mapJSONDriverBehaviourScoreToProto(j.CruiseControl))
+	r.SetCoasting(mapJSONDriverBehaviourScoreToProto(j.Coasting))
+	r.SetHarshBraking(mapJSONDriverBehaviourScoreToProto(j.HarshBraking))
+	r.SetHarshAcceleration(mapJSONDriverBehaviourScoreToProto(j.HarshAcceleration))
+	r.SetHarshCornering(mapJSONDriverBehaviourScoreToProto(j.HarshCornering))
+	r.SetGreenRpm(mapJSONDriverBehaviourScoreToProto(j.GreenRPM))
+	r.SetSpeeding(mapJSONDriverBehaviourScoreToProto(j.Speeding))
+	if j.DistanceGPS != nil {
+		r.SetDistanceGpsKm(*j.DistanceGPS)
+	}
+	if j.DistanceCAN != nil {
+		r.SetDistanceCanKm(*j.DistanceCAN)
+	}
+	if j.DrivingDuration != nil {
+		r.SetDrivingDurationS(int64(*j.DrivingDuration))
+	}
+	if j.ExcessiveIdlingPTODuration != nil {
+		r.SetExcessiveIdlingPtoDurationS(int64(*j.ExcessiveIdlingPTODuration))
+	}
+	if j.FuelAvgConsumption != nil {
+		r.SetFuelAvgConsumption(*j.FuelAvgConsumption)
+	}
+	if j.FuelAvgConsumptionMeasure != nil {
+		r.SetFuelAvgConsumptionUnit(*j.FuelAvgConsumptionMeasure)
+	}
+	if j.FuelAvgConsumptionByNorm != nil {
+		r.SetFuelAvgConsumptionByNorm(*j.FuelAvgConsumptionByNorm)
+	}
+	if j.FuelAvgConsumptionRatio != nil {
+		r.SetFuelAvgConsumptionRatio(*j.FuelAvgConsumptionRatio)
+	}
+	if j.FuelConsumption != nil {
+		r.SetFuelConsumption(*j.FuelConsumption)
+	}
+	if j.FuelConsumptionCAN != nil {
+		r.SetFuelConsumptionCan(*j.FuelConsumptionCAN)
+	}
+	if j.ExcessiveIdlingConsumptionCAN != nil {
+		r.SetExcessiveIdlingFuelConsumptionCan(*j.ExcessiveIdlingConsumptionCAN)
+	}
+	if j.PTOIdleFuelConsumptionCAN != nil {
+		r.SetPtoIdleFuelConsumptionCan(*j.PTOIdleFuelConsumptionCAN)
+	}
+	if j.StopCount != nil {
+		r.SetStopCount(int64(*j.StopCount))
+	}
+	if j.StopDuration != nil {
+		r.SetStopDurationS(int64(*j.StopDuration))
+	}
+	if j.AvgSpeed != nil {
+		r.SetAvgSpeedKmh(*j.AvgSpeed)
+	}
+	return r
+}
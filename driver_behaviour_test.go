@@ -0,0 +1,86 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+func TestGetDriverBehaviourReportDrivers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/driver_behaviour/report_drivers.json" {
+			t.Errorf("expected /driver_behaviour/report_drivers.json, got %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("date_from") != "2024-05-01" || query.Get("date_till") != "2024-05-07" ||
+			query.Get("metrics") != "harsh_braking,speeding" || query.Get("driver_ids") != "1,2" {
+			t.Errorf("unexpected query: %v", query)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [
+				{
+					"user_id": 1,
+					"title": "John Smith",
+					"driven_units_ids": [1],
+					"overall": {"grade": "D", "score": 48.75},
+					"excessive_idling": {"grade": "F", "score": 25.84, "duration": 24930},
+					"harsh_braking": {"grade": "B", "score": 8.96, "count": 10},
+					"speeding": {"grade": "F", "score": 20.51, "distance": 88.052, "duration": 3486},
+					"cruise_control": null,
+					"distance_gps": 1609.468,
+					"driving_duration": 96478,
+					"fuel_avg_consumption": 13.75,
+					"fuel_avg_consumption_measure": "l/100km",
+					"fuel_consumption": null,
+					"stop_count": 98,
+					"avg_speed": 58.36
+				}
+			],
+			"_meta": {"total": 3, "totalPages": 3, "perPage": 1, "page": 1}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.GetDriverBehaviourReportDrivers(context.Background(), maponv1.GetDriverBehaviourReportDriversRequest_builder{
+		FromDate:  new("2024-05-01"),
+		ToDate:    new("2024-05-07"),
+		DriverIds: []int64{1, 2},
+		Metrics: []maponv1.DriverBehaviourMetric{
+			maponv1.DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_HARSH_BRAKING,
+			maponv1.DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_SPEEDING,
+		},
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetPagination().GetTotalPages() != 3 || len(resp.GetDrivers()) != 1 {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	driver := resp.GetDrivers()[0]
+	if driver.GetDriverId() != 1 || driver.GetName() != "John Smith" || len(driver.GetUnitIds()) != 1 {
+		t.Errorf("unexpected driver: %v", driver)
+	}
+	result := driver.GetResult()
+	if result.GetOverall().GetGrade() != "D" || result.GetOverall().GetScore() != 48.75 {
+		t.Errorf("unexpected overall: %v", result.GetOverall())
+	}
+	if result.GetHarshBraking().GetCount() != 10 || result.GetSpeeding().GetDurationS() != 3486 {
+		t.Errorf("unexpected scores: %v", result)
+	}
+	if result.HasCruiseControl() || result.HasFuelConsumption() {
+		t.Errorf("expected null values to be unset: %v", result)
+	}
+	if result.GetDrivingDurationS() != 96478 || result.GetFuelAvgConsumptionUnit() != "l/100km" {
+		t.Errorf("unexpected statistics: %v", result)
+	}
+}
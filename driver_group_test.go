@@ -0,0 +1,83 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+func TestListDriverGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/driver_groups/list.json" {
+			t.Errorf("expected /driver_groups/list.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("driver"); got != "7" {
+			t.Errorf("expected driver 7, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [
+				{"id": 1, "name": "Depot", "parent_id": null},
+				{"id": 2, "name": "Night shift", "parent_id": "1"},
+				{"id": 3, "name": "Day shift", "parent_id": 1}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListDriverGroups(context.Background(), maponv1.ListDriverGroupsRequest_builder{
+		DriverId: new(int64(7)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetGroups()) != 3 {
+		t.Fatalf("expected 3 groups, got %d", len(resp.GetGroups()))
+	}
+	for i, want := range []int64{0, 1, 1} {
+		if got := resp.GetGroups()[i].GetParentId(); got != want {
+			t.Errorf("group %d: expected parent_id %d, got %d", i, want, got)
+		}
+	}
+}
+
+func TestAddDriverToGroup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/driver_groups/add_driver.json" {
+			t.Errorf("expected /driver_groups/add_driver.json, got %s", r.URL.Path)
+		}
+		_ = r.ParseForm()
+		if r.PostForm.Get("key") != "test-key" || r.PostForm.Get("id") != "2" || r.PostForm.Get("driver") != "7" {
+			t.Errorf("unexpected form: %v", r.PostForm)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"error": {"code": 5, "msg": "Invalid driver parameter"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	_, err = client.AddDriverToGroup(context.Background(), maponv1.AddDriverToGroupRequest_builder{
+		GroupId:  new(int64(2)),
+		DriverId: new(int64(7)),
+	}.Build())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if want := "mapon: add driver to group: api error 5: Invalid driver parameter"; err.Error() != want {
+		t.Errorf("expected error %q, got %q", want, err.Error())
+	}
+}
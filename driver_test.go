@@ -0,0 +1,286 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListDriverCustomFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/driver/custom_fields.json" {
+			t.Errorf("expected /driver/custom_fields.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query()["ids[]"]; len(got) != 2 {
+			t.Errorf("expected 2 ids, got %v", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"drivers": [
+					{
+						"id": 5021,
+						"fields": [
+							{"id": 1, "label": "CF date", "type": "date", "settings": [], "value": 1609417794},
+							{"id": 2, "label": "CF link", "type": "hyperlink", "settings": [], "value": "https://example.com", "title": "Example"},
+							{"id": 3, "label": "CF select", "type": "select", "settings": {"options": {"a1": "true", "b2": "false"}}, "value": "a1"},
+							{"id": 4, "label": "CF other", "type": "checkbox", "settings": [], "value": null}
+						]
+					},
+					{"id": 87359, "fields": []}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListDriverCustomFields(context.Background(), maponv1.ListDriverCustomFieldsRequest_builder{
+		DriverIds: []int64{5021, 87359},
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetDrivers()) != 2 {
+		t.Fatalf("expected 2 drivers, got %d", len(resp.GetDrivers()))
+	}
+	fields := resp.GetDrivers()[0].GetFields()
+	if len(fields) != 4 {
+		t.Fatalf("expected 4 fields, got %d", len(fields))
+	}
+	if fields[0].GetType() != maponv1.CustomField_TYPE_DATE || fields[0].GetValue() != "1609417794" {
+		t.Errorf("unexpected date field: %v", fields[0])
+	}
+	if fields[1].GetTitle() != "Example" {
+		t.Errorf("expected hyperlink title Example, got %q", fields[1].GetTitle())
+	}
+	if fields[2].GetOptions()["b2"] != "false" || fields[2].GetValue() != "a1" {
+		t.Errorf("unexpected select field: %v", fields[2])
+	}
+	if fields[3].GetType() != maponv1.CustomField_TYPE_UNRECOGNIZED || fields[3].GetUnrecognizedType() != "checkbox" {
+		t.Errorf("expected unrecognized type checkbox, got %v %q", fields[3].GetType(), fields[3].GetUnrecognizedType())
+	}
+}
+
+func TestUpsertDriverByExternalID(t *testing.T) {
+	const driversResponse = `{
+		"data": {
+			"drivers": [
+				{"id": 1, "name": "John", "surname": "Doe", "email": "john@example.com", "fields": {"77": "EMP001"}},
+				{"id": 2, "name": "Jane", "surname": "Roe", "fields": []}
+			]
+		}
+	}`
+	for _, tt := range []struct {
+		name        string
+		externalID  string
+		driver      *maponv1.Driver
+		tagFails    bool
+		wantID      int64
+		wantCreated bool
+		wantErr     bool
+		wantCalls   []string
+	}{
+		{
+			name:       "unchanged",
+			externalID: "EMP001",
+			driver: maponv1.Driver_builder{
+				Name:    new("John"),
+				Surname: new("Doe"),
+				Email:   new("john@example.com"),
+			}.Build(),
+			wantID:    1,
+			wantCalls: []string{"/driver/list.json"},
+		},
+		{
+			name:       "unchanged with surrounding whitespace",
+			externalID: "EMP001",
+			driver: maponv1.Driver_builder{
+				Name:    new(" John "),
+				Surname: new("Doe\n"),
+				Email:   new("john@example.com"),
+			}.Build(),
+			wantID:    1,
+			wantCalls: []string{"/driver/list.json"},
+		},
+		{
+			name:       "changed",
+			externalID: "EMP001",
+			driver: maponv1.Driver_builder{
+				Name:    new("Johnny"),
+				Surname: new("Doe"),
+				Email:   new("john@example.com"),
+			}.Build(),
+			wantID:    1,
+			wantCalls: []string{"/driver/list.json", "/driver/update.json"},
+		},
+		{
+			name:       "new",
+			externalID: "EMP002",
+			driver: maponv1.Driver_builder{
+				Name:    new("Max"),
+				Surname: new("Mustermann"),
+			}.Build(),
+			wantID:      3,
+			wantCreated: true,
+			wantCalls:   []string{"/driver/list.json", "/driver/create.json", "/driver/save_custom_fields_values.json"},
+		},
+		{
+			name:       "new with external ID failure",
+			externalID: "EMP002",
+			driver: maponv1.Driver_builder{
+				Name:    new("Max"),
+				Surname: new("Mustermann"),
+			}.Build(),
+			tagFails: true,
+			wantErr:  true,
+			wantCalls: []string{
+				"/driver/list.json", "/driver/create.json", "/driver/save_custom_fields_values.json", "/driver/delete.json",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/driver/list.json":
+					if got := r.URL.Query().Get("include"); got != "fields" {
+						t.Errorf("expected include fields, got %q", got)
+					}
+					_, _ = w.Write([]byte(driversResponse))
+				case "/driver/update.json":
+					_ = r.ParseForm()
+					if r.PostForm.Get("driver_id") != "1" || r.PostForm.Get("name") != "Johnny" {
+						t.Errorf("unexpected update form: %v", r.PostForm)
+					}
+					if _, ok := r.PostForm["surname"]; ok {
+						t.Errorf("expected unchanged surname not to be sent")
+					}
+					_, _ = w.Write([]byte(`{"status": "successful"}`))
+				case "/driver/create.json":
+					_ = r.ParseForm()
+					if r.PostForm.Get("name") != "Max" || r.PostForm.Get("surname") != "Mustermann" {
+						t.Errorf("unexpected create form: %v", r.PostForm)
+					}
+					_, _ = w.Write([]byte(`{"id": 3, "password": "password123"}`))
+				case "/driver/save_custom_fields_values.json":
+					_ = r.ParseForm()
+					if r.PostForm.Get("id") != "3" || r.PostForm.Get("fields[77]") != "EMP002" {
+						t.Errorf("unexpected custom field values form: %v", r.PostForm)
+					}
+					if tt.tagFails {
+						_, _ = w.Write([]byte(`{"error": {"code": 1, "msg": "Invalid field"}}`))
+						return
+					}
+					_, _ = w.Write([]byte(`{"data": {"status": "ok"}}`))
+				case "/driver/delete.json":
+					_ = r.ParseForm()
+					if r.PostForm.Get("driver_id") != "3" {
+						t.Errorf("unexpected delete form: %v", r.PostForm)
+					}
+					_, _ = w.Write([]byte(`{"status": "successful"}`))
+				default:
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			client.baseURL = server.URL
+
+			driverID, created, err := client.UpsertDriverByExternalID(context.Background(), 77, tt.externalID, tt.driver)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("expected error %t, got %v", tt.wantErr, err)
+			}
+			if driverID != tt.wantID || created != tt.wantCreated {
+				t.Errorf("expected driver %d created=%t, got %d created=%t", tt.wantID, tt.wantCreated, driverID, created)
+			}
+			if len(calls) != len(tt.wantCalls) {
+				t.Fatalf("expected calls %v, got %v", tt.wantCalls, calls)
+			}
+			for i := range calls {
+				if calls[i] != tt.wantCalls[i] {
+					t.Errorf("expected calls %v, got %v", tt.wantCalls, calls)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestListDriverDailyActivities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/driver/daily_activities.json" {
+			t.Errorf("expected /driver/daily_activities.json, got %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("driver") != "42" || query.Get("from") != "2023-07-02T00:00:00Z" || query.Get("include") != "card_events" {
+			t.Errorf("unexpected query: %v", query)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{
+				"day": "2023-07-03 00:00:00",
+				"summary": {"shift": 23160, "driving": 14640, "rest": 31080},
+				"activities": [
+					{"start": 1688331600, "end": 1688356920, "duration": 25320, "status": "REST", "source": "unkn", "unitId": 424168},
+					{"start": 1688356920, "end": 1688356920, "duration": 0, "status": "CARD_INSERTED", "source": "ddd", "unitId": 424168},
+					{"start": 1688357940, "end": 1688358000, "duration": 60, "status": "FERRY", "source": "gps", "unitId": 424168}
+				]
+			}
+		]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListDriverDailyActivities(context.Background(), maponv1.ListDriverDailyActivitiesRequest_builder{
+		DriverId:          new(int64(42)),
+		FromTime:          timestamppb.New(time.Date(2023, 7, 2, 0, 0, 0, 0, time.UTC)),
+		ToTime:            timestamppb.New(time.Date(2023, 7, 4, 0, 0, 0, 0, time.UTC)),
+		IncludeCardEvents: new(true),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetDays()) != 1 {
+		t.Fatalf("expected 1 day, got %d", len(resp.GetDays()))
+	}
+	day := resp.GetDays()[0]
+	if day.GetDate() != "2023-07-03" || day.GetDrivingS() != 14640 {
+		t.Errorf("unexpected day: %v", day)
+	}
+	if len(day.GetActivities()) != 3 {
+		t.Fatalf("expected 3 activities, got %d", len(day.GetActivities()))
+	}
+	rest := day.GetActivities()[0]
+	if rest.GetStatus() != maponv1.DriverActivity_STATUS_REST || rest.GetSource() != maponv1.DriverActivity_SOURCE_UNKNOWN {
+		t.Errorf("unexpected rest activity: %v", rest)
+	}
+	if !rest.GetEndTime().AsTime().Equal(time.Unix(1688356920, 0)) || rest.GetUnitId() != 424168 {
+		t.Errorf("unexpected rest activity: %v", rest)
+	}
+	unknown := day.GetActivities()[2]
+	if unknown.GetUnrecognizedStatus() != "FERRY" || unknown.GetUnrecognizedSource() != "gps" {
+		t.Errorf("expected unrecognized status and source, got %v", unknown)
+	}
+}
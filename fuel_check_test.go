@@ -0,0 +1,122 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListFuelChecks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/fuel_check/list.json" {
+			t.Errorf("expected /fuel_check/list.json, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"checks": [
+					{
+						"id": 7,
+						"unit_id": 199,
+						"card_id": "1234",
+						"card_name": "Card 1",
+						"gmt": "2018-07-24 13:00:00",
+						"check_no": "A-1",
+						"fuel_station": "Station",
+						"fuel_type": "D",
+						"liters": "20.000000",
+						"total_sum": "120.500000",
+						"currency": "EUR",
+						"location": {
+							"place": "Riga",
+							"coordinates": {"lat": 56.9, "lng": 24.1},
+							"object": {"id": null, "name": null}
+						},
+						"odometer_gps": 1000,
+						"odometer_can": 1200
+					}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListFuelChecks(context.Background(), maponv1.ListFuelChecksRequest_builder{
+		FromTime: timestamppb.New(time.Date(2018, 7, 1, 0, 0, 0, 0, time.UTC)),
+		ToTime:   timestamppb.New(time.Date(2018, 8, 1, 0, 0, 0, 0, time.UTC)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetChecks()) != 1 {
+		t.Fatalf("expected 1 check, got %d", len(resp.GetChecks()))
+	}
+	check := resp.GetChecks()[0]
+	if check.GetCheckId() != 7 {
+		t.Errorf("expected check_id 7, got %d", check.GetCheckId())
+	}
+	if check.GetVolume() != 20 {
+		t.Errorf("expected volume 20, got %v", check.GetVolume())
+	}
+	if check.GetTotalAmount() != 120.5 {
+		t.Errorf("expected total amount 120.5, got %v", check.GetTotalAmount())
+	}
+	if want := time.Date(2018, 7, 24, 13, 0, 0, 0, time.UTC); !check.GetTime().AsTime().Equal(want) {
+		t.Errorf("expected time %v, got %v", want, check.GetTime().AsTime())
+	}
+	if check.HasObjectId() {
+		t.Errorf("expected no object ID, got %d", check.GetObjectId())
+	}
+	if check.GetLocation().GetAddress() != "Riga" {
+		t.Errorf("expected address Riga, got %s", check.GetLocation().GetAddress())
+	}
+}
+
+func TestEditFuelCheck_OnlySetFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/fuel_check/edit.json" {
+			t.Errorf("expected /fuel_check/edit.json, got %s", r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("failed to parse form: %v", err)
+		}
+		if got := r.PostForm.Get("id"); got != "7" {
+			t.Errorf("expected id 7, got %s", got)
+		}
+		if got := r.PostForm.Get("unit_id"); got != "199" {
+			t.Errorf("expected unit_id 199, got %s", got)
+		}
+		for _, name := range []string{"date", "fuel_station", "liters", "amount"} {
+			if r.PostForm.Has(name) {
+				t.Errorf("expected %s to be omitted", name)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"status": "ok", "id": 7}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	req := &maponv1.EditFuelCheckRequest{}
+	req.SetCheckId(7)
+	req.SetUnitId(199)
+	if _, err := client.EditFuelCheck(context.Background(), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListFuelChanges(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/fuel/changes.json" {
+			t.Errorf("expected /fuel/changes.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query()["unit_id[]"]; len(got) != 1 || got[0] != "2" {
+			t.Errorf("expected unit_id[]=2, got %v", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [
+				{
+					"unit_id": 2,
+					"fuel_consumption_measurement": "l/h",
+					"sensor": [
+						{
+							"fuel_change": 275,
+							"fuel_before": 528,
+							"lat": 28.45721,
+							"lng": -43.2719,
+							"gmt": "2016-03-15T09:29:33Z",
+							"driver": "Driver A",
+							"driver_id": 1,
+							"address": "123 Main Street, City, AB 12345"
+						}
+					],
+					"can": [
+						{
+							"fuel_change": -27,
+							"fuel_before": 938,
+							"lat": 28.45721,
+							"lng": -43.2719,
+							"gmt": "2016-03-15T04:05:20Z",
+							"driver": "Driver B",
+							"driver_id": 2,
+							"address": "123 Main Street, City, AB 12345"
+						}
+					],
+					"number": "000 AAA"
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	req := &maponv1.ListFuelChangesRequest{}
+	req.SetUnitIds([]int64{2})
+	req.SetFromTime(timestamppb.Now())
+	req.SetToTime(timestamppb.Now())
+	resp, err := client.ListFuelChanges(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	units := resp.GetUnits()
+	if len(units) != 1 {
+		t.Fatalf("expected 1 unit, got %d", len(units))
+	}
+	changes := units[0].GetChanges()
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %d", len(changes))
+	}
+	// Changes are ordered by time across data sources.
+	drain := changes[0]
+	if drain.GetType() != maponv1.FuelChange_TYPE_DRAIN {
+		t.Errorf("expected first change to be a drain, got %v", drain.GetType())
+	}
+	if drain.GetSource() != maponv1.FuelDataSource_FUEL_DATA_SOURCE_CAN {
+		t.Errorf("expected first change from CAN, got %v", drain.GetSource())
+	}
+	if drain.GetVolume() != 27 {
+		t.Errorf("expected drain volume 27, got %v", drain.GetVolume())
+	}
+	refuel := changes[1]
+	if refuel.GetType() != maponv1.FuelChange_TYPE_REFUEL {
+		t.Errorf("expected second change to be a refuel, got %v", refuel.GetType())
+	}
+	if refuel.GetVolume() != 275 || refuel.GetLevelBefore() != 528 {
+		t.Errorf("unexpected refuel volume %v and level %v", refuel.GetVolume(), refuel.GetLevelBefore())
+	}
+	if refuel.GetLocation().GetAddress() != "123 Main Street, City, AB 12345" {
+		t.Errorf("unexpected refuel address %s", refuel.GetLocation().GetAddress())
+	}
+}
@@ -0,0 +1,307 @@
+package mapon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"mime/multipart"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type jsonMessagingChannel struct {
+	ID            int64        `json:"id"`
+	Name          string       `json:"name"`
+	IsAdmin       bool         `json:"is_admin"`
+	IsMuted       bool         `json:"is_muted"`
+	LastMessageAt *string      `json:"last_message_at"`
+	LastMessage   *jsonMessage `json:"last_message"`
+	UnreadTotal   int32        `json:"unread_total"`
+	UserCount     int32        `json:"user_count"`
+}
+
+type jsonMessagingConversation struct {
+	ID               json.Number             `json:"id"` // API returns string "1"
+	Title            string                  `json:"title"`
+	Type             string                  `json:"type"`
+	UnreadTotal      int32                   `json:"unread_total"`
+	RemovedAt        *string                 `json:"removed_at"`
+	IsFavorite       bool                    `json:"is_favorite"`
+	IsAdmin          bool                    `json:"is_admin"`
+	JoinedAt         *string                 `json:"joined_at"`
+	LastMessageAt    *string                 `json:"last_message_at"`
+	LastMessage      *jsonMessage            `json:"last_message"`
+	ConversationWith *jsonMessageParticipant `json:"conversation_with"` // Null in group conversations
+}
+
+type jsonMessage struct {
+	ID        int64   `json:"id"`
+	Status    string  `json:"status"`
+	CreatedAt string  `json:"created_at"` // "1970-01-01T00:00:02+00:00"
+	Text      *string `json:"text"`
+	GPS       *struct {
+		Lat     float64 `json:"lat"`
+		Lng     float64 `json:"lng"`
+		Address string  `json:"address"`
+	} `json:"gps"`
+	Attachments []struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		Mime     string `json:"mime"`
+		URL      string `json:"url"`
+		ThumbURL string `json:"thumb_url"`
+		Size     int64  `json:"size"`
+	} `json:"attachments"`
+	Sender *jsonMessageParticipant `json:"sender"`
+}
+
+type jsonMessageParticipant struct {
+	ID     int64  `json:"id"`
+	UnitID *int64 `json:"unit_id"`
+	UserID *int64 `json:"user_id"`
+	Title  string `json:"title"`
+}
+
+type jsonMessagesResponse struct {
+	Data  []jsonMessage `json:"data"`
+	Error *jsonError    `json:"error"`
+}
+
+type jsonMessagingConversationsResponse struct {
+	Data  []jsonMessagingConversation `json:"data"`
+	Error *jsonError                  `json:"error"`
+}
+
+var messageStatuses = map[string]maponv1.Message_Status{
+	"sent":      maponv1.Message_STATUS_SENT,
+	"delivered": maponv1.Message_STATUS_DELIVERED,
+	"read":      maponv1.Message_STATUS_READ,
+	"unread":    maponv1.Message_STATUS_UNREAD,
+}
+
+var messagingConversationTypes = map[string]maponv1.MessagingConversation_Type{
+	"individual": maponv1.MessagingConversation_TYPE_INDIVIDUAL,
+	"group":      maponv1.MessagingConversation_TYPE_GROUP,
+}
+
+var messagingMedia = map[string]maponv1.MessagingMedium{
+	"general": maponv1.MessagingMedium_MESSAGING_MEDIUM_GENERAL,
+	"sms":     maponv1.MessagingMedium_MESSAGING_MEDIUM_SMS,
+	"garmin":  maponv1.MessagingMedium_MESSAGING_MEDIUM_GARMIN,
+}
+
+// formatMessagingMedium returns the API name of a messaging medium, defaulting to "general".
+func formatMessagingMedium(m maponv1.MessagingMedium) (string, error) {
+	if m == maponv1.MessagingMedium_MESSAGING_MEDIUM_UNSPECIFIED {
+		return "general", nil
+	}
+	for name, value := range messagingMedia {
+		if value == m {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported messaging medium %s", m)
+}
+
+// formatMessagingConversationType returns the API name of a conversation type.
+func formatMessagingConversationType(t maponv1.MessagingConversation_Type) (string, error) {
+	for name, value := range messagingConversationTypes {
+		if value == t {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported conversation type %s", t)
+}
+
+// parseMessagingTime parses an optional RFC 3339 messaging timestamp.
+func parseMessagingTime(s *string) *timestamppb.Timestamp {
+	if s == nil {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func mapJSONMessageParticipantToProto(j *jsonMessageParticipant) *maponv1.MessageParticipant {
+	p := &maponv1.MessageParticipant{}
+	p.SetParticipantId(j.ID)
+	if j.UnitID != nil {
+		p.SetUnitId(*j.UnitID)
+	}
+	if j.UserID != nil {
+		p.SetUserId(*j.UserID)
+	}
+	p.SetTitle(j.Title)
+	return p
+}
+
+func mapJSONMessageToProto(j *jsonMessage) *maponv1.Message {
+	m := &maponv1.Message{}
+	m.SetMessageId(j.ID)
+	if status, ok := messageStatuses[j.Status]; ok {
+		m.SetStatus(status)
+	} else if j.Status != "" {
+		m.SetStatus(maponv1.Message_STATUS_UNRECOGNIZED)
+		m.SetUnrecognizedStatus(j.Status)
+	}
+	if createdAt := parseMessagingTime(&j.CreatedAt); createdAt != nil {
+		m.SetCreatedAt(createdAt)
+	}
+	if j.Text != nil {
+		m.SetText(*j.Text)
+	}
+	if j.GPS != nil {
+		location := &maponv1.Location{}
+		location.SetLatitude(j.GPS.Lat)
+		location.SetLongitude(j.GPS.Lng)
+		location.SetAddress(j.GPS.Address)
+		m.SetLocation(location)
+	}
+	attachments := make([]*maponv1.MessageAttachment, 0, len(j.Attachments))
+	for _, ja := range j.Attachments {
+		a := &maponv1.MessageAttachment{}
+		a.SetAttachmentId(ja.ID)
+		a.SetName(ja.Name)
+		a.SetMimeType(ja.Mime)
+		a.SetUrl(ja.URL)
+		a.SetThumbnailUrl(ja.ThumbURL)
+		a.SetSizeBytes(ja.Size)
+		attachments = append(attachments, a)
+	}
+	m.SetAttachments(attachments)
+	if j.Sender != nil {
+		m.SetSender(mapJSONMessageParticipantToProto(j.Sender))
+		switch {
+		case j.Sender.UnitID != nil:
+			m.SetDirection(maponv1.Message_DIRECTION_INBOUND)
+		case j.Sender.UserID != nil:
+			m.SetDirection(maponv1.Message_DIRECTION_OUTBOUND)
+		}
+	}
+	return m
+}
+
+func mapJSONMessagingChannelToProto(j *jsonMessagingChannel) *maponv1.MessagingChannel {
+	c := &maponv1.MessagingChannel{}
+	c.SetChannelId(j.ID)
+	c.SetName(j.Name)
+	c.SetIsAdmin(j.IsAdmin)
+	c.SetIsMuted(j.IsMuted)
+	if t := parseMessagingTime(j.LastMessageAt); t != nil {
+		c.SetLastMessageAt(t)
+	}
+	if j.LastMessage != nil {
+		c.SetLastMessage(mapJSONMessageToProto(j.LastMessage))
+	}
+	c.SetUnreadTotal(j.UnreadTotal)
+	c.SetUserCount(j.UserCount)
+	return c
+}
+
+func mapJSONMessagingConversationToProto(j *jsonMessagingConversation) (*maponv1.MessagingConversation, error) {
+	id, err := j.ID.Int64()
+	if err != nil {
+		return nil, fmt.Errorf("invalid conversation ID %q: %w", j.ID, err)
+	}
+	c := &maponv1.MessagingConversation{}
+	c.SetConversationId(id)
+	c.SetTitle(j.Title)
+	if t, ok := messagingConversationTypes[j.Type]; ok {
+		c.SetType(t)
+	} else if j.Type != "" {
+		c.SetType(maponv1.MessagingConversation_TYPE_UNRECOGNIZED)
+		c.SetUnrecognizedType(j.Type)
+	}
+	c.SetUnreadTotal(j.UnreadTotal)
+	if t := parseMessagingTime(j.RemovedAt); t != nil {
+		c.SetRemovedAt(t)
+	}
+	c.SetIsFavorite(j.IsFavorite)
+	c.SetIsAdmin(j.IsAdmin)
+	if t := parseMessagingTime(j.JoinedAt); t != nil {
+		c.SetJoinedAt(t)
+	}
+	if t := parseMessagingTime(j.LastMessageAt); t != nil {
+		c.SetLastMessageAt(t)
+	}
+	if j.LastMessage != nil {
+		c.SetLastMessage(mapJSONMessageToProto(j.LastMessage))
+	}
+	if j.ConversationWith != nil {
+		c.SetConversationWith(mapJSONMessageParticipantToProto(j.ConversationWith))
+	}
+	return c, nil
+}
+
+// addMessageParams adds the content of a message as form parameters.
+// The parameter names are prefixed, e.g. "message_" when creating a conversation.
+func addMessageParams(
+	params url.Values,
+	prefix string,
+	text string,
+	location *maponv1.Location,
+	attachments []*maponv1.MessageAttachmentUpload,
+) error {
+	if text == "" && location == nil && len(attachments) == 0 {
+		return fmt.Errorf("empty message: text, location or attachments required")
+	}
+	if text != "" {
+		params.Add(prefix+"text", text)
+	}
+	if location != nil {
+		params.Add(prefix+"gps[lat]", strconv.FormatFloat(location.GetLatitude(), 'f', -1, 64))
+		params.Add(prefix+"gps[lng]", strconv.FormatFloat(location.GetLongitude(), 'f', -1, 64))
+		if location.GetAddress() != "" {
+			params.Add(prefix+"gps[name]", location.GetAddress())
+		}
+	}
+	return nil
+}
+
+// newMessageRequestBody returns the body and content type of a message request.
+// Requests with attachments are sent as multipart/form-data, others as URL-encoded forms.
+func newMessageRequestBody(
+	params url.Values,
+	attachmentsField string,
+	attachments []*maponv1.MessageAttachmentUpload,
+) (io.Reader, string, error) {
+	if len(attachments) == 0 {
+		return strings.NewReader(params.Encode()), "application/x-www-form-urlencoded", nil
+	}
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for _, key := range slices.Sorted(maps.Keys(params)) {
+		for _, value := range params[key] {
+			if err := w.WriteField(key, value); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+	for i, attachment := range attachments {
+		if attachment.GetName() == "" {
+			return nil, "", fmt.Errorf("attachment %d: missing name", i)
+		}
+		part, err := w.CreateFormFile(attachmentsField+"[]", attachment.GetName())
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := part.Write(attachment.GetContent()); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+	return &body, w.FormDataContentType(), nil
+}
@@ -0,0 +1,168 @@
+package mapon
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+func TestListMessages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/messaging_messages/list.json" {
+			t.Errorf("expected /messaging_messages/list.json, got %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("conversation_id") != "5" || query.Get("cursor") != "10" || query.Get("cursor_direction") != "next" {
+			t.Errorf("unexpected query: %v", query)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [
+				{
+					"id": 11,
+					"status": "unread",
+					"created_at": "2024-05-01T08:30:00+00:00",
+					"text": null,
+					"gps": {"lat": 56.95, "lng": 24.1, "address": "Riga"},
+					"attachments": [
+						{"id": 3, "name": "cmr.jpg", "mime": "image/jpeg", "url": "https://example.com/cmr.jpg", "thumb_url": "https://example.com/t.jpg", "size": 2048}
+					],
+					"sender": {"id": 7, "unit_id": 199, "user_id": null, "title": "AB-1234"}
+				},
+				{
+					"id": 12,
+					"status": "delivered",
+					"created_at": "2024-05-01T08:31:00+00:00",
+					"text": "Thanks",
+					"gps": null,
+					"attachments": null,
+					"sender": {"id": 8, "unit_id": null, "user_id": 1, "title": "Dispatcher"}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListMessages(context.Background(), maponv1.ListMessagesRequest_builder{
+		UserId:         new(int64(1)),
+		ConversationId: new(int64(5)),
+		AfterMessageId: new(int64(10)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetMessages()) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(resp.GetMessages()))
+	}
+	inbound := resp.GetMessages()[0]
+	if inbound.GetDirection() != maponv1.Message_DIRECTION_INBOUND || inbound.GetStatus() != maponv1.Message_STATUS_UNREAD {
+		t.Errorf("unexpected inbound message: %v", inbound)
+	}
+	if inbound.GetSender().GetUnitId() != 199 || inbound.GetLocation().GetAddress() != "Riga" {
+		t.Errorf("unexpected inbound message: %v", inbound)
+	}
+	if len(inbound.GetAttachments()) != 1 || inbound.GetAttachments()[0].GetSizeBytes() != 2048 {
+		t.Errorf("unexpected attachments: %v", inbound.GetAttachments())
+	}
+	outbound := resp.GetMessages()[1]
+	if outbound.GetDirection() != maponv1.Message_DIRECTION_OUTBOUND || outbound.GetText() != "Thanks" || outbound.HasLocation() {
+		t.Errorf("unexpected outbound message: %v", outbound)
+	}
+	if outbound.GetCreatedAt().AsTime().Minute() != 31 {
+		t.Errorf("unexpected created at: %v", outbound.GetCreatedAt().AsTime())
+	}
+}
+
+func TestSendMessageWithAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/messaging_messages/send.json" {
+			t.Errorf("expected /messaging_messages/send.json, got %s", r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("failed to parse multipart form: %v", err)
+		}
+		if r.FormValue("key") != "test-key" || r.FormValue("conversation_id") != "5" || r.FormValue("text") != "Load at gate 3" {
+			t.Errorf("unexpected form: %v", r.MultipartForm.Value)
+		}
+		files := r.MultipartForm.File["attachments[]"]
+		if len(files) != 1 || files[0].Filename != "job.txt" {
+			t.Fatalf("unexpected files: %v", r.MultipartForm.File)
+		}
+		f, _ := files[0].Open()
+		content, _ := io.ReadAll(f)
+		if string(content) != "instructions" {
+			t.Errorf("unexpected file content: %q", content)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [{"id": 13, "status": "sent", "created_at": "2024-05-01T08:32:00+00:00", "text": "Load at gate 3", "sender": {"id": 8, "unit_id": null, "user_id": 1, "title": "Dispatcher"}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.SendMessage(context.Background(), maponv1.SendMessageRequest_builder{
+		UserId:         new(int64(1)),
+		ConversationId: new(int64(5)),
+		Text:           new("Load at gate 3"),
+		Attachments: []*maponv1.MessageAttachmentUpload{
+			maponv1.MessageAttachmentUpload_builder{Name: new("job.txt"), Content: []byte("instructions")}.Build(),
+		},
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetMessage().GetMessageId() != 13 || resp.GetMessage().GetStatus() != maponv1.Message_STATUS_SENT {
+		t.Errorf("unexpected message: %v", resp.GetMessage())
+	}
+}
+
+func TestCreateMessagingConversation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.PostForm.Get("type") != "individual" || r.PostForm.Get("medium") != "general" ||
+			r.PostForm.Get("receivers[0][type]") != "car" || r.PostForm.Get("receivers[0][id]") != "199" ||
+			r.PostForm.Get("message_text") != "Hello" {
+			t.Errorf("unexpected form: %v", r.PostForm)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [{"id": "5", "title": "AB-1234", "type": "individual", "conversation_with": {"id": 7, "unit_id": 199, "user_id": null, "title": "AB-1234"}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.CreateMessagingConversation(context.Background(), maponv1.CreateMessagingConversationRequest_builder{
+		UserId: new(int64(1)),
+		Receivers: []*maponv1.CreateMessagingConversationRequest_Receiver{
+			maponv1.CreateMessagingConversationRequest_Receiver_builder{UnitId: new(int64(199))}.Build(),
+		},
+		Text: new("Hello"),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetConversations()) != 1 || resp.GetConversations()[0].GetConversationId() != 5 {
+		t.Fatalf("unexpected conversations: %v", resp.GetConversations())
+	}
+	if c := resp.GetConversations()[0]; c.GetType() != maponv1.MessagingConversation_TYPE_INDIVIDUAL || c.GetConversationWith().GetUnitId() != 199 {
+		t.Errorf("unexpected conversation: %v", c)
+	}
+}
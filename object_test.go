@@ -0,0 +1,88 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+func TestSaveObject(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/object/save.json" {
+			t.Errorf("expected /object/save.json, got %s", r.URL.Path)
+		}
+		_ = r.ParseForm()
+		if r.PostForm.Get("name") != "Depot" || r.PostForm.Get("wkt_lon_first") != "1" || r.PostForm.Get("private") != "N" {
+			t.Errorf("unexpected form: %v", r.PostForm)
+		}
+		if _, ok := r.PostForm["id"]; ok {
+			t.Errorf("expected no id for a new object")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"status": "ok", "id": 17}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.SaveObject(context.Background(), maponv1.SaveObjectRequest_builder{
+		Name:        new("Depot"),
+		Wkt:         new("POLYGON((24.01 56.92, 24.02 56.92, 24.02 56.93, 24.01 56.92))"),
+		WktLonFirst: new(true),
+		IsPrivate:   new(false),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetObjectId() != 17 {
+		t.Errorf("expected object ID 17, got %d", resp.GetObjectId())
+	}
+
+	if _, err := client.SaveObject(context.Background(), maponv1.SaveObjectRequest_builder{
+		Name: new("Broken"),
+		Wkt:  new("POLYGON((24.01 56.92, 24.02 56.92, 24.02 56.93))"),
+	}.Build()); err == nil {
+		t.Errorf("expected error for invalid WKT")
+	}
+	if calls != 1 {
+		t.Errorf("expected invalid WKT not to be sent, got %d calls", calls)
+	}
+}
+
+func TestListObjectGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/object/list_groups.json" {
+			t.Errorf("expected /object/list_groups.json, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"groups": [{"id": 1, "name": "Group name", "user_id": "5", "private": "Y", "color": "FF0000"}]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListObjectGroups(context.Background(), &maponv1.ListObjectGroupsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetGroups()) != 1 {
+		t.Fatalf("expected 1 group, got %d", len(resp.GetGroups()))
+	}
+	g := resp.GetGroups()[0]
+	if g.GetUserId() != 5 || !g.GetIsPrivate() || g.GetColorHex() != "FF0000" {
+		t.Errorf("unexpected group: %v", g)
+	}
+}
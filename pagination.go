@@ -0,0 +1,25 @@
+package mapon
+
+import (
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// jsonPageMeta is the "_meta" object of paginated list responses.
+// Some endpoints spell the fields in camel case.
+type jsonPageMeta struct {
+	Total           int32 `json:"total"`
+	TotalPages      int32 `json:"total_pages"`
+	TotalPagesCamel int32 `json:"totalPages"`
+	PerPage         int32 `json:"per_page"`
+	PerPageCamel    int32 `json:"perPage"`
+	Page            int32 `json:"page"`
+}
+
+func mapJSONPageMetaToProto(j jsonPageMeta) *maponv1.Pagination {
+	p := &maponv1.Pagination{}
+	p.SetTotal(j.Total)
+	p.SetTotalPages(max(j.TotalPages, j.TotalPagesCamel))
+	p.SetPerPage(max(j.PerPage, j.PerPageCamel))
+	p.SetPage(j.Page)
+	return p
+}
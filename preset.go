@@ -0,0 +1,187 @@
+package mapon
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Preset permissions depend on company and distributor settings.
+// Permissions that are not known are kept by name as unrecognized permissions.
+
+type jsonPreset struct {
+	ID                       int64          `json:"id"`
+	Title                    string         `json:"title"`
+	IsCustom                 bool           `json:"is_custom"`
+	HasAccessToAllCars       bool           `json:"has_access_to_all_cars"`
+	HasAccessToAllDrivers    bool           `json:"has_access_to_all_drivers"`
+	HasAccessToAllObjects    bool           `json:"has_access_to_all_objects"`
+	HasAccessToAllMenus      bool           `json:"has_access_to_all_menus"`
+	HasAccessToAllBleBeacons bool           `json:"has_access_to_all_ble_beacons"`
+	Cars                     []int64        `json:"cars"`
+	CarGroups                []int64        `json:"carGroups"`
+	CarDepots                []int64        `json:"carDepots"`
+	Drivers                  []int64        `json:"drivers"`
+	DriverDepots             []int64        `json:"driverDepots"`
+	Objects                  []int64        `json:"objects"`
+	ObjectGroups             []int64        `json:"objectGroups"`
+	BleBeacons               []int64        `json:"bleBeacons"`
+	Menus                    []int64        `json:"menus"`
+	Permissions              map[string]any `json:"permissions"`
+	Restrictions             struct {
+		RestrictedMobileAccess bool    `json:"restricted_mobile_access"`
+		RestrictedIPRange      *string `json:"restricted_ip_range"`
+	} `json:"restrictions"`
+}
+
+type jsonPresetStatusResponse struct {
+	ID       int64      `json:"id"`
+	Status   string     `json:"status"`
+	Messages any        `json:"messages"`
+	Error    *jsonError `json:"error"`
+}
+
+var presetPermissions = map[string]maponv1.Permission{
+	"can_edit_object":            maponv1.Permission_PERMISSION_CAN_EDIT_OBJECT,
+	"has_limited_objects_access": maponv1.Permission_PERMISSION_HAS_LIMITED_OBJECTS_ACCESS,
+	"can_edit_obj_others":        maponv1.Permission_PERMISSION_CAN_EDIT_OBJ_OTHERS,
+	"can_access_tracking_link":   maponv1.Permission_PERMISSION_CAN_ACCESS_TRACKING_LINK,
+	"has_reefer_control":         maponv1.Permission_PERMISSION_HAS_REEFER_CONTROL,
+	"do_allow_sms_features":      maponv1.Permission_PERMISSION_DO_ALLOW_SMS_FEATURES,
+	"has_private_car_groups":     maponv1.Permission_PERMISSION_HAS_PRIVATE_CAR_GROUPS,
+	"can_control_device":         maponv1.Permission_PERMISSION_CAN_CONTROL_DEVICE,
+	"can_export_reefer_report":   maponv1.Permission_PERMISSION_CAN_EXPORT_REEFER_REPORT,
+	"communication_with_driver":  maponv1.Permission_PERMISSION_COMMUNICATION_WITH_DRIVER,
+}
+
+// PermissionName returns the API name of a preset permission, e.g. "can_edit_object".
+func PermissionName(p maponv1.Permission) (string, bool) {
+	for name, value := range presetPermissions {
+		if value == p {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// ParsePermission returns the preset permission with the given API name.
+func ParsePermission(name string) (maponv1.Permission, bool) {
+	p, ok := presetPermissions[name]
+	return p, ok
+}
+
+// mapJSONPresetPermissionsToProto returns the permissions of a JSON object sorted by name.
+func mapJSONPresetPermissionsToProto(permissions map[string]any) ([]*maponv1.PresetPermission, error) {
+	result := make([]*maponv1.PresetPermission, 0, len(permissions))
+	for _, name := range slices.Sorted(maps.Keys(permissions)) {
+		value, err := structpb.NewValue(permissions[name])
+		if err != nil {
+			return nil, fmt.Errorf("permission %s: %w", name, err)
+		}
+		p := &maponv1.PresetPermission{}
+		if permission, ok := presetPermissions[name]; ok {
+			p.SetPermission(permission)
+		} else {
+			p.SetPermission(maponv1.Permission_PERMISSION_UNRECOGNIZED)
+			p.SetUnrecognizedPermission(name)
+		}
+		p.SetValue(value)
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+func mapJSONPresetToProto(j jsonPreset) (*maponv1.Preset, error) {
+	p := &maponv1.Preset{}
+	p.SetPresetId(j.ID)
+	p.SetTitle(j.Title)
+	p.SetIsCustom(j.IsCustom)
+	p.SetHasAccessToAllUnits(j.HasAccessToAllCars)
+	p.SetHasAccessToAllDrivers(j.HasAccessToAllDrivers)
+	p.SetHasAccessToAllObjects(j.HasAccessToAllObjects)
+	p.SetHasAccessToAllMenus(j.HasAccessToAllMenus)
+	p.SetHasAccessToAllBleBeacons(j.HasAccessToAllBleBeacons)
+	p.SetUnitIds(j.Cars)
+	p.SetUnitGroupIds(j.CarGroups)
+	p.SetUnitDepotIds(j.CarDepots)
+	p.SetDriverIds(j.Drivers)
+	p.SetDriverDepotIds(j.DriverDepots)
+	p.SetObjectIds(j.Objects)
+	p.SetObjectGroupIds(j.ObjectGroups)
+	p.SetBleBeaconIds(j.BleBeacons)
+	p.SetMenuIds(j.Menus)
+	permissions, err := mapJSONPresetPermissionsToProto(j.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	p.SetPermissions(permissions)
+	p.SetRestrictedMobileAccess(j.Restrictions.RestrictedMobileAccess)
+	if j.Restrictions.RestrictedIPRange != nil {
+		p.SetRestrictedIpRange(*j.Restrictions.RestrictedIPRange)
+	}
+	return p, nil
+}
+
+// presetRequestBody returns the JSON request body fields for creating or editing a preset.
+func presetRequestBody(p *maponv1.Preset) (map[string]any, error) {
+	body := map[string]any{
+		"title":                         p.GetTitle(),
+		"has_access_to_all_cars":        p.GetHasAccessToAllUnits(),
+		"has_access_to_all_drivers":     p.GetHasAccessToAllDrivers(),
+		"has_access_to_all_objects":     p.GetHasAccessToAllObjects(),
+		"has_access_to_all_menus":       p.GetHasAccessToAllMenus(),
+		"has_access_to_all_ble_beacons": p.GetHasAccessToAllBleBeacons(),
+		"select_car_depots":             len(p.GetUnitDepotIds()) > 0,
+		"select_driver_depots":          len(p.GetDriverDepotIds()) > 0,
+		"menu_ids":                      nonNilInt64s(p.GetMenuIds()),
+		"car_ids":                       nonNilInt64s(p.GetUnitIds()),
+		"car_group_ids":                 nonNilInt64s(p.GetUnitGroupIds()),
+		"car_depot_ids":                 nonNilInt64s(p.GetUnitDepotIds()),
+		"driver_ids":                    nonNilInt64s(p.GetDriverIds()),
+		"driver_depot_ids":              nonNilInt64s(p.GetDriverDepotIds()),
+		"object_ids":                    nonNilInt64s(p.GetObjectIds()),
+		"object_group_ids":              nonNilInt64s(p.GetObjectGroupIds()),
+		"ble_beacon_ids":                nonNilInt64s(p.GetBleBeaconIds()),
+	}
+	if len(p.GetPermissions()) > 0 {
+		permissions := make(map[string]any, len(p.GetPermissions()))
+		for _, permission := range p.GetPermissions() {
+			name, ok := PermissionName(permission.GetPermission())
+			if !ok {
+				name = permission.GetUnrecognizedPermission()
+			}
+			if name == "" {
+				return nil, fmt.Errorf("unsupported permission %s", permission.GetPermission())
+			}
+			permissions[name] = permission.GetValue().AsInterface()
+		}
+		body["permissions"] = permissions
+	}
+	restrictions := map[string]any{
+		"restricted_mobile_access": p.GetRestrictedMobileAccess(),
+	}
+	if p.GetRestrictedIpRange() != "" {
+		restrictions["restricted_ip_range"] = p.GetRestrictedIpRange()
+	}
+	body["restrictions"] = restrictions
+	return body, nil
+}
+
+// nonNilInt64s returns ids, or an empty slice when ids is nil so it is encoded as an empty JSON array.
+func nonNilInt64s(ids []int64) []int64 {
+	if ids == nil {
+		return []int64{}
+	}
+	return ids
+}
+
+// checkPresetStatus returns an error for a preset response with a validation error status.
+func checkPresetStatus(r *jsonPresetStatusResponse) error {
+	if r.Status == "validation-error" {
+		return fmt.Errorf("validation error: %v", r.Messages)
+	}
+	return nil
+}
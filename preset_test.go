@@ -0,0 +1,124 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestGetPreset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/preset/one.json" {
+			t.Errorf("expected /preset/one.json, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"title": "Depot Riga",
+				"is_custom": false,
+				"has_access_to_all_cars": false,
+				"cars": [1, 2],
+				"carGroups": [],
+				"menus": [3],
+				"permissions": {
+					"can_edit_object": true,
+					"communication_with_driver": "default",
+					"can_fly": false
+				},
+				"restrictions": {"restricted_mobile_access": true, "restricted_ip_range": null}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.GetPreset(context.Background(), maponv1.GetPresetRequest_builder{
+		PresetId: new(int64(9)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	preset := resp.GetPreset()
+	if preset.GetPresetId() != 9 || preset.GetTitle() != "Depot Riga" || len(preset.GetUnitIds()) != 2 {
+		t.Errorf("unexpected preset: %v", preset)
+	}
+	if !preset.GetRestrictedMobileAccess() {
+		t.Errorf("expected restricted mobile access")
+	}
+	permissions := preset.GetPermissions()
+	if len(permissions) != 3 {
+		t.Fatalf("expected 3 permissions, got %d", len(permissions))
+	}
+	// Permissions are sorted by name.
+	if permissions[0].GetPermission() != maponv1.Permission_PERMISSION_CAN_EDIT_OBJECT || !permissions[0].GetValue().GetBoolValue() {
+		t.Errorf("unexpected permission: %v", permissions[0])
+	}
+	if permissions[1].GetPermission() != maponv1.Permission_PERMISSION_UNRECOGNIZED || permissions[1].GetUnrecognizedPermission() != "can_fly" {
+		t.Errorf("expected unrecognized permission can_fly, got %v", permissions[1])
+	}
+	if permissions[2].GetValue().GetStringValue() != "default" {
+		t.Errorf("unexpected permission: %v", permissions[2])
+	}
+}
+
+func TestCreatePreset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/preset/create.json" {
+			t.Errorf("expected /preset/create.json, got %s", r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		var body map[string]any
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Fatalf("invalid JSON body: %v", err)
+		}
+		if body["key"] != "test-key" || body["title"] != "Depot" || body["select_car_depots"] != true {
+			t.Errorf("unexpected body: %s", data)
+		}
+		if permissions, ok := body["permissions"].(map[string]any); !ok || permissions["can_control_device"] != true {
+			t.Errorf("unexpected permissions: %v", body["permissions"])
+		}
+		if ids, ok := body["object_ids"].([]any); !ok || len(ids) != 0 {
+			t.Errorf("expected empty object IDs, got %v", body["object_ids"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123, "status": "successful"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.CreatePreset(context.Background(), maponv1.CreatePresetRequest_builder{
+		Preset: maponv1.Preset_builder{
+			Title:        new("Depot"),
+			MenuIds:      []int64{3},
+			UnitDepotIds: []int64{7},
+			Permissions: []*maponv1.PresetPermission{
+				maponv1.PresetPermission_builder{
+					Permission: new(maponv1.Permission_PERMISSION_CAN_CONTROL_DEVICE),
+					Value:      structpb.NewBoolValue(true),
+				}.Build(),
+			},
+		}.Build(),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetPresetId() != 123 {
+		t.Errorf("expected preset ID 123, got %d", resp.GetPresetId())
+	}
+}
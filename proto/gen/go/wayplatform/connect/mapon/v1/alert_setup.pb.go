@@ -0,0 +1,1445 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/alert_setup.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Type enum defining the input types of alert setup fields.
+type AlertSetupField_Type int32
+
+const (
+	AlertSetupField_TYPE_UNSPECIFIED  AlertSetupField_Type = 0
+	AlertSetupField_TYPE_UNRECOGNIZED AlertSetupField_Type = 1
+	AlertSetupField_TYPE_SELECT       AlertSetupField_Type = 2
+	AlertSetupField_TYPE_DATE         AlertSetupField_Type = 3
+	AlertSetupField_TYPE_PHONE        AlertSetupField_Type = 4
+	AlertSetupField_TYPE_EMAIL        AlertSetupField_Type = 5
+	AlertSetupField_TYPE_CHECKBOX     AlertSetupField_Type = 6
+	AlertSetupField_TYPE_TEXT         AlertSetupField_Type = 7
+	AlertSetupField_TYPE_NUMERIC      AlertSetupField_Type = 8
+	AlertSetupField_TYPE_INTEGER      AlertSetupField_Type = 9
+)
+
+// Enum value maps for AlertSetupField_Type.
+var (
+	AlertSetupField_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_UNRECOGNIZED",
+		2: "TYPE_SELECT",
+		3: "TYPE_DATE",
+		4: "TYPE_PHONE",
+		5: "TYPE_EMAIL",
+		6: "TYPE_CHECKBOX",
+		7: "TYPE_TEXT",
+		8: "TYPE_NUMERIC",
+		9: "TYPE_INTEGER",
+	}
+	AlertSetupField_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":  0,
+		"TYPE_UNRECOGNIZED": 1,
+		"TYPE_SELECT":       2,
+		"TYPE_DATE":         3,
+		"TYPE_PHONE":        4,
+		"TYPE_EMAIL":        5,
+		"TYPE_CHECKBOX":     6,
+		"TYPE_TEXT":         7,
+		"TYPE_NUMERIC":      8,
+		"TYPE_INTEGER":      9,
+	}
+)
+
+func (x AlertSetupField_Type) Enum() *AlertSetupField_Type {
+	p := new(AlertSetupField_Type)
+	*p = x
+	return p
+}
+
+func (x AlertSetupField_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertSetupField_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_alert_setup_proto_enumTypes[0].Descriptor()
+}
+
+func (AlertSetupField_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_alert_setup_proto_enumTypes[0]
+}
+
+func (x AlertSetupField_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// AlertSetup represents a configured alert rule.
+type AlertSetup struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_SetupId     *string                `protobuf:"bytes,1,opt,name=setup_id,json=setupId"`
+	xxx_hidden_AlertType   *string                `protobuf:"bytes,2,opt,name=alert_type,json=alertType"`
+	xxx_hidden_Title       *string                `protobuf:"bytes,3,opt,name=title"`
+	xxx_hidden_Expired     bool                   `protobuf:"varint,4,opt,name=expired"`
+	xxx_hidden_Active      bool                   `protobuf:"varint,5,opt,name=active"`
+	xxx_hidden_Condition   *string                `protobuf:"bytes,6,opt,name=condition"`
+	xxx_hidden_UnitsTitle  *string                `protobuf:"bytes,7,opt,name=units_title,json=unitsTitle"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt"`
+	xxx_hidden_CreatedBy   *string                `protobuf:"bytes,9,opt,name=created_by,json=createdBy"`
+	xxx_hidden_ModifiedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=modified_at,json=modifiedAt"`
+	xxx_hidden_ModifiedBy  *string                `protobuf:"bytes,11,opt,name=modified_by,json=modifiedBy"`
+	xxx_hidden_Fields      *structpb.Struct       `protobuf:"bytes,12,opt,name=fields"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AlertSetup) Reset() {
+	*x = AlertSetup{}
+	mi := &file_wayplatform_connect_mapon_v1_alert_setup_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertSetup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertSetup) ProtoMessage() {}
+
+func (x *AlertSetup) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_alert_setup_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AlertSetup) GetSetupId() string {
+	if x != nil {
+		if x.xxx_hidden_SetupId != nil {
+			return *x.xxx_hidden_SetupId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetup) GetAlertType() string {
+	if x != nil {
+		if x.xxx_hidden_AlertType != nil {
+			return *x.xxx_hidden_AlertType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetup) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetup) GetExpired() bool {
+	if x != nil {
+		return x.xxx_hidden_Expired
+	}
+	return false
+}
+
+func (x *AlertSetup) GetActive() bool {
+	if x != nil {
+		return x.xxx_hidden_Active
+	}
+	return false
+}
+
+func (x *AlertSetup) GetCondition() string {
+	if x != nil {
+		if x.xxx_hidden_Condition != nil {
+			return *x.xxx_hidden_Condition
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetup) GetUnitsTitle() string {
+	if x != nil {
+		if x.xxx_hidden_UnitsTitle != nil {
+			return *x.xxx_hidden_UnitsTitle
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetup) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *AlertSetup) GetCreatedBy() string {
+	if x != nil {
+		if x.xxx_hidden_CreatedBy != nil {
+			return *x.xxx_hidden_CreatedBy
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetup) GetModifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ModifiedAt
+	}
+	return nil
+}
+
+func (x *AlertSetup) GetModifiedBy() string {
+	if x != nil {
+		if x.xxx_hidden_ModifiedBy != nil {
+			return *x.xxx_hidden_ModifiedBy
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetup) GetFields() *structpb.Struct {
+	if x != nil {
+		return x.xxx_hidden_Fields
+	}
+	return nil
+}
+
+func (x *AlertSetup) SetSetupId(v string) {
+	x.xxx_hidden_SetupId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 12)
+}
+
+func (x *AlertSetup) SetAlertType(v string) {
+	x.xxx_hidden_AlertType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 12)
+}
+
+func (x *AlertSetup) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 12)
+}
+
+func (x *AlertSetup) SetExpired(v bool) {
+	x.xxx_hidden_Expired = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 12)
+}
+
+func (x *AlertSetup) SetActive(v bool) {
+	x.xxx_hidden_Active = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 12)
+}
+
+func (x *AlertSetup) SetCondition(v string) {
+	x.xxx_hidden_Condition = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 12)
+}
+
+func (x *AlertSetup) SetUnitsTitle(v string) {
+	x.xxx_hidden_UnitsTitle = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 12)
+}
+
+func (x *AlertSetup) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *AlertSetup) SetCreatedBy(v string) {
+	x.xxx_hidden_CreatedBy = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 12)
+}
+
+func (x *AlertSetup) SetModifiedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ModifiedAt = v
+}
+
+func (x *AlertSetup) SetModifiedBy(v string) {
+	x.xxx_hidden_ModifiedBy = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 12)
+}
+
+func (x *AlertSetup) SetFields(v *structpb.Struct) {
+	x.xxx_hidden_Fields = v
+}
+
+func (x *AlertSetup) HasSetupId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *AlertSetup) HasAlertType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *AlertSetup) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *AlertSetup) HasExpired() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *AlertSetup) HasActive() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *AlertSetup) HasCondition() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *AlertSetup) HasUnitsTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *AlertSetup) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *AlertSetup) HasCreatedBy() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *AlertSetup) HasModifiedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ModifiedAt != nil
+}
+
+func (x *AlertSetup) HasModifiedBy() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *AlertSetup) HasFields() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Fields != nil
+}
+
+func (x *AlertSetup) ClearSetupId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_SetupId = nil
+}
+
+func (x *AlertSetup) ClearAlertType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_AlertType = nil
+}
+
+func (x *AlertSetup) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *AlertSetup) ClearExpired() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Expired = false
+}
+
+func (x *AlertSetup) ClearActive() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Active = false
+}
+
+func (x *AlertSetup) ClearCondition() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Condition = nil
+}
+
+func (x *AlertSetup) ClearUnitsTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_UnitsTitle = nil
+}
+
+func (x *AlertSetup) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *AlertSetup) ClearCreatedBy() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_CreatedBy = nil
+}
+
+func (x *AlertSetup) ClearModifiedAt() {
+	x.xxx_hidden_ModifiedAt = nil
+}
+
+func (x *AlertSetup) ClearModifiedBy() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_ModifiedBy = nil
+}
+
+func (x *AlertSetup) ClearFields() {
+	x.xxx_hidden_Fields = nil
+}
+
+type AlertSetup_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Opaque identifier of the alert setup.
+	SetupId *string
+	// Alert type of the setup (e.g., "speeding", "in_object").
+	AlertType *string
+	// Localized title of the alert type.
+	Title *string
+	// Indicates if the active period of the setup has ended.
+	Expired *bool
+	// Indicates if the setup is currently active.
+	Active *bool
+	// Localized human-readable alert condition.
+	Condition *string
+	// Localized title of the units covered by the setup (e.g., "All vehicles (104)").
+	UnitsTitle *string
+	// Timestamp when the setup was created.
+	CreatedAt *timestamppb.Timestamp
+	// Name of the user who created the setup.
+	CreatedBy *string
+	// Timestamp when the setup was last modified.
+	ModifiedAt *timestamppb.Timestamp
+	// Name of the user who last modified the setup.
+	ModifiedBy *string
+	// Field values of the setup, keyed by the field keys returned by GetAlertSetupFields.
+	Fields *structpb.Struct
+}
+
+func (b0 AlertSetup_builder) Build() *AlertSetup {
+	m0 := &AlertSetup{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.SetupId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 12)
+		x.xxx_hidden_SetupId = b.SetupId
+	}
+	if b.AlertType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 12)
+		x.xxx_hidden_AlertType = b.AlertType
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 12)
+		x.xxx_hidden_Title = b.Title
+	}
+	if b.Expired != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 12)
+		x.xxx_hidden_Expired = *b.Expired
+	}
+	if b.Active != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 12)
+		x.xxx_hidden_Active = *b.Active
+	}
+	if b.Condition != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 12)
+		x.xxx_hidden_Condition = b.Condition
+	}
+	if b.UnitsTitle != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 12)
+		x.xxx_hidden_UnitsTitle = b.UnitsTitle
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.CreatedBy != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 12)
+		x.xxx_hidden_CreatedBy = b.CreatedBy
+	}
+	x.xxx_hidden_ModifiedAt = b.ModifiedAt
+	if b.ModifiedBy != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 12)
+		x.xxx_hidden_ModifiedBy = b.ModifiedBy
+	}
+	x.xxx_hidden_Fields = b.Fields
+	return m0
+}
+
+// AlertSetupTypeGroup groups alert types that can be managed via the API.
+type AlertSetupTypeGroup struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GroupId     *string                `protobuf:"bytes,1,opt,name=group_id,json=groupId"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_AlertTypes  *[]*AlertSetupType     `protobuf:"bytes,3,rep,name=alert_types,json=alertTypes"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AlertSetupTypeGroup) Reset() {
+	*x = AlertSetupTypeGroup{}
+	mi := &file_wayplatform_connect_mapon_v1_alert_setup_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertSetupTypeGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertSetupTypeGroup) ProtoMessage() {}
+
+func (x *AlertSetupTypeGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_alert_setup_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AlertSetupTypeGroup) GetGroupId() string {
+	if x != nil {
+		if x.xxx_hidden_GroupId != nil {
+			return *x.xxx_hidden_GroupId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetupTypeGroup) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetupTypeGroup) GetAlertTypes() []*AlertSetupType {
+	if x != nil {
+		if x.xxx_hidden_AlertTypes != nil {
+			return *x.xxx_hidden_AlertTypes
+		}
+	}
+	return nil
+}
+
+func (x *AlertSetupTypeGroup) SetGroupId(v string) {
+	x.xxx_hidden_GroupId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *AlertSetupTypeGroup) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *AlertSetupTypeGroup) SetAlertTypes(v []*AlertSetupType) {
+	x.xxx_hidden_AlertTypes = &v
+}
+
+func (x *AlertSetupTypeGroup) HasGroupId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *AlertSetupTypeGroup) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *AlertSetupTypeGroup) ClearGroupId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GroupId = nil
+}
+
+func (x *AlertSetupTypeGroup) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+type AlertSetupTypeGroup_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Identifier of the group (e.g., "objects", "technical").
+	GroupId *string
+	// Localized name of the group.
+	Name *string
+	// Alert types in the group.
+	AlertTypes []*AlertSetupType
+}
+
+func (b0 AlertSetupTypeGroup_builder) Build() *AlertSetupTypeGroup {
+	m0 := &AlertSetupTypeGroup{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GroupId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_GroupId = b.GroupId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Name = b.Name
+	}
+	x.xxx_hidden_AlertTypes = &b.AlertTypes
+	return m0
+}
+
+// AlertSetupType describes an alert type that can be used in an alert setup.
+type AlertSetupType struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Type        *string                `protobuf:"bytes,1,opt,name=type"`
+	xxx_hidden_Title       *string                `protobuf:"bytes,2,opt,name=title"`
+	xxx_hidden_Description *string                `protobuf:"bytes,3,opt,name=description"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AlertSetupType) Reset() {
+	*x = AlertSetupType{}
+	mi := &file_wayplatform_connect_mapon_v1_alert_setup_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertSetupType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertSetupType) ProtoMessage() {}
+
+func (x *AlertSetupType) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_alert_setup_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AlertSetupType) GetType() string {
+	if x != nil {
+		if x.xxx_hidden_Type != nil {
+			return *x.xxx_hidden_Type
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetupType) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetupType) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetupType) SetType(v string) {
+	x.xxx_hidden_Type = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *AlertSetupType) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *AlertSetupType) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *AlertSetupType) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *AlertSetupType) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *AlertSetupType) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *AlertSetupType) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Type = nil
+}
+
+func (x *AlertSetupType) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *AlertSetupType) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Description = nil
+}
+
+type AlertSetupType_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Alert type key (e.g., "speeding").
+	Type *string
+	// Localized title of the alert type.
+	Title *string
+	// Localized description of the alert type.
+	Description *string
+}
+
+func (b0 AlertSetupType_builder) Build() *AlertSetupType {
+	m0 := &AlertSetupType{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Type = b.Type
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Title = b.Title
+	}
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Description = b.Description
+	}
+	return m0
+}
+
+// AlertSetupField describes a field that is accepted when storing an alert setup.
+type AlertSetupField struct {
+	state                       protoimpl.MessageState     `protogen:"opaque.v1"`
+	xxx_hidden_Field            *string                    `protobuf:"bytes,1,opt,name=field"`
+	xxx_hidden_FieldGroup       *string                    `protobuf:"bytes,2,opt,name=field_group,json=fieldGroup"`
+	xxx_hidden_Type             AlertSetupField_Type       `protobuf:"varint,3,opt,name=type,enum=wayplatform.connect.mapon.v1.AlertSetupField_Type"`
+	xxx_hidden_UnrecognizedType *string                    `protobuf:"bytes,4,opt,name=unrecognized_type,json=unrecognizedType"`
+	xxx_hidden_Title            *string                    `protobuf:"bytes,5,opt,name=title"`
+	xxx_hidden_Description      *string                    `protobuf:"bytes,6,opt,name=description"`
+	xxx_hidden_Required         bool                       `protobuf:"varint,7,opt,name=required"`
+	xxx_hidden_RequiredIf       *string                    `protobuf:"bytes,8,opt,name=required_if,json=requiredIf"`
+	xxx_hidden_Multiple         bool                       `protobuf:"varint,9,opt,name=multiple"`
+	xxx_hidden_Options          *[]*AlertSetupField_Option `protobuf:"bytes,10,rep,name=options"`
+	xxx_hidden_RangeMin         float64                    `protobuf:"fixed64,11,opt,name=range_min,json=rangeMin"`
+	xxx_hidden_RangeMax         float64                    `protobuf:"fixed64,12,opt,name=range_max,json=rangeMax"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *AlertSetupField) Reset() {
+	*x = AlertSetupField{}
+	mi := &file_wayplatform_connect_mapon_v1_alert_setup_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertSetupField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertSetupField) ProtoMessage() {}
+
+func (x *AlertSetupField) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_alert_setup_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AlertSetupField) GetField() string {
+	if x != nil {
+		if x.xxx_hidden_Field != nil {
+			return *x.xxx_hidden_Field
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetupField) GetFieldGroup() string {
+	if x != nil {
+		if x.xxx_hidden_FieldGroup != nil {
+			return *x.xxx_hidden_FieldGroup
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetupField) GetType() AlertSetupField_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return AlertSetupField_TYPE_UNSPECIFIED
+}
+
+func (x *AlertSetupField) GetUnrecognizedType() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedType != nil {
+			return *x.xxx_hidden_UnrecognizedType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetupField) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetupField) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetupField) GetRequired() bool {
+	if x != nil {
+		return x.xxx_hidden_Required
+	}
+	return false
+}
+
+func (x *AlertSetupField) GetRequiredIf() string {
+	if x != nil {
+		if x.xxx_hidden_RequiredIf != nil {
+			return *x.xxx_hidden_RequiredIf
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetupField) GetMultiple() bool {
+	if x != nil {
+		return x.xxx_hidden_Multiple
+	}
+	return false
+}
+
+func (x *AlertSetupField) GetOptions() []*AlertSetupField_Option {
+	if x != nil {
+		if x.xxx_hidden_Options != nil {
+			return *x.xxx_hidden_Options
+		}
+	}
+	return nil
+}
+
+func (x *AlertSetupField) GetRangeMin() float64 {
+	if x != nil {
+		return x.xxx_hidden_RangeMin
+	}
+	return 0
+}
+
+func (x *AlertSetupField) GetRangeMax() float64 {
+	if x != nil {
+		return x.xxx_hidden_RangeMax
+	}
+	return 0
+}
+
+func (x *AlertSetupField) SetField(v string) {
+	x.xxx_hidden_Field = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 12)
+}
+
+func (x *AlertSetupField) SetFieldGroup(v string) {
+	x.xxx_hidden_FieldGroup = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 12)
+}
+
+func (x *AlertSetupField) SetType(v AlertSetupField_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 12)
+}
+
+func (x *AlertSetupField) SetUnrecognizedType(v string) {
+	x.xxx_hidden_UnrecognizedType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 12)
+}
+
+func (x *AlertSetupField) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 12)
+}
+
+func (x *AlertSetupField) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 12)
+}
+
+func (x *AlertSetupField) SetRequired(v bool) {
+	x.xxx_hidden_Required = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 12)
+}
+
+func (x *AlertSetupField) SetRequiredIf(v string) {
+	x.xxx_hidden_RequiredIf = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 12)
+}
+
+func (x *AlertSetupField) SetMultiple(v bool) {
+	x.xxx_hidden_Multiple = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 12)
+}
+
+func (x *AlertSetupField) SetOptions(v []*AlertSetupField_Option) {
+	x.xxx_hidden_Options = &v
+}
+
+func (x *AlertSetupField) SetRangeMin(v float64) {
+	x.xxx_hidden_RangeMin = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 12)
+}
+
+func (x *AlertSetupField) SetRangeMax(v float64) {
+	x.xxx_hidden_RangeMax = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 12)
+}
+
+func (x *AlertSetupField) HasField() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *AlertSetupField) HasFieldGroup() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *AlertSetupField) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *AlertSetupField) HasUnrecognizedType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *AlertSetupField) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *AlertSetupField) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *AlertSetupField) HasRequired() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *AlertSetupField) HasRequiredIf() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *AlertSetupField) HasMultiple() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *AlertSetupField) HasRangeMin() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *AlertSetupField) HasRangeMax() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *AlertSetupField) ClearField() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Field = nil
+}
+
+func (x *AlertSetupField) ClearFieldGroup() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_FieldGroup = nil
+}
+
+func (x *AlertSetupField) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Type = AlertSetupField_TYPE_UNSPECIFIED
+}
+
+func (x *AlertSetupField) ClearUnrecognizedType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_UnrecognizedType = nil
+}
+
+func (x *AlertSetupField) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *AlertSetupField) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Description = nil
+}
+
+func (x *AlertSetupField) ClearRequired() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Required = false
+}
+
+func (x *AlertSetupField) ClearRequiredIf() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_RequiredIf = nil
+}
+
+func (x *AlertSetupField) ClearMultiple() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Multiple = false
+}
+
+func (x *AlertSetupField) ClearRangeMin() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_RangeMin = 0
+}
+
+func (x *AlertSetupField) ClearRangeMax() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_RangeMax = 0
+}
+
+type AlertSetupField_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Field key, used as the key in AlertSetup.fields.
+	Field *string
+	// Key of the group the field belongs to.
+	FieldGroup *string
+	// Input type of the field.
+	Type *AlertSetupField_Type
+	// The raw string value of the type if it is not one of the known Type enum values.
+	// This field is populated only when 'type' is TYPE_UNRECOGNIZED.
+	UnrecognizedType *string
+	// Localized name of the field.
+	Title *string
+	// Localized description of the field.
+	Description *string
+	// Indicates if the field is mandatory.
+	Required *bool
+	// Condition under which the field becomes required, in "field:value" format.
+	RequiredIf *string
+	// Indicates if the field accepts multiple values.
+	Multiple *bool
+	// Accepted values of the field. Only populated for select fields.
+	Options []*AlertSetupField_Option
+	// Inclusive lower bound of the value. Only populated for numeric and integer fields.
+	RangeMin *float64
+	// Inclusive upper bound of the value. Only populated for numeric and integer fields.
+	RangeMax *float64
+}
+
+func (b0 AlertSetupField_builder) Build() *AlertSetupField {
+	m0 := &AlertSetupField{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Field != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 12)
+		x.xxx_hidden_Field = b.Field
+	}
+	if b.FieldGroup != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 12)
+		x.xxx_hidden_FieldGroup = b.FieldGroup
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 12)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.UnrecognizedType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 12)
+		x.xxx_hidden_UnrecognizedType = b.UnrecognizedType
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 12)
+		x.xxx_hidden_Title = b.Title
+	}
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 12)
+		x.xxx_hidden_Description = b.Description
+	}
+	if b.Required != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 12)
+		x.xxx_hidden_Required = *b.Required
+	}
+	if b.RequiredIf != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 12)
+		x.xxx_hidden_RequiredIf = b.RequiredIf
+	}
+	if b.Multiple != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 12)
+		x.xxx_hidden_Multiple = *b.Multiple
+	}
+	x.xxx_hidden_Options = &b.Options
+	if b.RangeMin != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 12)
+		x.xxx_hidden_RangeMin = *b.RangeMin
+	}
+	if b.RangeMax != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 12)
+		x.xxx_hidden_RangeMax = *b.RangeMax
+	}
+	return m0
+}
+
+// Option represents an accepted value of a select field.
+type AlertSetupField_Option struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Title       *string                `protobuf:"bytes,1,opt,name=title"`
+	xxx_hidden_Value       *structpb.Value        `protobuf:"bytes,2,opt,name=value"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AlertSetupField_Option) Reset() {
+	*x = AlertSetupField_Option{}
+	mi := &file_wayplatform_connect_mapon_v1_alert_setup_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertSetupField_Option) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertSetupField_Option) ProtoMessage() {}
+
+func (x *AlertSetupField_Option) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_alert_setup_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AlertSetupField_Option) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AlertSetupField_Option) GetValue() *structpb.Value {
+	if x != nil {
+		return x.xxx_hidden_Value
+	}
+	return nil
+}
+
+func (x *AlertSetupField_Option) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *AlertSetupField_Option) SetValue(v *structpb.Value) {
+	x.xxx_hidden_Value = v
+}
+
+func (x *AlertSetupField_Option) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *AlertSetupField_Option) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Value != nil
+}
+
+func (x *AlertSetupField_Option) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *AlertSetupField_Option) ClearValue() {
+	x.xxx_hidden_Value = nil
+}
+
+type AlertSetupField_Option_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Localized title of the option.
+	Title *string
+	// Value to pass in AlertSetup.fields when selecting the option.
+	Value *structpb.Value
+}
+
+func (b0 AlertSetupField_Option_builder) Build() *AlertSetupField_Option {
+	m0 := &AlertSetupField_Option{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Title = b.Title
+	}
+	x.xxx_hidden_Value = b.Value
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_alert_setup_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_alert_setup_proto_rawDesc = "" +
+	"\n" +
+	".wayplatform/connect/mapon/v1/alert_setup.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x03\n" +
+	"\n" +
+	"AlertSetup\x12\x19\n" +
+	"\bsetup_id\x18\x01 \x01(\tR\asetupId\x12\x1d\n" +
+	"\n" +
+	"alert_type\x18\x02 \x01(\tR\talertType\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\aexpired\x18\x04 \x01(\bR\aexpired\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12\x1c\n" +
+	"\tcondition\x18\x06 \x01(\tR\tcondition\x12\x1f\n" +
+	"\vunits_title\x18\a \x01(\tR\n" +
+	"unitsTitle\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x12;\n" +
+	"\vmodified_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"modifiedAt\x12\x1f\n" +
+	"\vmodified_by\x18\v \x01(\tR\n" +
+	"modifiedBy\x12/\n" +
+	"\x06fields\x18\f \x01(\v2\x17.google.protobuf.StructR\x06fields\"\x93\x01\n" +
+	"\x13AlertSetupTypeGroup\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12M\n" +
+	"\valert_types\x18\x03 \x03(\v2,.wayplatform.connect.mapon.v1.AlertSetupTypeR\n" +
+	"alertTypes\"\\\n" +
+	"\x0eAlertSetupType\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\xe2\x05\n" +
+	"\x0fAlertSetupField\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1f\n" +
+	"\vfield_group\x18\x02 \x01(\tR\n" +
+	"fieldGroup\x12F\n" +
+	"\x04type\x18\x03 \x01(\x0e22.wayplatform.connect.mapon.v1.AlertSetupField.TypeR\x04type\x12+\n" +
+	"\x11unrecognized_type\x18\x04 \x01(\tR\x10unrecognizedType\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1a\n" +
+	"\brequired\x18\a \x01(\bR\brequired\x12\x1f\n" +
+	"\vrequired_if\x18\b \x01(\tR\n" +
+	"requiredIf\x12\x1a\n" +
+	"\bmultiple\x18\t \x01(\bR\bmultiple\x12N\n" +
+	"\aoptions\x18\n" +
+	" \x03(\v24.wayplatform.connect.mapon.v1.AlertSetupField.OptionR\aoptions\x12\x1b\n" +
+	"\trange_min\x18\v \x01(\x01R\brangeMin\x12\x1b\n" +
+	"\trange_max\x18\f \x01(\x01R\brangeMax\x1aL\n" +
+	"\x06Option\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value\"\xb9\x01\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TYPE_UNRECOGNIZED\x10\x01\x12\x0f\n" +
+	"\vTYPE_SELECT\x10\x02\x12\r\n" +
+	"\tTYPE_DATE\x10\x03\x12\x0e\n" +
+	"\n" +
+	"TYPE_PHONE\x10\x04\x12\x0e\n" +
+	"\n" +
+	"TYPE_EMAIL\x10\x05\x12\x11\n" +
+	"\rTYPE_CHECKBOX\x10\x06\x12\r\n" +
+	"\tTYPE_TEXT\x10\a\x12\x10\n" +
+	"\fTYPE_NUMERIC\x10\b\x12\x10\n" +
+	"\fTYPE_INTEGER\x10\tB\x9a\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x0fAlertSetupProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_alert_setup_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_mapon_v1_alert_setup_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_wayplatform_connect_mapon_v1_alert_setup_proto_goTypes = []any{
+	(AlertSetupField_Type)(0),      // 0: wayplatform.connect.mapon.v1.AlertSetupField.Type
+	(*AlertSetup)(nil),             // 1: wayplatform.connect.mapon.v1.AlertSetup
+	(*AlertSetupTypeGroup)(nil),    // 2: wayplatform.connect.mapon.v1.AlertSetupTypeGroup
+	(*AlertSetupType)(nil),         // 3: wayplatform.connect.mapon.v1.AlertSetupType
+	(*AlertSetupField)(nil),        // 4: wayplatform.connect.mapon.v1.AlertSetupField
+	(*AlertSetupField_Option)(nil), // 5: wayplatform.connect.mapon.v1.AlertSetupField.Option
+	(*timestamppb.Timestamp)(nil),  // 6: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),        // 7: google.protobuf.Struct
+	(*structpb.Value)(nil),         // 8: google.protobuf.Value
+}
+var file_wayplatform_connect_mapon_v1_alert_setup_proto_depIdxs = []int32{
+	6, // 0: wayplatform.connect.mapon.v1.AlertSetup.created_at:type_name -> google.protobuf.Timestamp
+	6, // 1: wayplatform.connect.mapon.v1.AlertSetup.modified_at:type_name -> google.protobuf.Timestamp
+	7, // 2: wayplatform.connect.mapon.v1.AlertSetup.fields:type_name -> google.protobuf.Struct
+	3, // 3: wayplatform.connect.mapon.v1.AlertSetupTypeGroup.alert_types:type_name -> wayplatform.connect.mapon.v1.AlertSetupType
+	0, // 4: wayplatform.connect.mapon.v1.AlertSetupField.type:type_name -> wayplatform.connect.mapon.v1.AlertSetupField.Type
+	5, // 5: wayplatform.connect.mapon.v1.AlertSetupField.options:type_name -> wayplatform.connect.mapon.v1.AlertSetupField.Option
+	8, // 6: wayplatform.connect.mapon.v1.AlertSetupField.Option.value:type_name -> google.protobuf.Value
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_alert_setup_proto_init() }
+func file_wayplatform_connect_mapon_v1_alert_setup_proto_init() {
+	if File_wayplatform_connect_mapon_v1_alert_setup_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_alert_setup_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_alert_setup_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_alert_setup_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_alert_setup_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_alert_setup_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_alert_setup_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_alert_setup_proto = out.File
+	file_wayplatform_connect_mapon_v1_alert_setup_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_alert_setup_proto_depIdxs = nil
+}
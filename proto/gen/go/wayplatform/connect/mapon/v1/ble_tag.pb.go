@@ -0,0 +1,912 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/ble_tag.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BleTag represents a Bluetooth Low Energy tag, e.g. attached to a trailer, scanned by unit gateways.
+type BleTag struct {
+	state                               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TagId                    int64                  `protobuf:"varint,1,opt,name=tag_id,json=tagId"`
+	xxx_hidden_BeaconId                 *string                `protobuf:"bytes,2,opt,name=beacon_id,json=beaconId"`
+	xxx_hidden_SerialNumber             *string                `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber"`
+	xxx_hidden_Name                     *string                `protobuf:"bytes,4,opt,name=name"`
+	xxx_hidden_LocationPrecisionRadiusM int32                  `protobuf:"varint,5,opt,name=location_precision_radius_m,json=locationPrecisionRadiusM"`
+	xxx_hidden_FirstScanAt              *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=first_scan_at,json=firstScanAt"`
+	xxx_hidden_LastScanAt               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_scan_at,json=lastScanAt"`
+	xxx_hidden_CreatedAt                *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt"`
+	xxx_hidden_ScanCount                int64                  `protobuf:"varint,9,opt,name=scan_count,json=scanCount"`
+	xxx_hidden_LocationChangedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=location_changed_at,json=locationChangedAt"`
+	xxx_hidden_Location                 *Location              `protobuf:"bytes,11,opt,name=location"`
+	XXX_raceDetectHookData              protoimpl.RaceDetectHookData
+	XXX_presence                        [1]uint32
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
+}
+
+func (x *BleTag) Reset() {
+	*x = BleTag{}
+	mi := &file_wayplatform_connect_mapon_v1_ble_tag_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BleTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BleTag) ProtoMessage() {}
+
+func (x *BleTag) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_ble_tag_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BleTag) GetTagId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TagId
+	}
+	return 0
+}
+
+func (x *BleTag) GetBeaconId() string {
+	if x != nil {
+		if x.xxx_hidden_BeaconId != nil {
+			return *x.xxx_hidden_BeaconId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BleTag) GetSerialNumber() string {
+	if x != nil {
+		if x.xxx_hidden_SerialNumber != nil {
+			return *x.xxx_hidden_SerialNumber
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BleTag) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BleTag) GetLocationPrecisionRadiusM() int32 {
+	if x != nil {
+		return x.xxx_hidden_LocationPrecisionRadiusM
+	}
+	return 0
+}
+
+func (x *BleTag) GetFirstScanAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_FirstScanAt
+	}
+	return nil
+}
+
+func (x *BleTag) GetLastScanAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastScanAt
+	}
+	return nil
+}
+
+func (x *BleTag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *BleTag) GetScanCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_ScanCount
+	}
+	return 0
+}
+
+func (x *BleTag) GetLocationChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LocationChangedAt
+	}
+	return nil
+}
+
+func (x *BleTag) GetLocation() *Location {
+	if x != nil {
+		return x.xxx_hidden_Location
+	}
+	return nil
+}
+
+func (x *BleTag) SetTagId(v int64) {
+	x.xxx_hidden_TagId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 11)
+}
+
+func (x *BleTag) SetBeaconId(v string) {
+	x.xxx_hidden_BeaconId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 11)
+}
+
+func (x *BleTag) SetSerialNumber(v string) {
+	x.xxx_hidden_SerialNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 11)
+}
+
+func (x *BleTag) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 11)
+}
+
+func (x *BleTag) SetLocationPrecisionRadiusM(v int32) {
+	x.xxx_hidden_LocationPrecisionRadiusM = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 11)
+}
+
+func (x *BleTag) SetFirstScanAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FirstScanAt = v
+}
+
+func (x *BleTag) SetLastScanAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastScanAt = v
+}
+
+func (x *BleTag) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *BleTag) SetScanCount(v int64) {
+	x.xxx_hidden_ScanCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 11)
+}
+
+func (x *BleTag) SetLocationChangedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LocationChangedAt = v
+}
+
+func (x *BleTag) SetLocation(v *Location) {
+	x.xxx_hidden_Location = v
+}
+
+func (x *BleTag) HasTagId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *BleTag) HasBeaconId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *BleTag) HasSerialNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *BleTag) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *BleTag) HasLocationPrecisionRadiusM() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *BleTag) HasFirstScanAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FirstScanAt != nil
+}
+
+func (x *BleTag) HasLastScanAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastScanAt != nil
+}
+
+func (x *BleTag) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *BleTag) HasScanCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *BleTag) HasLocationChangedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LocationChangedAt != nil
+}
+
+func (x *BleTag) HasLocation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Location != nil
+}
+
+func (x *BleTag) ClearTagId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_TagId = 0
+}
+
+func (x *BleTag) ClearBeaconId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_BeaconId = nil
+}
+
+func (x *BleTag) ClearSerialNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_SerialNumber = nil
+}
+
+func (x *BleTag) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *BleTag) ClearLocationPrecisionRadiusM() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_LocationPrecisionRadiusM = 0
+}
+
+func (x *BleTag) ClearFirstScanAt() {
+	x.xxx_hidden_FirstScanAt = nil
+}
+
+func (x *BleTag) ClearLastScanAt() {
+	x.xxx_hidden_LastScanAt = nil
+}
+
+func (x *BleTag) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *BleTag) ClearScanCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_ScanCount = 0
+}
+
+func (x *BleTag) ClearLocationChangedAt() {
+	x.xxx_hidden_LocationChangedAt = nil
+}
+
+func (x *BleTag) ClearLocation() {
+	x.xxx_hidden_Location = nil
+}
+
+type BleTag_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the tag.
+	TagId *int64
+	// Beacon identifier broadcast by the tag.
+	BeaconId *string
+	// Serial number of the tag.
+	SerialNumber *string
+	// Name of the tag.
+	Name *string
+	// Radius of the approximated tag location in meters. A scan outside this radius registers a new location.
+	LocationPrecisionRadiusM *int32
+	// Timestamp of the first scan of the tag.
+	FirstScanAt *timestamppb.Timestamp
+	// Timestamp of the last scan of the tag.
+	LastScanAt *timestamppb.Timestamp
+	// Timestamp when the tag was created.
+	CreatedAt *timestamppb.Timestamp
+	// Total number of scans of the tag.
+	ScanCount *int64
+	// Timestamp when the location of the tag last changed.
+	LocationChangedAt *timestamppb.Timestamp
+	// Last known location of the tag, if scanned.
+	Location *Location
+}
+
+func (b0 BleTag_builder) Build() *BleTag {
+	m0 := &BleTag{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.TagId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 11)
+		x.xxx_hidden_TagId = *b.TagId
+	}
+	if b.BeaconId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 11)
+		x.xxx_hidden_BeaconId = b.BeaconId
+	}
+	if b.SerialNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 11)
+		x.xxx_hidden_SerialNumber = b.SerialNumber
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 11)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.LocationPrecisionRadiusM != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 11)
+		x.xxx_hidden_LocationPrecisionRadiusM = *b.LocationPrecisionRadiusM
+	}
+	x.xxx_hidden_FirstScanAt = b.FirstScanAt
+	x.xxx_hidden_LastScanAt = b.LastScanAt
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.ScanCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 11)
+		x.xxx_hidden_ScanCount = *b.ScanCount
+	}
+	x.xxx_hidden_LocationChangedAt = b.LocationChangedAt
+	x.xxx_hidden_Location = b.Location
+	return m0
+}
+
+// BleTagScan represents a scan of a BLE tag by a unit.
+type BleTagScan struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Time            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time"`
+	xxx_hidden_UnitId          int64                  `protobuf:"varint,2,opt,name=unit_id,json=unitId"`
+	xxx_hidden_UnitState       *string                `protobuf:"bytes,3,opt,name=unit_state,json=unitState"`
+	xxx_hidden_Location        *Location              `protobuf:"bytes,4,opt,name=location"`
+	xxx_hidden_Rssi            int32                  `protobuf:"varint,5,opt,name=rssi"`
+	xxx_hidden_VoltageMv       int32                  `protobuf:"varint,6,opt,name=voltage_mv,json=voltageMv"`
+	xxx_hidden_TemperatureC    float64                `protobuf:"fixed64,7,opt,name=temperature_c,json=temperatureC"`
+	xxx_hidden_HumidityPercent float64                `protobuf:"fixed64,8,opt,name=humidity_percent,json=humidityPercent"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *BleTagScan) Reset() {
+	*x = BleTagScan{}
+	mi := &file_wayplatform_connect_mapon_v1_ble_tag_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BleTagScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BleTagScan) ProtoMessage() {}
+
+func (x *BleTagScan) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_ble_tag_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BleTagScan) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *BleTagScan) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *BleTagScan) GetUnitState() string {
+	if x != nil {
+		if x.xxx_hidden_UnitState != nil {
+			return *x.xxx_hidden_UnitState
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BleTagScan) GetLocation() *Location {
+	if x != nil {
+		return x.xxx_hidden_Location
+	}
+	return nil
+}
+
+func (x *BleTagScan) GetRssi() int32 {
+	if x != nil {
+		return x.xxx_hidden_Rssi
+	}
+	return 0
+}
+
+func (x *BleTagScan) GetVoltageMv() int32 {
+	if x != nil {
+		return x.xxx_hidden_VoltageMv
+	}
+	return 0
+}
+
+func (x *BleTagScan) GetTemperatureC() float64 {
+	if x != nil {
+		return x.xxx_hidden_TemperatureC
+	}
+	return 0
+}
+
+func (x *BleTagScan) GetHumidityPercent() float64 {
+	if x != nil {
+		return x.xxx_hidden_HumidityPercent
+	}
+	return 0
+}
+
+func (x *BleTagScan) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *BleTagScan) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *BleTagScan) SetUnitState(v string) {
+	x.xxx_hidden_UnitState = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *BleTagScan) SetLocation(v *Location) {
+	x.xxx_hidden_Location = v
+}
+
+func (x *BleTagScan) SetRssi(v int32) {
+	x.xxx_hidden_Rssi = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+}
+
+func (x *BleTagScan) SetVoltageMv(v int32) {
+	x.xxx_hidden_VoltageMv = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *BleTagScan) SetTemperatureC(v float64) {
+	x.xxx_hidden_TemperatureC = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *BleTagScan) SetHumidityPercent(v float64) {
+	x.xxx_hidden_HumidityPercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *BleTagScan) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *BleTagScan) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *BleTagScan) HasUnitState() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *BleTagScan) HasLocation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Location != nil
+}
+
+func (x *BleTagScan) HasRssi() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *BleTagScan) HasVoltageMv() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *BleTagScan) HasTemperatureC() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *BleTagScan) HasHumidityPercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *BleTagScan) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *BleTagScan) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *BleTagScan) ClearUnitState() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_UnitState = nil
+}
+
+func (x *BleTagScan) ClearLocation() {
+	x.xxx_hidden_Location = nil
+}
+
+func (x *BleTagScan) ClearRssi() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Rssi = 0
+}
+
+func (x *BleTagScan) ClearVoltageMv() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_VoltageMv = 0
+}
+
+func (x *BleTagScan) ClearTemperatureC() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_TemperatureC = 0
+}
+
+func (x *BleTagScan) ClearHumidityPercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_HumidityPercent = 0
+}
+
+type BleTagScan_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Timestamp of the scan.
+	Time *timestamppb.Timestamp
+	// Identifier of the scanning unit.
+	UnitId *int64
+	// State of the scanning unit, e.g. "ignition".
+	UnitState *string
+	// Location of the scanning unit.
+	Location *Location
+	// Received signal strength indicator in dBm.
+	Rssi *int32
+	// Battery voltage of the tag in millivolts.
+	VoltageMv *int32
+	// Temperature measured by the tag in Celsius.
+	TemperatureC *float64
+	// Relative humidity measured by the tag in percent.
+	HumidityPercent *float64
+}
+
+func (b0 BleTagScan_builder) Build() *BleTagScan {
+	m0 := &BleTagScan{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Time = b.Time
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.UnitState != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_UnitState = b.UnitState
+	}
+	x.xxx_hidden_Location = b.Location
+	if b.Rssi != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		x.xxx_hidden_Rssi = *b.Rssi
+	}
+	if b.VoltageMv != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_VoltageMv = *b.VoltageMv
+	}
+	if b.TemperatureC != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_TemperatureC = *b.TemperatureC
+	}
+	if b.HumidityPercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_HumidityPercent = *b.HumidityPercent
+	}
+	return m0
+}
+
+// BleTagLocation represents an approximated location of a BLE tag during a period.
+type BleTagLocation struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime"`
+	xxx_hidden_EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime"`
+	xxx_hidden_Location  *Location              `protobuf:"bytes,3,opt,name=location"`
+	xxx_hidden_Bounds    *[]*Location           `protobuf:"bytes,4,rep,name=bounds"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BleTagLocation) Reset() {
+	*x = BleTagLocation{}
+	mi := &file_wayplatform_connect_mapon_v1_ble_tag_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BleTagLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BleTagLocation) ProtoMessage() {}
+
+func (x *BleTagLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_ble_tag_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BleTagLocation) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_StartTime
+	}
+	return nil
+}
+
+func (x *BleTagLocation) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_EndTime
+	}
+	return nil
+}
+
+func (x *BleTagLocation) GetLocation() *Location {
+	if x != nil {
+		return x.xxx_hidden_Location
+	}
+	return nil
+}
+
+func (x *BleTagLocation) GetBounds() []*Location {
+	if x != nil {
+		if x.xxx_hidden_Bounds != nil {
+			return *x.xxx_hidden_Bounds
+		}
+	}
+	return nil
+}
+
+func (x *BleTagLocation) SetStartTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_StartTime = v
+}
+
+func (x *BleTagLocation) SetEndTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_EndTime = v
+}
+
+func (x *BleTagLocation) SetLocation(v *Location) {
+	x.xxx_hidden_Location = v
+}
+
+func (x *BleTagLocation) SetBounds(v []*Location) {
+	x.xxx_hidden_Bounds = &v
+}
+
+func (x *BleTagLocation) HasStartTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StartTime != nil
+}
+
+func (x *BleTagLocation) HasEndTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_EndTime != nil
+}
+
+func (x *BleTagLocation) HasLocation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Location != nil
+}
+
+func (x *BleTagLocation) ClearStartTime() {
+	x.xxx_hidden_StartTime = nil
+}
+
+func (x *BleTagLocation) ClearEndTime() {
+	x.xxx_hidden_EndTime = nil
+}
+
+func (x *BleTagLocation) ClearLocation() {
+	x.xxx_hidden_Location = nil
+}
+
+type BleTagLocation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Timestamp when the tag arrived at the location.
+	StartTime *timestamppb.Timestamp
+	// Timestamp when the tag left the location. Not set if the location has not changed since.
+	EndTime *timestamppb.Timestamp
+	// Center of the approximated location.
+	Location *Location
+	// Outer border of the approximated location circle.
+	Bounds []*Location
+}
+
+func (b0 BleTagLocation_builder) Build() *BleTagLocation {
+	m0 := &BleTagLocation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_StartTime = b.StartTime
+	x.xxx_hidden_EndTime = b.EndTime
+	x.xxx_hidden_Location = b.Location
+	x.xxx_hidden_Bounds = &b.Bounds
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_ble_tag_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_ble_tag_proto_rawDesc = "" +
+	"\n" +
+	"*wayplatform/connect/mapon/v1/ble_tag.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)wayplatform/connect/mapon/v1/common.proto\"\x9c\x04\n" +
+	"\x06BleTag\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\x03R\x05tagId\x12\x1b\n" +
+	"\tbeacon_id\x18\x02 \x01(\tR\bbeaconId\x12#\n" +
+	"\rserial_number\x18\x03 \x01(\tR\fserialNumber\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12=\n" +
+	"\x1blocation_precision_radius_m\x18\x05 \x01(\x05R\x18locationPrecisionRadiusM\x12>\n" +
+	"\rfirst_scan_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vfirstScanAt\x12<\n" +
+	"\flast_scan_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastScanAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"scan_count\x18\t \x01(\x03R\tscanCount\x12J\n" +
+	"\x13location_changed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x11locationChangedAt\x12B\n" +
+	"\blocation\x18\v \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\blocation\"\xbb\x02\n" +
+	"\n" +
+	"BleTagScan\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\x03R\x06unitId\x12\x1d\n" +
+	"\n" +
+	"unit_state\x18\x03 \x01(\tR\tunitState\x12B\n" +
+	"\blocation\x18\x04 \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\blocation\x12\x12\n" +
+	"\x04rssi\x18\x05 \x01(\x05R\x04rssi\x12\x1d\n" +
+	"\n" +
+	"voltage_mv\x18\x06 \x01(\x05R\tvoltageMv\x12#\n" +
+	"\rtemperature_c\x18\a \x01(\x01R\ftemperatureC\x12)\n" +
+	"\x10humidity_percent\x18\b \x01(\x01R\x0fhumidityPercent\"\x86\x02\n" +
+	"\x0eBleTagLocation\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12B\n" +
+	"\blocation\x18\x03 \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\blocation\x12>\n" +
+	"\x06bounds\x18\x04 \x03(\v2&.wayplatform.connect.mapon.v1.LocationR\x06boundsB\x96\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\vBleTagProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_ble_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_wayplatform_connect_mapon_v1_ble_tag_proto_goTypes = []any{
+	(*BleTag)(nil),                // 0: wayplatform.connect.mapon.v1.BleTag
+	(*BleTagScan)(nil),            // 1: wayplatform.connect.mapon.v1.BleTagScan
+	(*BleTagLocation)(nil),        // 2: wayplatform.connect.mapon.v1.BleTagLocation
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*Location)(nil),              // 4: wayplatform.connect.mapon.v1.Location
+}
+var file_wayplatform_connect_mapon_v1_ble_tag_proto_depIdxs = []int32{
+	3,  // 0: wayplatform.connect.mapon.v1.BleTag.first_scan_at:type_name -> google.protobuf.Timestamp
+	3,  // 1: wayplatform.connect.mapon.v1.BleTag.last_scan_at:type_name -> google.protobuf.Timestamp
+	3,  // 2: wayplatform.connect.mapon.v1.BleTag.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 3: wayplatform.connect.mapon.v1.BleTag.location_changed_at:type_name -> google.protobuf.Timestamp
+	4,  // 4: wayplatform.connect.mapon.v1.BleTag.location:type_name -> wayplatform.connect.mapon.v1.Location
+	3,  // 5: wayplatform.connect.mapon.v1.BleTagScan.time:type_name -> google.protobuf.Timestamp
+	4,  // 6: wayplatform.connect.mapon.v1.BleTagScan.location:type_name -> wayplatform.connect.mapon.v1.Location
+	3,  // 7: wayplatform.connect.mapon.v1.BleTagLocation.start_time:type_name -> google.protobuf.Timestamp
+	3,  // 8: wayplatform.connect.mapon.v1.BleTagLocation.end_time:type_name -> google.protobuf.Timestamp
+	4,  // 9: wayplatform.connect.mapon.v1.BleTagLocation.location:type_name -> wayplatform.connect.mapon.v1.Location
+	4,  // 10: wayplatform.connect.mapon.v1.BleTagLocation.bounds:type_name -> wayplatform.connect.mapon.v1.Location
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_ble_tag_proto_init() }
+func file_wayplatform_connect_mapon_v1_ble_tag_proto_init() {
+	if File_wayplatform_connect_mapon_v1_ble_tag_proto != nil {
+		return
+	}
+	file_wayplatform_connect_mapon_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_ble_tag_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_ble_tag_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_ble_tag_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_ble_tag_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_ble_tag_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_ble_tag_proto = out.File
+	file_wayplatform_connect_mapon_v1_ble_tag_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_ble_tag_proto_depIdxs = nil
+}
@@ -217,6 +217,173 @@ func (b0 UnitIDsList_builder) Build() *UnitIDsList {
 	return m0
 }
 
+// Pagination represents the paging metadata of a paginated list response.
+type Pagination struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Total       int32                  `protobuf:"varint,1,opt,name=total"`
+	xxx_hidden_TotalPages  int32                  `protobuf:"varint,2,opt,name=total_pages,json=totalPages"`
+	xxx_hidden_PerPage     int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage"`
+	xxx_hidden_Page        int32                  `protobuf:"varint,4,opt,name=page"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_wayplatform_connect_mapon_v1_common_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_common_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Pagination) GetTotal() int32 {
+	if x != nil {
+		return x.xxx_hidden_Total
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotalPages() int32 {
+	if x != nil {
+		return x.xxx_hidden_TotalPages
+	}
+	return 0
+}
+
+func (x *Pagination) GetPerPage() int32 {
+	if x != nil {
+		return x.xxx_hidden_PerPage
+	}
+	return 0
+}
+
+func (x *Pagination) GetPage() int32 {
+	if x != nil {
+		return x.xxx_hidden_Page
+	}
+	return 0
+}
+
+func (x *Pagination) SetTotal(v int32) {
+	x.xxx_hidden_Total = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *Pagination) SetTotalPages(v int32) {
+	x.xxx_hidden_TotalPages = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *Pagination) SetPerPage(v int32) {
+	x.xxx_hidden_PerPage = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *Pagination) SetPage(v int32) {
+	x.xxx_hidden_Page = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *Pagination) HasTotal() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Pagination) HasTotalPages() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Pagination) HasPerPage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Pagination) HasPage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Pagination) ClearTotal() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Total = 0
+}
+
+func (x *Pagination) ClearTotalPages() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_TotalPages = 0
+}
+
+func (x *Pagination) ClearPerPage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_PerPage = 0
+}
+
+func (x *Pagination) ClearPage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Page = 0
+}
+
+type Pagination_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Total      *int32
+	TotalPages *int32
+	PerPage    *int32
+	// Current page number, starting from 1.
+	Page *int32
+}
+
+func (b0 Pagination_builder) Build() *Pagination {
+	m0 := &Pagination{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Total != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Total = *b.Total
+	}
+	if b.TotalPages != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_TotalPages = *b.TotalPages
+	}
+	if b.PerPage != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_PerPage = *b.PerPage
+	}
+	if b.Page != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Page = *b.Page
+	}
+	return m0
+}
+
 var File_wayplatform_connect_mapon_v1_common_proto protoreflect.FileDescriptor
 
 const file_wayplatform_connect_mapon_v1_common_proto_rawDesc = "" +
@@ -227,13 +394,21 @@ const file_wayplatform_connect_mapon_v1_common_proto_rawDesc = "" +
 	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x18\n" +
 	"\aaddress\x18\x03 \x01(\tR\aaddress\"\x1f\n" +
 	"\vUnitIDsList\x12\x10\n" +
-	"\x03ids\x18\x01 \x03(\x03R\x03idsB\x96\x02\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"r\n" +
+	"\n" +
+	"Pagination\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x1f\n" +
+	"\vtotal_pages\x18\x02 \x01(\x05R\n" +
+	"totalPages\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04pageB\x96\x02\n" +
 	" com.wayplatform.connect.mapon.v1B\vCommonProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
 
-var file_wayplatform_connect_mapon_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_mapon_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_wayplatform_connect_mapon_v1_common_proto_goTypes = []any{
 	(*Location)(nil),    // 0: wayplatform.connect.mapon.v1.Location
 	(*UnitIDsList)(nil), // 1: wayplatform.connect.mapon.v1.UnitIDsList
+	(*Pagination)(nil),  // 2: wayplatform.connect.mapon.v1.Pagination
 }
 var file_wayplatform_connect_mapon_v1_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -254,7 +429,7 @@ func file_wayplatform_connect_mapon_v1_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_common_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_common_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,1075 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/company.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Unit of distance used by a company.
+type Company_DistanceUnit int32
+
+const (
+	// Default value, used when the unit is missing or not set.
+	Company_DISTANCE_UNIT_UNSPECIFIED Company_DistanceUnit = 0
+	// Used when the received value does not match any known enum member.
+	Company_DISTANCE_UNIT_UNRECOGNIZED Company_DistanceUnit = 1
+	// Kilometers.
+	Company_DISTANCE_UNIT_KILOMETER Company_DistanceUnit = 2
+	// International miles.
+	Company_DISTANCE_UNIT_MILE Company_DistanceUnit = 3
+	// US survey miles.
+	Company_DISTANCE_UNIT_MILE_US Company_DistanceUnit = 4
+)
+
+// Enum value maps for Company_DistanceUnit.
+var (
+	Company_DistanceUnit_name = map[int32]string{
+		0: "DISTANCE_UNIT_UNSPECIFIED",
+		1: "DISTANCE_UNIT_UNRECOGNIZED",
+		2: "DISTANCE_UNIT_KILOMETER",
+		3: "DISTANCE_UNIT_MILE",
+		4: "DISTANCE_UNIT_MILE_US",
+	}
+	Company_DistanceUnit_value = map[string]int32{
+		"DISTANCE_UNIT_UNSPECIFIED":  0,
+		"DISTANCE_UNIT_UNRECOGNIZED": 1,
+		"DISTANCE_UNIT_KILOMETER":    2,
+		"DISTANCE_UNIT_MILE":         3,
+		"DISTANCE_UNIT_MILE_US":      4,
+	}
+)
+
+func (x Company_DistanceUnit) Enum() *Company_DistanceUnit {
+	p := new(Company_DistanceUnit)
+	*p = x
+	return p
+}
+
+func (x Company_DistanceUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Company_DistanceUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_company_proto_enumTypes[0].Descriptor()
+}
+
+func (Company_DistanceUnit) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_company_proto_enumTypes[0]
+}
+
+func (x Company_DistanceUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Company represents a company account.
+type Company struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CompanyId          int64                  `protobuf:"varint,1,opt,name=company_id,json=companyId"`
+	xxx_hidden_Name               *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_LegalName          *string                `protobuf:"bytes,3,opt,name=legal_name,json=legalName"`
+	xxx_hidden_RegistrationNumber *string                `protobuf:"bytes,4,opt,name=registration_number,json=registrationNumber"`
+	xxx_hidden_Address            *string                `protobuf:"bytes,5,opt,name=address"`
+	xxx_hidden_Timezone           *string                `protobuf:"bytes,6,opt,name=timezone"`
+	xxx_hidden_Currency           *string                `protobuf:"bytes,7,opt,name=currency"`
+	xxx_hidden_DistanceUnit       Company_DistanceUnit   `protobuf:"varint,8,opt,name=distance_unit,json=distanceUnit,enum=wayplatform.connect.mapon.v1.Company_DistanceUnit"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *Company) Reset() {
+	*x = Company{}
+	mi := &file_wayplatform_connect_mapon_v1_company_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Company) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Company) ProtoMessage() {}
+
+func (x *Company) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_company_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Company) GetCompanyId() int64 {
+	if x != nil {
+		return x.xxx_hidden_CompanyId
+	}
+	return 0
+}
+
+func (x *Company) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Company) GetLegalName() string {
+	if x != nil {
+		if x.xxx_hidden_LegalName != nil {
+			return *x.xxx_hidden_LegalName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Company) GetRegistrationNumber() string {
+	if x != nil {
+		if x.xxx_hidden_RegistrationNumber != nil {
+			return *x.xxx_hidden_RegistrationNumber
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Company) GetAddress() string {
+	if x != nil {
+		if x.xxx_hidden_Address != nil {
+			return *x.xxx_hidden_Address
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Company) GetTimezone() string {
+	if x != nil {
+		if x.xxx_hidden_Timezone != nil {
+			return *x.xxx_hidden_Timezone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Company) GetCurrency() string {
+	if x != nil {
+		if x.xxx_hidden_Currency != nil {
+			return *x.xxx_hidden_Currency
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Company) GetDistanceUnit() Company_DistanceUnit {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 7) {
+			return x.xxx_hidden_DistanceUnit
+		}
+	}
+	return Company_DISTANCE_UNIT_UNSPECIFIED
+}
+
+func (x *Company) SetCompanyId(v int64) {
+	x.xxx_hidden_CompanyId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *Company) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *Company) SetLegalName(v string) {
+	x.xxx_hidden_LegalName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *Company) SetRegistrationNumber(v string) {
+	x.xxx_hidden_RegistrationNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *Company) SetAddress(v string) {
+	x.xxx_hidden_Address = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+}
+
+func (x *Company) SetTimezone(v string) {
+	x.xxx_hidden_Timezone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *Company) SetCurrency(v string) {
+	x.xxx_hidden_Currency = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *Company) SetDistanceUnit(v Company_DistanceUnit) {
+	x.xxx_hidden_DistanceUnit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *Company) HasCompanyId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Company) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Company) HasLegalName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Company) HasRegistrationNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Company) HasAddress() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Company) HasTimezone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Company) HasCurrency() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Company) HasDistanceUnit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Company) ClearCompanyId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CompanyId = 0
+}
+
+func (x *Company) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *Company) ClearLegalName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_LegalName = nil
+}
+
+func (x *Company) ClearRegistrationNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RegistrationNumber = nil
+}
+
+func (x *Company) ClearAddress() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Address = nil
+}
+
+func (x *Company) ClearTimezone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Timezone = nil
+}
+
+func (x *Company) ClearCurrency() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Currency = nil
+}
+
+func (x *Company) ClearDistanceUnit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_DistanceUnit = Company_DISTANCE_UNIT_UNSPECIFIED
+}
+
+type Company_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the company, as in PushMessage.company_id.
+	CompanyId *int64
+	// Name of the company.
+	Name *string
+	// Legal name of the company.
+	LegalName *string
+	// Registration number of the company.
+	RegistrationNumber *string
+	// Address of the company.
+	Address *string
+	// Timezone of the company (e.g. "UTC").
+	Timezone *string
+	// Currency code (e.g. "EUR").
+	Currency *string
+	// Unit of distance used by the company.
+	DistanceUnit *Company_DistanceUnit
+}
+
+func (b0 Company_builder) Build() *Company {
+	m0 := &Company{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.CompanyId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_CompanyId = *b.CompanyId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.LegalName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_LegalName = b.LegalName
+	}
+	if b.RegistrationNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_RegistrationNumber = b.RegistrationNumber
+	}
+	if b.Address != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		x.xxx_hidden_Address = b.Address
+	}
+	if b.Timezone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_Timezone = b.Timezone
+	}
+	if b.Currency != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_Currency = b.Currency
+	}
+	if b.DistanceUnit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_DistanceUnit = *b.DistanceUnit
+	}
+	return m0
+}
+
+// CompanyClient represents a client of a company, such as a customer receiving deliveries.
+type CompanyClient struct {
+	state                      protoimpl.MessageState     `protogen:"opaque.v1"`
+	xxx_hidden_ClientId        int64                      `protobuf:"varint,1,opt,name=client_id,json=clientId"`
+	xxx_hidden_Name            *string                    `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Location        *Location                  `protobuf:"bytes,3,opt,name=location"`
+	xxx_hidden_Phone           *string                    `protobuf:"bytes,4,opt,name=phone"`
+	xxx_hidden_Email           *string                    `protobuf:"bytes,5,opt,name=email"`
+	xxx_hidden_Comments        *string                    `protobuf:"bytes,6,opt,name=comments"`
+	xxx_hidden_WorkingDays     *CompanyClient_WorkingDays `protobuf:"bytes,7,opt,name=working_days,json=workingDays"`
+	xxx_hidden_WorkingTimeFrom *string                    `protobuf:"bytes,8,opt,name=working_time_from,json=workingTimeFrom"`
+	xxx_hidden_WorkingTimeTo   *string                    `protobuf:"bytes,9,opt,name=working_time_to,json=workingTimeTo"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *CompanyClient) Reset() {
+	*x = CompanyClient{}
+	mi := &file_wayplatform_connect_mapon_v1_company_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanyClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyClient) ProtoMessage() {}
+
+func (x *CompanyClient) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_company_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CompanyClient) GetClientId() int64 {
+	if x != nil {
+		return x.xxx_hidden_ClientId
+	}
+	return 0
+}
+
+func (x *CompanyClient) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CompanyClient) GetLocation() *Location {
+	if x != nil {
+		return x.xxx_hidden_Location
+	}
+	return nil
+}
+
+func (x *CompanyClient) GetPhone() string {
+	if x != nil {
+		if x.xxx_hidden_Phone != nil {
+			return *x.xxx_hidden_Phone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CompanyClient) GetEmail() string {
+	if x != nil {
+		if x.xxx_hidden_Email != nil {
+			return *x.xxx_hidden_Email
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CompanyClient) GetComments() string {
+	if x != nil {
+		if x.xxx_hidden_Comments != nil {
+			return *x.xxx_hidden_Comments
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CompanyClient) GetWorkingDays() *CompanyClient_WorkingDays {
+	if x != nil {
+		return x.xxx_hidden_WorkingDays
+	}
+	return nil
+}
+
+func (x *CompanyClient) GetWorkingTimeFrom() string {
+	if x != nil {
+		if x.xxx_hidden_WorkingTimeFrom != nil {
+			return *x.xxx_hidden_WorkingTimeFrom
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CompanyClient) GetWorkingTimeTo() string {
+	if x != nil {
+		if x.xxx_hidden_WorkingTimeTo != nil {
+			return *x.xxx_hidden_WorkingTimeTo
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CompanyClient) SetClientId(v int64) {
+	x.xxx_hidden_ClientId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
+}
+
+func (x *CompanyClient) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
+}
+
+func (x *CompanyClient) SetLocation(v *Location) {
+	x.xxx_hidden_Location = v
+}
+
+func (x *CompanyClient) SetPhone(v string) {
+	x.xxx_hidden_Phone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
+}
+
+func (x *CompanyClient) SetEmail(v string) {
+	x.xxx_hidden_Email = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+}
+
+func (x *CompanyClient) SetComments(v string) {
+	x.xxx_hidden_Comments = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
+}
+
+func (x *CompanyClient) SetWorkingDays(v *CompanyClient_WorkingDays) {
+	x.xxx_hidden_WorkingDays = v
+}
+
+func (x *CompanyClient) SetWorkingTimeFrom(v string) {
+	x.xxx_hidden_WorkingTimeFrom = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *CompanyClient) SetWorkingTimeTo(v string) {
+	x.xxx_hidden_WorkingTimeTo = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+}
+
+func (x *CompanyClient) HasClientId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CompanyClient) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CompanyClient) HasLocation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Location != nil
+}
+
+func (x *CompanyClient) HasPhone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CompanyClient) HasEmail() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *CompanyClient) HasComments() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *CompanyClient) HasWorkingDays() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_WorkingDays != nil
+}
+
+func (x *CompanyClient) HasWorkingTimeFrom() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *CompanyClient) HasWorkingTimeTo() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *CompanyClient) ClearClientId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ClientId = 0
+}
+
+func (x *CompanyClient) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *CompanyClient) ClearLocation() {
+	x.xxx_hidden_Location = nil
+}
+
+func (x *CompanyClient) ClearPhone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Phone = nil
+}
+
+func (x *CompanyClient) ClearEmail() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Email = nil
+}
+
+func (x *CompanyClient) ClearComments() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Comments = nil
+}
+
+func (x *CompanyClient) ClearWorkingDays() {
+	x.xxx_hidden_WorkingDays = nil
+}
+
+func (x *CompanyClient) ClearWorkingTimeFrom() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_WorkingTimeFrom = nil
+}
+
+func (x *CompanyClient) ClearWorkingTimeTo() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_WorkingTimeTo = nil
+}
+
+type CompanyClient_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the client.
+	ClientId *int64
+	// Name of the client.
+	Name *string
+	// Address and coordinates of the client.
+	Location *Location
+	// Contact phone number.
+	Phone *string
+	// Contact email address.
+	Email *string
+	// Free-form comments.
+	Comments *string
+	// Days of the week the client is working.
+	WorkingDays *CompanyClient_WorkingDays
+	// Start of the daily working time (HH:MM).
+	WorkingTimeFrom *string
+	// End of the daily working time (HH:MM). May be earlier than the start for overnight working times.
+	WorkingTimeTo *string
+}
+
+func (b0 CompanyClient_builder) Build() *CompanyClient {
+	m0 := &CompanyClient{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ClientId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		x.xxx_hidden_ClientId = *b.ClientId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
+		x.xxx_hidden_Name = b.Name
+	}
+	x.xxx_hidden_Location = b.Location
+	if b.Phone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
+		x.xxx_hidden_Phone = b.Phone
+	}
+	if b.Email != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_Email = b.Email
+	}
+	if b.Comments != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
+		x.xxx_hidden_Comments = b.Comments
+	}
+	x.xxx_hidden_WorkingDays = b.WorkingDays
+	if b.WorkingTimeFrom != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		x.xxx_hidden_WorkingTimeFrom = b.WorkingTimeFrom
+	}
+	if b.WorkingTimeTo != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_WorkingTimeTo = b.WorkingTimeTo
+	}
+	return m0
+}
+
+// Days of the week a client is working.
+type CompanyClient_WorkingDays struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Monday      bool                   `protobuf:"varint,1,opt,name=monday"`
+	xxx_hidden_Tuesday     bool                   `protobuf:"varint,2,opt,name=tuesday"`
+	xxx_hidden_Wednesday   bool                   `protobuf:"varint,3,opt,name=wednesday"`
+	xxx_hidden_Thursday    bool                   `protobuf:"varint,4,opt,name=thursday"`
+	xxx_hidden_Friday      bool                   `protobuf:"varint,5,opt,name=friday"`
+	xxx_hidden_Saturday    bool                   `protobuf:"varint,6,opt,name=saturday"`
+	xxx_hidden_Sunday      bool                   `protobuf:"varint,7,opt,name=sunday"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CompanyClient_WorkingDays) Reset() {
+	*x = CompanyClient_WorkingDays{}
+	mi := &file_wayplatform_connect_mapon_v1_company_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanyClient_WorkingDays) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyClient_WorkingDays) ProtoMessage() {}
+
+func (x *CompanyClient_WorkingDays) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_company_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CompanyClient_WorkingDays) GetMonday() bool {
+	if x != nil {
+		return x.xxx_hidden_Monday
+	}
+	return false
+}
+
+func (x *CompanyClient_WorkingDays) GetTuesday() bool {
+	if x != nil {
+		return x.xxx_hidden_Tuesday
+	}
+	return false
+}
+
+func (x *CompanyClient_WorkingDays) GetWednesday() bool {
+	if x != nil {
+		return x.xxx_hidden_Wednesday
+	}
+	return false
+}
+
+func (x *CompanyClient_WorkingDays) GetThursday() bool {
+	if x != nil {
+		return x.xxx_hidden_Thursday
+	}
+	return false
+}
+
+func (x *CompanyClient_WorkingDays) GetFriday() bool {
+	if x != nil {
+		return x.xxx_hidden_Friday
+	}
+	return false
+}
+
+func (x *CompanyClient_WorkingDays) GetSaturday() bool {
+	if x != nil {
+		return x.xxx_hidden_Saturday
+	}
+	return false
+}
+
+func (x *CompanyClient_WorkingDays) GetSunday() bool {
+	if x != nil {
+		return x.xxx_hidden_Sunday
+	}
+	return false
+}
+
+func (x *CompanyClient_WorkingDays) SetMonday(v bool) {
+	x.xxx_hidden_Monday = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *CompanyClient_WorkingDays) SetTuesday(v bool) {
+	x.xxx_hidden_Tuesday = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *CompanyClient_WorkingDays) SetWednesday(v bool) {
+	x.xxx_hidden_Wednesday = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+}
+
+func (x *CompanyClient_WorkingDays) SetThursday(v bool) {
+	x.xxx_hidden_Thursday = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+}
+
+func (x *CompanyClient_WorkingDays) SetFriday(v bool) {
+	x.xxx_hidden_Friday = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+}
+
+func (x *CompanyClient_WorkingDays) SetSaturday(v bool) {
+	x.xxx_hidden_Saturday = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+}
+
+func (x *CompanyClient_WorkingDays) SetSunday(v bool) {
+	x.xxx_hidden_Sunday = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+}
+
+func (x *CompanyClient_WorkingDays) HasMonday() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CompanyClient_WorkingDays) HasTuesday() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CompanyClient_WorkingDays) HasWednesday() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CompanyClient_WorkingDays) HasThursday() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CompanyClient_WorkingDays) HasFriday() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *CompanyClient_WorkingDays) HasSaturday() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *CompanyClient_WorkingDays) HasSunday() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *CompanyClient_WorkingDays) ClearMonday() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Monday = false
+}
+
+func (x *CompanyClient_WorkingDays) ClearTuesday() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Tuesday = false
+}
+
+func (x *CompanyClient_WorkingDays) ClearWednesday() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Wednesday = false
+}
+
+func (x *CompanyClient_WorkingDays) ClearThursday() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Thursday = false
+}
+
+func (x *CompanyClient_WorkingDays) ClearFriday() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Friday = false
+}
+
+func (x *CompanyClient_WorkingDays) ClearSaturday() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Saturday = false
+}
+
+func (x *CompanyClient_WorkingDays) ClearSunday() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Sunday = false
+}
+
+type CompanyClient_WorkingDays_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Working on Monday.
+	Monday *bool
+	// Working on Tuesday.
+	Tuesday *bool
+	// Working on Wednesday.
+	Wednesday *bool
+	// Working on Thursday.
+	Thursday *bool
+	// Working on Friday.
+	Friday *bool
+	// Working on Saturday.
+	Saturday *bool
+	// Working on Sunday.
+	Sunday *bool
+}
+
+func (b0 CompanyClient_WorkingDays_builder) Build() *CompanyClient_WorkingDays {
+	m0 := &CompanyClient_WorkingDays{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Monday != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_Monday = *b.Monday
+	}
+	if b.Tuesday != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_Tuesday = *b.Tuesday
+	}
+	if b.Wednesday != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_Wednesday = *b.Wednesday
+	}
+	if b.Thursday != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_Thursday = *b.Thursday
+	}
+	if b.Friday != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_Friday = *b.Friday
+	}
+	if b.Saturday != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		x.xxx_hidden_Saturday = *b.Saturday
+	}
+	if b.Sunday != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		x.xxx_hidden_Sunday = *b.Sunday
+	}
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_company_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_company_proto_rawDesc = "" +
+	"\n" +
+	"*wayplatform/connect/mapon/v1/company.proto\x12\x1cwayplatform.connect.mapon.v1\x1a)wayplatform/connect/mapon/v1/common.proto\"\xd7\x03\n" +
+	"\aCompany\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\x03R\tcompanyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"legal_name\x18\x03 \x01(\tR\tlegalName\x12/\n" +
+	"\x13registration_number\x18\x04 \x01(\tR\x12registrationNumber\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12W\n" +
+	"\rdistance_unit\x18\b \x01(\x0e22.wayplatform.connect.mapon.v1.Company.DistanceUnitR\fdistanceUnit\"\x9d\x01\n" +
+	"\fDistanceUnit\x12\x1d\n" +
+	"\x19DISTANCE_UNIT_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aDISTANCE_UNIT_UNRECOGNIZED\x10\x01\x12\x1b\n" +
+	"\x17DISTANCE_UNIT_KILOMETER\x10\x02\x12\x16\n" +
+	"\x12DISTANCE_UNIT_MILE\x10\x03\x12\x19\n" +
+	"\x15DISTANCE_UNIT_MILE_US\x10\x04\"\xc4\x04\n" +
+	"\rCompanyClient\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\x03R\bclientId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12B\n" +
+	"\blocation\x18\x03 \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\blocation\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x1a\n" +
+	"\bcomments\x18\x06 \x01(\tR\bcomments\x12Z\n" +
+	"\fworking_days\x18\a \x01(\v27.wayplatform.connect.mapon.v1.CompanyClient.WorkingDaysR\vworkingDays\x12*\n" +
+	"\x11working_time_from\x18\b \x01(\tR\x0fworkingTimeFrom\x12&\n" +
+	"\x0fworking_time_to\x18\t \x01(\tR\rworkingTimeTo\x1a\xc5\x01\n" +
+	"\vWorkingDays\x12\x16\n" +
+	"\x06monday\x18\x01 \x01(\bR\x06monday\x12\x18\n" +
+	"\atuesday\x18\x02 \x01(\bR\atuesday\x12\x1c\n" +
+	"\twednesday\x18\x03 \x01(\bR\twednesday\x12\x1a\n" +
+	"\bthursday\x18\x04 \x01(\bR\bthursday\x12\x16\n" +
+	"\x06friday\x18\x05 \x01(\bR\x06friday\x12\x1a\n" +
+	"\bsaturday\x18\x06 \x01(\bR\bsaturday\x12\x16\n" +
+	"\x06sunday\x18\a \x01(\bR\x06sundayB\x97\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\fCompanyProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_company_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_mapon_v1_company_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_wayplatform_connect_mapon_v1_company_proto_goTypes = []any{
+	(Company_DistanceUnit)(0),         // 0: wayplatform.connect.mapon.v1.Company.DistanceUnit
+	(*Company)(nil),                   // 1: wayplatform.connect.mapon.v1.Company
+	(*CompanyClient)(nil),             // 2: wayplatform.connect.mapon.v1.CompanyClient
+	(*CompanyClient_WorkingDays)(nil), // 3: wayplatform.connect.mapon.v1.CompanyClient.WorkingDays
+	(*Location)(nil),                  // 4: wayplatform.connect.mapon.v1.Location
+}
+var file_wayplatform_connect_mapon_v1_company_proto_depIdxs = []int32{
+	0, // 0: wayplatform.connect.mapon.v1.Company.distance_unit:type_name -> wayplatform.connect.mapon.v1.Company.DistanceUnit
+	4, // 1: wayplatform.connect.mapon.v1.CompanyClient.location:type_name -> wayplatform.connect.mapon.v1.Location
+	3, // 2: wayplatform.connect.mapon.v1.CompanyClient.working_days:type_name -> wayplatform.connect.mapon.v1.CompanyClient.WorkingDays
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_company_proto_init() }
+func file_wayplatform_connect_mapon_v1_company_proto_init() {
+	if File_wayplatform_connect_mapon_v1_company_proto != nil {
+		return
+	}
+	file_wayplatform_connect_mapon_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_company_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_company_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_company_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_company_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_company_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_company_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_company_proto = out.File
+	file_wayplatform_connect_mapon_v1_company_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_company_proto_depIdxs = nil
+}
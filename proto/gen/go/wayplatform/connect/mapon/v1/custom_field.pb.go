@@ -0,0 +1,421 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/custom_field.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Type of a custom field.
+type CustomField_Type int32
+
+const (
+	// Default value. This value is unused.
+	CustomField_TYPE_UNSPECIFIED CustomField_Type = 0
+	// The type is not recognized. See unrecognized_type for the raw value.
+	CustomField_TYPE_UNRECOGNIZED CustomField_Type = 1
+	// Free text.
+	CustomField_TYPE_TEXT CustomField_Type = 2
+	// One of a set of options.
+	CustomField_TYPE_SELECT CustomField_Type = 3
+	// Uploaded file.
+	CustomField_TYPE_FILE CustomField_Type = 4
+	// Uploaded image.
+	CustomField_TYPE_IMAGE CustomField_Type = 5
+	// Hyperlink with a title.
+	CustomField_TYPE_HYPERLINK CustomField_Type = 6
+	// Date.
+	CustomField_TYPE_DATE CustomField_Type = 7
+)
+
+// Enum value maps for CustomField_Type.
+var (
+	CustomField_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_UNRECOGNIZED",
+		2: "TYPE_TEXT",
+		3: "TYPE_SELECT",
+		4: "TYPE_FILE",
+		5: "TYPE_IMAGE",
+		6: "TYPE_HYPERLINK",
+		7: "TYPE_DATE",
+	}
+	CustomField_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":  0,
+		"TYPE_UNRECOGNIZED": 1,
+		"TYPE_TEXT":         2,
+		"TYPE_SELECT":       3,
+		"TYPE_FILE":         4,
+		"TYPE_IMAGE":        5,
+		"TYPE_HYPERLINK":    6,
+		"TYPE_DATE":         7,
+	}
+)
+
+func (x CustomField_Type) Enum() *CustomField_Type {
+	p := new(CustomField_Type)
+	*p = x
+	return p
+}
+
+func (x CustomField_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomField_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_custom_field_proto_enumTypes[0].Descriptor()
+}
+
+func (CustomField_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_custom_field_proto_enumTypes[0]
+}
+
+func (x CustomField_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// CustomField represents a company defined custom field and its value on an entity.
+type CustomField struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FieldId          int64                  `protobuf:"varint,1,opt,name=field_id,json=fieldId"`
+	xxx_hidden_Label            *string                `protobuf:"bytes,2,opt,name=label"`
+	xxx_hidden_Type             CustomField_Type       `protobuf:"varint,3,opt,name=type,enum=wayplatform.connect.mapon.v1.CustomField_Type"`
+	xxx_hidden_UnrecognizedType *string                `protobuf:"bytes,4,opt,name=unrecognized_type,json=unrecognizedType"`
+	xxx_hidden_Value            *string                `protobuf:"bytes,5,opt,name=value"`
+	xxx_hidden_Title            *string                `protobuf:"bytes,6,opt,name=title"`
+	xxx_hidden_Options          map[string]string      `protobuf:"bytes,7,rep,name=options" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *CustomField) Reset() {
+	*x = CustomField{}
+	mi := &file_wayplatform_connect_mapon_v1_custom_field_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomField) ProtoMessage() {}
+
+func (x *CustomField) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_custom_field_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CustomField) GetFieldId() int64 {
+	if x != nil {
+		return x.xxx_hidden_FieldId
+	}
+	return 0
+}
+
+func (x *CustomField) GetLabel() string {
+	if x != nil {
+		if x.xxx_hidden_Label != nil {
+			return *x.xxx_hidden_Label
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CustomField) GetType() CustomField_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return CustomField_TYPE_UNSPECIFIED
+}
+
+func (x *CustomField) GetUnrecognizedType() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedType != nil {
+			return *x.xxx_hidden_UnrecognizedType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CustomField) GetValue() string {
+	if x != nil {
+		if x.xxx_hidden_Value != nil {
+			return *x.xxx_hidden_Value
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CustomField) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CustomField) GetOptions() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Options
+	}
+	return nil
+}
+
+func (x *CustomField) SetFieldId(v int64) {
+	x.xxx_hidden_FieldId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *CustomField) SetLabel(v string) {
+	x.xxx_hidden_Label = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *CustomField) SetType(v CustomField_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+}
+
+func (x *CustomField) SetUnrecognizedType(v string) {
+	x.xxx_hidden_UnrecognizedType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+}
+
+func (x *CustomField) SetValue(v string) {
+	x.xxx_hidden_Value = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+}
+
+func (x *CustomField) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+}
+
+func (x *CustomField) SetOptions(v map[string]string) {
+	x.xxx_hidden_Options = v
+}
+
+func (x *CustomField) HasFieldId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CustomField) HasLabel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CustomField) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CustomField) HasUnrecognizedType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CustomField) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *CustomField) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *CustomField) ClearFieldId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_FieldId = 0
+}
+
+func (x *CustomField) ClearLabel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Label = nil
+}
+
+func (x *CustomField) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Type = CustomField_TYPE_UNSPECIFIED
+}
+
+func (x *CustomField) ClearUnrecognizedType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_UnrecognizedType = nil
+}
+
+func (x *CustomField) ClearValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Value = nil
+}
+
+func (x *CustomField) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Title = nil
+}
+
+type CustomField_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier of the custom field.
+	FieldId *int64
+	// Display name of the custom field.
+	Label *string
+	// Type of the custom field.
+	Type *CustomField_Type
+	// The raw string value of the type if it is not one of the known enum values.
+	UnrecognizedType *string
+	// Value of the field. Select fields hold the option ID, date fields a Unix timestamp
+	// and file and image fields a download URL.
+	Value *string
+	// Title of the hyperlink, for hyperlink fields.
+	Title *string
+	// Options of a select field, keyed by option ID with the option label as value.
+	Options map[string]string
+}
+
+func (b0 CustomField_builder) Build() *CustomField {
+	m0 := &CustomField{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.FieldId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_FieldId = *b.FieldId
+	}
+	if b.Label != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_Label = b.Label
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.UnrecognizedType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_UnrecognizedType = b.UnrecognizedType
+	}
+	if b.Value != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_Value = b.Value
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		x.xxx_hidden_Title = b.Title
+	}
+	x.xxx_hidden_Options = b.Options
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_custom_field_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_custom_field_proto_rawDesc = "" +
+	"\n" +
+	"/wayplatform/connect/mapon/v1/custom_field.proto\x12\x1cwayplatform.connect.mapon.v1\"\x81\x04\n" +
+	"\vCustomField\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\x03R\afieldId\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12B\n" +
+	"\x04type\x18\x03 \x01(\x0e2..wayplatform.connect.mapon.v1.CustomField.TypeR\x04type\x12+\n" +
+	"\x11unrecognized_type\x18\x04 \x01(\tR\x10unrecognizedType\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12P\n" +
+	"\aoptions\x18\a \x03(\v26.wayplatform.connect.mapon.v1.CustomField.OptionsEntryR\aoptions\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x95\x01\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TYPE_UNRECOGNIZED\x10\x01\x12\r\n" +
+	"\tTYPE_TEXT\x10\x02\x12\x0f\n" +
+	"\vTYPE_SELECT\x10\x03\x12\r\n" +
+	"\tTYPE_FILE\x10\x04\x12\x0e\n" +
+	"\n" +
+	"TYPE_IMAGE\x10\x05\x12\x12\n" +
+	"\x0eTYPE_HYPERLINK\x10\x06\x12\r\n" +
+	"\tTYPE_DATE\x10\aB\x9b\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x10CustomFieldProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_custom_field_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_mapon_v1_custom_field_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_mapon_v1_custom_field_proto_goTypes = []any{
+	(CustomField_Type)(0), // 0: wayplatform.connect.mapon.v1.CustomField.Type
+	(*CustomField)(nil),   // 1: wayplatform.connect.mapon.v1.CustomField
+	nil,                   // 2: wayplatform.connect.mapon.v1.CustomField.OptionsEntry
+}
+var file_wayplatform_connect_mapon_v1_custom_field_proto_depIdxs = []int32{
+	0, // 0: wayplatform.connect.mapon.v1.CustomField.type:type_name -> wayplatform.connect.mapon.v1.CustomField.Type
+	2, // 1: wayplatform.connect.mapon.v1.CustomField.options:type_name -> wayplatform.connect.mapon.v1.CustomField.OptionsEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_custom_field_proto_init() }
+func file_wayplatform_connect_mapon_v1_custom_field_proto_init() {
+	if File_wayplatform_connect_mapon_v1_custom_field_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_custom_field_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_custom_field_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_custom_field_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_custom_field_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_custom_field_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_custom_field_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_custom_field_proto = out.File
+	file_wayplatform_connect_mapon_v1_custom_field_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_custom_field_proto_depIdxs = nil
+}
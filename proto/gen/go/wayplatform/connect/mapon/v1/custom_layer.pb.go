@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/custom_layer.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Type of a custom layer geometry.
+type CustomLayerGeometry_Type int32
+
+const (
+	// Default value, used when the type is missing or not set.
+	CustomLayerGeometry_TYPE_UNSPECIFIED CustomLayerGeometry_Type = 0
+	// Used when the received value does not match any known enum member.
+	CustomLayerGeometry_TYPE_UNRECOGNIZED CustomLayerGeometry_Type = 1
+	// A single point.
+	CustomLayerGeometry_TYPE_POINT CustomLayerGeometry_Type = 2
+	// A line through two or more points.
+	CustomLayerGeometry_TYPE_LINE_STRING CustomLayerGeometry_Type = 3
+	// A polygon with an exterior ring and optional holes.
+	CustomLayerGeometry_TYPE_POLYGON CustomLayerGeometry_Type = 4
+	// A collection of points.
+	CustomLayerGeometry_TYPE_MULTI_POINT CustomLayerGeometry_Type = 5
+	// A collection of lines.
+	CustomLayerGeometry_TYPE_MULTI_LINE_STRING CustomLayerGeometry_Type = 6
+	// A collection of polygons.
+	CustomLayerGeometry_TYPE_MULTI_POLYGON CustomLayerGeometry_Type = 7
+)
+
+// Enum value maps for CustomLayerGeometry_Type.
+var (
+	CustomLayerGeometry_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_UNRECOGNIZED",
+		2: "TYPE_POINT",
+		3: "TYPE_LINE_STRING",
+		4: "TYPE_POLYGON",
+		5: "TYPE_MULTI_POINT",
+		6: "TYPE_MULTI_LINE_STRING",
+		7: "TYPE_MULTI_POLYGON",
+	}
+	CustomLayerGeometry_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":       0,
+		"TYPE_UNRECOGNIZED":      1,
+		"TYPE_POINT":             2,
+		"TYPE_LINE_STRING":       3,
+		"TYPE_POLYGON":           4,
+		"TYPE_MULTI_POINT":       5,
+		"TYPE_MULTI_LINE_STRING": 6,
+		"TYPE_MULTI_POLYGON":     7,
+	}
+)
+
+func (x CustomLayerGeometry_Type) Enum() *CustomLayerGeometry_Type {
+	p := new(CustomLayerGeometry_Type)
+	*p = x
+	return p
+}
+
+func (x CustomLayerGeometry_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomLayerGeometry_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_custom_layer_proto_enumTypes[0].Descriptor()
+}
+
+func (CustomLayerGeometry_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_custom_layer_proto_enumTypes[0]
+}
+
+func (x CustomLayerGeometry_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// CustomLayer represents a user-defined map layer.
+type CustomLayer struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_LayerId     int64                  `protobuf:"varint,1,opt,name=layer_id,json=layerId"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_ColorHex    *string                `protobuf:"bytes,3,opt,name=color_hex,json=colorHex"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CustomLayer) Reset() {
+	*x = CustomLayer{}
+	mi := &file_wayplatform_connect_mapon_v1_custom_layer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomLayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomLayer) ProtoMessage() {}
+
+func (x *CustomLayer) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_custom_layer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CustomLayer) GetLayerId() int64 {
+	if x != nil {
+		return x.xxx_hidden_LayerId
+	}
+	return 0
+}
+
+func (x *CustomLayer) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CustomLayer) GetColorHex() string {
+	if x != nil {
+		if x.xxx_hidden_ColorHex != nil {
+			return *x.xxx_hidden_ColorHex
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CustomLayer) SetLayerId(v int64) {
+	x.xxx_hidden_LayerId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *CustomLayer) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *CustomLayer) SetColorHex(v string) {
+	x.xxx_hidden_ColorHex = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *CustomLayer) HasLayerId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CustomLayer) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CustomLayer) HasColorHex() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CustomLayer) ClearLayerId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_LayerId = 0
+}
+
+func (x *CustomLayer) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *CustomLayer) ClearColorHex() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_ColorHex = nil
+}
+
+type CustomLayer_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the layer.
+	LayerId *int64
+	// Name of the layer.
+	Name *string
+	// Hex color code for displaying the layer (e.g. "#FF5733").
+	ColorHex *string
+}
+
+func (b0 CustomLayer_builder) Build() *CustomLayer {
+	m0 := &CustomLayer{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.LayerId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_LayerId = *b.LayerId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.ColorHex != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_ColorHex = b.ColorHex
+	}
+	return m0
+}
+
+// CustomLayerGeometry represents a geometry displayed on a custom map layer.
+type CustomLayerGeometry struct {
+	state                  protoimpl.MessageState          `protogen:"opaque.v1"`
+	xxx_hidden_GeometryId  int64                           `protobuf:"varint,1,opt,name=geometry_id,json=geometryId"`
+	xxx_hidden_Name        *string                         `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Wkt         *string                         `protobuf:"bytes,3,opt,name=wkt"`
+	xxx_hidden_Type        CustomLayerGeometry_Type        `protobuf:"varint,4,opt,name=type,enum=wayplatform.connect.mapon.v1.CustomLayerGeometry_Type"`
+	xxx_hidden_Points      *[]*Location                    `protobuf:"bytes,5,rep,name=points"`
+	xxx_hidden_Lines       *[]*CustomLayerGeometry_Line    `protobuf:"bytes,6,rep,name=lines"`
+	xxx_hidden_Polygons    *[]*CustomLayerGeometry_Polygon `protobuf:"bytes,7,rep,name=polygons"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CustomLayerGeometry) Reset() {
+	*x = CustomLayerGeometry{}
+	mi := &file_wayplatform_connect_mapon_v1_custom_layer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomLayerGeometry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomLayerGeometry) ProtoMessage() {}
+
+func (x *CustomLayerGeometry) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_custom_layer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CustomLayerGeometry) GetGeometryId() int64 {
+	if x != nil {
+		return x.xxx_hidden_GeometryId
+	}
+	return 0
+}
+
+func (x *CustomLayerGeometry) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CustomLayerGeometry) GetWkt() string {
+	if x != nil {
+		if x.xxx_hidden_Wkt != nil {
+			return *x.xxx_hidden_Wkt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CustomLayerGeometry) GetType() CustomLayerGeometry_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 3) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return CustomLayerGeometry_TYPE_UNSPECIFIED
+}
+
+func (x *CustomLayerGeometry) GetPoints() []*Location {
+	if x != nil {
+		if x.xxx_hidden_Points != nil {
+			return *x.xxx_hidden_Points
+		}
+	}
+	return nil
+}
+
+func (x *CustomLayerGeometry) GetLines() []*CustomLayerGeometry_Line {
+	if x != nil {
+		if x.xxx_hidden_Lines != nil {
+			return *x.xxx_hidden_Lines
+		}
+	}
+	return nil
+}
+
+func (x *CustomLayerGeometry) GetPolygons() []*CustomLayerGeometry_Polygon {
+	if x != nil {
+		if x.xxx_hidden_Polygons != nil {
+			return *x.xxx_hidden_Polygons
+		}
+	}
+	return nil
+}
+
+func (x *CustomLayerGeometry) SetGeometryId(v int64) {
+	x.xxx_hidden_GeometryId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *CustomLayerGeometry) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *CustomLayerGeometry) SetWkt(v string) {
+	x.xxx_hidden_Wkt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+}
+
+func (x *CustomLayerGeometry) SetType(v CustomLayerGeometry_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+}
+
+func (x *CustomLayerGeometry) SetPoints(v []*Location) {
+	x.xxx_hidden_Points = &v
+}
+
+func (x *CustomLayerGeometry) SetLines(v []*CustomLayerGeometry_Line) {
+	x.xxx_hidden_Lines = &v
+}
+
+func (x *CustomLayerGeometry) SetPolygons(v []*CustomLayerGeometry_Polygon) {
+	x.xxx_hidden_Polygons = &v
+}
+
+func (x *CustomLayerGeometry) HasGeometryId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CustomLayerGeometry) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CustomLayerGeometry) HasWkt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CustomLayerGeometry) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CustomLayerGeometry) ClearGeometryId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GeometryId = 0
+}
+
+func (x *CustomLayerGeometry) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *CustomLayerGeometry) ClearWkt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Wkt = nil
+}
+
+func (x *CustomLayerGeometry) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Type = CustomLayerGeometry_TYPE_UNSPECIFIED
+}
+
+type CustomLayerGeometry_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the geometry.
+	GeometryId *int64
+	// Name of the geometry.
+	Name *string
+	// Geometry in Well-Known Text (WKT) format with latitude first, e.g. "POINT(51.49318 -0.15068)".
+	Wkt *string
+	// Type of the geometry.
+	Type *CustomLayerGeometry_Type
+	// Points of a POINT or MULTIPOINT geometry.
+	Points []*Location
+	// Lines of a LINESTRING or MULTILINESTRING geometry.
+	Lines []*CustomLayerGeometry_Line
+	// Polygons of a POLYGON or MULTIPOLYGON geometry.
+	Polygons []*CustomLayerGeometry_Polygon
+}
+
+func (b0 CustomLayerGeometry_builder) Build() *CustomLayerGeometry {
+	m0 := &CustomLayerGeometry{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GeometryId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_GeometryId = *b.GeometryId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Wkt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_Wkt = b.Wkt
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_Type = *b.Type
+	}
+	x.xxx_hidden_Points = &b.Points
+	x.xxx_hidden_Lines = &b.Lines
+	x.xxx_hidden_Polygons = &b.Polygons
+	return m0
+}
+
+// Line represents a sequence of points.
+type CustomLayerGeometry_Line struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Points *[]*Location           `protobuf:"bytes,1,rep,name=points"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CustomLayerGeometry_Line) Reset() {
+	*x = CustomLayerGeometry_Line{}
+	mi := &file_wayplatform_connect_mapon_v1_custom_layer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomLayerGeometry_Line) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomLayerGeometry_Line) ProtoMessage() {}
+
+func (x *CustomLayerGeometry_Line) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_custom_layer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CustomLayerGeometry_Line) GetPoints() []*Location {
+	if x != nil {
+		if x.xxx_hidden_Points != nil {
+			return *x.xxx_hidden_Points
+		}
+	}
+	return nil
+}
+
+func (x *CustomLayerGeometry_Line) SetPoints(v []*Location) {
+	x.xxx_hidden_Points = &v
+}
+
+type CustomLayerGeometry_Line_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Points of the line in order.
+	Points []*Location
+}
+
+func (b0 CustomLayerGeometry_Line_builder) Build() *CustomLayerGeometry_Line {
+	m0 := &CustomLayerGeometry_Line{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Points = &b.Points
+	return m0
+}
+
+// Polygon represents an area bounded by closed rings.
+type CustomLayerGeometry_Polygon struct {
+	state            protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Rings *[]*CustomLayerGeometry_Line `protobuf:"bytes,1,rep,name=rings"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CustomLayerGeometry_Polygon) Reset() {
+	*x = CustomLayerGeometry_Polygon{}
+	mi := &file_wayplatform_connect_mapon_v1_custom_layer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomLayerGeometry_Polygon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomLayerGeometry_Polygon) ProtoMessage() {}
+
+func (x *CustomLayerGeometry_Polygon) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_custom_layer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CustomLayerGeometry_Polygon) GetRings() []*CustomLayerGeometry_Line {
+	if x != nil {
+		if x.xxx_hidden_Rings != nil {
+			return *x.xxx_hidden_Rings
+		}
+	}
+	return nil
+}
+
+func (x *CustomLayerGeometry_Polygon) SetRings(v []*CustomLayerGeometry_Line) {
+	x.xxx_hidden_Rings = &v
+}
+
+type CustomLayerGeometry_Polygon_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Rings of the polygon. The first ring is the exterior, the others are holes.
+	Rings []*CustomLayerGeometry_Line
+}
+
+func (b0 CustomLayerGeometry_Polygon_builder) Build() *CustomLayerGeometry_Polygon {
+	m0 := &CustomLayerGeometry_Polygon{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Rings = &b.Rings
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_custom_layer_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_custom_layer_proto_rawDesc = "" +
+	"\n" +
+	"/wayplatform/connect/mapon/v1/custom_layer.proto\x12\x1cwayplatform.connect.mapon.v1\x1a)wayplatform/connect/mapon/v1/common.proto\"Y\n" +
+	"\vCustomLayer\x12\x19\n" +
+	"\blayer_id\x18\x01 \x01(\x03R\alayerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tcolor_hex\x18\x03 \x01(\tR\bcolorHex\"\xe6\x05\n" +
+	"\x13CustomLayerGeometry\x12\x1f\n" +
+	"\vgeometry_id\x18\x01 \x01(\x03R\n" +
+	"geometryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03wkt\x18\x03 \x01(\tR\x03wkt\x12J\n" +
+	"\x04type\x18\x04 \x01(\x0e26.wayplatform.connect.mapon.v1.CustomLayerGeometry.TypeR\x04type\x12>\n" +
+	"\x06points\x18\x05 \x03(\v2&.wayplatform.connect.mapon.v1.LocationR\x06points\x12L\n" +
+	"\x05lines\x18\x06 \x03(\v26.wayplatform.connect.mapon.v1.CustomLayerGeometry.LineR\x05lines\x12U\n" +
+	"\bpolygons\x18\a \x03(\v29.wayplatform.connect.mapon.v1.CustomLayerGeometry.PolygonR\bpolygons\x1aF\n" +
+	"\x04Line\x12>\n" +
+	"\x06points\x18\x01 \x03(\v2&.wayplatform.connect.mapon.v1.LocationR\x06points\x1aW\n" +
+	"\aPolygon\x12L\n" +
+	"\x05rings\x18\x01 \x03(\v26.wayplatform.connect.mapon.v1.CustomLayerGeometry.LineR\x05rings\"\xb5\x01\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TYPE_UNRECOGNIZED\x10\x01\x12\x0e\n" +
+	"\n" +
+	"TYPE_POINT\x10\x02\x12\x14\n" +
+	"\x10TYPE_LINE_STRING\x10\x03\x12\x10\n" +
+	"\fTYPE_POLYGON\x10\x04\x12\x14\n" +
+	"\x10TYPE_MULTI_POINT\x10\x05\x12\x1a\n" +
+	"\x16TYPE_MULTI_LINE_STRING\x10\x06\x12\x16\n" +
+	"\x12TYPE_MULTI_POLYGON\x10\aB\x9b\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x10CustomLayerProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_custom_layer_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_mapon_v1_custom_layer_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_wayplatform_connect_mapon_v1_custom_layer_proto_goTypes = []any{
+	(CustomLayerGeometry_Type)(0),       // 0: wayplatform.connect.mapon.v1.CustomLayerGeometry.Type
+	(*CustomLayer)(nil),                 // 1: wayplatform.connect.mapon.v1.CustomLayer
+	(*CustomLayerGeometry)(nil),         // 2: wayplatform.connect.mapon.v1.CustomLayerGeometry
+	(*CustomLayerGeometry_Line)(nil),    // 3: wayplatform.connect.mapon.v1.CustomLayerGeometry.Line
+	(*CustomLayerGeometry_Polygon)(nil), // 4: wayplatform.connect.mapon.v1.CustomLayerGeometry.Polygon
+	(*Location)(nil),                    // 5: wayplatform.connect.mapon.v1.Location
+}
+var file_wayplatform_connect_mapon_v1_custom_layer_proto_depIdxs = []int32{
+	0, // 0: wayplatform.connect.mapon.v1.CustomLayerGeometry.type:type_name -> wayplatform.connect.mapon.v1.CustomLayerGeometry.Type
+	5, // 1: wayplatform.connect.mapon.v1.CustomLayerGeometry.points:type_name -> wayplatform.connect.mapon.v1.Location
+	3, // 2: wayplatform.connect.mapon.v1.CustomLayerGeometry.lines:type_name -> wayplatform.connect.mapon.v1.CustomLayerGeometry.Line
+	4, // 3: wayplatform.connect.mapon.v1.CustomLayerGeometry.polygons:type_name -> wayplatform.connect.mapon.v1.CustomLayerGeometry.Polygon
+	5, // 4: wayplatform.connect.mapon.v1.CustomLayerGeometry.Line.points:type_name -> wayplatform.connect.mapon.v1.Location
+	3, // 5: wayplatform.connect.mapon.v1.CustomLayerGeometry.Polygon.rings:type_name -> wayplatform.connect.mapon.v1.CustomLayerGeometry.Line
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_custom_layer_proto_init() }
+func file_wayplatform_connect_mapon_v1_custom_layer_proto_init() {
+	if File_wayplatform_connect_mapon_v1_custom_layer_proto != nil {
+		return
+	}
+	file_wayplatform_connect_mapon_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_custom_layer_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_custom_layer_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_custom_layer_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_custom_layer_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_custom_layer_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_custom_layer_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_custom_layer_proto = out.File
+	file_wayplatform_connect_mapon_v1_custom_layer_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_custom_layer_proto_depIdxs = nil
+}
@@ -0,0 +1,456 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/custom_target.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CustomTarget represents a company specific measurable parameter, e.g. a sensor channel.
+type CustomTarget struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CustomTargetId int64                  `protobuf:"varint,1,opt,name=custom_target_id,json=customTargetId"`
+	xxx_hidden_Label          *string                `protobuf:"bytes,2,opt,name=label"`
+	xxx_hidden_Units          *string                `protobuf:"bytes,3,opt,name=units"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CustomTarget) Reset() {
+	*x = CustomTarget{}
+	mi := &file_wayplatform_connect_mapon_v1_custom_target_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomTarget) ProtoMessage() {}
+
+func (x *CustomTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_custom_target_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CustomTarget) GetCustomTargetId() int64 {
+	if x != nil {
+		return x.xxx_hidden_CustomTargetId
+	}
+	return 0
+}
+
+func (x *CustomTarget) GetLabel() string {
+	if x != nil {
+		if x.xxx_hidden_Label != nil {
+			return *x.xxx_hidden_Label
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CustomTarget) GetUnits() string {
+	if x != nil {
+		if x.xxx_hidden_Units != nil {
+			return *x.xxx_hidden_Units
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CustomTarget) SetCustomTargetId(v int64) {
+	x.xxx_hidden_CustomTargetId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *CustomTarget) SetLabel(v string) {
+	x.xxx_hidden_Label = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *CustomTarget) SetUnits(v string) {
+	x.xxx_hidden_Units = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *CustomTarget) HasCustomTargetId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CustomTarget) HasLabel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CustomTarget) HasUnits() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CustomTarget) ClearCustomTargetId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CustomTargetId = 0
+}
+
+func (x *CustomTarget) ClearLabel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Label = nil
+}
+
+func (x *CustomTarget) ClearUnits() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Units = nil
+}
+
+type CustomTarget_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the custom target.
+	CustomTargetId *int64
+	// Human-readable name of the custom target.
+	Label *string
+	// Unit of measurement of the target values (e.g. "celsius", "km", "minutes").
+	Units *string
+}
+
+func (b0 CustomTarget_builder) Build() *CustomTarget {
+	m0 := &CustomTarget{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.CustomTargetId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_CustomTargetId = *b.CustomTargetId
+	}
+	if b.Label != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Label = b.Label
+	}
+	if b.Units != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Units = b.Units
+	}
+	return m0
+}
+
+// CustomTargetValue represents a value of a custom target recorded for a unit.
+// Exactly one of the value fields is set, depending on the type of the recorded value.
+type CustomTargetValue struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UnitId         int64                  `protobuf:"varint,1,opt,name=unit_id,json=unitId"`
+	xxx_hidden_CustomTargetId int64                  `protobuf:"varint,2,opt,name=custom_target_id,json=customTargetId"`
+	xxx_hidden_Time           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time"`
+	xxx_hidden_NumberValue    float64                `protobuf:"fixed64,4,opt,name=number_value,json=numberValue"`
+	xxx_hidden_StringValue    *string                `protobuf:"bytes,5,opt,name=string_value,json=stringValue"`
+	xxx_hidden_BoolValue      bool                   `protobuf:"varint,6,opt,name=bool_value,json=boolValue"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CustomTargetValue) Reset() {
+	*x = CustomTargetValue{}
+	mi := &file_wayplatform_connect_mapon_v1_custom_target_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomTargetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomTargetValue) ProtoMessage() {}
+
+func (x *CustomTargetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_custom_target_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CustomTargetValue) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *CustomTargetValue) GetCustomTargetId() int64 {
+	if x != nil {
+		return x.xxx_hidden_CustomTargetId
+	}
+	return 0
+}
+
+func (x *CustomTargetValue) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *CustomTargetValue) GetNumberValue() float64 {
+	if x != nil {
+		return x.xxx_hidden_NumberValue
+	}
+	return 0
+}
+
+func (x *CustomTargetValue) GetStringValue() string {
+	if x != nil {
+		if x.xxx_hidden_StringValue != nil {
+			return *x.xxx_hidden_StringValue
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CustomTargetValue) GetBoolValue() bool {
+	if x != nil {
+		return x.xxx_hidden_BoolValue
+	}
+	return false
+}
+
+func (x *CustomTargetValue) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *CustomTargetValue) SetCustomTargetId(v int64) {
+	x.xxx_hidden_CustomTargetId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *CustomTargetValue) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *CustomTargetValue) SetNumberValue(v float64) {
+	x.xxx_hidden_NumberValue = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *CustomTargetValue) SetStringValue(v string) {
+	x.xxx_hidden_StringValue = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *CustomTargetValue) SetBoolValue(v bool) {
+	x.xxx_hidden_BoolValue = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *CustomTargetValue) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CustomTargetValue) HasCustomTargetId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CustomTargetValue) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *CustomTargetValue) HasNumberValue() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CustomTargetValue) HasStringValue() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *CustomTargetValue) HasBoolValue() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *CustomTargetValue) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *CustomTargetValue) ClearCustomTargetId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CustomTargetId = 0
+}
+
+func (x *CustomTargetValue) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *CustomTargetValue) ClearNumberValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_NumberValue = 0
+}
+
+func (x *CustomTargetValue) ClearStringValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_StringValue = nil
+}
+
+func (x *CustomTargetValue) ClearBoolValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_BoolValue = false
+}
+
+type CustomTargetValue_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Identifier of the unit the value was recorded for.
+	UnitId *int64
+	// Identifier of the custom target the value belongs to.
+	CustomTargetId *int64
+	// Timestamp when the value was recorded.
+	Time *timestamppb.Timestamp
+	// Numeric value, e.g. 123.45.
+	NumberValue *float64
+	// Text value, e.g. "Active".
+	StringValue *string
+	// Boolean value.
+	BoolValue *bool
+}
+
+func (b0 CustomTargetValue_builder) Build() *CustomTargetValue {
+	m0 := &CustomTargetValue{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.CustomTargetId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_CustomTargetId = *b.CustomTargetId
+	}
+	x.xxx_hidden_Time = b.Time
+	if b.NumberValue != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_NumberValue = *b.NumberValue
+	}
+	if b.StringValue != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_StringValue = b.StringValue
+	}
+	if b.BoolValue != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_BoolValue = *b.BoolValue
+	}
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_custom_target_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_custom_target_proto_rawDesc = "" +
+	"\n" +
+	"0wayplatform/connect/mapon/v1/custom_target.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"d\n" +
+	"\fCustomTarget\x12(\n" +
+	"\x10custom_target_id\x18\x01 \x01(\x03R\x0ecustomTargetId\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05units\x18\x03 \x01(\tR\x05units\"\xeb\x01\n" +
+	"\x11CustomTargetValue\x12\x17\n" +
+	"\aunit_id\x18\x01 \x01(\x03R\x06unitId\x12(\n" +
+	"\x10custom_target_id\x18\x02 \x01(\x03R\x0ecustomTargetId\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12!\n" +
+	"\fnumber_value\x18\x04 \x01(\x01R\vnumberValue\x12!\n" +
+	"\fstring_value\x18\x05 \x01(\tR\vstringValue\x12\x1d\n" +
+	"\n" +
+	"bool_value\x18\x06 \x01(\bR\tboolValueB\x9c\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x11CustomTargetProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_custom_target_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_mapon_v1_custom_target_proto_goTypes = []any{
+	(*CustomTarget)(nil),          // 0: wayplatform.connect.mapon.v1.CustomTarget
+	(*CustomTargetValue)(nil),     // 1: wayplatform.connect.mapon.v1.CustomTargetValue
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_mapon_v1_custom_target_proto_depIdxs = []int32{
+	2, // 0: wayplatform.connect.mapon.v1.CustomTargetValue.time:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_custom_target_proto_init() }
+func file_wayplatform_connect_mapon_v1_custom_target_proto_init() {
+	if File_wayplatform_connect_mapon_v1_custom_target_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_custom_target_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_custom_target_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_custom_target_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_custom_target_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_custom_target_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_custom_target_proto = out.File
+	file_wayplatform_connect_mapon_v1_custom_target_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_custom_target_proto_depIdxs = nil
+}
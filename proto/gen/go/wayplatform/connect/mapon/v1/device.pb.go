@@ -0,0 +1,1123 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/device.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Transport used to send a device command.
+type DeviceCommand_Transport int32
+
+const (
+	// Default value, used when the transport is missing or not set.
+	DeviceCommand_TRANSPORT_UNSPECIFIED DeviceCommand_Transport = 0
+	// The command is sent as an SMS.
+	DeviceCommand_TRANSPORT_SMS DeviceCommand_Transport = 1
+	// The command is sent over a TCP connection.
+	DeviceCommand_TRANSPORT_TCP DeviceCommand_Transport = 2
+)
+
+// Enum value maps for DeviceCommand_Transport.
+var (
+	DeviceCommand_Transport_name = map[int32]string{
+		0: "TRANSPORT_UNSPECIFIED",
+		1: "TRANSPORT_SMS",
+		2: "TRANSPORT_TCP",
+	}
+	DeviceCommand_Transport_value = map[string]int32{
+		"TRANSPORT_UNSPECIFIED": 0,
+		"TRANSPORT_SMS":         1,
+		"TRANSPORT_TCP":         2,
+	}
+)
+
+func (x DeviceCommand_Transport) Enum() *DeviceCommand_Transport {
+	p := new(DeviceCommand_Transport)
+	*p = x
+	return p
+}
+
+func (x DeviceCommand_Transport) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeviceCommand_Transport) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_device_proto_enumTypes[0].Descriptor()
+}
+
+func (DeviceCommand_Transport) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_device_proto_enumTypes[0]
+}
+
+func (x DeviceCommand_Transport) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Device represents a tracking device of the company,
+// either installed on a unit or kept in the company storage.
+type Device struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BoxId        int64                  `protobuf:"varint,1,opt,name=box_id,json=boxId"`
+	xxx_hidden_UnitId       int64                  `protobuf:"varint,2,opt,name=unit_id,json=unitId"`
+	xxx_hidden_Model        *string                `protobuf:"bytes,3,opt,name=model"`
+	xxx_hidden_ModelVersion *string                `protobuf:"bytes,4,opt,name=model_version,json=modelVersion"`
+	xxx_hidden_Installed    bool                   `protobuf:"varint,5,opt,name=installed"`
+	xxx_hidden_Imei         *string                `protobuf:"bytes,6,opt,name=imei"`
+	xxx_hidden_SerialNumber *string                `protobuf:"bytes,7,opt,name=serial_number,json=serialNumber"`
+	xxx_hidden_Phone        *string                `protobuf:"bytes,8,opt,name=phone"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_wayplatform_connect_mapon_v1_device_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_device_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Device) GetBoxId() int64 {
+	if x != nil {
+		return x.xxx_hidden_BoxId
+	}
+	return 0
+}
+
+func (x *Device) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *Device) GetModel() string {
+	if x != nil {
+		if x.xxx_hidden_Model != nil {
+			return *x.xxx_hidden_Model
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Device) GetModelVersion() string {
+	if x != nil {
+		if x.xxx_hidden_ModelVersion != nil {
+			return *x.xxx_hidden_ModelVersion
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Device) GetInstalled() bool {
+	if x != nil {
+		return x.xxx_hidden_Installed
+	}
+	return false
+}
+
+func (x *Device) GetImei() string {
+	if x != nil {
+		if x.xxx_hidden_Imei != nil {
+			return *x.xxx_hidden_Imei
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Device) GetSerialNumber() string {
+	if x != nil {
+		if x.xxx_hidden_SerialNumber != nil {
+			return *x.xxx_hidden_SerialNumber
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Device) GetPhone() string {
+	if x != nil {
+		if x.xxx_hidden_Phone != nil {
+			return *x.xxx_hidden_Phone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Device) SetBoxId(v int64) {
+	x.xxx_hidden_BoxId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *Device) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *Device) SetModel(v string) {
+	x.xxx_hidden_Model = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *Device) SetModelVersion(v string) {
+	x.xxx_hidden_ModelVersion = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *Device) SetInstalled(v bool) {
+	x.xxx_hidden_Installed = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+}
+
+func (x *Device) SetImei(v string) {
+	x.xxx_hidden_Imei = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *Device) SetSerialNumber(v string) {
+	x.xxx_hidden_SerialNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *Device) SetPhone(v string) {
+	x.xxx_hidden_Phone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *Device) HasBoxId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Device) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Device) HasModel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Device) HasModelVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Device) HasInstalled() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Device) HasImei() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Device) HasSerialNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Device) HasPhone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Device) ClearBoxId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_BoxId = 0
+}
+
+func (x *Device) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *Device) ClearModel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Model = nil
+}
+
+func (x *Device) ClearModelVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_ModelVersion = nil
+}
+
+func (x *Device) ClearInstalled() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Installed = false
+}
+
+func (x *Device) ClearImei() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Imei = nil
+}
+
+func (x *Device) ClearSerialNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_SerialNumber = nil
+}
+
+func (x *Device) ClearPhone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Phone = nil
+}
+
+type Device_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier of the device (box ID).
+	BoxId *int64
+	// Identifier of the unit the device is installed on (0 if in storage).
+	UnitId *int64
+	// Device model (e.g. "TELTONIKA").
+	Model *string
+	// Device model version (e.g. "FMB640").
+	ModelVersion *string
+	// Whether the device is installed on a unit.
+	Installed *bool
+	// IMEI (International Mobile Equipment Identity) of the device.
+	Imei *string
+	// Serial number of the device.
+	SerialNumber *string
+	// Phone number of the device SIM card.
+	Phone *string
+}
+
+func (b0 Device_builder) Build() *Device {
+	m0 := &Device{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.BoxId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_BoxId = *b.BoxId
+	}
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.Model != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_Model = b.Model
+	}
+	if b.ModelVersion != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_ModelVersion = b.ModelVersion
+	}
+	if b.Installed != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		x.xxx_hidden_Installed = *b.Installed
+	}
+	if b.Imei != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_Imei = b.Imei
+	}
+	if b.SerialNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_SerialNumber = b.SerialNumber
+	}
+	if b.Phone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_Phone = b.Phone
+	}
+	return m0
+}
+
+// DeviceModel represents a device model supported by Mapon.
+type DeviceModel struct {
+	state                       protoimpl.MessageState     `protogen:"opaque.v1"`
+	xxx_hidden_Model            *string                    `protobuf:"bytes,1,opt,name=model"`
+	xxx_hidden_Title            *string                    `protobuf:"bytes,2,opt,name=title"`
+	xxx_hidden_DisplayId        *string                    `protobuf:"bytes,3,opt,name=display_id,json=displayId"`
+	xxx_hidden_Versions         map[string]string          `protobuf:"bytes,4,rep,name=versions" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_RequiredFields   []string                   `protobuf:"bytes,5,rep,name=required_fields,json=requiredFields"`
+	xxx_hidden_AdditionalFields []string                   `protobuf:"bytes,6,rep,name=additional_fields,json=additionalFields"`
+	xxx_hidden_CustomFields     *[]*DeviceModelCustomField `protobuf:"bytes,7,rep,name=custom_fields,json=customFields"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *DeviceModel) Reset() {
+	*x = DeviceModel{}
+	mi := &file_wayplatform_connect_mapon_v1_device_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceModel) ProtoMessage() {}
+
+func (x *DeviceModel) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_device_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeviceModel) GetModel() string {
+	if x != nil {
+		if x.xxx_hidden_Model != nil {
+			return *x.xxx_hidden_Model
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeviceModel) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeviceModel) GetDisplayId() string {
+	if x != nil {
+		if x.xxx_hidden_DisplayId != nil {
+			return *x.xxx_hidden_DisplayId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeviceModel) GetVersions() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Versions
+	}
+	return nil
+}
+
+func (x *DeviceModel) GetRequiredFields() []string {
+	if x != nil {
+		return x.xxx_hidden_RequiredFields
+	}
+	return nil
+}
+
+func (x *DeviceModel) GetAdditionalFields() []string {
+	if x != nil {
+		return x.xxx_hidden_AdditionalFields
+	}
+	return nil
+}
+
+func (x *DeviceModel) GetCustomFields() []*DeviceModelCustomField {
+	if x != nil {
+		if x.xxx_hidden_CustomFields != nil {
+			return *x.xxx_hidden_CustomFields
+		}
+	}
+	return nil
+}
+
+func (x *DeviceModel) SetModel(v string) {
+	x.xxx_hidden_Model = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *DeviceModel) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *DeviceModel) SetDisplayId(v string) {
+	x.xxx_hidden_DisplayId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+}
+
+func (x *DeviceModel) SetVersions(v map[string]string) {
+	x.xxx_hidden_Versions = v
+}
+
+func (x *DeviceModel) SetRequiredFields(v []string) {
+	x.xxx_hidden_RequiredFields = v
+}
+
+func (x *DeviceModel) SetAdditionalFields(v []string) {
+	x.xxx_hidden_AdditionalFields = v
+}
+
+func (x *DeviceModel) SetCustomFields(v []*DeviceModelCustomField) {
+	x.xxx_hidden_CustomFields = &v
+}
+
+func (x *DeviceModel) HasModel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeviceModel) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DeviceModel) HasDisplayId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DeviceModel) ClearModel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Model = nil
+}
+
+func (x *DeviceModel) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *DeviceModel) ClearDisplayId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_DisplayId = nil
+}
+
+type DeviceModel_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Model name identifier (e.g. "TELTONIKA").
+	Model *string
+	// Human-readable title of the model.
+	Title *string
+	// Name of the field used to identify devices of this model (e.g. "imei" or "sn").
+	DisplayId *string
+	// Supported model versions, keyed by model version with the version title as value.
+	Versions map[string]string
+	// Fields that are required when creating a device of this model.
+	RequiredFields []string
+	// Additional fields supported when creating a device of this model.
+	AdditionalFields []string
+	// Custom fields supported by this model.
+	CustomFields []*DeviceModelCustomField
+}
+
+func (b0 DeviceModel_builder) Build() *DeviceModel {
+	m0 := &DeviceModel{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Model != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_Model = b.Model
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_Title = b.Title
+	}
+	if b.DisplayId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_DisplayId = b.DisplayId
+	}
+	x.xxx_hidden_Versions = b.Versions
+	x.xxx_hidden_RequiredFields = b.RequiredFields
+	x.xxx_hidden_AdditionalFields = b.AdditionalFields
+	x.xxx_hidden_CustomFields = &b.CustomFields
+	return m0
+}
+
+// DeviceModelCustomField represents a custom field supported by a device model.
+type DeviceModelCustomField struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name         *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_Type         *string                `protobuf:"bytes,2,opt,name=type"`
+	xxx_hidden_ModelVersion *string                `protobuf:"bytes,3,opt,name=model_version,json=modelVersion"`
+	xxx_hidden_Options      []string               `protobuf:"bytes,4,rep,name=options"`
+	xxx_hidden_DefaultValue *string                `protobuf:"bytes,5,opt,name=default_value,json=defaultValue"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *DeviceModelCustomField) Reset() {
+	*x = DeviceModelCustomField{}
+	mi := &file_wayplatform_connect_mapon_v1_device_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceModelCustomField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceModelCustomField) ProtoMessage() {}
+
+func (x *DeviceModelCustomField) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_device_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeviceModelCustomField) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeviceModelCustomField) GetType() string {
+	if x != nil {
+		if x.xxx_hidden_Type != nil {
+			return *x.xxx_hidden_Type
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeviceModelCustomField) GetModelVersion() string {
+	if x != nil {
+		if x.xxx_hidden_ModelVersion != nil {
+			return *x.xxx_hidden_ModelVersion
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeviceModelCustomField) GetOptions() []string {
+	if x != nil {
+		return x.xxx_hidden_Options
+	}
+	return nil
+}
+
+func (x *DeviceModelCustomField) GetDefaultValue() string {
+	if x != nil {
+		if x.xxx_hidden_DefaultValue != nil {
+			return *x.xxx_hidden_DefaultValue
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeviceModelCustomField) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *DeviceModelCustomField) SetType(v string) {
+	x.xxx_hidden_Type = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *DeviceModelCustomField) SetModelVersion(v string) {
+	x.xxx_hidden_ModelVersion = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *DeviceModelCustomField) SetOptions(v []string) {
+	x.xxx_hidden_Options = v
+}
+
+func (x *DeviceModelCustomField) SetDefaultValue(v string) {
+	x.xxx_hidden_DefaultValue = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *DeviceModelCustomField) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeviceModelCustomField) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DeviceModelCustomField) HasModelVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DeviceModelCustomField) HasDefaultValue() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DeviceModelCustomField) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *DeviceModelCustomField) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Type = nil
+}
+
+func (x *DeviceModelCustomField) ClearModelVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_ModelVersion = nil
+}
+
+func (x *DeviceModelCustomField) ClearDefaultValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_DefaultValue = nil
+}
+
+type DeviceModelCustomField_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Name of the custom field.
+	Name *string
+	// Raw type of the custom field (e.g. "text" or "select").
+	Type *string
+	// Model version the field applies to (empty for all versions).
+	ModelVersion *string
+	// Allowed values for select fields.
+	Options []string
+	// Default value of the field.
+	DefaultValue *string
+}
+
+func (b0 DeviceModelCustomField_builder) Build() *DeviceModelCustomField {
+	m0 := &DeviceModelCustomField{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Type = b.Type
+	}
+	if b.ModelVersion != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_ModelVersion = b.ModelVersion
+	}
+	x.xxx_hidden_Options = b.Options
+	if b.DefaultValue != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_DefaultValue = b.DefaultValue
+	}
+	return m0
+}
+
+// DeviceCommand represents an SMS or TCP command supported by a device.
+type DeviceCommand struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Command     *string                `protobuf:"bytes,1,opt,name=command"`
+	xxx_hidden_Parameters  map[string]string      `protobuf:"bytes,2,rep,name=parameters" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeviceCommand) Reset() {
+	*x = DeviceCommand{}
+	mi := &file_wayplatform_connect_mapon_v1_device_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceCommand) ProtoMessage() {}
+
+func (x *DeviceCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_device_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeviceCommand) GetCommand() string {
+	if x != nil {
+		if x.xxx_hidden_Command != nil {
+			return *x.xxx_hidden_Command
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeviceCommand) GetParameters() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Parameters
+	}
+	return nil
+}
+
+func (x *DeviceCommand) SetCommand(v string) {
+	x.xxx_hidden_Command = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *DeviceCommand) SetParameters(v map[string]string) {
+	x.xxx_hidden_Parameters = v
+}
+
+func (x *DeviceCommand) HasCommand() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeviceCommand) ClearCommand() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Command = nil
+}
+
+type DeviceCommand_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Name of the command.
+	Command *string
+	// Parameters of the command, keyed by parameter name with the default value (empty if none).
+	Parameters map[string]string
+}
+
+func (b0 DeviceCommand_builder) Build() *DeviceCommand {
+	m0 := &DeviceCommand{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Command != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Command = b.Command
+	}
+	x.xxx_hidden_Parameters = b.Parameters
+	return m0
+}
+
+// DeviceSmsMessage represents an SMS received from a device.
+type DeviceSmsMessage struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Phone       *string                `protobuf:"bytes,1,opt,name=phone"`
+	xxx_hidden_Response    *string                `protobuf:"bytes,2,opt,name=response"`
+	xxx_hidden_ReceivedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=received_at,json=receivedAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeviceSmsMessage) Reset() {
+	*x = DeviceSmsMessage{}
+	mi := &file_wayplatform_connect_mapon_v1_device_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceSmsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceSmsMessage) ProtoMessage() {}
+
+func (x *DeviceSmsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_device_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeviceSmsMessage) GetPhone() string {
+	if x != nil {
+		if x.xxx_hidden_Phone != nil {
+			return *x.xxx_hidden_Phone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeviceSmsMessage) GetResponse() string {
+	if x != nil {
+		if x.xxx_hidden_Response != nil {
+			return *x.xxx_hidden_Response
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeviceSmsMessage) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ReceivedAt
+	}
+	return nil
+}
+
+func (x *DeviceSmsMessage) SetPhone(v string) {
+	x.xxx_hidden_Phone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *DeviceSmsMessage) SetResponse(v string) {
+	x.xxx_hidden_Response = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *DeviceSmsMessage) SetReceivedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ReceivedAt = v
+}
+
+func (x *DeviceSmsMessage) HasPhone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeviceSmsMessage) HasResponse() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DeviceSmsMessage) HasReceivedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ReceivedAt != nil
+}
+
+func (x *DeviceSmsMessage) ClearPhone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Phone = nil
+}
+
+func (x *DeviceSmsMessage) ClearResponse() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Response = nil
+}
+
+func (x *DeviceSmsMessage) ClearReceivedAt() {
+	x.xxx_hidden_ReceivedAt = nil
+}
+
+type DeviceSmsMessage_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Phone number the SMS was sent from.
+	Phone *string
+	// Content of the SMS.
+	Response *string
+	// Time when the SMS was received.
+	ReceivedAt *timestamppb.Timestamp
+}
+
+func (b0 DeviceSmsMessage_builder) Build() *DeviceSmsMessage {
+	m0 := &DeviceSmsMessage{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Phone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Phone = b.Phone
+	}
+	if b.Response != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Response = b.Response
+	}
+	x.xxx_hidden_ReceivedAt = b.ReceivedAt
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_device_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_device_proto_rawDesc = "" +
+	"\n" +
+	")wayplatform/connect/mapon/v1/device.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe0\x01\n" +
+	"\x06Device\x12\x15\n" +
+	"\x06box_id\x18\x01 \x01(\x03R\x05boxId\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\x03R\x06unitId\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12#\n" +
+	"\rmodel_version\x18\x04 \x01(\tR\fmodelVersion\x12\x1c\n" +
+	"\tinstalled\x18\x05 \x01(\bR\tinstalled\x12\x12\n" +
+	"\x04imei\x18\x06 \x01(\tR\x04imei\x12#\n" +
+	"\rserial_number\x18\a \x01(\tR\fserialNumber\x12\x14\n" +
+	"\x05phone\x18\b \x01(\tR\x05phone\"\x9b\x03\n" +
+	"\vDeviceModel\x12\x14\n" +
+	"\x05model\x18\x01 \x01(\tR\x05model\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"display_id\x18\x03 \x01(\tR\tdisplayId\x12S\n" +
+	"\bversions\x18\x04 \x03(\v27.wayplatform.connect.mapon.v1.DeviceModel.VersionsEntryR\bversions\x12'\n" +
+	"\x0frequired_fields\x18\x05 \x03(\tR\x0erequiredFields\x12+\n" +
+	"\x11additional_fields\x18\x06 \x03(\tR\x10additionalFields\x12Y\n" +
+	"\rcustom_fields\x18\a \x03(\v24.wayplatform.connect.mapon.v1.DeviceModelCustomFieldR\fcustomFields\x1a;\n" +
+	"\rVersionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa4\x01\n" +
+	"\x16DeviceModelCustomField\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12#\n" +
+	"\rmodel_version\x18\x03 \x01(\tR\fmodelVersion\x12\x18\n" +
+	"\aoptions\x18\x04 \x03(\tR\aoptions\x12#\n" +
+	"\rdefault_value\x18\x05 \x01(\tR\fdefaultValue\"\x93\x02\n" +
+	"\rDeviceCommand\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\tR\acommand\x12[\n" +
+	"\n" +
+	"parameters\x18\x02 \x03(\v2;.wayplatform.connect.mapon.v1.DeviceCommand.ParametersEntryR\n" +
+	"parameters\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"L\n" +
+	"\tTransport\x12\x19\n" +
+	"\x15TRANSPORT_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rTRANSPORT_SMS\x10\x01\x12\x11\n" +
+	"\rTRANSPORT_TCP\x10\x02\"\x81\x01\n" +
+	"\x10DeviceSmsMessage\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x1a\n" +
+	"\bresponse\x18\x02 \x01(\tR\bresponse\x12;\n" +
+	"\vreceived_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAtB\x96\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\vDeviceProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_device_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_mapon_v1_device_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_wayplatform_connect_mapon_v1_device_proto_goTypes = []any{
+	(DeviceCommand_Transport)(0),   // 0: wayplatform.connect.mapon.v1.DeviceCommand.Transport
+	(*Device)(nil),                 // 1: wayplatform.connect.mapon.v1.Device
+	(*DeviceModel)(nil),            // 2: wayplatform.connect.mapon.v1.DeviceModel
+	(*DeviceModelCustomField)(nil), // 3: wayplatform.connect.mapon.v1.DeviceModelCustomField
+	(*DeviceCommand)(nil),          // 4: wayplatform.connect.mapon.v1.DeviceCommand
+	(*DeviceSmsMessage)(nil),       // 5: wayplatform.connect.mapon.v1.DeviceSmsMessage
+	nil,                            // 6: wayplatform.connect.mapon.v1.DeviceModel.VersionsEntry
+	nil,                            // 7: wayplatform.connect.mapon.v1.DeviceCommand.ParametersEntry
+	(*timestamppb.Timestamp)(nil),  // 8: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_mapon_v1_device_proto_depIdxs = []int32{
+	6, // 0: wayplatform.connect.mapon.v1.DeviceModel.versions:type_name -> wayplatform.connect.mapon.v1.DeviceModel.VersionsEntry
+	3, // 1: wayplatform.connect.mapon.v1.DeviceModel.custom_fields:type_name -> wayplatform.connect.mapon.v1.DeviceModelCustomField
+	7, // 2: wayplatform.connect.mapon.v1.DeviceCommand.parameters:type_name -> wayplatform.connect.mapon.v1.DeviceCommand.ParametersEntry
+	8, // 3: wayplatform.connect.mapon.v1.DeviceSmsMessage.received_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_device_proto_init() }
+func file_wayplatform_connect_mapon_v1_device_proto_init() {
+	if File_wayplatform_connect_mapon_v1_device_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_device_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_device_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_device_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_device_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_device_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_device_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_device_proto = out.File
+	file_wayplatform_connect_mapon_v1_device_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_device_proto_depIdxs = nil
+}
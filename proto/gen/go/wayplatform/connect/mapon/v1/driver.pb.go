@@ -23,20 +23,23 @@ const (
 
 // Driver represents a vehicle driver.
 type Driver struct {
-	state                   protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_DriverId     int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId"`
-	xxx_hidden_Name         *string                `protobuf:"bytes,2,opt,name=name"`
-	xxx_hidden_Surname      *string                `protobuf:"bytes,3,opt,name=surname"`
-	xxx_hidden_Email        *string                `protobuf:"bytes,4,opt,name=email"`
-	xxx_hidden_Phone        *string                `protobuf:"bytes,5,opt,name=phone"`
-	xxx_hidden_IbuttonValue *string                `protobuf:"bytes,6,opt,name=ibutton_value,json=ibuttonValue"`
-	xxx_hidden_TachographId *string                `protobuf:"bytes,7,opt,name=tachograph_id,json=tachographId"`
-	xxx_hidden_Blocked      bool                   `protobuf:"varint,8,opt,name=blocked"`
-	xxx_hidden_CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt"`
-	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
-	XXX_presence            [1]uint32
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverId           int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId"`
+	xxx_hidden_Name               *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Surname            *string                `protobuf:"bytes,3,opt,name=surname"`
+	xxx_hidden_Email              *string                `protobuf:"bytes,4,opt,name=email"`
+	xxx_hidden_Phone              *string                `protobuf:"bytes,5,opt,name=phone"`
+	xxx_hidden_IbuttonValue       *string                `protobuf:"bytes,6,opt,name=ibutton_value,json=ibuttonValue"`
+	xxx_hidden_TachographId       *string                `protobuf:"bytes,7,opt,name=tachograph_id,json=tachographId"`
+	xxx_hidden_Blocked            bool                   `protobuf:"varint,8,opt,name=blocked"`
+	xxx_hidden_CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Language           *string                `protobuf:"bytes,10,opt,name=language"`
+	xxx_hidden_AccessToRouteSheet bool                   `protobuf:"varint,11,opt,name=access_to_route_sheet,json=accessToRouteSheet"`
+	xxx_hidden_CustomFields       map[int64]string       `protobuf:"bytes,12,rep,name=custom_fields,json=customFields" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
 }
 
 func (x *Driver) Reset() {
@@ -145,50 +148,88 @@ func (x *Driver) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Driver) GetLanguage() string {
+	if x != nil {
+		if x.xxx_hidden_Language != nil {
+			return *x.xxx_hidden_Language
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Driver) GetAccessToRouteSheet() bool {
+	if x != nil {
+		return x.xxx_hidden_AccessToRouteSheet
+	}
+	return false
+}
+
+func (x *Driver) GetCustomFields() map[int64]string {
+	if x != nil {
+		return x.xxx_hidden_CustomFields
+	}
+	return nil
+}
+
 func (x *Driver) SetDriverId(v int64) {
 	x.xxx_hidden_DriverId = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 12)
 }
 
 func (x *Driver) SetName(v string) {
 	x.xxx_hidden_Name = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 12)
 }
 
 func (x *Driver) SetSurname(v string) {
 	x.xxx_hidden_Surname = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 12)
 }
 
 func (x *Driver) SetEmail(v string) {
 	x.xxx_hidden_Email = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 12)
 }
 
 func (x *Driver) SetPhone(v string) {
 	x.xxx_hidden_Phone = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 12)
 }
 
 func (x *Driver) SetIbuttonValue(v string) {
 	x.xxx_hidden_IbuttonValue = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 12)
 }
 
 func (x *Driver) SetTachographId(v string) {
 	x.xxx_hidden_TachographId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 12)
 }
 
 func (x *Driver) SetBlocked(v bool) {
 	x.xxx_hidden_Blocked = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 12)
 }
 
 func (x *Driver) SetCreatedAt(v *timestamppb.Timestamp) {
 	x.xxx_hidden_CreatedAt = v
 }
 
+func (x *Driver) SetLanguage(v string) {
+	x.xxx_hidden_Language = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 12)
+}
+
+func (x *Driver) SetAccessToRouteSheet(v bool) {
+	x.xxx_hidden_AccessToRouteSheet = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 12)
+}
+
+func (x *Driver) SetCustomFields(v map[int64]string) {
+	x.xxx_hidden_CustomFields = v
+}
+
 func (x *Driver) HasDriverId() bool {
 	if x == nil {
 		return false
@@ -252,6 +293,20 @@ func (x *Driver) HasCreatedAt() bool {
 	return x.xxx_hidden_CreatedAt != nil
 }
 
+func (x *Driver) HasLanguage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *Driver) HasAccessToRouteSheet() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
 func (x *Driver) ClearDriverId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_DriverId = 0
@@ -296,6 +351,16 @@ func (x *Driver) ClearCreatedAt() {
 	x.xxx_hidden_CreatedAt = nil
 }
 
+func (x *Driver) ClearLanguage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_Language = nil
+}
+
+func (x *Driver) ClearAccessToRouteSheet() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_AccessToRouteSheet = false
+}
+
 type Driver_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -317,6 +382,13 @@ type Driver_builder struct {
 	Blocked *bool
 	// Timestamp when the driver record was created.
 	CreatedAt *timestamppb.Timestamp
+	// Two letter language code of the driver.
+	Language *string
+	// Indicates if the driver can log in with email and password.
+	AccessToRouteSheet *bool
+	// Custom field values keyed by custom field ID.
+	// Only populated when requested with include_custom_fields.
+	CustomFields map[int64]string
 }
 
 func (b0 Driver_builder) Build() *Driver {
@@ -324,38 +396,459 @@ func (b0 Driver_builder) Build() *Driver {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.DriverId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 12)
 		x.xxx_hidden_DriverId = *b.DriverId
 	}
 	if b.Name != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 12)
 		x.xxx_hidden_Name = b.Name
 	}
 	if b.Surname != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 12)
 		x.xxx_hidden_Surname = b.Surname
 	}
 	if b.Email != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 12)
 		x.xxx_hidden_Email = b.Email
 	}
 	if b.Phone != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 12)
 		x.xxx_hidden_Phone = b.Phone
 	}
 	if b.IbuttonValue != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 12)
 		x.xxx_hidden_IbuttonValue = b.IbuttonValue
 	}
 	if b.TachographId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 12)
 		x.xxx_hidden_TachographId = b.TachographId
 	}
 	if b.Blocked != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 12)
 		x.xxx_hidden_Blocked = *b.Blocked
 	}
 	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Language != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 12)
+		x.xxx_hidden_Language = b.Language
+	}
+	if b.AccessToRouteSheet != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 12)
+		x.xxx_hidden_AccessToRouteSheet = *b.AccessToRouteSheet
+	}
+	x.xxx_hidden_CustomFields = b.CustomFields
+	return m0
+}
+
+// DriverCustomFields aggregates the custom fields of a driver.
+type DriverCustomFields struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverId    int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId"`
+	xxx_hidden_Fields      *[]*CustomField        `protobuf:"bytes,2,rep,name=fields"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DriverCustomFields) Reset() {
+	*x = DriverCustomFields{}
+	mi := &file_wayplatform_connect_mapon_v1_driver_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverCustomFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverCustomFields) ProtoMessage() {}
+
+func (x *DriverCustomFields) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_driver_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverCustomFields) GetDriverId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DriverId
+	}
+	return 0
+}
+
+func (x *DriverCustomFields) GetFields() []*CustomField {
+	if x != nil {
+		if x.xxx_hidden_Fields != nil {
+			return *x.xxx_hidden_Fields
+		}
+	}
+	return nil
+}
+
+func (x *DriverCustomFields) SetDriverId(v int64) {
+	x.xxx_hidden_DriverId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *DriverCustomFields) SetFields(v []*CustomField) {
+	x.xxx_hidden_Fields = &v
+}
+
+func (x *DriverCustomFields) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverCustomFields) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = 0
+}
+
+type DriverCustomFields_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier of the driver.
+	DriverId *int64
+	// Custom fields with their values.
+	Fields []*CustomField
+}
+
+func (b0 DriverCustomFields_builder) Build() *DriverCustomFields {
+	m0 := &DriverCustomFields{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_DriverId = *b.DriverId
+	}
+	x.xxx_hidden_Fields = &b.Fields
+	return m0
+}
+
+// ExternalDriverAssociation associates an external driver with a Mapon driver.
+type ExternalDriverAssociation struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverId    int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId"`
+	xxx_hidden_EmployeeId  *string                `protobuf:"bytes,2,opt,name=employee_id,json=employeeId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ExternalDriverAssociation) Reset() {
+	*x = ExternalDriverAssociation{}
+	mi := &file_wayplatform_connect_mapon_v1_driver_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalDriverAssociation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalDriverAssociation) ProtoMessage() {}
+
+func (x *ExternalDriverAssociation) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_driver_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExternalDriverAssociation) GetDriverId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DriverId
+	}
+	return 0
+}
+
+func (x *ExternalDriverAssociation) GetEmployeeId() string {
+	if x != nil {
+		if x.xxx_hidden_EmployeeId != nil {
+			return *x.xxx_hidden_EmployeeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ExternalDriverAssociation) SetDriverId(v int64) {
+	x.xxx_hidden_DriverId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ExternalDriverAssociation) SetEmployeeId(v string) {
+	x.xxx_hidden_EmployeeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ExternalDriverAssociation) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ExternalDriverAssociation) HasEmployeeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ExternalDriverAssociation) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = 0
+}
+
+func (x *ExternalDriverAssociation) ClearEmployeeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EmployeeId = nil
+}
+
+type ExternalDriverAssociation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier of the Mapon driver.
+	DriverId *int64
+	// Identifier of the driver in the external system, e.g. an employee ID.
+	EmployeeId *string
+}
+
+func (b0 ExternalDriverAssociation_builder) Build() *ExternalDriverAssociation {
+	m0 := &ExternalDriverAssociation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_DriverId = *b.DriverId
+	}
+	if b.EmployeeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_EmployeeId = b.EmployeeId
+	}
+	return m0
+}
+
+// ExternalDriverAssociationResult is the outcome of a single external driver association.
+type ExternalDriverAssociationResult struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverId    int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId"`
+	xxx_hidden_EmployeeId  *string                `protobuf:"bytes,2,opt,name=employee_id,json=employeeId"`
+	xxx_hidden_CompanyId   int64                  `protobuf:"varint,3,opt,name=company_id,json=companyId"`
+	xxx_hidden_Success     bool                   `protobuf:"varint,4,opt,name=success"`
+	xxx_hidden_Message     *string                `protobuf:"bytes,5,opt,name=message"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ExternalDriverAssociationResult) Reset() {
+	*x = ExternalDriverAssociationResult{}
+	mi := &file_wayplatform_connect_mapon_v1_driver_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalDriverAssociationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalDriverAssociationResult) ProtoMessage() {}
+
+func (x *ExternalDriverAssociationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_driver_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExternalDriverAssociationResult) GetDriverId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DriverId
+	}
+	return 0
+}
+
+func (x *ExternalDriverAssociationResult) GetEmployeeId() string {
+	if x != nil {
+		if x.xxx_hidden_EmployeeId != nil {
+			return *x.xxx_hidden_EmployeeId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ExternalDriverAssociationResult) GetCompanyId() int64 {
+	if x != nil {
+		return x.xxx_hidden_CompanyId
+	}
+	return 0
+}
+
+func (x *ExternalDriverAssociationResult) GetSuccess() bool {
+	if x != nil {
+		return x.xxx_hidden_Success
+	}
+	return false
+}
+
+func (x *ExternalDriverAssociationResult) GetMessage() string {
+	if x != nil {
+		if x.xxx_hidden_Message != nil {
+			return *x.xxx_hidden_Message
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ExternalDriverAssociationResult) SetDriverId(v int64) {
+	x.xxx_hidden_DriverId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *ExternalDriverAssociationResult) SetEmployeeId(v string) {
+	x.xxx_hidden_EmployeeId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *ExternalDriverAssociationResult) SetCompanyId(v int64) {
+	x.xxx_hidden_CompanyId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *ExternalDriverAssociationResult) SetSuccess(v bool) {
+	x.xxx_hidden_Success = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *ExternalDriverAssociationResult) SetMessage(v string) {
+	x.xxx_hidden_Message = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *ExternalDriverAssociationResult) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ExternalDriverAssociationResult) HasEmployeeId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ExternalDriverAssociationResult) HasCompanyId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ExternalDriverAssociationResult) HasSuccess() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ExternalDriverAssociationResult) HasMessage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ExternalDriverAssociationResult) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = 0
+}
+
+func (x *ExternalDriverAssociationResult) ClearEmployeeId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EmployeeId = nil
+}
+
+func (x *ExternalDriverAssociationResult) ClearCompanyId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CompanyId = 0
+}
+
+func (x *ExternalDriverAssociationResult) ClearSuccess() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Success = false
+}
+
+func (x *ExternalDriverAssociationResult) ClearMessage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Message = nil
+}
+
+type ExternalDriverAssociationResult_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier of the Mapon driver.
+	DriverId *int64
+	// Identifier of the driver in the external system.
+	EmployeeId *string
+	// Identifier of the company of the driver.
+	CompanyId *int64
+	// Indicates if the association succeeded.
+	Success *bool
+	// Reason of the failure, for failed associations.
+	Message *string
+}
+
+func (b0 ExternalDriverAssociationResult_builder) Build() *ExternalDriverAssociationResult {
+	m0 := &ExternalDriverAssociationResult{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_DriverId = *b.DriverId
+	}
+	if b.EmployeeId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_EmployeeId = b.EmployeeId
+	}
+	if b.CompanyId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_CompanyId = *b.CompanyId
+	}
+	if b.Success != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Success = *b.Success
+	}
+	if b.Message != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Message = b.Message
+	}
 	return m0
 }
 
@@ -363,7 +856,7 @@ var File_wayplatform_connect_mapon_v1_driver_proto protoreflect.FileDescriptor
 
 const file_wayplatform_connect_mapon_v1_driver_proto_rawDesc = "" +
 	"\n" +
-	")wayplatform/connect/mapon/v1/driver.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9e\x02\n" +
+	")wayplatform/connect/mapon/v1/driver.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a/wayplatform/connect/mapon/v1/custom_field.proto\"\x8b\x04\n" +
 	"\x06Driver\x12\x1b\n" +
 	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
@@ -374,21 +867,50 @@ const file_wayplatform_connect_mapon_v1_driver_proto_rawDesc = "" +
 	"\rtachograph_id\x18\a \x01(\tR\ftachographId\x12\x18\n" +
 	"\ablocked\x18\b \x01(\bR\ablocked\x129\n" +
 	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x96\x02\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\blanguage\x18\n" +
+	" \x01(\tR\blanguage\x121\n" +
+	"\x15access_to_route_sheet\x18\v \x01(\bR\x12accessToRouteSheet\x12[\n" +
+	"\rcustom_fields\x18\f \x03(\v26.wayplatform.connect.mapon.v1.Driver.CustomFieldsEntryR\fcustomFields\x1a?\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"t\n" +
+	"\x12DriverCustomFields\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12A\n" +
+	"\x06fields\x18\x02 \x03(\v2).wayplatform.connect.mapon.v1.CustomFieldR\x06fields\"Y\n" +
+	"\x19ExternalDriverAssociation\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\"\xb2\x01\n" +
+	"\x1fExternalDriverAssociationResult\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x03 \x01(\x03R\tcompanyId\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessageB\x96\x02\n" +
 	" com.wayplatform.connect.mapon.v1B\vDriverProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
 
-var file_wayplatform_connect_mapon_v1_driver_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_wayplatform_connect_mapon_v1_driver_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_wayplatform_connect_mapon_v1_driver_proto_goTypes = []any{
-	(*Driver)(nil),                // 0: wayplatform.connect.mapon.v1.Driver
-	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	(*Driver)(nil),                          // 0: wayplatform.connect.mapon.v1.Driver
+	(*DriverCustomFields)(nil),              // 1: wayplatform.connect.mapon.v1.DriverCustomFields
+	(*ExternalDriverAssociation)(nil),       // 2: wayplatform.connect.mapon.v1.ExternalDriverAssociation
+	(*ExternalDriverAssociationResult)(nil), // 3: wayplatform.connect.mapon.v1.ExternalDriverAssociationResult
+	nil,                                     // 4: wayplatform.connect.mapon.v1.Driver.CustomFieldsEntry
+	(*timestamppb.Timestamp)(nil),           // 5: google.protobuf.Timestamp
+	(*CustomField)(nil),                     // 6: wayplatform.connect.mapon.v1.CustomField
 }
 var file_wayplatform_connect_mapon_v1_driver_proto_depIdxs = []int32{
-	1, // 0: wayplatform.connect.mapon.v1.Driver.created_at:type_name -> google.protobuf.Timestamp
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 0: wayplatform.connect.mapon.v1.Driver.created_at:type_name -> google.protobuf.Timestamp
+	4, // 1: wayplatform.connect.mapon.v1.Driver.custom_fields:type_name -> wayplatform.connect.mapon.v1.Driver.CustomFieldsEntry
+	6, // 2: wayplatform.connect.mapon.v1.DriverCustomFields.fields:type_name -> wayplatform.connect.mapon.v1.CustomField
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_wayplatform_connect_mapon_v1_driver_proto_init() }
@@ -396,13 +918,14 @@ func file_wayplatform_connect_mapon_v1_driver_proto_init() {
 	if File_wayplatform_connect_mapon_v1_driver_proto != nil {
 		return
 	}
+	file_wayplatform_connect_mapon_v1_custom_field_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_driver_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_driver_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
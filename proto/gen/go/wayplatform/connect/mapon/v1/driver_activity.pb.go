@@ -0,0 +1,730 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/driver_activity.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Activity status of a driver.
+type DriverActivity_Status int32
+
+const (
+	// Default value, used when the status is missing or not set.
+	DriverActivity_STATUS_UNSPECIFIED DriverActivity_Status = 0
+	// Used when the received value does not match any known enum member.
+	DriverActivity_STATUS_UNRECOGNIZED DriverActivity_Status = 1
+	// The driver is driving.
+	DriverActivity_STATUS_DRIVING DriverActivity_Status = 2
+	// The driver is resting.
+	DriverActivity_STATUS_REST DriverActivity_Status = 3
+	// The driver is performing other work.
+	DriverActivity_STATUS_WORK DriverActivity_Status = 4
+	// The driver is available.
+	DriverActivity_STATUS_AVAILABLE DriverActivity_Status = 5
+	// The driver card was inserted.
+	DriverActivity_STATUS_CARD_INSERTED DriverActivity_Status = 6
+	// The driver card was removed.
+	DriverActivity_STATUS_CARD_REMOVED DriverActivity_Status = 7
+	// The driver started a work period.
+	DriverActivity_STATUS_WORK_PERIOD_STARTED DriverActivity_Status = 8
+	// The driver finished a work period.
+	DriverActivity_STATUS_WORK_PERIOD_FINISHED DriverActivity_Status = 9
+)
+
+// Enum value maps for DriverActivity_Status.
+var (
+	DriverActivity_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_UNRECOGNIZED",
+		2: "STATUS_DRIVING",
+		3: "STATUS_REST",
+		4: "STATUS_WORK",
+		5: "STATUS_AVAILABLE",
+		6: "STATUS_CARD_INSERTED",
+		7: "STATUS_CARD_REMOVED",
+		8: "STATUS_WORK_PERIOD_STARTED",
+		9: "STATUS_WORK_PERIOD_FINISHED",
+	}
+	DriverActivity_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED":          0,
+		"STATUS_UNRECOGNIZED":         1,
+		"STATUS_DRIVING":              2,
+		"STATUS_REST":                 3,
+		"STATUS_WORK":                 4,
+		"STATUS_AVAILABLE":            5,
+		"STATUS_CARD_INSERTED":        6,
+		"STATUS_CARD_REMOVED":         7,
+		"STATUS_WORK_PERIOD_STARTED":  8,
+		"STATUS_WORK_PERIOD_FINISHED": 9,
+	}
+)
+
+func (x DriverActivity_Status) Enum() *DriverActivity_Status {
+	p := new(DriverActivity_Status)
+	*p = x
+	return p
+}
+
+func (x DriverActivity_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverActivity_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_driver_activity_proto_enumTypes[0].Descriptor()
+}
+
+func (DriverActivity_Status) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_driver_activity_proto_enumTypes[0]
+}
+
+func (x DriverActivity_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Source of an activity.
+type DriverActivity_Source int32
+
+const (
+	// Default value, used when the source is missing or not set.
+	DriverActivity_SOURCE_UNSPECIFIED DriverActivity_Source = 0
+	// Used when the received value does not match any known enum member.
+	DriverActivity_SOURCE_UNRECOGNIZED DriverActivity_Source = 1
+	// Driver tachograph file.
+	DriverActivity_SOURCE_DDD DriverActivity_Source = 2
+	// Vehicle CAN bus.
+	DriverActivity_SOURCE_CAN DriverActivity_Source = 3
+	// Unknown source. Gaps in the available data are filled with rest activities of unknown source.
+	DriverActivity_SOURCE_UNKNOWN DriverActivity_Source = 4
+)
+
+// Enum value maps for DriverActivity_Source.
+var (
+	DriverActivity_Source_name = map[int32]string{
+		0: "SOURCE_UNSPECIFIED",
+		1: "SOURCE_UNRECOGNIZED",
+		2: "SOURCE_DDD",
+		3: "SOURCE_CAN",
+		4: "SOURCE_UNKNOWN",
+	}
+	DriverActivity_Source_value = map[string]int32{
+		"SOURCE_UNSPECIFIED":  0,
+		"SOURCE_UNRECOGNIZED": 1,
+		"SOURCE_DDD":          2,
+		"SOURCE_CAN":          3,
+		"SOURCE_UNKNOWN":      4,
+	}
+)
+
+func (x DriverActivity_Source) Enum() *DriverActivity_Source {
+	p := new(DriverActivity_Source)
+	*p = x
+	return p
+}
+
+func (x DriverActivity_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverActivity_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_driver_activity_proto_enumTypes[1].Descriptor()
+}
+
+func (DriverActivity_Source) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_driver_activity_proto_enumTypes[1]
+}
+
+func (x DriverActivity_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// DriverActivity represents a single activity interval of a driver.
+type DriverActivity struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_StartTime          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime"`
+	xxx_hidden_EndTime            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime"`
+	xxx_hidden_DurationS          int64                  `protobuf:"varint,3,opt,name=duration_s,json=durationS"`
+	xxx_hidden_Status             DriverActivity_Status  `protobuf:"varint,4,opt,name=status,enum=wayplatform.connect.mapon.v1.DriverActivity_Status"`
+	xxx_hidden_UnrecognizedStatus *string                `protobuf:"bytes,5,opt,name=unrecognized_status,json=unrecognizedStatus"`
+	xxx_hidden_Source             DriverActivity_Source  `protobuf:"varint,6,opt,name=source,enum=wayplatform.connect.mapon.v1.DriverActivity_Source"`
+	xxx_hidden_UnrecognizedSource *string                `protobuf:"bytes,7,opt,name=unrecognized_source,json=unrecognizedSource"`
+	xxx_hidden_UnitId             int64                  `protobuf:"varint,8,opt,name=unit_id,json=unitId"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *DriverActivity) Reset() {
+	*x = DriverActivity{}
+	mi := &file_wayplatform_connect_mapon_v1_driver_activity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverActivity) ProtoMessage() {}
+
+func (x *DriverActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_driver_activity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverActivity) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_StartTime
+	}
+	return nil
+}
+
+func (x *DriverActivity) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_EndTime
+	}
+	return nil
+}
+
+func (x *DriverActivity) GetDurationS() int64 {
+	if x != nil {
+		return x.xxx_hidden_DurationS
+	}
+	return 0
+}
+
+func (x *DriverActivity) GetStatus() DriverActivity_Status {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 3) {
+			return x.xxx_hidden_Status
+		}
+	}
+	return DriverActivity_STATUS_UNSPECIFIED
+}
+
+func (x *DriverActivity) GetUnrecognizedStatus() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedStatus != nil {
+			return *x.xxx_hidden_UnrecognizedStatus
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverActivity) GetSource() DriverActivity_Source {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 5) {
+			return x.xxx_hidden_Source
+		}
+	}
+	return DriverActivity_SOURCE_UNSPECIFIED
+}
+
+func (x *DriverActivity) GetUnrecognizedSource() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedSource != nil {
+			return *x.xxx_hidden_UnrecognizedSource
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverActivity) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *DriverActivity) SetStartTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_StartTime = v
+}
+
+func (x *DriverActivity) SetEndTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_EndTime = v
+}
+
+func (x *DriverActivity) SetDurationS(v int64) {
+	x.xxx_hidden_DurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *DriverActivity) SetStatus(v DriverActivity_Status) {
+	x.xxx_hidden_Status = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *DriverActivity) SetUnrecognizedStatus(v string) {
+	x.xxx_hidden_UnrecognizedStatus = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+}
+
+func (x *DriverActivity) SetSource(v DriverActivity_Source) {
+	x.xxx_hidden_Source = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *DriverActivity) SetUnrecognizedSource(v string) {
+	x.xxx_hidden_UnrecognizedSource = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *DriverActivity) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *DriverActivity) HasStartTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StartTime != nil
+}
+
+func (x *DriverActivity) HasEndTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_EndTime != nil
+}
+
+func (x *DriverActivity) HasDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DriverActivity) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DriverActivity) HasUnrecognizedStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DriverActivity) HasSource() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *DriverActivity) HasUnrecognizedSource() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *DriverActivity) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *DriverActivity) ClearStartTime() {
+	x.xxx_hidden_StartTime = nil
+}
+
+func (x *DriverActivity) ClearEndTime() {
+	x.xxx_hidden_EndTime = nil
+}
+
+func (x *DriverActivity) ClearDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_DurationS = 0
+}
+
+func (x *DriverActivity) ClearStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Status = DriverActivity_STATUS_UNSPECIFIED
+}
+
+func (x *DriverActivity) ClearUnrecognizedStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_UnrecognizedStatus = nil
+}
+
+func (x *DriverActivity) ClearSource() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Source = DriverActivity_SOURCE_UNSPECIFIED
+}
+
+func (x *DriverActivity) ClearUnrecognizedSource() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_UnrecognizedSource = nil
+}
+
+func (x *DriverActivity) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_UnitId = 0
+}
+
+type DriverActivity_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Start time of the activity.
+	StartTime *timestamppb.Timestamp
+	// End time of the activity.
+	EndTime *timestamppb.Timestamp
+	// Duration of the activity in seconds.
+	DurationS *int64
+	// Status of the activity.
+	Status *DriverActivity_Status
+	// The raw string value of the status if it is not one of the known enum values.
+	UnrecognizedStatus *string
+	// Source of the activity.
+	Source *DriverActivity_Source
+	// The raw string value of the source if it is not one of the known enum values.
+	UnrecognizedSource *string
+	// Identifier of the unit the driver was assigned to during the activity.
+	UnitId *int64
+}
+
+func (b0 DriverActivity_builder) Build() *DriverActivity {
+	m0 := &DriverActivity{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_StartTime = b.StartTime
+	x.xxx_hidden_EndTime = b.EndTime
+	if b.DurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_DurationS = *b.DurationS
+	}
+	if b.Status != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_Status = *b.Status
+	}
+	if b.UnrecognizedStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		x.xxx_hidden_UnrecognizedStatus = b.UnrecognizedStatus
+	}
+	if b.Source != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_Source = *b.Source
+	}
+	if b.UnrecognizedSource != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_UnrecognizedSource = b.UnrecognizedSource
+	}
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	return m0
+}
+
+// DriverDailyActivities represents the activities of a driver during a single day.
+type DriverDailyActivities struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Date        *string                `protobuf:"bytes,1,opt,name=date"`
+	xxx_hidden_ShiftS      int64                  `protobuf:"varint,2,opt,name=shift_s,json=shiftS"`
+	xxx_hidden_DrivingS    int64                  `protobuf:"varint,3,opt,name=driving_s,json=drivingS"`
+	xxx_hidden_RestS       int64                  `protobuf:"varint,4,opt,name=rest_s,json=restS"`
+	xxx_hidden_Activities  *[]*DriverActivity     `protobuf:"bytes,5,rep,name=activities"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DriverDailyActivities) Reset() {
+	*x = DriverDailyActivities{}
+	mi := &file_wayplatform_connect_mapon_v1_driver_activity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverDailyActivities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverDailyActivities) ProtoMessage() {}
+
+func (x *DriverDailyActivities) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_driver_activity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverDailyActivities) GetDate() string {
+	if x != nil {
+		if x.xxx_hidden_Date != nil {
+			return *x.xxx_hidden_Date
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverDailyActivities) GetShiftS() int64 {
+	if x != nil {
+		return x.xxx_hidden_ShiftS
+	}
+	return 0
+}
+
+func (x *DriverDailyActivities) GetDrivingS() int64 {
+	if x != nil {
+		return x.xxx_hidden_DrivingS
+	}
+	return 0
+}
+
+func (x *DriverDailyActivities) GetRestS() int64 {
+	if x != nil {
+		return x.xxx_hidden_RestS
+	}
+	return 0
+}
+
+func (x *DriverDailyActivities) GetActivities() []*DriverActivity {
+	if x != nil {
+		if x.xxx_hidden_Activities != nil {
+			return *x.xxx_hidden_Activities
+		}
+	}
+	return nil
+}
+
+func (x *DriverDailyActivities) SetDate(v string) {
+	x.xxx_hidden_Date = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *DriverDailyActivities) SetShiftS(v int64) {
+	x.xxx_hidden_ShiftS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *DriverDailyActivities) SetDrivingS(v int64) {
+	x.xxx_hidden_DrivingS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *DriverDailyActivities) SetRestS(v int64) {
+	x.xxx_hidden_RestS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *DriverDailyActivities) SetActivities(v []*DriverActivity) {
+	x.xxx_hidden_Activities = &v
+}
+
+func (x *DriverDailyActivities) HasDate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverDailyActivities) HasShiftS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverDailyActivities) HasDrivingS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DriverDailyActivities) HasRestS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DriverDailyActivities) ClearDate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Date = nil
+}
+
+func (x *DriverDailyActivities) ClearShiftS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ShiftS = 0
+}
+
+func (x *DriverDailyActivities) ClearDrivingS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_DrivingS = 0
+}
+
+func (x *DriverDailyActivities) ClearRestS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RestS = 0
+}
+
+type DriverDailyActivities_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Day in the company timezone (YYYY-MM-DD).
+	Date *string
+	// Total shift duration in seconds.
+	ShiftS *int64
+	// Total driving duration in seconds.
+	DrivingS *int64
+	// Total rest duration in seconds.
+	RestS *int64
+	// Activities of the day in chronological order.
+	Activities []*DriverActivity
+}
+
+func (b0 DriverDailyActivities_builder) Build() *DriverDailyActivities {
+	m0 := &DriverDailyActivities{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Date != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Date = b.Date
+	}
+	if b.ShiftS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_ShiftS = *b.ShiftS
+	}
+	if b.DrivingS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_DrivingS = *b.DrivingS
+	}
+	if b.RestS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_RestS = *b.RestS
+	}
+	x.xxx_hidden_Activities = &b.Activities
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_driver_activity_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_driver_activity_proto_rawDesc = "" +
+	"\n" +
+	"2wayplatform/connect/mapon/v1/driver_activity.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa1\x06\n" +
+	"\x0eDriverActivity\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1d\n" +
+	"\n" +
+	"duration_s\x18\x03 \x01(\x03R\tdurationS\x12K\n" +
+	"\x06status\x18\x04 \x01(\x0e23.wayplatform.connect.mapon.v1.DriverActivity.StatusR\x06status\x12/\n" +
+	"\x13unrecognized_status\x18\x05 \x01(\tR\x12unrecognizedStatus\x12K\n" +
+	"\x06source\x18\x06 \x01(\x0e23.wayplatform.connect.mapon.v1.DriverActivity.SourceR\x06source\x12/\n" +
+	"\x13unrecognized_source\x18\a \x01(\tR\x12unrecognizedSource\x12\x17\n" +
+	"\aunit_id\x18\b \x01(\x03R\x06unitId\"\xf9\x01\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13STATUS_UNRECOGNIZED\x10\x01\x12\x12\n" +
+	"\x0eSTATUS_DRIVING\x10\x02\x12\x0f\n" +
+	"\vSTATUS_REST\x10\x03\x12\x0f\n" +
+	"\vSTATUS_WORK\x10\x04\x12\x14\n" +
+	"\x10STATUS_AVAILABLE\x10\x05\x12\x18\n" +
+	"\x14STATUS_CARD_INSERTED\x10\x06\x12\x17\n" +
+	"\x13STATUS_CARD_REMOVED\x10\a\x12\x1e\n" +
+	"\x1aSTATUS_WORK_PERIOD_STARTED\x10\b\x12\x1f\n" +
+	"\x1bSTATUS_WORK_PERIOD_FINISHED\x10\t\"m\n" +
+	"\x06Source\x12\x16\n" +
+	"\x12SOURCE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SOURCE_UNRECOGNIZED\x10\x01\x12\x0e\n" +
+	"\n" +
+	"SOURCE_DDD\x10\x02\x12\x0e\n" +
+	"\n" +
+	"SOURCE_CAN\x10\x03\x12\x12\n" +
+	"\x0eSOURCE_UNKNOWN\x10\x04\"\xc6\x01\n" +
+	"\x15DriverDailyActivities\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x17\n" +
+	"\ashift_s\x18\x02 \x01(\x03R\x06shiftS\x12\x1b\n" +
+	"\tdriving_s\x18\x03 \x01(\x03R\bdrivingS\x12\x15\n" +
+	"\x06rest_s\x18\x04 \x01(\x03R\x05restS\x12L\n" +
+	"\n" +
+	"activities\x18\x05 \x03(\v2,.wayplatform.connect.mapon.v1.DriverActivityR\n" +
+	"activitiesB\x9e\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x13DriverActivityProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_driver_activity_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_wayplatform_connect_mapon_v1_driver_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_mapon_v1_driver_activity_proto_goTypes = []any{
+	(DriverActivity_Status)(0),    // 0: wayplatform.connect.mapon.v1.DriverActivity.Status
+	(DriverActivity_Source)(0),    // 1: wayplatform.connect.mapon.v1.DriverActivity.Source
+	(*DriverActivity)(nil),        // 2: wayplatform.connect.mapon.v1.DriverActivity
+	(*DriverDailyActivities)(nil), // 3: wayplatform.connect.mapon.v1.DriverDailyActivities
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_mapon_v1_driver_activity_proto_depIdxs = []int32{
+	4, // 0: wayplatform.connect.mapon.v1.DriverActivity.start_time:type_name -> google.protobuf.Timestamp
+	4, // 1: wayplatform.connect.mapon.v1.DriverActivity.end_time:type_name -> google.protobuf.Timestamp
+	0, // 2: wayplatform.connect.mapon.v1.DriverActivity.status:type_name -> wayplatform.connect.mapon.v1.DriverActivity.Status
+	1, // 3: wayplatform.connect.mapon.v1.DriverActivity.source:type_name -> wayplatform.connect.mapon.v1.DriverActivity.Source
+	2, // 4: wayplatform.connect.mapon.v1.DriverDailyActivities.activities:type_name -> wayplatform.connect.mapon.v1.DriverActivity
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_driver_activity_proto_init() }
+func file_wayplatform_connect_mapon_v1_driver_activity_proto_init() {
+	if File_wayplatform_connect_mapon_v1_driver_activity_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_driver_activity_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_driver_activity_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_driver_activity_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_driver_activity_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_driver_activity_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_driver_activity_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_driver_activity_proto = out.File
+	file_wayplatform_connect_mapon_v1_driver_activity_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_driver_activity_proto_depIdxs = nil
+}
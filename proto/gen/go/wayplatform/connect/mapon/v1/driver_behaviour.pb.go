@@ -0,0 +1,1506 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/driver_behaviour.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DriverBehaviourMetric is a metric taken into account when calculating driver behaviour results.
+type DriverBehaviourMetric int32
+
+const (
+	// Default value, used when the metric is missing or not set.
+	DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_UNSPECIFIED DriverBehaviourMetric = 0
+	// Used when the received value does not match any known enum member.
+	DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_UNRECOGNIZED DriverBehaviourMetric = 1
+	// Driving in the economical speed range.
+	DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_EFFECTIVE_SPEED DriverBehaviourMetric = 2
+	// Idling with the engine running for too long.
+	DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_EXCESSIVE_IDLING DriverBehaviourMetric = 3
+	// Driving with cruise control.
+	DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_CRUISE_CONTROL DriverBehaviourMetric = 4
+	// Rolling without pressing the accelerator.
+	DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_COASTING DriverBehaviourMetric = 5
+	// Harsh braking events.
+	DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_HARSH_BRAKING DriverBehaviourMetric = 6
+	// Harsh acceleration events.
+	DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_HARSH_ACCELERATION DriverBehaviourMetric = 7
+	// Harsh cornering events.
+	DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_HARSH_CORNERING DriverBehaviourMetric = 8
+	// Driving in the economical engine RPM range.
+	DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_GREEN_RPM DriverBehaviourMetric = 9
+	// Driving over the speed limit.
+	DriverBehaviourMetric_DRIVER_BEHAVIOUR_METRIC_SPEEDING DriverBehaviourMetric = 10
+)
+
+// Enum value maps for DriverBehaviourMetric.
+var (
+	DriverBehaviourMetric_name = map[int32]string{
+		0:  "DRIVER_BEHAVIOUR_METRIC_UNSPECIFIED",
+		1:  "DRIVER_BEHAVIOUR_METRIC_UNRECOGNIZED",
+		2:  "DRIVER_BEHAVIOUR_METRIC_EFFECTIVE_SPEED",
+		3:  "DRIVER_BEHAVIOUR_METRIC_EXCESSIVE_IDLING",
+		4:  "DRIVER_BEHAVIOUR_METRIC_CRUISE_CONTROL",
+		5:  "DRIVER_BEHAVIOUR_METRIC_COASTING",
+		6:  "DRIVER_BEHAVIOUR_METRIC_HARSH_BRAKING",
+		7:  "DRIVER_BEHAVIOUR_METRIC_HARSH_ACCELERATION",
+		8:  "DRIVER_BEHAVIOUR_METRIC_HARSH_CORNERING",
+		9:  "DRIVER_BEHAVIOUR_METRIC_GREEN_RPM",
+		10: "DRIVER_BEHAVIOUR_METRIC_SPEEDING",
+	}
+	DriverBehaviourMetric_value = map[string]int32{
+		"DRIVER_BEHAVIOUR_METRIC_UNSPECIFIED":        0,
+		"DRIVER_BEHAVIOUR_METRIC_UNRECOGNIZED":       1,
+		"DRIVER_BEHAVIOUR_METRIC_EFFECTIVE_SPEED":    2,
+		"DRIVER_BEHAVIOUR_METRIC_EXCESSIVE_IDLING":   3,
+		"DRIVER_BEHAVIOUR_METRIC_CRUISE_CONTROL":     4,
+		"DRIVER_BEHAVIOUR_METRIC_COASTING":           5,
+		"DRIVER_BEHAVIOUR_METRIC_HARSH_BRAKING":      6,
+		"DRIVER_BEHAVIOUR_METRIC_HARSH_ACCELERATION": 7,
+		"DRIVER_BEHAVIOUR_METRIC_HARSH_CORNERING":    8,
+		"DRIVER_BEHAVIOUR_METRIC_GREEN_RPM":          9,
+		"DRIVER_BEHAVIOUR_METRIC_SPEEDING":           10,
+	}
+)
+
+func (x DriverBehaviourMetric) Enum() *DriverBehaviourMetric {
+	p := new(DriverBehaviourMetric)
+	*p = x
+	return p
+}
+
+func (x DriverBehaviourMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverBehaviourMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_driver_behaviour_proto_enumTypes[0].Descriptor()
+}
+
+func (DriverBehaviourMetric) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_driver_behaviour_proto_enumTypes[0]
+}
+
+func (x DriverBehaviourMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// DriverBehaviourScore represents the result of a driver behaviour metric.
+// Distance, duration and count are only set for the metrics that measure them.
+type DriverBehaviourScore struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Grade       *string                `protobuf:"bytes,1,opt,name=grade"`
+	xxx_hidden_Score       float64                `protobuf:"fixed64,2,opt,name=score"`
+	xxx_hidden_DistanceKm  float64                `protobuf:"fixed64,3,opt,name=distance_km,json=distanceKm"`
+	xxx_hidden_DurationS   int64                  `protobuf:"varint,4,opt,name=duration_s,json=durationS"`
+	xxx_hidden_Count       int64                  `protobuf:"varint,5,opt,name=count"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DriverBehaviourScore) Reset() {
+	*x = DriverBehaviourScore{}
+	mi := &file_wayplatform_connect_mapon_v1_driver_behaviour_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverBehaviourScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverBehaviourScore) ProtoMessage() {}
+
+func (x *DriverBehaviourScore) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_driver_behaviour_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverBehaviourScore) GetGrade() string {
+	if x != nil {
+		if x.xxx_hidden_Grade != nil {
+			return *x.xxx_hidden_Grade
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverBehaviourScore) GetScore() float64 {
+	if x != nil {
+		return x.xxx_hidden_Score
+	}
+	return 0
+}
+
+func (x *DriverBehaviourScore) GetDistanceKm() float64 {
+	if x != nil {
+		return x.xxx_hidden_DistanceKm
+	}
+	return 0
+}
+
+func (x *DriverBehaviourScore) GetDurationS() int64 {
+	if x != nil {
+		return x.xxx_hidden_DurationS
+	}
+	return 0
+}
+
+func (x *DriverBehaviourScore) GetCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_Count
+	}
+	return 0
+}
+
+func (x *DriverBehaviourScore) SetGrade(v string) {
+	x.xxx_hidden_Grade = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *DriverBehaviourScore) SetScore(v float64) {
+	x.xxx_hidden_Score = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *DriverBehaviourScore) SetDistanceKm(v float64) {
+	x.xxx_hidden_DistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *DriverBehaviourScore) SetDurationS(v int64) {
+	x.xxx_hidden_DurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *DriverBehaviourScore) SetCount(v int64) {
+	x.xxx_hidden_Count = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *DriverBehaviourScore) HasGrade() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverBehaviourScore) HasScore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverBehaviourScore) HasDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DriverBehaviourScore) HasDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DriverBehaviourScore) HasCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DriverBehaviourScore) ClearGrade() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Grade = nil
+}
+
+func (x *DriverBehaviourScore) ClearScore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Score = 0
+}
+
+func (x *DriverBehaviourScore) ClearDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_DistanceKm = 0
+}
+
+func (x *DriverBehaviourScore) ClearDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_DurationS = 0
+}
+
+func (x *DriverBehaviourScore) ClearCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Count = 0
+}
+
+type DriverBehaviourScore_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Grade of the result, from A (best) to G (worst).
+	Grade *string
+	// Score of the result. Depending on the metric, either a rating or a share of the driving in percent.
+	Score *float64
+	// Distance driven within the metric in kilometers.
+	DistanceKm *float64
+	// Duration within the metric in seconds.
+	DurationS *int64
+	// Number of events, e.g. harsh braking events.
+	Count *int64
+}
+
+func (b0 DriverBehaviourScore_builder) Build() *DriverBehaviourScore {
+	m0 := &DriverBehaviourScore{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Grade != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Grade = b.Grade
+	}
+	if b.Score != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Score = *b.Score
+	}
+	if b.DistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_DistanceKm = *b.DistanceKm
+	}
+	if b.DurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_DurationS = *b.DurationS
+	}
+	if b.Count != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Count = *b.Count
+	}
+	return m0
+}
+
+// DriverBehaviourResult represents the driver behaviour results and driving statistics for a period.
+// Fields are not set when no data is available.
+type DriverBehaviourResult struct {
+	state                                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Overall                           *DriverBehaviourScore  `protobuf:"bytes,1,opt,name=overall"`
+	xxx_hidden_EffectiveSpeed                    *DriverBehaviourScore  `protobuf:"bytes,2,opt,name=effective_speed,json=effectiveSpeed"`
+	xxx_hidden_ExcessiveIdling                   *DriverBehaviourScore  `protobuf:"bytes,3,opt,name=excessive_idling,json=excessiveIdling"`
+	xxx_hidden_CruiseControl                     *DriverBehaviourScore  `protobuf:"bytes,4,opt,name=cruise_control,json=cruiseControl"`
+	xxx_hidden_Coasting                          *DriverBehaviourScore  `protobuf:"bytes,5,opt,name=coasting"`
+	xxx_hidden_HarshBraking                      *DriverBehaviourScore  `protobuf:"bytes,6,opt,name=harsh_braking,json=harshBraking"`
+	xxx_hidden_HarshAcceleration                 *DriverBehaviourScore  `protobuf:"bytes,7,opt,name=harsh_acceleration,json=harshAcceleration"`
+	xxx_hidden_HarshCornering                    *DriverBehaviourScore  `protobuf:"bytes,8,opt,name=harsh_cornering,json=harshCornering"`
+	xxx_hidden_GreenRpm                          *DriverBehaviourScore  `protobuf:"bytes,9,opt,name=green_rpm,json=greenRpm"`
+	xxx_hidden_Speeding                          *DriverBehaviourScore  `protobuf:"bytes,10,opt,name=speeding"`
+	xxx_hidden_DistanceGpsKm                     float64                `protobuf:"fixed64,11,opt,name=distance_gps_km,json=distanceGpsKm"`
+	xxx_hidden_DistanceCanKm                     float64                `protobuf:"fixed64,12,opt,name=distance_can_km,json=distanceCanKm"`
+	xxx_hidden_DrivingDurationS                  int64                  `protobuf:"varint,13,opt,name=driving_duration_s,json=drivingDurationS"`
+	xxx_hidden_ExcessiveIdlingPtoDurationS       int64                  `protobuf:"varint,14,opt,name=excessive_idling_pto_duration_s,json=excessiveIdlingPtoDurationS"`
+	xxx_hidden_FuelAvgConsumption                float64                `protobuf:"fixed64,15,opt,name=fuel_avg_consumption,json=fuelAvgConsumption"`
+	xxx_hidden_FuelAvgConsumptionUnit            *string                `protobuf:"bytes,16,opt,name=fuel_avg_consumption_unit,json=fuelAvgConsumptionUnit"`
+	xxx_hidden_FuelAvgConsumptionByNorm          float64                `protobuf:"fixed64,17,opt,name=fuel_avg_consumption_by_norm,json=fuelAvgConsumptionByNorm"`
+	xxx_hidden_FuelAvgConsumptionRatio           float64                `protobuf:"fixed64,18,opt,name=fuel_avg_consumption_ratio,json=fuelAvgConsumptionRatio"`
+	xxx_hidden_FuelConsumption                   float64                `protobuf:"fixed64,19,opt,name=fuel_consumption,json=fuelConsumption"`
+	xxx_hidden_FuelConsumptionCan                float64                `protobuf:"fixed64,20,opt,name=fuel_consumption_can,json=fuelConsumptionCan"`
+	xxx_hidden_ExcessiveIdlingFuelConsumptionCan float64                `protobuf:"fixed64,21,opt,name=excessive_idling_fuel_consumption_can,json=excessiveIdlingFuelConsumptionCan"`
+	xxx_hidden_PtoIdleFuelConsumptionCan         float64                `protobuf:"fixed64,22,opt,name=pto_idle_fuel_consumption_can,json=ptoIdleFuelConsumptionCan"`
+	xxx_hidden_StopCount                         int64                  `protobuf:"varint,23,opt,name=stop_count,json=stopCount"`
+	xxx_hidden_StopDurationS                     int64                  `protobuf:"varint,24,opt,name=stop_duration_s,json=stopDurationS"`
+	xxx_hidden_AvgSpeedKmh                       float64                `protobuf:"fixed64,25,opt,name=avg_speed_kmh,json=avgSpeedKmh"`
+	XXX_raceDetectHookData                       protoimpl.RaceDetectHookData
+	XXX_presence                                 [1]uint32
+	unknownFields                                protoimpl.UnknownFields
+	sizeCache                                    protoimpl.SizeCache
+}
+
+func (x *DriverBehaviourResult) Reset() {
+	*x = DriverBehaviourResult{}
+	mi := &file_wayplatform_connect_mapon_v1_driver_behaviour_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverBehaviourResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverBehaviourResult) ProtoMessage() {}
+
+func (x *DriverBehaviourResult) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_driver_behaviour_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverBehaviourResult) GetOverall() *DriverBehaviourScore {
+	if x != nil {
+		return x.xxx_hidden_Overall
+	}
+	return nil
+}
+
+func (x *DriverBehaviourResult) GetEffectiveSpeed() *DriverBehaviourScore {
+	if x != nil {
+		return x.xxx_hidden_EffectiveSpeed
+	}
+	return nil
+}
+
+func (x *DriverBehaviourResult) GetExcessiveIdling() *DriverBehaviourScore {
+	if x != nil {
+		return x.xxx_hidden_ExcessiveIdling
+	}
+	return nil
+}
+
+func (x *DriverBehaviourResult) GetCruiseControl() *DriverBehaviourScore {
+	if x != nil {
+		return x.xxx_hidden_CruiseControl
+	}
+	return nil
+}
+
+func (x *DriverBehaviourResult) GetCoasting() *DriverBehaviourScore {
+	if x != nil {
+		return x.xxx_hidden_Coasting
+	}
+	return nil
+}
+
+func (x *DriverBehaviourResult) GetHarshBraking() *DriverBehaviourScore {
+	if x != nil {
+		return x.xxx_hidden_HarshBraking
+	}
+	return nil
+}
+
+func (x *DriverBehaviourResult) GetHarshAcceleration() *DriverBehaviourScore {
+	if x != nil {
+		return x.xxx_hidden_HarshAcceleration
+	}
+	return nil
+}
+
+func (x *DriverBehaviourResult) GetHarshCornering() *DriverBehaviourScore {
+	if x != nil {
+		return x.xxx_hidden_HarshCornering
+	}
+	return nil
+}
+
+func (x *DriverBehaviourResult) GetGreenRpm() *DriverBehaviourScore {
+	if x != nil {
+		return x.xxx_hidden_GreenRpm
+	}
+	return nil
+}
+
+func (x *DriverBehaviourResult) GetSpeeding() *DriverBehaviourScore {
+	if x != nil {
+		return x.xxx_hidden_Speeding
+	}
+	return nil
+}
+
+func (x *DriverBehaviourResult) GetDistanceGpsKm() float64 {
+	if x != nil {
+		return x.xxx_hidden_DistanceGpsKm
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetDistanceCanKm() float64 {
+	if x != nil {
+		return x.xxx_hidden_DistanceCanKm
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetDrivingDurationS() int64 {
+	if x != nil {
+		return x.xxx_hidden_DrivingDurationS
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetExcessiveIdlingPtoDurationS() int64 {
+	if x != nil {
+		return x.xxx_hidden_ExcessiveIdlingPtoDurationS
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetFuelAvgConsumption() float64 {
+	if x != nil {
+		return x.xxx_hidden_FuelAvgConsumption
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetFuelAvgConsumptionUnit() string {
+	if x != nil {
+		if x.xxx_hidden_FuelAvgConsumptionUnit != nil {
+			return *x.xxx_hidden_FuelAvgConsumptionUnit
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverBehaviourResult) GetFuelAvgConsumptionByNorm() float64 {
+	if x != nil {
+		return x.xxx_hidden_FuelAvgConsumptionByNorm
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetFuelAvgConsumptionRatio() float64 {
+	if x != nil {
+		return x.xxx_hidden_FuelAvgConsumptionRatio
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetFuelConsumption() float64 {
+	if x != nil {
+		return x.xxx_hidden_FuelConsumption
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetFuelConsumptionCan() float64 {
+	if x != nil {
+		return x.xxx_hidden_FuelConsumptionCan
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetExcessiveIdlingFuelConsumptionCan() float64 {
+	if x != nil {
+		return x.xxx_hidden_ExcessiveIdlingFuelConsumptionCan
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetPtoIdleFuelConsumptionCan() float64 {
+	if x != nil {
+		return x.xxx_hidden_PtoIdleFuelConsumptionCan
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetStopCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_StopCount
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetStopDurationS() int64 {
+	if x != nil {
+		return x.xxx_hidden_StopDurationS
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) GetAvgSpeedKmh() float64 {
+	if x != nil {
+		return x.xxx_hidden_AvgSpeedKmh
+	}
+	return 0
+}
+
+func (x *DriverBehaviourResult) SetOverall(v *DriverBehaviourScore) {
+	x.xxx_hidden_Overall = v
+}
+
+func (x *DriverBehaviourResult) SetEffectiveSpeed(v *DriverBehaviourScore) {
+	x.xxx_hidden_EffectiveSpeed = v
+}
+
+func (x *DriverBehaviourResult) SetExcessiveIdling(v *DriverBehaviourScore) {
+	x.xxx_hidden_ExcessiveIdling = v
+}
+
+func (x *DriverBehaviourResult) SetCruiseControl(v *DriverBehaviourScore) {
+	x.xxx_hidden_CruiseControl = v
+}
+
+func (x *DriverBehaviourResult) SetCoasting(v *DriverBehaviourScore) {
+	x.xxx_hidden_Coasting = v
+}
+
+func (x *DriverBehaviourResult) SetHarshBraking(v *DriverBehaviourScore) {
+	x.xxx_hidden_HarshBraking = v
+}
+
+func (x *DriverBehaviourResult) SetHarshAcceleration(v *DriverBehaviourScore) {
+	x.xxx_hidden_HarshAcceleration = v
+}
+
+func (x *DriverBehaviourResult) SetHarshCornering(v *DriverBehaviourScore) {
+	x.xxx_hidden_HarshCornering = v
+}
+
+func (x *DriverBehaviourResult) SetGreenRpm(v *DriverBehaviourScore) {
+	x.xxx_hidden_GreenRpm = v
+}
+
+func (x *DriverBehaviourResult) SetSpeeding(v *DriverBehaviourScore) {
+	x.xxx_hidden_Speeding = v
+}
+
+func (x *DriverBehaviourResult) SetDistanceGpsKm(v float64) {
+	x.xxx_hidden_DistanceGpsKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 25)
+}
+
+func (x *DriverBehaviourResult) SetDistanceCanKm(v float64) {
+	x.xxx_hidden_DistanceCanKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 25)
+}
+
+func (x *DriverBehaviourResult) SetDrivingDurationS(v int64) {
+	x.xxx_hidden_DrivingDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 25)
+}
+
+func (x *DriverBehaviourResult) SetExcessiveIdlingPtoDurationS(v int64) {
+	x.xxx_hidden_ExcessiveIdlingPtoDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 25)
+}
+
+func (x *DriverBehaviourResult) SetFuelAvgConsumption(v float64) {
+	x.xxx_hidden_FuelAvgConsumption = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 25)
+}
+
+func (x *DriverBehaviourResult) SetFuelAvgConsumptionUnit(v string) {
+	x.xxx_hidden_FuelAvgConsumptionUnit = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 25)
+}
+
+func (x *DriverBehaviourResult) SetFuelAvgConsumptionByNorm(v float64) {
+	x.xxx_hidden_FuelAvgConsumptionByNorm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 25)
+}
+
+func (x *DriverBehaviourResult) SetFuelAvgConsumptionRatio(v float64) {
+	x.xxx_hidden_FuelAvgConsumptionRatio = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 17, 25)
+}
+
+func (x *DriverBehaviourResult) SetFuelConsumption(v float64) {
+	x.xxx_hidden_FuelConsumption = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 18, 25)
+}
+
+func (x *DriverBehaviourResult) SetFuelConsumptionCan(v float64) {
+	x.xxx_hidden_FuelConsumptionCan = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 19, 25)
+}
+
+func (x *DriverBehaviourResult) SetExcessiveIdlingFuelConsumptionCan(v float64) {
+	x.xxx_hidden_ExcessiveIdlingFuelConsumptionCan = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 20, 25)
+}
+
+func (x *DriverBehaviourResult) SetPtoIdleFuelConsumptionCan(v float64) {
+	x.xxx_hidden_PtoIdleFuelConsumptionCan = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 21, 25)
+}
+
+func (x *DriverBehaviourResult) SetStopCount(v int64) {
+	x.xxx_hidden_StopCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 22, 25)
+}
+
+func (x *DriverBehaviourResult) SetStopDurationS(v int64) {
+	x.xxx_hidden_StopDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 23, 25)
+}
+
+func (x *DriverBehaviourResult) SetAvgSpeedKmh(v float64) {
+	x.xxx_hidden_AvgSpeedKmh = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 24, 25)
+}
+
+func (x *DriverBehaviourResult) HasOverall() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Overall != nil
+}
+
+func (x *DriverBehaviourResult) HasEffectiveSpeed() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_EffectiveSpeed != nil
+}
+
+func (x *DriverBehaviourResult) HasExcessiveIdling() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExcessiveIdling != nil
+}
+
+func (x *DriverBehaviourResult) HasCruiseControl() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CruiseControl != nil
+}
+
+func (x *DriverBehaviourResult) HasCoasting() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Coasting != nil
+}
+
+func (x *DriverBehaviourResult) HasHarshBraking() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_HarshBraking != nil
+}
+
+func (x *DriverBehaviourResult) HasHarshAcceleration() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_HarshAcceleration != nil
+}
+
+func (x *DriverBehaviourResult) HasHarshCornering() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_HarshCornering != nil
+}
+
+func (x *DriverBehaviourResult) HasGreenRpm() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_GreenRpm != nil
+}
+
+func (x *DriverBehaviourResult) HasSpeeding() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Speeding != nil
+}
+
+func (x *DriverBehaviourResult) HasDistanceGpsKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *DriverBehaviourResult) HasDistanceCanKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *DriverBehaviourResult) HasDrivingDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *DriverBehaviourResult) HasExcessiveIdlingPtoDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *DriverBehaviourResult) HasFuelAvgConsumption() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *DriverBehaviourResult) HasFuelAvgConsumptionUnit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *DriverBehaviourResult) HasFuelAvgConsumptionByNorm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 16)
+}
+
+func (x *DriverBehaviourResult) HasFuelAvgConsumptionRatio() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 17)
+}
+
+func (x *DriverBehaviourResult) HasFuelConsumption() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 18)
+}
+
+func (x *DriverBehaviourResult) HasFuelConsumptionCan() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 19)
+}
+
+func (x *DriverBehaviourResult) HasExcessiveIdlingFuelConsumptionCan() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 20)
+}
+
+func (x *DriverBehaviourResult) HasPtoIdleFuelConsumptionCan() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 21)
+}
+
+func (x *DriverBehaviourResult) HasStopCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 22)
+}
+
+func (x *DriverBehaviourResult) HasStopDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 23)
+}
+
+func (x *DriverBehaviourResult) HasAvgSpeedKmh() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 24)
+}
+
+func (x *DriverBehaviourResult) ClearOverall() {
+	x.xxx_hidden_Overall = nil
+}
+
+func (x *DriverBehaviourResult) ClearEffectiveSpeed() {
+	x.xxx_hidden_EffectiveSpeed = nil
+}
+
+func (x *DriverBehaviourResult) ClearExcessiveIdling() {
+	x.xxx_hidden_ExcessiveIdling = nil
+}
+
+func (x *DriverBehaviourResult) ClearCruiseControl() {
+	x.xxx_hidden_CruiseControl = nil
+}
+
+func (x *DriverBehaviourResult) ClearCoasting() {
+	x.xxx_hidden_Coasting = nil
+}
+
+func (x *DriverBehaviourResult) ClearHarshBraking() {
+	x.xxx_hidden_HarshBraking = nil
+}
+
+func (x *DriverBehaviourResult) ClearHarshAcceleration() {
+	x.xxx_hidden_HarshAcceleration = nil
+}
+
+func (x *DriverBehaviourResult) ClearHarshCornering() {
+	x.xxx_hidden_HarshCornering = nil
+}
+
+func (x *DriverBehaviourResult) ClearGreenRpm() {
+	x.xxx_hidden_GreenRpm = nil
+}
+
+func (x *DriverBehaviourResult) ClearSpeeding() {
+	x.xxx_hidden_Speeding = nil
+}
+
+func (x *DriverBehaviourResult) ClearDistanceGpsKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_DistanceGpsKm = 0
+}
+
+func (x *DriverBehaviourResult) ClearDistanceCanKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_DistanceCanKm = 0
+}
+
+func (x *DriverBehaviourResult) ClearDrivingDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_DrivingDurationS = 0
+}
+
+func (x *DriverBehaviourResult) ClearExcessiveIdlingPtoDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_ExcessiveIdlingPtoDurationS = 0
+}
+
+func (x *DriverBehaviourResult) ClearFuelAvgConsumption() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_FuelAvgConsumption = 0
+}
+
+func (x *DriverBehaviourResult) ClearFuelAvgConsumptionUnit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_FuelAvgConsumptionUnit = nil
+}
+
+func (x *DriverBehaviourResult) ClearFuelAvgConsumptionByNorm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 16)
+	x.xxx_hidden_FuelAvgConsumptionByNorm = 0
+}
+
+func (x *DriverBehaviourResult) ClearFuelAvgConsumptionRatio() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 17)
+	x.xxx_hidden_FuelAvgConsumptionRatio = 0
+}
+
+func (x *DriverBehaviourResult) ClearFuelConsumption() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 18)
+	x.xxx_hidden_FuelConsumption = 0
+}
+
+func (x *DriverBehaviourResult) ClearFuelConsumptionCan() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 19)
+	x.xxx_hidden_FuelConsumptionCan = 0
+}
+
+func (x *DriverBehaviourResult) ClearExcessiveIdlingFuelConsumptionCan() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 20)
+	x.xxx_hidden_ExcessiveIdlingFuelConsumptionCan = 0
+}
+
+func (x *DriverBehaviourResult) ClearPtoIdleFuelConsumptionCan() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 21)
+	x.xxx_hidden_PtoIdleFuelConsumptionCan = 0
+}
+
+func (x *DriverBehaviourResult) ClearStopCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 22)
+	x.xxx_hidden_StopCount = 0
+}
+
+func (x *DriverBehaviourResult) ClearStopDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 23)
+	x.xxx_hidden_StopDurationS = 0
+}
+
+func (x *DriverBehaviourResult) ClearAvgSpeedKmh() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 24)
+	x.xxx_hidden_AvgSpeedKmh = 0
+}
+
+type DriverBehaviourResult_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Overall result, based on the selected metrics.
+	Overall *DriverBehaviourScore
+	// Result of driving in the economical speed range.
+	EffectiveSpeed *DriverBehaviourScore
+	// Result of excessive idling.
+	ExcessiveIdling *DriverBehaviourScore
+	// Result of cruise control usage.
+	CruiseControl *DriverBehaviourScore
+	// Result of coasting.
+	Coasting *DriverBehaviourScore
+	// Result of harsh braking, with the number of events.
+	HarshBraking *DriverBehaviourScore
+	// Result of harsh acceleration, with the number of events.
+	HarshAcceleration *DriverBehaviourScore
+	// Result of harsh cornering, with the number of events.
+	HarshCornering *DriverBehaviourScore
+	// Result of driving in the economical engine RPM range.
+	GreenRpm *DriverBehaviourScore
+	// Result of speeding.
+	Speeding *DriverBehaviourScore
+	// Distance driven according to GPS in kilometers.
+	DistanceGpsKm *float64
+	// Distance driven according to CAN data in kilometers.
+	DistanceCanKm *float64
+	// Total driving duration in seconds.
+	DrivingDurationS *int64
+	// Duration of excessive idling with PTO engaged in seconds.
+	ExcessiveIdlingPtoDurationS *int64
+	// Average fuel consumption, in the unit of fuel_avg_consumption_unit.
+	FuelAvgConsumption *float64
+	// Unit of the fuel values, e.g. "l/100km". If "kg/100km", all fuel values are in kilograms instead of liters.
+	FuelAvgConsumptionUnit *string
+	// Average fuel consumption according to the norm.
+	FuelAvgConsumptionByNorm *float64
+	// Ratio of the average fuel consumption to the norm.
+	FuelAvgConsumptionRatio *float64
+	// Fuel consumed in liters.
+	FuelConsumption *float64
+	// Fuel consumed according to CAN data in liters.
+	FuelConsumptionCan *float64
+	// Fuel consumed while excessively idling, according to CAN data in liters.
+	ExcessiveIdlingFuelConsumptionCan *float64
+	// Fuel consumed while idling with PTO engaged, according to CAN data in liters.
+	PtoIdleFuelConsumptionCan *float64
+	// Number of stops.
+	StopCount *int64
+	// Total duration of the stops in seconds.
+	StopDurationS *int64
+	// Average speed in km/h.
+	AvgSpeedKmh *float64
+}
+
+func (b0 DriverBehaviourResult_builder) Build() *DriverBehaviourResult {
+	m0 := &DriverBehaviourResult{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Overall = b.Overall
+	x.xxx_hidden_EffectiveSpeed = b.EffectiveSpeed
+	x.xxx_hidden_ExcessiveIdling = b.ExcessiveIdling
+	x.xxx_hidden_CruiseControl = b.CruiseControl
+	x.xxx_hidden_Coasting = b.Coasting
+	x.xxx_hidden_HarshBraking = b.HarshBraking
+	x.xxx_hidden_HarshAcceleration = b.HarshAcceleration
+	x.xxx_hidden_HarshCornering = b.HarshCornering
+	x.xxx_hidden_GreenRpm = b.GreenRpm
+	x.xxx_hidden_Speeding = b.Speeding
+	if b.DistanceGpsKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 25)
+		x.xxx_hidden_DistanceGpsKm = *b.DistanceGpsKm
+	}
+	if b.DistanceCanKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 25)
+		x.xxx_hidden_DistanceCanKm = *b.DistanceCanKm
+	}
+	if b.DrivingDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 25)
+		x.xxx_hidden_DrivingDurationS = *b.DrivingDurationS
+	}
+	if b.ExcessiveIdlingPtoDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 25)
+		x.xxx_hidden_ExcessiveIdlingPtoDurationS = *b.ExcessiveIdlingPtoDurationS
+	}
+	if b.FuelAvgConsumption != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 25)
+		x.xxx_hidden_FuelAvgConsumption = *b.FuelAvgConsumption
+	}
+	if b.FuelAvgConsumptionUnit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 25)
+		x.xxx_hidden_FuelAvgConsumptionUnit = b.FuelAvgConsumptionUnit
+	}
+	if b.FuelAvgConsumptionByNorm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 25)
+		x.xxx_hidden_FuelAvgConsumptionByNorm = *b.FuelAvgConsumptionByNorm
+	}
+	if b.FuelAvgConsumptionRatio != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 17, 25)
+		x.xxx_hidden_FuelAvgConsumptionRatio = *b.FuelAvgConsumptionRatio
+	}
+	if b.FuelConsumption != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 18, 25)
+		x.xxx_hidden_FuelConsumption = *b.FuelConsumption
+	}
+	if b.FuelConsumptionCan != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 19, 25)
+		x.xxx_hidden_FuelConsumptionCan = *b.FuelConsumptionCan
+	}
+	if b.ExcessiveIdlingFuelConsumptionCan != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 20, 25)
+		x.xxx_hidden_ExcessiveIdlingFuelConsumptionCan = *b.ExcessiveIdlingFuelConsumptionCan
+	}
+	if b.PtoIdleFuelConsumptionCan != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 21, 25)
+		x.xxx_hidden_PtoIdleFuelConsumptionCan = *b.PtoIdleFuelConsumptionCan
+	}
+	if b.StopCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 22, 25)
+		x.xxx_hidden_StopCount = *b.StopCount
+	}
+	if b.StopDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 23, 25)
+		x.xxx_hidden_StopDurationS = *b.StopDurationS
+	}
+	if b.AvgSpeedKmh != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 24, 25)
+		x.xxx_hidden_AvgSpeedKmh = *b.AvgSpeedKmh
+	}
+	return m0
+}
+
+// DriverBehaviourDriverReport represents the driver behaviour results of a driver.
+type DriverBehaviourDriverReport struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverId    int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_UnitIds     []int64                `protobuf:"varint,3,rep,packed,name=unit_ids,json=unitIds"`
+	xxx_hidden_Result      *DriverBehaviourResult `protobuf:"bytes,4,opt,name=result"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DriverBehaviourDriverReport) Reset() {
+	*x = DriverBehaviourDriverReport{}
+	mi := &file_wayplatform_connect_mapon_v1_driver_behaviour_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverBehaviourDriverReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverBehaviourDriverReport) ProtoMessage() {}
+
+func (x *DriverBehaviourDriverReport) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_driver_behaviour_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverBehaviourDriverReport) GetDriverId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DriverId
+	}
+	return 0
+}
+
+func (x *DriverBehaviourDriverReport) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverBehaviourDriverReport) GetUnitIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitIds
+	}
+	return nil
+}
+
+func (x *DriverBehaviourDriverReport) GetResult() *DriverBehaviourResult {
+	if x != nil {
+		return x.xxx_hidden_Result
+	}
+	return nil
+}
+
+func (x *DriverBehaviourDriverReport) SetDriverId(v int64) {
+	x.xxx_hidden_DriverId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *DriverBehaviourDriverReport) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *DriverBehaviourDriverReport) SetUnitIds(v []int64) {
+	x.xxx_hidden_UnitIds = v
+}
+
+func (x *DriverBehaviourDriverReport) SetResult(v *DriverBehaviourResult) {
+	x.xxx_hidden_Result = v
+}
+
+func (x *DriverBehaviourDriverReport) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverBehaviourDriverReport) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverBehaviourDriverReport) HasResult() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Result != nil
+}
+
+func (x *DriverBehaviourDriverReport) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = 0
+}
+
+func (x *DriverBehaviourDriverReport) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *DriverBehaviourDriverReport) ClearResult() {
+	x.xxx_hidden_Result = nil
+}
+
+type DriverBehaviourDriverReport_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Identifier of the driver.
+	DriverId *int64
+	// Name of the driver.
+	Name *string
+	// Identifiers of the units driven by the driver in the period.
+	UnitIds []int64
+	// Results of the driver.
+	Result *DriverBehaviourResult
+}
+
+func (b0 DriverBehaviourDriverReport_builder) Build() *DriverBehaviourDriverReport {
+	m0 := &DriverBehaviourDriverReport{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_DriverId = *b.DriverId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Name = b.Name
+	}
+	x.xxx_hidden_UnitIds = b.UnitIds
+	x.xxx_hidden_Result = b.Result
+	return m0
+}
+
+// DriverBehaviourUnitReport represents the driver behaviour results of a unit.
+type DriverBehaviourUnitReport struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UnitId      int64                  `protobuf:"varint,1,opt,name=unit_id,json=unitId"`
+	xxx_hidden_Title       *string                `protobuf:"bytes,2,opt,name=title"`
+	xxx_hidden_DriverIds   []int64                `protobuf:"varint,3,rep,packed,name=driver_ids,json=driverIds"`
+	xxx_hidden_Result      *DriverBehaviourResult `protobuf:"bytes,4,opt,name=result"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DriverBehaviourUnitReport) Reset() {
+	*x = DriverBehaviourUnitReport{}
+	mi := &file_wayplatform_connect_mapon_v1_driver_behaviour_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverBehaviourUnitReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverBehaviourUnitReport) ProtoMessage() {}
+
+func (x *DriverBehaviourUnitReport) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_driver_behaviour_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverBehaviourUnitReport) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *DriverBehaviourUnitReport) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverBehaviourUnitReport) GetDriverIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_DriverIds
+	}
+	return nil
+}
+
+func (x *DriverBehaviourUnitReport) GetResult() *DriverBehaviourResult {
+	if x != nil {
+		return x.xxx_hidden_Result
+	}
+	return nil
+}
+
+func (x *DriverBehaviourUnitReport) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *DriverBehaviourUnitReport) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *DriverBehaviourUnitReport) SetDriverIds(v []int64) {
+	x.xxx_hidden_DriverIds = v
+}
+
+func (x *DriverBehaviourUnitReport) SetResult(v *DriverBehaviourResult) {
+	x.xxx_hidden_Result = v
+}
+
+func (x *DriverBehaviourUnitReport) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverBehaviourUnitReport) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverBehaviourUnitReport) HasResult() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Result != nil
+}
+
+func (x *DriverBehaviourUnitReport) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *DriverBehaviourUnitReport) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *DriverBehaviourUnitReport) ClearResult() {
+	x.xxx_hidden_Result = nil
+}
+
+type DriverBehaviourUnitReport_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Identifier of the unit.
+	UnitId *int64
+	// Title of the unit, e.g. its license plate.
+	Title *string
+	// Identifiers of the drivers who drove the unit in the period.
+	DriverIds []int64
+	// Results of the unit.
+	Result *DriverBehaviourResult
+}
+
+func (b0 DriverBehaviourUnitReport_builder) Build() *DriverBehaviourUnitReport {
+	m0 := &DriverBehaviourUnitReport{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Title = b.Title
+	}
+	x.xxx_hidden_DriverIds = b.DriverIds
+	x.xxx_hidden_Result = b.Result
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_driver_behaviour_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_driver_behaviour_proto_rawDesc = "" +
+	"\n" +
+	"3wayplatform/connect/mapon/v1/driver_behaviour.proto\x12\x1cwayplatform.connect.mapon.v1\"\x98\x01\n" +
+	"\x14DriverBehaviourScore\x12\x14\n" +
+	"\x05grade\x18\x01 \x01(\tR\x05grade\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x1f\n" +
+	"\vdistance_km\x18\x03 \x01(\x01R\n" +
+	"distanceKm\x12\x1d\n" +
+	"\n" +
+	"duration_s\x18\x04 \x01(\x03R\tdurationS\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x03R\x05count\"\x90\r\n" +
+	"\x15DriverBehaviourResult\x12L\n" +
+	"\aoverall\x18\x01 \x01(\v22.wayplatform.connect.mapon.v1.DriverBehaviourScoreR\aoverall\x12[\n" +
+	"\x0feffective_speed\x18\x02 \x01(\v22.wayplatform.connect.mapon.v1.DriverBehaviourScoreR\x0eeffectiveSpeed\x12]\n" +
+	"\x10excessive_idling\x18\x03 \x01(\v22.wayplatform.connect.mapon.v1.DriverBehaviourScoreR\x0fexcessiveIdling\x12Y\n" +
+	"\x0ecruise_control\x18\x04 \x01(\v22.wayplatform.connect.mapon.v1.DriverBehaviourScoreR\rcruiseControl\x12N\n" +
+	"\bcoasting\x18\x05 \x01(\v22.wayplatform.connect.mapon.v1.DriverBehaviourScoreR\bcoasting\x12W\n" +
+	"\rharsh_braking\x18\x06 \x01(\v22.wayplatform.connect.mapon.v1.DriverBehaviourScoreR\fharshBraking\x12a\n" +
+	"\x12harsh_acceleration\x18\a \x01(\v22.wayplatform.connect.mapon.v1.DriverBehaviourScoreR\x11harshAcceleration\x12[\n" +
+	"\x0fharsh_cornering\x18\b \x01(\v22.wayplatform.connect.mapon.v1.DriverBehaviourScoreR\x0eharshCornering\x12O\n" +
+	"\tgreen_rpm\x18\t \x01(\v22.wayplatform.connect.mapon.v1.DriverBehaviourScoreR\bgreenRpm\x12N\n" +
+	"\bspeeding\x18\n" +
+	" \x01(\v22.wayplatform.connect.mapon.v1.DriverBehaviourScoreR\bspeeding\x12&\n" +
+	"\x0fdistance_gps_km\x18\v \x01(\x01R\rdistanceGpsKm\x12&\n" +
+	"\x0fdistance_can_km\x18\f \x01(\x01R\rdistanceCanKm\x12,\n" +
+	"\x12driving_duration_s\x18\r \x01(\x03R\x10drivingDurationS\x12D\n" +
+	"\x1fexcessive_idling_pto_duration_s\x18\x0e \x01(\x03R\x1bexcessiveIdlingPtoDurationS\x120\n" +
+	"\x14fuel_avg_consumption\x18\x0f \x01(\x01R\x12fuelAvgConsumption\x129\n" +
+	"\x19fuel_avg_consumption_unit\x18\x10 \x01(\tR\x16fuelAvgConsumptionUnit\x12>\n" +
+	"\x1cfuel_avg_consumption_by_norm\x18\x11 \x01(\x01R\x18fuelAvgConsumptionByNorm\x12;\n" +
+	"\x1afuel_avg_consumption_ratio\x18\x12 \x01(\x01R\x17fuelAvgConsumptionRatio\x12)\n" +
+	"\x10fuel_consumption\x18\x13 \x01(\x01R\x0ffuelConsumption\x120\n" +
+	"\x14fuel_consumption_can\x18\x14 \x01(\x01R\x12fuelConsumptionCan\x12P\n" +
+	"%excessive_idling_fuel_consumption_can\x18\x15 \x01(\x01R!excessiveIdlingFuelConsumptionCan\x12@\n" +
+	"\x1dpto_idle_fuel_consumption_can\x18\x16 \x01(\x01R\x19ptoIdleFuelConsumptionCan\x12\x1d\n" +
+	"\n" +
+	"stop_count\x18\x17 \x01(\x03R\tstopCount\x12&\n" +
+	"\x0fstop_duration_s\x18\x18 \x01(\x03R\rstopDurationS\x12\"\n" +
+	"\ravg_speed_kmh\x18\x19 \x01(\x01R\vavgSpeedKmh\"\xb6\x01\n" +
+	"\x1bDriverBehaviourDriverReport\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bunit_ids\x18\x03 \x03(\x03R\aunitIds\x12K\n" +
+	"\x06result\x18\x04 \x01(\v23.wayplatform.connect.mapon.v1.DriverBehaviourResultR\x06result\"\xb6\x01\n" +
+	"\x19DriverBehaviourUnitReport\x12\x17\n" +
+	"\aunit_id\x18\x01 \x01(\x03R\x06unitId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"driver_ids\x18\x03 \x03(\x03R\tdriverIds\x12K\n" +
+	"\x06result\x18\x04 \x01(\v23.wayplatform.connect.mapon.v1.DriverBehaviourResultR\x06result*\xec\x03\n" +
+	"\x15DriverBehaviourMetric\x12'\n" +
+	"#DRIVER_BEHAVIOUR_METRIC_UNSPECIFIED\x10\x00\x12(\n" +
+	"$DRIVER_BEHAVIOUR_METRIC_UNRECOGNIZED\x10\x01\x12+\n" +
+	"'DRIVER_BEHAVIOUR_METRIC_EFFECTIVE_SPEED\x10\x02\x12,\n" +
+	"(DRIVER_BEHAVIOUR_METRIC_EXCESSIVE_IDLING\x10\x03\x12*\n" +
+	"&DRIVER_BEHAVIOUR_METRIC_CRUISE_CONTROL\x10\x04\x12$\n" +
+	" DRIVER_BEHAVIOUR_METRIC_COASTING\x10\x05\x12)\n" +
+	"%DRIVER_BEHAVIOUR_METRIC_HARSH_BRAKING\x10\x06\x12.\n" +
+	"*DRIVER_BEHAVIOUR_METRIC_HARSH_ACCELERATION\x10\a\x12+\n" +
+	"'DRIVER_BEHAVIOUR_METRIC_HARSH_CORNERING\x10\b\x12%\n" +
+	"!DRIVER_BEHAVIOUR_METRIC_GREEN_RPM\x10\t\x12$\n" +
+	" DRIVER_BEHAVIOUR_METRIC_SPEEDING\x10\n" +
+	"B\x9f\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x14DriverBehaviourProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_driver_behaviour_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_mapon_v1_driver_behaviour_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_wayplatform_connect_mapon_v1_driver_behaviour_proto_goTypes = []any{
+	(DriverBehaviourMetric)(0),          // 0: wayplatform.connect.mapon.v1.DriverBehaviourMetric
+	(*DriverBehaviourScore)(nil),        // 1: wayplatform.connect.mapon.v1.DriverBehaviourScore
+	(*DriverBehaviourResult)(nil),       // 2: wayplatform.connect.mapon.v1.DriverBehaviourResult
+	(*DriverBehaviourDriverReport)(nil), // 3: wayplatform.connect.mapon.v1.DriverBehaviourDriverReport
+	(*DriverBehaviourUnitReport)(nil),   // 4: wayplatform.connect.mapon.v1.DriverBehaviourUnitReport
+}
+var file_wayplatform_connect_mapon_v1_driver_behaviour_proto_depIdxs = []int32{
+	1,  // 0: wayplatform.connect.mapon.v1.DriverBehaviourResult.overall:type_name -> wayplatform.connect.mapon.v1.DriverBehaviourScore
+	1,  // 1: wayplatform.connect.mapon.v1.DriverBehaviourResult.effective_speed:type_name -> wayplatform.connect.mapon.v1.DriverBehaviourScore
+	1,  // 2: wayplatform.connect.mapon.v1.DriverBehaviourResult.excessive_idling:type_name -> wayplatform.connect.mapon.v1.DriverBehaviourScore
+	1,  // 3: wayplatform.connect.mapon.v1.DriverBehaviourResult.cruise_control:type_name -> wayplatform.connect.mapon.v1.DriverBehaviourScore
+	1,  // 4: wayplatform.connect.mapon.v1.DriverBehaviourResult.coasting:type_name -> wayplatform.connect.mapon.v1.DriverBehaviourScore
+	1,  // 5: wayplatform.connect.mapon.v1.DriverBehaviourResult.harsh_braking:type_name -> wayplatform.connect.mapon.v1.DriverBehaviourScore
+	1,  // 6: wayplatform.connect.mapon.v1.DriverBehaviourResult.harsh_acceleration:type_name -> wayplatform.connect.mapon.v1.DriverBehaviourScore
+	1,  // 7: wayplatform.connect.mapon.v1.DriverBehaviourResult.harsh_cornering:type_name -> wayplatform.connect.mapon.v1.DriverBehaviourScore
+	1,  // 8: wayplatform.connect.mapon.v1.DriverBehaviourResult.green_rpm:type_name -> wayplatform.connect.mapon.v1.DriverBehaviourScore
+	1,  // 9: wayplatform.connect.mapon.v1.DriverBehaviourResult.speeding:type_name -> wayplatform.connect.mapon.v1.DriverBehaviourScore
+	2,  // 10: wayplatform.connect.mapon.v1.DriverBehaviourDriverReport.result:type_name -> wayplatform.connect.mapon.v1.DriverBehaviourResult
+	2,  // 11: wayplatform.connect.mapon.v1.DriverBehaviourUnitReport.result:type_name -> wayplatform.connect.mapon.v1.DriverBehaviourResult
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_driver_behaviour_proto_init() }
+func file_wayplatform_connect_mapon_v1_driver_behaviour_proto_init() {
+	if File_wayplatform_connect_mapon_v1_driver_behaviour_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_driver_behaviour_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_driver_behaviour_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_driver_behaviour_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_driver_behaviour_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_driver_behaviour_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_driver_behaviour_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_driver_behaviour_proto = out.File
+	file_wayplatform_connect_mapon_v1_driver_behaviour_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_driver_behaviour_proto_depIdxs = nil
+}
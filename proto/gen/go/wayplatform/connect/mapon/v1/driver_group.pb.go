@@ -0,0 +1,210 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/driver_group.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DriverGroup represents a group of drivers.
+type DriverGroup struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GroupId     int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_ParentId    int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DriverGroup) Reset() {
+	*x = DriverGroup{}
+	mi := &file_wayplatform_connect_mapon_v1_driver_group_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverGroup) ProtoMessage() {}
+
+func (x *DriverGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_driver_group_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverGroup) GetGroupId() int64 {
+	if x != nil {
+		return x.xxx_hidden_GroupId
+	}
+	return 0
+}
+
+func (x *DriverGroup) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverGroup) GetParentId() int64 {
+	if x != nil {
+		return x.xxx_hidden_ParentId
+	}
+	return 0
+}
+
+func (x *DriverGroup) SetGroupId(v int64) {
+	x.xxx_hidden_GroupId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *DriverGroup) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *DriverGroup) SetParentId(v int64) {
+	x.xxx_hidden_ParentId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *DriverGroup) HasGroupId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverGroup) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverGroup) HasParentId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DriverGroup) ClearGroupId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GroupId = 0
+}
+
+func (x *DriverGroup) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *DriverGroup) ClearParentId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_ParentId = 0
+}
+
+type DriverGroup_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the group.
+	GroupId *int64
+	// Name of the group.
+	Name *string
+	// Identifier of the parent group (0 if root).
+	ParentId *int64
+}
+
+func (b0 DriverGroup_builder) Build() *DriverGroup {
+	m0 := &DriverGroup{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GroupId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_GroupId = *b.GroupId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.ParentId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_ParentId = *b.ParentId
+	}
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_driver_group_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_driver_group_proto_rawDesc = "" +
+	"\n" +
+	"/wayplatform/connect/mapon/v1/driver_group.proto\x12\x1cwayplatform.connect.mapon.v1\"Y\n" +
+	"\vDriverGroup\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentIdB\x9b\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x10DriverGroupProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_driver_group_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_wayplatform_connect_mapon_v1_driver_group_proto_goTypes = []any{
+	(*DriverGroup)(nil), // 0: wayplatform.connect.mapon.v1.DriverGroup
+}
+var file_wayplatform_connect_mapon_v1_driver_group_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_driver_group_proto_init() }
+func file_wayplatform_connect_mapon_v1_driver_group_proto_init() {
+	if File_wayplatform_connect_mapon_v1_driver_group_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_driver_group_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_driver_group_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_driver_group_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_driver_group_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_driver_group_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_driver_group_proto = out.File
+	file_wayplatform_connect_mapon_v1_driver_group_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_driver_group_proto_depIdxs = nil
+}
@@ -0,0 +1,1510 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/fuel.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FuelDataSource represents the source of fuel measurements.
+type FuelDataSource int32
+
+const (
+	// Default value, used when the source is missing or not set.
+	FuelDataSource_FUEL_DATA_SOURCE_UNSPECIFIED FuelDataSource = 0
+	// Used when the received value does not match any known enum member.
+	FuelDataSource_FUEL_DATA_SOURCE_UNRECOGNIZED FuelDataSource = 1
+	// Fuel levels measured by fuel level sensors (fuel rods).
+	FuelDataSource_FUEL_DATA_SOURCE_SENSOR FuelDataSource = 2
+	// Fuel levels reported by the vehicle board computer (CAN).
+	FuelDataSource_FUEL_DATA_SOURCE_CAN FuelDataSource = 3
+	// Fuel flow rate measured by a flow meter.
+	FuelDataSource_FUEL_DATA_SOURCE_FLOW FuelDataSource = 4
+)
+
+// Enum value maps for FuelDataSource.
+var (
+	FuelDataSource_name = map[int32]string{
+		0: "FUEL_DATA_SOURCE_UNSPECIFIED",
+		1: "FUEL_DATA_SOURCE_UNRECOGNIZED",
+		2: "FUEL_DATA_SOURCE_SENSOR",
+		3: "FUEL_DATA_SOURCE_CAN",
+		4: "FUEL_DATA_SOURCE_FLOW",
+	}
+	FuelDataSource_value = map[string]int32{
+		"FUEL_DATA_SOURCE_UNSPECIFIED":  0,
+		"FUEL_DATA_SOURCE_UNRECOGNIZED": 1,
+		"FUEL_DATA_SOURCE_SENSOR":       2,
+		"FUEL_DATA_SOURCE_CAN":          3,
+		"FUEL_DATA_SOURCE_FLOW":         4,
+	}
+)
+
+func (x FuelDataSource) Enum() *FuelDataSource {
+	p := new(FuelDataSource)
+	*p = x
+	return p
+}
+
+func (x FuelDataSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FuelDataSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_fuel_proto_enumTypes[0].Descriptor()
+}
+
+func (FuelDataSource) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_fuel_proto_enumTypes[0]
+}
+
+func (x FuelDataSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Type enum defining the kinds of fuel changes.
+type FuelChange_Type int32
+
+const (
+	FuelChange_TYPE_UNSPECIFIED FuelChange_Type = 0
+	// Fuel was added to the tank.
+	FuelChange_TYPE_REFUEL FuelChange_Type = 1
+	// Fuel was removed from the tank.
+	FuelChange_TYPE_DRAIN FuelChange_Type = 2
+)
+
+// Enum value maps for FuelChange_Type.
+var (
+	FuelChange_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_REFUEL",
+		2: "TYPE_DRAIN",
+	}
+	FuelChange_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_REFUEL":      1,
+		"TYPE_DRAIN":       2,
+	}
+)
+
+func (x FuelChange_Type) Enum() *FuelChange_Type {
+	p := new(FuelChange_Type)
+	*p = x
+	return p
+}
+
+func (x FuelChange_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FuelChange_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_fuel_proto_enumTypes[1].Descriptor()
+}
+
+func (FuelChange_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_fuel_proto_enumTypes[1]
+}
+
+func (x FuelChange_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// FuelValue represents a single fuel measurement with a timestamp.
+// Values are in liters, or in kilograms if the unit's fuel consumption is measured in kg/100km.
+type FuelValue struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Time        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time"`
+	xxx_hidden_Value       float64                `protobuf:"fixed64,2,opt,name=value"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *FuelValue) Reset() {
+	*x = FuelValue{}
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelValue) ProtoMessage() {}
+
+func (x *FuelValue) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FuelValue) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *FuelValue) GetValue() float64 {
+	if x != nil {
+		return x.xxx_hidden_Value
+	}
+	return 0
+}
+
+func (x *FuelValue) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *FuelValue) SetValue(v float64) {
+	x.xxx_hidden_Value = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *FuelValue) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *FuelValue) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *FuelValue) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *FuelValue) ClearValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Value = 0
+}
+
+type FuelValue_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Timestamp of the measurement.
+	Time *timestamppb.Timestamp
+	// Measured value.
+	Value *float64
+}
+
+func (b0 FuelValue_builder) Build() *FuelValue {
+	m0 := &FuelValue{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Time = b.Time
+	if b.Value != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Value = *b.Value
+	}
+	return m0
+}
+
+// FuelTankValues aggregates fuel level measurements of a single tank.
+type FuelTankValues struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Tank        int32                  `protobuf:"varint,1,opt,name=tank"`
+	xxx_hidden_Values      *[]*FuelValue          `protobuf:"bytes,2,rep,name=values"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *FuelTankValues) Reset() {
+	*x = FuelTankValues{}
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelTankValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelTankValues) ProtoMessage() {}
+
+func (x *FuelTankValues) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FuelTankValues) GetTank() int32 {
+	if x != nil {
+		return x.xxx_hidden_Tank
+	}
+	return 0
+}
+
+func (x *FuelTankValues) GetValues() []*FuelValue {
+	if x != nil {
+		if x.xxx_hidden_Values != nil {
+			return *x.xxx_hidden_Values
+		}
+	}
+	return nil
+}
+
+func (x *FuelTankValues) SetTank(v int32) {
+	x.xxx_hidden_Tank = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *FuelTankValues) SetValues(v []*FuelValue) {
+	x.xxx_hidden_Values = &v
+}
+
+func (x *FuelTankValues) HasTank() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *FuelTankValues) ClearTank() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Tank = 0
+}
+
+type FuelTankValues_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Number of the tank.
+	Tank *int32
+	// Fuel level measurements of the tank.
+	Values []*FuelValue
+}
+
+func (b0 FuelTankValues_builder) Build() *FuelTankValues {
+	m0 := &FuelTankValues{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Tank != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Tank = *b.Tank
+	}
+	x.xxx_hidden_Values = &b.Values
+	return m0
+}
+
+// UnitFuelData aggregates fuel level measurements over a period for a specific unit.
+type UnitFuelData struct {
+	state                                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UnitId                     int64                  `protobuf:"varint,1,opt,name=unit_id,json=unitId"`
+	xxx_hidden_FuelConsumptionMeasurement *string                `protobuf:"bytes,2,opt,name=fuel_consumption_measurement,json=fuelConsumptionMeasurement"`
+	xxx_hidden_SensorTotal                *[]*FuelValue          `protobuf:"bytes,3,rep,name=sensor_total,json=sensorTotal"`
+	xxx_hidden_SensorTanks                *[]*FuelTankValues     `protobuf:"bytes,4,rep,name=sensor_tanks,json=sensorTanks"`
+	xxx_hidden_Can                        *[]*FuelValue          `protobuf:"bytes,5,rep,name=can"`
+	xxx_hidden_Flow                       *[]*FuelValue          `protobuf:"bytes,6,rep,name=flow"`
+	XXX_raceDetectHookData                protoimpl.RaceDetectHookData
+	XXX_presence                          [1]uint32
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *UnitFuelData) Reset() {
+	*x = UnitFuelData{}
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitFuelData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitFuelData) ProtoMessage() {}
+
+func (x *UnitFuelData) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UnitFuelData) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *UnitFuelData) GetFuelConsumptionMeasurement() string {
+	if x != nil {
+		if x.xxx_hidden_FuelConsumptionMeasurement != nil {
+			return *x.xxx_hidden_FuelConsumptionMeasurement
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UnitFuelData) GetSensorTotal() []*FuelValue {
+	if x != nil {
+		if x.xxx_hidden_SensorTotal != nil {
+			return *x.xxx_hidden_SensorTotal
+		}
+	}
+	return nil
+}
+
+func (x *UnitFuelData) GetSensorTanks() []*FuelTankValues {
+	if x != nil {
+		if x.xxx_hidden_SensorTanks != nil {
+			return *x.xxx_hidden_SensorTanks
+		}
+	}
+	return nil
+}
+
+func (x *UnitFuelData) GetCan() []*FuelValue {
+	if x != nil {
+		if x.xxx_hidden_Can != nil {
+			return *x.xxx_hidden_Can
+		}
+	}
+	return nil
+}
+
+func (x *UnitFuelData) GetFlow() []*FuelValue {
+	if x != nil {
+		if x.xxx_hidden_Flow != nil {
+			return *x.xxx_hidden_Flow
+		}
+	}
+	return nil
+}
+
+func (x *UnitFuelData) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *UnitFuelData) SetFuelConsumptionMeasurement(v string) {
+	x.xxx_hidden_FuelConsumptionMeasurement = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *UnitFuelData) SetSensorTotal(v []*FuelValue) {
+	x.xxx_hidden_SensorTotal = &v
+}
+
+func (x *UnitFuelData) SetSensorTanks(v []*FuelTankValues) {
+	x.xxx_hidden_SensorTanks = &v
+}
+
+func (x *UnitFuelData) SetCan(v []*FuelValue) {
+	x.xxx_hidden_Can = &v
+}
+
+func (x *UnitFuelData) SetFlow(v []*FuelValue) {
+	x.xxx_hidden_Flow = &v
+}
+
+func (x *UnitFuelData) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UnitFuelData) HasFuelConsumptionMeasurement() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UnitFuelData) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *UnitFuelData) ClearFuelConsumptionMeasurement() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_FuelConsumptionMeasurement = nil
+}
+
+type UnitFuelData_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the unit.
+	UnitId *int64
+	// Fuel consumption measurement of the unit (e.g., "l/100km", "l/h", "kg/100km").
+	FuelConsumptionMeasurement *string
+	// Total fuel level of all tanks, measured by fuel level sensors.
+	SensorTotal []*FuelValue
+	// Fuel levels of individual tanks, measured by fuel level sensors.
+	SensorTanks []*FuelTankValues
+	// Fuel levels reported by the vehicle board computer.
+	Can []*FuelValue
+	// Fuel flow rates in time segments.
+	Flow []*FuelValue
+}
+
+func (b0 UnitFuelData_builder) Build() *UnitFuelData {
+	m0 := &UnitFuelData{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.FuelConsumptionMeasurement != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_FuelConsumptionMeasurement = b.FuelConsumptionMeasurement
+	}
+	x.xxx_hidden_SensorTotal = &b.SensorTotal
+	x.xxx_hidden_SensorTanks = &b.SensorTanks
+	x.xxx_hidden_Can = &b.Can
+	x.xxx_hidden_Flow = &b.Flow
+	return m0
+}
+
+// FuelChange represents a refuel or fuel drain event detected by Mapon.
+type FuelChange struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Type        FuelChange_Type        `protobuf:"varint,1,opt,name=type,enum=wayplatform.connect.mapon.v1.FuelChange_Type"`
+	xxx_hidden_Source      FuelDataSource         `protobuf:"varint,2,opt,name=source,enum=wayplatform.connect.mapon.v1.FuelDataSource"`
+	xxx_hidden_Volume      float64                `protobuf:"fixed64,3,opt,name=volume"`
+	xxx_hidden_LevelBefore float64                `protobuf:"fixed64,4,opt,name=level_before,json=levelBefore"`
+	xxx_hidden_Time        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time"`
+	xxx_hidden_Location    *Location              `protobuf:"bytes,6,opt,name=location"`
+	xxx_hidden_DriverId    int64                  `protobuf:"varint,7,opt,name=driver_id,json=driverId"`
+	xxx_hidden_DriverName  *string                `protobuf:"bytes,8,opt,name=driver_name,json=driverName"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *FuelChange) Reset() {
+	*x = FuelChange{}
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelChange) ProtoMessage() {}
+
+func (x *FuelChange) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FuelChange) GetType() FuelChange_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return FuelChange_TYPE_UNSPECIFIED
+}
+
+func (x *FuelChange) GetSource() FuelDataSource {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Source
+		}
+	}
+	return FuelDataSource_FUEL_DATA_SOURCE_UNSPECIFIED
+}
+
+func (x *FuelChange) GetVolume() float64 {
+	if x != nil {
+		return x.xxx_hidden_Volume
+	}
+	return 0
+}
+
+func (x *FuelChange) GetLevelBefore() float64 {
+	if x != nil {
+		return x.xxx_hidden_LevelBefore
+	}
+	return 0
+}
+
+func (x *FuelChange) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *FuelChange) GetLocation() *Location {
+	if x != nil {
+		return x.xxx_hidden_Location
+	}
+	return nil
+}
+
+func (x *FuelChange) GetDriverId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DriverId
+	}
+	return 0
+}
+
+func (x *FuelChange) GetDriverName() string {
+	if x != nil {
+		if x.xxx_hidden_DriverName != nil {
+			return *x.xxx_hidden_DriverName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FuelChange) SetType(v FuelChange_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *FuelChange) SetSource(v FuelDataSource) {
+	x.xxx_hidden_Source = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *FuelChange) SetVolume(v float64) {
+	x.xxx_hidden_Volume = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *FuelChange) SetLevelBefore(v float64) {
+	x.xxx_hidden_LevelBefore = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *FuelChange) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *FuelChange) SetLocation(v *Location) {
+	x.xxx_hidden_Location = v
+}
+
+func (x *FuelChange) SetDriverId(v int64) {
+	x.xxx_hidden_DriverId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *FuelChange) SetDriverName(v string) {
+	x.xxx_hidden_DriverName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *FuelChange) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *FuelChange) HasSource() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *FuelChange) HasVolume() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *FuelChange) HasLevelBefore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *FuelChange) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *FuelChange) HasLocation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Location != nil
+}
+
+func (x *FuelChange) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *FuelChange) HasDriverName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *FuelChange) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Type = FuelChange_TYPE_UNSPECIFIED
+}
+
+func (x *FuelChange) ClearSource() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Source = FuelDataSource_FUEL_DATA_SOURCE_UNSPECIFIED
+}
+
+func (x *FuelChange) ClearVolume() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Volume = 0
+}
+
+func (x *FuelChange) ClearLevelBefore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_LevelBefore = 0
+}
+
+func (x *FuelChange) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *FuelChange) ClearLocation() {
+	x.xxx_hidden_Location = nil
+}
+
+func (x *FuelChange) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_DriverId = 0
+}
+
+func (x *FuelChange) ClearDriverName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_DriverName = nil
+}
+
+type FuelChange_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Type of the fuel change.
+	Type *FuelChange_Type
+	// Source of the fuel measurements the change was detected from.
+	Source *FuelDataSource
+	// Absolute amount of fuel added or removed.
+	// Liters, or kilograms if the unit's fuel consumption is measured in kg/100km.
+	Volume *float64
+	// Fuel level before the change.
+	LevelBefore *float64
+	// Timestamp of the fuel change.
+	Time *timestamppb.Timestamp
+	// Location where the fuel change occurred.
+	Location *Location
+	// Identifier of the driver at the time of the change.
+	// 0 if no driver was identified.
+	DriverId *int64
+	// Name of the driver at the time of the change.
+	DriverName *string
+}
+
+func (b0 FuelChange_builder) Build() *FuelChange {
+	m0 := &FuelChange{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.Source != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_Source = *b.Source
+	}
+	if b.Volume != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_Volume = *b.Volume
+	}
+	if b.LevelBefore != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_LevelBefore = *b.LevelBefore
+	}
+	x.xxx_hidden_Time = b.Time
+	x.xxx_hidden_Location = b.Location
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_DriverId = *b.DriverId
+	}
+	if b.DriverName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_DriverName = b.DriverName
+	}
+	return m0
+}
+
+// UnitFuelChanges aggregates fuel changes over a period for a specific unit.
+type UnitFuelChanges struct {
+	state                                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UnitId                     int64                  `protobuf:"varint,1,opt,name=unit_id,json=unitId"`
+	xxx_hidden_Number                     *string                `protobuf:"bytes,2,opt,name=number"`
+	xxx_hidden_FuelConsumptionMeasurement *string                `protobuf:"bytes,3,opt,name=fuel_consumption_measurement,json=fuelConsumptionMeasurement"`
+	xxx_hidden_Changes                    *[]*FuelChange         `protobuf:"bytes,4,rep,name=changes"`
+	XXX_raceDetectHookData                protoimpl.RaceDetectHookData
+	XXX_presence                          [1]uint32
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *UnitFuelChanges) Reset() {
+	*x = UnitFuelChanges{}
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitFuelChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitFuelChanges) ProtoMessage() {}
+
+func (x *UnitFuelChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UnitFuelChanges) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *UnitFuelChanges) GetNumber() string {
+	if x != nil {
+		if x.xxx_hidden_Number != nil {
+			return *x.xxx_hidden_Number
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UnitFuelChanges) GetFuelConsumptionMeasurement() string {
+	if x != nil {
+		if x.xxx_hidden_FuelConsumptionMeasurement != nil {
+			return *x.xxx_hidden_FuelConsumptionMeasurement
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UnitFuelChanges) GetChanges() []*FuelChange {
+	if x != nil {
+		if x.xxx_hidden_Changes != nil {
+			return *x.xxx_hidden_Changes
+		}
+	}
+	return nil
+}
+
+func (x *UnitFuelChanges) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *UnitFuelChanges) SetNumber(v string) {
+	x.xxx_hidden_Number = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *UnitFuelChanges) SetFuelConsumptionMeasurement(v string) {
+	x.xxx_hidden_FuelConsumptionMeasurement = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *UnitFuelChanges) SetChanges(v []*FuelChange) {
+	x.xxx_hidden_Changes = &v
+}
+
+func (x *UnitFuelChanges) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UnitFuelChanges) HasNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UnitFuelChanges) HasFuelConsumptionMeasurement() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *UnitFuelChanges) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *UnitFuelChanges) ClearNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Number = nil
+}
+
+func (x *UnitFuelChanges) ClearFuelConsumptionMeasurement() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_FuelConsumptionMeasurement = nil
+}
+
+type UnitFuelChanges_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the unit.
+	UnitId *int64
+	// Registration number of the unit.
+	Number *string
+	// Fuel consumption measurement of the unit (e.g., "l/100km", "l/h", "kg/100km").
+	FuelConsumptionMeasurement *string
+	// Fuel changes, ordered by time.
+	Changes []*FuelChange
+}
+
+func (b0 UnitFuelChanges_builder) Build() *UnitFuelChanges {
+	m0 := &UnitFuelChanges{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.Number != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Number = b.Number
+	}
+	if b.FuelConsumptionMeasurement != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_FuelConsumptionMeasurement = b.FuelConsumptionMeasurement
+	}
+	x.xxx_hidden_Changes = &b.Changes
+	return m0
+}
+
+// FuelSummaryValues contains fuel totals for a period from a single data source.
+// Values are in liters, or in kilograms if the unit's fuel consumption is measured in kg/100km.
+type FuelSummaryValues struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_StartLevel         float64                `protobuf:"fixed64,1,opt,name=start_level,json=startLevel"`
+	xxx_hidden_EndLevel           float64                `protobuf:"fixed64,2,opt,name=end_level,json=endLevel"`
+	xxx_hidden_TotalConsumed      float64                `protobuf:"fixed64,3,opt,name=total_consumed,json=totalConsumed"`
+	xxx_hidden_Fueled             float64                `protobuf:"fixed64,4,opt,name=fueled"`
+	xxx_hidden_Drained            float64                `protobuf:"fixed64,5,opt,name=drained"`
+	xxx_hidden_AvgConsumption     float64                `protobuf:"fixed64,6,opt,name=avg_consumption,json=avgConsumption"`
+	xxx_hidden_AvgConsumptionType *string                `protobuf:"bytes,7,opt,name=avg_consumption_type,json=avgConsumptionType"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *FuelSummaryValues) Reset() {
+	*x = FuelSummaryValues{}
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelSummaryValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelSummaryValues) ProtoMessage() {}
+
+func (x *FuelSummaryValues) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FuelSummaryValues) GetStartLevel() float64 {
+	if x != nil {
+		return x.xxx_hidden_StartLevel
+	}
+	return 0
+}
+
+func (x *FuelSummaryValues) GetEndLevel() float64 {
+	if x != nil {
+		return x.xxx_hidden_EndLevel
+	}
+	return 0
+}
+
+func (x *FuelSummaryValues) GetTotalConsumed() float64 {
+	if x != nil {
+		return x.xxx_hidden_TotalConsumed
+	}
+	return 0
+}
+
+func (x *FuelSummaryValues) GetFueled() float64 {
+	if x != nil {
+		return x.xxx_hidden_Fueled
+	}
+	return 0
+}
+
+func (x *FuelSummaryValues) GetDrained() float64 {
+	if x != nil {
+		return x.xxx_hidden_Drained
+	}
+	return 0
+}
+
+func (x *FuelSummaryValues) GetAvgConsumption() float64 {
+	if x != nil {
+		return x.xxx_hidden_AvgConsumption
+	}
+	return 0
+}
+
+func (x *FuelSummaryValues) GetAvgConsumptionType() string {
+	if x != nil {
+		if x.xxx_hidden_AvgConsumptionType != nil {
+			return *x.xxx_hidden_AvgConsumptionType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FuelSummaryValues) SetStartLevel(v float64) {
+	x.xxx_hidden_StartLevel = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *FuelSummaryValues) SetEndLevel(v float64) {
+	x.xxx_hidden_EndLevel = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *FuelSummaryValues) SetTotalConsumed(v float64) {
+	x.xxx_hidden_TotalConsumed = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+}
+
+func (x *FuelSummaryValues) SetFueled(v float64) {
+	x.xxx_hidden_Fueled = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+}
+
+func (x *FuelSummaryValues) SetDrained(v float64) {
+	x.xxx_hidden_Drained = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+}
+
+func (x *FuelSummaryValues) SetAvgConsumption(v float64) {
+	x.xxx_hidden_AvgConsumption = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+}
+
+func (x *FuelSummaryValues) SetAvgConsumptionType(v string) {
+	x.xxx_hidden_AvgConsumptionType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+}
+
+func (x *FuelSummaryValues) HasStartLevel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *FuelSummaryValues) HasEndLevel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *FuelSummaryValues) HasTotalConsumed() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *FuelSummaryValues) HasFueled() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *FuelSummaryValues) HasDrained() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *FuelSummaryValues) HasAvgConsumption() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *FuelSummaryValues) HasAvgConsumptionType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *FuelSummaryValues) ClearStartLevel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_StartLevel = 0
+}
+
+func (x *FuelSummaryValues) ClearEndLevel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EndLevel = 0
+}
+
+func (x *FuelSummaryValues) ClearTotalConsumed() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_TotalConsumed = 0
+}
+
+func (x *FuelSummaryValues) ClearFueled() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Fueled = 0
+}
+
+func (x *FuelSummaryValues) ClearDrained() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Drained = 0
+}
+
+func (x *FuelSummaryValues) ClearAvgConsumption() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_AvgConsumption = 0
+}
+
+func (x *FuelSummaryValues) ClearAvgConsumptionType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_AvgConsumptionType = nil
+}
+
+type FuelSummaryValues_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fuel level at the start of the period.
+	StartLevel *float64
+	// Fuel level at the end of the period.
+	EndLevel *float64
+	// Total fuel consumed in the period.
+	TotalConsumed *float64
+	// Total fuel added in the period.
+	Fueled *float64
+	// Total fuel drained in the period (negative value).
+	Drained *float64
+	// Average fuel consumption in the period.
+	AvgConsumption *float64
+	// Basis of the average consumption (e.g., "km" for per 100 km, "h" for per hour).
+	AvgConsumptionType *string
+}
+
+func (b0 FuelSummaryValues_builder) Build() *FuelSummaryValues {
+	m0 := &FuelSummaryValues{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.StartLevel != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_StartLevel = *b.StartLevel
+	}
+	if b.EndLevel != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_EndLevel = *b.EndLevel
+	}
+	if b.TotalConsumed != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_TotalConsumed = *b.TotalConsumed
+	}
+	if b.Fueled != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_Fueled = *b.Fueled
+	}
+	if b.Drained != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_Drained = *b.Drained
+	}
+	if b.AvgConsumption != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		x.xxx_hidden_AvgConsumption = *b.AvgConsumption
+	}
+	if b.AvgConsumptionType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		x.xxx_hidden_AvgConsumptionType = b.AvgConsumptionType
+	}
+	return m0
+}
+
+// FuelSummary contains fuel totals for a period for a specific unit.
+type FuelSummary struct {
+	state                                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UnitId                     int64                  `protobuf:"varint,1,opt,name=unit_id,json=unitId"`
+	xxx_hidden_FuelConsumptionMeasurement *string                `protobuf:"bytes,2,opt,name=fuel_consumption_measurement,json=fuelConsumptionMeasurement"`
+	xxx_hidden_Sensor                     *FuelSummaryValues     `protobuf:"bytes,3,opt,name=sensor"`
+	xxx_hidden_Can                        *FuelSummaryValues     `protobuf:"bytes,4,opt,name=can"`
+	XXX_raceDetectHookData                protoimpl.RaceDetectHookData
+	XXX_presence                          [1]uint32
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *FuelSummary) Reset() {
+	*x = FuelSummary{}
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelSummary) ProtoMessage() {}
+
+func (x *FuelSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FuelSummary) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *FuelSummary) GetFuelConsumptionMeasurement() string {
+	if x != nil {
+		if x.xxx_hidden_FuelConsumptionMeasurement != nil {
+			return *x.xxx_hidden_FuelConsumptionMeasurement
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FuelSummary) GetSensor() *FuelSummaryValues {
+	if x != nil {
+		return x.xxx_hidden_Sensor
+	}
+	return nil
+}
+
+func (x *FuelSummary) GetCan() *FuelSummaryValues {
+	if x != nil {
+		return x.xxx_hidden_Can
+	}
+	return nil
+}
+
+func (x *FuelSummary) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *FuelSummary) SetFuelConsumptionMeasurement(v string) {
+	x.xxx_hidden_FuelConsumptionMeasurement = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *FuelSummary) SetSensor(v *FuelSummaryValues) {
+	x.xxx_hidden_Sensor = v
+}
+
+func (x *FuelSummary) SetCan(v *FuelSummaryValues) {
+	x.xxx_hidden_Can = v
+}
+
+func (x *FuelSummary) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *FuelSummary) HasFuelConsumptionMeasurement() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *FuelSummary) HasSensor() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Sensor != nil
+}
+
+func (x *FuelSummary) HasCan() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Can != nil
+}
+
+func (x *FuelSummary) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *FuelSummary) ClearFuelConsumptionMeasurement() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_FuelConsumptionMeasurement = nil
+}
+
+func (x *FuelSummary) ClearSensor() {
+	x.xxx_hidden_Sensor = nil
+}
+
+func (x *FuelSummary) ClearCan() {
+	x.xxx_hidden_Can = nil
+}
+
+type FuelSummary_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the unit.
+	UnitId *int64
+	// Fuel consumption measurement of the unit (e.g., "l/100km", "l/h", "kg/100km").
+	FuelConsumptionMeasurement *string
+	// Totals based on fuel level sensors.
+	Sensor *FuelSummaryValues
+	// Totals based on the vehicle board computer.
+	Can *FuelSummaryValues
+}
+
+func (b0 FuelSummary_builder) Build() *FuelSummary {
+	m0 := &FuelSummary{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.FuelConsumptionMeasurement != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_FuelConsumptionMeasurement = b.FuelConsumptionMeasurement
+	}
+	x.xxx_hidden_Sensor = b.Sensor
+	x.xxx_hidden_Can = b.Can
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_fuel_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_fuel_proto_rawDesc = "" +
+	"\n" +
+	"'wayplatform/connect/mapon/v1/fuel.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)wayplatform/connect/mapon/v1/common.proto\"Q\n" +
+	"\tFuelValue\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"e\n" +
+	"\x0eFuelTankValues\x12\x12\n" +
+	"\x04tank\x18\x01 \x01(\x05R\x04tank\x12?\n" +
+	"\x06values\x18\x02 \x03(\v2'.wayplatform.connect.mapon.v1.FuelValueR\x06values\"\xfe\x02\n" +
+	"\fUnitFuelData\x12\x17\n" +
+	"\aunit_id\x18\x01 \x01(\x03R\x06unitId\x12@\n" +
+	"\x1cfuel_consumption_measurement\x18\x02 \x01(\tR\x1afuelConsumptionMeasurement\x12J\n" +
+	"\fsensor_total\x18\x03 \x03(\v2'.wayplatform.connect.mapon.v1.FuelValueR\vsensorTotal\x12O\n" +
+	"\fsensor_tanks\x18\x04 \x03(\v2,.wayplatform.connect.mapon.v1.FuelTankValuesR\vsensorTanks\x129\n" +
+	"\x03can\x18\x05 \x03(\v2'.wayplatform.connect.mapon.v1.FuelValueR\x03can\x12;\n" +
+	"\x04flow\x18\x06 \x03(\v2'.wayplatform.connect.mapon.v1.FuelValueR\x04flow\"\xc1\x03\n" +
+	"\n" +
+	"FuelChange\x12A\n" +
+	"\x04type\x18\x01 \x01(\x0e2-.wayplatform.connect.mapon.v1.FuelChange.TypeR\x04type\x12D\n" +
+	"\x06source\x18\x02 \x01(\x0e2,.wayplatform.connect.mapon.v1.FuelDataSourceR\x06source\x12\x16\n" +
+	"\x06volume\x18\x03 \x01(\x01R\x06volume\x12!\n" +
+	"\flevel_before\x18\x04 \x01(\x01R\vlevelBefore\x12.\n" +
+	"\x04time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12B\n" +
+	"\blocation\x18\x06 \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\blocation\x12\x1b\n" +
+	"\tdriver_id\x18\a \x01(\x03R\bdriverId\x12\x1f\n" +
+	"\vdriver_name\x18\b \x01(\tR\n" +
+	"driverName\"=\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vTYPE_REFUEL\x10\x01\x12\x0e\n" +
+	"\n" +
+	"TYPE_DRAIN\x10\x02\"\xc8\x01\n" +
+	"\x0fUnitFuelChanges\x12\x17\n" +
+	"\aunit_id\x18\x01 \x01(\x03R\x06unitId\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\x12@\n" +
+	"\x1cfuel_consumption_measurement\x18\x03 \x01(\tR\x1afuelConsumptionMeasurement\x12B\n" +
+	"\achanges\x18\x04 \x03(\v2(.wayplatform.connect.mapon.v1.FuelChangeR\achanges\"\x85\x02\n" +
+	"\x11FuelSummaryValues\x12\x1f\n" +
+	"\vstart_level\x18\x01 \x01(\x01R\n" +
+	"startLevel\x12\x1b\n" +
+	"\tend_level\x18\x02 \x01(\x01R\bendLevel\x12%\n" +
+	"\x0etotal_consumed\x18\x03 \x01(\x01R\rtotalConsumed\x12\x16\n" +
+	"\x06fueled\x18\x04 \x01(\x01R\x06fueled\x12\x18\n" +
+	"\adrained\x18\x05 \x01(\x01R\adrained\x12'\n" +
+	"\x0favg_consumption\x18\x06 \x01(\x01R\x0eavgConsumption\x120\n" +
+	"\x14avg_consumption_type\x18\a \x01(\tR\x12avgConsumptionType\"\xf4\x01\n" +
+	"\vFuelSummary\x12\x17\n" +
+	"\aunit_id\x18\x01 \x01(\x03R\x06unitId\x12@\n" +
+	"\x1cfuel_consumption_measurement\x18\x02 \x01(\tR\x1afuelConsumptionMeasurement\x12G\n" +
+	"\x06sensor\x18\x03 \x01(\v2/.wayplatform.connect.mapon.v1.FuelSummaryValuesR\x06sensor\x12A\n" +
+	"\x03can\x18\x04 \x01(\v2/.wayplatform.connect.mapon.v1.FuelSummaryValuesR\x03can*\xa7\x01\n" +
+	"\x0eFuelDataSource\x12 \n" +
+	"\x1cFUEL_DATA_SOURCE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dFUEL_DATA_SOURCE_UNRECOGNIZED\x10\x01\x12\x1b\n" +
+	"\x17FUEL_DATA_SOURCE_SENSOR\x10\x02\x12\x18\n" +
+	"\x14FUEL_DATA_SOURCE_CAN\x10\x03\x12\x19\n" +
+	"\x15FUEL_DATA_SOURCE_FLOW\x10\x04B\x94\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\tFuelProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_fuel_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_wayplatform_connect_mapon_v1_fuel_proto_goTypes = []any{
+	(FuelDataSource)(0),           // 0: wayplatform.connect.mapon.v1.FuelDataSource
+	(FuelChange_Type)(0),          // 1: wayplatform.connect.mapon.v1.FuelChange.Type
+	(*FuelValue)(nil),             // 2: wayplatform.connect.mapon.v1.FuelValue
+	(*FuelTankValues)(nil),        // 3: wayplatform.connect.mapon.v1.FuelTankValues
+	(*UnitFuelData)(nil),          // 4: wayplatform.connect.mapon.v1.UnitFuelData
+	(*FuelChange)(nil),            // 5: wayplatform.connect.mapon.v1.FuelChange
+	(*UnitFuelChanges)(nil),       // 6: wayplatform.connect.mapon.v1.UnitFuelChanges
+	(*FuelSummaryValues)(nil),     // 7: wayplatform.connect.mapon.v1.FuelSummaryValues
+	(*FuelSummary)(nil),           // 8: wayplatform.connect.mapon.v1.FuelSummary
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*Location)(nil),              // 10: wayplatform.connect.mapon.v1.Location
+}
+var file_wayplatform_connect_mapon_v1_fuel_proto_depIdxs = []int32{
+	9,  // 0: wayplatform.connect.mapon.v1.FuelValue.time:type_name -> google.protobuf.Timestamp
+	2,  // 1: wayplatform.connect.mapon.v1.FuelTankValues.values:type_name -> wayplatform.connect.mapon.v1.FuelValue
+	2,  // 2: wayplatform.connect.mapon.v1.UnitFuelData.sensor_total:type_name -> wayplatform.connect.mapon.v1.FuelValue
+	3,  // 3: wayplatform.connect.mapon.v1.UnitFuelData.sensor_tanks:type_name -> wayplatform.connect.mapon.v1.FuelTankValues
+	2,  // 4: wayplatform.connect.mapon.v1.UnitFuelData.can:type_name -> wayplatform.connect.mapon.v1.FuelValue
+	2,  // 5: wayplatform.connect.mapon.v1.UnitFuelData.flow:type_name -> wayplatform.connect.mapon.v1.FuelValue
+	1,  // 6: wayplatform.connect.mapon.v1.FuelChange.type:type_name -> wayplatform.connect.mapon.v1.FuelChange.Type
+	0,  // 7: wayplatform.connect.mapon.v1.FuelChange.source:type_name -> wayplatform.connect.mapon.v1.FuelDataSource
+	9,  // 8: wayplatform.connect.mapon.v1.FuelChange.time:type_name -> google.protobuf.Timestamp
+	10, // 9: wayplatform.connect.mapon.v1.FuelChange.location:type_name -> wayplatform.connect.mapon.v1.Location
+	5,  // 10: wayplatform.connect.mapon.v1.UnitFuelChanges.changes:type_name -> wayplatform.connect.mapon.v1.FuelChange
+	7,  // 11: wayplatform.connect.mapon.v1.FuelSummary.sensor:type_name -> wayplatform.connect.mapon.v1.FuelSummaryValues
+	7,  // 12: wayplatform.connect.mapon.v1.FuelSummary.can:type_name -> wayplatform.connect.mapon.v1.FuelSummaryValues
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_fuel_proto_init() }
+func file_wayplatform_connect_mapon_v1_fuel_proto_init() {
+	if File_wayplatform_connect_mapon_v1_fuel_proto != nil {
+		return
+	}
+	file_wayplatform_connect_mapon_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_fuel_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_fuel_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_fuel_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_fuel_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_fuel_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_fuel_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_fuel_proto = out.File
+	file_wayplatform_connect_mapon_v1_fuel_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_fuel_proto_depIdxs = nil
+}
@@ -0,0 +1,643 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/fuel_check.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FuelCheck represents a fuel purchase receipt, e.g. from a fuel card transaction.
+type FuelCheck struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CheckId      int64                  `protobuf:"varint,1,opt,name=check_id,json=checkId"`
+	xxx_hidden_UnitId       int64                  `protobuf:"varint,2,opt,name=unit_id,json=unitId"`
+	xxx_hidden_CardId       *string                `protobuf:"bytes,3,opt,name=card_id,json=cardId"`
+	xxx_hidden_CardName     *string                `protobuf:"bytes,4,opt,name=card_name,json=cardName"`
+	xxx_hidden_Time         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time"`
+	xxx_hidden_CheckNumber  *string                `protobuf:"bytes,6,opt,name=check_number,json=checkNumber"`
+	xxx_hidden_FuelStation  *string                `protobuf:"bytes,7,opt,name=fuel_station,json=fuelStation"`
+	xxx_hidden_FuelType     *string                `protobuf:"bytes,8,opt,name=fuel_type,json=fuelType"`
+	xxx_hidden_Volume       float64                `protobuf:"fixed64,9,opt,name=volume"`
+	xxx_hidden_TotalAmount  float64                `protobuf:"fixed64,10,opt,name=total_amount,json=totalAmount"`
+	xxx_hidden_Currency     *string                `protobuf:"bytes,11,opt,name=currency"`
+	xxx_hidden_Location     *Location              `protobuf:"bytes,12,opt,name=location"`
+	xxx_hidden_ObjectId     int64                  `protobuf:"varint,13,opt,name=object_id,json=objectId"`
+	xxx_hidden_ObjectName   *string                `protobuf:"bytes,14,opt,name=object_name,json=objectName"`
+	xxx_hidden_OdometerGpsM int64                  `protobuf:"varint,15,opt,name=odometer_gps_m,json=odometerGpsM"`
+	xxx_hidden_OdometerCanM int64                  `protobuf:"varint,16,opt,name=odometer_can_m,json=odometerCanM"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *FuelCheck) Reset() {
+	*x = FuelCheck{}
+	mi := &file_wayplatform_connect_mapon_v1_fuel_check_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelCheck) ProtoMessage() {}
+
+func (x *FuelCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_fuel_check_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FuelCheck) GetCheckId() int64 {
+	if x != nil {
+		return x.xxx_hidden_CheckId
+	}
+	return 0
+}
+
+func (x *FuelCheck) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *FuelCheck) GetCardId() string {
+	if x != nil {
+		if x.xxx_hidden_CardId != nil {
+			return *x.xxx_hidden_CardId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FuelCheck) GetCardName() string {
+	if x != nil {
+		if x.xxx_hidden_CardName != nil {
+			return *x.xxx_hidden_CardName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FuelCheck) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *FuelCheck) GetCheckNumber() string {
+	if x != nil {
+		if x.xxx_hidden_CheckNumber != nil {
+			return *x.xxx_hidden_CheckNumber
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FuelCheck) GetFuelStation() string {
+	if x != nil {
+		if x.xxx_hidden_FuelStation != nil {
+			return *x.xxx_hidden_FuelStation
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FuelCheck) GetFuelType() string {
+	if x != nil {
+		if x.xxx_hidden_FuelType != nil {
+			return *x.xxx_hidden_FuelType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FuelCheck) GetVolume() float64 {
+	if x != nil {
+		return x.xxx_hidden_Volume
+	}
+	return 0
+}
+
+func (x *FuelCheck) GetTotalAmount() float64 {
+	if x != nil {
+		return x.xxx_hidden_TotalAmount
+	}
+	return 0
+}
+
+func (x *FuelCheck) GetCurrency() string {
+	if x != nil {
+		if x.xxx_hidden_Currency != nil {
+			return *x.xxx_hidden_Currency
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FuelCheck) GetLocation() *Location {
+	if x != nil {
+		return x.xxx_hidden_Location
+	}
+	return nil
+}
+
+func (x *FuelCheck) GetObjectId() int64 {
+	if x != nil {
+		return x.xxx_hidden_ObjectId
+	}
+	return 0
+}
+
+func (x *FuelCheck) GetObjectName() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectName != nil {
+			return *x.xxx_hidden_ObjectName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FuelCheck) GetOdometerGpsM() int64 {
+	if x != nil {
+		return x.xxx_hidden_OdometerGpsM
+	}
+	return 0
+}
+
+func (x *FuelCheck) GetOdometerCanM() int64 {
+	if x != nil {
+		return x.xxx_hidden_OdometerCanM
+	}
+	return 0
+}
+
+func (x *FuelCheck) SetCheckId(v int64) {
+	x.xxx_hidden_CheckId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 16)
+}
+
+func (x *FuelCheck) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 16)
+}
+
+func (x *FuelCheck) SetCardId(v string) {
+	x.xxx_hidden_CardId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 16)
+}
+
+func (x *FuelCheck) SetCardName(v string) {
+	x.xxx_hidden_CardName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 16)
+}
+
+func (x *FuelCheck) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *FuelCheck) SetCheckNumber(v string) {
+	x.xxx_hidden_CheckNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 16)
+}
+
+func (x *FuelCheck) SetFuelStation(v string) {
+	x.xxx_hidden_FuelStation = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 16)
+}
+
+func (x *FuelCheck) SetFuelType(v string) {
+	x.xxx_hidden_FuelType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 16)
+}
+
+func (x *FuelCheck) SetVolume(v float64) {
+	x.xxx_hidden_Volume = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 16)
+}
+
+func (x *FuelCheck) SetTotalAmount(v float64) {
+	x.xxx_hidden_TotalAmount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 16)
+}
+
+func (x *FuelCheck) SetCurrency(v string) {
+	x.xxx_hidden_Currency = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 16)
+}
+
+func (x *FuelCheck) SetLocation(v *Location) {
+	x.xxx_hidden_Location = v
+}
+
+func (x *FuelCheck) SetObjectId(v int64) {
+	x.xxx_hidden_ObjectId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 16)
+}
+
+func (x *FuelCheck) SetObjectName(v string) {
+	x.xxx_hidden_ObjectName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 16)
+}
+
+func (x *FuelCheck) SetOdometerGpsM(v int64) {
+	x.xxx_hidden_OdometerGpsM = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 16)
+}
+
+func (x *FuelCheck) SetOdometerCanM(v int64) {
+	x.xxx_hidden_OdometerCanM = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 16)
+}
+
+func (x *FuelCheck) HasCheckId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *FuelCheck) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *FuelCheck) HasCardId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *FuelCheck) HasCardName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *FuelCheck) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *FuelCheck) HasCheckNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *FuelCheck) HasFuelStation() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *FuelCheck) HasFuelType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *FuelCheck) HasVolume() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *FuelCheck) HasTotalAmount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *FuelCheck) HasCurrency() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *FuelCheck) HasLocation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Location != nil
+}
+
+func (x *FuelCheck) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *FuelCheck) HasObjectName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *FuelCheck) HasOdometerGpsM() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *FuelCheck) HasOdometerCanM() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *FuelCheck) ClearCheckId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CheckId = 0
+}
+
+func (x *FuelCheck) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *FuelCheck) ClearCardId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CardId = nil
+}
+
+func (x *FuelCheck) ClearCardName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_CardName = nil
+}
+
+func (x *FuelCheck) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *FuelCheck) ClearCheckNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_CheckNumber = nil
+}
+
+func (x *FuelCheck) ClearFuelStation() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_FuelStation = nil
+}
+
+func (x *FuelCheck) ClearFuelType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_FuelType = nil
+}
+
+func (x *FuelCheck) ClearVolume() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Volume = 0
+}
+
+func (x *FuelCheck) ClearTotalAmount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_TotalAmount = 0
+}
+
+func (x *FuelCheck) ClearCurrency() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_Currency = nil
+}
+
+func (x *FuelCheck) ClearLocation() {
+	x.xxx_hidden_Location = nil
+}
+
+func (x *FuelCheck) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_ObjectId = 0
+}
+
+func (x *FuelCheck) ClearObjectName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_ObjectName = nil
+}
+
+func (x *FuelCheck) ClearOdometerGpsM() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_OdometerGpsM = 0
+}
+
+func (x *FuelCheck) ClearOdometerCanM() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_OdometerCanM = 0
+}
+
+type FuelCheck_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the fuel check.
+	CheckId *int64
+	// Identifier of the unit that was refueled.
+	UnitId *int64
+	// Identifier of the fuel card used for the purchase.
+	CardId *string
+	// Name of the fuel card used for the purchase.
+	CardName *string
+	// Timestamp of the purchase.
+	Time *timestamppb.Timestamp
+	// Number of the receipt.
+	CheckNumber *string
+	// Name of the fuel station.
+	FuelStation *string
+	// Fuel type code (e.g., "D" for diesel, "95", "98", "100", "G" for gas,
+	// "A" for AdBlue, "L" for agriculture fuel, "CNG").
+	FuelType *string
+	// Purchased fuel volume in liters, or in kilograms for weight based fuels.
+	Volume *float64
+	// Total amount paid.
+	TotalAmount *float64
+	// ISO 4217 currency code of the total amount.
+	Currency *string
+	// Location of the fuel station.
+	Location *Location
+	// Identifier of the object (geofence) at the location, 0 if none.
+	ObjectId *int64
+	// Name of the object (geofence) at the location.
+	ObjectName *string
+	// GPS odometer value at the time of the purchase in meters.
+	OdometerGpsM *int64
+	// CAN odometer value at the time of the purchase in meters.
+	OdometerCanM *int64
+}
+
+func (b0 FuelCheck_builder) Build() *FuelCheck {
+	m0 := &FuelCheck{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.CheckId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 16)
+		x.xxx_hidden_CheckId = *b.CheckId
+	}
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 16)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.CardId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 16)
+		x.xxx_hidden_CardId = b.CardId
+	}
+	if b.CardName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 16)
+		x.xxx_hidden_CardName = b.CardName
+	}
+	x.xxx_hidden_Time = b.Time
+	if b.CheckNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 16)
+		x.xxx_hidden_CheckNumber = b.CheckNumber
+	}
+	if b.FuelStation != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 16)
+		x.xxx_hidden_FuelStation = b.FuelStation
+	}
+	if b.FuelType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 16)
+		x.xxx_hidden_FuelType = b.FuelType
+	}
+	if b.Volume != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 16)
+		x.xxx_hidden_Volume = *b.Volume
+	}
+	if b.TotalAmount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 16)
+		x.xxx_hidden_TotalAmount = *b.TotalAmount
+	}
+	if b.Currency != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 16)
+		x.xxx_hidden_Currency = b.Currency
+	}
+	x.xxx_hidden_Location = b.Location
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 16)
+		x.xxx_hidden_ObjectId = *b.ObjectId
+	}
+	if b.ObjectName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 16)
+		x.xxx_hidden_ObjectName = b.ObjectName
+	}
+	if b.OdometerGpsM != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 16)
+		x.xxx_hidden_OdometerGpsM = *b.OdometerGpsM
+	}
+	if b.OdometerCanM != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 16)
+		x.xxx_hidden_OdometerCanM = *b.OdometerCanM
+	}
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_fuel_check_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_fuel_check_proto_rawDesc = "" +
+	"\n" +
+	"-wayplatform/connect/mapon/v1/fuel_check.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)wayplatform/connect/mapon/v1/common.proto\"\xad\x04\n" +
+	"\tFuelCheck\x12\x19\n" +
+	"\bcheck_id\x18\x01 \x01(\x03R\acheckId\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\x03R\x06unitId\x12\x17\n" +
+	"\acard_id\x18\x03 \x01(\tR\x06cardId\x12\x1b\n" +
+	"\tcard_name\x18\x04 \x01(\tR\bcardName\x12.\n" +
+	"\x04time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12!\n" +
+	"\fcheck_number\x18\x06 \x01(\tR\vcheckNumber\x12!\n" +
+	"\ffuel_station\x18\a \x01(\tR\vfuelStation\x12\x1b\n" +
+	"\tfuel_type\x18\b \x01(\tR\bfuelType\x12\x16\n" +
+	"\x06volume\x18\t \x01(\x01R\x06volume\x12!\n" +
+	"\ftotal_amount\x18\n" +
+	" \x01(\x01R\vtotalAmount\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\x12B\n" +
+	"\blocation\x18\f \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\blocation\x12\x1b\n" +
+	"\tobject_id\x18\r \x01(\x03R\bobjectId\x12\x1f\n" +
+	"\vobject_name\x18\x0e \x01(\tR\n" +
+	"objectName\x12$\n" +
+	"\x0eodometer_gps_m\x18\x0f \x01(\x03R\fodometerGpsM\x12$\n" +
+	"\x0eodometer_can_m\x18\x10 \x01(\x03R\fodometerCanMB\x99\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x0eFuelCheckProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_fuel_check_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_wayplatform_connect_mapon_v1_fuel_check_proto_goTypes = []any{
+	(*FuelCheck)(nil),             // 0: wayplatform.connect.mapon.v1.FuelCheck
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	(*Location)(nil),              // 2: wayplatform.connect.mapon.v1.Location
+}
+var file_wayplatform_connect_mapon_v1_fuel_check_proto_depIdxs = []int32{
+	1, // 0: wayplatform.connect.mapon.v1.FuelCheck.time:type_name -> google.protobuf.Timestamp
+	2, // 1: wayplatform.connect.mapon.v1.FuelCheck.location:type_name -> wayplatform.connect.mapon.v1.Location
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_fuel_check_proto_init() }
+func file_wayplatform_connect_mapon_v1_fuel_check_proto_init() {
+	if File_wayplatform_connect_mapon_v1_fuel_check_proto != nil {
+		return
+	}
+	file_wayplatform_connect_mapon_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_fuel_check_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_fuel_check_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_fuel_check_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_fuel_check_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_fuel_check_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_fuel_check_proto = out.File
+	file_wayplatform_connect_mapon_v1_fuel_check_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_fuel_check_proto_depIdxs = nil
+}
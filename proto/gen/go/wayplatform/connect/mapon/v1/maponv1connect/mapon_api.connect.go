@@ -35,6 +35,67 @@ const (
 const (
 	// MaponApiListAlertsProcedure is the fully-qualified name of the MaponApi's ListAlerts RPC.
 	MaponApiListAlertsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListAlerts"
+	// MaponApiListAlertSetupsProcedure is the fully-qualified name of the MaponApi's ListAlertSetups
+	// RPC.
+	MaponApiListAlertSetupsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListAlertSetups"
+	// MaponApiGetAlertSetupTypesProcedure is the fully-qualified name of the MaponApi's
+	// GetAlertSetupTypes RPC.
+	MaponApiGetAlertSetupTypesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetAlertSetupTypes"
+	// MaponApiGetAlertSetupFieldsProcedure is the fully-qualified name of the MaponApi's
+	// GetAlertSetupFields RPC.
+	MaponApiGetAlertSetupFieldsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetAlertSetupFields"
+	// MaponApiStoreAlertSetupProcedure is the fully-qualified name of the MaponApi's StoreAlertSetup
+	// RPC.
+	MaponApiStoreAlertSetupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/StoreAlertSetup"
+	// MaponApiDeleteAlertSetupProcedure is the fully-qualified name of the MaponApi's DeleteAlertSetup
+	// RPC.
+	MaponApiDeleteAlertSetupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteAlertSetup"
+	// MaponApiListBleTagsProcedure is the fully-qualified name of the MaponApi's ListBleTags RPC.
+	MaponApiListBleTagsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListBleTags"
+	// MaponApiListBleTagScansProcedure is the fully-qualified name of the MaponApi's ListBleTagScans
+	// RPC.
+	MaponApiListBleTagScansProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListBleTagScans"
+	// MaponApiListBleTagLocationsProcedure is the fully-qualified name of the MaponApi's
+	// ListBleTagLocations RPC.
+	MaponApiListBleTagLocationsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListBleTagLocations"
+	// MaponApiGetBleTagHistoryPointProcedure is the fully-qualified name of the MaponApi's
+	// GetBleTagHistoryPoint RPC.
+	MaponApiGetBleTagHistoryPointProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetBleTagHistoryPoint"
+	// MaponApiGetCompanyProcedure is the fully-qualified name of the MaponApi's GetCompany RPC.
+	MaponApiGetCompanyProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetCompany"
+	// MaponApiListCompanyClientsProcedure is the fully-qualified name of the MaponApi's
+	// ListCompanyClients RPC.
+	MaponApiListCompanyClientsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListCompanyClients"
+	// MaponApiCreateCompanyClientProcedure is the fully-qualified name of the MaponApi's
+	// CreateCompanyClient RPC.
+	MaponApiCreateCompanyClientProcedure = "/wayplatform.connect.mapon.v1.MaponApi/CreateCompanyClient"
+	// MaponApiListCustomLayersProcedure is the fully-qualified name of the MaponApi's ListCustomLayers
+	// RPC.
+	MaponApiListCustomLayersProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListCustomLayers"
+	// MaponApiSaveCustomLayerProcedure is the fully-qualified name of the MaponApi's SaveCustomLayer
+	// RPC.
+	MaponApiSaveCustomLayerProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SaveCustomLayer"
+	// MaponApiDeleteCustomLayerProcedure is the fully-qualified name of the MaponApi's
+	// DeleteCustomLayer RPC.
+	MaponApiDeleteCustomLayerProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteCustomLayer"
+	// MaponApiListCustomLayerGeometriesProcedure is the fully-qualified name of the MaponApi's
+	// ListCustomLayerGeometries RPC.
+	MaponApiListCustomLayerGeometriesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListCustomLayerGeometries"
+	// MaponApiAddCustomLayerGeometriesProcedure is the fully-qualified name of the MaponApi's
+	// AddCustomLayerGeometries RPC.
+	MaponApiAddCustomLayerGeometriesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/AddCustomLayerGeometries"
+	// MaponApiEditCustomLayerGeometryProcedure is the fully-qualified name of the MaponApi's
+	// EditCustomLayerGeometry RPC.
+	MaponApiEditCustomLayerGeometryProcedure = "/wayplatform.connect.mapon.v1.MaponApi/EditCustomLayerGeometry"
+	// MaponApiDeleteCustomLayerGeometryProcedure is the fully-qualified name of the MaponApi's
+	// DeleteCustomLayerGeometry RPC.
+	MaponApiDeleteCustomLayerGeometryProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteCustomLayerGeometry"
+	// MaponApiListCustomTargetsProcedure is the fully-qualified name of the MaponApi's
+	// ListCustomTargets RPC.
+	MaponApiListCustomTargetsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListCustomTargets"
+	// MaponApiListCustomTargetValuesProcedure is the fully-qualified name of the MaponApi's
+	// ListCustomTargetValues RPC.
+	MaponApiListCustomTargetValuesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListCustomTargetValues"
 	// MaponApiDeleteDataForwardProcedure is the fully-qualified name of the MaponApi's
 	// DeleteDataForward RPC.
 	MaponApiDeleteDataForwardProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteDataForward"
@@ -44,22 +105,323 @@ const (
 	// MaponApiSaveDataForwardProcedure is the fully-qualified name of the MaponApi's SaveDataForward
 	// RPC.
 	MaponApiSaveDataForwardProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SaveDataForward"
+	// MaponApiListDevicesProcedure is the fully-qualified name of the MaponApi's ListDevices RPC.
+	MaponApiListDevicesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListDevices"
+	// MaponApiCreateDeviceProcedure is the fully-qualified name of the MaponApi's CreateDevice RPC.
+	MaponApiCreateDeviceProcedure = "/wayplatform.connect.mapon.v1.MaponApi/CreateDevice"
+	// MaponApiListDeviceModelsProcedure is the fully-qualified name of the MaponApi's ListDeviceModels
+	// RPC.
+	MaponApiListDeviceModelsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListDeviceModels"
+	// MaponApiGetDeviceCommandsProcedure is the fully-qualified name of the MaponApi's
+	// GetDeviceCommands RPC.
+	MaponApiGetDeviceCommandsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetDeviceCommands"
+	// MaponApiSendDeviceSmsCommandProcedure is the fully-qualified name of the MaponApi's
+	// SendDeviceSmsCommand RPC.
+	MaponApiSendDeviceSmsCommandProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SendDeviceSmsCommand"
+	// MaponApiSendDeviceTcpCommandProcedure is the fully-qualified name of the MaponApi's
+	// SendDeviceTcpCommand RPC.
+	MaponApiSendDeviceTcpCommandProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SendDeviceTcpCommand"
+	// MaponApiListDeviceSmsHistoryProcedure is the fully-qualified name of the MaponApi's
+	// ListDeviceSmsHistory RPC.
+	MaponApiListDeviceSmsHistoryProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListDeviceSmsHistory"
 	// MaponApiListDriversProcedure is the fully-qualified name of the MaponApi's ListDrivers RPC.
 	MaponApiListDriversProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListDrivers"
+	// MaponApiCreateDriverProcedure is the fully-qualified name of the MaponApi's CreateDriver RPC.
+	MaponApiCreateDriverProcedure = "/wayplatform.connect.mapon.v1.MaponApi/CreateDriver"
+	// MaponApiUpdateDriverProcedure is the fully-qualified name of the MaponApi's UpdateDriver RPC.
+	MaponApiUpdateDriverProcedure = "/wayplatform.connect.mapon.v1.MaponApi/UpdateDriver"
+	// MaponApiDeleteDriverProcedure is the fully-qualified name of the MaponApi's DeleteDriver RPC.
+	MaponApiDeleteDriverProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteDriver"
+	// MaponApiChangeDriverPasswordProcedure is the fully-qualified name of the MaponApi's
+	// ChangeDriverPassword RPC.
+	MaponApiChangeDriverPasswordProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ChangeDriverPassword"
+	// MaponApiAssociateExternalDriverProcedure is the fully-qualified name of the MaponApi's
+	// AssociateExternalDriver RPC.
+	MaponApiAssociateExternalDriverProcedure = "/wayplatform.connect.mapon.v1.MaponApi/AssociateExternalDriver"
+	// MaponApiLinkDriverUserProcedure is the fully-qualified name of the MaponApi's LinkDriverUser RPC.
+	MaponApiLinkDriverUserProcedure = "/wayplatform.connect.mapon.v1.MaponApi/LinkDriverUser"
+	// MaponApiUnlinkDriverUserProcedure is the fully-qualified name of the MaponApi's UnlinkDriverUser
+	// RPC.
+	MaponApiUnlinkDriverUserProcedure = "/wayplatform.connect.mapon.v1.MaponApi/UnlinkDriverUser"
+	// MaponApiListDriverCustomFieldsProcedure is the fully-qualified name of the MaponApi's
+	// ListDriverCustomFields RPC.
+	MaponApiListDriverCustomFieldsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListDriverCustomFields"
+	// MaponApiSaveDriverCustomFieldsProcedure is the fully-qualified name of the MaponApi's
+	// SaveDriverCustomFields RPC.
+	MaponApiSaveDriverCustomFieldsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SaveDriverCustomFields"
+	// MaponApiSaveDriverCustomFieldValuesProcedure is the fully-qualified name of the MaponApi's
+	// SaveDriverCustomFieldValues RPC.
+	MaponApiSaveDriverCustomFieldValuesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SaveDriverCustomFieldValues"
+	// MaponApiDeleteDriverCustomFieldsProcedure is the fully-qualified name of the MaponApi's
+	// DeleteDriverCustomFields RPC.
+	MaponApiDeleteDriverCustomFieldsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteDriverCustomFields"
+	// MaponApiListDriverDailyActivitiesProcedure is the fully-qualified name of the MaponApi's
+	// ListDriverDailyActivities RPC.
+	MaponApiListDriverDailyActivitiesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListDriverDailyActivities"
+	// MaponApiGetDriverBehaviourReportDriversProcedure is the fully-qualified name of the MaponApi's
+	// GetDriverBehaviourReportDrivers RPC.
+	MaponApiGetDriverBehaviourReportDriversProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetDriverBehaviourReportDrivers"
+	// MaponApiGetDriverBehaviourReportUnitsProcedure is the fully-qualified name of the MaponApi's
+	// GetDriverBehaviourReportUnits RPC.
+	MaponApiGetDriverBehaviourReportUnitsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetDriverBehaviourReportUnits"
+	// MaponApiListDriverGroupsProcedure is the fully-qualified name of the MaponApi's ListDriverGroups
+	// RPC.
+	MaponApiListDriverGroupsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListDriverGroups"
+	// MaponApiListDriversInGroupProcedure is the fully-qualified name of the MaponApi's
+	// ListDriversInGroup RPC.
+	MaponApiListDriversInGroupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListDriversInGroup"
+	// MaponApiSaveDriverGroupProcedure is the fully-qualified name of the MaponApi's SaveDriverGroup
+	// RPC.
+	MaponApiSaveDriverGroupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SaveDriverGroup"
+	// MaponApiDeleteDriverGroupProcedure is the fully-qualified name of the MaponApi's
+	// DeleteDriverGroup RPC.
+	MaponApiDeleteDriverGroupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteDriverGroup"
+	// MaponApiAddDriverToGroupProcedure is the fully-qualified name of the MaponApi's AddDriverToGroup
+	// RPC.
+	MaponApiAddDriverToGroupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/AddDriverToGroup"
+	// MaponApiRemoveDriverFromGroupProcedure is the fully-qualified name of the MaponApi's
+	// RemoveDriverFromGroup RPC.
+	MaponApiRemoveDriverFromGroupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/RemoveDriverFromGroup"
+	// MaponApiClearDriverGroupsProcedure is the fully-qualified name of the MaponApi's
+	// ClearDriverGroups RPC.
+	MaponApiClearDriverGroupsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ClearDriverGroups"
+	// MaponApiListFuelDataProcedure is the fully-qualified name of the MaponApi's ListFuelData RPC.
+	MaponApiListFuelDataProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListFuelData"
+	// MaponApiListFuelChangesProcedure is the fully-qualified name of the MaponApi's ListFuelChanges
+	// RPC.
+	MaponApiListFuelChangesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListFuelChanges"
+	// MaponApiGetFuelSummaryProcedure is the fully-qualified name of the MaponApi's GetFuelSummary RPC.
+	MaponApiGetFuelSummaryProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetFuelSummary"
+	// MaponApiListFuelChecksProcedure is the fully-qualified name of the MaponApi's ListFuelChecks RPC.
+	MaponApiListFuelChecksProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListFuelChecks"
+	// MaponApiAddFuelCheckProcedure is the fully-qualified name of the MaponApi's AddFuelCheck RPC.
+	MaponApiAddFuelCheckProcedure = "/wayplatform.connect.mapon.v1.MaponApi/AddFuelCheck"
+	// MaponApiEditFuelCheckProcedure is the fully-qualified name of the MaponApi's EditFuelCheck RPC.
+	MaponApiEditFuelCheckProcedure = "/wayplatform.connect.mapon.v1.MaponApi/EditFuelCheck"
+	// MaponApiDeleteFuelCheckProcedure is the fully-qualified name of the MaponApi's DeleteFuelCheck
+	// RPC.
+	MaponApiDeleteFuelCheckProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteFuelCheck"
+	// MaponApiAddFuelCardProcedure is the fully-qualified name of the MaponApi's AddFuelCard RPC.
+	MaponApiAddFuelCardProcedure = "/wayplatform.connect.mapon.v1.MaponApi/AddFuelCard"
+	// MaponApiUpdateFuelCardProcedure is the fully-qualified name of the MaponApi's UpdateFuelCard RPC.
+	MaponApiUpdateFuelCardProcedure = "/wayplatform.connect.mapon.v1.MaponApi/UpdateFuelCard"
+	// MaponApiDeleteFuelCardProcedure is the fully-qualified name of the MaponApi's DeleteFuelCard RPC.
+	MaponApiDeleteFuelCardProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteFuelCard"
+	// MaponApiListMessagingChannelsProcedure is the fully-qualified name of the MaponApi's
+	// ListMessagingChannels RPC.
+	MaponApiListMessagingChannelsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListMessagingChannels"
+	// MaponApiListMessagingConversationsProcedure is the fully-qualified name of the MaponApi's
+	// ListMessagingConversations RPC.
+	MaponApiListMessagingConversationsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListMessagingConversations"
+	// MaponApiCreateMessagingConversationProcedure is the fully-qualified name of the MaponApi's
+	// CreateMessagingConversation RPC.
+	MaponApiCreateMessagingConversationProcedure = "/wayplatform.connect.mapon.v1.MaponApi/CreateMessagingConversation"
+	// MaponApiListMessagesProcedure is the fully-qualified name of the MaponApi's ListMessages RPC.
+	MaponApiListMessagesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListMessages"
+	// MaponApiSendMessageProcedure is the fully-qualified name of the MaponApi's SendMessage RPC.
+	MaponApiSendMessageProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SendMessage"
 	// MaponApiListObjectsProcedure is the fully-qualified name of the MaponApi's ListObjects RPC.
 	MaponApiListObjectsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListObjects"
+	// MaponApiSaveObjectProcedure is the fully-qualified name of the MaponApi's SaveObject RPC.
+	MaponApiSaveObjectProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SaveObject"
+	// MaponApiDeleteObjectProcedure is the fully-qualified name of the MaponApi's DeleteObject RPC.
+	MaponApiDeleteObjectProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteObject"
+	// MaponApiGetObjectCustomFieldsProcedure is the fully-qualified name of the MaponApi's
+	// GetObjectCustomFields RPC.
+	MaponApiGetObjectCustomFieldsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetObjectCustomFields"
+	// MaponApiSaveObjectCustomFieldsValuesProcedure is the fully-qualified name of the MaponApi's
+	// SaveObjectCustomFieldsValues RPC.
+	MaponApiSaveObjectCustomFieldsValuesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SaveObjectCustomFieldsValues"
+	// MaponApiListObjectGroupsProcedure is the fully-qualified name of the MaponApi's ListObjectGroups
+	// RPC.
+	MaponApiListObjectGroupsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListObjectGroups"
+	// MaponApiSaveObjectGroupProcedure is the fully-qualified name of the MaponApi's SaveObjectGroup
+	// RPC.
+	MaponApiSaveObjectGroupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SaveObjectGroup"
+	// MaponApiDeleteObjectGroupProcedure is the fully-qualified name of the MaponApi's
+	// DeleteObjectGroup RPC.
+	MaponApiDeleteObjectGroupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteObjectGroup"
+	// MaponApiListPresetsProcedure is the fully-qualified name of the MaponApi's ListPresets RPC.
+	MaponApiListPresetsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListPresets"
+	// MaponApiGetPresetProcedure is the fully-qualified name of the MaponApi's GetPreset RPC.
+	MaponApiGetPresetProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetPreset"
+	// MaponApiCreatePresetProcedure is the fully-qualified name of the MaponApi's CreatePreset RPC.
+	MaponApiCreatePresetProcedure = "/wayplatform.connect.mapon.v1.MaponApi/CreatePreset"
+	// MaponApiEditPresetProcedure is the fully-qualified name of the MaponApi's EditPreset RPC.
+	MaponApiEditPresetProcedure = "/wayplatform.connect.mapon.v1.MaponApi/EditPreset"
+	// MaponApiDeletePresetProcedure is the fully-qualified name of the MaponApi's DeletePreset RPC.
+	MaponApiDeletePresetProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeletePreset"
+	// MaponApiGetAvailablePresetPermissionsProcedure is the fully-qualified name of the MaponApi's
+	// GetAvailablePresetPermissions RPC.
+	MaponApiGetAvailablePresetPermissionsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetAvailablePresetPermissions"
+	// MaponApiGetReeferHistoricPeriodProcedure is the fully-qualified name of the MaponApi's
+	// GetReeferHistoricPeriod RPC.
+	MaponApiGetReeferHistoricPeriodProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetReeferHistoricPeriod"
+	// MaponApiGetReeferHistoricPointProcedure is the fully-qualified name of the MaponApi's
+	// GetReeferHistoricPoint RPC.
+	MaponApiGetReeferHistoricPointProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetReeferHistoricPoint"
+	// MaponApiListReeferTemperatureDataProcedure is the fully-qualified name of the MaponApi's
+	// ListReeferTemperatureData RPC.
+	MaponApiListReeferTemperatureDataProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListReeferTemperatureData"
+	// MaponApiListReeferRunModesProcedure is the fully-qualified name of the MaponApi's
+	// ListReeferRunModes RPC.
+	MaponApiListReeferRunModesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListReeferRunModes"
+	// MaponApiChangeReeferSetpointProcedure is the fully-qualified name of the MaponApi's
+	// ChangeReeferSetpoint RPC.
+	MaponApiChangeReeferSetpointProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ChangeReeferSetpoint"
+	// MaponApiChangeReeferRunModeProcedure is the fully-qualified name of the MaponApi's
+	// ChangeReeferRunMode RPC.
+	MaponApiChangeReeferRunModeProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ChangeReeferRunMode"
+	// MaponApiSetReeferAlertProcedure is the fully-qualified name of the MaponApi's SetReeferAlert RPC.
+	MaponApiSetReeferAlertProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SetReeferAlert"
+	// MaponApiListReeferAlertsProcedure is the fully-qualified name of the MaponApi's ListReeferAlerts
+	// RPC.
+	MaponApiListReeferAlertsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListReeferAlerts"
+	// MaponApiDeleteReeferAlertProcedure is the fully-qualified name of the MaponApi's
+	// DeleteReeferAlert RPC.
+	MaponApiDeleteReeferAlertProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteReeferAlert"
+	// MaponApiChangeReeferAlertUserProcedure is the fully-qualified name of the MaponApi's
+	// ChangeReeferAlertUser RPC.
+	MaponApiChangeReeferAlertUserProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ChangeReeferAlertUser"
 	// MaponApiListRoutesProcedure is the fully-qualified name of the MaponApi's ListRoutes RPC.
 	MaponApiListRoutesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListRoutes"
+	// MaponApiCreateRoutePlanningOrderProcedure is the fully-qualified name of the MaponApi's
+	// CreateRoutePlanningOrder RPC.
+	MaponApiCreateRoutePlanningOrderProcedure = "/wayplatform.connect.mapon.v1.MaponApi/CreateRoutePlanningOrder"
+	// MaponApiAddRoutePlanningOrderPlacesProcedure is the fully-qualified name of the MaponApi's
+	// AddRoutePlanningOrderPlaces RPC.
+	MaponApiAddRoutePlanningOrderPlacesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/AddRoutePlanningOrderPlaces"
+	// MaponApiGetRoutePlanningOrderProcedure is the fully-qualified name of the MaponApi's
+	// GetRoutePlanningOrder RPC.
+	MaponApiGetRoutePlanningOrderProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetRoutePlanningOrder"
+	// MaponApiListRoutePlanningOrdersProcedure is the fully-qualified name of the MaponApi's
+	// ListRoutePlanningOrders RPC.
+	MaponApiListRoutePlanningOrdersProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListRoutePlanningOrders"
+	// MaponApiDeleteRoutePlanningOrdersProcedure is the fully-qualified name of the MaponApi's
+	// DeleteRoutePlanningOrders RPC.
+	MaponApiDeleteRoutePlanningOrdersProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteRoutePlanningOrders"
+	// MaponApiGetRoutePlanningPlaceProcedure is the fully-qualified name of the MaponApi's
+	// GetRoutePlanningPlace RPC.
+	MaponApiGetRoutePlanningPlaceProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetRoutePlanningPlace"
+	// MaponApiListRoutePlanningPlacesProcedure is the fully-qualified name of the MaponApi's
+	// ListRoutePlanningPlaces RPC.
+	MaponApiListRoutePlanningPlacesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListRoutePlanningPlaces"
+	// MaponApiDeleteRoutePlanningPlacesProcedure is the fully-qualified name of the MaponApi's
+	// DeleteRoutePlanningPlaces RPC.
+	MaponApiDeleteRoutePlanningPlacesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteRoutePlanningPlaces"
+	// MaponApiSaveRoutePlanningRouteProcedure is the fully-qualified name of the MaponApi's
+	// SaveRoutePlanningRoute RPC.
+	MaponApiSaveRoutePlanningRouteProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SaveRoutePlanningRoute"
+	// MaponApiGetRoutePlanningRouteProcedure is the fully-qualified name of the MaponApi's
+	// GetRoutePlanningRoute RPC.
+	MaponApiGetRoutePlanningRouteProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetRoutePlanningRoute"
+	// MaponApiListRoutePlanningRoutesProcedure is the fully-qualified name of the MaponApi's
+	// ListRoutePlanningRoutes RPC.
+	MaponApiListRoutePlanningRoutesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListRoutePlanningRoutes"
+	// MaponApiSendRoutePlanningRouteToAssigneeProcedure is the fully-qualified name of the MaponApi's
+	// SendRoutePlanningRouteToAssignee RPC.
+	MaponApiSendRoutePlanningRouteToAssigneeProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SendRoutePlanningRouteToAssignee"
+	// MaponApiSetRoutePlanningRouteStartAddressProcedure is the fully-qualified name of the MaponApi's
+	// SetRoutePlanningRouteStartAddress RPC.
+	MaponApiSetRoutePlanningRouteStartAddressProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SetRoutePlanningRouteStartAddress"
+	// MaponApiSetRoutePlanningRouteEndAddressProcedure is the fully-qualified name of the MaponApi's
+	// SetRoutePlanningRouteEndAddress RPC.
+	MaponApiSetRoutePlanningRouteEndAddressProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SetRoutePlanningRouteEndAddress"
+	// MaponApiOptimizeRoutePlanningRouteProcedure is the fully-qualified name of the MaponApi's
+	// OptimizeRoutePlanningRoute RPC.
+	MaponApiOptimizeRoutePlanningRouteProcedure = "/wayplatform.connect.mapon.v1.MaponApi/OptimizeRoutePlanningRoute"
+	// MaponApiGetRoutePlanningOptimizationProgressProcedure is the fully-qualified name of the
+	// MaponApi's GetRoutePlanningOptimizationProgress RPC.
+	MaponApiGetRoutePlanningOptimizationProgressProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetRoutePlanningOptimizationProgress"
+	// MaponApiDeleteRoutePlanningRoutesProcedure is the fully-qualified name of the MaponApi's
+	// DeleteRoutePlanningRoutes RPC.
+	MaponApiDeleteRoutePlanningRoutesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteRoutePlanningRoutes"
+	// MaponApiListDriverDddFilesProcedure is the fully-qualified name of the MaponApi's
+	// ListDriverDddFiles RPC.
+	MaponApiListDriverDddFilesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListDriverDddFiles"
+	// MaponApiListVehicleDddFilesProcedure is the fully-qualified name of the MaponApi's
+	// ListVehicleDddFiles RPC.
+	MaponApiListVehicleDddFilesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListVehicleDddFiles"
+	// MaponApiDownloadDriverDddProcedure is the fully-qualified name of the MaponApi's
+	// DownloadDriverDdd RPC.
+	MaponApiDownloadDriverDddProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DownloadDriverDdd"
+	// MaponApiDownloadVehicleDddProcedure is the fully-qualified name of the MaponApi's
+	// DownloadVehicleDdd RPC.
+	MaponApiDownloadVehicleDddProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DownloadVehicleDdd"
+	// MaponApiListTasksProcedure is the fully-qualified name of the MaponApi's ListTasks RPC.
+	MaponApiListTasksProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListTasks"
+	// MaponApiCreateTaskProcedure is the fully-qualified name of the MaponApi's CreateTask RPC.
+	MaponApiCreateTaskProcedure = "/wayplatform.connect.mapon.v1.MaponApi/CreateTask"
+	// MaponApiEditTaskProcedure is the fully-qualified name of the MaponApi's EditTask RPC.
+	MaponApiEditTaskProcedure = "/wayplatform.connect.mapon.v1.MaponApi/EditTask"
+	// MaponApiDeleteTaskProcedure is the fully-qualified name of the MaponApi's DeleteTask RPC.
+	MaponApiDeleteTaskProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteTask"
+	// MaponApiDeleteTaskRouteProcedure is the fully-qualified name of the MaponApi's DeleteTaskRoute
+	// RPC.
+	MaponApiDeleteTaskRouteProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteTaskRoute"
 	// MaponApiListTellTaleValuesProcedure is the fully-qualified name of the MaponApi's
 	// ListTellTaleValues RPC.
 	MaponApiListTellTaleValuesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListTellTaleValues"
+	// MaponApiListTrackingLinksProcedure is the fully-qualified name of the MaponApi's
+	// ListTrackingLinks RPC.
+	MaponApiListTrackingLinksProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListTrackingLinks"
+	// MaponApiCreateTrackingLinkProcedure is the fully-qualified name of the MaponApi's
+	// CreateTrackingLink RPC.
+	MaponApiCreateTrackingLinkProcedure = "/wayplatform.connect.mapon.v1.MaponApi/CreateTrackingLink"
+	// MaponApiEditTrackingLinkProcedure is the fully-qualified name of the MaponApi's EditTrackingLink
+	// RPC.
+	MaponApiEditTrackingLinkProcedure = "/wayplatform.connect.mapon.v1.MaponApi/EditTrackingLink"
+	// MaponApiDeleteTrackingLinkProcedure is the fully-qualified name of the MaponApi's
+	// DeleteTrackingLink RPC.
+	MaponApiDeleteTrackingLinkProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteTrackingLink"
 	// MaponApiListUnitsProcedure is the fully-qualified name of the MaponApi's ListUnits RPC.
 	MaponApiListUnitsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListUnits"
+	// MaponApiEditUnitProcedure is the fully-qualified name of the MaponApi's EditUnit RPC.
+	MaponApiEditUnitProcedure = "/wayplatform.connect.mapon.v1.MaponApi/EditUnit"
+	// MaponApiInstallUnitProcedure is the fully-qualified name of the MaponApi's InstallUnit RPC.
+	MaponApiInstallUnitProcedure = "/wayplatform.connect.mapon.v1.MaponApi/InstallUnit"
+	// MaponApiUninstallUnitProcedure is the fully-qualified name of the MaponApi's UninstallUnit RPC.
+	MaponApiUninstallUnitProcedure = "/wayplatform.connect.mapon.v1.MaponApi/UninstallUnit"
+	// MaponApiChangeUnitDeviceProcedure is the fully-qualified name of the MaponApi's ChangeUnitDevice
+	// RPC.
+	MaponApiChangeUnitDeviceProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ChangeUnitDevice"
+	// MaponApiChangeUnitRelayProcedure is the fully-qualified name of the MaponApi's ChangeUnitRelay
+	// RPC.
+	MaponApiChangeUnitRelayProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ChangeUnitRelay"
+	// MaponApiListAvailableUnitIconsProcedure is the fully-qualified name of the MaponApi's
+	// ListAvailableUnitIcons RPC.
+	MaponApiListAvailableUnitIconsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListAvailableUnitIcons"
+	// MaponApiListUnitCustomFieldsProcedure is the fully-qualified name of the MaponApi's
+	// ListUnitCustomFields RPC.
+	MaponApiListUnitCustomFieldsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListUnitCustomFields"
+	// MaponApiSaveUnitCustomFieldValuesProcedure is the fully-qualified name of the MaponApi's
+	// SaveUnitCustomFieldValues RPC.
+	MaponApiSaveUnitCustomFieldValuesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SaveUnitCustomFieldValues"
+	// MaponApiGetAvailableUnitCommandsProcedure is the fully-qualified name of the MaponApi's
+	// GetAvailableUnitCommands RPC.
+	MaponApiGetAvailableUnitCommandsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetAvailableUnitCommands"
+	// MaponApiExecuteUnitCommandProcedure is the fully-qualified name of the MaponApi's
+	// ExecuteUnitCommand RPC.
+	MaponApiExecuteUnitCommandProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ExecuteUnitCommand"
 	// MaponApiListUnitGroupsProcedure is the fully-qualified name of the MaponApi's ListUnitGroups RPC.
 	MaponApiListUnitGroupsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListUnitGroups"
 	// MaponApiListUnitsInGroupProcedure is the fully-qualified name of the MaponApi's ListUnitsInGroup
 	// RPC.
 	MaponApiListUnitsInGroupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListUnitsInGroup"
+	// MaponApiSaveUnitGroupProcedure is the fully-qualified name of the MaponApi's SaveUnitGroup RPC.
+	MaponApiSaveUnitGroupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/SaveUnitGroup"
+	// MaponApiDeleteUnitGroupProcedure is the fully-qualified name of the MaponApi's DeleteUnitGroup
+	// RPC.
+	MaponApiDeleteUnitGroupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteUnitGroup"
+	// MaponApiAttachUnitToGroupProcedure is the fully-qualified name of the MaponApi's
+	// AttachUnitToGroup RPC.
+	MaponApiAttachUnitToGroupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/AttachUnitToGroup"
+	// MaponApiDetachUnitFromGroupProcedure is the fully-qualified name of the MaponApi's
+	// DetachUnitFromGroup RPC.
+	MaponApiDetachUnitFromGroupProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DetachUnitFromGroup"
+	// MaponApiClearUnitGroupsProcedure is the fully-qualified name of the MaponApi's ClearUnitGroups
+	// RPC.
+	MaponApiClearUnitGroupsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ClearUnitGroups"
 	// MaponApiGetCanDataPointProcedure is the fully-qualified name of the MaponApi's GetCanDataPoint
 	// RPC.
 	MaponApiGetCanDataPointProcedure = "/wayplatform.connect.mapon.v1.MaponApi/GetCanDataPoint"
@@ -92,32 +454,328 @@ const (
 	// MaponApiListTemperaturesProcedure is the fully-qualified name of the MaponApi's ListTemperatures
 	// RPC.
 	MaponApiListTemperaturesProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListTemperatures"
+	// MaponApiListUsersProcedure is the fully-qualified name of the MaponApi's ListUsers RPC.
+	MaponApiListUsersProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListUsers"
+	// MaponApiCreateUserProcedure is the fully-qualified name of the MaponApi's CreateUser RPC.
+	MaponApiCreateUserProcedure = "/wayplatform.connect.mapon.v1.MaponApi/CreateUser"
+	// MaponApiUpdateUserProcedure is the fully-qualified name of the MaponApi's UpdateUser RPC.
+	MaponApiUpdateUserProcedure = "/wayplatform.connect.mapon.v1.MaponApi/UpdateUser"
+	// MaponApiDeleteUserProcedure is the fully-qualified name of the MaponApi's DeleteUser RPC.
+	MaponApiDeleteUserProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteUser"
+	// MaponApiChangeUserPasswordProcedure is the fully-qualified name of the MaponApi's
+	// ChangeUserPassword RPC.
+	MaponApiChangeUserPasswordProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ChangeUserPassword"
+	// MaponApiLinkUserToDriverProcedure is the fully-qualified name of the MaponApi's LinkUserToDriver
+	// RPC.
+	MaponApiLinkUserToDriverProcedure = "/wayplatform.connect.mapon.v1.MaponApi/LinkUserToDriver"
+	// MaponApiUnlinkUserFromDriverProcedure is the fully-qualified name of the MaponApi's
+	// UnlinkUserFromDriver RPC.
+	MaponApiUnlinkUserFromDriverProcedure = "/wayplatform.connect.mapon.v1.MaponApi/UnlinkUserFromDriver"
+	// MaponApiListVehicleInspectionsProcedure is the fully-qualified name of the MaponApi's
+	// ListVehicleInspections RPC.
+	MaponApiListVehicleInspectionsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/ListVehicleInspections"
+	// MaponApiCreateVehiclePinDeviceMappingsProcedure is the fully-qualified name of the MaponApi's
+	// CreateVehiclePinDeviceMappings RPC.
+	MaponApiCreateVehiclePinDeviceMappingsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/CreateVehiclePinDeviceMappings"
+	// MaponApiDeleteVehiclePinDeviceMappingsProcedure is the fully-qualified name of the MaponApi's
+	// DeleteVehiclePinDeviceMappings RPC.
+	MaponApiDeleteVehiclePinDeviceMappingsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/DeleteVehiclePinDeviceMappings"
+	// MaponApiCreateDriverPinsProcedure is the fully-qualified name of the MaponApi's CreateDriverPins
+	// RPC.
+	MaponApiCreateDriverPinsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/CreateDriverPins"
+	// MaponApiUpdateDriverPinsProcedure is the fully-qualified name of the MaponApi's UpdateDriverPins
+	// RPC.
+	MaponApiUpdateDriverPinsProcedure = "/wayplatform.connect.mapon.v1.MaponApi/UpdateDriverPins"
 )
 
 // MaponApiClient is a client for the wayplatform.connect.mapon.v1.MaponApi service.
 type MaponApiClient interface {
 	// ListAlerts returns triggered alerts.
 	ListAlerts(context.Context, *v1.ListAlertsRequest) (*v1.ListAlertsResponse, error)
+	// ListAlertSetups lists the configured alert setups.
+	ListAlertSetups(context.Context, *v1.ListAlertSetupsRequest) (*v1.ListAlertSetupsResponse, error)
+	// GetAlertSetupTypes returns the alert types that can be managed via the API.
+	GetAlertSetupTypes(context.Context, *v1.GetAlertSetupTypesRequest) (*v1.GetAlertSetupTypesResponse, error)
+	// GetAlertSetupFields returns the fields supported by an alert type.
+	GetAlertSetupFields(context.Context, *v1.GetAlertSetupFieldsRequest) (*v1.GetAlertSetupFieldsResponse, error)
+	// StoreAlertSetup creates or updates an alert setup.
+	StoreAlertSetup(context.Context, *v1.StoreAlertSetupRequest) (*v1.StoreAlertSetupResponse, error)
+	// DeleteAlertSetup deletes an alert setup.
+	DeleteAlertSetup(context.Context, *v1.DeleteAlertSetupRequest) (*v1.DeleteAlertSetupResponse, error)
+	// ListBleTags lists the active BLE tags of the company.
+	ListBleTags(context.Context, *v1.ListBleTagsRequest) (*v1.ListBleTagsResponse, error)
+	// ListBleTagScans lists the scans of a BLE tag in a period.
+	ListBleTagScans(context.Context, *v1.ListBleTagScansRequest) (*v1.ListBleTagScansResponse, error)
+	// ListBleTagLocations lists the location history of a BLE tag in a period.
+	ListBleTagLocations(context.Context, *v1.ListBleTagLocationsRequest) (*v1.ListBleTagLocationsResponse, error)
+	// GetBleTagHistoryPoint returns the scan and location of a BLE tag closest to a point in time.
+	GetBleTagHistoryPoint(context.Context, *v1.GetBleTagHistoryPointRequest) (*v1.GetBleTagHistoryPointResponse, error)
+	// GetCompany returns the basic data of the companies available for the API key.
+	GetCompany(context.Context, *v1.GetCompanyRequest) (*v1.GetCompanyResponse, error)
+	// ListCompanyClients returns a page of the clients of the company.
+	ListCompanyClients(context.Context, *v1.ListCompanyClientsRequest) (*v1.ListCompanyClientsResponse, error)
+	// CreateCompanyClient creates a client of the company.
+	CreateCompanyClient(context.Context, *v1.CreateCompanyClientRequest) (*v1.CreateCompanyClientResponse, error)
+	// ListCustomLayers lists the custom map layers.
+	ListCustomLayers(context.Context, *v1.ListCustomLayersRequest) (*v1.ListCustomLayersResponse, error)
+	// SaveCustomLayer creates or updates a custom map layer.
+	SaveCustomLayer(context.Context, *v1.SaveCustomLayerRequest) (*v1.SaveCustomLayerResponse, error)
+	// DeleteCustomLayer deletes a custom map layer.
+	DeleteCustomLayer(context.Context, *v1.DeleteCustomLayerRequest) (*v1.DeleteCustomLayerResponse, error)
+	// ListCustomLayerGeometries returns a page of the geometries of a custom map layer.
+	ListCustomLayerGeometries(context.Context, *v1.ListCustomLayerGeometriesRequest) (*v1.ListCustomLayerGeometriesResponse, error)
+	// AddCustomLayerGeometries adds geometries to a custom map layer.
+	AddCustomLayerGeometries(context.Context, *v1.AddCustomLayerGeometriesRequest) (*v1.AddCustomLayerGeometriesResponse, error)
+	// EditCustomLayerGeometry updates a custom map layer geometry.
+	EditCustomLayerGeometry(context.Context, *v1.EditCustomLayerGeometryRequest) (*v1.EditCustomLayerGeometryResponse, error)
+	// DeleteCustomLayerGeometry deletes a custom map layer geometry.
+	DeleteCustomLayerGeometry(context.Context, *v1.DeleteCustomLayerGeometryRequest) (*v1.DeleteCustomLayerGeometryResponse, error)
+	// ListCustomTargets lists the custom targets defined for the company.
+	ListCustomTargets(context.Context, *v1.ListCustomTargetsRequest) (*v1.ListCustomTargetsResponse, error)
+	// ListCustomTargetValues returns the values of a custom target recorded for units in the specified period.
+	ListCustomTargetValues(context.Context, *v1.ListCustomTargetValuesRequest) (*v1.ListCustomTargetValuesResponse, error)
 	// DeleteDataForward deregisters a push webhook endpoint from Mapon.
 	DeleteDataForward(context.Context, *v1.DeleteDataForwardRequest) (*v1.DeleteDataForwardResponse, error)
 	// ListDataForwards returns all registered push webhook endpoints for the API key.
 	ListDataForwards(context.Context, *v1.ListDataForwardsRequest) (*v1.ListDataForwardsResponse, error)
 	// SaveDataForward registers a push webhook endpoint with Mapon.
 	SaveDataForward(context.Context, *v1.SaveDataForwardRequest) (*v1.SaveDataForwardResponse, error)
+	// ListDevices lists the devices of the company.
+	ListDevices(context.Context, *v1.ListDevicesRequest) (*v1.ListDevicesResponse, error)
+	// CreateDevice creates a device in the company storage.
+	CreateDevice(context.Context, *v1.CreateDeviceRequest) (*v1.CreateDeviceResponse, error)
+	// ListDeviceModels lists the supported device models.
+	ListDeviceModels(context.Context, *v1.ListDeviceModelsRequest) (*v1.ListDeviceModelsResponse, error)
+	// GetDeviceCommands lists the SMS or TCP commands supported by a device.
+	GetDeviceCommands(context.Context, *v1.GetDeviceCommandsRequest) (*v1.GetDeviceCommandsResponse, error)
+	// SendDeviceSmsCommand sends an SMS command to a device.
+	SendDeviceSmsCommand(context.Context, *v1.SendDeviceSmsCommandRequest) (*v1.SendDeviceSmsCommandResponse, error)
+	// SendDeviceTcpCommand sends a TCP command to a device.
+	SendDeviceTcpCommand(context.Context, *v1.SendDeviceTcpCommandRequest) (*v1.SendDeviceTcpCommandResponse, error)
+	// ListDeviceSmsHistory lists the SMS messages received from a device.
+	ListDeviceSmsHistory(context.Context, *v1.ListDeviceSmsHistoryRequest) (*v1.ListDeviceSmsHistoryResponse, error)
 	// ListDrivers lists the drivers available for the current API key.
 	ListDrivers(context.Context, *v1.ListDriversRequest) (*v1.ListDriversResponse, error)
+	// CreateDriver creates a driver.
+	CreateDriver(context.Context, *v1.CreateDriverRequest) (*v1.CreateDriverResponse, error)
+	// UpdateDriver updates the fields of a driver that are set in the request.
+	UpdateDriver(context.Context, *v1.UpdateDriverRequest) (*v1.UpdateDriverResponse, error)
+	// DeleteDriver deletes a driver.
+	DeleteDriver(context.Context, *v1.DeleteDriverRequest) (*v1.DeleteDriverResponse, error)
+	// ChangeDriverPassword changes the password of a driver.
+	ChangeDriverPassword(context.Context, *v1.ChangeDriverPasswordRequest) (*v1.ChangeDriverPasswordResponse, error)
+	// AssociateExternalDriver associates external drivers with Mapon drivers.
+	AssociateExternalDriver(context.Context, *v1.AssociateExternalDriverRequest) (*v1.AssociateExternalDriverResponse, error)
+	// LinkDriverUser assigns a user to a driver.
+	LinkDriverUser(context.Context, *v1.LinkDriverUserRequest) (*v1.LinkDriverUserResponse, error)
+	// UnlinkDriverUser removes the user assigned to a driver.
+	UnlinkDriverUser(context.Context, *v1.UnlinkDriverUserRequest) (*v1.UnlinkDriverUserResponse, error)
+	// ListDriverCustomFields returns the custom fields and values of drivers.
+	ListDriverCustomFields(context.Context, *v1.ListDriverCustomFieldsRequest) (*v1.ListDriverCustomFieldsResponse, error)
+	// SaveDriverCustomFields creates or edits the custom fields of a driver.
+	SaveDriverCustomFields(context.Context, *v1.SaveDriverCustomFieldsRequest) (*v1.SaveDriverCustomFieldsResponse, error)
+	// SaveDriverCustomFieldValues sets custom field values of a driver.
+	SaveDriverCustomFieldValues(context.Context, *v1.SaveDriverCustomFieldValuesRequest) (*v1.SaveDriverCustomFieldValuesResponse, error)
+	// DeleteDriverCustomFields deletes custom fields of a driver.
+	DeleteDriverCustomFields(context.Context, *v1.DeleteDriverCustomFieldsRequest) (*v1.DeleteDriverCustomFieldsResponse, error)
+	// ListDriverDailyActivities returns the activity intervals of a driver grouped by day.
+	ListDriverDailyActivities(context.Context, *v1.ListDriverDailyActivitiesRequest) (*v1.ListDriverDailyActivitiesResponse, error)
+	// GetDriverBehaviourReportDrivers returns the driver behaviour results of drivers for a period.
+	GetDriverBehaviourReportDrivers(context.Context, *v1.GetDriverBehaviourReportDriversRequest) (*v1.GetDriverBehaviourReportDriversResponse, error)
+	// GetDriverBehaviourReportUnits returns the driver behaviour results of units for a period.
+	GetDriverBehaviourReportUnits(context.Context, *v1.GetDriverBehaviourReportUnitsRequest) (*v1.GetDriverBehaviourReportUnitsResponse, error)
+	// ListDriverGroups lists driver groups.
+	ListDriverGroups(context.Context, *v1.ListDriverGroupsRequest) (*v1.ListDriverGroupsResponse, error)
+	// ListDriversInGroup lists drivers in a group.
+	ListDriversInGroup(context.Context, *v1.ListDriversInGroupRequest) (*v1.ListDriversInGroupResponse, error)
+	// SaveDriverGroup creates or updates a driver group.
+	SaveDriverGroup(context.Context, *v1.SaveDriverGroupRequest) (*v1.SaveDriverGroupResponse, error)
+	// DeleteDriverGroup deletes a driver group.
+	DeleteDriverGroup(context.Context, *v1.DeleteDriverGroupRequest) (*v1.DeleteDriverGroupResponse, error)
+	// AddDriverToGroup adds a driver to a group.
+	AddDriverToGroup(context.Context, *v1.AddDriverToGroupRequest) (*v1.AddDriverToGroupResponse, error)
+	// RemoveDriverFromGroup removes a driver from a group.
+	RemoveDriverFromGroup(context.Context, *v1.RemoveDriverFromGroupRequest) (*v1.RemoveDriverFromGroupResponse, error)
+	// ClearDriverGroups removes a driver from all groups.
+	ClearDriverGroups(context.Context, *v1.ClearDriverGroupsRequest) (*v1.ClearDriverGroupsResponse, error)
+	// ListFuelData returns fuel levels and flow rates for a unit in the specified period.
+	ListFuelData(context.Context, *v1.ListFuelDataRequest) (*v1.ListFuelDataResponse, error)
+	// ListFuelChanges returns refuel and fuel drain events for units in the specified period.
+	ListFuelChanges(context.Context, *v1.ListFuelChangesRequest) (*v1.ListFuelChangesResponse, error)
+	// GetFuelSummary returns fuel totals for units in the specified period.
+	GetFuelSummary(context.Context, *v1.GetFuelSummaryRequest) (*v1.GetFuelSummaryResponse, error)
+	// ListFuelChecks returns fuel checks in the specified period.
+	ListFuelChecks(context.Context, *v1.ListFuelChecksRequest) (*v1.ListFuelChecksResponse, error)
+	// AddFuelCheck adds a new fuel check.
+	AddFuelCheck(context.Context, *v1.AddFuelCheckRequest) (*v1.AddFuelCheckResponse, error)
+	// EditFuelCheck updates an existing fuel check.
+	EditFuelCheck(context.Context, *v1.EditFuelCheckRequest) (*v1.EditFuelCheckResponse, error)
+	// DeleteFuelCheck deletes a fuel check.
+	DeleteFuelCheck(context.Context, *v1.DeleteFuelCheckRequest) (*v1.DeleteFuelCheckResponse, error)
+	// AddFuelCard creates a new fuel card.
+	AddFuelCard(context.Context, *v1.AddFuelCardRequest) (*v1.AddFuelCardResponse, error)
+	// UpdateFuelCard updates an existing fuel card.
+	UpdateFuelCard(context.Context, *v1.UpdateFuelCardRequest) (*v1.UpdateFuelCardResponse, error)
+	// DeleteFuelCard deletes a fuel card.
+	DeleteFuelCard(context.Context, *v1.DeleteFuelCardRequest) (*v1.DeleteFuelCardResponse, error)
+	// ListMessagingChannels lists the messaging channels of a user.
+	ListMessagingChannels(context.Context, *v1.ListMessagingChannelsRequest) (*v1.ListMessagingChannelsResponse, error)
+	// ListMessagingConversations lists the messaging conversations of a user.
+	ListMessagingConversations(context.Context, *v1.ListMessagingConversationsRequest) (*v1.ListMessagingConversationsResponse, error)
+	// CreateMessagingConversation creates a conversation with users or units, starting with a first message.
+	CreateMessagingConversation(context.Context, *v1.CreateMessagingConversationRequest) (*v1.CreateMessagingConversationResponse, error)
+	// ListMessages lists the messages of a conversation.
+	ListMessages(context.Context, *v1.ListMessagesRequest) (*v1.ListMessagesResponse, error)
+	// SendMessage sends a message to an existing conversation.
+	SendMessage(context.Context, *v1.SendMessageRequest) (*v1.SendMessageResponse, error)
 	// ListObjects lists the geofence objects.
 	ListObjects(context.Context, *v1.ListObjectsRequest) (*v1.ListObjectsResponse, error)
+	// SaveObject creates or updates a geofence object.
+	SaveObject(context.Context, *v1.SaveObjectRequest) (*v1.SaveObjectResponse, error)
+	// DeleteObject deletes a geofence object.
+	DeleteObject(context.Context, *v1.DeleteObjectRequest) (*v1.DeleteObjectResponse, error)
+	// GetObjectCustomFields returns the custom fields and values of an object.
+	GetObjectCustomFields(context.Context, *v1.GetObjectCustomFieldsRequest) (*v1.GetObjectCustomFieldsResponse, error)
+	// SaveObjectCustomFieldsValues sets custom field values of an object.
+	SaveObjectCustomFieldsValues(context.Context, *v1.SaveObjectCustomFieldsValuesRequest) (*v1.SaveObjectCustomFieldsValuesResponse, error)
+	// ListObjectGroups lists the object groups.
+	ListObjectGroups(context.Context, *v1.ListObjectGroupsRequest) (*v1.ListObjectGroupsResponse, error)
+	// SaveObjectGroup creates or updates an object group.
+	SaveObjectGroup(context.Context, *v1.SaveObjectGroupRequest) (*v1.SaveObjectGroupResponse, error)
+	// DeleteObjectGroup deletes an object group.
+	DeleteObjectGroup(context.Context, *v1.DeleteObjectGroupRequest) (*v1.DeleteObjectGroupResponse, error)
+	// ListPresets lists the permission presets of the company.
+	ListPresets(context.Context, *v1.ListPresetsRequest) (*v1.ListPresetsResponse, error)
+	// GetPreset returns the permissions and accessible resources of a preset.
+	GetPreset(context.Context, *v1.GetPresetRequest) (*v1.GetPresetResponse, error)
+	// CreatePreset creates a permission preset.
+	CreatePreset(context.Context, *v1.CreatePresetRequest) (*v1.CreatePresetResponse, error)
+	// EditPreset replaces the permissions and accessible resources of a preset.
+	EditPreset(context.Context, *v1.EditPresetRequest) (*v1.EditPresetResponse, error)
+	// DeletePreset deletes a preset, moving its users to custom presets.
+	DeletePreset(context.Context, *v1.DeletePresetRequest) (*v1.DeletePresetResponse, error)
+	// GetAvailablePresetPermissions returns the permissions that can be set in presets, with their default values.
+	GetAvailablePresetPermissions(context.Context, *v1.GetAvailablePresetPermissionsRequest) (*v1.GetAvailablePresetPermissionsResponse, error)
+	// GetReeferHistoricPeriod returns historic reefer readings of a unit for a period.
+	GetReeferHistoricPeriod(context.Context, *v1.GetReeferHistoricPeriodRequest) (*v1.GetReeferHistoricPeriodResponse, error)
+	// GetReeferHistoricPoint returns reefer readings of a unit at a point in time.
+	GetReeferHistoricPoint(context.Context, *v1.GetReeferHistoricPointRequest) (*v1.GetReeferHistoricPointResponse, error)
+	// ListReeferTemperatureData returns reefer compartment temperatures of a unit for a period.
+	ListReeferTemperatureData(context.Context, *v1.ListReeferTemperatureDataRequest) (*v1.ListReeferTemperatureDataResponse, error)
+	// ListReeferRunModes returns current and supported refrigerator run modes.
+	ListReeferRunModes(context.Context, *v1.ListReeferRunModesRequest) (*v1.ListReeferRunModesResponse, error)
+	// ChangeReeferSetpoint changes the setpoint temperature of a refrigerator compartment.
+	ChangeReeferSetpoint(context.Context, *v1.ChangeReeferSetpointRequest) (*v1.ChangeReeferSetpointResponse, error)
+	// ChangeReeferRunMode changes the run mode of a refrigerator.
+	ChangeReeferRunMode(context.Context, *v1.ChangeReeferRunModeRequest) (*v1.ChangeReeferRunModeResponse, error)
+	// SetReeferAlert creates a new reefer temperature alert.
+	SetReeferAlert(context.Context, *v1.SetReeferAlertRequest) (*v1.SetReeferAlertResponse, error)
+	// ListReeferAlerts returns reefer temperature alerts.
+	ListReeferAlerts(context.Context, *v1.ListReeferAlertsRequest) (*v1.ListReeferAlertsResponse, error)
+	// DeleteReeferAlert deletes a reefer temperature alert.
+	DeleteReeferAlert(context.Context, *v1.DeleteReeferAlertRequest) (*v1.DeleteReeferAlertResponse, error)
+	// ChangeReeferAlertUser transfers a reefer temperature alert to another user.
+	ChangeReeferAlertUser(context.Context, *v1.ChangeReeferAlertUserRequest) (*v1.ChangeReeferAlertUserResponse, error)
 	// ListRoutes returns list of stops and routes for units in the specified period.
 	ListRoutes(context.Context, *v1.ListRoutesRequest) (*v1.ListRoutesResponse, error)
+	// CreateRoutePlanningOrder creates a route planning order with its places.
+	CreateRoutePlanningOrder(context.Context, *v1.CreateRoutePlanningOrderRequest) (*v1.CreateRoutePlanningOrderResponse, error)
+	// AddRoutePlanningOrderPlaces adds places to an existing route planning order.
+	AddRoutePlanningOrderPlaces(context.Context, *v1.AddRoutePlanningOrderPlacesRequest) (*v1.AddRoutePlanningOrderPlacesResponse, error)
+	// GetRoutePlanningOrder returns a route planning order.
+	GetRoutePlanningOrder(context.Context, *v1.GetRoutePlanningOrderRequest) (*v1.GetRoutePlanningOrderResponse, error)
+	// ListRoutePlanningOrders returns a page of route planning orders.
+	ListRoutePlanningOrders(context.Context, *v1.ListRoutePlanningOrdersRequest) (*v1.ListRoutePlanningOrdersResponse, error)
+	// DeleteRoutePlanningOrders deletes route planning orders.
+	DeleteRoutePlanningOrders(context.Context, *v1.DeleteRoutePlanningOrdersRequest) (*v1.DeleteRoutePlanningOrdersResponse, error)
+	// GetRoutePlanningPlace returns a route planning place.
+	GetRoutePlanningPlace(context.Context, *v1.GetRoutePlanningPlaceRequest) (*v1.GetRoutePlanningPlaceResponse, error)
+	// ListRoutePlanningPlaces returns a page of route planning places of the given routes.
+	ListRoutePlanningPlaces(context.Context, *v1.ListRoutePlanningPlacesRequest) (*v1.ListRoutePlanningPlacesResponse, error)
+	// DeleteRoutePlanningPlaces deletes route planning places.
+	DeleteRoutePlanningPlaces(context.Context, *v1.DeleteRoutePlanningPlacesRequest) (*v1.DeleteRoutePlanningPlacesResponse, error)
+	// SaveRoutePlanningRoute creates or updates a planned route.
+	SaveRoutePlanningRoute(context.Context, *v1.SaveRoutePlanningRouteRequest) (*v1.SaveRoutePlanningRouteResponse, error)
+	// GetRoutePlanningRoute returns a planned route.
+	GetRoutePlanningRoute(context.Context, *v1.GetRoutePlanningRouteRequest) (*v1.GetRoutePlanningRouteResponse, error)
+	// ListRoutePlanningRoutes returns a page of planned routes.
+	ListRoutePlanningRoutes(context.Context, *v1.ListRoutePlanningRoutesRequest) (*v1.ListRoutePlanningRoutesResponse, error)
+	// SendRoutePlanningRouteToAssignee makes a planned route available in the driver application.
+	SendRoutePlanningRouteToAssignee(context.Context, *v1.SendRoutePlanningRouteToAssigneeRequest) (*v1.SendRoutePlanningRouteToAssigneeResponse, error)
+	// SetRoutePlanningRouteStartAddress sets the start address and departure time of a planned route.
+	SetRoutePlanningRouteStartAddress(context.Context, *v1.SetRoutePlanningRouteStartAddressRequest) (*v1.SetRoutePlanningRouteStartAddressResponse, error)
+	// SetRoutePlanningRouteEndAddress sets the end address of a planned route.
+	SetRoutePlanningRouteEndAddress(context.Context, *v1.SetRoutePlanningRouteEndAddressRequest) (*v1.SetRoutePlanningRouteEndAddressResponse, error)
+	// OptimizeRoutePlanningRoute starts the optimization of a planned route.
+	OptimizeRoutePlanningRoute(context.Context, *v1.OptimizeRoutePlanningRouteRequest) (*v1.OptimizeRoutePlanningRouteResponse, error)
+	// GetRoutePlanningOptimizationProgress returns the progress of a route optimization.
+	GetRoutePlanningOptimizationProgress(context.Context, *v1.GetRoutePlanningOptimizationProgressRequest) (*v1.GetRoutePlanningOptimizationProgressResponse, error)
+	// DeleteRoutePlanningRoutes deletes planned routes.
+	DeleteRoutePlanningRoutes(context.Context, *v1.DeleteRoutePlanningRoutesRequest) (*v1.DeleteRoutePlanningRoutesResponse, error)
+	// ListDriverDddFiles returns driver card DDD files created in a period.
+	ListDriverDddFiles(context.Context, *v1.ListDriverDddFilesRequest) (*v1.ListDriverDddFilesResponse, error)
+	// ListVehicleDddFiles returns vehicle unit DDD files created in a period.
+	ListVehicleDddFiles(context.Context, *v1.ListVehicleDddFilesRequest) (*v1.ListVehicleDddFilesResponse, error)
+	// DownloadDriverDdd downloads the contents of a driver card DDD file.
+	DownloadDriverDdd(context.Context, *v1.DownloadDriverDddRequest) (*v1.DownloadDriverDddResponse, error)
+	// DownloadVehicleDdd downloads the contents of a vehicle unit DDD file.
+	DownloadVehicleDdd(context.Context, *v1.DownloadVehicleDddRequest) (*v1.DownloadVehicleDddResponse, error)
+	// ListTasks lists the tasks of a unit.
+	ListTasks(context.Context, *v1.ListTasksRequest) (*v1.ListTasksResponse, error)
+	// CreateTask creates a task for a unit.
+	CreateTask(context.Context, *v1.CreateTaskRequest) (*v1.CreateTaskResponse, error)
+	// EditTask updates a task.
+	EditTask(context.Context, *v1.EditTaskRequest) (*v1.EditTaskResponse, error)
+	// DeleteTask deletes a task.
+	DeleteTask(context.Context, *v1.DeleteTaskRequest) (*v1.DeleteTaskResponse, error)
+	// DeleteTaskRoute deletes a task route and all of its tasks.
+	DeleteTaskRoute(context.Context, *v1.DeleteTaskRouteRequest) (*v1.DeleteTaskRouteResponse, error)
 	// ListTellTaleValues retrieves FMS tell tale values for a specified unit within a date range.
 	ListTellTaleValues(context.Context, *v1.ListTellTaleValuesRequest) (*v1.ListTellTaleValuesResponse, error)
+	// ListTrackingLinks lists the enabled tracking links created in the specified period.
+	ListTrackingLinks(context.Context, *v1.ListTrackingLinksRequest) (*v1.ListTrackingLinksResponse, error)
+	// CreateTrackingLink creates a public tracking link for units.
+	CreateTrackingLink(context.Context, *v1.CreateTrackingLinkRequest) (*v1.CreateTrackingLinkResponse, error)
+	// EditTrackingLink updates a tracking link.
+	EditTrackingLink(context.Context, *v1.EditTrackingLinkRequest) (*v1.EditTrackingLinkResponse, error)
+	// DeleteTrackingLink deletes a tracking link.
+	DeleteTrackingLink(context.Context, *v1.DeleteTrackingLinkRequest) (*v1.DeleteTrackingLinkResponse, error)
 	// ListUnits lists the units available for the current API key.
 	ListUnits(context.Context, *v1.ListUnitsRequest) (*v1.ListUnitsResponse, error)
+	// EditUnit edits the details of a unit.
+	EditUnit(context.Context, *v1.EditUnitRequest) (*v1.EditUnitResponse, error)
+	// InstallUnit creates a unit for a device.
+	InstallUnit(context.Context, *v1.InstallUnitRequest) (*v1.InstallUnitResponse, error)
+	// UninstallUnit removes the device from a unit.
+	UninstallUnit(context.Context, *v1.UninstallUnitRequest) (*v1.UninstallUnitResponse, error)
+	// ChangeUnitDevice changes the device assigned to a unit.
+	ChangeUnitDevice(context.Context, *v1.ChangeUnitDeviceRequest) (*v1.ChangeUnitDeviceResponse, error)
+	// ChangeUnitRelay requests a change of a unit relay state.
+	ChangeUnitRelay(context.Context, *v1.ChangeUnitRelayRequest) (*v1.ChangeUnitRelayResponse, error)
+	// ListAvailableUnitIcons lists the vehicle icons that can be assigned to units.
+	ListAvailableUnitIcons(context.Context, *v1.ListAvailableUnitIconsRequest) (*v1.ListAvailableUnitIconsResponse, error)
+	// ListUnitCustomFields returns the custom fields and values of a unit.
+	ListUnitCustomFields(context.Context, *v1.ListUnitCustomFieldsRequest) (*v1.ListUnitCustomFieldsResponse, error)
+	// SaveUnitCustomFieldValues sets custom field values of a unit.
+	SaveUnitCustomFieldValues(context.Context, *v1.SaveUnitCustomFieldValuesRequest) (*v1.SaveUnitCustomFieldValuesResponse, error)
+	// GetAvailableUnitCommands lists the remote commands available for a unit.
+	GetAvailableUnitCommands(context.Context, *v1.GetAvailableUnitCommandsRequest) (*v1.GetAvailableUnitCommandsResponse, error)
+	// ExecuteUnitCommand executes a remote command on a unit.
+	ExecuteUnitCommand(context.Context, *v1.ExecuteUnitCommandRequest) (*v1.ExecuteUnitCommandResponse, error)
 	// ListUnitGroups lists unit groups.
 	ListUnitGroups(context.Context, *v1.ListUnitGroupsRequest) (*v1.ListUnitGroupsResponse, error)
 	// ListUnitsInGroup lists units in a group.
 	ListUnitsInGroup(context.Context, *v1.ListUnitsInGroupRequest) (*v1.ListUnitsInGroupResponse, error)
+	// SaveUnitGroup creates or updates a unit group.
+	SaveUnitGroup(context.Context, *v1.SaveUnitGroupRequest) (*v1.SaveUnitGroupResponse, error)
+	// DeleteUnitGroup deletes a unit group.
+	DeleteUnitGroup(context.Context, *v1.DeleteUnitGroupRequest) (*v1.DeleteUnitGroupResponse, error)
+	// AttachUnitToGroup attaches a unit to a group.
+	AttachUnitToGroup(context.Context, *v1.AttachUnitToGroupRequest) (*v1.AttachUnitToGroupResponse, error)
+	// DetachUnitFromGroup detaches a unit from a group.
+	DetachUnitFromGroup(context.Context, *v1.DetachUnitFromGroupRequest) (*v1.DetachUnitFromGroupResponse, error)
+	// ClearUnitGroups detaches a unit from all groups.
+	ClearUnitGroups(context.Context, *v1.ClearUnitGroupsRequest) (*v1.ClearUnitGroupsResponse, error)
 	// GetCanDataPoint returns CAN data at a specific datetime.
 	GetCanDataPoint(context.Context, *v1.GetCanDataPointRequest) (*v1.GetCanDataPointResponse, error)
 	// ListCanPeriodData returns CAN data for a given period.
@@ -142,6 +800,30 @@ type MaponApiClient interface {
 	ListIgnitions(context.Context, *v1.ListIgnitionsRequest) (*v1.ListIgnitionsResponse, error)
 	// ListTemperatures returns measured temperature points.
 	ListTemperatures(context.Context, *v1.ListTemperaturesRequest) (*v1.ListTemperaturesResponse, error)
+	// ListUsers lists the users of the company.
+	ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error)
+	// CreateUser creates a user.
+	CreateUser(context.Context, *v1.CreateUserRequest) (*v1.CreateUserResponse, error)
+	// UpdateUser updates the fields of a user that are set in the request.
+	UpdateUser(context.Context, *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error)
+	// DeleteUser deletes a user.
+	DeleteUser(context.Context, *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error)
+	// ChangeUserPassword changes the password of a user.
+	ChangeUserPassword(context.Context, *v1.ChangeUserPasswordRequest) (*v1.ChangeUserPasswordResponse, error)
+	// LinkUserToDriver assigns a user to a driver.
+	LinkUserToDriver(context.Context, *v1.LinkUserToDriverRequest) (*v1.LinkUserToDriverResponse, error)
+	// UnlinkUserFromDriver removes the user assigned to a driver.
+	UnlinkUserFromDriver(context.Context, *v1.UnlinkUserFromDriverRequest) (*v1.UnlinkUserFromDriverResponse, error)
+	// ListVehicleInspections lists vehicle inspections with their checklist results.
+	ListVehicleInspections(context.Context, *v1.ListVehicleInspectionsRequest) (*v1.ListVehicleInspectionsResponse, error)
+	// CreateVehiclePinDeviceMappings maps PIN keypad devices to units.
+	CreateVehiclePinDeviceMappings(context.Context, *v1.CreateVehiclePinDeviceMappingsRequest) (*v1.CreateVehiclePinDeviceMappingsResponse, error)
+	// DeleteVehiclePinDeviceMappings deletes the mappings of PIN keypad devices.
+	DeleteVehiclePinDeviceMappings(context.Context, *v1.DeleteVehiclePinDeviceMappingsRequest) (*v1.DeleteVehiclePinDeviceMappingsResponse, error)
+	// CreateDriverPins creates PIN codes for drivers without a PIN.
+	CreateDriverPins(context.Context, *v1.CreateDriverPinsRequest) (*v1.CreateDriverPinsResponse, error)
+	// UpdateDriverPins updates the PIN codes of drivers with an existing PIN.
+	UpdateDriverPins(context.Context, *v1.UpdateDriverPinsRequest) (*v1.UpdateDriverPinsResponse, error)
 }
 
 // NewMaponApiClient constructs a client for the wayplatform.connect.mapon.v1.MaponApi service. By
@@ -161,6 +843,132 @@ func NewMaponApiClient(httpClient connect.HTTPClient, baseURL string, opts ...co
 			connect.WithSchema(maponApiMethods.ByName("ListAlerts")),
 			connect.WithClientOptions(opts...),
 		),
+		listAlertSetups: connect.NewClient[v1.ListAlertSetupsRequest, v1.ListAlertSetupsResponse](
+			httpClient,
+			baseURL+MaponApiListAlertSetupsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListAlertSetups")),
+			connect.WithClientOptions(opts...),
+		),
+		getAlertSetupTypes: connect.NewClient[v1.GetAlertSetupTypesRequest, v1.GetAlertSetupTypesResponse](
+			httpClient,
+			baseURL+MaponApiGetAlertSetupTypesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetAlertSetupTypes")),
+			connect.WithClientOptions(opts...),
+		),
+		getAlertSetupFields: connect.NewClient[v1.GetAlertSetupFieldsRequest, v1.GetAlertSetupFieldsResponse](
+			httpClient,
+			baseURL+MaponApiGetAlertSetupFieldsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetAlertSetupFields")),
+			connect.WithClientOptions(opts...),
+		),
+		storeAlertSetup: connect.NewClient[v1.StoreAlertSetupRequest, v1.StoreAlertSetupResponse](
+			httpClient,
+			baseURL+MaponApiStoreAlertSetupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("StoreAlertSetup")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAlertSetup: connect.NewClient[v1.DeleteAlertSetupRequest, v1.DeleteAlertSetupResponse](
+			httpClient,
+			baseURL+MaponApiDeleteAlertSetupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteAlertSetup")),
+			connect.WithClientOptions(opts...),
+		),
+		listBleTags: connect.NewClient[v1.ListBleTagsRequest, v1.ListBleTagsResponse](
+			httpClient,
+			baseURL+MaponApiListBleTagsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListBleTags")),
+			connect.WithClientOptions(opts...),
+		),
+		listBleTagScans: connect.NewClient[v1.ListBleTagScansRequest, v1.ListBleTagScansResponse](
+			httpClient,
+			baseURL+MaponApiListBleTagScansProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListBleTagScans")),
+			connect.WithClientOptions(opts...),
+		),
+		listBleTagLocations: connect.NewClient[v1.ListBleTagLocationsRequest, v1.ListBleTagLocationsResponse](
+			httpClient,
+			baseURL+MaponApiListBleTagLocationsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListBleTagLocations")),
+			connect.WithClientOptions(opts...),
+		),
+		getBleTagHistoryPoint: connect.NewClient[v1.GetBleTagHistoryPointRequest, v1.GetBleTagHistoryPointResponse](
+			httpClient,
+			baseURL+MaponApiGetBleTagHistoryPointProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetBleTagHistoryPoint")),
+			connect.WithClientOptions(opts...),
+		),
+		getCompany: connect.NewClient[v1.GetCompanyRequest, v1.GetCompanyResponse](
+			httpClient,
+			baseURL+MaponApiGetCompanyProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetCompany")),
+			connect.WithClientOptions(opts...),
+		),
+		listCompanyClients: connect.NewClient[v1.ListCompanyClientsRequest, v1.ListCompanyClientsResponse](
+			httpClient,
+			baseURL+MaponApiListCompanyClientsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListCompanyClients")),
+			connect.WithClientOptions(opts...),
+		),
+		createCompanyClient: connect.NewClient[v1.CreateCompanyClientRequest, v1.CreateCompanyClientResponse](
+			httpClient,
+			baseURL+MaponApiCreateCompanyClientProcedure,
+			connect.WithSchema(maponApiMethods.ByName("CreateCompanyClient")),
+			connect.WithClientOptions(opts...),
+		),
+		listCustomLayers: connect.NewClient[v1.ListCustomLayersRequest, v1.ListCustomLayersResponse](
+			httpClient,
+			baseURL+MaponApiListCustomLayersProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListCustomLayers")),
+			connect.WithClientOptions(opts...),
+		),
+		saveCustomLayer: connect.NewClient[v1.SaveCustomLayerRequest, v1.SaveCustomLayerResponse](
+			httpClient,
+			baseURL+MaponApiSaveCustomLayerProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SaveCustomLayer")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCustomLayer: connect.NewClient[v1.DeleteCustomLayerRequest, v1.DeleteCustomLayerResponse](
+			httpClient,
+			baseURL+MaponApiDeleteCustomLayerProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteCustomLayer")),
+			connect.WithClientOptions(opts...),
+		),
+		listCustomLayerGeometries: connect.NewClient[v1.ListCustomLayerGeometriesRequest, v1.ListCustomLayerGeometriesResponse](
+			httpClient,
+			baseURL+MaponApiListCustomLayerGeometriesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListCustomLayerGeometries")),
+			connect.WithClientOptions(opts...),
+		),
+		addCustomLayerGeometries: connect.NewClient[v1.AddCustomLayerGeometriesRequest, v1.AddCustomLayerGeometriesResponse](
+			httpClient,
+			baseURL+MaponApiAddCustomLayerGeometriesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("AddCustomLayerGeometries")),
+			connect.WithClientOptions(opts...),
+		),
+		editCustomLayerGeometry: connect.NewClient[v1.EditCustomLayerGeometryRequest, v1.EditCustomLayerGeometryResponse](
+			httpClient,
+			baseURL+MaponApiEditCustomLayerGeometryProcedure,
+			connect.WithSchema(maponApiMethods.ByName("EditCustomLayerGeometry")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCustomLayerGeometry: connect.NewClient[v1.DeleteCustomLayerGeometryRequest, v1.DeleteCustomLayerGeometryResponse](
+			httpClient,
+			baseURL+MaponApiDeleteCustomLayerGeometryProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteCustomLayerGeometry")),
+			connect.WithClientOptions(opts...),
+		),
+		listCustomTargets: connect.NewClient[v1.ListCustomTargetsRequest, v1.ListCustomTargetsResponse](
+			httpClient,
+			baseURL+MaponApiListCustomTargetsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListCustomTargets")),
+			connect.WithClientOptions(opts...),
+		),
+		listCustomTargetValues: connect.NewClient[v1.ListCustomTargetValuesRequest, v1.ListCustomTargetValuesResponse](
+			httpClient,
+			baseURL+MaponApiListCustomTargetValuesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListCustomTargetValues")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteDataForward: connect.NewClient[v1.DeleteDataForwardRequest, v1.DeleteDataForwardResponse](
 			httpClient,
 			baseURL+MaponApiDeleteDataForwardProcedure,
@@ -179,236 +987,2274 @@ func NewMaponApiClient(httpClient connect.HTTPClient, baseURL string, opts ...co
 			connect.WithSchema(maponApiMethods.ByName("SaveDataForward")),
 			connect.WithClientOptions(opts...),
 		),
+		listDevices: connect.NewClient[v1.ListDevicesRequest, v1.ListDevicesResponse](
+			httpClient,
+			baseURL+MaponApiListDevicesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListDevices")),
+			connect.WithClientOptions(opts...),
+		),
+		createDevice: connect.NewClient[v1.CreateDeviceRequest, v1.CreateDeviceResponse](
+			httpClient,
+			baseURL+MaponApiCreateDeviceProcedure,
+			connect.WithSchema(maponApiMethods.ByName("CreateDevice")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeviceModels: connect.NewClient[v1.ListDeviceModelsRequest, v1.ListDeviceModelsResponse](
+			httpClient,
+			baseURL+MaponApiListDeviceModelsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListDeviceModels")),
+			connect.WithClientOptions(opts...),
+		),
+		getDeviceCommands: connect.NewClient[v1.GetDeviceCommandsRequest, v1.GetDeviceCommandsResponse](
+			httpClient,
+			baseURL+MaponApiGetDeviceCommandsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetDeviceCommands")),
+			connect.WithClientOptions(opts...),
+		),
+		sendDeviceSmsCommand: connect.NewClient[v1.SendDeviceSmsCommandRequest, v1.SendDeviceSmsCommandResponse](
+			httpClient,
+			baseURL+MaponApiSendDeviceSmsCommandProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SendDeviceSmsCommand")),
+			connect.WithClientOptions(opts...),
+		),
+		sendDeviceTcpCommand: connect.NewClient[v1.SendDeviceTcpCommandRequest, v1.SendDeviceTcpCommandResponse](
+			httpClient,
+			baseURL+MaponApiSendDeviceTcpCommandProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SendDeviceTcpCommand")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeviceSmsHistory: connect.NewClient[v1.ListDeviceSmsHistoryRequest, v1.ListDeviceSmsHistoryResponse](
+			httpClient,
+			baseURL+MaponApiListDeviceSmsHistoryProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListDeviceSmsHistory")),
+			connect.WithClientOptions(opts...),
+		),
 		listDrivers: connect.NewClient[v1.ListDriversRequest, v1.ListDriversResponse](
 			httpClient,
 			baseURL+MaponApiListDriversProcedure,
 			connect.WithSchema(maponApiMethods.ByName("ListDrivers")),
 			connect.WithClientOptions(opts...),
 		),
-		listObjects: connect.NewClient[v1.ListObjectsRequest, v1.ListObjectsResponse](
+		createDriver: connect.NewClient[v1.CreateDriverRequest, v1.CreateDriverResponse](
 			httpClient,
-			baseURL+MaponApiListObjectsProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListObjects")),
+			baseURL+MaponApiCreateDriverProcedure,
+			connect.WithSchema(maponApiMethods.ByName("CreateDriver")),
 			connect.WithClientOptions(opts...),
 		),
-		listRoutes: connect.NewClient[v1.ListRoutesRequest, v1.ListRoutesResponse](
+		updateDriver: connect.NewClient[v1.UpdateDriverRequest, v1.UpdateDriverResponse](
 			httpClient,
-			baseURL+MaponApiListRoutesProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListRoutes")),
+			baseURL+MaponApiUpdateDriverProcedure,
+			connect.WithSchema(maponApiMethods.ByName("UpdateDriver")),
 			connect.WithClientOptions(opts...),
 		),
-		listTellTaleValues: connect.NewClient[v1.ListTellTaleValuesRequest, v1.ListTellTaleValuesResponse](
+		deleteDriver: connect.NewClient[v1.DeleteDriverRequest, v1.DeleteDriverResponse](
 			httpClient,
-			baseURL+MaponApiListTellTaleValuesProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListTellTaleValues")),
+			baseURL+MaponApiDeleteDriverProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteDriver")),
 			connect.WithClientOptions(opts...),
 		),
-		listUnits: connect.NewClient[v1.ListUnitsRequest, v1.ListUnitsResponse](
+		changeDriverPassword: connect.NewClient[v1.ChangeDriverPasswordRequest, v1.ChangeDriverPasswordResponse](
 			httpClient,
-			baseURL+MaponApiListUnitsProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListUnits")),
+			baseURL+MaponApiChangeDriverPasswordProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ChangeDriverPassword")),
 			connect.WithClientOptions(opts...),
 		),
-		listUnitGroups: connect.NewClient[v1.ListUnitGroupsRequest, v1.ListUnitGroupsResponse](
+		associateExternalDriver: connect.NewClient[v1.AssociateExternalDriverRequest, v1.AssociateExternalDriverResponse](
 			httpClient,
-			baseURL+MaponApiListUnitGroupsProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListUnitGroups")),
+			baseURL+MaponApiAssociateExternalDriverProcedure,
+			connect.WithSchema(maponApiMethods.ByName("AssociateExternalDriver")),
 			connect.WithClientOptions(opts...),
 		),
-		listUnitsInGroup: connect.NewClient[v1.ListUnitsInGroupRequest, v1.ListUnitsInGroupResponse](
+		linkDriverUser: connect.NewClient[v1.LinkDriverUserRequest, v1.LinkDriverUserResponse](
 			httpClient,
-			baseURL+MaponApiListUnitsInGroupProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListUnitsInGroup")),
+			baseURL+MaponApiLinkDriverUserProcedure,
+			connect.WithSchema(maponApiMethods.ByName("LinkDriverUser")),
 			connect.WithClientOptions(opts...),
 		),
-		getCanDataPoint: connect.NewClient[v1.GetCanDataPointRequest, v1.GetCanDataPointResponse](
+		unlinkDriverUser: connect.NewClient[v1.UnlinkDriverUserRequest, v1.UnlinkDriverUserResponse](
 			httpClient,
-			baseURL+MaponApiGetCanDataPointProcedure,
-			connect.WithSchema(maponApiMethods.ByName("GetCanDataPoint")),
+			baseURL+MaponApiUnlinkDriverUserProcedure,
+			connect.WithSchema(maponApiMethods.ByName("UnlinkDriverUser")),
 			connect.WithClientOptions(opts...),
 		),
-		listCanPeriodData: connect.NewClient[v1.ListCanPeriodDataRequest, v1.ListCanPeriodDataResponse](
+		listDriverCustomFields: connect.NewClient[v1.ListDriverCustomFieldsRequest, v1.ListDriverCustomFieldsResponse](
 			httpClient,
-			baseURL+MaponApiListCanPeriodDataProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListCanPeriodData")),
+			baseURL+MaponApiListDriverCustomFieldsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListDriverCustomFields")),
 			connect.WithClientOptions(opts...),
 		),
-		getUnitDebugInfo: connect.NewClient[v1.GetUnitDebugInfoRequest, v1.GetUnitDebugInfoResponse](
+		saveDriverCustomFields: connect.NewClient[v1.SaveDriverCustomFieldsRequest, v1.SaveDriverCustomFieldsResponse](
 			httpClient,
-			baseURL+MaponApiGetUnitDebugInfoProcedure,
-			connect.WithSchema(maponApiMethods.ByName("GetUnitDebugInfo")),
+			baseURL+MaponApiSaveDriverCustomFieldsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SaveDriverCustomFields")),
 			connect.WithClientOptions(opts...),
 		),
-		listDigitalInputs: connect.NewClient[v1.ListDigitalInputsRequest, v1.ListDigitalInputsResponse](
+		saveDriverCustomFieldValues: connect.NewClient[v1.SaveDriverCustomFieldValuesRequest, v1.SaveDriverCustomFieldValuesResponse](
 			httpClient,
-			baseURL+MaponApiListDigitalInputsProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListDigitalInputs")),
+			baseURL+MaponApiSaveDriverCustomFieldValuesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SaveDriverCustomFieldValues")),
 			connect.WithClientOptions(opts...),
 		),
-		listDigitalInputsExtended: connect.NewClient[v1.ListDigitalInputsExtendedRequest, v1.ListDigitalInputsExtendedResponse](
+		deleteDriverCustomFields: connect.NewClient[v1.DeleteDriverCustomFieldsRequest, v1.DeleteDriverCustomFieldsResponse](
 			httpClient,
-			baseURL+MaponApiListDigitalInputsExtendedProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListDigitalInputsExtended")),
+			baseURL+MaponApiDeleteDriverCustomFieldsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteDriverCustomFields")),
 			connect.WithClientOptions(opts...),
 		),
-		getDrivingTimeExtended: connect.NewClient[v1.GetDrivingTimeExtendedRequest, v1.GetDrivingTimeExtendedResponse](
+		listDriverDailyActivities: connect.NewClient[v1.ListDriverDailyActivitiesRequest, v1.ListDriverDailyActivitiesResponse](
 			httpClient,
-			baseURL+MaponApiGetDrivingTimeExtendedProcedure,
-			connect.WithSchema(maponApiMethods.ByName("GetDrivingTimeExtended")),
+			baseURL+MaponApiListDriverDailyActivitiesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListDriverDailyActivities")),
 			connect.WithClientOptions(opts...),
 		),
-		getUnitFields: connect.NewClient[v1.GetUnitFieldsRequest, v1.GetUnitFieldsResponse](
+		getDriverBehaviourReportDrivers: connect.NewClient[v1.GetDriverBehaviourReportDriversRequest, v1.GetDriverBehaviourReportDriversResponse](
 			httpClient,
-			baseURL+MaponApiGetUnitFieldsProcedure,
-			connect.WithSchema(maponApiMethods.ByName("GetUnitFields")),
+			baseURL+MaponApiGetDriverBehaviourReportDriversProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetDriverBehaviourReportDrivers")),
 			connect.WithClientOptions(opts...),
 		),
-		getHistoryPointData: connect.NewClient[v1.GetHistoryPointDataRequest, v1.GetHistoryPointDataResponse](
+		getDriverBehaviourReportUnits: connect.NewClient[v1.GetDriverBehaviourReportUnitsRequest, v1.GetDriverBehaviourReportUnitsResponse](
 			httpClient,
-			baseURL+MaponApiGetHistoryPointDataProcedure,
-			connect.WithSchema(maponApiMethods.ByName("GetHistoryPointData")),
+			baseURL+MaponApiGetDriverBehaviourReportUnitsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetDriverBehaviourReportUnits")),
 			connect.WithClientOptions(opts...),
 		),
-		listHumidity: connect.NewClient[v1.ListHumidityRequest, v1.ListHumidityResponse](
+		listDriverGroups: connect.NewClient[v1.ListDriverGroupsRequest, v1.ListDriverGroupsResponse](
 			httpClient,
-			baseURL+MaponApiListHumidityProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListHumidity")),
+			baseURL+MaponApiListDriverGroupsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListDriverGroups")),
 			connect.WithClientOptions(opts...),
 		),
-		listIbuttons: connect.NewClient[v1.ListIbuttonsRequest, v1.ListIbuttonsResponse](
+		listDriversInGroup: connect.NewClient[v1.ListDriversInGroupRequest, v1.ListDriversInGroupResponse](
 			httpClient,
-			baseURL+MaponApiListIbuttonsProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListIbuttons")),
+			baseURL+MaponApiListDriversInGroupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListDriversInGroup")),
 			connect.WithClientOptions(opts...),
 		),
-		listIgnitions: connect.NewClient[v1.ListIgnitionsRequest, v1.ListIgnitionsResponse](
+		saveDriverGroup: connect.NewClient[v1.SaveDriverGroupRequest, v1.SaveDriverGroupResponse](
 			httpClient,
-			baseURL+MaponApiListIgnitionsProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListIgnitions")),
+			baseURL+MaponApiSaveDriverGroupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SaveDriverGroup")),
 			connect.WithClientOptions(opts...),
 		),
-		listTemperatures: connect.NewClient[v1.ListTemperaturesRequest, v1.ListTemperaturesResponse](
+		deleteDriverGroup: connect.NewClient[v1.DeleteDriverGroupRequest, v1.DeleteDriverGroupResponse](
 			httpClient,
-			baseURL+MaponApiListTemperaturesProcedure,
-			connect.WithSchema(maponApiMethods.ByName("ListTemperatures")),
+			baseURL+MaponApiDeleteDriverGroupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteDriverGroup")),
 			connect.WithClientOptions(opts...),
 		),
-	}
-}
-
-// maponApiClient implements MaponApiClient.
-type maponApiClient struct {
-	listAlerts                *connect.Client[v1.ListAlertsRequest, v1.ListAlertsResponse]
-	deleteDataForward         *connect.Client[v1.DeleteDataForwardRequest, v1.DeleteDataForwardResponse]
-	listDataForwards          *connect.Client[v1.ListDataForwardsRequest, v1.ListDataForwardsResponse]
-	saveDataForward           *connect.Client[v1.SaveDataForwardRequest, v1.SaveDataForwardResponse]
-	listDrivers               *connect.Client[v1.ListDriversRequest, v1.ListDriversResponse]
-	listObjects               *connect.Client[v1.ListObjectsRequest, v1.ListObjectsResponse]
-	listRoutes                *connect.Client[v1.ListRoutesRequest, v1.ListRoutesResponse]
-	listTellTaleValues        *connect.Client[v1.ListTellTaleValuesRequest, v1.ListTellTaleValuesResponse]
-	listUnits                 *connect.Client[v1.ListUnitsRequest, v1.ListUnitsResponse]
-	listUnitGroups            *connect.Client[v1.ListUnitGroupsRequest, v1.ListUnitGroupsResponse]
-	listUnitsInGroup          *connect.Client[v1.ListUnitsInGroupRequest, v1.ListUnitsInGroupResponse]
-	getCanDataPoint           *connect.Client[v1.GetCanDataPointRequest, v1.GetCanDataPointResponse]
-	listCanPeriodData         *connect.Client[v1.ListCanPeriodDataRequest, v1.ListCanPeriodDataResponse]
-	getUnitDebugInfo          *connect.Client[v1.GetUnitDebugInfoRequest, v1.GetUnitDebugInfoResponse]
-	listDigitalInputs         *connect.Client[v1.ListDigitalInputsRequest, v1.ListDigitalInputsResponse]
-	listDigitalInputsExtended *connect.Client[v1.ListDigitalInputsExtendedRequest, v1.ListDigitalInputsExtendedResponse]
-	getDrivingTimeExtended    *connect.Client[v1.GetDrivingTimeExtendedRequest, v1.GetDrivingTimeExtendedResponse]
-	getUnitFields             *connect.Client[v1.GetUnitFieldsRequest, v1.GetUnitFieldsResponse]
-	getHistoryPointData       *connect.Client[v1.GetHistoryPointDataRequest, v1.GetHistoryPointDataResponse]
-	listHumidity              *connect.Client[v1.ListHumidityRequest, v1.ListHumidityResponse]
-	listIbuttons              *connect.Client[v1.ListIbuttonsRequest, v1.ListIbuttonsResponse]
-	listIgnitions             *connect.Client[v1.ListIgnitionsRequest, v1.ListIgnitionsResponse]
-	listTemperatures          *connect.Client[v1.ListTemperaturesRequest, v1.ListTemperaturesResponse]
-}
-
-// ListAlerts calls wayplatform.connect.mapon.v1.MaponApi.ListAlerts.
-func (c *maponApiClient) ListAlerts(ctx context.Context, req *v1.ListAlertsRequest) (*v1.ListAlertsResponse, error) {
-	response, err := c.listAlerts.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// DeleteDataForward calls wayplatform.connect.mapon.v1.MaponApi.DeleteDataForward.
-func (c *maponApiClient) DeleteDataForward(ctx context.Context, req *v1.DeleteDataForwardRequest) (*v1.DeleteDataForwardResponse, error) {
-	response, err := c.deleteDataForward.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// ListDataForwards calls wayplatform.connect.mapon.v1.MaponApi.ListDataForwards.
-func (c *maponApiClient) ListDataForwards(ctx context.Context, req *v1.ListDataForwardsRequest) (*v1.ListDataForwardsResponse, error) {
-	response, err := c.listDataForwards.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// SaveDataForward calls wayplatform.connect.mapon.v1.MaponApi.SaveDataForward.
-func (c *maponApiClient) SaveDataForward(ctx context.Context, req *v1.SaveDataForwardRequest) (*v1.SaveDataForwardResponse, error) {
-	response, err := c.saveDataForward.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// ListDrivers calls wayplatform.connect.mapon.v1.MaponApi.ListDrivers.
-func (c *maponApiClient) ListDrivers(ctx context.Context, req *v1.ListDriversRequest) (*v1.ListDriversResponse, error) {
-	response, err := c.listDrivers.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// ListObjects calls wayplatform.connect.mapon.v1.MaponApi.ListObjects.
-func (c *maponApiClient) ListObjects(ctx context.Context, req *v1.ListObjectsRequest) (*v1.ListObjectsResponse, error) {
-	response, err := c.listObjects.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// ListRoutes calls wayplatform.connect.mapon.v1.MaponApi.ListRoutes.
-func (c *maponApiClient) ListRoutes(ctx context.Context, req *v1.ListRoutesRequest) (*v1.ListRoutesResponse, error) {
-	response, err := c.listRoutes.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// ListTellTaleValues calls wayplatform.connect.mapon.v1.MaponApi.ListTellTaleValues.
-func (c *maponApiClient) ListTellTaleValues(ctx context.Context, req *v1.ListTellTaleValuesRequest) (*v1.ListTellTaleValuesResponse, error) {
-	response, err := c.listTellTaleValues.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// ListUnits calls wayplatform.connect.mapon.v1.MaponApi.ListUnits.
-func (c *maponApiClient) ListUnits(ctx context.Context, req *v1.ListUnitsRequest) (*v1.ListUnitsResponse, error) {
-	response, err := c.listUnits.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// ListUnitGroups calls wayplatform.connect.mapon.v1.MaponApi.ListUnitGroups.
-func (c *maponApiClient) ListUnitGroups(ctx context.Context, req *v1.ListUnitGroupsRequest) (*v1.ListUnitGroupsResponse, error) {
-	response, err := c.listUnitGroups.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
+		addDriverToGroup: connect.NewClient[v1.AddDriverToGroupRequest, v1.AddDriverToGroupResponse](
+			httpClient,
+			baseURL+MaponApiAddDriverToGroupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("AddDriverToGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		removeDriverFromGroup: connect.NewClient[v1.RemoveDriverFromGroupRequest, v1.RemoveDriverFromGroupResponse](
+			httpClient,
+			baseURL+MaponApiRemoveDriverFromGroupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("RemoveDriverFromGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		clearDriverGroups: connect.NewClient[v1.ClearDriverGroupsRequest, v1.ClearDriverGroupsResponse](
+			httpClient,
+			baseURL+MaponApiClearDriverGroupsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ClearDriverGroups")),
+			connect.WithClientOptions(opts...),
+		),
+		listFuelData: connect.NewClient[v1.ListFuelDataRequest, v1.ListFuelDataResponse](
+			httpClient,
+			baseURL+MaponApiListFuelDataProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListFuelData")),
+			connect.WithClientOptions(opts...),
+		),
+		listFuelChanges: connect.NewClient[v1.ListFuelChangesRequest, v1.ListFuelChangesResponse](
+			httpClient,
+			baseURL+MaponApiListFuelChangesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListFuelChanges")),
+			connect.WithClientOptions(opts...),
+		),
+		getFuelSummary: connect.NewClient[v1.GetFuelSummaryRequest, v1.GetFuelSummaryResponse](
+			httpClient,
+			baseURL+MaponApiGetFuelSummaryProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetFuelSummary")),
+			connect.WithClientOptions(opts...),
+		),
+		listFuelChecks: connect.NewClient[v1.ListFuelChecksRequest, v1.ListFuelChecksResponse](
+			httpClient,
+			baseURL+MaponApiListFuelChecksProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListFuelChecks")),
+			connect.WithClientOptions(opts...),
+		),
+		addFuelCheck: connect.NewClient[v1.AddFuelCheckRequest, v1.AddFuelCheckResponse](
+			httpClient,
+			baseURL+MaponApiAddFuelCheckProcedure,
+			connect.WithSchema(maponApiMethods.ByName("AddFuelCheck")),
+			connect.WithClientOptions(opts...),
+		),
+		editFuelCheck: connect.NewClient[v1.EditFuelCheckRequest, v1.EditFuelCheckResponse](
+			httpClient,
+			baseURL+MaponApiEditFuelCheckProcedure,
+			connect.WithSchema(maponApiMethods.ByName("EditFuelCheck")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFuelCheck: connect.NewClient[v1.DeleteFuelCheckRequest, v1.DeleteFuelCheckResponse](
+			httpClient,
+			baseURL+MaponApiDeleteFuelCheckProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteFuelCheck")),
+			connect.WithClientOptions(opts...),
+		),
+		addFuelCard: connect.NewClient[v1.AddFuelCardRequest, v1.AddFuelCardResponse](
+			httpClient,
+			baseURL+MaponApiAddFuelCardProcedure,
+			connect.WithSchema(maponApiMethods.ByName("AddFuelCard")),
+			connect.WithClientOptions(opts...),
+		),
+		updateFuelCard: connect.NewClient[v1.UpdateFuelCardRequest, v1.UpdateFuelCardResponse](
+			httpClient,
+			baseURL+MaponApiUpdateFuelCardProcedure,
+			connect.WithSchema(maponApiMethods.ByName("UpdateFuelCard")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFuelCard: connect.NewClient[v1.DeleteFuelCardRequest, v1.DeleteFuelCardResponse](
+			httpClient,
+			baseURL+MaponApiDeleteFuelCardProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteFuelCard")),
+			connect.WithClientOptions(opts...),
+		),
+		listMessagingChannels: connect.NewClient[v1.ListMessagingChannelsRequest, v1.ListMessagingChannelsResponse](
+			httpClient,
+			baseURL+MaponApiListMessagingChannelsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListMessagingChannels")),
+			connect.WithClientOptions(opts...),
+		),
+		listMessagingConversations: connect.NewClient[v1.ListMessagingConversationsRequest, v1.ListMessagingConversationsResponse](
+			httpClient,
+			baseURL+MaponApiListMessagingConversationsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListMessagingConversations")),
+			connect.WithClientOptions(opts...),
+		),
+		createMessagingConversation: connect.NewClient[v1.CreateMessagingConversationRequest, v1.CreateMessagingConversationResponse](
+			httpClient,
+			baseURL+MaponApiCreateMessagingConversationProcedure,
+			connect.WithSchema(maponApiMethods.ByName("CreateMessagingConversation")),
+			connect.WithClientOptions(opts...),
+		),
+		listMessages: connect.NewClient[v1.ListMessagesRequest, v1.ListMessagesResponse](
+			httpClient,
+			baseURL+MaponApiListMessagesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListMessages")),
+			connect.WithClientOptions(opts...),
+		),
+		sendMessage: connect.NewClient[v1.SendMessageRequest, v1.SendMessageResponse](
+			httpClient,
+			baseURL+MaponApiSendMessageProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SendMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		listObjects: connect.NewClient[v1.ListObjectsRequest, v1.ListObjectsResponse](
+			httpClient,
+			baseURL+MaponApiListObjectsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListObjects")),
+			connect.WithClientOptions(opts...),
+		),
+		saveObject: connect.NewClient[v1.SaveObjectRequest, v1.SaveObjectResponse](
+			httpClient,
+			baseURL+MaponApiSaveObjectProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SaveObject")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteObject: connect.NewClient[v1.DeleteObjectRequest, v1.DeleteObjectResponse](
+			httpClient,
+			baseURL+MaponApiDeleteObjectProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteObject")),
+			connect.WithClientOptions(opts...),
+		),
+		getObjectCustomFields: connect.NewClient[v1.GetObjectCustomFieldsRequest, v1.GetObjectCustomFieldsResponse](
+			httpClient,
+			baseURL+MaponApiGetObjectCustomFieldsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetObjectCustomFields")),
+			connect.WithClientOptions(opts...),
+		),
+		saveObjectCustomFieldsValues: connect.NewClient[v1.SaveObjectCustomFieldsValuesRequest, v1.SaveObjectCustomFieldsValuesResponse](
+			httpClient,
+			baseURL+MaponApiSaveObjectCustomFieldsValuesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SaveObjectCustomFieldsValues")),
+			connect.WithClientOptions(opts...),
+		),
+		listObjectGroups: connect.NewClient[v1.ListObjectGroupsRequest, v1.ListObjectGroupsResponse](
+			httpClient,
+			baseURL+MaponApiListObjectGroupsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListObjectGroups")),
+			connect.WithClientOptions(opts...),
+		),
+		saveObjectGroup: connect.NewClient[v1.SaveObjectGroupRequest, v1.SaveObjectGroupResponse](
+			httpClient,
+			baseURL+MaponApiSaveObjectGroupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SaveObjectGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteObjectGroup: connect.NewClient[v1.DeleteObjectGroupRequest, v1.DeleteObjectGroupResponse](
+			httpClient,
+			baseURL+MaponApiDeleteObjectGroupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteObjectGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		listPresets: connect.NewClient[v1.ListPresetsRequest, v1.ListPresetsResponse](
+			httpClient,
+			baseURL+MaponApiListPresetsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListPresets")),
+			connect.WithClientOptions(opts...),
+		),
+		getPreset: connect.NewClient[v1.GetPresetRequest, v1.GetPresetResponse](
+			httpClient,
+			baseURL+MaponApiGetPresetProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetPreset")),
+			connect.WithClientOptions(opts...),
+		),
+		createPreset: connect.NewClient[v1.CreatePresetRequest, v1.CreatePresetResponse](
+			httpClient,
+			baseURL+MaponApiCreatePresetProcedure,
+			connect.WithSchema(maponApiMethods.ByName("CreatePreset")),
+			connect.WithClientOptions(opts...),
+		),
+		editPreset: connect.NewClient[v1.EditPresetRequest, v1.EditPresetResponse](
+			httpClient,
+			baseURL+MaponApiEditPresetProcedure,
+			connect.WithSchema(maponApiMethods.ByName("EditPreset")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePreset: connect.NewClient[v1.DeletePresetRequest, v1.DeletePresetResponse](
+			httpClient,
+			baseURL+MaponApiDeletePresetProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeletePreset")),
+			connect.WithClientOptions(opts...),
+		),
+		getAvailablePresetPermissions: connect.NewClient[v1.GetAvailablePresetPermissionsRequest, v1.GetAvailablePresetPermissionsResponse](
+			httpClient,
+			baseURL+MaponApiGetAvailablePresetPermissionsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetAvailablePresetPermissions")),
+			connect.WithClientOptions(opts...),
+		),
+		getReeferHistoricPeriod: connect.NewClient[v1.GetReeferHistoricPeriodRequest, v1.GetReeferHistoricPeriodResponse](
+			httpClient,
+			baseURL+MaponApiGetReeferHistoricPeriodProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetReeferHistoricPeriod")),
+			connect.WithClientOptions(opts...),
+		),
+		getReeferHistoricPoint: connect.NewClient[v1.GetReeferHistoricPointRequest, v1.GetReeferHistoricPointResponse](
+			httpClient,
+			baseURL+MaponApiGetReeferHistoricPointProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetReeferHistoricPoint")),
+			connect.WithClientOptions(opts...),
+		),
+		listReeferTemperatureData: connect.NewClient[v1.ListReeferTemperatureDataRequest, v1.ListReeferTemperatureDataResponse](
+			httpClient,
+			baseURL+MaponApiListReeferTemperatureDataProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListReeferTemperatureData")),
+			connect.WithClientOptions(opts...),
+		),
+		listReeferRunModes: connect.NewClient[v1.ListReeferRunModesRequest, v1.ListReeferRunModesResponse](
+			httpClient,
+			baseURL+MaponApiListReeferRunModesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListReeferRunModes")),
+			connect.WithClientOptions(opts...),
+		),
+		changeReeferSetpoint: connect.NewClient[v1.ChangeReeferSetpointRequest, v1.ChangeReeferSetpointResponse](
+			httpClient,
+			baseURL+MaponApiChangeReeferSetpointProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ChangeReeferSetpoint")),
+			connect.WithClientOptions(opts...),
+		),
+		changeReeferRunMode: connect.NewClient[v1.ChangeReeferRunModeRequest, v1.ChangeReeferRunModeResponse](
+			httpClient,
+			baseURL+MaponApiChangeReeferRunModeProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ChangeReeferRunMode")),
+			connect.WithClientOptions(opts...),
+		),
+		setReeferAlert: connect.NewClient[v1.SetReeferAlertRequest, v1.SetReeferAlertResponse](
+			httpClient,
+			baseURL+MaponApiSetReeferAlertProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SetReeferAlert")),
+			connect.WithClientOptions(opts...),
+		),
+		listReeferAlerts: connect.NewClient[v1.ListReeferAlertsRequest, v1.ListReeferAlertsResponse](
+			httpClient,
+			baseURL+MaponApiListReeferAlertsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListReeferAlerts")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteReeferAlert: connect.NewClient[v1.DeleteReeferAlertRequest, v1.DeleteReeferAlertResponse](
+			httpClient,
+			baseURL+MaponApiDeleteReeferAlertProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteReeferAlert")),
+			connect.WithClientOptions(opts...),
+		),
+		changeReeferAlertUser: connect.NewClient[v1.ChangeReeferAlertUserRequest, v1.ChangeReeferAlertUserResponse](
+			httpClient,
+			baseURL+MaponApiChangeReeferAlertUserProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ChangeReeferAlertUser")),
+			connect.WithClientOptions(opts...),
+		),
+		listRoutes: connect.NewClient[v1.ListRoutesRequest, v1.ListRoutesResponse](
+			httpClient,
+			baseURL+MaponApiListRoutesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListRoutes")),
+			connect.WithClientOptions(opts...),
+		),
+		createRoutePlanningOrder: connect.NewClient[v1.CreateRoutePlanningOrderRequest, v1.CreateRoutePlanningOrderResponse](
+			httpClient,
+			baseURL+MaponApiCreateRoutePlanningOrderProcedure,
+			connect.WithSchema(maponApiMethods.ByName("CreateRoutePlanningOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		addRoutePlanningOrderPlaces: connect.NewClient[v1.AddRoutePlanningOrderPlacesRequest, v1.AddRoutePlanningOrderPlacesResponse](
+			httpClient,
+			baseURL+MaponApiAddRoutePlanningOrderPlacesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("AddRoutePlanningOrderPlaces")),
+			connect.WithClientOptions(opts...),
+		),
+		getRoutePlanningOrder: connect.NewClient[v1.GetRoutePlanningOrderRequest, v1.GetRoutePlanningOrderResponse](
+			httpClient,
+			baseURL+MaponApiGetRoutePlanningOrderProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetRoutePlanningOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		listRoutePlanningOrders: connect.NewClient[v1.ListRoutePlanningOrdersRequest, v1.ListRoutePlanningOrdersResponse](
+			httpClient,
+			baseURL+MaponApiListRoutePlanningOrdersProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListRoutePlanningOrders")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRoutePlanningOrders: connect.NewClient[v1.DeleteRoutePlanningOrdersRequest, v1.DeleteRoutePlanningOrdersResponse](
+			httpClient,
+			baseURL+MaponApiDeleteRoutePlanningOrdersProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteRoutePlanningOrders")),
+			connect.WithClientOptions(opts...),
+		),
+		getRoutePlanningPlace: connect.NewClient[v1.GetRoutePlanningPlaceRequest, v1.GetRoutePlanningPlaceResponse](
+			httpClient,
+			baseURL+MaponApiGetRoutePlanningPlaceProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetRoutePlanningPlace")),
+			connect.WithClientOptions(opts...),
+		),
+		listRoutePlanningPlaces: connect.NewClient[v1.ListRoutePlanningPlacesRequest, v1.ListRoutePlanningPlacesResponse](
+			httpClient,
+			baseURL+MaponApiListRoutePlanningPlacesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListRoutePlanningPlaces")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRoutePlanningPlaces: connect.NewClient[v1.DeleteRoutePlanningPlacesRequest, v1.DeleteRoutePlanningPlacesResponse](
+			httpClient,
+			baseURL+MaponApiDeleteRoutePlanningPlacesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteRoutePlanningPlaces")),
+			connect.WithClientOptions(opts...),
+		),
+		saveRoutePlanningRoute: connect.NewClient[v1.SaveRoutePlanningRouteRequest, v1.SaveRoutePlanningRouteResponse](
+			httpClient,
+			baseURL+MaponApiSaveRoutePlanningRouteProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SaveRoutePlanningRoute")),
+			connect.WithClientOptions(opts...),
+		),
+		getRoutePlanningRoute: connect.NewClient[v1.GetRoutePlanningRouteRequest, v1.GetRoutePlanningRouteResponse](
+			httpClient,
+			baseURL+MaponApiGetRoutePlanningRouteProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetRoutePlanningRoute")),
+			connect.WithClientOptions(opts...),
+		),
+		listRoutePlanningRoutes: connect.NewClient[v1.ListRoutePlanningRoutesRequest, v1.ListRoutePlanningRoutesResponse](
+			httpClient,
+			baseURL+MaponApiListRoutePlanningRoutesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListRoutePlanningRoutes")),
+			connect.WithClientOptions(opts...),
+		),
+		sendRoutePlanningRouteToAssignee: connect.NewClient[v1.SendRoutePlanningRouteToAssigneeRequest, v1.SendRoutePlanningRouteToAssigneeResponse](
+			httpClient,
+			baseURL+MaponApiSendRoutePlanningRouteToAssigneeProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SendRoutePlanningRouteToAssignee")),
+			connect.WithClientOptions(opts...),
+		),
+		setRoutePlanningRouteStartAddress: connect.NewClient[v1.SetRoutePlanningRouteStartAddressRequest, v1.SetRoutePlanningRouteStartAddressResponse](
+			httpClient,
+			baseURL+MaponApiSetRoutePlanningRouteStartAddressProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SetRoutePlanningRouteStartAddress")),
+			connect.WithClientOptions(opts...),
+		),
+		setRoutePlanningRouteEndAddress: connect.NewClient[v1.SetRoutePlanningRouteEndAddressRequest, v1.SetRoutePlanningRouteEndAddressResponse](
+			httpClient,
+			baseURL+MaponApiSetRoutePlanningRouteEndAddressProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SetRoutePlanningRouteEndAddress")),
+			connect.WithClientOptions(opts...),
+		),
+		optimizeRoutePlanningRoute: connect.NewClient[v1.OptimizeRoutePlanningRouteRequest, v1.OptimizeRoutePlanningRouteResponse](
+			httpClient,
+			baseURL+MaponApiOptimizeRoutePlanningRouteProcedure,
+			connect.WithSchema(maponApiMethods.ByName("OptimizeRoutePlanningRoute")),
+			connect.WithClientOptions(opts...),
+		),
+		getRoutePlanningOptimizationProgress: connect.NewClient[v1.GetRoutePlanningOptimizationProgressRequest, v1.GetRoutePlanningOptimizationProgressResponse](
+			httpClient,
+			baseURL+MaponApiGetRoutePlanningOptimizationProgressProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetRoutePlanningOptimizationProgress")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRoutePlanningRoutes: connect.NewClient[v1.DeleteRoutePlanningRoutesRequest, v1.DeleteRoutePlanningRoutesResponse](
+			httpClient,
+			baseURL+MaponApiDeleteRoutePlanningRoutesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteRoutePlanningRoutes")),
+			connect.WithClientOptions(opts...),
+		),
+		listDriverDddFiles: connect.NewClient[v1.ListDriverDddFilesRequest, v1.ListDriverDddFilesResponse](
+			httpClient,
+			baseURL+MaponApiListDriverDddFilesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListDriverDddFiles")),
+			connect.WithClientOptions(opts...),
+		),
+		listVehicleDddFiles: connect.NewClient[v1.ListVehicleDddFilesRequest, v1.ListVehicleDddFilesResponse](
+			httpClient,
+			baseURL+MaponApiListVehicleDddFilesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListVehicleDddFiles")),
+			connect.WithClientOptions(opts...),
+		),
+		downloadDriverDdd: connect.NewClient[v1.DownloadDriverDddRequest, v1.DownloadDriverDddResponse](
+			httpClient,
+			baseURL+MaponApiDownloadDriverDddProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DownloadDriverDdd")),
+			connect.WithClientOptions(opts...),
+		),
+		downloadVehicleDdd: connect.NewClient[v1.DownloadVehicleDddRequest, v1.DownloadVehicleDddResponse](
+			httpClient,
+			baseURL+MaponApiDownloadVehicleDddProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DownloadVehicleDdd")),
+			connect.WithClientOptions(opts...),
+		),
+		listTasks: connect.NewClient[v1.ListTasksRequest, v1.ListTasksResponse](
+			httpClient,
+			baseURL+MaponApiListTasksProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		createTask: connect.NewClient[v1.CreateTaskRequest, v1.CreateTaskResponse](
+			httpClient,
+			baseURL+MaponApiCreateTaskProcedure,
+			connect.WithSchema(maponApiMethods.ByName("CreateTask")),
+			connect.WithClientOptions(opts...),
+		),
+		editTask: connect.NewClient[v1.EditTaskRequest, v1.EditTaskResponse](
+			httpClient,
+			baseURL+MaponApiEditTaskProcedure,
+			connect.WithSchema(maponApiMethods.ByName("EditTask")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTask: connect.NewClient[v1.DeleteTaskRequest, v1.DeleteTaskResponse](
+			httpClient,
+			baseURL+MaponApiDeleteTaskProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteTask")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTaskRoute: connect.NewClient[v1.DeleteTaskRouteRequest, v1.DeleteTaskRouteResponse](
+			httpClient,
+			baseURL+MaponApiDeleteTaskRouteProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteTaskRoute")),
+			connect.WithClientOptions(opts...),
+		),
+		listTellTaleValues: connect.NewClient[v1.ListTellTaleValuesRequest, v1.ListTellTaleValuesResponse](
+			httpClient,
+			baseURL+MaponApiListTellTaleValuesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListTellTaleValues")),
+			connect.WithClientOptions(opts...),
+		),
+		listTrackingLinks: connect.NewClient[v1.ListTrackingLinksRequest, v1.ListTrackingLinksResponse](
+			httpClient,
+			baseURL+MaponApiListTrackingLinksProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListTrackingLinks")),
+			connect.WithClientOptions(opts...),
+		),
+		createTrackingLink: connect.NewClient[v1.CreateTrackingLinkRequest, v1.CreateTrackingLinkResponse](
+			httpClient,
+			baseURL+MaponApiCreateTrackingLinkProcedure,
+			connect.WithSchema(maponApiMethods.ByName("CreateTrackingLink")),
+			connect.WithClientOptions(opts...),
+		),
+		editTrackingLink: connect.NewClient[v1.EditTrackingLinkRequest, v1.EditTrackingLinkResponse](
+			httpClient,
+			baseURL+MaponApiEditTrackingLinkProcedure,
+			connect.WithSchema(maponApiMethods.ByName("EditTrackingLink")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTrackingLink: connect.NewClient[v1.DeleteTrackingLinkRequest, v1.DeleteTrackingLinkResponse](
+			httpClient,
+			baseURL+MaponApiDeleteTrackingLinkProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteTrackingLink")),
+			connect.WithClientOptions(opts...),
+		),
+		listUnits: connect.NewClient[v1.ListUnitsRequest, v1.ListUnitsResponse](
+			httpClient,
+			baseURL+MaponApiListUnitsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListUnits")),
+			connect.WithClientOptions(opts...),
+		),
+		editUnit: connect.NewClient[v1.EditUnitRequest, v1.EditUnitResponse](
+			httpClient,
+			baseURL+MaponApiEditUnitProcedure,
+			connect.WithSchema(maponApiMethods.ByName("EditUnit")),
+			connect.WithClientOptions(opts...),
+		),
+		installUnit: connect.NewClient[v1.InstallUnitRequest, v1.InstallUnitResponse](
+			httpClient,
+			baseURL+MaponApiInstallUnitProcedure,
+			connect.WithSchema(maponApiMethods.ByName("InstallUnit")),
+			connect.WithClientOptions(opts...),
+		),
+		uninstallUnit: connect.NewClient[v1.UninstallUnitRequest, v1.UninstallUnitResponse](
+			httpClient,
+			baseURL+MaponApiUninstallUnitProcedure,
+			connect.WithSchema(maponApiMethods.ByName("UninstallUnit")),
+			connect.WithClientOptions(opts...),
+		),
+		changeUnitDevice: connect.NewClient[v1.ChangeUnitDeviceRequest, v1.ChangeUnitDeviceResponse](
+			httpClient,
+			baseURL+MaponApiChangeUnitDeviceProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ChangeUnitDevice")),
+			connect.WithClientOptions(opts...),
+		),
+		changeUnitRelay: connect.NewClient[v1.ChangeUnitRelayRequest, v1.ChangeUnitRelayResponse](
+			httpClient,
+			baseURL+MaponApiChangeUnitRelayProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ChangeUnitRelay")),
+			connect.WithClientOptions(opts...),
+		),
+		listAvailableUnitIcons: connect.NewClient[v1.ListAvailableUnitIconsRequest, v1.ListAvailableUnitIconsResponse](
+			httpClient,
+			baseURL+MaponApiListAvailableUnitIconsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListAvailableUnitIcons")),
+			connect.WithClientOptions(opts...),
+		),
+		listUnitCustomFields: connect.NewClient[v1.ListUnitCustomFieldsRequest, v1.ListUnitCustomFieldsResponse](
+			httpClient,
+			baseURL+MaponApiListUnitCustomFieldsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListUnitCustomFields")),
+			connect.WithClientOptions(opts...),
+		),
+		saveUnitCustomFieldValues: connect.NewClient[v1.SaveUnitCustomFieldValuesRequest, v1.SaveUnitCustomFieldValuesResponse](
+			httpClient,
+			baseURL+MaponApiSaveUnitCustomFieldValuesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SaveUnitCustomFieldValues")),
+			connect.WithClientOptions(opts...),
+		),
+		getAvailableUnitCommands: connect.NewClient[v1.GetAvailableUnitCommandsRequest, v1.GetAvailableUnitCommandsResponse](
+			httpClient,
+			baseURL+MaponApiGetAvailableUnitCommandsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetAvailableUnitCommands")),
+			connect.WithClientOptions(opts...),
+		),
+		executeUnitCommand: connect.NewClient[v1.ExecuteUnitCommandRequest, v1.ExecuteUnitCommandResponse](
+			httpClient,
+			baseURL+MaponApiExecuteUnitCommandProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ExecuteUnitCommand")),
+			connect.WithClientOptions(opts...),
+		),
+		listUnitGroups: connect.NewClient[v1.ListUnitGroupsRequest, v1.ListUnitGroupsResponse](
+			httpClient,
+			baseURL+MaponApiListUnitGroupsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListUnitGroups")),
+			connect.WithClientOptions(opts...),
+		),
+		listUnitsInGroup: connect.NewClient[v1.ListUnitsInGroupRequest, v1.ListUnitsInGroupResponse](
+			httpClient,
+			baseURL+MaponApiListUnitsInGroupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListUnitsInGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		saveUnitGroup: connect.NewClient[v1.SaveUnitGroupRequest, v1.SaveUnitGroupResponse](
+			httpClient,
+			baseURL+MaponApiSaveUnitGroupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("SaveUnitGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUnitGroup: connect.NewClient[v1.DeleteUnitGroupRequest, v1.DeleteUnitGroupResponse](
+			httpClient,
+			baseURL+MaponApiDeleteUnitGroupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteUnitGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		attachUnitToGroup: connect.NewClient[v1.AttachUnitToGroupRequest, v1.AttachUnitToGroupResponse](
+			httpClient,
+			baseURL+MaponApiAttachUnitToGroupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("AttachUnitToGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		detachUnitFromGroup: connect.NewClient[v1.DetachUnitFromGroupRequest, v1.DetachUnitFromGroupResponse](
+			httpClient,
+			baseURL+MaponApiDetachUnitFromGroupProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DetachUnitFromGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		clearUnitGroups: connect.NewClient[v1.ClearUnitGroupsRequest, v1.ClearUnitGroupsResponse](
+			httpClient,
+			baseURL+MaponApiClearUnitGroupsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ClearUnitGroups")),
+			connect.WithClientOptions(opts...),
+		),
+		getCanDataPoint: connect.NewClient[v1.GetCanDataPointRequest, v1.GetCanDataPointResponse](
+			httpClient,
+			baseURL+MaponApiGetCanDataPointProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetCanDataPoint")),
+			connect.WithClientOptions(opts...),
+		),
+		listCanPeriodData: connect.NewClient[v1.ListCanPeriodDataRequest, v1.ListCanPeriodDataResponse](
+			httpClient,
+			baseURL+MaponApiListCanPeriodDataProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListCanPeriodData")),
+			connect.WithClientOptions(opts...),
+		),
+		getUnitDebugInfo: connect.NewClient[v1.GetUnitDebugInfoRequest, v1.GetUnitDebugInfoResponse](
+			httpClient,
+			baseURL+MaponApiGetUnitDebugInfoProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetUnitDebugInfo")),
+			connect.WithClientOptions(opts...),
+		),
+		listDigitalInputs: connect.NewClient[v1.ListDigitalInputsRequest, v1.ListDigitalInputsResponse](
+			httpClient,
+			baseURL+MaponApiListDigitalInputsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListDigitalInputs")),
+			connect.WithClientOptions(opts...),
+		),
+		listDigitalInputsExtended: connect.NewClient[v1.ListDigitalInputsExtendedRequest, v1.ListDigitalInputsExtendedResponse](
+			httpClient,
+			baseURL+MaponApiListDigitalInputsExtendedProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListDigitalInputsExtended")),
+			connect.WithClientOptions(opts...),
+		),
+		getDrivingTimeExtended: connect.NewClient[v1.GetDrivingTimeExtendedRequest, v1.GetDrivingTimeExtendedResponse](
+			httpClient,
+			baseURL+MaponApiGetDrivingTimeExtendedProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetDrivingTimeExtended")),
+			connect.WithClientOptions(opts...),
+		),
+		getUnitFields: connect.NewClient[v1.GetUnitFieldsRequest, v1.GetUnitFieldsResponse](
+			httpClient,
+			baseURL+MaponApiGetUnitFieldsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetUnitFields")),
+			connect.WithClientOptions(opts...),
+		),
+		getHistoryPointData: connect.NewClient[v1.GetHistoryPointDataRequest, v1.GetHistoryPointDataResponse](
+			httpClient,
+			baseURL+MaponApiGetHistoryPointDataProcedure,
+			connect.WithSchema(maponApiMethods.ByName("GetHistoryPointData")),
+			connect.WithClientOptions(opts...),
+		),
+		listHumidity: connect.NewClient[v1.ListHumidityRequest, v1.ListHumidityResponse](
+			httpClient,
+			baseURL+MaponApiListHumidityProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListHumidity")),
+			connect.WithClientOptions(opts...),
+		),
+		listIbuttons: connect.NewClient[v1.ListIbuttonsRequest, v1.ListIbuttonsResponse](
+			httpClient,
+			baseURL+MaponApiListIbuttonsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListIbuttons")),
+			connect.WithClientOptions(opts...),
+		),
+		listIgnitions: connect.NewClient[v1.ListIgnitionsRequest, v1.ListIgnitionsResponse](
+			httpClient,
+			baseURL+MaponApiListIgnitionsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListIgnitions")),
+			connect.WithClientOptions(opts...),
+		),
+		listTemperatures: connect.NewClient[v1.ListTemperaturesRequest, v1.ListTemperaturesResponse](
+			httpClient,
+			baseURL+MaponApiListTemperaturesProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListTemperatures")),
+			connect.WithClientOptions(opts...),
+		),
+		listUsers: connect.NewClient[v1.ListUsersRequest, v1.ListUsersResponse](
+			httpClient,
+			baseURL+MaponApiListUsersProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListUsers")),
+			connect.WithClientOptions(opts...),
+		),
+		createUser: connect.NewClient[v1.CreateUserRequest, v1.CreateUserResponse](
+			httpClient,
+			baseURL+MaponApiCreateUserProcedure,
+			connect.WithSchema(maponApiMethods.ByName("CreateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUser: connect.NewClient[v1.UpdateUserRequest, v1.UpdateUserResponse](
+			httpClient,
+			baseURL+MaponApiUpdateUserProcedure,
+			connect.WithSchema(maponApiMethods.ByName("UpdateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUser: connect.NewClient[v1.DeleteUserRequest, v1.DeleteUserResponse](
+			httpClient,
+			baseURL+MaponApiDeleteUserProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteUser")),
+			connect.WithClientOptions(opts...),
+		),
+		changeUserPassword: connect.NewClient[v1.ChangeUserPasswordRequest, v1.ChangeUserPasswordResponse](
+			httpClient,
+			baseURL+MaponApiChangeUserPasswordProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ChangeUserPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		linkUserToDriver: connect.NewClient[v1.LinkUserToDriverRequest, v1.LinkUserToDriverResponse](
+			httpClient,
+			baseURL+MaponApiLinkUserToDriverProcedure,
+			connect.WithSchema(maponApiMethods.ByName("LinkUserToDriver")),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkUserFromDriver: connect.NewClient[v1.UnlinkUserFromDriverRequest, v1.UnlinkUserFromDriverResponse](
+			httpClient,
+			baseURL+MaponApiUnlinkUserFromDriverProcedure,
+			connect.WithSchema(maponApiMethods.ByName("UnlinkUserFromDriver")),
+			connect.WithClientOptions(opts...),
+		),
+		listVehicleInspections: connect.NewClient[v1.ListVehicleInspectionsRequest, v1.ListVehicleInspectionsResponse](
+			httpClient,
+			baseURL+MaponApiListVehicleInspectionsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("ListVehicleInspections")),
+			connect.WithClientOptions(opts...),
+		),
+		createVehiclePinDeviceMappings: connect.NewClient[v1.CreateVehiclePinDeviceMappingsRequest, v1.CreateVehiclePinDeviceMappingsResponse](
+			httpClient,
+			baseURL+MaponApiCreateVehiclePinDeviceMappingsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("CreateVehiclePinDeviceMappings")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteVehiclePinDeviceMappings: connect.NewClient[v1.DeleteVehiclePinDeviceMappingsRequest, v1.DeleteVehiclePinDeviceMappingsResponse](
+			httpClient,
+			baseURL+MaponApiDeleteVehiclePinDeviceMappingsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("DeleteVehiclePinDeviceMappings")),
+			connect.WithClientOptions(opts...),
+		),
+		createDriverPins: connect.NewClient[v1.CreateDriverPinsRequest, v1.CreateDriverPinsResponse](
+			httpClient,
+			baseURL+MaponApiCreateDriverPinsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("CreateDriverPins")),
+			connect.WithClientOptions(opts...),
+		),
+		updateDriverPins: connect.NewClient[v1.UpdateDriverPinsRequest, v1.UpdateDriverPinsResponse](
+			httpClient,
+			baseURL+MaponApiUpdateDriverPinsProcedure,
+			connect.WithSchema(maponApiMethods.ByName("UpdateDriverPins")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// maponApiClient implements MaponApiClient.
+type maponApiClient struct {
+	listAlerts                           *connect.Client[v1.ListAlertsRequest, v1.ListAlertsResponse]
+	listAlertSetups                      *connect.Client[v1.ListAlertSetupsRequest, v1.ListAlertSetupsResponse]
+	getAlertSetupTypes                   *connect.Client[v1.GetAlertSetupTypesRequest, v1.GetAlertSetupTypesResponse]
+	getAlertSetupFields                  *connect.Client[v1.GetAlertSetupFieldsRequest, v1.GetAlertSetupFieldsResponse]
+	storeAlertSetup                      *connect.Client[v1.StoreAlertSetupRequest, v1.StoreAlertSetupResponse]
+	deleteAlertSetup                     *connect.Client[v1.DeleteAlertSetupRequest, v1.DeleteAlertSetupResponse]
+	listBleTags                          *connect.Client[v1.ListBleTagsRequest, v1.ListBleTagsResponse]
+	listBleTagScans                      *connect.Client[v1.ListBleTagScansRequest, v1.ListBleTagScansResponse]
+	listBleTagLocations                  *connect.Client[v1.ListBleTagLocationsRequest, v1.ListBleTagLocationsResponse]
+	getBleTagHistoryPoint                *connect.Client[v1.GetBleTagHistoryPointRequest, v1.GetBleTagHistoryPointResponse]
+	getCompany                           *connect.Client[v1.GetCompanyRequest, v1.GetCompanyResponse]
+	listCompanyClients                   *connect.Client[v1.ListCompanyClientsRequest, v1.ListCompanyClientsResponse]
+	createCompanyClient                  *connect.Client[v1.CreateCompanyClientRequest, v1.CreateCompanyClientResponse]
+	listCustomLayers                     *connect.Client[v1.ListCustomLayersRequest, v1.ListCustomLayersResponse]
+	saveCustomLayer                      *connect.Client[v1.SaveCustomLayerRequest, v1.SaveCustomLayerResponse]
+	deleteCustomLayer                    *connect.Client[v1.DeleteCustomLayerRequest, v1.DeleteCustomLayerResponse]
+	listCustomLayerGeometries            *connect.Client[v1.ListCustomLayerGeometriesRequest, v1.ListCustomLayerGeometriesResponse]
+	addCustomLayerGeometries             *connect.Client[v1.AddCustomLayerGeometriesRequest, v1.AddCustomLayerGeometriesResponse]
+	editCustomLayerGeometry              *connect.Client[v1.EditCustomLayerGeometryRequest, v1.EditCustomLayerGeometryResponse]
+	deleteCustomLayerGeometry            *connect.Client[v1.DeleteCustomLayerGeometryRequest, v1.DeleteCustomLayerGeometryResponse]
+	listCustomTargets                    *connect.Client[v1.ListCustomTargetsRequest, v1.ListCustomTargetsResponse]
+	listCustomTargetValues               *connect.Client[v1.ListCustomTargetValuesRequest, v1.ListCustomTargetValuesResponse]
+	deleteDataForward                    *connect.Client[v1.DeleteDataForwardRequest, v1.DeleteDataForwardResponse]
+	listDataForwards                     *connect.Client[v1.ListDataForwardsRequest, v1.ListDataForwardsResponse]
+	saveDataForward                      *connect.Client[v1.SaveDataForwardRequest, v1.SaveDataForwardResponse]
+	listDevices                          *connect.Client[v1.ListDevicesRequest, v1.ListDevicesResponse]
+	createDevice                         *connect.Client[v1.CreateDeviceRequest, v1.CreateDeviceResponse]
+	listDeviceModels                     *connect.Client[v1.ListDeviceModelsRequest, v1.ListDeviceModelsResponse]
+	getDeviceCommands                    *connect.Client[v1.GetDeviceCommandsRequest, v1.GetDeviceCommandsResponse]
+	sendDeviceSmsCommand                 *connect.Client[v1.SendDeviceSmsCommandRequest, v1.SendDeviceSmsCommandResponse]
+	sendDeviceTcpCommand                 *connect.Client[v1.SendDeviceTcpCommandRequest, v1.SendDeviceTcpCommandResponse]
+	listDeviceSmsHistory                 *connect.Client[v1.ListDeviceSmsHistoryRequest, v1.ListDeviceSmsHistoryResponse]
+	listDrivers                          *connect.Client[v1.ListDriversRequest, v1.ListDriversResponse]
+	createDriver                         *connect.Client[v1.CreateDriverRequest, v1.CreateDriverResponse]
+	updateDriver                         *connect.Client[v1.UpdateDriverRequest, v1.UpdateDriverResponse]
+	deleteDriver                         *connect.Client[v1.DeleteDriverRequest, v1.DeleteDriverResponse]
+	changeDriverPassword                 *connect.Client[v1.ChangeDriverPasswordRequest, v1.ChangeDriverPasswordResponse]
+	associateExternalDriver              *connect.Client[v1.AssociateExternalDriverRequest, v1.AssociateExternalDriverResponse]
+	linkDriverUser                       *connect.Client[v1.LinkDriverUserRequest, v1.LinkDriverUserResponse]
+	unlinkDriverUser                     *connect.Client[v1.UnlinkDriverUserRequest, v1.UnlinkDriverUserResponse]
+	listDriverCustomFields               *connect.Client[v1.ListDriverCustomFieldsRequest, v1.ListDriverCustomFieldsResponse]
+	saveDriverCustomFields               *connect.Client[v1.SaveDriverCustomFieldsRequest, v1.SaveDriverCustomFieldsResponse]
+	saveDriverCustomFieldValues          *connect.Client[v1.SaveDriverCustomFieldValuesRequest, v1.SaveDriverCustomFieldValuesResponse]
+	deleteDriverCustomFields             *connect.Client[v1.DeleteDriverCustomFieldsRequest, v1.DeleteDriverCustomFieldsResponse]
+	listDriverDailyActivities            *connect.Client[v1.ListDriverDailyActivitiesRequest, v1.ListDriverDailyActivitiesResponse]
+	getDriverBehaviourReportDrivers      *connect.Client[v1.GetDriverBehaviourReportDriversRequest, v1.GetDriverBehaviourReportDriversResponse]
+	getDriverBehaviourReportUnits        *connect.Client[v1.GetDriverBehaviourReportUnitsRequest, v1.GetDriverBehaviourReportUnitsResponse]
+	listDriverGroups                     *connect.Client[v1.ListDriverGroupsRequest, v1.ListDriverGroupsResponse]
+	listDriversInGroup                   *connect.Client[v1.ListDriversInGroupRequest, v1.ListDriversInGroupResponse]
+	saveDriverGroup                      *connect.Client[v1.SaveDriverGroupRequest, v1.SaveDriverGroupResponse]
+	deleteDriverGroup                    *connect.Client[v1.DeleteDriverGroupRequest, v1.DeleteDriverGroupResponse]
+	addDriverToGroup                     *connect.Client[v1.AddDriverToGroupRequest, v1.AddDriverToGroupResponse]
+	removeDriverFromGroup                *connect.Client[v1.RemoveDriverFromGroupRequest, v1.RemoveDriverFromGroupResponse]
+	clearDriverGroups                    *connect.Client[v1.ClearDriverGroupsRequest, v1.ClearDriverGroupsResponse]
+	listFuelData                         *connect.Client[v1.ListFuelDataRequest, v1.ListFuelDataResponse]
+	listFuelChanges                      *connect.Client[v1.ListFuelChangesRequest, v1.ListFuelChangesResponse]
+	getFuelSummary                       *connect.Client[v1.GetFuelSummaryRequest, v1.GetFuelSummaryResponse]
+	listFuelChecks                       *connect.Client[v1.ListFuelChecksRequest, v1.ListFuelChecksResponse]
+	addFuelCheck                         *connect.Client[v1.AddFuelCheckRequest, v1.AddFuelCheckResponse]
+	editFuelCheck                        *connect.Client[v1.EditFuelCheckRequest, v1.EditFuelCheckResponse]
+	deleteFuelCheck                      *connect.Client[v1.DeleteFuelCheckRequest, v1.DeleteFuelCheckResponse]
+	addFuelCard                          *connect.Client[v1.AddFuelCardRequest, v1.AddFuelCardResponse]
+	updateFuelCard                       *connect.Client[v1.UpdateFuelCardRequest, v1.UpdateFuelCardResponse]
+	deleteFuelCard                       *connect.Client[v1.DeleteFuelCardRequest, v1.DeleteFuelCardResponse]
+	listMessagingChannels                *connect.Client[v1.ListMessagingChannelsRequest, v1.ListMessagingChannelsResponse]
+	listMessagingConversations           *connect.Client[v1.ListMessagingConversationsRequest, v1.ListMessagingConversationsResponse]
+	createMessagingConversation          *connect.Client[v1.CreateMessagingConversationRequest, v1.CreateMessagingConversationResponse]
+	listMessages                         *connect.Client[v1.ListMessagesRequest, v1.ListMessagesResponse]
+	sendMessage                          *connect.Client[v1.SendMessageRequest, v1.SendMessageResponse]
+	listObjects                          *connect.Client[v1.ListObjectsRequest, v1.ListObjectsResponse]
+	saveObject                           *connect.Client[v1.SaveObjectRequest, v1.SaveObjectResponse]
+	deleteObject                         *connect.Client[v1.DeleteObjectRequest, v1.DeleteObjectResponse]
+	getObjectCustomFields                *connect.Client[v1.GetObjectCustomFieldsRequest, v1.GetObjectCustomFieldsResponse]
+	saveObjectCustomFieldsValues         *connect.Client[v1.SaveObjectCustomFieldsValuesRequest, v1.SaveObjectCustomFieldsValuesResponse]
+	listObjectGroups                     *connect.Client[v1.ListObjectGroupsRequest, v1.ListObjectGroupsResponse]
+	saveObjectGroup                      *connect.Client[v1.SaveObjectGroupRequest, v1.SaveObjectGroupResponse]
+	deleteObjectGroup                    *connect.Client[v1.DeleteObjectGroupRequest, v1.DeleteObjectGroupResponse]
+	listPresets                          *connect.Client[v1.ListPresetsRequest, v1.ListPresetsResponse]
+	getPreset                            *connect.Client[v1.GetPresetRequest, v1.GetPresetResponse]
+	createPreset                         *connect.Client[v1.CreatePresetRequest, v1.CreatePresetResponse]
+	editPreset                           *connect.Client[v1.EditPresetRequest, v1.EditPresetResponse]
+	deletePreset                         *connect.Client[v1.DeletePresetRequest, v1.DeletePresetResponse]
+	getAvailablePresetPermissions        *connect.Client[v1.GetAvailablePresetPermissionsRequest, v1.GetAvailablePresetPermissionsResponse]
+	getReeferHistoricPeriod              *connect.Client[v1.GetReeferHistoricPeriodRequest, v1.GetReeferHistoricPeriodResponse]
+	getReeferHistoricPoint               *connect.Client[v1.GetReeferHistoricPointRequest, v1.GetReeferHistoricPointResponse]
+	listReeferTemperatureData            *connect.Client[v1.ListReeferTemperatureDataRequest, v1.ListReeferTemperatureDataResponse]
+	listReeferRunModes                   *connect.Client[v1.ListReeferRunModesRequest, v1.ListReeferRunModesResponse]
+	changeReeferSetpoint                 *connect.Client[v1.ChangeReeferSetpointRequest, v1.ChangeReeferSetpointResponse]
+	changeReeferRunMode                  *connect.Client[v1.ChangeReeferRunModeRequest, v1.ChangeReeferRunModeResponse]
+	setReeferAlert                       *connect.Client[v1.SetReeferAlertRequest, v1.SetReeferAlertResponse]
+	listReeferAlerts                     *connect.Client[v1.ListReeferAlertsRequest, v1.ListReeferAlertsResponse]
+	deleteReeferAlert                    *connect.Client[v1.DeleteReeferAlertRequest, v1.DeleteReeferAlertResponse]
+	changeReeferAlertUser                *connect.Client[v1.ChangeReeferAlertUserRequest, v1.ChangeReeferAlertUserResponse]
+	listRoutes                           *connect.Client[v1.ListRoutesRequest, v1.ListRoutesResponse]
+	createRoutePlanningOrder             *connect.Client[v1.CreateRoutePlanningOrderRequest, v1.CreateRoutePlanningOrderResponse]
+	addRoutePlanningOrderPlaces          *connect.Client[v1.AddRoutePlanningOrderPlacesRequest, v1.AddRoutePlanningOrderPlacesResponse]
+	getRoutePlanningOrder                *connect.Client[v1.GetRoutePlanningOrderRequest, v1.GetRoutePlanningOrderResponse]
+	listRoutePlanningOrders              *connect.Client[v1.ListRoutePlanningOrdersRequest, v1.ListRoutePlanningOrdersResponse]
+	deleteRoutePlanningOrders            *connect.Client[v1.DeleteRoutePlanningOrdersRequest, v1.DeleteRoutePlanningOrdersResponse]
+	getRoutePlanningPlace                *connect.Client[v1.GetRoutePlanningPlaceRequest, v1.GetRoutePlanningPlaceResponse]
+	listRoutePlanningPlaces              *connect.Client[v1.ListRoutePlanningPlacesRequest, v1.ListRoutePlanningPlacesResponse]
+	deleteRoutePlanningPlaces            *connect.Client[v1.DeleteRoutePlanningPlacesRequest, v1.DeleteRoutePlanningPlacesResponse]
+	saveRoutePlanningRoute               *connect.Client[v1.SaveRoutePlanningRouteRequest, v1.SaveRoutePlanningRouteResponse]
+	getRoutePlanningRoute                *connect.Client[v1.GetRoutePlanningRouteRequest, v1.GetRoutePlanningRouteResponse]
+	listRoutePlanningRoutes              *connect.Client[v1.ListRoutePlanningRoutesRequest, v1.ListRoutePlanningRoutesResponse]
+	sendRoutePlanningRouteToAssignee     *connect.Client[v1.SendRoutePlanningRouteToAssigneeRequest, v1.SendRoutePlanningRouteToAssigneeResponse]
+	setRoutePlanningRouteStartAddress    *connect.Client[v1.SetRoutePlanningRouteStartAddressRequest, v1.SetRoutePlanningRouteStartAddressResponse]
+	setRoutePlanningRouteEndAddress      *connect.Client[v1.SetRoutePlanningRouteEndAddressRequest, v1.SetRoutePlanningRouteEndAddressResponse]
+	optimizeRoutePlanningRoute           *connect.Client[v1.OptimizeRoutePlanningRouteRequest, v1.OptimizeRoutePlanningRouteResponse]
+	getRoutePlanningOptimizationProgress *connect.Client[v1.GetRoutePlanningOptimizationProgressRequest, v1.GetRoutePlanningOptimizationProgressResponse]
+	deleteRoutePlanningRoutes            *connect.Client[v1.DeleteRoutePlanningRoutesRequest, v1.DeleteRoutePlanningRoutesResponse]
+	listDriverDddFiles                   *connect.Client[v1.ListDriverDddFilesRequest, v1.ListDriverDddFilesResponse]
+	listVehicleDddFiles                  *connect.Client[v1.ListVehicleDddFilesRequest, v1.ListVehicleDddFilesResponse]
+	downloadDriverDdd                    *connect.Client[v1.DownloadDriverDddRequest, v1.DownloadDriverDddResponse]
+	downloadVehicleDdd                   *connect.Client[v1.DownloadVehicleDddRequest, v1.DownloadVehicleDddResponse]
+	listTasks                            *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
+	createTask                           *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
+	editTask                             *connect.Client[v1.EditTaskRequest, v1.EditTaskResponse]
+	deleteTask                           *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	deleteTaskRoute                      *connect.Client[v1.DeleteTaskRouteRequest, v1.DeleteTaskRouteResponse]
+	listTellTaleValues                   *connect.Client[v1.ListTellTaleValuesRequest, v1.ListTellTaleValuesResponse]
+	listTrackingLinks                    *connect.Client[v1.ListTrackingLinksRequest, v1.ListTrackingLinksResponse]
+	createTrackingLink                   *connect.Client[v1.CreateTrackingLinkRequest, v1.CreateTrackingLinkResponse]
+	editTrackingLink                     *connect.Client[v1.EditTrackingLinkRequest, v1.EditTrackingLinkResponse]
+	deleteTrackingLink                   *connect.Client[v1.DeleteTrackingLinkRequest, v1.DeleteTrackingLinkResponse]
+	listUnits                            *connect.Client[v1.ListUnitsRequest, v1.ListUnitsResponse]
+	editUnit                             *connect.Client[v1.EditUnitRequest, v1.EditUnitResponse]
+	installUnit                          *connect.Client[v1.InstallUnitRequest, v1.InstallUnitResponse]
+	uninstallUnit                        *connect.Client[v1.UninstallUnitRequest, v1.UninstallUnitResponse]
+	changeUnitDevice                     *connect.Client[v1.ChangeUnitDeviceRequest, v1.ChangeUnitDeviceResponse]
+	changeUnitRelay                      *connect.Client[v1.ChangeUnitRelayRequest, v1.ChangeUnitRelayResponse]
+	listAvailableUnitIcons               *connect.Client[v1.ListAvailableUnitIconsRequest, v1.ListAvailableUnitIconsResponse]
+	listUnitCustomFields                 *connect.Client[v1.ListUnitCustomFieldsRequest, v1.ListUnitCustomFieldsResponse]
+	saveUnitCustomFieldValues            *connect.Client[v1.SaveUnitCustomFieldValuesRequest, v1.SaveUnitCustomFieldValuesResponse]
+	getAvailableUnitCommands             *connect.Client[v1.GetAvailableUnitCommandsRequest, v1.GetAvailableUnitCommandsResponse]
+	executeUnitCommand                   *connect.Client[v1.ExecuteUnitCommandRequest, v1.ExecuteUnitCommandResponse]
+	listUnitGroups                       *connect.Client[v1.ListUnitGroupsRequest, v1.ListUnitGroupsResponse]
+	listUnitsInGroup                     *connect.Client[v1.ListUnitsInGroupRequest, v1.ListUnitsInGroupResponse]
+	saveUnitGroup                        *connect.Client[v1.SaveUnitGroupRequest, v1.SaveUnitGroupResponse]
+	deleteUnitGroup                      *connect.Client[v1.DeleteUnitGroupRequest, v1.DeleteUnitGroupResponse]
+	attachUnitToGroup                    *connect.Client[v1.AttachUnitToGroupRequest, v1.AttachUnitToGroupResponse]
+	detachUnitFromGroup                  *connect.Client[v1.DetachUnitFromGroupRequest, v1.DetachUnitFromGroupResponse]
+	clearUnitGroups                      *connect.Client[v1.ClearUnitGroupsRequest, v1.ClearUnitGroupsResponse]
+	getCanDataPoint                      *connect.Client[v1.GetCanDataPointRequest, v1.GetCanDataPointResponse]
+	listCanPeriodData                    *connect.Client[v1.ListCanPeriodDataRequest, v1.ListCanPeriodDataResponse]
+	getUnitDebugInfo                     *connect.Client[v1.GetUnitDebugInfoRequest, v1.GetUnitDebugInfoResponse]
+	listDigitalInputs                    *connect.Client[v1.ListDigitalInputsRequest, v1.ListDigitalInputsResponse]
+	listDigitalInputsExtended            *connect.Client[v1.ListDigitalInputsExtendedRequest, v1.ListDigitalInputsExtendedResponse]
+	getDrivingTimeExtended               *connect.Client[v1.GetDrivingTimeExtendedRequest, v1.GetDrivingTimeExtendedResponse]
+	getUnitFields                        *connect.Client[v1.GetUnitFieldsRequest, v1.GetUnitFieldsResponse]
+	getHistoryPointData                  *connect.Client[v1.GetHistoryPointDataRequest, v1.GetHistoryPointDataResponse]
+	listHumidity                         *connect.Client[v1.ListHumidityRequest, v1.ListHumidityResponse]
+	listIbuttons                         *connect.Client[v1.ListIbuttonsRequest, v1.ListIbuttonsResponse]
+	listIgnitions                        *connect.Client[v1.ListIgnitionsRequest, v1.ListIgnitionsResponse]
+	listTemperatures                     *connect.Client[v1.ListTemperaturesRequest, v1.ListTemperaturesResponse]
+	listUsers                            *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	createUser                           *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
+	updateUser                           *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	deleteUser                           *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	changeUserPassword                   *connect.Client[v1.ChangeUserPasswordRequest, v1.ChangeUserPasswordResponse]
+	linkUserToDriver                     *connect.Client[v1.LinkUserToDriverRequest, v1.LinkUserToDriverResponse]
+	unlinkUserFromDriver                 *connect.Client[v1.UnlinkUserFromDriverRequest, v1.UnlinkUserFromDriverResponse]
+	listVehicleInspections               *connect.Client[v1.ListVehicleInspectionsRequest, v1.ListVehicleInspectionsResponse]
+	createVehiclePinDeviceMappings       *connect.Client[v1.CreateVehiclePinDeviceMappingsRequest, v1.CreateVehiclePinDeviceMappingsResponse]
+	deleteVehiclePinDeviceMappings       *connect.Client[v1.DeleteVehiclePinDeviceMappingsRequest, v1.DeleteVehiclePinDeviceMappingsResponse]
+	createDriverPins                     *connect.Client[v1.CreateDriverPinsRequest, v1.CreateDriverPinsResponse]
+	updateDriverPins                     *connect.Client[v1.UpdateDriverPinsRequest, v1.UpdateDriverPinsResponse]
+}
+
+// ListAlerts calls wayplatform.connect.mapon.v1.MaponApi.ListAlerts.
+func (c *maponApiClient) ListAlerts(ctx context.Context, req *v1.ListAlertsRequest) (*v1.ListAlertsResponse, error) {
+	response, err := c.listAlerts.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListAlertSetups calls wayplatform.connect.mapon.v1.MaponApi.ListAlertSetups.
+func (c *maponApiClient) ListAlertSetups(ctx context.Context, req *v1.ListAlertSetupsRequest) (*v1.ListAlertSetupsResponse, error) {
+	response, err := c.listAlertSetups.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetAlertSetupTypes calls wayplatform.connect.mapon.v1.MaponApi.GetAlertSetupTypes.
+func (c *maponApiClient) GetAlertSetupTypes(ctx context.Context, req *v1.GetAlertSetupTypesRequest) (*v1.GetAlertSetupTypesResponse, error) {
+	response, err := c.getAlertSetupTypes.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetAlertSetupFields calls wayplatform.connect.mapon.v1.MaponApi.GetAlertSetupFields.
+func (c *maponApiClient) GetAlertSetupFields(ctx context.Context, req *v1.GetAlertSetupFieldsRequest) (*v1.GetAlertSetupFieldsResponse, error) {
+	response, err := c.getAlertSetupFields.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// StoreAlertSetup calls wayplatform.connect.mapon.v1.MaponApi.StoreAlertSetup.
+func (c *maponApiClient) StoreAlertSetup(ctx context.Context, req *v1.StoreAlertSetupRequest) (*v1.StoreAlertSetupResponse, error) {
+	response, err := c.storeAlertSetup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteAlertSetup calls wayplatform.connect.mapon.v1.MaponApi.DeleteAlertSetup.
+func (c *maponApiClient) DeleteAlertSetup(ctx context.Context, req *v1.DeleteAlertSetupRequest) (*v1.DeleteAlertSetupResponse, error) {
+	response, err := c.deleteAlertSetup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListBleTags calls wayplatform.connect.mapon.v1.MaponApi.ListBleTags.
+func (c *maponApiClient) ListBleTags(ctx context.Context, req *v1.ListBleTagsRequest) (*v1.ListBleTagsResponse, error) {
+	response, err := c.listBleTags.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListBleTagScans calls wayplatform.connect.mapon.v1.MaponApi.ListBleTagScans.
+func (c *maponApiClient) ListBleTagScans(ctx context.Context, req *v1.ListBleTagScansRequest) (*v1.ListBleTagScansResponse, error) {
+	response, err := c.listBleTagScans.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListBleTagLocations calls wayplatform.connect.mapon.v1.MaponApi.ListBleTagLocations.
+func (c *maponApiClient) ListBleTagLocations(ctx context.Context, req *v1.ListBleTagLocationsRequest) (*v1.ListBleTagLocationsResponse, error) {
+	response, err := c.listBleTagLocations.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetBleTagHistoryPoint calls wayplatform.connect.mapon.v1.MaponApi.GetBleTagHistoryPoint.
+func (c *maponApiClient) GetBleTagHistoryPoint(ctx context.Context, req *v1.GetBleTagHistoryPointRequest) (*v1.GetBleTagHistoryPointResponse, error) {
+	response, err := c.getBleTagHistoryPoint.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetCompany calls wayplatform.connect.mapon.v1.MaponApi.GetCompany.
+func (c *maponApiClient) GetCompany(ctx context.Context, req *v1.GetCompanyRequest) (*v1.GetCompanyResponse, error) {
+	response, err := c.getCompany.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListCompanyClients calls wayplatform.connect.mapon.v1.MaponApi.ListCompanyClients.
+func (c *maponApiClient) ListCompanyClients(ctx context.Context, req *v1.ListCompanyClientsRequest) (*v1.ListCompanyClientsResponse, error) {
+	response, err := c.listCompanyClients.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateCompanyClient calls wayplatform.connect.mapon.v1.MaponApi.CreateCompanyClient.
+func (c *maponApiClient) CreateCompanyClient(ctx context.Context, req *v1.CreateCompanyClientRequest) (*v1.CreateCompanyClientResponse, error) {
+	response, err := c.createCompanyClient.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListCustomLayers calls wayplatform.connect.mapon.v1.MaponApi.ListCustomLayers.
+func (c *maponApiClient) ListCustomLayers(ctx context.Context, req *v1.ListCustomLayersRequest) (*v1.ListCustomLayersResponse, error) {
+	response, err := c.listCustomLayers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SaveCustomLayer calls wayplatform.connect.mapon.v1.MaponApi.SaveCustomLayer.
+func (c *maponApiClient) SaveCustomLayer(ctx context.Context, req *v1.SaveCustomLayerRequest) (*v1.SaveCustomLayerResponse, error) {
+	response, err := c.saveCustomLayer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteCustomLayer calls wayplatform.connect.mapon.v1.MaponApi.DeleteCustomLayer.
+func (c *maponApiClient) DeleteCustomLayer(ctx context.Context, req *v1.DeleteCustomLayerRequest) (*v1.DeleteCustomLayerResponse, error) {
+	response, err := c.deleteCustomLayer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListCustomLayerGeometries calls wayplatform.connect.mapon.v1.MaponApi.ListCustomLayerGeometries.
+func (c *maponApiClient) ListCustomLayerGeometries(ctx context.Context, req *v1.ListCustomLayerGeometriesRequest) (*v1.ListCustomLayerGeometriesResponse, error) {
+	response, err := c.listCustomLayerGeometries.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AddCustomLayerGeometries calls wayplatform.connect.mapon.v1.MaponApi.AddCustomLayerGeometries.
+func (c *maponApiClient) AddCustomLayerGeometries(ctx context.Context, req *v1.AddCustomLayerGeometriesRequest) (*v1.AddCustomLayerGeometriesResponse, error) {
+	response, err := c.addCustomLayerGeometries.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EditCustomLayerGeometry calls wayplatform.connect.mapon.v1.MaponApi.EditCustomLayerGeometry.
+func (c *maponApiClient) EditCustomLayerGeometry(ctx context.Context, req *v1.EditCustomLayerGeometryRequest) (*v1.EditCustomLayerGeometryResponse, error) {
+	response, err := c.editCustomLayerGeometry.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteCustomLayerGeometry calls wayplatform.connect.mapon.v1.MaponApi.DeleteCustomLayerGeometry.
+func (c *maponApiClient) DeleteCustomLayerGeometry(ctx context.Context, req *v1.DeleteCustomLayerGeometryRequest) (*v1.DeleteCustomLayerGeometryResponse, error) {
+	response, err := c.deleteCustomLayerGeometry.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListCustomTargets calls wayplatform.connect.mapon.v1.MaponApi.ListCustomTargets.
+func (c *maponApiClient) ListCustomTargets(ctx context.Context, req *v1.ListCustomTargetsRequest) (*v1.ListCustomTargetsResponse, error) {
+	response, err := c.listCustomTargets.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListCustomTargetValues calls wayplatform.connect.mapon.v1.MaponApi.ListCustomTargetValues.
+func (c *maponApiClient) ListCustomTargetValues(ctx context.Context, req *v1.ListCustomTargetValuesRequest) (*v1.ListCustomTargetValuesResponse, error) {
+	response, err := c.listCustomTargetValues.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteDataForward calls wayplatform.connect.mapon.v1.MaponApi.DeleteDataForward.
+func (c *maponApiClient) DeleteDataForward(ctx context.Context, req *v1.DeleteDataForwardRequest) (*v1.DeleteDataForwardResponse, error) {
+	response, err := c.deleteDataForward.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDataForwards calls wayplatform.connect.mapon.v1.MaponApi.ListDataForwards.
+func (c *maponApiClient) ListDataForwards(ctx context.Context, req *v1.ListDataForwardsRequest) (*v1.ListDataForwardsResponse, error) {
+	response, err := c.listDataForwards.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SaveDataForward calls wayplatform.connect.mapon.v1.MaponApi.SaveDataForward.
+func (c *maponApiClient) SaveDataForward(ctx context.Context, req *v1.SaveDataForwardRequest) (*v1.SaveDataForwardResponse, error) {
+	response, err := c.saveDataForward.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDevices calls wayplatform.connect.mapon.v1.MaponApi.ListDevices.
+func (c *maponApiClient) ListDevices(ctx context.Context, req *v1.ListDevicesRequest) (*v1.ListDevicesResponse, error) {
+	response, err := c.listDevices.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateDevice calls wayplatform.connect.mapon.v1.MaponApi.CreateDevice.
+func (c *maponApiClient) CreateDevice(ctx context.Context, req *v1.CreateDeviceRequest) (*v1.CreateDeviceResponse, error) {
+	response, err := c.createDevice.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDeviceModels calls wayplatform.connect.mapon.v1.MaponApi.ListDeviceModels.
+func (c *maponApiClient) ListDeviceModels(ctx context.Context, req *v1.ListDeviceModelsRequest) (*v1.ListDeviceModelsResponse, error) {
+	response, err := c.listDeviceModels.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetDeviceCommands calls wayplatform.connect.mapon.v1.MaponApi.GetDeviceCommands.
+func (c *maponApiClient) GetDeviceCommands(ctx context.Context, req *v1.GetDeviceCommandsRequest) (*v1.GetDeviceCommandsResponse, error) {
+	response, err := c.getDeviceCommands.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SendDeviceSmsCommand calls wayplatform.connect.mapon.v1.MaponApi.SendDeviceSmsCommand.
+func (c *maponApiClient) SendDeviceSmsCommand(ctx context.Context, req *v1.SendDeviceSmsCommandRequest) (*v1.SendDeviceSmsCommandResponse, error) {
+	response, err := c.sendDeviceSmsCommand.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SendDeviceTcpCommand calls wayplatform.connect.mapon.v1.MaponApi.SendDeviceTcpCommand.
+func (c *maponApiClient) SendDeviceTcpCommand(ctx context.Context, req *v1.SendDeviceTcpCommandRequest) (*v1.SendDeviceTcpCommandResponse, error) {
+	response, err := c.sendDeviceTcpCommand.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDeviceSmsHistory calls wayplatform.connect.mapon.v1.MaponApi.ListDeviceSmsHistory.
+func (c *maponApiClient) ListDeviceSmsHistory(ctx context.Context, req *v1.ListDeviceSmsHistoryRequest) (*v1.ListDeviceSmsHistoryResponse, error) {
+	response, err := c.listDeviceSmsHistory.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDrivers calls wayplatform.connect.mapon.v1.MaponApi.ListDrivers.
+func (c *maponApiClient) ListDrivers(ctx context.Context, req *v1.ListDriversRequest) (*v1.ListDriversResponse, error) {
+	response, err := c.listDrivers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateDriver calls wayplatform.connect.mapon.v1.MaponApi.CreateDriver.
+func (c *maponApiClient) CreateDriver(ctx context.Context, req *v1.CreateDriverRequest) (*v1.CreateDriverResponse, error) {
+	response, err := c.createDriver.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateDriver calls wayplatform.connect.mapon.v1.MaponApi.UpdateDriver.
+func (c *maponApiClient) UpdateDriver(ctx context.Context, req *v1.UpdateDriverRequest) (*v1.UpdateDriverResponse, error) {
+	response, err := c.updateDriver.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteDriver calls wayplatform.connect.mapon.v1.MaponApi.DeleteDriver.
+func (c *maponApiClient) DeleteDriver(ctx context.Context, req *v1.DeleteDriverRequest) (*v1.DeleteDriverResponse, error) {
+	response, err := c.deleteDriver.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ChangeDriverPassword calls wayplatform.connect.mapon.v1.MaponApi.ChangeDriverPassword.
+func (c *maponApiClient) ChangeDriverPassword(ctx context.Context, req *v1.ChangeDriverPasswordRequest) (*v1.ChangeDriverPasswordResponse, error) {
+	response, err := c.changeDriverPassword.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AssociateExternalDriver calls wayplatform.connect.mapon.v1.MaponApi.AssociateExternalDriver.
+func (c *maponApiClient) AssociateExternalDriver(ctx context.Context, req *v1.AssociateExternalDriverRequest) (*v1.AssociateExternalDriverResponse, error) {
+	response, err := c.associateExternalDriver.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// LinkDriverUser calls wayplatform.connect.mapon.v1.MaponApi.LinkDriverUser.
+func (c *maponApiClient) LinkDriverUser(ctx context.Context, req *v1.LinkDriverUserRequest) (*v1.LinkDriverUserResponse, error) {
+	response, err := c.linkDriverUser.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UnlinkDriverUser calls wayplatform.connect.mapon.v1.MaponApi.UnlinkDriverUser.
+func (c *maponApiClient) UnlinkDriverUser(ctx context.Context, req *v1.UnlinkDriverUserRequest) (*v1.UnlinkDriverUserResponse, error) {
+	response, err := c.unlinkDriverUser.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDriverCustomFields calls wayplatform.connect.mapon.v1.MaponApi.ListDriverCustomFields.
+func (c *maponApiClient) ListDriverCustomFields(ctx context.Context, req *v1.ListDriverCustomFieldsRequest) (*v1.ListDriverCustomFieldsResponse, error) {
+	response, err := c.listDriverCustomFields.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SaveDriverCustomFields calls wayplatform.connect.mapon.v1.MaponApi.SaveDriverCustomFields.
+func (c *maponApiClient) SaveDriverCustomFields(ctx context.Context, req *v1.SaveDriverCustomFieldsRequest) (*v1.SaveDriverCustomFieldsResponse, error) {
+	response, err := c.saveDriverCustomFields.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SaveDriverCustomFieldValues calls
+// wayplatform.connect.mapon.v1.MaponApi.SaveDriverCustomFieldValues.
+func (c *maponApiClient) SaveDriverCustomFieldValues(ctx context.Context, req *v1.SaveDriverCustomFieldValuesRequest) (*v1.SaveDriverCustomFieldValuesResponse, error) {
+	response, err := c.saveDriverCustomFieldValues.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteDriverCustomFields calls wayplatform.connect.mapon.v1.MaponApi.DeleteDriverCustomFields.
+func (c *maponApiClient) DeleteDriverCustomFields(ctx context.Context, req *v1.DeleteDriverCustomFieldsRequest) (*v1.DeleteDriverCustomFieldsResponse, error) {
+	response, err := c.deleteDriverCustomFields.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDriverDailyActivities calls wayplatform.connect.mapon.v1.MaponApi.ListDriverDailyActivities.
+func (c *maponApiClient) ListDriverDailyActivities(ctx context.Context, req *v1.ListDriverDailyActivitiesRequest) (*v1.ListDriverDailyActivitiesResponse, error) {
+	response, err := c.listDriverDailyActivities.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetDriverBehaviourReportDrivers calls
+// wayplatform.connect.mapon.v1.MaponApi.GetDriverBehaviourReportDrivers.
+func (c *maponApiClient) GetDriverBehaviourReportDrivers(ctx context.Context, req *v1.GetDriverBehaviourReportDriversRequest) (*v1.GetDriverBehaviourReportDriversResponse, error) {
+	response, err := c.getDriverBehaviourReportDrivers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetDriverBehaviourReportUnits calls
+// wayplatform.connect.mapon.v1.MaponApi.GetDriverBehaviourReportUnits.
+func (c *maponApiClient) GetDriverBehaviourReportUnits(ctx context.Context, req *v1.GetDriverBehaviourReportUnitsRequest) (*v1.GetDriverBehaviourReportUnitsResponse, error) {
+	response, err := c.getDriverBehaviourReportUnits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDriverGroups calls wayplatform.connect.mapon.v1.MaponApi.ListDriverGroups.
+func (c *maponApiClient) ListDriverGroups(ctx context.Context, req *v1.ListDriverGroupsRequest) (*v1.ListDriverGroupsResponse, error) {
+	response, err := c.listDriverGroups.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDriversInGroup calls wayplatform.connect.mapon.v1.MaponApi.ListDriversInGroup.
+func (c *maponApiClient) ListDriversInGroup(ctx context.Context, req *v1.ListDriversInGroupRequest) (*v1.ListDriversInGroupResponse, error) {
+	response, err := c.listDriversInGroup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SaveDriverGroup calls wayplatform.connect.mapon.v1.MaponApi.SaveDriverGroup.
+func (c *maponApiClient) SaveDriverGroup(ctx context.Context, req *v1.SaveDriverGroupRequest) (*v1.SaveDriverGroupResponse, error) {
+	response, err := c.saveDriverGroup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteDriverGroup calls wayplatform.connect.mapon.v1.MaponApi.DeleteDriverGroup.
+func (c *maponApiClient) DeleteDriverGroup(ctx context.Context, req *v1.DeleteDriverGroupRequest) (*v1.DeleteDriverGroupResponse, error) {
+	response, err := c.deleteDriverGroup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AddDriverToGroup calls wayplatform.connect.mapon.v1.MaponApi.AddDriverToGroup.
+func (c *maponApiClient) AddDriverToGroup(ctx context.Context, req *v1.AddDriverToGroupRequest) (*v1.AddDriverToGroupResponse, error) {
+	response, err := c.addDriverToGroup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RemoveDriverFromGroup calls wayplatform.connect.mapon.v1.MaponApi.RemoveDriverFromGroup.
+func (c *maponApiClient) RemoveDriverFromGroup(ctx context.Context, req *v1.RemoveDriverFromGroupRequest) (*v1.RemoveDriverFromGroupResponse, error) {
+	response, err := c.removeDriverFromGroup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ClearDriverGroups calls wayplatform.connect.mapon.v1.MaponApi.ClearDriverGroups.
+func (c *maponApiClient) ClearDriverGroups(ctx context.Context, req *v1.ClearDriverGroupsRequest) (*v1.ClearDriverGroupsResponse, error) {
+	response, err := c.clearDriverGroups.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListFuelData calls wayplatform.connect.mapon.v1.MaponApi.ListFuelData.
+func (c *maponApiClient) ListFuelData(ctx context.Context, req *v1.ListFuelDataRequest) (*v1.ListFuelDataResponse, error) {
+	response, err := c.listFuelData.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListFuelChanges calls wayplatform.connect.mapon.v1.MaponApi.ListFuelChanges.
+func (c *maponApiClient) ListFuelChanges(ctx context.Context, req *v1.ListFuelChangesRequest) (*v1.ListFuelChangesResponse, error) {
+	response, err := c.listFuelChanges.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetFuelSummary calls wayplatform.connect.mapon.v1.MaponApi.GetFuelSummary.
+func (c *maponApiClient) GetFuelSummary(ctx context.Context, req *v1.GetFuelSummaryRequest) (*v1.GetFuelSummaryResponse, error) {
+	response, err := c.getFuelSummary.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListFuelChecks calls wayplatform.connect.mapon.v1.MaponApi.ListFuelChecks.
+func (c *maponApiClient) ListFuelChecks(ctx context.Context, req *v1.ListFuelChecksRequest) (*v1.ListFuelChecksResponse, error) {
+	response, err := c.listFuelChecks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AddFuelCheck calls wayplatform.connect.mapon.v1.MaponApi.AddFuelCheck.
+func (c *maponApiClient) AddFuelCheck(ctx context.Context, req *v1.AddFuelCheckRequest) (*v1.AddFuelCheckResponse, error) {
+	response, err := c.addFuelCheck.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EditFuelCheck calls wayplatform.connect.mapon.v1.MaponApi.EditFuelCheck.
+func (c *maponApiClient) EditFuelCheck(ctx context.Context, req *v1.EditFuelCheckRequest) (*v1.EditFuelCheckResponse, error) {
+	response, err := c.editFuelCheck.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteFuelCheck calls wayplatform.connect.mapon.v1.MaponApi.DeleteFuelCheck.
+func (c *maponApiClient) DeleteFuelCheck(ctx context.Context, req *v1.DeleteFuelCheckRequest) (*v1.DeleteFuelCheckResponse, error) {
+	response, err := c.deleteFuelCheck.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AddFuelCard calls wayplatform.connect.mapon.v1.MaponApi.AddFuelCard.
+func (c *maponApiClient) AddFuelCard(ctx context.Context, req *v1.AddFuelCardRequest) (*v1.AddFuelCardResponse, error) {
+	response, err := c.addFuelCard.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateFuelCard calls wayplatform.connect.mapon.v1.MaponApi.UpdateFuelCard.
+func (c *maponApiClient) UpdateFuelCard(ctx context.Context, req *v1.UpdateFuelCardRequest) (*v1.UpdateFuelCardResponse, error) {
+	response, err := c.updateFuelCard.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteFuelCard calls wayplatform.connect.mapon.v1.MaponApi.DeleteFuelCard.
+func (c *maponApiClient) DeleteFuelCard(ctx context.Context, req *v1.DeleteFuelCardRequest) (*v1.DeleteFuelCardResponse, error) {
+	response, err := c.deleteFuelCard.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListMessagingChannels calls wayplatform.connect.mapon.v1.MaponApi.ListMessagingChannels.
+func (c *maponApiClient) ListMessagingChannels(ctx context.Context, req *v1.ListMessagingChannelsRequest) (*v1.ListMessagingChannelsResponse, error) {
+	response, err := c.listMessagingChannels.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListMessagingConversations calls
+// wayplatform.connect.mapon.v1.MaponApi.ListMessagingConversations.
+func (c *maponApiClient) ListMessagingConversations(ctx context.Context, req *v1.ListMessagingConversationsRequest) (*v1.ListMessagingConversationsResponse, error) {
+	response, err := c.listMessagingConversations.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateMessagingConversation calls
+// wayplatform.connect.mapon.v1.MaponApi.CreateMessagingConversation.
+func (c *maponApiClient) CreateMessagingConversation(ctx context.Context, req *v1.CreateMessagingConversationRequest) (*v1.CreateMessagingConversationResponse, error) {
+	response, err := c.createMessagingConversation.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListMessages calls wayplatform.connect.mapon.v1.MaponApi.ListMessages.
+func (c *maponApiClient) ListMessages(ctx context.Context, req *v1.ListMessagesRequest) (*v1.ListMessagesResponse, error) {
+	response, err := c.listMessages.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SendMessage calls wayplatform.connect.mapon.v1.MaponApi.SendMessage.
+func (c *maponApiClient) SendMessage(ctx context.Context, req *v1.SendMessageRequest) (*v1.SendMessageResponse, error) {
+	response, err := c.sendMessage.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListObjects calls wayplatform.connect.mapon.v1.MaponApi.ListObjects.
+func (c *maponApiClient) ListObjects(ctx context.Context, req *v1.ListObjectsRequest) (*v1.ListObjectsResponse, error) {
+	response, err := c.listObjects.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SaveObject calls wayplatform.connect.mapon.v1.MaponApi.SaveObject.
+func (c *maponApiClient) SaveObject(ctx context.Context, req *v1.SaveObjectRequest) (*v1.SaveObjectResponse, error) {
+	response, err := c.saveObject.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteObject calls wayplatform.connect.mapon.v1.MaponApi.DeleteObject.
+func (c *maponApiClient) DeleteObject(ctx context.Context, req *v1.DeleteObjectRequest) (*v1.DeleteObjectResponse, error) {
+	response, err := c.deleteObject.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetObjectCustomFields calls wayplatform.connect.mapon.v1.MaponApi.GetObjectCustomFields.
+func (c *maponApiClient) GetObjectCustomFields(ctx context.Context, req *v1.GetObjectCustomFieldsRequest) (*v1.GetObjectCustomFieldsResponse, error) {
+	response, err := c.getObjectCustomFields.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SaveObjectCustomFieldsValues calls
+// wayplatform.connect.mapon.v1.MaponApi.SaveObjectCustomFieldsValues.
+func (c *maponApiClient) SaveObjectCustomFieldsValues(ctx context.Context, req *v1.SaveObjectCustomFieldsValuesRequest) (*v1.SaveObjectCustomFieldsValuesResponse, error) {
+	response, err := c.saveObjectCustomFieldsValues.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListObjectGroups calls wayplatform.connect.mapon.v1.MaponApi.ListObjectGroups.
+func (c *maponApiClient) ListObjectGroups(ctx context.Context, req *v1.ListObjectGroupsRequest) (*v1.ListObjectGroupsResponse, error) {
+	response, err := c.listObjectGroups.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SaveObjectGroup calls wayplatform.connect.mapon.v1.MaponApi.SaveObjectGroup.
+func (c *maponApiClient) SaveObjectGroup(ctx context.Context, req *v1.SaveObjectGroupRequest) (*v1.SaveObjectGroupResponse, error) {
+	response, err := c.saveObjectGroup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteObjectGroup calls wayplatform.connect.mapon.v1.MaponApi.DeleteObjectGroup.
+func (c *maponApiClient) DeleteObjectGroup(ctx context.Context, req *v1.DeleteObjectGroupRequest) (*v1.DeleteObjectGroupResponse, error) {
+	response, err := c.deleteObjectGroup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListPresets calls wayplatform.connect.mapon.v1.MaponApi.ListPresets.
+func (c *maponApiClient) ListPresets(ctx context.Context, req *v1.ListPresetsRequest) (*v1.ListPresetsResponse, error) {
+	response, err := c.listPresets.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetPreset calls wayplatform.connect.mapon.v1.MaponApi.GetPreset.
+func (c *maponApiClient) GetPreset(ctx context.Context, req *v1.GetPresetRequest) (*v1.GetPresetResponse, error) {
+	response, err := c.getPreset.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreatePreset calls wayplatform.connect.mapon.v1.MaponApi.CreatePreset.
+func (c *maponApiClient) CreatePreset(ctx context.Context, req *v1.CreatePresetRequest) (*v1.CreatePresetResponse, error) {
+	response, err := c.createPreset.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EditPreset calls wayplatform.connect.mapon.v1.MaponApi.EditPreset.
+func (c *maponApiClient) EditPreset(ctx context.Context, req *v1.EditPresetRequest) (*v1.EditPresetResponse, error) {
+	response, err := c.editPreset.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeletePreset calls wayplatform.connect.mapon.v1.MaponApi.DeletePreset.
+func (c *maponApiClient) DeletePreset(ctx context.Context, req *v1.DeletePresetRequest) (*v1.DeletePresetResponse, error) {
+	response, err := c.deletePreset.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetAvailablePresetPermissions calls
+// wayplatform.connect.mapon.v1.MaponApi.GetAvailablePresetPermissions.
+func (c *maponApiClient) GetAvailablePresetPermissions(ctx context.Context, req *v1.GetAvailablePresetPermissionsRequest) (*v1.GetAvailablePresetPermissionsResponse, error) {
+	response, err := c.getAvailablePresetPermissions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetReeferHistoricPeriod calls wayplatform.connect.mapon.v1.MaponApi.GetReeferHistoricPeriod.
+func (c *maponApiClient) GetReeferHistoricPeriod(ctx context.Context, req *v1.GetReeferHistoricPeriodRequest) (*v1.GetReeferHistoricPeriodResponse, error) {
+	response, err := c.getReeferHistoricPeriod.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetReeferHistoricPoint calls wayplatform.connect.mapon.v1.MaponApi.GetReeferHistoricPoint.
+func (c *maponApiClient) GetReeferHistoricPoint(ctx context.Context, req *v1.GetReeferHistoricPointRequest) (*v1.GetReeferHistoricPointResponse, error) {
+	response, err := c.getReeferHistoricPoint.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListReeferTemperatureData calls wayplatform.connect.mapon.v1.MaponApi.ListReeferTemperatureData.
+func (c *maponApiClient) ListReeferTemperatureData(ctx context.Context, req *v1.ListReeferTemperatureDataRequest) (*v1.ListReeferTemperatureDataResponse, error) {
+	response, err := c.listReeferTemperatureData.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListReeferRunModes calls wayplatform.connect.mapon.v1.MaponApi.ListReeferRunModes.
+func (c *maponApiClient) ListReeferRunModes(ctx context.Context, req *v1.ListReeferRunModesRequest) (*v1.ListReeferRunModesResponse, error) {
+	response, err := c.listReeferRunModes.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ChangeReeferSetpoint calls wayplatform.connect.mapon.v1.MaponApi.ChangeReeferSetpoint.
+func (c *maponApiClient) ChangeReeferSetpoint(ctx context.Context, req *v1.ChangeReeferSetpointRequest) (*v1.ChangeReeferSetpointResponse, error) {
+	response, err := c.changeReeferSetpoint.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ChangeReeferRunMode calls wayplatform.connect.mapon.v1.MaponApi.ChangeReeferRunMode.
+func (c *maponApiClient) ChangeReeferRunMode(ctx context.Context, req *v1.ChangeReeferRunModeRequest) (*v1.ChangeReeferRunModeResponse, error) {
+	response, err := c.changeReeferRunMode.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetReeferAlert calls wayplatform.connect.mapon.v1.MaponApi.SetReeferAlert.
+func (c *maponApiClient) SetReeferAlert(ctx context.Context, req *v1.SetReeferAlertRequest) (*v1.SetReeferAlertResponse, error) {
+	response, err := c.setReeferAlert.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListReeferAlerts calls wayplatform.connect.mapon.v1.MaponApi.ListReeferAlerts.
+func (c *maponApiClient) ListReeferAlerts(ctx context.Context, req *v1.ListReeferAlertsRequest) (*v1.ListReeferAlertsResponse, error) {
+	response, err := c.listReeferAlerts.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteReeferAlert calls wayplatform.connect.mapon.v1.MaponApi.DeleteReeferAlert.
+func (c *maponApiClient) DeleteReeferAlert(ctx context.Context, req *v1.DeleteReeferAlertRequest) (*v1.DeleteReeferAlertResponse, error) {
+	response, err := c.deleteReeferAlert.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ChangeReeferAlertUser calls wayplatform.connect.mapon.v1.MaponApi.ChangeReeferAlertUser.
+func (c *maponApiClient) ChangeReeferAlertUser(ctx context.Context, req *v1.ChangeReeferAlertUserRequest) (*v1.ChangeReeferAlertUserResponse, error) {
+	response, err := c.changeReeferAlertUser.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListRoutes calls wayplatform.connect.mapon.v1.MaponApi.ListRoutes.
+func (c *maponApiClient) ListRoutes(ctx context.Context, req *v1.ListRoutesRequest) (*v1.ListRoutesResponse, error) {
+	response, err := c.listRoutes.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateRoutePlanningOrder calls wayplatform.connect.mapon.v1.MaponApi.CreateRoutePlanningOrder.
+func (c *maponApiClient) CreateRoutePlanningOrder(ctx context.Context, req *v1.CreateRoutePlanningOrderRequest) (*v1.CreateRoutePlanningOrderResponse, error) {
+	response, err := c.createRoutePlanningOrder.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AddRoutePlanningOrderPlaces calls
+// wayplatform.connect.mapon.v1.MaponApi.AddRoutePlanningOrderPlaces.
+func (c *maponApiClient) AddRoutePlanningOrderPlaces(ctx context.Context, req *v1.AddRoutePlanningOrderPlacesRequest) (*v1.AddRoutePlanningOrderPlacesResponse, error) {
+	response, err := c.addRoutePlanningOrderPlaces.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetRoutePlanningOrder calls wayplatform.connect.mapon.v1.MaponApi.GetRoutePlanningOrder.
+func (c *maponApiClient) GetRoutePlanningOrder(ctx context.Context, req *v1.GetRoutePlanningOrderRequest) (*v1.GetRoutePlanningOrderResponse, error) {
+	response, err := c.getRoutePlanningOrder.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListRoutePlanningOrders calls wayplatform.connect.mapon.v1.MaponApi.ListRoutePlanningOrders.
+func (c *maponApiClient) ListRoutePlanningOrders(ctx context.Context, req *v1.ListRoutePlanningOrdersRequest) (*v1.ListRoutePlanningOrdersResponse, error) {
+	response, err := c.listRoutePlanningOrders.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteRoutePlanningOrders calls wayplatform.connect.mapon.v1.MaponApi.DeleteRoutePlanningOrders.
+func (c *maponApiClient) DeleteRoutePlanningOrders(ctx context.Context, req *v1.DeleteRoutePlanningOrdersRequest) (*v1.DeleteRoutePlanningOrdersResponse, error) {
+	response, err := c.deleteRoutePlanningOrders.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetRoutePlanningPlace calls wayplatform.connect.mapon.v1.MaponApi.GetRoutePlanningPlace.
+func (c *maponApiClient) GetRoutePlanningPlace(ctx context.Context, req *v1.GetRoutePlanningPlaceRequest) (*v1.GetRoutePlanningPlaceResponse, error) {
+	response, err := c.getRoutePlanningPlace.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListRoutePlanningPlaces calls wayplatform.connect.mapon.v1.MaponApi.ListRoutePlanningPlaces.
+func (c *maponApiClient) ListRoutePlanningPlaces(ctx context.Context, req *v1.ListRoutePlanningPlacesRequest) (*v1.ListRoutePlanningPlacesResponse, error) {
+	response, err := c.listRoutePlanningPlaces.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteRoutePlanningPlaces calls wayplatform.connect.mapon.v1.MaponApi.DeleteRoutePlanningPlaces.
+func (c *maponApiClient) DeleteRoutePlanningPlaces(ctx context.Context, req *v1.DeleteRoutePlanningPlacesRequest) (*v1.DeleteRoutePlanningPlacesResponse, error) {
+	response, err := c.deleteRoutePlanningPlaces.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SaveRoutePlanningRoute calls wayplatform.connect.mapon.v1.MaponApi.SaveRoutePlanningRoute.
+func (c *maponApiClient) SaveRoutePlanningRoute(ctx context.Context, req *v1.SaveRoutePlanningRouteRequest) (*v1.SaveRoutePlanningRouteResponse, error) {
+	response, err := c.saveRoutePlanningRoute.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetRoutePlanningRoute calls wayplatform.connect.mapon.v1.MaponApi.GetRoutePlanningRoute.
+func (c *maponApiClient) GetRoutePlanningRoute(ctx context.Context, req *v1.GetRoutePlanningRouteRequest) (*v1.GetRoutePlanningRouteResponse, error) {
+	response, err := c.getRoutePlanningRoute.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListRoutePlanningRoutes calls wayplatform.connect.mapon.v1.MaponApi.ListRoutePlanningRoutes.
+func (c *maponApiClient) ListRoutePlanningRoutes(ctx context.Context, req *v1.ListRoutePlanningRoutesRequest) (*v1.ListRoutePlanningRoutesResponse, error) {
+	response, err := c.listRoutePlanningRoutes.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SendRoutePlanningRouteToAssignee calls
+// wayplatform.connect.mapon.v1.MaponApi.SendRoutePlanningRouteToAssignee.
+func (c *maponApiClient) SendRoutePlanningRouteToAssignee(ctx context.Context, req *v1.SendRoutePlanningRouteToAssigneeRequest) (*v1.SendRoutePlanningRouteToAssigneeResponse, error) {
+	response, err := c.sendRoutePlanningRouteToAssignee.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetRoutePlanningRouteStartAddress calls
+// wayplatform.connect.mapon.v1.MaponApi.SetRoutePlanningRouteStartAddress.
+func (c *maponApiClient) SetRoutePlanningRouteStartAddress(ctx context.Context, req *v1.SetRoutePlanningRouteStartAddressRequest) (*v1.SetRoutePlanningRouteStartAddressResponse, error) {
+	response, err := c.setRoutePlanningRouteStartAddress.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetRoutePlanningRouteEndAddress calls
+// wayplatform.connect.mapon.v1.MaponApi.SetRoutePlanningRouteEndAddress.
+func (c *maponApiClient) SetRoutePlanningRouteEndAddress(ctx context.Context, req *v1.SetRoutePlanningRouteEndAddressRequest) (*v1.SetRoutePlanningRouteEndAddressResponse, error) {
+	response, err := c.setRoutePlanningRouteEndAddress.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// OptimizeRoutePlanningRoute calls
+// wayplatform.connect.mapon.v1.MaponApi.OptimizeRoutePlanningRoute.
+func (c *maponApiClient) OptimizeRoutePlanningRoute(ctx context.Context, req *v1.OptimizeRoutePlanningRouteRequest) (*v1.OptimizeRoutePlanningRouteResponse, error) {
+	response, err := c.optimizeRoutePlanningRoute.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetRoutePlanningOptimizationProgress calls
+// wayplatform.connect.mapon.v1.MaponApi.GetRoutePlanningOptimizationProgress.
+func (c *maponApiClient) GetRoutePlanningOptimizationProgress(ctx context.Context, req *v1.GetRoutePlanningOptimizationProgressRequest) (*v1.GetRoutePlanningOptimizationProgressResponse, error) {
+	response, err := c.getRoutePlanningOptimizationProgress.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteRoutePlanningRoutes calls wayplatform.connect.mapon.v1.MaponApi.DeleteRoutePlanningRoutes.
+func (c *maponApiClient) DeleteRoutePlanningRoutes(ctx context.Context, req *v1.DeleteRoutePlanningRoutesRequest) (*v1.DeleteRoutePlanningRoutesResponse, error) {
+	response, err := c.deleteRoutePlanningRoutes.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDriverDddFiles calls wayplatform.connect.mapon.v1.MaponApi.ListDriverDddFiles.
+func (c *maponApiClient) ListDriverDddFiles(ctx context.Context, req *v1.ListDriverDddFilesRequest) (*v1.ListDriverDddFilesResponse, error) {
+	response, err := c.listDriverDddFiles.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListVehicleDddFiles calls wayplatform.connect.mapon.v1.MaponApi.ListVehicleDddFiles.
+func (c *maponApiClient) ListVehicleDddFiles(ctx context.Context, req *v1.ListVehicleDddFilesRequest) (*v1.ListVehicleDddFilesResponse, error) {
+	response, err := c.listVehicleDddFiles.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DownloadDriverDdd calls wayplatform.connect.mapon.v1.MaponApi.DownloadDriverDdd.
+func (c *maponApiClient) DownloadDriverDdd(ctx context.Context, req *v1.DownloadDriverDddRequest) (*v1.DownloadDriverDddResponse, error) {
+	response, err := c.downloadDriverDdd.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DownloadVehicleDdd calls wayplatform.connect.mapon.v1.MaponApi.DownloadVehicleDdd.
+func (c *maponApiClient) DownloadVehicleDdd(ctx context.Context, req *v1.DownloadVehicleDddRequest) (*v1.DownloadVehicleDddResponse, error) {
+	response, err := c.downloadVehicleDdd.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTasks calls wayplatform.connect.mapon.v1.MaponApi.ListTasks.
+func (c *maponApiClient) ListTasks(ctx context.Context, req *v1.ListTasksRequest) (*v1.ListTasksResponse, error) {
+	response, err := c.listTasks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateTask calls wayplatform.connect.mapon.v1.MaponApi.CreateTask.
+func (c *maponApiClient) CreateTask(ctx context.Context, req *v1.CreateTaskRequest) (*v1.CreateTaskResponse, error) {
+	response, err := c.createTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EditTask calls wayplatform.connect.mapon.v1.MaponApi.EditTask.
+func (c *maponApiClient) EditTask(ctx context.Context, req *v1.EditTaskRequest) (*v1.EditTaskResponse, error) {
+	response, err := c.editTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteTask calls wayplatform.connect.mapon.v1.MaponApi.DeleteTask.
+func (c *maponApiClient) DeleteTask(ctx context.Context, req *v1.DeleteTaskRequest) (*v1.DeleteTaskResponse, error) {
+	response, err := c.deleteTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteTaskRoute calls wayplatform.connect.mapon.v1.MaponApi.DeleteTaskRoute.
+func (c *maponApiClient) DeleteTaskRoute(ctx context.Context, req *v1.DeleteTaskRouteRequest) (*v1.DeleteTaskRouteResponse, error) {
+	response, err := c.deleteTaskRoute.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTellTaleValues calls wayplatform.connect.mapon.v1.MaponApi.ListTellTaleValues.
+func (c *maponApiClient) ListTellTaleValues(ctx context.Context, req *v1.ListTellTaleValuesRequest) (*v1.ListTellTaleValuesResponse, error) {
+	response, err := c.listTellTaleValues.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTrackingLinks calls wayplatform.connect.mapon.v1.MaponApi.ListTrackingLinks.
+func (c *maponApiClient) ListTrackingLinks(ctx context.Context, req *v1.ListTrackingLinksRequest) (*v1.ListTrackingLinksResponse, error) {
+	response, err := c.listTrackingLinks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateTrackingLink calls wayplatform.connect.mapon.v1.MaponApi.CreateTrackingLink.
+func (c *maponApiClient) CreateTrackingLink(ctx context.Context, req *v1.CreateTrackingLinkRequest) (*v1.CreateTrackingLinkResponse, error) {
+	response, err := c.createTrackingLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EditTrackingLink calls wayplatform.connect.mapon.v1.MaponApi.EditTrackingLink.
+func (c *maponApiClient) EditTrackingLink(ctx context.Context, req *v1.EditTrackingLinkRequest) (*v1.EditTrackingLinkResponse, error) {
+	response, err := c.editTrackingLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteTrackingLink calls wayplatform.connect.mapon.v1.MaponApi.DeleteTrackingLink.
+func (c *maponApiClient) DeleteTrackingLink(ctx context.Context, req *v1.DeleteTrackingLinkRequest) (*v1.DeleteTrackingLinkResponse, error) {
+	response, err := c.deleteTrackingLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListUnits calls wayplatform.connect.mapon.v1.MaponApi.ListUnits.
+func (c *maponApiClient) ListUnits(ctx context.Context, req *v1.ListUnitsRequest) (*v1.ListUnitsResponse, error) {
+	response, err := c.listUnits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EditUnit calls wayplatform.connect.mapon.v1.MaponApi.EditUnit.
+func (c *maponApiClient) EditUnit(ctx context.Context, req *v1.EditUnitRequest) (*v1.EditUnitResponse, error) {
+	response, err := c.editUnit.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// InstallUnit calls wayplatform.connect.mapon.v1.MaponApi.InstallUnit.
+func (c *maponApiClient) InstallUnit(ctx context.Context, req *v1.InstallUnitRequest) (*v1.InstallUnitResponse, error) {
+	response, err := c.installUnit.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UninstallUnit calls wayplatform.connect.mapon.v1.MaponApi.UninstallUnit.
+func (c *maponApiClient) UninstallUnit(ctx context.Context, req *v1.UninstallUnitRequest) (*v1.UninstallUnitResponse, error) {
+	response, err := c.uninstallUnit.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ChangeUnitDevice calls wayplatform.connect.mapon.v1.MaponApi.ChangeUnitDevice.
+func (c *maponApiClient) ChangeUnitDevice(ctx context.Context, req *v1.ChangeUnitDeviceRequest) (*v1.ChangeUnitDeviceResponse, error) {
+	response, err := c.changeUnitDevice.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ChangeUnitRelay calls wayplatform.connect.mapon.v1.MaponApi.ChangeUnitRelay.
+func (c *maponApiClient) ChangeUnitRelay(ctx context.Context, req *v1.ChangeUnitRelayRequest) (*v1.ChangeUnitRelayResponse, error) {
+	response, err := c.changeUnitRelay.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListAvailableUnitIcons calls wayplatform.connect.mapon.v1.MaponApi.ListAvailableUnitIcons.
+func (c *maponApiClient) ListAvailableUnitIcons(ctx context.Context, req *v1.ListAvailableUnitIconsRequest) (*v1.ListAvailableUnitIconsResponse, error) {
+	response, err := c.listAvailableUnitIcons.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListUnitCustomFields calls wayplatform.connect.mapon.v1.MaponApi.ListUnitCustomFields.
+func (c *maponApiClient) ListUnitCustomFields(ctx context.Context, req *v1.ListUnitCustomFieldsRequest) (*v1.ListUnitCustomFieldsResponse, error) {
+	response, err := c.listUnitCustomFields.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SaveUnitCustomFieldValues calls wayplatform.connect.mapon.v1.MaponApi.SaveUnitCustomFieldValues.
+func (c *maponApiClient) SaveUnitCustomFieldValues(ctx context.Context, req *v1.SaveUnitCustomFieldValuesRequest) (*v1.SaveUnitCustomFieldValuesResponse, error) {
+	response, err := c.saveUnitCustomFieldValues.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetAvailableUnitCommands calls wayplatform.connect.mapon.v1.MaponApi.GetAvailableUnitCommands.
+func (c *maponApiClient) GetAvailableUnitCommands(ctx context.Context, req *v1.GetAvailableUnitCommandsRequest) (*v1.GetAvailableUnitCommandsResponse, error) {
+	response, err := c.getAvailableUnitCommands.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ExecuteUnitCommand calls wayplatform.connect.mapon.v1.MaponApi.ExecuteUnitCommand.
+func (c *maponApiClient) ExecuteUnitCommand(ctx context.Context, req *v1.ExecuteUnitCommandRequest) (*v1.ExecuteUnitCommandResponse, error) {
+	response, err := c.executeUnitCommand.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListUnitGroups calls wayplatform.connect.mapon.v1.MaponApi.ListUnitGroups.
+func (c *maponApiClient) ListUnitGroups(ctx context.Context, req *v1.ListUnitGroupsRequest) (*v1.ListUnitGroupsResponse, error) {
+	response, err := c.listUnitGroups.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
 	}
 	return nil, err
 }
@@ -422,6 +3268,51 @@ func (c *maponApiClient) ListUnitsInGroup(ctx context.Context, req *v1.ListUnits
 	return nil, err
 }
 
+// SaveUnitGroup calls wayplatform.connect.mapon.v1.MaponApi.SaveUnitGroup.
+func (c *maponApiClient) SaveUnitGroup(ctx context.Context, req *v1.SaveUnitGroupRequest) (*v1.SaveUnitGroupResponse, error) {
+	response, err := c.saveUnitGroup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteUnitGroup calls wayplatform.connect.mapon.v1.MaponApi.DeleteUnitGroup.
+func (c *maponApiClient) DeleteUnitGroup(ctx context.Context, req *v1.DeleteUnitGroupRequest) (*v1.DeleteUnitGroupResponse, error) {
+	response, err := c.deleteUnitGroup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AttachUnitToGroup calls wayplatform.connect.mapon.v1.MaponApi.AttachUnitToGroup.
+func (c *maponApiClient) AttachUnitToGroup(ctx context.Context, req *v1.AttachUnitToGroupRequest) (*v1.AttachUnitToGroupResponse, error) {
+	response, err := c.attachUnitToGroup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DetachUnitFromGroup calls wayplatform.connect.mapon.v1.MaponApi.DetachUnitFromGroup.
+func (c *maponApiClient) DetachUnitFromGroup(ctx context.Context, req *v1.DetachUnitFromGroupRequest) (*v1.DetachUnitFromGroupResponse, error) {
+	response, err := c.detachUnitFromGroup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ClearUnitGroups calls wayplatform.connect.mapon.v1.MaponApi.ClearUnitGroups.
+func (c *maponApiClient) ClearUnitGroups(ctx context.Context, req *v1.ClearUnitGroupsRequest) (*v1.ClearUnitGroupsResponse, error) {
+	response, err := c.clearUnitGroups.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // GetCanDataPoint calls wayplatform.connect.mapon.v1.MaponApi.GetCanDataPoint.
 func (c *maponApiClient) GetCanDataPoint(ctx context.Context, req *v1.GetCanDataPointRequest) (*v1.GetCanDataPointResponse, error) {
 	response, err := c.getCanDataPoint.CallUnary(ctx, connect.NewRequest(req))
@@ -530,30 +3421,404 @@ func (c *maponApiClient) ListTemperatures(ctx context.Context, req *v1.ListTempe
 	return nil, err
 }
 
+// ListUsers calls wayplatform.connect.mapon.v1.MaponApi.ListUsers.
+func (c *maponApiClient) ListUsers(ctx context.Context, req *v1.ListUsersRequest) (*v1.ListUsersResponse, error) {
+	response, err := c.listUsers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateUser calls wayplatform.connect.mapon.v1.MaponApi.CreateUser.
+func (c *maponApiClient) CreateUser(ctx context.Context, req *v1.CreateUserRequest) (*v1.CreateUserResponse, error) {
+	response, err := c.createUser.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateUser calls wayplatform.connect.mapon.v1.MaponApi.UpdateUser.
+func (c *maponApiClient) UpdateUser(ctx context.Context, req *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error) {
+	response, err := c.updateUser.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteUser calls wayplatform.connect.mapon.v1.MaponApi.DeleteUser.
+func (c *maponApiClient) DeleteUser(ctx context.Context, req *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error) {
+	response, err := c.deleteUser.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ChangeUserPassword calls wayplatform.connect.mapon.v1.MaponApi.ChangeUserPassword.
+func (c *maponApiClient) ChangeUserPassword(ctx context.Context, req *v1.ChangeUserPasswordRequest) (*v1.ChangeUserPasswordResponse, error) {
+	response, err := c.changeUserPassword.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// LinkUserToDriver calls wayplatform.connect.mapon.v1.MaponApi.LinkUserToDriver.
+func (c *maponApiClient) LinkUserToDriver(ctx context.Context, req *v1.LinkUserToDriverRequest) (*v1.LinkUserToDriverResponse, error) {
+	response, err := c.linkUserToDriver.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UnlinkUserFromDriver calls wayplatform.connect.mapon.v1.MaponApi.UnlinkUserFromDriver.
+func (c *maponApiClient) UnlinkUserFromDriver(ctx context.Context, req *v1.UnlinkUserFromDriverRequest) (*v1.UnlinkUserFromDriverResponse, error) {
+	response, err := c.unlinkUserFromDriver.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListVehicleInspections calls wayplatform.connect.mapon.v1.MaponApi.ListVehicleInspections.
+func (c *maponApiClient) ListVehicleInspections(ctx context.Context, req *v1.ListVehicleInspectionsRequest) (*v1.ListVehicleInspectionsResponse, error) {
+	response, err := c.listVehicleInspections.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateVehiclePinDeviceMappings calls
+// wayplatform.connect.mapon.v1.MaponApi.CreateVehiclePinDeviceMappings.
+func (c *maponApiClient) CreateVehiclePinDeviceMappings(ctx context.Context, req *v1.CreateVehiclePinDeviceMappingsRequest) (*v1.CreateVehiclePinDeviceMappingsResponse, error) {
+	response, err := c.createVehiclePinDeviceMappings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteVehiclePinDeviceMappings calls
+// wayplatform.connect.mapon.v1.MaponApi.DeleteVehiclePinDeviceMappings.
+func (c *maponApiClient) DeleteVehiclePinDeviceMappings(ctx context.Context, req *v1.DeleteVehiclePinDeviceMappingsRequest) (*v1.DeleteVehiclePinDeviceMappingsResponse, error) {
+	response, err := c.deleteVehiclePinDeviceMappings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateDriverPins calls wayplatform.connect.mapon.v1.MaponApi.CreateDriverPins.
+func (c *maponApiClient) CreateDriverPins(ctx context.Context, req *v1.CreateDriverPinsRequest) (*v1.CreateDriverPinsResponse, error) {
+	response, err := c.createDriverPins.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateDriverPins calls wayplatform.connect.mapon.v1.MaponApi.UpdateDriverPins.
+func (c *maponApiClient) UpdateDriverPins(ctx context.Context, req *v1.UpdateDriverPinsRequest) (*v1.UpdateDriverPinsResponse, error) {
+	response, err := c.updateDriverPins.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // MaponApiHandler is an implementation of the wayplatform.connect.mapon.v1.MaponApi service.
 type MaponApiHandler interface {
 	// ListAlerts returns triggered alerts.
 	ListAlerts(context.Context, *v1.ListAlertsRequest) (*v1.ListAlertsResponse, error)
+	// ListAlertSetups lists the configured alert setups.
+	ListAlertSetups(context.Context, *v1.ListAlertSetupsRequest) (*v1.ListAlertSetupsResponse, error)
+	// GetAlertSetupTypes returns the alert types that can be managed via the API.
+	GetAlertSetupTypes(context.Context, *v1.GetAlertSetupTypesRequest) (*v1.GetAlertSetupTypesResponse, error)
+	// GetAlertSetupFields returns the fields supported by an alert type.
+	GetAlertSetupFields(context.Context, *v1.GetAlertSetupFieldsRequest) (*v1.GetAlertSetupFieldsResponse, error)
+	// StoreAlertSetup creates or updates an alert setup.
+	StoreAlertSetup(context.Context, *v1.StoreAlertSetupRequest) (*v1.StoreAlertSetupResponse, error)
+	// DeleteAlertSetup deletes an alert setup.
+	DeleteAlertSetup(context.Context, *v1.DeleteAlertSetupRequest) (*v1.DeleteAlertSetupResponse, error)
+	// ListBleTags lists the active BLE tags of the company.
+	ListBleTags(context.Context, *v1.ListBleTagsRequest) (*v1.ListBleTagsResponse, error)
+	// ListBleTagScans lists the scans of a BLE tag in a period.
+	ListBleTagScans(context.Context, *v1.ListBleTagScansRequest) (*v1.ListBleTagScansResponse, error)
+	// ListBleTagLocations lists the location history of a BLE tag in a period.
+	ListBleTagLocations(context.Context, *v1.ListBleTagLocationsRequest) (*v1.ListBleTagLocationsResponse, error)
+	// GetBleTagHistoryPoint returns the scan and location of a BLE tag closest to a point in time.
+	GetBleTagHistoryPoint(context.Context, *v1.GetBleTagHistoryPointRequest) (*v1.GetBleTagHistoryPointResponse, error)
+	// GetCompany returns the basic data of the companies available for the API key.
+	GetCompany(context.Context, *v1.GetCompanyRequest) (*v1.GetCompanyResponse, error)
+	// ListCompanyClients returns a page of the clients of the company.
+	ListCompanyClients(context.Context, *v1.ListCompanyClientsRequest) (*v1.ListCompanyClientsResponse, error)
+	// CreateCompanyClient creates a client of the company.
+	CreateCompanyClient(context.Context, *v1.CreateCompanyClientRequest) (*v1.CreateCompanyClientResponse, error)
+	// ListCustomLayers lists the custom map layers.
+	ListCustomLayers(context.Context, *v1.ListCustomLayersRequest) (*v1.ListCustomLayersResponse, error)
+	// SaveCustomLayer creates or updates a custom map layer.
+	SaveCustomLayer(context.Context, *v1.SaveCustomLayerRequest) (*v1.SaveCustomLayerResponse, error)
+	// DeleteCustomLayer deletes a custom map layer.
+	DeleteCustomLayer(context.Context, *v1.DeleteCustomLayerRequest) (*v1.DeleteCustomLayerResponse, error)
+	// ListCustomLayerGeometries returns a page of the geometries of a custom map layer.
+	ListCustomLayerGeometries(context.Context, *v1.ListCustomLayerGeometriesRequest) (*v1.ListCustomLayerGeometriesResponse, error)
+	// AddCustomLayerGeometries adds geometries to a custom map layer.
+	AddCustomLayerGeometries(context.Context, *v1.AddCustomLayerGeometriesRequest) (*v1.AddCustomLayerGeometriesResponse, error)
+	// EditCustomLayerGeometry updates a custom map layer geometry.
+	EditCustomLayerGeometry(context.Context, *v1.EditCustomLayerGeometryRequest) (*v1.EditCustomLayerGeometryResponse, error)
+	// DeleteCustomLayerGeometry deletes a custom map layer geometry.
+	DeleteCustomLayerGeometry(context.Context, *v1.DeleteCustomLayerGeometryRequest) (*v1.DeleteCustomLayerGeometryResponse, error)
+	// ListCustomTargets lists the custom targets defined for the company.
+	ListCustomTargets(context.Context, *v1.ListCustomTargetsRequest) (*v1.ListCustomTargetsResponse, error)
+	// ListCustomTargetValues returns the values of a custom target recorded for units in the specified period.
+	ListCustomTargetValues(context.Context, *v1.ListCustomTargetValuesRequest) (*v1.ListCustomTargetValuesResponse, error)
 	// DeleteDataForward deregisters a push webhook endpoint from Mapon.
 	DeleteDataForward(context.Context, *v1.DeleteDataForwardRequest) (*v1.DeleteDataForwardResponse, error)
 	// ListDataForwards returns all registered push webhook endpoints for the API key.
 	ListDataForwards(context.Context, *v1.ListDataForwardsRequest) (*v1.ListDataForwardsResponse, error)
 	// SaveDataForward registers a push webhook endpoint with Mapon.
 	SaveDataForward(context.Context, *v1.SaveDataForwardRequest) (*v1.SaveDataForwardResponse, error)
+	// ListDevices lists the devices of the company.
+	ListDevices(context.Context, *v1.ListDevicesRequest) (*v1.ListDevicesResponse, error)
+	// CreateDevice creates a device in the company storage.
+	CreateDevice(context.Context, *v1.CreateDeviceRequest) (*v1.CreateDeviceResponse, error)
+	// ListDeviceModels lists the supported device models.
+	ListDeviceModels(context.Context, *v1.ListDeviceModelsRequest) (*v1.ListDeviceModelsResponse, error)
+	// GetDeviceCommands lists the SMS or TCP commands supported by a device.
+	GetDeviceCommands(context.Context, *v1.GetDeviceCommandsRequest) (*v1.GetDeviceCommandsResponse, error)
+	// SendDeviceSmsCommand sends an SMS command to a device.
+	SendDeviceSmsCommand(context.Context, *v1.SendDeviceSmsCommandRequest) (*v1.SendDeviceSmsCommandResponse, error)
+	// SendDeviceTcpCommand sends a TCP command to a device.
+	SendDeviceTcpCommand(context.Context, *v1.SendDeviceTcpCommandRequest) (*v1.SendDeviceTcpCommandResponse, error)
+	// ListDeviceSmsHistory lists the SMS messages received from a device.
+	ListDeviceSmsHistory(context.Context, *v1.ListDeviceSmsHistoryRequest) (*v1.ListDeviceSmsHistoryResponse, error)
 	// ListDrivers lists the drivers available for the current API key.
 	ListDrivers(context.Context, *v1.ListDriversRequest) (*v1.ListDriversResponse, error)
+	// CreateDriver creates a driver.
+	CreateDriver(context.Context, *v1.CreateDriverRequest) (*v1.CreateDriverResponse, error)
+	// UpdateDriver updates the fields of a driver that are set in the request.
+	UpdateDriver(context.Context, *v1.UpdateDriverRequest) (*v1.UpdateDriverResponse, error)
+	// DeleteDriver deletes a driver.
+	DeleteDriver(context.Context, *v1.DeleteDriverRequest) (*v1.DeleteDriverResponse, error)
+	// ChangeDriverPassword changes the password of a driver.
+	ChangeDriverPassword(context.Context, *v1.ChangeDriverPasswordRequest) (*v1.ChangeDriverPasswordResponse, error)
+	// AssociateExternalDriver associates external drivers with Mapon drivers.
+	AssociateExternalDriver(context.Context, *v1.AssociateExternalDriverRequest) (*v1.AssociateExternalDriverResponse, error)
+	// LinkDriverUser assigns a user to a driver.
+	LinkDriverUser(context.Context, *v1.LinkDriverUserRequest) (*v1.LinkDriverUserResponse, error)
+	// UnlinkDriverUser removes the user assigned to a driver.
+	UnlinkDriverUser(context.Context, *v1.UnlinkDriverUserRequest) (*v1.UnlinkDriverUserResponse, error)
+	// ListDriverCustomFields returns the custom fields and values of drivers.
+	ListDriverCustomFields(context.Context, *v1.ListDriverCustomFieldsRequest) (*v1.ListDriverCustomFieldsResponse, error)
+	// SaveDriverCustomFields creates or edits the custom fields of a driver.
+	SaveDriverCustomFields(context.Context, *v1.SaveDriverCustomFieldsRequest) (*v1.SaveDriverCustomFieldsResponse, error)
+	// SaveDriverCustomFieldValues sets custom field values of a driver.
+	SaveDriverCustomFieldValues(context.Context, *v1.SaveDriverCustomFieldValuesRequest) (*v1.SaveDriverCustomFieldValuesResponse, error)
+	// DeleteDriverCustomFields deletes custom fields of a driver.
+	DeleteDriverCustomFields(context.Context, *v1.DeleteDriverCustomFieldsRequest) (*v1.DeleteDriverCustomFieldsResponse, error)
+	// ListDriverDailyActivities returns the activity intervals of a driver grouped by day.
+	ListDriverDailyActivities(context.Context, *v1.ListDriverDailyActivitiesRequest) (*v1.ListDriverDailyActivitiesResponse, error)
+	// GetDriverBehaviourReportDrivers returns the driver behaviour results of drivers for a period.
+	GetDriverBehaviourReportDrivers(context.Context, *v1.GetDriverBehaviourReportDriversRequest) (*v1.GetDriverBehaviourReportDriversResponse, error)
+	// GetDriverBehaviourReportUnits returns the driver behaviour results of units for a period.
+	GetDriverBehaviourReportUnits(context.Context, *v1.GetDriverBehaviourReportUnitsRequest) (*v1.GetDriverBehaviourReportUnitsResponse, error)
+	// ListDriverGroups lists driver groups.
+	ListDriverGroups(context.Context, *v1.ListDriverGroupsRequest) (*v1.ListDriverGroupsResponse, error)
+	// ListDriversInGroup lists drivers in a group.
+	ListDriversInGroup(context.Context, *v1.ListDriversInGroupRequest) (*v1.ListDriversInGroupResponse, error)
+	// SaveDriverGroup creates or updates a driver group.
+	SaveDriverGroup(context.Context, *v1.SaveDriverGroupRequest) (*v1.SaveDriverGroupResponse, error)
+	// DeleteDriverGroup deletes a driver group.
+	DeleteDriverGroup(context.Context, *v1.DeleteDriverGroupRequest) (*v1.DeleteDriverGroupResponse, error)
+	// AddDriverToGroup adds a driver to a group.
+	AddDriverToGroup(context.Context, *v1.AddDriverToGroupRequest) (*v1.AddDriverToGroupResponse, error)
+	// RemoveDriverFromGroup removes a driver from a group.
+	RemoveDriverFromGroup(context.Context, *v1.RemoveDriverFromGroupRequest) (*v1.RemoveDriverFromGroupResponse, error)
+	// ClearDriverGroups removes a driver from all groups.
+	ClearDriverGroups(context.Context, *v1.ClearDriverGroupsRequest) (*v1.ClearDriverGroupsResponse, error)
+	// ListFuelData returns fuel levels and flow rates for a unit in the specified period.
+	ListFuelData(context.Context, *v1.ListFuelDataRequest) (*v1.ListFuelDataResponse, error)
+	// ListFuelChanges returns refuel and fuel drain events for units in the specified period.
+	ListFuelChanges(context.Context, *v1.ListFuelChangesRequest) (*v1.ListFuelChangesResponse, error)
+	// GetFuelSummary returns fuel totals for units in the specified period.
+	GetFuelSummary(context.Context, *v1.GetFuelSummaryRequest) (*v1.GetFuelSummaryResponse, error)
+	// ListFuelChecks returns fuel checks in the specified period.
+	ListFuelChecks(context.Context, *v1.ListFuelChecksRequest) (*v1.ListFuelChecksResponse, error)
+	// AddFuelCheck adds a new fuel check.
+	AddFuelCheck(context.Context, *v1.AddFuelCheckRequest) (*v1.AddFuelCheckResponse, error)
+	// EditFuelCheck updates an existing fuel check.
+	EditFuelCheck(context.Context, *v1.EditFuelCheckRequest) (*v1.EditFuelCheckResponse, error)
+	// DeleteFuelCheck deletes a fuel check.
+	DeleteFuelCheck(context.Context, *v1.DeleteFuelCheckRequest) (*v1.DeleteFuelCheckResponse, error)
+	// AddFuelCard creates a new fuel card.
+	AddFuelCard(context.Context, *v1.AddFuelCardRequest) (*v1.AddFuelCardResponse, error)
+	// UpdateFuelCard updates an existing fuel card.
+	UpdateFuelCard(context.Context, *v1.UpdateFuelCardRequest) (*v1.UpdateFuelCardResponse, error)
+	// DeleteFuelCard deletes a fuel card.
+	DeleteFuelCard(context.Context, *v1.DeleteFuelCardRequest) (*v1.DeleteFuelCardResponse, error)
+	// ListMessagingChannels lists the messaging channels of a user.
+	ListMessagingChannels(context.Context, *v1.ListMessagingChannelsRequest) (*v1.ListMessagingChannelsResponse, error)
+	// ListMessagingConversations lists the messaging conversations of a user.
+	ListMessagingConversations(context.Context, *v1.ListMessagingConversationsRequest) (*v1.ListMessagingConversationsResponse, error)
+	// CreateMessagingConversation creates a conversation with users or units, starting with a first message.
+	CreateMessagingConversation(context.Context, *v1.CreateMessagingConversationRequest) (*v1.CreateMessagingConversationResponse, error)
+	// ListMessages lists the messages of a conversation.
+	ListMessages(context.Context, *v1.ListMessagesRequest) (*v1.ListMessagesResponse, error)
+	// SendMessage sends a message to an existing conversation.
+	SendMessage(context.Context, *v1.SendMessageRequest) (*v1.SendMessageResponse, error)
 	// ListObjects lists the geofence objects.
 	ListObjects(context.Context, *v1.ListObjectsRequest) (*v1.ListObjectsResponse, error)
+	// SaveObject creates or updates a geofence object.
+	SaveObject(context.Context, *v1.SaveObjectRequest) (*v1.SaveObjectResponse, error)
+	// DeleteObject deletes a geofence object.
+	DeleteObject(context.Context, *v1.DeleteObjectRequest) (*v1.DeleteObjectResponse, error)
+	// GetObjectCustomFields returns the custom fields and values of an object.
+	GetObjectCustomFields(context.Context, *v1.GetObjectCustomFieldsRequest) (*v1.GetObjectCustomFieldsResponse, error)
+	// SaveObjectCustomFieldsValues sets custom field values of an object.
+	SaveObjectCustomFieldsValues(context.Context, *v1.SaveObjectCustomFieldsValuesRequest) (*v1.SaveObjectCustomFieldsValuesResponse, error)
+	// ListObjectGroups lists the object groups.
+	ListObjectGroups(context.Context, *v1.ListObjectGroupsRequest) (*v1.ListObjectGroupsResponse, error)
+	// SaveObjectGroup creates or updates an object group.
+	SaveObjectGroup(context.Context, *v1.SaveObjectGroupRequest) (*v1.SaveObjectGroupResponse, error)
+	// DeleteObjectGroup deletes an object group.
+	DeleteObjectGroup(context.Context, *v1.DeleteObjectGroupRequest) (*v1.DeleteObjectGroupResponse, error)
+	// ListPresets lists the permission presets of the company.
+	ListPresets(context.Context, *v1.ListPresetsRequest) (*v1.ListPresetsResponse, error)
+	// GetPreset returns the permissions and accessible resources of a preset.
+	GetPreset(context.Context, *v1.GetPresetRequest) (*v1.GetPresetResponse, error)
+	// CreatePreset creates a permission preset.
+	CreatePreset(context.Context, *v1.CreatePresetRequest) (*v1.CreatePresetResponse, error)
+	// EditPreset replaces the permissions and accessible resources of a preset.
+	EditPreset(context.Context, *v1.EditPresetRequest) (*v1.EditPresetResponse, error)
+	// DeletePreset deletes a preset, moving its users to custom presets.
+	DeletePreset(context.Context, *v1.DeletePresetRequest) (*v1.DeletePresetResponse, error)
+	// GetAvailablePresetPermissions returns the permissions that can be set in presets, with their default values.
+	GetAvailablePresetPermissions(context.Context, *v1.GetAvailablePresetPermissionsRequest) (*v1.GetAvailablePresetPermissionsResponse, error)
+	// GetReeferHistoricPeriod returns historic reefer readings of a unit for a period.
+	GetReeferHistoricPeriod(context.Context, *v1.GetReeferHistoricPeriodRequest) (*v1.GetReeferHistoricPeriodResponse, error)
+	// GetReeferHistoricPoint returns reefer readings of a unit at a point in time.
+	GetReeferHistoricPoint(context.Context, *v1.GetReeferHistoricPointRequest) (*v1.GetReeferHistoricPointResponse, error)
+	// ListReeferTemperatureData returns reefer compartment temperatures of a unit for a period.
+	ListReeferTemperatureData(context.Context, *v1.ListReeferTemperatureDataRequest) (*v1.ListReeferTemperatureDataResponse, error)
+	// ListReeferRunModes returns current and supported refrigerator run modes.
+	ListReeferRunModes(context.Context, *v1.ListReeferRunModesRequest) (*v1.ListReeferRunModesResponse, error)
+	// ChangeReeferSetpoint changes the setpoint temperature of a refrigerator compartment.
+	ChangeReeferSetpoint(context.Context, *v1.ChangeReeferSetpointRequest) (*v1.ChangeReeferSetpointResponse, error)
+	// ChangeReeferRunMode changes the run mode of a refrigerator.
+	ChangeReeferRunMode(context.Context, *v1.ChangeReeferRunModeRequest) (*v1.ChangeReeferRunModeResponse, error)
+	// SetReeferAlert creates a new reefer temperature alert.
+	SetReeferAlert(context.Context, *v1.SetReeferAlertRequest) (*v1.SetReeferAlertResponse, error)
+	// ListReeferAlerts returns reefer temperature alerts.
+	ListReeferAlerts(context.Context, *v1.ListReeferAlertsRequest) (*v1.ListReeferAlertsResponse, error)
+	// DeleteReeferAlert deletes a reefer temperature alert.
+	DeleteReeferAlert(context.Context, *v1.DeleteReeferAlertRequest) (*v1.DeleteReeferAlertResponse, error)
+	// ChangeReeferAlertUser transfers a reefer temperature alert to another user.
+	ChangeReeferAlertUser(context.Context, *v1.ChangeReeferAlertUserRequest) (*v1.ChangeReeferAlertUserResponse, error)
 	// ListRoutes returns list of stops and routes for units in the specified period.
 	ListRoutes(context.Context, *v1.ListRoutesRequest) (*v1.ListRoutesResponse, error)
+	// CreateRoutePlanningOrder creates a route planning order with its places.
+	CreateRoutePlanningOrder(context.Context, *v1.CreateRoutePlanningOrderRequest) (*v1.CreateRoutePlanningOrderResponse, error)
+	// AddRoutePlanningOrderPlaces adds places to an existing route planning order.
+	AddRoutePlanningOrderPlaces(context.Context, *v1.AddRoutePlanningOrderPlacesRequest) (*v1.AddRoutePlanningOrderPlacesResponse, error)
+	// GetRoutePlanningOrder returns a route planning order.
+	GetRoutePlanningOrder(context.Context, *v1.GetRoutePlanningOrderRequest) (*v1.GetRoutePlanningOrderResponse, error)
+	// ListRoutePlanningOrders returns a page of route planning orders.
+	ListRoutePlanningOrders(context.Context, *v1.ListRoutePlanningOrdersRequest) (*v1.ListRoutePlanningOrdersResponse, error)
+	// DeleteRoutePlanningOrders deletes route planning orders.
+	DeleteRoutePlanningOrders(context.Context, *v1.DeleteRoutePlanningOrdersRequest) (*v1.DeleteRoutePlanningOrdersResponse, error)
+	// GetRoutePlanningPlace returns a route planning place.
+	GetRoutePlanningPlace(context.Context, *v1.GetRoutePlanningPlaceRequest) (*v1.GetRoutePlanningPlaceResponse, error)
+	// ListRoutePlanningPlaces returns a page of route planning places of the given routes.
+	ListRoutePlanningPlaces(context.Context, *v1.ListRoutePlanningPlacesRequest) (*v1.ListRoutePlanningPlacesResponse, error)
+	// DeleteRoutePlanningPlaces deletes route planning places.
+	DeleteRoutePlanningPlaces(context.Context, *v1.DeleteRoutePlanningPlacesRequest) (*v1.DeleteRoutePlanningPlacesResponse, error)
+	// SaveRoutePlanningRoute creates or updates a planned route.
+	SaveRoutePlanningRoute(context.Context, *v1.SaveRoutePlanningRouteRequest) (*v1.SaveRoutePlanningRouteResponse, error)
+	// GetRoutePlanningRoute returns a planned route.
+	GetRoutePlanningRoute(context.Context, *v1.GetRoutePlanningRouteRequest) (*v1.GetRoutePlanningRouteResponse, error)
+	// ListRoutePlanningRoutes returns a page of planned routes.
+	ListRoutePlanningRoutes(context.Context, *v1.ListRoutePlanningRoutesRequest) (*v1.ListRoutePlanningRoutesResponse, error)
+	// SendRoutePlanningRouteToAssignee makes a planned route available in the driver application.
+	SendRoutePlanningRouteToAssignee(context.Context, *v1.SendRoutePlanningRouteToAssigneeRequest) (*v1.SendRoutePlanningRouteToAssigneeResponse, error)
+	// SetRoutePlanningRouteStartAddress sets the start address and departure time of a planned route.
+	SetRoutePlanningRouteStartAddress(context.Context, *v1.SetRoutePlanningRouteStartAddressRequest) (*v1.SetRoutePlanningRouteStartAddressResponse, error)
+	// SetRoutePlanningRouteEndAddress sets the end address of a planned route.
+	SetRoutePlanningRouteEndAddress(context.Context, *v1.SetRoutePlanningRouteEndAddressRequest) (*v1.SetRoutePlanningRouteEndAddressResponse, error)
+	// OptimizeRoutePlanningRoute starts the optimization of a planned route.
+	OptimizeRoutePlanningRoute(context.Context, *v1.OptimizeRoutePlanningRouteRequest) (*v1.OptimizeRoutePlanningRouteResponse, error)
+	// GetRoutePlanningOptimizationProgress returns the progress of a route optimization.
+	GetRoutePlanningOptimizationProgress(context.Context, *v1.GetRoutePlanningOptimizationProgressRequest) (*v1.GetRoutePlanningOptimizationProgressResponse, error)
+	// DeleteRoutePlanningRoutes deletes planned routes.
+	DeleteRoutePlanningRoutes(context.Context, *v1.DeleteRoutePlanningRoutesRequest) (*v1.DeleteRoutePlanningRoutesResponse, error)
+	// ListDriverDddFiles returns driver card DDD files created in a period.
+	ListDriverDddFiles(context.Context, *v1.ListDriverDddFilesRequest) (*v1.ListDriverDddFilesResponse, error)
+	// ListVehicleDddFiles returns vehicle unit DDD files created in a period.
+	ListVehicleDddFiles(context.Context, *v1.ListVehicleDddFilesRequest) (*v1.ListVehicleDddFilesResponse, error)
+	// DownloadDriverDdd downloads the contents of a driver card DDD file.
+	DownloadDriverDdd(context.Context, *v1.DownloadDriverDddRequest) (*v1.DownloadDriverDddResponse, error)
+	// DownloadVehicleDdd downloads the contents of a vehicle unit DDD file.
+	DownloadVehicleDdd(context.Context, *v1.DownloadVehicleDddRequest) (*v1.DownloadVehicleDddResponse, error)
+	// ListTasks lists the tasks of a unit.
+	ListTasks(context.Context, *v1.ListTasksRequest) (*v1.ListTasksResponse, error)
+	// CreateTask creates a task for a unit.
+	CreateTask(context.Context, *v1.CreateTaskRequest) (*v1.CreateTaskResponse, error)
+	// EditTask updates a task.
+	EditTask(context.Context, *v1.EditTaskRequest) (*v1.EditTaskResponse, error)
+	// DeleteTask deletes a task.
+	DeleteTask(context.Context, *v1.DeleteTaskRequest) (*v1.DeleteTaskResponse, error)
+	// DeleteTaskRoute deletes a task route and all of its tasks.
+	DeleteTaskRoute(context.Context, *v1.DeleteTaskRouteRequest) (*v1.DeleteTaskRouteResponse, error)
 	// ListTellTaleValues retrieves FMS tell tale values for a specified unit within a date range.
 	ListTellTaleValues(context.Context, *v1.ListTellTaleValuesRequest) (*v1.ListTellTaleValuesResponse, error)
+	// ListTrackingLinks lists the enabled tracking links created in the specified period.
+	ListTrackingLinks(context.Context, *v1.ListTrackingLinksRequest) (*v1.ListTrackingLinksResponse, error)
+	// CreateTrackingLink creates a public tracking link for units.
+	CreateTrackingLink(context.Context, *v1.CreateTrackingLinkRequest) (*v1.CreateTrackingLinkResponse, error)
+	// EditTrackingLink updates a tracking link.
+	EditTrackingLink(context.Context, *v1.EditTrackingLinkRequest) (*v1.EditTrackingLinkResponse, error)
+	// DeleteTrackingLink deletes a tracking link.
+	DeleteTrackingLink(context.Context, *v1.DeleteTrackingLinkRequest) (*v1.DeleteTrackingLinkResponse, error)
 	// ListUnits lists the units available for the current API key.
 	ListUnits(context.Context, *v1.ListUnitsRequest) (*v1.ListUnitsResponse, error)
+	// EditUnit edits the details of a unit.
+	EditUnit(context.Context, *v1.EditUnitRequest) (*v1.EditUnitResponse, error)
+	// InstallUnit creates a unit for a device.
+	InstallUnit(context.Context, *v1.InstallUnitRequest) (*v1.InstallUnitResponse, error)
+	// UninstallUnit removes the device from a unit.
+	UninstallUnit(context.Context, *v1.UninstallUnitRequest) (*v1.UninstallUnitResponse, error)
+	// ChangeUnitDevice changes the device assigned to a unit.
+	ChangeUnitDevice(context.Context, *v1.ChangeUnitDeviceRequest) (*v1.ChangeUnitDeviceResponse, error)
+	// ChangeUnitRelay requests a change of a unit relay state.
+	ChangeUnitRelay(context.Context, *v1.ChangeUnitRelayRequest) (*v1.ChangeUnitRelayResponse, error)
+	// ListAvailableUnitIcons lists the vehicle icons that can be assigned to units.
+	ListAvailableUnitIcons(context.Context, *v1.ListAvailableUnitIconsRequest) (*v1.ListAvailableUnitIconsResponse, error)
+	// ListUnitCustomFields returns the custom fields and values of a unit.
+	ListUnitCustomFields(context.Context, *v1.ListUnitCustomFieldsRequest) (*v1.ListUnitCustomFieldsResponse, error)
+	// SaveUnitCustomFieldValues sets custom field values of a unit.
+	SaveUnitCustomFieldValues(context.Context, *v1.SaveUnitCustomFieldValuesRequest) (*v1.SaveUnitCustomFieldValuesResponse, error)
+	// GetAvailableUnitCommands lists the remote commands available for a unit.
+	GetAvailableUnitCommands(context.Context, *v1.GetAvailableUnitCommandsRequest) (*v1.GetAvailableUnitCommandsResponse, error)
+	// ExecuteUnitCommand executes a remote command on a unit.
+	ExecuteUnitCommand(context.Context, *v1.ExecuteUnitCommandRequest) (*v1.ExecuteUnitCommandResponse, error)
 	// ListUnitGroups lists unit groups.
 	ListUnitGroups(context.Context, *v1.ListUnitGroupsRequest) (*v1.ListUnitGroupsResponse, error)
 	// ListUnitsInGroup lists units in a group.
 	ListUnitsInGroup(context.Context, *v1.ListUnitsInGroupRequest) (*v1.ListUnitsInGroupResponse, error)
+	// SaveUnitGroup creates or updates a unit group.
+	SaveUnitGroup(context.Context, *v1.SaveUnitGroupRequest) (*v1.SaveUnitGroupResponse, error)
+	// DeleteUnitGroup deletes a unit group.
+	DeleteUnitGroup(context.Context, *v1.DeleteUnitGroupRequest) (*v1.DeleteUnitGroupResponse, error)
+	// AttachUnitToGroup attaches a unit to a group.
+	AttachUnitToGroup(context.Context, *v1.AttachUnitToGroupRequest) (*v1.AttachUnitToGroupResponse, error)
+	// DetachUnitFromGroup detaches a unit from a group.
+	DetachUnitFromGroup(context.Context, *v1.DetachUnitFromGroupRequest) (*v1.DetachUnitFromGroupResponse, error)
+	// ClearUnitGroups detaches a unit from all groups.
+	ClearUnitGroups(context.Context, *v1.ClearUnitGroupsRequest) (*v1.ClearUnitGroupsResponse, error)
 	// GetCanDataPoint returns CAN data at a specific datetime.
 	GetCanDataPoint(context.Context, *v1.GetCanDataPointRequest) (*v1.GetCanDataPointResponse, error)
 	// ListCanPeriodData returns CAN data for a given period.
@@ -578,6 +3843,30 @@ type MaponApiHandler interface {
 	ListIgnitions(context.Context, *v1.ListIgnitionsRequest) (*v1.ListIgnitionsResponse, error)
 	// ListTemperatures returns measured temperature points.
 	ListTemperatures(context.Context, *v1.ListTemperaturesRequest) (*v1.ListTemperaturesResponse, error)
+	// ListUsers lists the users of the company.
+	ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error)
+	// CreateUser creates a user.
+	CreateUser(context.Context, *v1.CreateUserRequest) (*v1.CreateUserResponse, error)
+	// UpdateUser updates the fields of a user that are set in the request.
+	UpdateUser(context.Context, *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error)
+	// DeleteUser deletes a user.
+	DeleteUser(context.Context, *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error)
+	// ChangeUserPassword changes the password of a user.
+	ChangeUserPassword(context.Context, *v1.ChangeUserPasswordRequest) (*v1.ChangeUserPasswordResponse, error)
+	// LinkUserToDriver assigns a user to a driver.
+	LinkUserToDriver(context.Context, *v1.LinkUserToDriverRequest) (*v1.LinkUserToDriverResponse, error)
+	// UnlinkUserFromDriver removes the user assigned to a driver.
+	UnlinkUserFromDriver(context.Context, *v1.UnlinkUserFromDriverRequest) (*v1.UnlinkUserFromDriverResponse, error)
+	// ListVehicleInspections lists vehicle inspections with their checklist results.
+	ListVehicleInspections(context.Context, *v1.ListVehicleInspectionsRequest) (*v1.ListVehicleInspectionsResponse, error)
+	// CreateVehiclePinDeviceMappings maps PIN keypad devices to units.
+	CreateVehiclePinDeviceMappings(context.Context, *v1.CreateVehiclePinDeviceMappingsRequest) (*v1.CreateVehiclePinDeviceMappingsResponse, error)
+	// DeleteVehiclePinDeviceMappings deletes the mappings of PIN keypad devices.
+	DeleteVehiclePinDeviceMappings(context.Context, *v1.DeleteVehiclePinDeviceMappingsRequest) (*v1.DeleteVehiclePinDeviceMappingsResponse, error)
+	// CreateDriverPins creates PIN codes for drivers without a PIN.
+	CreateDriverPins(context.Context, *v1.CreateDriverPinsRequest) (*v1.CreateDriverPinsResponse, error)
+	// UpdateDriverPins updates the PIN codes of drivers with an existing PIN.
+	UpdateDriverPins(context.Context, *v1.UpdateDriverPinsRequest) (*v1.UpdateDriverPinsResponse, error)
 }
 
 // NewMaponApiHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -593,28 +3882,412 @@ func NewMaponApiHandler(svc MaponApiHandler, opts ...connect.HandlerOption) (str
 		connect.WithSchema(maponApiMethods.ByName("ListAlerts")),
 		connect.WithHandlerOptions(opts...),
 	)
-	maponApiDeleteDataForwardHandler := connect.NewUnaryHandlerSimple(
-		MaponApiDeleteDataForwardProcedure,
-		svc.DeleteDataForward,
-		connect.WithSchema(maponApiMethods.ByName("DeleteDataForward")),
+	maponApiListAlertSetupsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListAlertSetupsProcedure,
+		svc.ListAlertSetups,
+		connect.WithSchema(maponApiMethods.ByName("ListAlertSetups")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetAlertSetupTypesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetAlertSetupTypesProcedure,
+		svc.GetAlertSetupTypes,
+		connect.WithSchema(maponApiMethods.ByName("GetAlertSetupTypes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetAlertSetupFieldsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetAlertSetupFieldsProcedure,
+		svc.GetAlertSetupFields,
+		connect.WithSchema(maponApiMethods.ByName("GetAlertSetupFields")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiStoreAlertSetupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiStoreAlertSetupProcedure,
+		svc.StoreAlertSetup,
+		connect.WithSchema(maponApiMethods.ByName("StoreAlertSetup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteAlertSetupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteAlertSetupProcedure,
+		svc.DeleteAlertSetup,
+		connect.WithSchema(maponApiMethods.ByName("DeleteAlertSetup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListBleTagsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListBleTagsProcedure,
+		svc.ListBleTags,
+		connect.WithSchema(maponApiMethods.ByName("ListBleTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListBleTagScansHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListBleTagScansProcedure,
+		svc.ListBleTagScans,
+		connect.WithSchema(maponApiMethods.ByName("ListBleTagScans")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListBleTagLocationsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListBleTagLocationsProcedure,
+		svc.ListBleTagLocations,
+		connect.WithSchema(maponApiMethods.ByName("ListBleTagLocations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetBleTagHistoryPointHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetBleTagHistoryPointProcedure,
+		svc.GetBleTagHistoryPoint,
+		connect.WithSchema(maponApiMethods.ByName("GetBleTagHistoryPoint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetCompanyHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetCompanyProcedure,
+		svc.GetCompany,
+		connect.WithSchema(maponApiMethods.ByName("GetCompany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListCompanyClientsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListCompanyClientsProcedure,
+		svc.ListCompanyClients,
+		connect.WithSchema(maponApiMethods.ByName("ListCompanyClients")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiCreateCompanyClientHandler := connect.NewUnaryHandlerSimple(
+		MaponApiCreateCompanyClientProcedure,
+		svc.CreateCompanyClient,
+		connect.WithSchema(maponApiMethods.ByName("CreateCompanyClient")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListCustomLayersHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListCustomLayersProcedure,
+		svc.ListCustomLayers,
+		connect.WithSchema(maponApiMethods.ByName("ListCustomLayers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSaveCustomLayerHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSaveCustomLayerProcedure,
+		svc.SaveCustomLayer,
+		connect.WithSchema(maponApiMethods.ByName("SaveCustomLayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteCustomLayerHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteCustomLayerProcedure,
+		svc.DeleteCustomLayer,
+		connect.WithSchema(maponApiMethods.ByName("DeleteCustomLayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListCustomLayerGeometriesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListCustomLayerGeometriesProcedure,
+		svc.ListCustomLayerGeometries,
+		connect.WithSchema(maponApiMethods.ByName("ListCustomLayerGeometries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiAddCustomLayerGeometriesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiAddCustomLayerGeometriesProcedure,
+		svc.AddCustomLayerGeometries,
+		connect.WithSchema(maponApiMethods.ByName("AddCustomLayerGeometries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiEditCustomLayerGeometryHandler := connect.NewUnaryHandlerSimple(
+		MaponApiEditCustomLayerGeometryProcedure,
+		svc.EditCustomLayerGeometry,
+		connect.WithSchema(maponApiMethods.ByName("EditCustomLayerGeometry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteCustomLayerGeometryHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteCustomLayerGeometryProcedure,
+		svc.DeleteCustomLayerGeometry,
+		connect.WithSchema(maponApiMethods.ByName("DeleteCustomLayerGeometry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListCustomTargetsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListCustomTargetsProcedure,
+		svc.ListCustomTargets,
+		connect.WithSchema(maponApiMethods.ByName("ListCustomTargets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListCustomTargetValuesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListCustomTargetValuesProcedure,
+		svc.ListCustomTargetValues,
+		connect.WithSchema(maponApiMethods.ByName("ListCustomTargetValues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteDataForwardHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteDataForwardProcedure,
+		svc.DeleteDataForward,
+		connect.WithSchema(maponApiMethods.ByName("DeleteDataForward")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListDataForwardsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListDataForwardsProcedure,
+		svc.ListDataForwards,
+		connect.WithSchema(maponApiMethods.ByName("ListDataForwards")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSaveDataForwardHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSaveDataForwardProcedure,
+		svc.SaveDataForward,
+		connect.WithSchema(maponApiMethods.ByName("SaveDataForward")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListDevicesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListDevicesProcedure,
+		svc.ListDevices,
+		connect.WithSchema(maponApiMethods.ByName("ListDevices")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiCreateDeviceHandler := connect.NewUnaryHandlerSimple(
+		MaponApiCreateDeviceProcedure,
+		svc.CreateDevice,
+		connect.WithSchema(maponApiMethods.ByName("CreateDevice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListDeviceModelsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListDeviceModelsProcedure,
+		svc.ListDeviceModels,
+		connect.WithSchema(maponApiMethods.ByName("ListDeviceModels")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetDeviceCommandsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetDeviceCommandsProcedure,
+		svc.GetDeviceCommands,
+		connect.WithSchema(maponApiMethods.ByName("GetDeviceCommands")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSendDeviceSmsCommandHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSendDeviceSmsCommandProcedure,
+		svc.SendDeviceSmsCommand,
+		connect.WithSchema(maponApiMethods.ByName("SendDeviceSmsCommand")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSendDeviceTcpCommandHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSendDeviceTcpCommandProcedure,
+		svc.SendDeviceTcpCommand,
+		connect.WithSchema(maponApiMethods.ByName("SendDeviceTcpCommand")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListDeviceSmsHistoryHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListDeviceSmsHistoryProcedure,
+		svc.ListDeviceSmsHistory,
+		connect.WithSchema(maponApiMethods.ByName("ListDeviceSmsHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListDriversHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListDriversProcedure,
+		svc.ListDrivers,
+		connect.WithSchema(maponApiMethods.ByName("ListDrivers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiCreateDriverHandler := connect.NewUnaryHandlerSimple(
+		MaponApiCreateDriverProcedure,
+		svc.CreateDriver,
+		connect.WithSchema(maponApiMethods.ByName("CreateDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiUpdateDriverHandler := connect.NewUnaryHandlerSimple(
+		MaponApiUpdateDriverProcedure,
+		svc.UpdateDriver,
+		connect.WithSchema(maponApiMethods.ByName("UpdateDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteDriverHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteDriverProcedure,
+		svc.DeleteDriver,
+		connect.WithSchema(maponApiMethods.ByName("DeleteDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiChangeDriverPasswordHandler := connect.NewUnaryHandlerSimple(
+		MaponApiChangeDriverPasswordProcedure,
+		svc.ChangeDriverPassword,
+		connect.WithSchema(maponApiMethods.ByName("ChangeDriverPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiAssociateExternalDriverHandler := connect.NewUnaryHandlerSimple(
+		MaponApiAssociateExternalDriverProcedure,
+		svc.AssociateExternalDriver,
+		connect.WithSchema(maponApiMethods.ByName("AssociateExternalDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiLinkDriverUserHandler := connect.NewUnaryHandlerSimple(
+		MaponApiLinkDriverUserProcedure,
+		svc.LinkDriverUser,
+		connect.WithSchema(maponApiMethods.ByName("LinkDriverUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiUnlinkDriverUserHandler := connect.NewUnaryHandlerSimple(
+		MaponApiUnlinkDriverUserProcedure,
+		svc.UnlinkDriverUser,
+		connect.WithSchema(maponApiMethods.ByName("UnlinkDriverUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListDriverCustomFieldsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListDriverCustomFieldsProcedure,
+		svc.ListDriverCustomFields,
+		connect.WithSchema(maponApiMethods.ByName("ListDriverCustomFields")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSaveDriverCustomFieldsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSaveDriverCustomFieldsProcedure,
+		svc.SaveDriverCustomFields,
+		connect.WithSchema(maponApiMethods.ByName("SaveDriverCustomFields")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSaveDriverCustomFieldValuesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSaveDriverCustomFieldValuesProcedure,
+		svc.SaveDriverCustomFieldValues,
+		connect.WithSchema(maponApiMethods.ByName("SaveDriverCustomFieldValues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteDriverCustomFieldsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteDriverCustomFieldsProcedure,
+		svc.DeleteDriverCustomFields,
+		connect.WithSchema(maponApiMethods.ByName("DeleteDriverCustomFields")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListDriverDailyActivitiesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListDriverDailyActivitiesProcedure,
+		svc.ListDriverDailyActivities,
+		connect.WithSchema(maponApiMethods.ByName("ListDriverDailyActivities")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetDriverBehaviourReportDriversHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetDriverBehaviourReportDriversProcedure,
+		svc.GetDriverBehaviourReportDrivers,
+		connect.WithSchema(maponApiMethods.ByName("GetDriverBehaviourReportDrivers")),
 		connect.WithHandlerOptions(opts...),
 	)
-	maponApiListDataForwardsHandler := connect.NewUnaryHandlerSimple(
-		MaponApiListDataForwardsProcedure,
-		svc.ListDataForwards,
-		connect.WithSchema(maponApiMethods.ByName("ListDataForwards")),
+	maponApiGetDriverBehaviourReportUnitsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetDriverBehaviourReportUnitsProcedure,
+		svc.GetDriverBehaviourReportUnits,
+		connect.WithSchema(maponApiMethods.ByName("GetDriverBehaviourReportUnits")),
 		connect.WithHandlerOptions(opts...),
 	)
-	maponApiSaveDataForwardHandler := connect.NewUnaryHandlerSimple(
-		MaponApiSaveDataForwardProcedure,
-		svc.SaveDataForward,
-		connect.WithSchema(maponApiMethods.ByName("SaveDataForward")),
+	maponApiListDriverGroupsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListDriverGroupsProcedure,
+		svc.ListDriverGroups,
+		connect.WithSchema(maponApiMethods.ByName("ListDriverGroups")),
 		connect.WithHandlerOptions(opts...),
 	)
-	maponApiListDriversHandler := connect.NewUnaryHandlerSimple(
-		MaponApiListDriversProcedure,
-		svc.ListDrivers,
-		connect.WithSchema(maponApiMethods.ByName("ListDrivers")),
+	maponApiListDriversInGroupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListDriversInGroupProcedure,
+		svc.ListDriversInGroup,
+		connect.WithSchema(maponApiMethods.ByName("ListDriversInGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSaveDriverGroupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSaveDriverGroupProcedure,
+		svc.SaveDriverGroup,
+		connect.WithSchema(maponApiMethods.ByName("SaveDriverGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteDriverGroupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteDriverGroupProcedure,
+		svc.DeleteDriverGroup,
+		connect.WithSchema(maponApiMethods.ByName("DeleteDriverGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiAddDriverToGroupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiAddDriverToGroupProcedure,
+		svc.AddDriverToGroup,
+		connect.WithSchema(maponApiMethods.ByName("AddDriverToGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiRemoveDriverFromGroupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiRemoveDriverFromGroupProcedure,
+		svc.RemoveDriverFromGroup,
+		connect.WithSchema(maponApiMethods.ByName("RemoveDriverFromGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiClearDriverGroupsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiClearDriverGroupsProcedure,
+		svc.ClearDriverGroups,
+		connect.WithSchema(maponApiMethods.ByName("ClearDriverGroups")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListFuelDataHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListFuelDataProcedure,
+		svc.ListFuelData,
+		connect.WithSchema(maponApiMethods.ByName("ListFuelData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListFuelChangesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListFuelChangesProcedure,
+		svc.ListFuelChanges,
+		connect.WithSchema(maponApiMethods.ByName("ListFuelChanges")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetFuelSummaryHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetFuelSummaryProcedure,
+		svc.GetFuelSummary,
+		connect.WithSchema(maponApiMethods.ByName("GetFuelSummary")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListFuelChecksHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListFuelChecksProcedure,
+		svc.ListFuelChecks,
+		connect.WithSchema(maponApiMethods.ByName("ListFuelChecks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiAddFuelCheckHandler := connect.NewUnaryHandlerSimple(
+		MaponApiAddFuelCheckProcedure,
+		svc.AddFuelCheck,
+		connect.WithSchema(maponApiMethods.ByName("AddFuelCheck")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiEditFuelCheckHandler := connect.NewUnaryHandlerSimple(
+		MaponApiEditFuelCheckProcedure,
+		svc.EditFuelCheck,
+		connect.WithSchema(maponApiMethods.ByName("EditFuelCheck")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteFuelCheckHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteFuelCheckProcedure,
+		svc.DeleteFuelCheck,
+		connect.WithSchema(maponApiMethods.ByName("DeleteFuelCheck")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiAddFuelCardHandler := connect.NewUnaryHandlerSimple(
+		MaponApiAddFuelCardProcedure,
+		svc.AddFuelCard,
+		connect.WithSchema(maponApiMethods.ByName("AddFuelCard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiUpdateFuelCardHandler := connect.NewUnaryHandlerSimple(
+		MaponApiUpdateFuelCardProcedure,
+		svc.UpdateFuelCard,
+		connect.WithSchema(maponApiMethods.ByName("UpdateFuelCard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteFuelCardHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteFuelCardProcedure,
+		svc.DeleteFuelCard,
+		connect.WithSchema(maponApiMethods.ByName("DeleteFuelCard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListMessagingChannelsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListMessagingChannelsProcedure,
+		svc.ListMessagingChannels,
+		connect.WithSchema(maponApiMethods.ByName("ListMessagingChannels")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListMessagingConversationsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListMessagingConversationsProcedure,
+		svc.ListMessagingConversations,
+		connect.WithSchema(maponApiMethods.ByName("ListMessagingConversations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiCreateMessagingConversationHandler := connect.NewUnaryHandlerSimple(
+		MaponApiCreateMessagingConversationProcedure,
+		svc.CreateMessagingConversation,
+		connect.WithSchema(maponApiMethods.ByName("CreateMessagingConversation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListMessagesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListMessagesProcedure,
+		svc.ListMessages,
+		connect.WithSchema(maponApiMethods.ByName("ListMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSendMessageHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSendMessageProcedure,
+		svc.SendMessage,
+		connect.WithSchema(maponApiMethods.ByName("SendMessage")),
 		connect.WithHandlerOptions(opts...),
 	)
 	maponApiListObjectsHandler := connect.NewUnaryHandlerSimple(
@@ -623,24 +4296,402 @@ func NewMaponApiHandler(svc MaponApiHandler, opts ...connect.HandlerOption) (str
 		connect.WithSchema(maponApiMethods.ByName("ListObjects")),
 		connect.WithHandlerOptions(opts...),
 	)
+	maponApiSaveObjectHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSaveObjectProcedure,
+		svc.SaveObject,
+		connect.WithSchema(maponApiMethods.ByName("SaveObject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteObjectHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteObjectProcedure,
+		svc.DeleteObject,
+		connect.WithSchema(maponApiMethods.ByName("DeleteObject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetObjectCustomFieldsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetObjectCustomFieldsProcedure,
+		svc.GetObjectCustomFields,
+		connect.WithSchema(maponApiMethods.ByName("GetObjectCustomFields")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSaveObjectCustomFieldsValuesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSaveObjectCustomFieldsValuesProcedure,
+		svc.SaveObjectCustomFieldsValues,
+		connect.WithSchema(maponApiMethods.ByName("SaveObjectCustomFieldsValues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListObjectGroupsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListObjectGroupsProcedure,
+		svc.ListObjectGroups,
+		connect.WithSchema(maponApiMethods.ByName("ListObjectGroups")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSaveObjectGroupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSaveObjectGroupProcedure,
+		svc.SaveObjectGroup,
+		connect.WithSchema(maponApiMethods.ByName("SaveObjectGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteObjectGroupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteObjectGroupProcedure,
+		svc.DeleteObjectGroup,
+		connect.WithSchema(maponApiMethods.ByName("DeleteObjectGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListPresetsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListPresetsProcedure,
+		svc.ListPresets,
+		connect.WithSchema(maponApiMethods.ByName("ListPresets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetPresetHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetPresetProcedure,
+		svc.GetPreset,
+		connect.WithSchema(maponApiMethods.ByName("GetPreset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiCreatePresetHandler := connect.NewUnaryHandlerSimple(
+		MaponApiCreatePresetProcedure,
+		svc.CreatePreset,
+		connect.WithSchema(maponApiMethods.ByName("CreatePreset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiEditPresetHandler := connect.NewUnaryHandlerSimple(
+		MaponApiEditPresetProcedure,
+		svc.EditPreset,
+		connect.WithSchema(maponApiMethods.ByName("EditPreset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeletePresetHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeletePresetProcedure,
+		svc.DeletePreset,
+		connect.WithSchema(maponApiMethods.ByName("DeletePreset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetAvailablePresetPermissionsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetAvailablePresetPermissionsProcedure,
+		svc.GetAvailablePresetPermissions,
+		connect.WithSchema(maponApiMethods.ByName("GetAvailablePresetPermissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetReeferHistoricPeriodHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetReeferHistoricPeriodProcedure,
+		svc.GetReeferHistoricPeriod,
+		connect.WithSchema(maponApiMethods.ByName("GetReeferHistoricPeriod")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetReeferHistoricPointHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetReeferHistoricPointProcedure,
+		svc.GetReeferHistoricPoint,
+		connect.WithSchema(maponApiMethods.ByName("GetReeferHistoricPoint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListReeferTemperatureDataHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListReeferTemperatureDataProcedure,
+		svc.ListReeferTemperatureData,
+		connect.WithSchema(maponApiMethods.ByName("ListReeferTemperatureData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListReeferRunModesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListReeferRunModesProcedure,
+		svc.ListReeferRunModes,
+		connect.WithSchema(maponApiMethods.ByName("ListReeferRunModes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiChangeReeferSetpointHandler := connect.NewUnaryHandlerSimple(
+		MaponApiChangeReeferSetpointProcedure,
+		svc.ChangeReeferSetpoint,
+		connect.WithSchema(maponApiMethods.ByName("ChangeReeferSetpoint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiChangeReeferRunModeHandler := connect.NewUnaryHandlerSimple(
+		MaponApiChangeReeferRunModeProcedure,
+		svc.ChangeReeferRunMode,
+		connect.WithSchema(maponApiMethods.ByName("ChangeReeferRunMode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSetReeferAlertHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSetReeferAlertProcedure,
+		svc.SetReeferAlert,
+		connect.WithSchema(maponApiMethods.ByName("SetReeferAlert")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListReeferAlertsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListReeferAlertsProcedure,
+		svc.ListReeferAlerts,
+		connect.WithSchema(maponApiMethods.ByName("ListReeferAlerts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteReeferAlertHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteReeferAlertProcedure,
+		svc.DeleteReeferAlert,
+		connect.WithSchema(maponApiMethods.ByName("DeleteReeferAlert")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiChangeReeferAlertUserHandler := connect.NewUnaryHandlerSimple(
+		MaponApiChangeReeferAlertUserProcedure,
+		svc.ChangeReeferAlertUser,
+		connect.WithSchema(maponApiMethods.ByName("ChangeReeferAlertUser")),
+		connect.WithHandlerOptions(opts...),
+	)
 	maponApiListRoutesHandler := connect.NewUnaryHandlerSimple(
 		MaponApiListRoutesProcedure,
 		svc.ListRoutes,
 		connect.WithSchema(maponApiMethods.ByName("ListRoutes")),
 		connect.WithHandlerOptions(opts...),
 	)
+	maponApiCreateRoutePlanningOrderHandler := connect.NewUnaryHandlerSimple(
+		MaponApiCreateRoutePlanningOrderProcedure,
+		svc.CreateRoutePlanningOrder,
+		connect.WithSchema(maponApiMethods.ByName("CreateRoutePlanningOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiAddRoutePlanningOrderPlacesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiAddRoutePlanningOrderPlacesProcedure,
+		svc.AddRoutePlanningOrderPlaces,
+		connect.WithSchema(maponApiMethods.ByName("AddRoutePlanningOrderPlaces")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetRoutePlanningOrderHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetRoutePlanningOrderProcedure,
+		svc.GetRoutePlanningOrder,
+		connect.WithSchema(maponApiMethods.ByName("GetRoutePlanningOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListRoutePlanningOrdersHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListRoutePlanningOrdersProcedure,
+		svc.ListRoutePlanningOrders,
+		connect.WithSchema(maponApiMethods.ByName("ListRoutePlanningOrders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteRoutePlanningOrdersHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteRoutePlanningOrdersProcedure,
+		svc.DeleteRoutePlanningOrders,
+		connect.WithSchema(maponApiMethods.ByName("DeleteRoutePlanningOrders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetRoutePlanningPlaceHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetRoutePlanningPlaceProcedure,
+		svc.GetRoutePlanningPlace,
+		connect.WithSchema(maponApiMethods.ByName("GetRoutePlanningPlace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListRoutePlanningPlacesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListRoutePlanningPlacesProcedure,
+		svc.ListRoutePlanningPlaces,
+		connect.WithSchema(maponApiMethods.ByName("ListRoutePlanningPlaces")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteRoutePlanningPlacesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteRoutePlanningPlacesProcedure,
+		svc.DeleteRoutePlanningPlaces,
+		connect.WithSchema(maponApiMethods.ByName("DeleteRoutePlanningPlaces")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSaveRoutePlanningRouteHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSaveRoutePlanningRouteProcedure,
+		svc.SaveRoutePlanningRoute,
+		connect.WithSchema(maponApiMethods.ByName("SaveRoutePlanningRoute")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetRoutePlanningRouteHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetRoutePlanningRouteProcedure,
+		svc.GetRoutePlanningRoute,
+		connect.WithSchema(maponApiMethods.ByName("GetRoutePlanningRoute")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListRoutePlanningRoutesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListRoutePlanningRoutesProcedure,
+		svc.ListRoutePlanningRoutes,
+		connect.WithSchema(maponApiMethods.ByName("ListRoutePlanningRoutes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSendRoutePlanningRouteToAssigneeHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSendRoutePlanningRouteToAssigneeProcedure,
+		svc.SendRoutePlanningRouteToAssignee,
+		connect.WithSchema(maponApiMethods.ByName("SendRoutePlanningRouteToAssignee")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSetRoutePlanningRouteStartAddressHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSetRoutePlanningRouteStartAddressProcedure,
+		svc.SetRoutePlanningRouteStartAddress,
+		connect.WithSchema(maponApiMethods.ByName("SetRoutePlanningRouteStartAddress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSetRoutePlanningRouteEndAddressHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSetRoutePlanningRouteEndAddressProcedure,
+		svc.SetRoutePlanningRouteEndAddress,
+		connect.WithSchema(maponApiMethods.ByName("SetRoutePlanningRouteEndAddress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiOptimizeRoutePlanningRouteHandler := connect.NewUnaryHandlerSimple(
+		MaponApiOptimizeRoutePlanningRouteProcedure,
+		svc.OptimizeRoutePlanningRoute,
+		connect.WithSchema(maponApiMethods.ByName("OptimizeRoutePlanningRoute")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetRoutePlanningOptimizationProgressHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetRoutePlanningOptimizationProgressProcedure,
+		svc.GetRoutePlanningOptimizationProgress,
+		connect.WithSchema(maponApiMethods.ByName("GetRoutePlanningOptimizationProgress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteRoutePlanningRoutesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteRoutePlanningRoutesProcedure,
+		svc.DeleteRoutePlanningRoutes,
+		connect.WithSchema(maponApiMethods.ByName("DeleteRoutePlanningRoutes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListDriverDddFilesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListDriverDddFilesProcedure,
+		svc.ListDriverDddFiles,
+		connect.WithSchema(maponApiMethods.ByName("ListDriverDddFiles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListVehicleDddFilesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListVehicleDddFilesProcedure,
+		svc.ListVehicleDddFiles,
+		connect.WithSchema(maponApiMethods.ByName("ListVehicleDddFiles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDownloadDriverDddHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDownloadDriverDddProcedure,
+		svc.DownloadDriverDdd,
+		connect.WithSchema(maponApiMethods.ByName("DownloadDriverDdd")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDownloadVehicleDddHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDownloadVehicleDddProcedure,
+		svc.DownloadVehicleDdd,
+		connect.WithSchema(maponApiMethods.ByName("DownloadVehicleDdd")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListTasksHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListTasksProcedure,
+		svc.ListTasks,
+		connect.WithSchema(maponApiMethods.ByName("ListTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiCreateTaskHandler := connect.NewUnaryHandlerSimple(
+		MaponApiCreateTaskProcedure,
+		svc.CreateTask,
+		connect.WithSchema(maponApiMethods.ByName("CreateTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiEditTaskHandler := connect.NewUnaryHandlerSimple(
+		MaponApiEditTaskProcedure,
+		svc.EditTask,
+		connect.WithSchema(maponApiMethods.ByName("EditTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteTaskHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteTaskProcedure,
+		svc.DeleteTask,
+		connect.WithSchema(maponApiMethods.ByName("DeleteTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteTaskRouteHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteTaskRouteProcedure,
+		svc.DeleteTaskRoute,
+		connect.WithSchema(maponApiMethods.ByName("DeleteTaskRoute")),
+		connect.WithHandlerOptions(opts...),
+	)
 	maponApiListTellTaleValuesHandler := connect.NewUnaryHandlerSimple(
 		MaponApiListTellTaleValuesProcedure,
 		svc.ListTellTaleValues,
 		connect.WithSchema(maponApiMethods.ByName("ListTellTaleValues")),
 		connect.WithHandlerOptions(opts...),
 	)
+	maponApiListTrackingLinksHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListTrackingLinksProcedure,
+		svc.ListTrackingLinks,
+		connect.WithSchema(maponApiMethods.ByName("ListTrackingLinks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiCreateTrackingLinkHandler := connect.NewUnaryHandlerSimple(
+		MaponApiCreateTrackingLinkProcedure,
+		svc.CreateTrackingLink,
+		connect.WithSchema(maponApiMethods.ByName("CreateTrackingLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiEditTrackingLinkHandler := connect.NewUnaryHandlerSimple(
+		MaponApiEditTrackingLinkProcedure,
+		svc.EditTrackingLink,
+		connect.WithSchema(maponApiMethods.ByName("EditTrackingLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteTrackingLinkHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteTrackingLinkProcedure,
+		svc.DeleteTrackingLink,
+		connect.WithSchema(maponApiMethods.ByName("DeleteTrackingLink")),
+		connect.WithHandlerOptions(opts...),
+	)
 	maponApiListUnitsHandler := connect.NewUnaryHandlerSimple(
 		MaponApiListUnitsProcedure,
 		svc.ListUnits,
 		connect.WithSchema(maponApiMethods.ByName("ListUnits")),
 		connect.WithHandlerOptions(opts...),
 	)
+	maponApiEditUnitHandler := connect.NewUnaryHandlerSimple(
+		MaponApiEditUnitProcedure,
+		svc.EditUnit,
+		connect.WithSchema(maponApiMethods.ByName("EditUnit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiInstallUnitHandler := connect.NewUnaryHandlerSimple(
+		MaponApiInstallUnitProcedure,
+		svc.InstallUnit,
+		connect.WithSchema(maponApiMethods.ByName("InstallUnit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiUninstallUnitHandler := connect.NewUnaryHandlerSimple(
+		MaponApiUninstallUnitProcedure,
+		svc.UninstallUnit,
+		connect.WithSchema(maponApiMethods.ByName("UninstallUnit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiChangeUnitDeviceHandler := connect.NewUnaryHandlerSimple(
+		MaponApiChangeUnitDeviceProcedure,
+		svc.ChangeUnitDevice,
+		connect.WithSchema(maponApiMethods.ByName("ChangeUnitDevice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiChangeUnitRelayHandler := connect.NewUnaryHandlerSimple(
+		MaponApiChangeUnitRelayProcedure,
+		svc.ChangeUnitRelay,
+		connect.WithSchema(maponApiMethods.ByName("ChangeUnitRelay")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListAvailableUnitIconsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListAvailableUnitIconsProcedure,
+		svc.ListAvailableUnitIcons,
+		connect.WithSchema(maponApiMethods.ByName("ListAvailableUnitIcons")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListUnitCustomFieldsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListUnitCustomFieldsProcedure,
+		svc.ListUnitCustomFields,
+		connect.WithSchema(maponApiMethods.ByName("ListUnitCustomFields")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiSaveUnitCustomFieldValuesHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSaveUnitCustomFieldValuesProcedure,
+		svc.SaveUnitCustomFieldValues,
+		connect.WithSchema(maponApiMethods.ByName("SaveUnitCustomFieldValues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiGetAvailableUnitCommandsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiGetAvailableUnitCommandsProcedure,
+		svc.GetAvailableUnitCommands,
+		connect.WithSchema(maponApiMethods.ByName("GetAvailableUnitCommands")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiExecuteUnitCommandHandler := connect.NewUnaryHandlerSimple(
+		MaponApiExecuteUnitCommandProcedure,
+		svc.ExecuteUnitCommand,
+		connect.WithSchema(maponApiMethods.ByName("ExecuteUnitCommand")),
+		connect.WithHandlerOptions(opts...),
+	)
 	maponApiListUnitGroupsHandler := connect.NewUnaryHandlerSimple(
 		MaponApiListUnitGroupsProcedure,
 		svc.ListUnitGroups,
@@ -653,6 +4704,36 @@ func NewMaponApiHandler(svc MaponApiHandler, opts ...connect.HandlerOption) (str
 		connect.WithSchema(maponApiMethods.ByName("ListUnitsInGroup")),
 		connect.WithHandlerOptions(opts...),
 	)
+	maponApiSaveUnitGroupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiSaveUnitGroupProcedure,
+		svc.SaveUnitGroup,
+		connect.WithSchema(maponApiMethods.ByName("SaveUnitGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteUnitGroupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteUnitGroupProcedure,
+		svc.DeleteUnitGroup,
+		connect.WithSchema(maponApiMethods.ByName("DeleteUnitGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiAttachUnitToGroupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiAttachUnitToGroupProcedure,
+		svc.AttachUnitToGroup,
+		connect.WithSchema(maponApiMethods.ByName("AttachUnitToGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDetachUnitFromGroupHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDetachUnitFromGroupProcedure,
+		svc.DetachUnitFromGroup,
+		connect.WithSchema(maponApiMethods.ByName("DetachUnitFromGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiClearUnitGroupsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiClearUnitGroupsProcedure,
+		svc.ClearUnitGroups,
+		connect.WithSchema(maponApiMethods.ByName("ClearUnitGroups")),
+		connect.WithHandlerOptions(opts...),
+	)
 	maponApiGetCanDataPointHandler := connect.NewUnaryHandlerSimple(
 		MaponApiGetCanDataPointProcedure,
 		svc.GetCanDataPoint,
@@ -725,30 +4806,366 @@ func NewMaponApiHandler(svc MaponApiHandler, opts ...connect.HandlerOption) (str
 		connect.WithSchema(maponApiMethods.ByName("ListTemperatures")),
 		connect.WithHandlerOptions(opts...),
 	)
+	maponApiListUsersHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListUsersProcedure,
+		svc.ListUsers,
+		connect.WithSchema(maponApiMethods.ByName("ListUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiCreateUserHandler := connect.NewUnaryHandlerSimple(
+		MaponApiCreateUserProcedure,
+		svc.CreateUser,
+		connect.WithSchema(maponApiMethods.ByName("CreateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiUpdateUserHandler := connect.NewUnaryHandlerSimple(
+		MaponApiUpdateUserProcedure,
+		svc.UpdateUser,
+		connect.WithSchema(maponApiMethods.ByName("UpdateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteUserHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteUserProcedure,
+		svc.DeleteUser,
+		connect.WithSchema(maponApiMethods.ByName("DeleteUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiChangeUserPasswordHandler := connect.NewUnaryHandlerSimple(
+		MaponApiChangeUserPasswordProcedure,
+		svc.ChangeUserPassword,
+		connect.WithSchema(maponApiMethods.ByName("ChangeUserPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiLinkUserToDriverHandler := connect.NewUnaryHandlerSimple(
+		MaponApiLinkUserToDriverProcedure,
+		svc.LinkUserToDriver,
+		connect.WithSchema(maponApiMethods.ByName("LinkUserToDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiUnlinkUserFromDriverHandler := connect.NewUnaryHandlerSimple(
+		MaponApiUnlinkUserFromDriverProcedure,
+		svc.UnlinkUserFromDriver,
+		connect.WithSchema(maponApiMethods.ByName("UnlinkUserFromDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiListVehicleInspectionsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiListVehicleInspectionsProcedure,
+		svc.ListVehicleInspections,
+		connect.WithSchema(maponApiMethods.ByName("ListVehicleInspections")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiCreateVehiclePinDeviceMappingsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiCreateVehiclePinDeviceMappingsProcedure,
+		svc.CreateVehiclePinDeviceMappings,
+		connect.WithSchema(maponApiMethods.ByName("CreateVehiclePinDeviceMappings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiDeleteVehiclePinDeviceMappingsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiDeleteVehiclePinDeviceMappingsProcedure,
+		svc.DeleteVehiclePinDeviceMappings,
+		connect.WithSchema(maponApiMethods.ByName("DeleteVehiclePinDeviceMappings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiCreateDriverPinsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiCreateDriverPinsProcedure,
+		svc.CreateDriverPins,
+		connect.WithSchema(maponApiMethods.ByName("CreateDriverPins")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maponApiUpdateDriverPinsHandler := connect.NewUnaryHandlerSimple(
+		MaponApiUpdateDriverPinsProcedure,
+		svc.UpdateDriverPins,
+		connect.WithSchema(maponApiMethods.ByName("UpdateDriverPins")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/wayplatform.connect.mapon.v1.MaponApi/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MaponApiListAlertsProcedure:
 			maponApiListAlertsHandler.ServeHTTP(w, r)
+		case MaponApiListAlertSetupsProcedure:
+			maponApiListAlertSetupsHandler.ServeHTTP(w, r)
+		case MaponApiGetAlertSetupTypesProcedure:
+			maponApiGetAlertSetupTypesHandler.ServeHTTP(w, r)
+		case MaponApiGetAlertSetupFieldsProcedure:
+			maponApiGetAlertSetupFieldsHandler.ServeHTTP(w, r)
+		case MaponApiStoreAlertSetupProcedure:
+			maponApiStoreAlertSetupHandler.ServeHTTP(w, r)
+		case MaponApiDeleteAlertSetupProcedure:
+			maponApiDeleteAlertSetupHandler.ServeHTTP(w, r)
+		case MaponApiListBleTagsProcedure:
+			maponApiListBleTagsHandler.ServeHTTP(w, r)
+		case MaponApiListBleTagScansProcedure:
+			maponApiListBleTagScansHandler.ServeHTTP(w, r)
+		case MaponApiListBleTagLocationsProcedure:
+			maponApiListBleTagLocationsHandler.ServeHTTP(w, r)
+		case MaponApiGetBleTagHistoryPointProcedure:
+			maponApiGetBleTagHistoryPointHandler.ServeHTTP(w, r)
+		case MaponApiGetCompanyProcedure:
+			maponApiGetCompanyHandler.ServeHTTP(w, r)
+		case MaponApiListCompanyClientsProcedure:
+			maponApiListCompanyClientsHandler.ServeHTTP(w, r)
+		case MaponApiCreateCompanyClientProcedure:
+			maponApiCreateCompanyClientHandler.ServeHTTP(w, r)
+		case MaponApiListCustomLayersProcedure:
+			maponApiListCustomLayersHandler.ServeHTTP(w, r)
+		case MaponApiSaveCustomLayerProcedure:
+			maponApiSaveCustomLayerHandler.ServeHTTP(w, r)
+		case MaponApiDeleteCustomLayerProcedure:
+			maponApiDeleteCustomLayerHandler.ServeHTTP(w, r)
+		case MaponApiListCustomLayerGeometriesProcedure:
+			maponApiListCustomLayerGeometriesHandler.ServeHTTP(w, r)
+		case MaponApiAddCustomLayerGeometriesProcedure:
+			maponApiAddCustomLayerGeometriesHandler.ServeHTTP(w, r)
+		case MaponApiEditCustomLayerGeometryProcedure:
+			maponApiEditCustomLayerGeometryHandler.ServeHTTP(w, r)
+		case MaponApiDeleteCustomLayerGeometryProcedure:
+			maponApiDeleteCustomLayerGeometryHandler.ServeHTTP(w, r)
+		case MaponApiListCustomTargetsProcedure:
+			maponApiListCustomTargetsHandler.ServeHTTP(w, r)
+		case MaponApiListCustomTargetValuesProcedure:
+			maponApiListCustomTargetValuesHandler.ServeHTTP(w, r)
 		case MaponApiDeleteDataForwardProcedure:
 			maponApiDeleteDataForwardHandler.ServeHTTP(w, r)
 		case MaponApiListDataForwardsProcedure:
 			maponApiListDataForwardsHandler.ServeHTTP(w, r)
 		case MaponApiSaveDataForwardProcedure:
 			maponApiSaveDataForwardHandler.ServeHTTP(w, r)
+		case MaponApiListDevicesProcedure:
+			maponApiListDevicesHandler.ServeHTTP(w, r)
+		case MaponApiCreateDeviceProcedure:
+			maponApiCreateDeviceHandler.ServeHTTP(w, r)
+		case MaponApiListDeviceModelsProcedure:
+			maponApiListDeviceModelsHandler.ServeHTTP(w, r)
+		case MaponApiGetDeviceCommandsProcedure:
+			maponApiGetDeviceCommandsHandler.ServeHTTP(w, r)
+		case MaponApiSendDeviceSmsCommandProcedure:
+			maponApiSendDeviceSmsCommandHandler.ServeHTTP(w, r)
+		case MaponApiSendDeviceTcpCommandProcedure:
+			maponApiSendDeviceTcpCommandHandler.ServeHTTP(w, r)
+		case MaponApiListDeviceSmsHistoryProcedure:
+			maponApiListDeviceSmsHistoryHandler.ServeHTTP(w, r)
 		case MaponApiListDriversProcedure:
 			maponApiListDriversHandler.ServeHTTP(w, r)
+		case MaponApiCreateDriverProcedure:
+			maponApiCreateDriverHandler.ServeHTTP(w, r)
+		case MaponApiUpdateDriverProcedure:
+			maponApiUpdateDriverHandler.ServeHTTP(w, r)
+		case MaponApiDeleteDriverProcedure:
+			maponApiDeleteDriverHandler.ServeHTTP(w, r)
+		case MaponApiChangeDriverPasswordProcedure:
+			maponApiChangeDriverPasswordHandler.ServeHTTP(w, r)
+		case MaponApiAssociateExternalDriverProcedure:
+			maponApiAssociateExternalDriverHandler.ServeHTTP(w, r)
+		case MaponApiLinkDriverUserProcedure:
+			maponApiLinkDriverUserHandler.ServeHTTP(w, r)
+		case MaponApiUnlinkDriverUserProcedure:
+			maponApiUnlinkDriverUserHandler.ServeHTTP(w, r)
+		case MaponApiListDriverCustomFieldsProcedure:
+			maponApiListDriverCustomFieldsHandler.ServeHTTP(w, r)
+		case MaponApiSaveDriverCustomFieldsProcedure:
+			maponApiSaveDriverCustomFieldsHandler.ServeHTTP(w, r)
+		case MaponApiSaveDriverCustomFieldValuesProcedure:
+			maponApiSaveDriverCustomFieldValuesHandler.ServeHTTP(w, r)
+		case MaponApiDeleteDriverCustomFieldsProcedure:
+			maponApiDeleteDriverCustomFieldsHandler.ServeHTTP(w, r)
+		case MaponApiListDriverDailyActivitiesProcedure:
+			maponApiListDriverDailyActivitiesHandler.ServeHTTP(w, r)
+		case MaponApiGetDriverBehaviourReportDriversProcedure:
+			maponApiGetDriverBehaviourReportDriversHandler.ServeHTTP(w, r)
+		case MaponApiGetDriverBehaviourReportUnitsProcedure:
+			maponApiGetDriverBehaviourReportUnitsHandler.ServeHTTP(w, r)
+		case MaponApiListDriverGroupsProcedure:
+			maponApiListDriverGroupsHandler.ServeHTTP(w, r)
+		case MaponApiListDriversInGroupProcedure:
+			maponApiListDriversInGroupHandler.ServeHTTP(w, r)
+		case MaponApiSaveDriverGroupProcedure:
+			maponApiSaveDriverGroupHandler.ServeHTTP(w, r)
+		case MaponApiDeleteDriverGroupProcedure:
+			maponApiDeleteDriverGroupHandler.ServeHTTP(w, r)
+		case MaponApiAddDriverToGroupProcedure:
+			maponApiAddDriverToGroupHandler.ServeHTTP(w, r)
+		case MaponApiRemoveDriverFromGroupProcedure:
+			maponApiRemoveDriverFromGroupHandler.ServeHTTP(w, r)
+		case MaponApiClearDriverGroupsProcedure:
+			maponApiClearDriverGroupsHandler.ServeHTTP(w, r)
+		case MaponApiListFuelDataProcedure:
+			maponApiListFuelDataHandler.ServeHTTP(w, r)
+		case MaponApiListFuelChangesProcedure:
+			maponApiListFuelChangesHandler.ServeHTTP(w, r)
+		case MaponApiGetFuelSummaryProcedure:
+			maponApiGetFuelSummaryHandler.ServeHTTP(w, r)
+		case MaponApiListFuelChecksProcedure:
+			maponApiListFuelChecksHandler.ServeHTTP(w, r)
+		case MaponApiAddFuelCheckProcedure:
+			maponApiAddFuelCheckHandler.ServeHTTP(w, r)
+		case MaponApiEditFuelCheckProcedure:
+			maponApiEditFuelCheckHandler.ServeHTTP(w, r)
+		case MaponApiDeleteFuelCheckProcedure:
+			maponApiDeleteFuelCheckHandler.ServeHTTP(w, r)
+		case MaponApiAddFuelCardProcedure:
+			maponApiAddFuelCardHandler.ServeHTTP(w, r)
+		case MaponApiUpdateFuelCardProcedure:
+			maponApiUpdateFuelCardHandler.ServeHTTP(w, r)
+		case MaponApiDeleteFuelCardProcedure:
+			maponApiDeleteFuelCardHandler.ServeHTTP(w, r)
+		case MaponApiListMessagingChannelsProcedure:
+			maponApiListMessagingChannelsHandler.ServeHTTP(w, r)
+		case MaponApiListMessagingConversationsProcedure:
+			maponApiListMessagingConversationsHandler.ServeHTTP(w, r)
+		case MaponApiCreateMessagingConversationProcedure:
+			maponApiCreateMessagingConversationHandler.ServeHTTP(w, r)
+		case MaponApiListMessagesProcedure:
+			maponApiListMessagesHandler.ServeHTTP(w, r)
+		case MaponApiSendMessageProcedure:
+			maponApiSendMessageHandler.ServeHTTP(w, r)
 		case MaponApiListObjectsProcedure:
 			maponApiListObjectsHandler.ServeHTTP(w, r)
+		case MaponApiSaveObjectProcedure:
+			maponApiSaveObjectHandler.ServeHTTP(w, r)
+		case MaponApiDeleteObjectProcedure:
+			maponApiDeleteObjectHandler.ServeHTTP(w, r)
+		case MaponApiGetObjectCustomFieldsProcedure:
+			maponApiGetObjectCustomFieldsHandler.ServeHTTP(w, r)
+		case MaponApiSaveObjectCustomFieldsValuesProcedure:
+			maponApiSaveObjectCustomFieldsValuesHandler.ServeHTTP(w, r)
+		case MaponApiListObjectGroupsProcedure:
+			maponApiListObjectGroupsHandler.ServeHTTP(w, r)
+		case MaponApiSaveObjectGroupProcedure:
+			maponApiSaveObjectGroupHandler.ServeHTTP(w, r)
+		case MaponApiDeleteObjectGroupProcedure:
+			maponApiDeleteObjectGroupHandler.ServeHTTP(w, r)
+		case MaponApiListPresetsProcedure:
+			maponApiListPresetsHandler.ServeHTTP(w, r)
+		case MaponApiGetPresetProcedure:
+			maponApiGetPresetHandler.ServeHTTP(w, r)
+		case MaponApiCreatePresetProcedure:
+			maponApiCreatePresetHandler.ServeHTTP(w, r)
+		case MaponApiEditPresetProcedure:
+			maponApiEditPresetHandler.ServeHTTP(w, r)
+		case MaponApiDeletePresetProcedure:
+			maponApiDeletePresetHandler.ServeHTTP(w, r)
+		case MaponApiGetAvailablePresetPermissionsProcedure:
+			maponApiGetAvailablePresetPermissionsHandler.ServeHTTP(w, r)
+		case MaponApiGetReeferHistoricPeriodProcedure:
+			maponApiGetReeferHistoricPeriodHandler.ServeHTTP(w, r)
+		case MaponApiGetReeferHistoricPointProcedure:
+			maponApiGetReeferHistoricPointHandler.ServeHTTP(w, r)
+		case MaponApiListReeferTemperatureDataProcedure:
+			maponApiListReeferTemperatureDataHandler.ServeHTTP(w, r)
+		case MaponApiListReeferRunModesProcedure:
+			maponApiListReeferRunModesHandler.ServeHTTP(w, r)
+		case MaponApiChangeReeferSetpointProcedure:
+			maponApiChangeReeferSetpointHandler.ServeHTTP(w, r)
+		case MaponApiChangeReeferRunModeProcedure:
+			maponApiChangeReeferRunModeHandler.ServeHTTP(w, r)
+		case MaponApiSetReeferAlertProcedure:
+			maponApiSetReeferAlertHandler.ServeHTTP(w, r)
+		case MaponApiListReeferAlertsProcedure:
+			maponApiListReeferAlertsHandler.ServeHTTP(w, r)
+		case MaponApiDeleteReeferAlertProcedure:
+			maponApiDeleteReeferAlertHandler.ServeHTTP(w, r)
+		case MaponApiChangeReeferAlertUserProcedure:
+			maponApiChangeReeferAlertUserHandler.ServeHTTP(w, r)
 		case MaponApiListRoutesProcedure:
 			maponApiListRoutesHandler.ServeHTTP(w, r)
+		case MaponApiCreateRoutePlanningOrderProcedure:
+			maponApiCreateRoutePlanningOrderHandler.ServeHTTP(w, r)
+		case MaponApiAddRoutePlanningOrderPlacesProcedure:
+			maponApiAddRoutePlanningOrderPlacesHandler.ServeHTTP(w, r)
+		case MaponApiGetRoutePlanningOrderProcedure:
+			maponApiGetRoutePlanningOrderHandler.ServeHTTP(w, r)
+		case MaponApiListRoutePlanningOrdersProcedure:
+			maponApiListRoutePlanningOrdersHandler.ServeHTTP(w, r)
+		case MaponApiDeleteRoutePlanningOrdersProcedure:
+			maponApiDeleteRoutePlanningOrdersHandler.ServeHTTP(w, r)
+		case MaponApiGetRoutePlanningPlaceProcedure:
+			maponApiGetRoutePlanningPlaceHandler.ServeHTTP(w, r)
+		case MaponApiListRoutePlanningPlacesProcedure:
+			maponApiListRoutePlanningPlacesHandler.ServeHTTP(w, r)
+		case MaponApiDeleteRoutePlanningPlacesProcedure:
+			maponApiDeleteRoutePlanningPlacesHandler.ServeHTTP(w, r)
+		case MaponApiSaveRoutePlanningRouteProcedure:
+			maponApiSaveRoutePlanningRouteHandler.ServeHTTP(w, r)
+		case MaponApiGetRoutePlanningRouteProcedure:
+			maponApiGetRoutePlanningRouteHandler.ServeHTTP(w, r)
+		case MaponApiListRoutePlanningRoutesProcedure:
+			maponApiListRoutePlanningRoutesHandler.ServeHTTP(w, r)
+		case MaponApiSendRoutePlanningRouteToAssigneeProcedure:
+			maponApiSendRoutePlanningRouteToAssigneeHandler.ServeHTTP(w, r)
+		case MaponApiSetRoutePlanningRouteStartAddressProcedure:
+			maponApiSetRoutePlanningRouteStartAddressHandler.ServeHTTP(w, r)
+		case MaponApiSetRoutePlanningRouteEndAddressProcedure:
+			maponApiSetRoutePlanningRouteEndAddressHandler.ServeHTTP(w, r)
+		case MaponApiOptimizeRoutePlanningRouteProcedure:
+			maponApiOptimizeRoutePlanningRouteHandler.ServeHTTP(w, r)
+		case MaponApiGetRoutePlanningOptimizationProgressProcedure:
+			maponApiGetRoutePlanningOptimizationProgressHandler.ServeHTTP(w, r)
+		case MaponApiDeleteRoutePlanningRoutesProcedure:
+			maponApiDeleteRoutePlanningRoutesHandler.ServeHTTP(w, r)
+		case MaponApiListDriverDddFilesProcedure:
+			maponApiListDriverDddFilesHandler.ServeHTTP(w, r)
+		case MaponApiListVehicleDddFilesProcedure:
+			maponApiListVehicleDddFilesHandler.ServeHTTP(w, r)
+		case MaponApiDownloadDriverDddProcedure:
+			maponApiDownloadDriverDddHandler.ServeHTTP(w, r)
+		case MaponApiDownloadVehicleDddProcedure:
+			maponApiDownloadVehicleDddHandler.ServeHTTP(w, r)
+		case MaponApiListTasksProcedure:
+			maponApiListTasksHandler.ServeHTTP(w, r)
+		case MaponApiCreateTaskProcedure:
+			maponApiCreateTaskHandler.ServeHTTP(w, r)
+		case MaponApiEditTaskProcedure:
+			maponApiEditTaskHandler.ServeHTTP(w, r)
+		case MaponApiDeleteTaskProcedure:
+			maponApiDeleteTaskHandler.ServeHTTP(w, r)
+		case MaponApiDeleteTaskRouteProcedure:
+			maponApiDeleteTaskRouteHandler.ServeHTTP(w, r)
 		case MaponApiListTellTaleValuesProcedure:
 			maponApiListTellTaleValuesHandler.ServeHTTP(w, r)
+		case MaponApiListTrackingLinksProcedure:
+			maponApiListTrackingLinksHandler.ServeHTTP(w, r)
+		case MaponApiCreateTrackingLinkProcedure:
+			maponApiCreateTrackingLinkHandler.ServeHTTP(w, r)
+		case MaponApiEditTrackingLinkProcedure:
+			maponApiEditTrackingLinkHandler.ServeHTTP(w, r)
+		case MaponApiDeleteTrackingLinkProcedure:
+			maponApiDeleteTrackingLinkHandler.ServeHTTP(w, r)
 		case MaponApiListUnitsProcedure:
 			maponApiListUnitsHandler.ServeHTTP(w, r)
+		case MaponApiEditUnitProcedure:
+			maponApiEditUnitHandler.ServeHTTP(w, r)
+		case MaponApiInstallUnitProcedure:
+			maponApiInstallUnitHandler.ServeHTTP(w, r)
+		case MaponApiUninstallUnitProcedure:
+			maponApiUninstallUnitHandler.ServeHTTP(w, r)
+		case MaponApiChangeUnitDeviceProcedure:
+			maponApiChangeUnitDeviceHandler.ServeHTTP(w, r)
+		case MaponApiChangeUnitRelayProcedure:
+			maponApiChangeUnitRelayHandler.ServeHTTP(w, r)
+		case MaponApiListAvailableUnitIconsProcedure:
+			maponApiListAvailableUnitIconsHandler.ServeHTTP(w, r)
+		case MaponApiListUnitCustomFieldsProcedure:
+			maponApiListUnitCustomFieldsHandler.ServeHTTP(w, r)
+		case MaponApiSaveUnitCustomFieldValuesProcedure:
+			maponApiSaveUnitCustomFieldValuesHandler.ServeHTTP(w, r)
+		case MaponApiGetAvailableUnitCommandsProcedure:
+			maponApiGetAvailableUnitCommandsHandler.ServeHTTP(w, r)
+		case MaponApiExecuteUnitCommandProcedure:
+			maponApiExecuteUnitCommandHandler.ServeHTTP(w, r)
 		case MaponApiListUnitGroupsProcedure:
 			maponApiListUnitGroupsHandler.ServeHTTP(w, r)
 		case MaponApiListUnitsInGroupProcedure:
 			maponApiListUnitsInGroupHandler.ServeHTTP(w, r)
+		case MaponApiSaveUnitGroupProcedure:
+			maponApiSaveUnitGroupHandler.ServeHTTP(w, r)
+		case MaponApiDeleteUnitGroupProcedure:
+			maponApiDeleteUnitGroupHandler.ServeHTTP(w, r)
+		case MaponApiAttachUnitToGroupProcedure:
+			maponApiAttachUnitToGroupHandler.ServeHTTP(w, r)
+		case MaponApiDetachUnitFromGroupProcedure:
+			maponApiDetachUnitFromGroupHandler.ServeHTTP(w, r)
+		case MaponApiClearUnitGroupsProcedure:
+			maponApiClearUnitGroupsHandler.ServeHTTP(w, r)
 		case MaponApiGetCanDataPointProcedure:
 			maponApiGetCanDataPointHandler.ServeHTTP(w, r)
 		case MaponApiListCanPeriodDataProcedure:
@@ -773,17 +5190,125 @@ func NewMaponApiHandler(svc MaponApiHandler, opts ...connect.HandlerOption) (str
 			maponApiListIgnitionsHandler.ServeHTTP(w, r)
 		case MaponApiListTemperaturesProcedure:
 			maponApiListTemperaturesHandler.ServeHTTP(w, r)
+		case MaponApiListUsersProcedure:
+			maponApiListUsersHandler.ServeHTTP(w, r)
+		case MaponApiCreateUserProcedure:
+			maponApiCreateUserHandler.ServeHTTP(w, r)
+		case MaponApiUpdateUserProcedure:
+			maponApiUpdateUserHandler.ServeHTTP(w, r)
+		case MaponApiDeleteUserProcedure:
+			maponApiDeleteUserHandler.ServeHTTP(w, r)
+		case MaponApiChangeUserPasswordProcedure:
+			maponApiChangeUserPasswordHandler.ServeHTTP(w, r)
+		case MaponApiLinkUserToDriverProcedure:
+			maponApiLinkUserToDriverHandler.ServeHTTP(w, r)
+		case MaponApiUnlinkUserFromDriverProcedure:
+			maponApiUnlinkUserFromDriverHandler.ServeHTTP(w, r)
+		case MaponApiListVehicleInspectionsProcedure:
+			maponApiListVehicleInspectionsHandler.ServeHTTP(w, r)
+		case MaponApiCreateVehiclePinDeviceMappingsProcedure:
+			maponApiCreateVehiclePinDeviceMappingsHandler.ServeHTTP(w, r)
+		case MaponApiDeleteVehiclePinDeviceMappingsProcedure:
+			maponApiDeleteVehiclePinDeviceMappingsHandler.ServeHTTP(w, r)
+		case MaponApiCreateDriverPinsProcedure:
+			maponApiCreateDriverPinsHandler.ServeHTTP(w, r)
+		case MaponApiUpdateDriverPinsProcedure:
+			maponApiUpdateDriverPinsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
 	})
 }
 
-// UnimplementedMaponApiHandler returns CodeUnimplemented from all methods.
-type UnimplementedMaponApiHandler struct{}
+// UnimplementedMaponApiHandler returns CodeUnimplemented from all methods.
+type UnimplementedMaponApiHandler struct{}
+
+func (UnimplementedMaponApiHandler) ListAlerts(context.Context, *v1.ListAlertsRequest) (*v1.ListAlertsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListAlerts is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListAlertSetups(context.Context, *v1.ListAlertSetupsRequest) (*v1.ListAlertSetupsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListAlertSetups is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetAlertSetupTypes(context.Context, *v1.GetAlertSetupTypesRequest) (*v1.GetAlertSetupTypesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetAlertSetupTypes is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetAlertSetupFields(context.Context, *v1.GetAlertSetupFieldsRequest) (*v1.GetAlertSetupFieldsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetAlertSetupFields is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) StoreAlertSetup(context.Context, *v1.StoreAlertSetupRequest) (*v1.StoreAlertSetupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.StoreAlertSetup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteAlertSetup(context.Context, *v1.DeleteAlertSetupRequest) (*v1.DeleteAlertSetupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteAlertSetup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListBleTags(context.Context, *v1.ListBleTagsRequest) (*v1.ListBleTagsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListBleTags is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListBleTagScans(context.Context, *v1.ListBleTagScansRequest) (*v1.ListBleTagScansResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListBleTagScans is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListBleTagLocations(context.Context, *v1.ListBleTagLocationsRequest) (*v1.ListBleTagLocationsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListBleTagLocations is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetBleTagHistoryPoint(context.Context, *v1.GetBleTagHistoryPointRequest) (*v1.GetBleTagHistoryPointResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetBleTagHistoryPoint is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetCompany(context.Context, *v1.GetCompanyRequest) (*v1.GetCompanyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetCompany is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListCompanyClients(context.Context, *v1.ListCompanyClientsRequest) (*v1.ListCompanyClientsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListCompanyClients is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) CreateCompanyClient(context.Context, *v1.CreateCompanyClientRequest) (*v1.CreateCompanyClientResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.CreateCompanyClient is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListCustomLayers(context.Context, *v1.ListCustomLayersRequest) (*v1.ListCustomLayersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListCustomLayers is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SaveCustomLayer(context.Context, *v1.SaveCustomLayerRequest) (*v1.SaveCustomLayerResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SaveCustomLayer is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteCustomLayer(context.Context, *v1.DeleteCustomLayerRequest) (*v1.DeleteCustomLayerResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteCustomLayer is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListCustomLayerGeometries(context.Context, *v1.ListCustomLayerGeometriesRequest) (*v1.ListCustomLayerGeometriesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListCustomLayerGeometries is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) AddCustomLayerGeometries(context.Context, *v1.AddCustomLayerGeometriesRequest) (*v1.AddCustomLayerGeometriesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.AddCustomLayerGeometries is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) EditCustomLayerGeometry(context.Context, *v1.EditCustomLayerGeometryRequest) (*v1.EditCustomLayerGeometryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.EditCustomLayerGeometry is not implemented"))
+}
 
-func (UnimplementedMaponApiHandler) ListAlerts(context.Context, *v1.ListAlertsRequest) (*v1.ListAlertsResponse, error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListAlerts is not implemented"))
+func (UnimplementedMaponApiHandler) DeleteCustomLayerGeometry(context.Context, *v1.DeleteCustomLayerGeometryRequest) (*v1.DeleteCustomLayerGeometryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteCustomLayerGeometry is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListCustomTargets(context.Context, *v1.ListCustomTargetsRequest) (*v1.ListCustomTargetsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListCustomTargets is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListCustomTargetValues(context.Context, *v1.ListCustomTargetValuesRequest) (*v1.ListCustomTargetValuesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListCustomTargetValues is not implemented"))
 }
 
 func (UnimplementedMaponApiHandler) DeleteDataForward(context.Context, *v1.DeleteDataForwardRequest) (*v1.DeleteDataForwardResponse, error) {
@@ -798,26 +5323,450 @@ func (UnimplementedMaponApiHandler) SaveDataForward(context.Context, *v1.SaveDat
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SaveDataForward is not implemented"))
 }
 
+func (UnimplementedMaponApiHandler) ListDevices(context.Context, *v1.ListDevicesRequest) (*v1.ListDevicesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListDevices is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) CreateDevice(context.Context, *v1.CreateDeviceRequest) (*v1.CreateDeviceResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.CreateDevice is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListDeviceModels(context.Context, *v1.ListDeviceModelsRequest) (*v1.ListDeviceModelsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListDeviceModels is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetDeviceCommands(context.Context, *v1.GetDeviceCommandsRequest) (*v1.GetDeviceCommandsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetDeviceCommands is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SendDeviceSmsCommand(context.Context, *v1.SendDeviceSmsCommandRequest) (*v1.SendDeviceSmsCommandResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SendDeviceSmsCommand is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SendDeviceTcpCommand(context.Context, *v1.SendDeviceTcpCommandRequest) (*v1.SendDeviceTcpCommandResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SendDeviceTcpCommand is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListDeviceSmsHistory(context.Context, *v1.ListDeviceSmsHistoryRequest) (*v1.ListDeviceSmsHistoryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListDeviceSmsHistory is not implemented"))
+}
+
 func (UnimplementedMaponApiHandler) ListDrivers(context.Context, *v1.ListDriversRequest) (*v1.ListDriversResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListDrivers is not implemented"))
 }
 
+func (UnimplementedMaponApiHandler) CreateDriver(context.Context, *v1.CreateDriverRequest) (*v1.CreateDriverResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.CreateDriver is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) UpdateDriver(context.Context, *v1.UpdateDriverRequest) (*v1.UpdateDriverResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.UpdateDriver is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteDriver(context.Context, *v1.DeleteDriverRequest) (*v1.DeleteDriverResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteDriver is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ChangeDriverPassword(context.Context, *v1.ChangeDriverPasswordRequest) (*v1.ChangeDriverPasswordResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ChangeDriverPassword is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) AssociateExternalDriver(context.Context, *v1.AssociateExternalDriverRequest) (*v1.AssociateExternalDriverResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.AssociateExternalDriver is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) LinkDriverUser(context.Context, *v1.LinkDriverUserRequest) (*v1.LinkDriverUserResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.LinkDriverUser is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) UnlinkDriverUser(context.Context, *v1.UnlinkDriverUserRequest) (*v1.UnlinkDriverUserResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.UnlinkDriverUser is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListDriverCustomFields(context.Context, *v1.ListDriverCustomFieldsRequest) (*v1.ListDriverCustomFieldsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListDriverCustomFields is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SaveDriverCustomFields(context.Context, *v1.SaveDriverCustomFieldsRequest) (*v1.SaveDriverCustomFieldsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SaveDriverCustomFields is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SaveDriverCustomFieldValues(context.Context, *v1.SaveDriverCustomFieldValuesRequest) (*v1.SaveDriverCustomFieldValuesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SaveDriverCustomFieldValues is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteDriverCustomFields(context.Context, *v1.DeleteDriverCustomFieldsRequest) (*v1.DeleteDriverCustomFieldsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteDriverCustomFields is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListDriverDailyActivities(context.Context, *v1.ListDriverDailyActivitiesRequest) (*v1.ListDriverDailyActivitiesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListDriverDailyActivities is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetDriverBehaviourReportDrivers(context.Context, *v1.GetDriverBehaviourReportDriversRequest) (*v1.GetDriverBehaviourReportDriversResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetDriverBehaviourReportDrivers is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetDriverBehaviourReportUnits(context.Context, *v1.GetDriverBehaviourReportUnitsRequest) (*v1.GetDriverBehaviourReportUnitsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetDriverBehaviourReportUnits is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListDriverGroups(context.Context, *v1.ListDriverGroupsRequest) (*v1.ListDriverGroupsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListDriverGroups is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListDriversInGroup(context.Context, *v1.ListDriversInGroupRequest) (*v1.ListDriversInGroupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListDriversInGroup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SaveDriverGroup(context.Context, *v1.SaveDriverGroupRequest) (*v1.SaveDriverGroupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SaveDriverGroup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteDriverGroup(context.Context, *v1.DeleteDriverGroupRequest) (*v1.DeleteDriverGroupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteDriverGroup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) AddDriverToGroup(context.Context, *v1.AddDriverToGroupRequest) (*v1.AddDriverToGroupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.AddDriverToGroup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) RemoveDriverFromGroup(context.Context, *v1.RemoveDriverFromGroupRequest) (*v1.RemoveDriverFromGroupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.RemoveDriverFromGroup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ClearDriverGroups(context.Context, *v1.ClearDriverGroupsRequest) (*v1.ClearDriverGroupsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ClearDriverGroups is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListFuelData(context.Context, *v1.ListFuelDataRequest) (*v1.ListFuelDataResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListFuelData is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListFuelChanges(context.Context, *v1.ListFuelChangesRequest) (*v1.ListFuelChangesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListFuelChanges is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetFuelSummary(context.Context, *v1.GetFuelSummaryRequest) (*v1.GetFuelSummaryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetFuelSummary is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListFuelChecks(context.Context, *v1.ListFuelChecksRequest) (*v1.ListFuelChecksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListFuelChecks is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) AddFuelCheck(context.Context, *v1.AddFuelCheckRequest) (*v1.AddFuelCheckResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.AddFuelCheck is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) EditFuelCheck(context.Context, *v1.EditFuelCheckRequest) (*v1.EditFuelCheckResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.EditFuelCheck is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteFuelCheck(context.Context, *v1.DeleteFuelCheckRequest) (*v1.DeleteFuelCheckResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteFuelCheck is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) AddFuelCard(context.Context, *v1.AddFuelCardRequest) (*v1.AddFuelCardResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.AddFuelCard is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) UpdateFuelCard(context.Context, *v1.UpdateFuelCardRequest) (*v1.UpdateFuelCardResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.UpdateFuelCard is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteFuelCard(context.Context, *v1.DeleteFuelCardRequest) (*v1.DeleteFuelCardResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteFuelCard is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListMessagingChannels(context.Context, *v1.ListMessagingChannelsRequest) (*v1.ListMessagingChannelsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListMessagingChannels is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListMessagingConversations(context.Context, *v1.ListMessagingConversationsRequest) (*v1.ListMessagingConversationsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListMessagingConversations is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) CreateMessagingConversation(context.Context, *v1.CreateMessagingConversationRequest) (*v1.CreateMessagingConversationResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.CreateMessagingConversation is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListMessages(context.Context, *v1.ListMessagesRequest) (*v1.ListMessagesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListMessages is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SendMessage(context.Context, *v1.SendMessageRequest) (*v1.SendMessageResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SendMessage is not implemented"))
+}
+
 func (UnimplementedMaponApiHandler) ListObjects(context.Context, *v1.ListObjectsRequest) (*v1.ListObjectsResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListObjects is not implemented"))
 }
 
+func (UnimplementedMaponApiHandler) SaveObject(context.Context, *v1.SaveObjectRequest) (*v1.SaveObjectResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SaveObject is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteObject(context.Context, *v1.DeleteObjectRequest) (*v1.DeleteObjectResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteObject is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetObjectCustomFields(context.Context, *v1.GetObjectCustomFieldsRequest) (*v1.GetObjectCustomFieldsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetObjectCustomFields is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SaveObjectCustomFieldsValues(context.Context, *v1.SaveObjectCustomFieldsValuesRequest) (*v1.SaveObjectCustomFieldsValuesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SaveObjectCustomFieldsValues is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListObjectGroups(context.Context, *v1.ListObjectGroupsRequest) (*v1.ListObjectGroupsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListObjectGroups is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SaveObjectGroup(context.Context, *v1.SaveObjectGroupRequest) (*v1.SaveObjectGroupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SaveObjectGroup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteObjectGroup(context.Context, *v1.DeleteObjectGroupRequest) (*v1.DeleteObjectGroupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteObjectGroup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListPresets(context.Context, *v1.ListPresetsRequest) (*v1.ListPresetsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListPresets is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetPreset(context.Context, *v1.GetPresetRequest) (*v1.GetPresetResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetPreset is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) CreatePreset(context.Context, *v1.CreatePresetRequest) (*v1.CreatePresetResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.CreatePreset is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) EditPreset(context.Context, *v1.EditPresetRequest) (*v1.EditPresetResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.EditPreset is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeletePreset(context.Context, *v1.DeletePresetRequest) (*v1.DeletePresetResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeletePreset is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetAvailablePresetPermissions(context.Context, *v1.GetAvailablePresetPermissionsRequest) (*v1.GetAvailablePresetPermissionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetAvailablePresetPermissions is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetReeferHistoricPeriod(context.Context, *v1.GetReeferHistoricPeriodRequest) (*v1.GetReeferHistoricPeriodResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetReeferHistoricPeriod is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetReeferHistoricPoint(context.Context, *v1.GetReeferHistoricPointRequest) (*v1.GetReeferHistoricPointResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetReeferHistoricPoint is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListReeferTemperatureData(context.Context, *v1.ListReeferTemperatureDataRequest) (*v1.ListReeferTemperatureDataResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListReeferTemperatureData is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListReeferRunModes(context.Context, *v1.ListReeferRunModesRequest) (*v1.ListReeferRunModesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListReeferRunModes is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ChangeReeferSetpoint(context.Context, *v1.ChangeReeferSetpointRequest) (*v1.ChangeReeferSetpointResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ChangeReeferSetpoint is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ChangeReeferRunMode(context.Context, *v1.ChangeReeferRunModeRequest) (*v1.ChangeReeferRunModeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ChangeReeferRunMode is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SetReeferAlert(context.Context, *v1.SetReeferAlertRequest) (*v1.SetReeferAlertResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SetReeferAlert is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListReeferAlerts(context.Context, *v1.ListReeferAlertsRequest) (*v1.ListReeferAlertsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListReeferAlerts is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteReeferAlert(context.Context, *v1.DeleteReeferAlertRequest) (*v1.DeleteReeferAlertResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteReeferAlert is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ChangeReeferAlertUser(context.Context, *v1.ChangeReeferAlertUserRequest) (*v1.ChangeReeferAlertUserResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ChangeReeferAlertUser is not implemented"))
+}
+
 func (UnimplementedMaponApiHandler) ListRoutes(context.Context, *v1.ListRoutesRequest) (*v1.ListRoutesResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListRoutes is not implemented"))
 }
 
+func (UnimplementedMaponApiHandler) CreateRoutePlanningOrder(context.Context, *v1.CreateRoutePlanningOrderRequest) (*v1.CreateRoutePlanningOrderResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.CreateRoutePlanningOrder is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) AddRoutePlanningOrderPlaces(context.Context, *v1.AddRoutePlanningOrderPlacesRequest) (*v1.AddRoutePlanningOrderPlacesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.AddRoutePlanningOrderPlaces is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetRoutePlanningOrder(context.Context, *v1.GetRoutePlanningOrderRequest) (*v1.GetRoutePlanningOrderResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetRoutePlanningOrder is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListRoutePlanningOrders(context.Context, *v1.ListRoutePlanningOrdersRequest) (*v1.ListRoutePlanningOrdersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListRoutePlanningOrders is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteRoutePlanningOrders(context.Context, *v1.DeleteRoutePlanningOrdersRequest) (*v1.DeleteRoutePlanningOrdersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteRoutePlanningOrders is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetRoutePlanningPlace(context.Context, *v1.GetRoutePlanningPlaceRequest) (*v1.GetRoutePlanningPlaceResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetRoutePlanningPlace is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListRoutePlanningPlaces(context.Context, *v1.ListRoutePlanningPlacesRequest) (*v1.ListRoutePlanningPlacesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListRoutePlanningPlaces is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteRoutePlanningPlaces(context.Context, *v1.DeleteRoutePlanningPlacesRequest) (*v1.DeleteRoutePlanningPlacesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteRoutePlanningPlaces is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SaveRoutePlanningRoute(context.Context, *v1.SaveRoutePlanningRouteRequest) (*v1.SaveRoutePlanningRouteResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SaveRoutePlanningRoute is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetRoutePlanningRoute(context.Context, *v1.GetRoutePlanningRouteRequest) (*v1.GetRoutePlanningRouteResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetRoutePlanningRoute is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListRoutePlanningRoutes(context.Context, *v1.ListRoutePlanningRoutesRequest) (*v1.ListRoutePlanningRoutesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListRoutePlanningRoutes is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SendRoutePlanningRouteToAssignee(context.Context, *v1.SendRoutePlanningRouteToAssigneeRequest) (*v1.SendRoutePlanningRouteToAssigneeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SendRoutePlanningRouteToAssignee is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SetRoutePlanningRouteStartAddress(context.Context, *v1.SetRoutePlanningRouteStartAddressRequest) (*v1.SetRoutePlanningRouteStartAddressResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SetRoutePlanningRouteStartAddress is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SetRoutePlanningRouteEndAddress(context.Context, *v1.SetRoutePlanningRouteEndAddressRequest) (*v1.SetRoutePlanningRouteEndAddressResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SetRoutePlanningRouteEndAddress is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) OptimizeRoutePlanningRoute(context.Context, *v1.OptimizeRoutePlanningRouteRequest) (*v1.OptimizeRoutePlanningRouteResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.OptimizeRoutePlanningRoute is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetRoutePlanningOptimizationProgress(context.Context, *v1.GetRoutePlanningOptimizationProgressRequest) (*v1.GetRoutePlanningOptimizationProgressResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetRoutePlanningOptimizationProgress is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteRoutePlanningRoutes(context.Context, *v1.DeleteRoutePlanningRoutesRequest) (*v1.DeleteRoutePlanningRoutesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteRoutePlanningRoutes is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListDriverDddFiles(context.Context, *v1.ListDriverDddFilesRequest) (*v1.ListDriverDddFilesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListDriverDddFiles is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListVehicleDddFiles(context.Context, *v1.ListVehicleDddFilesRequest) (*v1.ListVehicleDddFilesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListVehicleDddFiles is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DownloadDriverDdd(context.Context, *v1.DownloadDriverDddRequest) (*v1.DownloadDriverDddResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DownloadDriverDdd is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DownloadVehicleDdd(context.Context, *v1.DownloadVehicleDddRequest) (*v1.DownloadVehicleDddResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DownloadVehicleDdd is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListTasks(context.Context, *v1.ListTasksRequest) (*v1.ListTasksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListTasks is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) CreateTask(context.Context, *v1.CreateTaskRequest) (*v1.CreateTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.CreateTask is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) EditTask(context.Context, *v1.EditTaskRequest) (*v1.EditTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.EditTask is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteTask(context.Context, *v1.DeleteTaskRequest) (*v1.DeleteTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteTask is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteTaskRoute(context.Context, *v1.DeleteTaskRouteRequest) (*v1.DeleteTaskRouteResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteTaskRoute is not implemented"))
+}
+
 func (UnimplementedMaponApiHandler) ListTellTaleValues(context.Context, *v1.ListTellTaleValuesRequest) (*v1.ListTellTaleValuesResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListTellTaleValues is not implemented"))
 }
 
+func (UnimplementedMaponApiHandler) ListTrackingLinks(context.Context, *v1.ListTrackingLinksRequest) (*v1.ListTrackingLinksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListTrackingLinks is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) CreateTrackingLink(context.Context, *v1.CreateTrackingLinkRequest) (*v1.CreateTrackingLinkResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.CreateTrackingLink is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) EditTrackingLink(context.Context, *v1.EditTrackingLinkRequest) (*v1.EditTrackingLinkResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.EditTrackingLink is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteTrackingLink(context.Context, *v1.DeleteTrackingLinkRequest) (*v1.DeleteTrackingLinkResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteTrackingLink is not implemented"))
+}
+
 func (UnimplementedMaponApiHandler) ListUnits(context.Context, *v1.ListUnitsRequest) (*v1.ListUnitsResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListUnits is not implemented"))
 }
 
+func (UnimplementedMaponApiHandler) EditUnit(context.Context, *v1.EditUnitRequest) (*v1.EditUnitResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.EditUnit is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) InstallUnit(context.Context, *v1.InstallUnitRequest) (*v1.InstallUnitResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.InstallUnit is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) UninstallUnit(context.Context, *v1.UninstallUnitRequest) (*v1.UninstallUnitResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.UninstallUnit is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ChangeUnitDevice(context.Context, *v1.ChangeUnitDeviceRequest) (*v1.ChangeUnitDeviceResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ChangeUnitDevice is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ChangeUnitRelay(context.Context, *v1.ChangeUnitRelayRequest) (*v1.ChangeUnitRelayResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ChangeUnitRelay is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListAvailableUnitIcons(context.Context, *v1.ListAvailableUnitIconsRequest) (*v1.ListAvailableUnitIconsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListAvailableUnitIcons is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListUnitCustomFields(context.Context, *v1.ListUnitCustomFieldsRequest) (*v1.ListUnitCustomFieldsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListUnitCustomFields is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) SaveUnitCustomFieldValues(context.Context, *v1.SaveUnitCustomFieldValuesRequest) (*v1.SaveUnitCustomFieldValuesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SaveUnitCustomFieldValues is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) GetAvailableUnitCommands(context.Context, *v1.GetAvailableUnitCommandsRequest) (*v1.GetAvailableUnitCommandsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetAvailableUnitCommands is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ExecuteUnitCommand(context.Context, *v1.ExecuteUnitCommandRequest) (*v1.ExecuteUnitCommandResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ExecuteUnitCommand is not implemented"))
+}
+
 func (UnimplementedMaponApiHandler) ListUnitGroups(context.Context, *v1.ListUnitGroupsRequest) (*v1.ListUnitGroupsResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListUnitGroups is not implemented"))
 }
@@ -826,6 +5775,26 @@ func (UnimplementedMaponApiHandler) ListUnitsInGroup(context.Context, *v1.ListUn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListUnitsInGroup is not implemented"))
 }
 
+func (UnimplementedMaponApiHandler) SaveUnitGroup(context.Context, *v1.SaveUnitGroupRequest) (*v1.SaveUnitGroupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.SaveUnitGroup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteUnitGroup(context.Context, *v1.DeleteUnitGroupRequest) (*v1.DeleteUnitGroupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteUnitGroup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) AttachUnitToGroup(context.Context, *v1.AttachUnitToGroupRequest) (*v1.AttachUnitToGroupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.AttachUnitToGroup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DetachUnitFromGroup(context.Context, *v1.DetachUnitFromGroupRequest) (*v1.DetachUnitFromGroupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DetachUnitFromGroup is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ClearUnitGroups(context.Context, *v1.ClearUnitGroupsRequest) (*v1.ClearUnitGroupsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ClearUnitGroups is not implemented"))
+}
+
 func (UnimplementedMaponApiHandler) GetCanDataPoint(context.Context, *v1.GetCanDataPointRequest) (*v1.GetCanDataPointResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.GetCanDataPoint is not implemented"))
 }
@@ -873,3 +5842,51 @@ func (UnimplementedMaponApiHandler) ListIgnitions(context.Context, *v1.ListIgnit
 func (UnimplementedMaponApiHandler) ListTemperatures(context.Context, *v1.ListTemperaturesRequest) (*v1.ListTemperaturesResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListTemperatures is not implemented"))
 }
+
+func (UnimplementedMaponApiHandler) ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListUsers is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) CreateUser(context.Context, *v1.CreateUserRequest) (*v1.CreateUserResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.CreateUser is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) UpdateUser(context.Context, *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.UpdateUser is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteUser(context.Context, *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteUser is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ChangeUserPassword(context.Context, *v1.ChangeUserPasswordRequest) (*v1.ChangeUserPasswordResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ChangeUserPassword is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) LinkUserToDriver(context.Context, *v1.LinkUserToDriverRequest) (*v1.LinkUserToDriverResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.LinkUserToDriver is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) UnlinkUserFromDriver(context.Context, *v1.UnlinkUserFromDriverRequest) (*v1.UnlinkUserFromDriverResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.UnlinkUserFromDriver is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) ListVehicleInspections(context.Context, *v1.ListVehicleInspectionsRequest) (*v1.ListVehicleInspectionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.ListVehicleInspections is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) CreateVehiclePinDeviceMappings(context.Context, *v1.CreateVehiclePinDeviceMappingsRequest) (*v1.CreateVehiclePinDeviceMappingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.CreateVehiclePinDeviceMappings is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) DeleteVehiclePinDeviceMappings(context.Context, *v1.DeleteVehiclePinDeviceMappingsRequest) (*v1.DeleteVehiclePinDeviceMappingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.DeleteVehiclePinDeviceMappings is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) CreateDriverPins(context.Context, *v1.CreateDriverPinsRequest) (*v1.CreateDriverPinsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.CreateDriverPins is not implemented"))
+}
+
+func (UnimplementedMaponApiHandler) UpdateDriverPins(context.Context, *v1.UpdateDriverPinsRequest) (*v1.UpdateDriverPinsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.mapon.v1.MaponApi.UpdateDriverPins is not implemented"))
+}
@@ -0,0 +1,1912 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/messaging.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MessagingMedium is the medium used to deliver the messages of a conversation.
+type MessagingMedium int32
+
+const (
+	// Default value, treated as MESSAGING_MEDIUM_GENERAL.
+	MessagingMedium_MESSAGING_MEDIUM_UNSPECIFIED MessagingMedium = 0
+	// Used when the received value does not match any known enum member.
+	MessagingMedium_MESSAGING_MEDIUM_UNRECOGNIZED MessagingMedium = 1
+	// Messages delivered to the Mapon apps and in-cab terminals.
+	MessagingMedium_MESSAGING_MEDIUM_GENERAL MessagingMedium = 2
+	// Messages delivered as SMS.
+	MessagingMedium_MESSAGING_MEDIUM_SMS MessagingMedium = 3
+	// Messages delivered to Garmin navigation devices.
+	MessagingMedium_MESSAGING_MEDIUM_GARMIN MessagingMedium = 4
+)
+
+// Enum value maps for MessagingMedium.
+var (
+	MessagingMedium_name = map[int32]string{
+		0: "MESSAGING_MEDIUM_UNSPECIFIED",
+		1: "MESSAGING_MEDIUM_UNRECOGNIZED",
+		2: "MESSAGING_MEDIUM_GENERAL",
+		3: "MESSAGING_MEDIUM_SMS",
+		4: "MESSAGING_MEDIUM_GARMIN",
+	}
+	MessagingMedium_value = map[string]int32{
+		"MESSAGING_MEDIUM_UNSPECIFIED":  0,
+		"MESSAGING_MEDIUM_UNRECOGNIZED": 1,
+		"MESSAGING_MEDIUM_GENERAL":      2,
+		"MESSAGING_MEDIUM_SMS":          3,
+		"MESSAGING_MEDIUM_GARMIN":       4,
+	}
+)
+
+func (x MessagingMedium) Enum() *MessagingMedium {
+	p := new(MessagingMedium)
+	*p = x
+	return p
+}
+
+func (x MessagingMedium) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessagingMedium) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_messaging_proto_enumTypes[0].Descriptor()
+}
+
+func (MessagingMedium) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_messaging_proto_enumTypes[0]
+}
+
+func (x MessagingMedium) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Type enum defining the kinds of conversations.
+type MessagingConversation_Type int32
+
+const (
+	// Default value, used when the type is missing or not set.
+	MessagingConversation_TYPE_UNSPECIFIED MessagingConversation_Type = 0
+	// Used when the received value does not match any known enum member.
+	MessagingConversation_TYPE_UNRECOGNIZED MessagingConversation_Type = 1
+	// A conversation with a single user or unit.
+	MessagingConversation_TYPE_INDIVIDUAL MessagingConversation_Type = 2
+	// A conversation with several participants.
+	MessagingConversation_TYPE_GROUP MessagingConversation_Type = 3
+)
+
+// Enum value maps for MessagingConversation_Type.
+var (
+	MessagingConversation_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_UNRECOGNIZED",
+		2: "TYPE_INDIVIDUAL",
+		3: "TYPE_GROUP",
+	}
+	MessagingConversation_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":  0,
+		"TYPE_UNRECOGNIZED": 1,
+		"TYPE_INDIVIDUAL":   2,
+		"TYPE_GROUP":        3,
+	}
+)
+
+func (x MessagingConversation_Type) Enum() *MessagingConversation_Type {
+	p := new(MessagingConversation_Type)
+	*p = x
+	return p
+}
+
+func (x MessagingConversation_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessagingConversation_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_messaging_proto_enumTypes[1].Descriptor()
+}
+
+func (MessagingConversation_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_messaging_proto_enumTypes[1]
+}
+
+func (x MessagingConversation_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Status enum defining the delivery states of a message.
+type Message_Status int32
+
+const (
+	// Default value, used when the status is missing or not set.
+	Message_STATUS_UNSPECIFIED Message_Status = 0
+	// Used when the received value does not match any known enum member.
+	Message_STATUS_UNRECOGNIZED Message_Status = 1
+	// The message has been sent.
+	Message_STATUS_SENT Message_Status = 2
+	// The message has been delivered to the recipient.
+	Message_STATUS_DELIVERED Message_Status = 3
+	// The message has been read by the recipient.
+	Message_STATUS_READ Message_Status = 4
+	// The received message has not been read by the user.
+	Message_STATUS_UNREAD Message_Status = 5
+)
+
+// Enum value maps for Message_Status.
+var (
+	Message_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_UNRECOGNIZED",
+		2: "STATUS_SENT",
+		3: "STATUS_DELIVERED",
+		4: "STATUS_READ",
+		5: "STATUS_UNREAD",
+	}
+	Message_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED":  0,
+		"STATUS_UNRECOGNIZED": 1,
+		"STATUS_SENT":         2,
+		"STATUS_DELIVERED":    3,
+		"STATUS_READ":         4,
+		"STATUS_UNREAD":       5,
+	}
+)
+
+func (x Message_Status) Enum() *Message_Status {
+	p := new(Message_Status)
+	*p = x
+	return p
+}
+
+func (x Message_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Message_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_messaging_proto_enumTypes[2].Descriptor()
+}
+
+func (Message_Status) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_messaging_proto_enumTypes[2]
+}
+
+func (x Message_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Direction of a message, derived from its sender.
+type Message_Direction int32
+
+const (
+	// Default value, used when the sender is unknown.
+	Message_DIRECTION_UNSPECIFIED Message_Direction = 0
+	// The message was sent from a unit, e.g. by the driver on an in-cab terminal.
+	Message_DIRECTION_INBOUND Message_Direction = 1
+	// The message was sent by a user, e.g. a dispatcher.
+	Message_DIRECTION_OUTBOUND Message_Direction = 2
+)
+
+// Enum value maps for Message_Direction.
+var (
+	Message_Direction_name = map[int32]string{
+		0: "DIRECTION_UNSPECIFIED",
+		1: "DIRECTION_INBOUND",
+		2: "DIRECTION_OUTBOUND",
+	}
+	Message_Direction_value = map[string]int32{
+		"DIRECTION_UNSPECIFIED": 0,
+		"DIRECTION_INBOUND":     1,
+		"DIRECTION_OUTBOUND":    2,
+	}
+)
+
+func (x Message_Direction) Enum() *Message_Direction {
+	p := new(Message_Direction)
+	*p = x
+	return p
+}
+
+func (x Message_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Message_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_messaging_proto_enumTypes[3].Descriptor()
+}
+
+func (Message_Direction) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_messaging_proto_enumTypes[3]
+}
+
+func (x Message_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// MessagingChannel represents a messaging channel grouping conversations of a user.
+type MessagingChannel struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ChannelId     int64                  `protobuf:"varint,1,opt,name=channel_id,json=channelId"`
+	xxx_hidden_Name          *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_IsAdmin       bool                   `protobuf:"varint,3,opt,name=is_admin,json=isAdmin"`
+	xxx_hidden_IsMuted       bool                   `protobuf:"varint,4,opt,name=is_muted,json=isMuted"`
+	xxx_hidden_LastMessageAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_message_at,json=lastMessageAt"`
+	xxx_hidden_LastMessage   *Message               `protobuf:"bytes,6,opt,name=last_message,json=lastMessage"`
+	xxx_hidden_UnreadTotal   int32                  `protobuf:"varint,7,opt,name=unread_total,json=unreadTotal"`
+	xxx_hidden_UserCount     int32                  `protobuf:"varint,8,opt,name=user_count,json=userCount"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *MessagingChannel) Reset() {
+	*x = MessagingChannel{}
+	mi := &file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessagingChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagingChannel) ProtoMessage() {}
+
+func (x *MessagingChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MessagingChannel) GetChannelId() int64 {
+	if x != nil {
+		return x.xxx_hidden_ChannelId
+	}
+	return 0
+}
+
+func (x *MessagingChannel) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MessagingChannel) GetIsAdmin() bool {
+	if x != nil {
+		return x.xxx_hidden_IsAdmin
+	}
+	return false
+}
+
+func (x *MessagingChannel) GetIsMuted() bool {
+	if x != nil {
+		return x.xxx_hidden_IsMuted
+	}
+	return false
+}
+
+func (x *MessagingChannel) GetLastMessageAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastMessageAt
+	}
+	return nil
+}
+
+func (x *MessagingChannel) GetLastMessage() *Message {
+	if x != nil {
+		return x.xxx_hidden_LastMessage
+	}
+	return nil
+}
+
+func (x *MessagingChannel) GetUnreadTotal() int32 {
+	if x != nil {
+		return x.xxx_hidden_UnreadTotal
+	}
+	return 0
+}
+
+func (x *MessagingChannel) GetUserCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_UserCount
+	}
+	return 0
+}
+
+func (x *MessagingChannel) SetChannelId(v int64) {
+	x.xxx_hidden_ChannelId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *MessagingChannel) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *MessagingChannel) SetIsAdmin(v bool) {
+	x.xxx_hidden_IsAdmin = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *MessagingChannel) SetIsMuted(v bool) {
+	x.xxx_hidden_IsMuted = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *MessagingChannel) SetLastMessageAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastMessageAt = v
+}
+
+func (x *MessagingChannel) SetLastMessage(v *Message) {
+	x.xxx_hidden_LastMessage = v
+}
+
+func (x *MessagingChannel) SetUnreadTotal(v int32) {
+	x.xxx_hidden_UnreadTotal = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *MessagingChannel) SetUserCount(v int32) {
+	x.xxx_hidden_UserCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *MessagingChannel) HasChannelId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *MessagingChannel) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *MessagingChannel) HasIsAdmin() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *MessagingChannel) HasIsMuted() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *MessagingChannel) HasLastMessageAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastMessageAt != nil
+}
+
+func (x *MessagingChannel) HasLastMessage() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastMessage != nil
+}
+
+func (x *MessagingChannel) HasUnreadTotal() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *MessagingChannel) HasUserCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *MessagingChannel) ClearChannelId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ChannelId = 0
+}
+
+func (x *MessagingChannel) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *MessagingChannel) ClearIsAdmin() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_IsAdmin = false
+}
+
+func (x *MessagingChannel) ClearIsMuted() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_IsMuted = false
+}
+
+func (x *MessagingChannel) ClearLastMessageAt() {
+	x.xxx_hidden_LastMessageAt = nil
+}
+
+func (x *MessagingChannel) ClearLastMessage() {
+	x.xxx_hidden_LastMessage = nil
+}
+
+func (x *MessagingChannel) ClearUnreadTotal() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_UnreadTotal = 0
+}
+
+func (x *MessagingChannel) ClearUserCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_UserCount = 0
+}
+
+type MessagingChannel_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the channel.
+	ChannelId *int64
+	// Name of the channel.
+	Name *string
+	// Indicates if the user is an administrator of the channel.
+	IsAdmin *bool
+	// Indicates if the channel is muted for the user.
+	IsMuted *bool
+	// Timestamp of the last message in the channel.
+	LastMessageAt *timestamppb.Timestamp
+	// Last message in the channel, if any.
+	LastMessage *Message
+	// Number of messages not read by the user.
+	UnreadTotal *int32
+	// Number of users in the channel.
+	UserCount *int32
+}
+
+func (b0 MessagingChannel_builder) Build() *MessagingChannel {
+	m0 := &MessagingChannel{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ChannelId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_ChannelId = *b.ChannelId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.IsAdmin != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_IsAdmin = *b.IsAdmin
+	}
+	if b.IsMuted != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_IsMuted = *b.IsMuted
+	}
+	x.xxx_hidden_LastMessageAt = b.LastMessageAt
+	x.xxx_hidden_LastMessage = b.LastMessage
+	if b.UnreadTotal != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_UnreadTotal = *b.UnreadTotal
+	}
+	if b.UserCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_UserCount = *b.UserCount
+	}
+	return m0
+}
+
+// MessagingConversation represents a conversation between users and units.
+type MessagingConversation struct {
+	state                       protoimpl.MessageState     `protogen:"opaque.v1"`
+	xxx_hidden_ConversationId   int64                      `protobuf:"varint,1,opt,name=conversation_id,json=conversationId"`
+	xxx_hidden_Title            *string                    `protobuf:"bytes,2,opt,name=title"`
+	xxx_hidden_Type             MessagingConversation_Type `protobuf:"varint,3,opt,name=type,enum=wayplatform.connect.mapon.v1.MessagingConversation_Type"`
+	xxx_hidden_UnrecognizedType *string                    `protobuf:"bytes,4,opt,name=unrecognized_type,json=unrecognizedType"`
+	xxx_hidden_UnreadTotal      int32                      `protobuf:"varint,5,opt,name=unread_total,json=unreadTotal"`
+	xxx_hidden_RemovedAt        *timestamppb.Timestamp     `protobuf:"bytes,6,opt,name=removed_at,json=removedAt"`
+	xxx_hidden_IsFavorite       bool                       `protobuf:"varint,7,opt,name=is_favorite,json=isFavorite"`
+	xxx_hidden_IsAdmin          bool                       `protobuf:"varint,8,opt,name=is_admin,json=isAdmin"`
+	xxx_hidden_JoinedAt         *timestamppb.Timestamp     `protobuf:"bytes,9,opt,name=joined_at,json=joinedAt"`
+	xxx_hidden_LastMessageAt    *timestamppb.Timestamp     `protobuf:"bytes,10,opt,name=last_message_at,json=lastMessageAt"`
+	xxx_hidden_LastMessage      *Message                   `protobuf:"bytes,11,opt,name=last_message,json=lastMessage"`
+	xxx_hidden_ConversationWith *MessageParticipant        `protobuf:"bytes,12,opt,name=conversation_with,json=conversationWith"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *MessagingConversation) Reset() {
+	*x = MessagingConversation{}
+	mi := &file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessagingConversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagingConversation) ProtoMessage() {}
+
+func (x *MessagingConversation) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MessagingConversation) GetConversationId() int64 {
+	if x != nil {
+		return x.xxx_hidden_ConversationId
+	}
+	return 0
+}
+
+func (x *MessagingConversation) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MessagingConversation) GetType() MessagingConversation_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return MessagingConversation_TYPE_UNSPECIFIED
+}
+
+func (x *MessagingConversation) GetUnrecognizedType() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedType != nil {
+			return *x.xxx_hidden_UnrecognizedType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MessagingConversation) GetUnreadTotal() int32 {
+	if x != nil {
+		return x.xxx_hidden_UnreadTotal
+	}
+	return 0
+}
+
+func (x *MessagingConversation) GetRemovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_RemovedAt
+	}
+	return nil
+}
+
+func (x *MessagingConversation) GetIsFavorite() bool {
+	if x != nil {
+		return x.xxx_hidden_IsFavorite
+	}
+	return false
+}
+
+func (x *MessagingConversation) GetIsAdmin() bool {
+	if x != nil {
+		return x.xxx_hidden_IsAdmin
+	}
+	return false
+}
+
+func (x *MessagingConversation) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_JoinedAt
+	}
+	return nil
+}
+
+func (x *MessagingConversation) GetLastMessageAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastMessageAt
+	}
+	return nil
+}
+
+func (x *MessagingConversation) GetLastMessage() *Message {
+	if x != nil {
+		return x.xxx_hidden_LastMessage
+	}
+	return nil
+}
+
+func (x *MessagingConversation) GetConversationWith() *MessageParticipant {
+	if x != nil {
+		return x.xxx_hidden_ConversationWith
+	}
+	return nil
+}
+
+func (x *MessagingConversation) SetConversationId(v int64) {
+	x.xxx_hidden_ConversationId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 12)
+}
+
+func (x *MessagingConversation) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 12)
+}
+
+func (x *MessagingConversation) SetType(v MessagingConversation_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 12)
+}
+
+func (x *MessagingConversation) SetUnrecognizedType(v string) {
+	x.xxx_hidden_UnrecognizedType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 12)
+}
+
+func (x *MessagingConversation) SetUnreadTotal(v int32) {
+	x.xxx_hidden_UnreadTotal = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 12)
+}
+
+func (x *MessagingConversation) SetRemovedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_RemovedAt = v
+}
+
+func (x *MessagingConversation) SetIsFavorite(v bool) {
+	x.xxx_hidden_IsFavorite = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 12)
+}
+
+func (x *MessagingConversation) SetIsAdmin(v bool) {
+	x.xxx_hidden_IsAdmin = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 12)
+}
+
+func (x *MessagingConversation) SetJoinedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_JoinedAt = v
+}
+
+func (x *MessagingConversation) SetLastMessageAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastMessageAt = v
+}
+
+func (x *MessagingConversation) SetLastMessage(v *Message) {
+	x.xxx_hidden_LastMessage = v
+}
+
+func (x *MessagingConversation) SetConversationWith(v *MessageParticipant) {
+	x.xxx_hidden_ConversationWith = v
+}
+
+func (x *MessagingConversation) HasConversationId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *MessagingConversation) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *MessagingConversation) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *MessagingConversation) HasUnrecognizedType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *MessagingConversation) HasUnreadTotal() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *MessagingConversation) HasRemovedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RemovedAt != nil
+}
+
+func (x *MessagingConversation) HasIsFavorite() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *MessagingConversation) HasIsAdmin() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *MessagingConversation) HasJoinedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_JoinedAt != nil
+}
+
+func (x *MessagingConversation) HasLastMessageAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastMessageAt != nil
+}
+
+func (x *MessagingConversation) HasLastMessage() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastMessage != nil
+}
+
+func (x *MessagingConversation) HasConversationWith() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ConversationWith != nil
+}
+
+func (x *MessagingConversation) ClearConversationId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ConversationId = 0
+}
+
+func (x *MessagingConversation) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *MessagingConversation) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Type = MessagingConversation_TYPE_UNSPECIFIED
+}
+
+func (x *MessagingConversation) ClearUnrecognizedType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_UnrecognizedType = nil
+}
+
+func (x *MessagingConversation) ClearUnreadTotal() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_UnreadTotal = 0
+}
+
+func (x *MessagingConversation) ClearRemovedAt() {
+	x.xxx_hidden_RemovedAt = nil
+}
+
+func (x *MessagingConversation) ClearIsFavorite() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_IsFavorite = false
+}
+
+func (x *MessagingConversation) ClearIsAdmin() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_IsAdmin = false
+}
+
+func (x *MessagingConversation) ClearJoinedAt() {
+	x.xxx_hidden_JoinedAt = nil
+}
+
+func (x *MessagingConversation) ClearLastMessageAt() {
+	x.xxx_hidden_LastMessageAt = nil
+}
+
+func (x *MessagingConversation) ClearLastMessage() {
+	x.xxx_hidden_LastMessage = nil
+}
+
+func (x *MessagingConversation) ClearConversationWith() {
+	x.xxx_hidden_ConversationWith = nil
+}
+
+type MessagingConversation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the conversation.
+	ConversationId *int64
+	// Title of the conversation.
+	Title *string
+	// Type of the conversation.
+	Type *MessagingConversation_Type
+	// The raw string value of the type if it is not one of the known Type enum values.
+	UnrecognizedType *string
+	// Number of messages not read by the user.
+	UnreadTotal *int32
+	// Timestamp when the user was removed from the conversation, if removed.
+	RemovedAt *timestamppb.Timestamp
+	// Indicates if the conversation is marked as favorite by the user.
+	IsFavorite *bool
+	// Indicates if the user is an administrator of the conversation.
+	IsAdmin *bool
+	// Timestamp when the user joined the conversation.
+	JoinedAt *timestamppb.Timestamp
+	// Timestamp of the last message in the conversation.
+	LastMessageAt *timestamppb.Timestamp
+	// Last message in the conversation, if any.
+	LastMessage *Message
+	// The other participant of an individual conversation. Not set for group conversations.
+	ConversationWith *MessageParticipant
+}
+
+func (b0 MessagingConversation_builder) Build() *MessagingConversation {
+	m0 := &MessagingConversation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ConversationId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 12)
+		x.xxx_hidden_ConversationId = *b.ConversationId
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 12)
+		x.xxx_hidden_Title = b.Title
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 12)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.UnrecognizedType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 12)
+		x.xxx_hidden_UnrecognizedType = b.UnrecognizedType
+	}
+	if b.UnreadTotal != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 12)
+		x.xxx_hidden_UnreadTotal = *b.UnreadTotal
+	}
+	x.xxx_hidden_RemovedAt = b.RemovedAt
+	if b.IsFavorite != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 12)
+		x.xxx_hidden_IsFavorite = *b.IsFavorite
+	}
+	if b.IsAdmin != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 12)
+		x.xxx_hidden_IsAdmin = *b.IsAdmin
+	}
+	x.xxx_hidden_JoinedAt = b.JoinedAt
+	x.xxx_hidden_LastMessageAt = b.LastMessageAt
+	x.xxx_hidden_LastMessage = b.LastMessage
+	x.xxx_hidden_ConversationWith = b.ConversationWith
+	return m0
+}
+
+// Message represents a single message in a conversation.
+type Message struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MessageId          int64                  `protobuf:"varint,1,opt,name=message_id,json=messageId"`
+	xxx_hidden_Status             Message_Status         `protobuf:"varint,2,opt,name=status,enum=wayplatform.connect.mapon.v1.Message_Status"`
+	xxx_hidden_UnrecognizedStatus *string                `protobuf:"bytes,3,opt,name=unrecognized_status,json=unrecognizedStatus"`
+	xxx_hidden_CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Text               *string                `protobuf:"bytes,5,opt,name=text"`
+	xxx_hidden_Location           *Location              `protobuf:"bytes,6,opt,name=location"`
+	xxx_hidden_Attachments        *[]*MessageAttachment  `protobuf:"bytes,7,rep,name=attachments"`
+	xxx_hidden_Sender             *MessageParticipant    `protobuf:"bytes,8,opt,name=sender"`
+	xxx_hidden_Direction          Message_Direction      `protobuf:"varint,9,opt,name=direction,enum=wayplatform.connect.mapon.v1.Message_Direction"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Message) GetMessageId() int64 {
+	if x != nil {
+		return x.xxx_hidden_MessageId
+	}
+	return 0
+}
+
+func (x *Message) GetStatus() Message_Status {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Status
+		}
+	}
+	return Message_STATUS_UNSPECIFIED
+}
+
+func (x *Message) GetUnrecognizedStatus() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedStatus != nil {
+			return *x.xxx_hidden_UnrecognizedStatus
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Message) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *Message) GetText() string {
+	if x != nil {
+		if x.xxx_hidden_Text != nil {
+			return *x.xxx_hidden_Text
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Message) GetLocation() *Location {
+	if x != nil {
+		return x.xxx_hidden_Location
+	}
+	return nil
+}
+
+func (x *Message) GetAttachments() []*MessageAttachment {
+	if x != nil {
+		if x.xxx_hidden_Attachments != nil {
+			return *x.xxx_hidden_Attachments
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetSender() *MessageParticipant {
+	if x != nil {
+		return x.xxx_hidden_Sender
+	}
+	return nil
+}
+
+func (x *Message) GetDirection() Message_Direction {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 8) {
+			return x.xxx_hidden_Direction
+		}
+	}
+	return Message_DIRECTION_UNSPECIFIED
+}
+
+func (x *Message) SetMessageId(v int64) {
+	x.xxx_hidden_MessageId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
+}
+
+func (x *Message) SetStatus(v Message_Status) {
+	x.xxx_hidden_Status = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
+}
+
+func (x *Message) SetUnrecognizedStatus(v string) {
+	x.xxx_hidden_UnrecognizedStatus = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
+}
+
+func (x *Message) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Message) SetText(v string) {
+	x.xxx_hidden_Text = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+}
+
+func (x *Message) SetLocation(v *Location) {
+	x.xxx_hidden_Location = v
+}
+
+func (x *Message) SetAttachments(v []*MessageAttachment) {
+	x.xxx_hidden_Attachments = &v
+}
+
+func (x *Message) SetSender(v *MessageParticipant) {
+	x.xxx_hidden_Sender = v
+}
+
+func (x *Message) SetDirection(v Message_Direction) {
+	x.xxx_hidden_Direction = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+}
+
+func (x *Message) HasMessageId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Message) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Message) HasUnrecognizedStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Message) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Message) HasText() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Message) HasLocation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Location != nil
+}
+
+func (x *Message) HasSender() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Sender != nil
+}
+
+func (x *Message) HasDirection() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *Message) ClearMessageId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_MessageId = 0
+}
+
+func (x *Message) ClearStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Status = Message_STATUS_UNSPECIFIED
+}
+
+func (x *Message) ClearUnrecognizedStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_UnrecognizedStatus = nil
+}
+
+func (x *Message) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Message) ClearText() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Text = nil
+}
+
+func (x *Message) ClearLocation() {
+	x.xxx_hidden_Location = nil
+}
+
+func (x *Message) ClearSender() {
+	x.xxx_hidden_Sender = nil
+}
+
+func (x *Message) ClearDirection() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Direction = Message_DIRECTION_UNSPECIFIED
+}
+
+type Message_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the message.
+	MessageId *int64
+	// Delivery status of the message.
+	Status *Message_Status
+	// The raw string value of the status if it is not one of the known Status enum values.
+	UnrecognizedStatus *string
+	// Timestamp when the message was created.
+	CreatedAt *timestamppb.Timestamp
+	// Text of the message, if any.
+	Text *string
+	// Location shared in the message, if any.
+	Location *Location
+	// Files attached to the message.
+	Attachments []*MessageAttachment
+	// Author of the message.
+	Sender *MessageParticipant
+	// Direction of the message.
+	Direction *Message_Direction
+}
+
+func (b0 Message_builder) Build() *Message {
+	m0 := &Message{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.MessageId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		x.xxx_hidden_MessageId = *b.MessageId
+	}
+	if b.Status != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
+		x.xxx_hidden_Status = *b.Status
+	}
+	if b.UnrecognizedStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
+		x.xxx_hidden_UnrecognizedStatus = b.UnrecognizedStatus
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Text != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_Text = b.Text
+	}
+	x.xxx_hidden_Location = b.Location
+	x.xxx_hidden_Attachments = &b.Attachments
+	x.xxx_hidden_Sender = b.Sender
+	if b.Direction != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_Direction = *b.Direction
+	}
+	return m0
+}
+
+// MessageParticipant represents the author or recipient of messages, either a user or a unit.
+type MessageParticipant struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ParticipantId int64                  `protobuf:"varint,1,opt,name=participant_id,json=participantId"`
+	xxx_hidden_UnitId        int64                  `protobuf:"varint,2,opt,name=unit_id,json=unitId"`
+	xxx_hidden_UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId"`
+	xxx_hidden_Title         *string                `protobuf:"bytes,4,opt,name=title"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *MessageParticipant) Reset() {
+	*x = MessageParticipant{}
+	mi := &file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageParticipant) ProtoMessage() {}
+
+func (x *MessageParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MessageParticipant) GetParticipantId() int64 {
+	if x != nil {
+		return x.xxx_hidden_ParticipantId
+	}
+	return 0
+}
+
+func (x *MessageParticipant) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *MessageParticipant) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *MessageParticipant) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MessageParticipant) SetParticipantId(v int64) {
+	x.xxx_hidden_ParticipantId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *MessageParticipant) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *MessageParticipant) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *MessageParticipant) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *MessageParticipant) HasParticipantId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *MessageParticipant) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *MessageParticipant) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *MessageParticipant) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *MessageParticipant) ClearParticipantId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ParticipantId = 0
+}
+
+func (x *MessageParticipant) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *MessageParticipant) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_UserId = 0
+}
+
+func (x *MessageParticipant) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Title = nil
+}
+
+type MessageParticipant_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the participant.
+	ParticipantId *int64
+	// Identifier of the unit, if the participant is a unit.
+	UnitId *int64
+	// Identifier of the user, if the participant is a user.
+	UserId *int64
+	// Display name of the participant.
+	Title *string
+}
+
+func (b0 MessageParticipant_builder) Build() *MessageParticipant {
+	m0 := &MessageParticipant{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ParticipantId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_ParticipantId = *b.ParticipantId
+	}
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_UserId = *b.UserId
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Title = b.Title
+	}
+	return m0
+}
+
+// MessageAttachment represents a file attached to a message.
+type MessageAttachment struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_AttachmentId int64                  `protobuf:"varint,1,opt,name=attachment_id,json=attachmentId"`
+	xxx_hidden_Name         *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_MimeType     *string                `protobuf:"bytes,3,opt,name=mime_type,json=mimeType"`
+	xxx_hidden_Url          *string                `protobuf:"bytes,4,opt,name=url"`
+	xxx_hidden_ThumbnailUrl *string                `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl"`
+	xxx_hidden_SizeBytes    int64                  `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *MessageAttachment) Reset() {
+	*x = MessageAttachment{}
+	mi := &file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageAttachment) ProtoMessage() {}
+
+func (x *MessageAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MessageAttachment) GetAttachmentId() int64 {
+	if x != nil {
+		return x.xxx_hidden_AttachmentId
+	}
+	return 0
+}
+
+func (x *MessageAttachment) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MessageAttachment) GetMimeType() string {
+	if x != nil {
+		if x.xxx_hidden_MimeType != nil {
+			return *x.xxx_hidden_MimeType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MessageAttachment) GetUrl() string {
+	if x != nil {
+		if x.xxx_hidden_Url != nil {
+			return *x.xxx_hidden_Url
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MessageAttachment) GetThumbnailUrl() string {
+	if x != nil {
+		if x.xxx_hidden_ThumbnailUrl != nil {
+			return *x.xxx_hidden_ThumbnailUrl
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MessageAttachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.xxx_hidden_SizeBytes
+	}
+	return 0
+}
+
+func (x *MessageAttachment) SetAttachmentId(v int64) {
+	x.xxx_hidden_AttachmentId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *MessageAttachment) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *MessageAttachment) SetMimeType(v string) {
+	x.xxx_hidden_MimeType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *MessageAttachment) SetUrl(v string) {
+	x.xxx_hidden_Url = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *MessageAttachment) SetThumbnailUrl(v string) {
+	x.xxx_hidden_ThumbnailUrl = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *MessageAttachment) SetSizeBytes(v int64) {
+	x.xxx_hidden_SizeBytes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *MessageAttachment) HasAttachmentId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *MessageAttachment) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *MessageAttachment) HasMimeType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *MessageAttachment) HasUrl() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *MessageAttachment) HasThumbnailUrl() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *MessageAttachment) HasSizeBytes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *MessageAttachment) ClearAttachmentId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_AttachmentId = 0
+}
+
+func (x *MessageAttachment) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *MessageAttachment) ClearMimeType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_MimeType = nil
+}
+
+func (x *MessageAttachment) ClearUrl() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Url = nil
+}
+
+func (x *MessageAttachment) ClearThumbnailUrl() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_ThumbnailUrl = nil
+}
+
+func (x *MessageAttachment) ClearSizeBytes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_SizeBytes = 0
+}
+
+type MessageAttachment_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the attachment.
+	AttachmentId *int64
+	// File name of the attachment.
+	Name *string
+	// MIME type of the attachment.
+	MimeType *string
+	// URL to download the attachment.
+	Url *string
+	// URL to download a thumbnail of the attachment.
+	ThumbnailUrl *string
+	// Size of the attachment in bytes.
+	SizeBytes *int64
+}
+
+func (b0 MessageAttachment_builder) Build() *MessageAttachment {
+	m0 := &MessageAttachment{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.AttachmentId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_AttachmentId = *b.AttachmentId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.MimeType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_MimeType = b.MimeType
+	}
+	if b.Url != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_Url = b.Url
+	}
+	if b.ThumbnailUrl != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_ThumbnailUrl = b.ThumbnailUrl
+	}
+	if b.SizeBytes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_SizeBytes = *b.SizeBytes
+	}
+	return m0
+}
+
+// MessageAttachmentUpload represents a file to attach to a sent message.
+type MessageAttachmentUpload struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_Content     []byte                 `protobuf:"bytes,2,opt,name=content"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MessageAttachmentUpload) Reset() {
+	*x = MessageAttachmentUpload{}
+	mi := &file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageAttachmentUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageAttachmentUpload) ProtoMessage() {}
+
+func (x *MessageAttachmentUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MessageAttachmentUpload) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MessageAttachmentUpload) GetContent() []byte {
+	if x != nil {
+		return x.xxx_hidden_Content
+	}
+	return nil
+}
+
+func (x *MessageAttachmentUpload) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *MessageAttachmentUpload) SetContent(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Content = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *MessageAttachmentUpload) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *MessageAttachmentUpload) HasContent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *MessageAttachmentUpload) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *MessageAttachmentUpload) ClearContent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Content = nil
+}
+
+type MessageAttachmentUpload_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// File name of the attachment.
+	Name *string
+	// Content of the file.
+	Content []byte
+}
+
+func (b0 MessageAttachmentUpload_builder) Build() *MessageAttachmentUpload {
+	m0 := &MessageAttachmentUpload{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Content != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Content = b.Content
+	}
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_messaging_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_messaging_proto_rawDesc = "" +
+	"\n" +
+	",wayplatform/connect/mapon/v1/messaging.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)wayplatform/connect/mapon/v1/common.proto\"\xcb\x02\n" +
+	"\x10MessagingChannel\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\x03R\tchannelId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bis_admin\x18\x03 \x01(\bR\aisAdmin\x12\x19\n" +
+	"\bis_muted\x18\x04 \x01(\bR\aisMuted\x12B\n" +
+	"\x0flast_message_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlastMessageAt\x12H\n" +
+	"\flast_message\x18\x06 \x01(\v2%.wayplatform.connect.mapon.v1.MessageR\vlastMessage\x12!\n" +
+	"\funread_total\x18\a \x01(\x05R\vunreadTotal\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\b \x01(\x05R\tuserCount\"\xeb\x05\n" +
+	"\x15MessagingConversation\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12L\n" +
+	"\x04type\x18\x03 \x01(\x0e28.wayplatform.connect.mapon.v1.MessagingConversation.TypeR\x04type\x12+\n" +
+	"\x11unrecognized_type\x18\x04 \x01(\tR\x10unrecognizedType\x12!\n" +
+	"\funread_total\x18\x05 \x01(\x05R\vunreadTotal\x129\n" +
+	"\n" +
+	"removed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tremovedAt\x12\x1f\n" +
+	"\vis_favorite\x18\a \x01(\bR\n" +
+	"isFavorite\x12\x19\n" +
+	"\bis_admin\x18\b \x01(\bR\aisAdmin\x127\n" +
+	"\tjoined_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\x12B\n" +
+	"\x0flast_message_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rlastMessageAt\x12H\n" +
+	"\flast_message\x18\v \x01(\v2%.wayplatform.connect.mapon.v1.MessageR\vlastMessage\x12]\n" +
+	"\x11conversation_with\x18\f \x01(\v20.wayplatform.connect.mapon.v1.MessageParticipantR\x10conversationWith\"X\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TYPE_UNRECOGNIZED\x10\x01\x12\x13\n" +
+	"\x0fTYPE_INDIVIDUAL\x10\x02\x12\x0e\n" +
+	"\n" +
+	"TYPE_GROUP\x10\x03\"\xfc\x05\n" +
+	"\aMessage\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\x12D\n" +
+	"\x06status\x18\x02 \x01(\x0e2,.wayplatform.connect.mapon.v1.Message.StatusR\x06status\x12/\n" +
+	"\x13unrecognized_status\x18\x03 \x01(\tR\x12unrecognizedStatus\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12B\n" +
+	"\blocation\x18\x06 \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\blocation\x12Q\n" +
+	"\vattachments\x18\a \x03(\v2/.wayplatform.connect.mapon.v1.MessageAttachmentR\vattachments\x12H\n" +
+	"\x06sender\x18\b \x01(\v20.wayplatform.connect.mapon.v1.MessageParticipantR\x06sender\x12M\n" +
+	"\tdirection\x18\t \x01(\x0e2/.wayplatform.connect.mapon.v1.Message.DirectionR\tdirection\"\x84\x01\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13STATUS_UNRECOGNIZED\x10\x01\x12\x0f\n" +
+	"\vSTATUS_SENT\x10\x02\x12\x14\n" +
+	"\x10STATUS_DELIVERED\x10\x03\x12\x0f\n" +
+	"\vSTATUS_READ\x10\x04\x12\x11\n" +
+	"\rSTATUS_UNREAD\x10\x05\"U\n" +
+	"\tDirection\x12\x19\n" +
+	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11DIRECTION_INBOUND\x10\x01\x12\x16\n" +
+	"\x12DIRECTION_OUTBOUND\x10\x02\"\x83\x01\n" +
+	"\x12MessageParticipant\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\x03R\rparticipantId\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\x03R\x06unitId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\"\xbf\x01\n" +
+	"\x11MessageAttachment\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\x03R\fattachmentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12#\n" +
+	"\rthumbnail_url\x18\x05 \x01(\tR\fthumbnailUrl\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x03R\tsizeBytes\"G\n" +
+	"\x17MessageAttachmentUpload\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent*\xab\x01\n" +
+	"\x0fMessagingMedium\x12 \n" +
+	"\x1cMESSAGING_MEDIUM_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dMESSAGING_MEDIUM_UNRECOGNIZED\x10\x01\x12\x1c\n" +
+	"\x18MESSAGING_MEDIUM_GENERAL\x10\x02\x12\x18\n" +
+	"\x14MESSAGING_MEDIUM_SMS\x10\x03\x12\x1b\n" +
+	"\x17MESSAGING_MEDIUM_GARMIN\x10\x04B\x99\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x0eMessagingProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_messaging_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_wayplatform_connect_mapon_v1_messaging_proto_goTypes = []any{
+	(MessagingMedium)(0),            // 0: wayplatform.connect.mapon.v1.MessagingMedium
+	(MessagingConversation_Type)(0), // 1: wayplatform.connect.mapon.v1.MessagingConversation.Type
+	(Message_Status)(0),             // 2: wayplatform.connect.mapon.v1.Message.Status
+	(Message_Direction)(0),          // 3: wayplatform.connect.mapon.v1.Message.Direction
+	(*MessagingChannel)(nil),        // 4: wayplatform.connect.mapon.v1.MessagingChannel
+	(*MessagingConversation)(nil),   // 5: wayplatform.connect.mapon.v1.MessagingConversation
+	(*Message)(nil),                 // 6: wayplatform.connect.mapon.v1.Message
+	(*MessageParticipant)(nil),      // 7: wayplatform.connect.mapon.v1.MessageParticipant
+	(*MessageAttachment)(nil),       // 8: wayplatform.connect.mapon.v1.MessageAttachment
+	(*MessageAttachmentUpload)(nil), // 9: wayplatform.connect.mapon.v1.MessageAttachmentUpload
+	(*timestamppb.Timestamp)(nil),   // 10: google.protobuf.Timestamp
+	(*Location)(nil),                // 11: wayplatform.connect.mapon.v1.Location
+}
+var file_wayplatform_connect_mapon_v1_messaging_proto_depIdxs = []int32{
+	10, // 0: wayplatform.connect.mapon.v1.MessagingChannel.last_message_at:type_name -> google.protobuf.Timestamp
+	6,  // 1: wayplatform.connect.mapon.v1.MessagingChannel.last_message:type_name -> wayplatform.connect.mapon.v1.Message
+	1,  // 2: wayplatform.connect.mapon.v1.MessagingConversation.type:type_name -> wayplatform.connect.mapon.v1.MessagingConversation.Type
+	10, // 3: wayplatform.connect.mapon.v1.MessagingConversation.removed_at:type_name -> google.protobuf.Timestamp
+	10, // 4: wayplatform.connect.mapon.v1.MessagingConversation.joined_at:type_name -> google.protobuf.Timestamp
+	10, // 5: wayplatform.connect.mapon.v1.MessagingConversation.last_message_at:type_name -> google.protobuf.Timestamp
+	6,  // 6: wayplatform.connect.mapon.v1.MessagingConversation.last_message:type_name -> wayplatform.connect.mapon.v1.Message
+	7,  // 7: wayplatform.connect.mapon.v1.MessagingConversation.conversation_with:type_name -> wayplatform.connect.mapon.v1.MessageParticipant
+	2,  // 8: wayplatform.connect.mapon.v1.Message.status:type_name -> wayplatform.connect.mapon.v1.Message.Status
+	10, // 9: wayplatform.connect.mapon.v1.Message.created_at:type_name -> google.protobuf.Timestamp
+	11, // 10: wayplatform.connect.mapon.v1.Message.location:type_name -> wayplatform.connect.mapon.v1.Location
+	8,  // 11: wayplatform.connect.mapon.v1.Message.attachments:type_name -> wayplatform.connect.mapon.v1.MessageAttachment
+	7,  // 12: wayplatform.connect.mapon.v1.Message.sender:type_name -> wayplatform.connect.mapon.v1.MessageParticipant
+	3,  // 13: wayplatform.connect.mapon.v1.Message.direction:type_name -> wayplatform.connect.mapon.v1.Message.Direction
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_messaging_proto_init() }
+func file_wayplatform_connect_mapon_v1_messaging_proto_init() {
+	if File_wayplatform_connect_mapon_v1_messaging_proto != nil {
+		return
+	}
+	file_wayplatform_connect_mapon_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_messaging_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_messaging_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_messaging_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_messaging_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_messaging_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_messaging_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_messaging_proto = out.File
+	file_wayplatform_connect_mapon_v1_messaging_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_messaging_proto_depIdxs = nil
+}
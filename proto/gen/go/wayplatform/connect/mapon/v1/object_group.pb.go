@@ -0,0 +1,278 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/object_group.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ObjectGroup represents a group of geofence objects.
+type ObjectGroup struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GroupId     int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_UserId      int64                  `protobuf:"varint,3,opt,name=user_id,json=userId"`
+	xxx_hidden_IsPrivate   bool                   `protobuf:"varint,4,opt,name=is_private,json=isPrivate"`
+	xxx_hidden_ColorHex    *string                `protobuf:"bytes,5,opt,name=color_hex,json=colorHex"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ObjectGroup) Reset() {
+	*x = ObjectGroup{}
+	mi := &file_wayplatform_connect_mapon_v1_object_group_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectGroup) ProtoMessage() {}
+
+func (x *ObjectGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_object_group_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ObjectGroup) GetGroupId() int64 {
+	if x != nil {
+		return x.xxx_hidden_GroupId
+	}
+	return 0
+}
+
+func (x *ObjectGroup) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ObjectGroup) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *ObjectGroup) GetIsPrivate() bool {
+	if x != nil {
+		return x.xxx_hidden_IsPrivate
+	}
+	return false
+}
+
+func (x *ObjectGroup) GetColorHex() string {
+	if x != nil {
+		if x.xxx_hidden_ColorHex != nil {
+			return *x.xxx_hidden_ColorHex
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ObjectGroup) SetGroupId(v int64) {
+	x.xxx_hidden_GroupId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *ObjectGroup) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *ObjectGroup) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *ObjectGroup) SetIsPrivate(v bool) {
+	x.xxx_hidden_IsPrivate = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *ObjectGroup) SetColorHex(v string) {
+	x.xxx_hidden_ColorHex = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *ObjectGroup) HasGroupId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ObjectGroup) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ObjectGroup) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ObjectGroup) HasIsPrivate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ObjectGroup) HasColorHex() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ObjectGroup) ClearGroupId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GroupId = 0
+}
+
+func (x *ObjectGroup) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *ObjectGroup) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_UserId = 0
+}
+
+func (x *ObjectGroup) ClearIsPrivate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_IsPrivate = false
+}
+
+func (x *ObjectGroup) ClearColorHex() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_ColorHex = nil
+}
+
+type ObjectGroup_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the group.
+	GroupId *int64
+	// Name of the group.
+	Name *string
+	// Identifier of the user who owns this group (if private).
+	UserId *int64
+	// Indicates if the group is private.
+	IsPrivate *bool
+	// Hex color code for displaying the objects of the group (e.g., "FF0000").
+	ColorHex *string
+}
+
+func (b0 ObjectGroup_builder) Build() *ObjectGroup {
+	m0 := &ObjectGroup{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GroupId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_GroupId = *b.GroupId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_UserId = *b.UserId
+	}
+	if b.IsPrivate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_IsPrivate = *b.IsPrivate
+	}
+	if b.ColorHex != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_ColorHex = b.ColorHex
+	}
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_object_group_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_object_group_proto_rawDesc = "" +
+	"\n" +
+	"/wayplatform/connect/mapon/v1/object_group.proto\x12\x1cwayplatform.connect.mapon.v1\"\x91\x01\n" +
+	"\vObjectGroup\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x04 \x01(\bR\tisPrivate\x12\x1b\n" +
+	"\tcolor_hex\x18\x05 \x01(\tR\bcolorHexB\x9b\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x10ObjectGroupProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_object_group_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_wayplatform_connect_mapon_v1_object_group_proto_goTypes = []any{
+	(*ObjectGroup)(nil), // 0: wayplatform.connect.mapon.v1.ObjectGroup
+}
+var file_wayplatform_connect_mapon_v1_object_group_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_object_group_proto_init() }
+func file_wayplatform_connect_mapon_v1_object_group_proto_init() {
+	if File_wayplatform_connect_mapon_v1_object_group_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_object_group_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_object_group_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_object_group_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_object_group_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_object_group_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_object_group_proto = out.File
+	file_wayplatform_connect_mapon_v1_object_group_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_object_group_proto_depIdxs = nil
+}
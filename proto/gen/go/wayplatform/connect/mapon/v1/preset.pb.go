@@ -0,0 +1,855 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/preset.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Permission represents a permission that can be granted by a preset.
+type Permission int32
+
+const (
+	// Default value, used when the permission is missing or not set.
+	Permission_PERMISSION_UNSPECIFIED Permission = 0
+	// Used when the received value does not match any known enum member.
+	Permission_PERMISSION_UNRECOGNIZED Permission = 1
+	// Allows editing objects.
+	Permission_PERMISSION_CAN_EDIT_OBJECT Permission = 2
+	// Limits access to the objects selected in the preset.
+	Permission_PERMISSION_HAS_LIMITED_OBJECTS_ACCESS Permission = 3
+	// Allows editing objects created by other users.
+	Permission_PERMISSION_CAN_EDIT_OBJ_OTHERS Permission = 4
+	// Allows creating and viewing tracking links.
+	Permission_PERMISSION_CAN_ACCESS_TRACKING_LINK Permission = 5
+	// Allows controlling reefer units.
+	Permission_PERMISSION_HAS_REEFER_CONTROL Permission = 6
+	// Allows SMS features.
+	Permission_PERMISSION_DO_ALLOW_SMS_FEATURES Permission = 7
+	// Allows private unit groups.
+	Permission_PERMISSION_HAS_PRIVATE_CAR_GROUPS Permission = 8
+	// Allows sending commands to devices.
+	Permission_PERMISSION_CAN_CONTROL_DEVICE Permission = 9
+	// Allows exporting reefer reports.
+	Permission_PERMISSION_CAN_EXPORT_REEFER_REPORT Permission = 10
+	// Mode of communication with drivers (e.g. "default").
+	Permission_PERMISSION_COMMUNICATION_WITH_DRIVER Permission = 11
+)
+
+// Enum value maps for Permission.
+var (
+	Permission_name = map[int32]string{
+		0:  "PERMISSION_UNSPECIFIED",
+		1:  "PERMISSION_UNRECOGNIZED",
+		2:  "PERMISSION_CAN_EDIT_OBJECT",
+		3:  "PERMISSION_HAS_LIMITED_OBJECTS_ACCESS",
+		4:  "PERMISSION_CAN_EDIT_OBJ_OTHERS",
+		5:  "PERMISSION_CAN_ACCESS_TRACKING_LINK",
+		6:  "PERMISSION_HAS_REEFER_CONTROL",
+		7:  "PERMISSION_DO_ALLOW_SMS_FEATURES",
+		8:  "PERMISSION_HAS_PRIVATE_CAR_GROUPS",
+		9:  "PERMISSION_CAN_CONTROL_DEVICE",
+		10: "PERMISSION_CAN_EXPORT_REEFER_REPORT",
+		11: "PERMISSION_COMMUNICATION_WITH_DRIVER",
+	}
+	Permission_value = map[string]int32{
+		"PERMISSION_UNSPECIFIED":                0,
+		"PERMISSION_UNRECOGNIZED":               1,
+		"PERMISSION_CAN_EDIT_OBJECT":            2,
+		"PERMISSION_HAS_LIMITED_OBJECTS_ACCESS": 3,
+		"PERMISSION_CAN_EDIT_OBJ_OTHERS":        4,
+		"PERMISSION_CAN_ACCESS_TRACKING_LINK":   5,
+		"PERMISSION_HAS_REEFER_CONTROL":         6,
+		"PERMISSION_DO_ALLOW_SMS_FEATURES":      7,
+		"PERMISSION_HAS_PRIVATE_CAR_GROUPS":     8,
+		"PERMISSION_CAN_CONTROL_DEVICE":         9,
+		"PERMISSION_CAN_EXPORT_REEFER_REPORT":   10,
+		"PERMISSION_COMMUNICATION_WITH_DRIVER":  11,
+	}
+)
+
+func (x Permission) Enum() *Permission {
+	p := new(Permission)
+	*p = x
+	return p
+}
+
+func (x Permission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_preset_proto_enumTypes[0].Descriptor()
+}
+
+func (Permission) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_preset_proto_enumTypes[0]
+}
+
+func (x Permission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// PresetPermission represents the value of a permission in a preset.
+type PresetPermission struct {
+	state                             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Permission             Permission             `protobuf:"varint,1,opt,name=permission,enum=wayplatform.connect.mapon.v1.Permission"`
+	xxx_hidden_UnrecognizedPermission *string                `protobuf:"bytes,2,opt,name=unrecognized_permission,json=unrecognizedPermission"`
+	xxx_hidden_Value                  *structpb.Value        `protobuf:"bytes,3,opt,name=value"`
+	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
+	XXX_presence                      [1]uint32
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *PresetPermission) Reset() {
+	*x = PresetPermission{}
+	mi := &file_wayplatform_connect_mapon_v1_preset_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresetPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresetPermission) ProtoMessage() {}
+
+func (x *PresetPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_preset_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PresetPermission) GetPermission() Permission {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Permission
+		}
+	}
+	return Permission_PERMISSION_UNSPECIFIED
+}
+
+func (x *PresetPermission) GetUnrecognizedPermission() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedPermission != nil {
+			return *x.xxx_hidden_UnrecognizedPermission
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *PresetPermission) GetValue() *structpb.Value {
+	if x != nil {
+		return x.xxx_hidden_Value
+	}
+	return nil
+}
+
+func (x *PresetPermission) SetPermission(v Permission) {
+	x.xxx_hidden_Permission = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *PresetPermission) SetUnrecognizedPermission(v string) {
+	x.xxx_hidden_UnrecognizedPermission = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *PresetPermission) SetValue(v *structpb.Value) {
+	x.xxx_hidden_Value = v
+}
+
+func (x *PresetPermission) HasPermission() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *PresetPermission) HasUnrecognizedPermission() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *PresetPermission) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Value != nil
+}
+
+func (x *PresetPermission) ClearPermission() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Permission = Permission_PERMISSION_UNSPECIFIED
+}
+
+func (x *PresetPermission) ClearUnrecognizedPermission() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnrecognizedPermission = nil
+}
+
+func (x *PresetPermission) ClearValue() {
+	x.xxx_hidden_Value = nil
+}
+
+type PresetPermission_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Permission this value applies to.
+	Permission *Permission
+	// Name of the permission when it is not recognized, e.g. "can_edit_object".
+	UnrecognizedPermission *string
+	// Value of the permission, usually a boolean.
+	Value *structpb.Value
+}
+
+func (b0 PresetPermission_builder) Build() *PresetPermission {
+	m0 := &PresetPermission{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Permission != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Permission = *b.Permission
+	}
+	if b.UnrecognizedPermission != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_UnrecognizedPermission = b.UnrecognizedPermission
+	}
+	x.xxx_hidden_Value = b.Value
+	return m0
+}
+
+// Preset represents a set of permissions and accessible resources that can be assigned to users.
+type Preset struct {
+	state                               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PresetId                 int64                  `protobuf:"varint,1,opt,name=preset_id,json=presetId"`
+	xxx_hidden_Title                    *string                `protobuf:"bytes,2,opt,name=title"`
+	xxx_hidden_IsCustom                 bool                   `protobuf:"varint,3,opt,name=is_custom,json=isCustom"`
+	xxx_hidden_HasAccessToAllUnits      bool                   `protobuf:"varint,4,opt,name=has_access_to_all_units,json=hasAccessToAllUnits"`
+	xxx_hidden_HasAccessToAllDrivers    bool                   `protobuf:"varint,5,opt,name=has_access_to_all_drivers,json=hasAccessToAllDrivers"`
+	xxx_hidden_HasAccessToAllObjects    bool                   `protobuf:"varint,6,opt,name=has_access_to_all_objects,json=hasAccessToAllObjects"`
+	xxx_hidden_HasAccessToAllMenus      bool                   `protobuf:"varint,7,opt,name=has_access_to_all_menus,json=hasAccessToAllMenus"`
+	xxx_hidden_HasAccessToAllBleBeacons bool                   `protobuf:"varint,8,opt,name=has_access_to_all_ble_beacons,json=hasAccessToAllBleBeacons"`
+	xxx_hidden_UnitIds                  []int64                `protobuf:"varint,9,rep,packed,name=unit_ids,json=unitIds"`
+	xxx_hidden_UnitGroupIds             []int64                `protobuf:"varint,10,rep,packed,name=unit_group_ids,json=unitGroupIds"`
+	xxx_hidden_UnitDepotIds             []int64                `protobuf:"varint,11,rep,packed,name=unit_depot_ids,json=unitDepotIds"`
+	xxx_hidden_DriverIds                []int64                `protobuf:"varint,12,rep,packed,name=driver_ids,json=driverIds"`
+	xxx_hidden_DriverDepotIds           []int64                `protobuf:"varint,13,rep,packed,name=driver_depot_ids,json=driverDepotIds"`
+	xxx_hidden_ObjectIds                []int64                `protobuf:"varint,14,rep,packed,name=object_ids,json=objectIds"`
+	xxx_hidden_ObjectGroupIds           []int64                `protobuf:"varint,15,rep,packed,name=object_group_ids,json=objectGroupIds"`
+	xxx_hidden_BleBeaconIds             []int64                `protobuf:"varint,16,rep,packed,name=ble_beacon_ids,json=bleBeaconIds"`
+	xxx_hidden_MenuIds                  []int64                `protobuf:"varint,17,rep,packed,name=menu_ids,json=menuIds"`
+	xxx_hidden_Permissions              *[]*PresetPermission   `protobuf:"bytes,18,rep,name=permissions"`
+	xxx_hidden_RestrictedMobileAccess   bool                   `protobuf:"varint,19,opt,name=restricted_mobile_access,json=restrictedMobileAccess"`
+	xxx_hidden_RestrictedIpRange        *string                `protobuf:"bytes,20,opt,name=restricted_ip_range,json=restrictedIpRange"`
+	XXX_raceDetectHookData              protoimpl.RaceDetectHookData
+	XXX_presence                        [1]uint32
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
+}
+
+func (x *Preset) Reset() {
+	*x = Preset{}
+	mi := &file_wayplatform_connect_mapon_v1_preset_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Preset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preset) ProtoMessage() {}
+
+func (x *Preset) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_preset_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Preset) GetPresetId() int64 {
+	if x != nil {
+		return x.xxx_hidden_PresetId
+	}
+	return 0
+}
+
+func (x *Preset) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Preset) GetIsCustom() bool {
+	if x != nil {
+		return x.xxx_hidden_IsCustom
+	}
+	return false
+}
+
+func (x *Preset) GetHasAccessToAllUnits() bool {
+	if x != nil {
+		return x.xxx_hidden_HasAccessToAllUnits
+	}
+	return false
+}
+
+func (x *Preset) GetHasAccessToAllDrivers() bool {
+	if x != nil {
+		return x.xxx_hidden_HasAccessToAllDrivers
+	}
+	return false
+}
+
+func (x *Preset) GetHasAccessToAllObjects() bool {
+	if x != nil {
+		return x.xxx_hidden_HasAccessToAllObjects
+	}
+	return false
+}
+
+func (x *Preset) GetHasAccessToAllMenus() bool {
+	if x != nil {
+		return x.xxx_hidden_HasAccessToAllMenus
+	}
+	return false
+}
+
+func (x *Preset) GetHasAccessToAllBleBeacons() bool {
+	if x != nil {
+		return x.xxx_hidden_HasAccessToAllBleBeacons
+	}
+	return false
+}
+
+func (x *Preset) GetUnitIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitIds
+	}
+	return nil
+}
+
+func (x *Preset) GetUnitGroupIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitGroupIds
+	}
+	return nil
+}
+
+func (x *Preset) GetUnitDepotIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitDepotIds
+	}
+	return nil
+}
+
+func (x *Preset) GetDriverIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_DriverIds
+	}
+	return nil
+}
+
+func (x *Preset) GetDriverDepotIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_DriverDepotIds
+	}
+	return nil
+}
+
+func (x *Preset) GetObjectIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_ObjectIds
+	}
+	return nil
+}
+
+func (x *Preset) GetObjectGroupIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_ObjectGroupIds
+	}
+	return nil
+}
+
+func (x *Preset) GetBleBeaconIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_BleBeaconIds
+	}
+	return nil
+}
+
+func (x *Preset) GetMenuIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_MenuIds
+	}
+	return nil
+}
+
+func (x *Preset) GetPermissions() []*PresetPermission {
+	if x != nil {
+		if x.xxx_hidden_Permissions != nil {
+			return *x.xxx_hidden_Permissions
+		}
+	}
+	return nil
+}
+
+func (x *Preset) GetRestrictedMobileAccess() bool {
+	if x != nil {
+		return x.xxx_hidden_RestrictedMobileAccess
+	}
+	return false
+}
+
+func (x *Preset) GetRestrictedIpRange() string {
+	if x != nil {
+		if x.xxx_hidden_RestrictedIpRange != nil {
+			return *x.xxx_hidden_RestrictedIpRange
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Preset) SetPresetId(v int64) {
+	x.xxx_hidden_PresetId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 20)
+}
+
+func (x *Preset) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 20)
+}
+
+func (x *Preset) SetIsCustom(v bool) {
+	x.xxx_hidden_IsCustom = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 20)
+}
+
+func (x *Preset) SetHasAccessToAllUnits(v bool) {
+	x.xxx_hidden_HasAccessToAllUnits = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 20)
+}
+
+func (x *Preset) SetHasAccessToAllDrivers(v bool) {
+	x.xxx_hidden_HasAccessToAllDrivers = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 20)
+}
+
+func (x *Preset) SetHasAccessToAllObjects(v bool) {
+	x.xxx_hidden_HasAccessToAllObjects = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 20)
+}
+
+func (x *Preset) SetHasAccessToAllMenus(v bool) {
+	x.xxx_hidden_HasAccessToAllMenus = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 20)
+}
+
+func (x *Preset) SetHasAccessToAllBleBeacons(v bool) {
+	x.xxx_hidden_HasAccessToAllBleBeacons = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 20)
+}
+
+func (x *Preset) SetUnitIds(v []int64) {
+	x.xxx_hidden_UnitIds = v
+}
+
+func (x *Preset) SetUnitGroupIds(v []int64) {
+	x.xxx_hidden_UnitGroupIds = v
+}
+
+func (x *Preset) SetUnitDepotIds(v []int64) {
+	x.xxx_hidden_UnitDepotIds = v
+}
+
+func (x *Preset) SetDriverIds(v []int64) {
+	x.xxx_hidden_DriverIds = v
+}
+
+func (x *Preset) SetDriverDepotIds(v []int64) {
+	x.xxx_hidden_DriverDepotIds = v
+}
+
+func (x *Preset) SetObjectIds(v []int64) {
+	x.xxx_hidden_ObjectIds = v
+}
+
+func (x *Preset) SetObjectGroupIds(v []int64) {
+	x.xxx_hidden_ObjectGroupIds = v
+}
+
+func (x *Preset) SetBleBeaconIds(v []int64) {
+	x.xxx_hidden_BleBeaconIds = v
+}
+
+func (x *Preset) SetMenuIds(v []int64) {
+	x.xxx_hidden_MenuIds = v
+}
+
+func (x *Preset) SetPermissions(v []*PresetPermission) {
+	x.xxx_hidden_Permissions = &v
+}
+
+func (x *Preset) SetRestrictedMobileAccess(v bool) {
+	x.xxx_hidden_RestrictedMobileAccess = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 18, 20)
+}
+
+func (x *Preset) SetRestrictedIpRange(v string) {
+	x.xxx_hidden_RestrictedIpRange = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 19, 20)
+}
+
+func (x *Preset) HasPresetId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Preset) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Preset) HasIsCustom() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Preset) HasHasAccessToAllUnits() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Preset) HasHasAccessToAllDrivers() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Preset) HasHasAccessToAllObjects() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Preset) HasHasAccessToAllMenus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Preset) HasHasAccessToAllBleBeacons() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Preset) HasRestrictedMobileAccess() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 18)
+}
+
+func (x *Preset) HasRestrictedIpRange() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 19)
+}
+
+func (x *Preset) ClearPresetId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_PresetId = 0
+}
+
+func (x *Preset) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *Preset) ClearIsCustom() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_IsCustom = false
+}
+
+func (x *Preset) ClearHasAccessToAllUnits() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_HasAccessToAllUnits = false
+}
+
+func (x *Preset) ClearHasAccessToAllDrivers() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_HasAccessToAllDrivers = false
+}
+
+func (x *Preset) ClearHasAccessToAllObjects() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_HasAccessToAllObjects = false
+}
+
+func (x *Preset) ClearHasAccessToAllMenus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_HasAccessToAllMenus = false
+}
+
+func (x *Preset) ClearHasAccessToAllBleBeacons() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_HasAccessToAllBleBeacons = false
+}
+
+func (x *Preset) ClearRestrictedMobileAccess() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 18)
+	x.xxx_hidden_RestrictedMobileAccess = false
+}
+
+func (x *Preset) ClearRestrictedIpRange() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 19)
+	x.xxx_hidden_RestrictedIpRange = nil
+}
+
+type Preset_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the preset.
+	PresetId *int64
+	// Title of the preset.
+	Title *string
+	// Indicates if the preset is customized for a single user.
+	IsCustom *bool
+	// Indicates if the preset grants access to all units.
+	HasAccessToAllUnits *bool
+	// Indicates if the preset grants access to all drivers.
+	HasAccessToAllDrivers *bool
+	// Indicates if the preset grants access to all objects.
+	HasAccessToAllObjects *bool
+	// Indicates if the preset grants access to all menus.
+	HasAccessToAllMenus *bool
+	// Indicates if the preset grants view access to all BLE beacons.
+	HasAccessToAllBleBeacons *bool
+	// Identifiers of the accessible units.
+	UnitIds []int64
+	// Identifiers of the accessible unit groups.
+	UnitGroupIds []int64
+	// Identifiers of the depots that restrict the accessible units.
+	UnitDepotIds []int64
+	// Identifiers of the accessible drivers.
+	DriverIds []int64
+	// Identifiers of the depots that restrict the accessible drivers.
+	DriverDepotIds []int64
+	// Identifiers of the accessible objects.
+	ObjectIds []int64
+	// Identifiers of the accessible object groups.
+	ObjectGroupIds []int64
+	// Identifiers of the accessible BLE beacons.
+	BleBeaconIds []int64
+	// Identifiers of the accessible menus.
+	MenuIds []int64
+	// Permissions granted by the preset.
+	Permissions []*PresetPermission
+	// Indicates if access from mobile phones is restricted.
+	RestrictedMobileAccess *bool
+	// Range of IP addresses the access is restricted to.
+	RestrictedIpRange *string
+}
+
+func (b0 Preset_builder) Build() *Preset {
+	m0 := &Preset{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.PresetId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 20)
+		x.xxx_hidden_PresetId = *b.PresetId
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 20)
+		x.xxx_hidden_Title = b.Title
+	}
+	if b.IsCustom != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 20)
+		x.xxx_hidden_IsCustom = *b.IsCustom
+	}
+	if b.HasAccessToAllUnits != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 20)
+		x.xxx_hidden_HasAccessToAllUnits = *b.HasAccessToAllUnits
+	}
+	if b.HasAccessToAllDrivers != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 20)
+		x.xxx_hidden_HasAccessToAllDrivers = *b.HasAccessToAllDrivers
+	}
+	if b.HasAccessToAllObjects != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 20)
+		x.xxx_hidden_HasAccessToAllObjects = *b.HasAccessToAllObjects
+	}
+	if b.HasAccessToAllMenus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 20)
+		x.xxx_hidden_HasAccessToAllMenus = *b.HasAccessToAllMenus
+	}
+	if b.HasAccessToAllBleBeacons != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 20)
+		x.xxx_hidden_HasAccessToAllBleBeacons = *b.HasAccessToAllBleBeacons
+	}
+	x.xxx_hidden_UnitIds = b.UnitIds
+	x.xxx_hidden_UnitGroupIds = b.UnitGroupIds
+	x.xxx_hidden_UnitDepotIds = b.UnitDepotIds
+	x.xxx_hidden_DriverIds = b.DriverIds
+	x.xxx_hidden_DriverDepotIds = b.DriverDepotIds
+	x.xxx_hidden_ObjectIds = b.ObjectIds
+	x.xxx_hidden_ObjectGroupIds = b.ObjectGroupIds
+	x.xxx_hidden_BleBeaconIds = b.BleBeaconIds
+	x.xxx_hidden_MenuIds = b.MenuIds
+	x.xxx_hidden_Permissions = &b.Permissions
+	if b.RestrictedMobileAccess != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 18, 20)
+		x.xxx_hidden_RestrictedMobileAccess = *b.RestrictedMobileAccess
+	}
+	if b.RestrictedIpRange != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 19, 20)
+		x.xxx_hidden_RestrictedIpRange = b.RestrictedIpRange
+	}
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_preset_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_preset_proto_rawDesc = "" +
+	"\n" +
+	")wayplatform/connect/mapon/v1/preset.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1cgoogle/protobuf/struct.proto\"\xc3\x01\n" +
+	"\x10PresetPermission\x12H\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\x0e2(.wayplatform.connect.mapon.v1.PermissionR\n" +
+	"permission\x127\n" +
+	"\x17unrecognized_permission\x18\x02 \x01(\tR\x16unrecognizedPermission\x12,\n" +
+	"\x05value\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x05value\"\xef\x06\n" +
+	"\x06Preset\x12\x1b\n" +
+	"\tpreset_id\x18\x01 \x01(\x03R\bpresetId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
+	"\tis_custom\x18\x03 \x01(\bR\bisCustom\x124\n" +
+	"\x17has_access_to_all_units\x18\x04 \x01(\bR\x13hasAccessToAllUnits\x128\n" +
+	"\x19has_access_to_all_drivers\x18\x05 \x01(\bR\x15hasAccessToAllDrivers\x128\n" +
+	"\x19has_access_to_all_objects\x18\x06 \x01(\bR\x15hasAccessToAllObjects\x124\n" +
+	"\x17has_access_to_all_menus\x18\a \x01(\bR\x13hasAccessToAllMenus\x12?\n" +
+	"\x1dhas_access_to_all_ble_beacons\x18\b \x01(\bR\x18hasAccessToAllBleBeacons\x12\x19\n" +
+	"\bunit_ids\x18\t \x03(\x03R\aunitIds\x12$\n" +
+	"\x0eunit_group_ids\x18\n" +
+	" \x03(\x03R\funitGroupIds\x12$\n" +
+	"\x0eunit_depot_ids\x18\v \x03(\x03R\funitDepotIds\x12\x1d\n" +
+	"\n" +
+	"driver_ids\x18\f \x03(\x03R\tdriverIds\x12(\n" +
+	"\x10driver_depot_ids\x18\r \x03(\x03R\x0edriverDepotIds\x12\x1d\n" +
+	"\n" +
+	"object_ids\x18\x0e \x03(\x03R\tobjectIds\x12(\n" +
+	"\x10object_group_ids\x18\x0f \x03(\x03R\x0eobjectGroupIds\x12$\n" +
+	"\x0eble_beacon_ids\x18\x10 \x03(\x03R\fbleBeaconIds\x12\x19\n" +
+	"\bmenu_ids\x18\x11 \x03(\x03R\amenuIds\x12P\n" +
+	"\vpermissions\x18\x12 \x03(\v2..wayplatform.connect.mapon.v1.PresetPermissionR\vpermissions\x128\n" +
+	"\x18restricted_mobile_access\x18\x13 \x01(\bR\x16restrictedMobileAccess\x12.\n" +
+	"\x13restricted_ip_range\x18\x14 \x01(\tR\x11restrictedIpRange*\xc3\x03\n" +
+	"\n" +
+	"Permission\x12\x1a\n" +
+	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PERMISSION_UNRECOGNIZED\x10\x01\x12\x1e\n" +
+	"\x1aPERMISSION_CAN_EDIT_OBJECT\x10\x02\x12)\n" +
+	"%PERMISSION_HAS_LIMITED_OBJECTS_ACCESS\x10\x03\x12\"\n" +
+	"\x1ePERMISSION_CAN_EDIT_OBJ_OTHERS\x10\x04\x12'\n" +
+	"#PERMISSION_CAN_ACCESS_TRACKING_LINK\x10\x05\x12!\n" +
+	"\x1dPERMISSION_HAS_REEFER_CONTROL\x10\x06\x12$\n" +
+	" PERMISSION_DO_ALLOW_SMS_FEATURES\x10\a\x12%\n" +
+	"!PERMISSION_HAS_PRIVATE_CAR_GROUPS\x10\b\x12!\n" +
+	"\x1dPERMISSION_CAN_CONTROL_DEVICE\x10\t\x12'\n" +
+	"#PERMISSION_CAN_EXPORT_REEFER_REPORT\x10\n" +
+	"\x12(\n" +
+	"$PERMISSION_COMMUNICATION_WITH_DRIVER\x10\vB\x96\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\vPresetProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_preset_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_mapon_v1_preset_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_mapon_v1_preset_proto_goTypes = []any{
+	(Permission)(0),          // 0: wayplatform.connect.mapon.v1.Permission
+	(*PresetPermission)(nil), // 1: wayplatform.connect.mapon.v1.PresetPermission
+	(*Preset)(nil),           // 2: wayplatform.connect.mapon.v1.Preset
+	(*structpb.Value)(nil),   // 3: google.protobuf.Value
+}
+var file_wayplatform_connect_mapon_v1_preset_proto_depIdxs = []int32{
+	0, // 0: wayplatform.connect.mapon.v1.PresetPermission.permission:type_name -> wayplatform.connect.mapon.v1.Permission
+	3, // 1: wayplatform.connect.mapon.v1.PresetPermission.value:type_name -> google.protobuf.Value
+	1, // 2: wayplatform.connect.mapon.v1.Preset.permissions:type_name -> wayplatform.connect.mapon.v1.PresetPermission
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_preset_proto_init() }
+func file_wayplatform_connect_mapon_v1_preset_proto_init() {
+	if File_wayplatform_connect_mapon_v1_preset_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_preset_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_preset_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_preset_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_preset_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_preset_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_preset_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_preset_proto = out.File
+	file_wayplatform_connect_mapon_v1_preset_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_preset_proto_depIdxs = nil
+}
@@ -0,0 +1,715 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/reefer_alert.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ReeferAlert represents a temperature alert configured for a unit's refrigerator.
+type ReeferAlert struct {
+	state                                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_AlertId                    int64                  `protobuf:"varint,1,opt,name=alert_id,json=alertId"`
+	xxx_hidden_UnitId                     int64                  `protobuf:"varint,2,opt,name=unit_id,json=unitId"`
+	xxx_hidden_RunMode                    int32                  `protobuf:"varint,3,opt,name=run_mode,json=runMode"`
+	xxx_hidden_SetpointCelsius            float64                `protobuf:"fixed64,4,opt,name=setpoint_celsius,json=setpointCelsius"`
+	xxx_hidden_OverSetpointCelsius        float64                `protobuf:"fixed64,5,opt,name=over_setpoint_celsius,json=overSetpointCelsius"`
+	xxx_hidden_UnderSetpointCelsius       float64                `protobuf:"fixed64,6,opt,name=under_setpoint_celsius,json=underSetpointCelsius"`
+	xxx_hidden_ActiveFrom                 *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=active_from,json=activeFrom"`
+	xxx_hidden_ActiveTo                   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=active_to,json=activeTo"`
+	xxx_hidden_UserId                     int64                  `protobuf:"varint,9,opt,name=user_id,json=userId"`
+	xxx_hidden_Compartment                int32                  `protobuf:"varint,10,opt,name=compartment"`
+	xxx_hidden_CargoCooling               bool                   `protobuf:"varint,11,opt,name=cargo_cooling,json=cargoCooling"`
+	xxx_hidden_CargoCoolingRangeCelsius   int32                  `protobuf:"varint,12,opt,name=cargo_cooling_range_celsius,json=cargoCoolingRangeCelsius"`
+	xxx_hidden_Channels                   []string               `protobuf:"bytes,13,rep,name=channels"`
+	xxx_hidden_Notes                      *string                `protobuf:"bytes,14,opt,name=notes"`
+	xxx_hidden_LastDataTime               *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=last_data_time,json=lastDataTime"`
+	xxx_hidden_CurrentFlags               *ReeferValue           `protobuf:"bytes,16,opt,name=current_flags,json=currentFlags"`
+	xxx_hidden_CurrentRunMode             *ReeferValue           `protobuf:"bytes,17,opt,name=current_run_mode,json=currentRunMode"`
+	xxx_hidden_CurrentSetpointTemperature *TemperatureRecord     `protobuf:"bytes,18,opt,name=current_setpoint_temperature,json=currentSetpointTemperature"`
+	xxx_hidden_CurrentReturnTemperature   *TemperatureRecord     `protobuf:"bytes,19,opt,name=current_return_temperature,json=currentReturnTemperature"`
+	xxx_hidden_CurrentCompartmentState    *ReeferStateValue      `protobuf:"bytes,20,opt,name=current_compartment_state,json=currentCompartmentState"`
+	XXX_raceDetectHookData                protoimpl.RaceDetectHookData
+	XXX_presence                          [1]uint32
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *ReeferAlert) Reset() {
+	*x = ReeferAlert{}
+	mi := &file_wayplatform_connect_mapon_v1_reefer_alert_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReeferAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReeferAlert) ProtoMessage() {}
+
+func (x *ReeferAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_reefer_alert_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReeferAlert) GetAlertId() int64 {
+	if x != nil {
+		return x.xxx_hidden_AlertId
+	}
+	return 0
+}
+
+func (x *ReeferAlert) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *ReeferAlert) GetRunMode() int32 {
+	if x != nil {
+		return x.xxx_hidden_RunMode
+	}
+	return 0
+}
+
+func (x *ReeferAlert) GetSetpointCelsius() float64 {
+	if x != nil {
+		return x.xxx_hidden_SetpointCelsius
+	}
+	return 0
+}
+
+func (x *ReeferAlert) GetOverSetpointCelsius() float64 {
+	if x != nil {
+		return x.xxx_hidden_OverSetpointCelsius
+	}
+	return 0
+}
+
+func (x *ReeferAlert) GetUnderSetpointCelsius() float64 {
+	if x != nil {
+		return x.xxx_hidden_UnderSetpointCelsius
+	}
+	return 0
+}
+
+func (x *ReeferAlert) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ActiveFrom
+	}
+	return nil
+}
+
+func (x *ReeferAlert) GetActiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ActiveTo
+	}
+	return nil
+}
+
+func (x *ReeferAlert) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *ReeferAlert) GetCompartment() int32 {
+	if x != nil {
+		return x.xxx_hidden_Compartment
+	}
+	return 0
+}
+
+func (x *ReeferAlert) GetCargoCooling() bool {
+	if x != nil {
+		return x.xxx_hidden_CargoCooling
+	}
+	return false
+}
+
+func (x *ReeferAlert) GetCargoCoolingRangeCelsius() int32 {
+	if x != nil {
+		return x.xxx_hidden_CargoCoolingRangeCelsius
+	}
+	return 0
+}
+
+func (x *ReeferAlert) GetChannels() []string {
+	if x != nil {
+		return x.xxx_hidden_Channels
+	}
+	return nil
+}
+
+func (x *ReeferAlert) GetNotes() string {
+	if x != nil {
+		if x.xxx_hidden_Notes != nil {
+			return *x.xxx_hidden_Notes
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ReeferAlert) GetLastDataTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastDataTime
+	}
+	return nil
+}
+
+func (x *ReeferAlert) GetCurrentFlags() *ReeferValue {
+	if x != nil {
+		return x.xxx_hidden_CurrentFlags
+	}
+	return nil
+}
+
+func (x *ReeferAlert) GetCurrentRunMode() *ReeferValue {
+	if x != nil {
+		return x.xxx_hidden_CurrentRunMode
+	}
+	return nil
+}
+
+func (x *ReeferAlert) GetCurrentSetpointTemperature() *TemperatureRecord {
+	if x != nil {
+		return x.xxx_hidden_CurrentSetpointTemperature
+	}
+	return nil
+}
+
+func (x *ReeferAlert) GetCurrentReturnTemperature() *TemperatureRecord {
+	if x != nil {
+		return x.xxx_hidden_CurrentReturnTemperature
+	}
+	return nil
+}
+
+func (x *ReeferAlert) GetCurrentCompartmentState() *ReeferStateValue {
+	if x != nil {
+		return x.xxx_hidden_CurrentCompartmentState
+	}
+	return nil
+}
+
+func (x *ReeferAlert) SetAlertId(v int64) {
+	x.xxx_hidden_AlertId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 20)
+}
+
+func (x *ReeferAlert) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 20)
+}
+
+func (x *ReeferAlert) SetRunMode(v int32) {
+	x.xxx_hidden_RunMode = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 20)
+}
+
+func (x *ReeferAlert) SetSetpointCelsius(v float64) {
+	x.xxx_hidden_SetpointCelsius = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 20)
+}
+
+func (x *ReeferAlert) SetOverSetpointCelsius(v float64) {
+	x.xxx_hidden_OverSetpointCelsius = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 20)
+}
+
+func (x *ReeferAlert) SetUnderSetpointCelsius(v float64) {
+	x.xxx_hidden_UnderSetpointCelsius = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 20)
+}
+
+func (x *ReeferAlert) SetActiveFrom(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ActiveFrom = v
+}
+
+func (x *ReeferAlert) SetActiveTo(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ActiveTo = v
+}
+
+func (x *ReeferAlert) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 20)
+}
+
+func (x *ReeferAlert) SetCompartment(v int32) {
+	x.xxx_hidden_Compartment = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 20)
+}
+
+func (x *ReeferAlert) SetCargoCooling(v bool) {
+	x.xxx_hidden_CargoCooling = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 20)
+}
+
+func (x *ReeferAlert) SetCargoCoolingRangeCelsius(v int32) {
+	x.xxx_hidden_CargoCoolingRangeCelsius = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 20)
+}
+
+func (x *ReeferAlert) SetChannels(v []string) {
+	x.xxx_hidden_Channels = v
+}
+
+func (x *ReeferAlert) SetNotes(v string) {
+	x.xxx_hidden_Notes = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 20)
+}
+
+func (x *ReeferAlert) SetLastDataTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastDataTime = v
+}
+
+func (x *ReeferAlert) SetCurrentFlags(v *ReeferValue) {
+	x.xxx_hidden_CurrentFlags = v
+}
+
+func (x *ReeferAlert) SetCurrentRunMode(v *ReeferValue) {
+	x.xxx_hidden_CurrentRunMode = v
+}
+
+func (x *ReeferAlert) SetCurrentSetpointTemperature(v *TemperatureRecord) {
+	x.xxx_hidden_CurrentSetpointTemperature = v
+}
+
+func (x *ReeferAlert) SetCurrentReturnTemperature(v *TemperatureRecord) {
+	x.xxx_hidden_CurrentReturnTemperature = v
+}
+
+func (x *ReeferAlert) SetCurrentCompartmentState(v *ReeferStateValue) {
+	x.xxx_hidden_CurrentCompartmentState = v
+}
+
+func (x *ReeferAlert) HasAlertId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ReeferAlert) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ReeferAlert) HasRunMode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ReeferAlert) HasSetpointCelsius() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ReeferAlert) HasOverSetpointCelsius() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ReeferAlert) HasUnderSetpointCelsius() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *ReeferAlert) HasActiveFrom() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ActiveFrom != nil
+}
+
+func (x *ReeferAlert) HasActiveTo() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ActiveTo != nil
+}
+
+func (x *ReeferAlert) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *ReeferAlert) HasCompartment() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *ReeferAlert) HasCargoCooling() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *ReeferAlert) HasCargoCoolingRangeCelsius() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *ReeferAlert) HasNotes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *ReeferAlert) HasLastDataTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastDataTime != nil
+}
+
+func (x *ReeferAlert) HasCurrentFlags() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CurrentFlags != nil
+}
+
+func (x *ReeferAlert) HasCurrentRunMode() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CurrentRunMode != nil
+}
+
+func (x *ReeferAlert) HasCurrentSetpointTemperature() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CurrentSetpointTemperature != nil
+}
+
+func (x *ReeferAlert) HasCurrentReturnTemperature() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CurrentReturnTemperature != nil
+}
+
+func (x *ReeferAlert) HasCurrentCompartmentState() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CurrentCompartmentState != nil
+}
+
+func (x *ReeferAlert) ClearAlertId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_AlertId = 0
+}
+
+func (x *ReeferAlert) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *ReeferAlert) ClearRunMode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_RunMode = 0
+}
+
+func (x *ReeferAlert) ClearSetpointCelsius() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_SetpointCelsius = 0
+}
+
+func (x *ReeferAlert) ClearOverSetpointCelsius() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_OverSetpointCelsius = 0
+}
+
+func (x *ReeferAlert) ClearUnderSetpointCelsius() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_UnderSetpointCelsius = 0
+}
+
+func (x *ReeferAlert) ClearActiveFrom() {
+	x.xxx_hidden_ActiveFrom = nil
+}
+
+func (x *ReeferAlert) ClearActiveTo() {
+	x.xxx_hidden_ActiveTo = nil
+}
+
+func (x *ReeferAlert) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_UserId = 0
+}
+
+func (x *ReeferAlert) ClearCompartment() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_Compartment = 0
+}
+
+func (x *ReeferAlert) ClearCargoCooling() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_CargoCooling = false
+}
+
+func (x *ReeferAlert) ClearCargoCoolingRangeCelsius() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_CargoCoolingRangeCelsius = 0
+}
+
+func (x *ReeferAlert) ClearNotes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_Notes = nil
+}
+
+func (x *ReeferAlert) ClearLastDataTime() {
+	x.xxx_hidden_LastDataTime = nil
+}
+
+func (x *ReeferAlert) ClearCurrentFlags() {
+	x.xxx_hidden_CurrentFlags = nil
+}
+
+func (x *ReeferAlert) ClearCurrentRunMode() {
+	x.xxx_hidden_CurrentRunMode = nil
+}
+
+func (x *ReeferAlert) ClearCurrentSetpointTemperature() {
+	x.xxx_hidden_CurrentSetpointTemperature = nil
+}
+
+func (x *ReeferAlert) ClearCurrentReturnTemperature() {
+	x.xxx_hidden_CurrentReturnTemperature = nil
+}
+
+func (x *ReeferAlert) ClearCurrentCompartmentState() {
+	x.xxx_hidden_CurrentCompartmentState = nil
+}
+
+type ReeferAlert_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the alert.
+	AlertId *int64
+	// The ID of the unit the alert is configured for.
+	UnitId *int64
+	// Expected run mode (-1, 0 or 1).
+	RunMode *int32
+	// Expected setpoint temperature in Celsius.
+	SetpointCelsius *float64
+	// Maximum allowed deviation above the setpoint in Celsius.
+	OverSetpointCelsius *float64
+	// Maximum allowed deviation below the setpoint in Celsius.
+	UnderSetpointCelsius *float64
+	// Start of the period when the alert is active.
+	ActiveFrom *timestamppb.Timestamp
+	// End of the period when the alert is active.
+	ActiveTo *timestamppb.Timestamp
+	// ID of the user owning the alert.
+	UserId *int64
+	// Compartment number, starting from 0.
+	Compartment *int32
+	// Whether cargo cooling monitoring is enabled.
+	CargoCooling *bool
+	// Cargo cooling range in Celsius (2-7).
+	CargoCoolingRangeCelsius *int32
+	// Notification channels (e.g., "sms", "email", "a3").
+	Channels []string
+	// Cargo notes.
+	Notes *string
+	// Timestamp of the last data received from the refrigerator.
+	LastDataTime *timestamppb.Timestamp
+	// Current refrigerator flags bitmask.
+	CurrentFlags *ReeferValue
+	// Current run mode of the refrigerator.
+	CurrentRunMode *ReeferValue
+	// Current setpoint temperature.
+	CurrentSetpointTemperature *TemperatureRecord
+	// Current return air temperature.
+	CurrentReturnTemperature *TemperatureRecord
+	// Current compartment state.
+	CurrentCompartmentState *ReeferStateValue
+}
+
+func (b0 ReeferAlert_builder) Build() *ReeferAlert {
+	m0 := &ReeferAlert{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.AlertId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 20)
+		x.xxx_hidden_AlertId = *b.AlertId
+	}
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 20)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.RunMode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 20)
+		x.xxx_hidden_RunMode = *b.RunMode
+	}
+	if b.SetpointCelsius != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 20)
+		x.xxx_hidden_SetpointCelsius = *b.SetpointCelsius
+	}
+	if b.OverSetpointCelsius != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 20)
+		x.xxx_hidden_OverSetpointCelsius = *b.OverSetpointCelsius
+	}
+	if b.UnderSetpointCelsius != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 20)
+		x.xxx_hidden_UnderSetpointCelsius = *b.UnderSetpointCelsius
+	}
+	x.xxx_hidden_ActiveFrom = b.ActiveFrom
+	x.xxx_hidden_ActiveTo = b.ActiveTo
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 20)
+		x.xxx_hidden_UserId = *b.UserId
+	}
+	if b.Compartment != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 20)
+		x.xxx_hidden_Compartment = *b.Compartment
+	}
+	if b.CargoCooling != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 20)
+		x.xxx_hidden_CargoCooling = *b.CargoCooling
+	}
+	if b.CargoCoolingRangeCelsius != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 20)
+		x.xxx_hidden_CargoCoolingRangeCelsius = *b.CargoCoolingRangeCelsius
+	}
+	x.xxx_hidden_Channels = b.Channels
+	if b.Notes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 20)
+		x.xxx_hidden_Notes = b.Notes
+	}
+	x.xxx_hidden_LastDataTime = b.LastDataTime
+	x.xxx_hidden_CurrentFlags = b.CurrentFlags
+	x.xxx_hidden_CurrentRunMode = b.CurrentRunMode
+	x.xxx_hidden_CurrentSetpointTemperature = b.CurrentSetpointTemperature
+	x.xxx_hidden_CurrentReturnTemperature = b.CurrentReturnTemperature
+	x.xxx_hidden_CurrentCompartmentState = b.CurrentCompartmentState
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_reefer_alert_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_reefer_alert_proto_rawDesc = "" +
+	"\n" +
+	"/wayplatform/connect/mapon/v1/reefer_alert.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.wayplatform/connect/mapon/v1/reefer_data.proto\x1a5wayplatform/connect/mapon/v1/temperature_record.proto\"\xed\b\n" +
+	"\vReeferAlert\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\x03R\aalertId\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\x03R\x06unitId\x12\x19\n" +
+	"\brun_mode\x18\x03 \x01(\x05R\arunMode\x12)\n" +
+	"\x10setpoint_celsius\x18\x04 \x01(\x01R\x0fsetpointCelsius\x122\n" +
+	"\x15over_setpoint_celsius\x18\x05 \x01(\x01R\x13overSetpointCelsius\x124\n" +
+	"\x16under_setpoint_celsius\x18\x06 \x01(\x01R\x14underSetpointCelsius\x12;\n" +
+	"\vactive_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"activeFrom\x127\n" +
+	"\tactive_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bactiveTo\x12\x17\n" +
+	"\auser_id\x18\t \x01(\x03R\x06userId\x12 \n" +
+	"\vcompartment\x18\n" +
+	" \x01(\x05R\vcompartment\x12#\n" +
+	"\rcargo_cooling\x18\v \x01(\bR\fcargoCooling\x12=\n" +
+	"\x1bcargo_cooling_range_celsius\x18\f \x01(\x05R\x18cargoCoolingRangeCelsius\x12\x1a\n" +
+	"\bchannels\x18\r \x03(\tR\bchannels\x12\x14\n" +
+	"\x05notes\x18\x0e \x01(\tR\x05notes\x12@\n" +
+	"\x0elast_data_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\flastDataTime\x12N\n" +
+	"\rcurrent_flags\x18\x10 \x01(\v2).wayplatform.connect.mapon.v1.ReeferValueR\fcurrentFlags\x12S\n" +
+	"\x10current_run_mode\x18\x11 \x01(\v2).wayplatform.connect.mapon.v1.ReeferValueR\x0ecurrentRunMode\x12q\n" +
+	"\x1ccurrent_setpoint_temperature\x18\x12 \x01(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x1acurrentSetpointTemperature\x12m\n" +
+	"\x1acurrent_return_temperature\x18\x13 \x01(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x18currentReturnTemperature\x12j\n" +
+	"\x19current_compartment_state\x18\x14 \x01(\v2..wayplatform.connect.mapon.v1.ReeferStateValueR\x17currentCompartmentStateB\x9b\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x10ReeferAlertProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_reefer_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_wayplatform_connect_mapon_v1_reefer_alert_proto_goTypes = []any{
+	(*ReeferAlert)(nil),           // 0: wayplatform.connect.mapon.v1.ReeferAlert
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	(*ReeferValue)(nil),           // 2: wayplatform.connect.mapon.v1.ReeferValue
+	(*TemperatureRecord)(nil),     // 3: wayplatform.connect.mapon.v1.TemperatureRecord
+	(*ReeferStateValue)(nil),      // 4: wayplatform.connect.mapon.v1.ReeferStateValue
+}
+var file_wayplatform_connect_mapon_v1_reefer_alert_proto_depIdxs = []int32{
+	1, // 0: wayplatform.connect.mapon.v1.ReeferAlert.active_from:type_name -> google.protobuf.Timestamp
+	1, // 1: wayplatform.connect.mapon.v1.ReeferAlert.active_to:type_name -> google.protobuf.Timestamp
+	1, // 2: wayplatform.connect.mapon.v1.ReeferAlert.last_data_time:type_name -> google.protobuf.Timestamp
+	2, // 3: wayplatform.connect.mapon.v1.ReeferAlert.current_flags:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	2, // 4: wayplatform.connect.mapon.v1.ReeferAlert.current_run_mode:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	3, // 5: wayplatform.connect.mapon.v1.ReeferAlert.current_setpoint_temperature:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	3, // 6: wayplatform.connect.mapon.v1.ReeferAlert.current_return_temperature:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	4, // 7: wayplatform.connect.mapon.v1.ReeferAlert.current_compartment_state:type_name -> wayplatform.connect.mapon.v1.ReeferStateValue
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_reefer_alert_proto_init() }
+func file_wayplatform_connect_mapon_v1_reefer_alert_proto_init() {
+	if File_wayplatform_connect_mapon_v1_reefer_alert_proto != nil {
+		return
+	}
+	file_wayplatform_connect_mapon_v1_reefer_data_proto_init()
+	file_wayplatform_connect_mapon_v1_temperature_record_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_reefer_alert_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_reefer_alert_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_reefer_alert_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_reefer_alert_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_reefer_alert_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_reefer_alert_proto = out.File
+	file_wayplatform_connect_mapon_v1_reefer_alert_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_reefer_alert_proto_depIdxs = nil
+}
@@ -0,0 +1,1599 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/reefer_data.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ReeferHistoricPointSelection selects which reading is returned for a point in time.
+type ReeferHistoricPointSelection int32
+
+const (
+	// Default value, treated as REEFER_HISTORIC_POINT_SELECTION_PREVIOUS.
+	ReeferHistoricPointSelection_REEFER_HISTORIC_POINT_SELECTION_UNSPECIFIED ReeferHistoricPointSelection = 0
+	// Used when the received value does not match any known enum member.
+	ReeferHistoricPointSelection_REEFER_HISTORIC_POINT_SELECTION_UNRECOGNIZED ReeferHistoricPointSelection = 1
+	// The last reading before the requested time.
+	ReeferHistoricPointSelection_REEFER_HISTORIC_POINT_SELECTION_PREVIOUS ReeferHistoricPointSelection = 2
+	// The reading nearest in time to the requested time.
+	ReeferHistoricPointSelection_REEFER_HISTORIC_POINT_SELECTION_NEAREST ReeferHistoricPointSelection = 3
+)
+
+// Enum value maps for ReeferHistoricPointSelection.
+var (
+	ReeferHistoricPointSelection_name = map[int32]string{
+		0: "REEFER_HISTORIC_POINT_SELECTION_UNSPECIFIED",
+		1: "REEFER_HISTORIC_POINT_SELECTION_UNRECOGNIZED",
+		2: "REEFER_HISTORIC_POINT_SELECTION_PREVIOUS",
+		3: "REEFER_HISTORIC_POINT_SELECTION_NEAREST",
+	}
+	ReeferHistoricPointSelection_value = map[string]int32{
+		"REEFER_HISTORIC_POINT_SELECTION_UNSPECIFIED":  0,
+		"REEFER_HISTORIC_POINT_SELECTION_UNRECOGNIZED": 1,
+		"REEFER_HISTORIC_POINT_SELECTION_PREVIOUS":     2,
+		"REEFER_HISTORIC_POINT_SELECTION_NEAREST":      3,
+	}
+)
+
+func (x ReeferHistoricPointSelection) Enum() *ReeferHistoricPointSelection {
+	p := new(ReeferHistoricPointSelection)
+	*p = x
+	return p
+}
+
+func (x ReeferHistoricPointSelection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReeferHistoricPointSelection) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_reefer_data_proto_enumTypes[0].Descriptor()
+}
+
+func (ReeferHistoricPointSelection) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_reefer_data_proto_enumTypes[0]
+}
+
+func (x ReeferHistoricPointSelection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// ReeferValue represents a single numeric reefer reading, e.g. engine hours or a mode.
+type ReeferValue struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Time        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time"`
+	xxx_hidden_Value       float64                `protobuf:"fixed64,2,opt,name=value"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReeferValue) Reset() {
+	*x = ReeferValue{}
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReeferValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReeferValue) ProtoMessage() {}
+
+func (x *ReeferValue) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReeferValue) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *ReeferValue) GetValue() float64 {
+	if x != nil {
+		return x.xxx_hidden_Value
+	}
+	return 0
+}
+
+func (x *ReeferValue) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *ReeferValue) SetValue(v float64) {
+	x.xxx_hidden_Value = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ReeferValue) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *ReeferValue) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ReeferValue) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *ReeferValue) ClearValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Value = 0
+}
+
+type ReeferValue_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Timestamp of the reading.
+	Time *timestamppb.Timestamp
+	// Value of the reading.
+	Value *float64
+}
+
+func (b0 ReeferValue_builder) Build() *ReeferValue {
+	m0 := &ReeferValue{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Time = b.Time
+	if b.Value != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Value = *b.Value
+	}
+	return m0
+}
+
+// ReeferStateValue represents a single compartment state reading.
+type ReeferStateValue struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Time        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time"`
+	xxx_hidden_State       *string                `protobuf:"bytes,2,opt,name=state"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReeferStateValue) Reset() {
+	*x = ReeferStateValue{}
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReeferStateValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReeferStateValue) ProtoMessage() {}
+
+func (x *ReeferStateValue) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReeferStateValue) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *ReeferStateValue) GetState() string {
+	if x != nil {
+		if x.xxx_hidden_State != nil {
+			return *x.xxx_hidden_State
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ReeferStateValue) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *ReeferStateValue) SetState(v string) {
+	x.xxx_hidden_State = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ReeferStateValue) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *ReeferStateValue) HasState() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ReeferStateValue) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *ReeferStateValue) ClearState() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_State = nil
+}
+
+type ReeferStateValue_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Timestamp of the reading.
+	Time *timestamppb.Timestamp
+	// State of the compartment (e.g., "on", "off").
+	State *string
+}
+
+func (b0 ReeferStateValue_builder) Build() *ReeferStateValue {
+	m0 := &ReeferStateValue{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Time = b.Time
+	if b.State != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_State = b.State
+	}
+	return m0
+}
+
+// ReeferCompartmentPoint contains the readings of a single compartment at a point in time.
+type ReeferCompartmentPoint struct {
+	state                                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Compartment                  int32                  `protobuf:"varint,1,opt,name=compartment"`
+	xxx_hidden_State                        *ReeferStateValue      `protobuf:"bytes,2,opt,name=state"`
+	xxx_hidden_SupplyTemperature            *TemperatureRecord     `protobuf:"bytes,3,opt,name=supply_temperature,json=supplyTemperature"`
+	xxx_hidden_ReturnTemperature            *TemperatureRecord     `protobuf:"bytes,4,opt,name=return_temperature,json=returnTemperature"`
+	xxx_hidden_SetpointTemperature          *TemperatureRecord     `protobuf:"bytes,5,opt,name=setpoint_temperature,json=setpointTemperature"`
+	xxx_hidden_CargowatchAmbientTemperature *TemperatureRecord     `protobuf:"bytes,6,opt,name=cargowatch_ambient_temperature,json=cargowatchAmbientTemperature"`
+	xxx_hidden_CargowatchRearTemperature    *TemperatureRecord     `protobuf:"bytes,7,opt,name=cargowatch_rear_temperature,json=cargowatchRearTemperature"`
+	xxx_hidden_CargowatchReturnTemperature  *TemperatureRecord     `protobuf:"bytes,8,opt,name=cargowatch_return_temperature,json=cargowatchReturnTemperature"`
+	XXX_raceDetectHookData                  protoimpl.RaceDetectHookData
+	XXX_presence                            [1]uint32
+	unknownFields                           protoimpl.UnknownFields
+	sizeCache                               protoimpl.SizeCache
+}
+
+func (x *ReeferCompartmentPoint) Reset() {
+	*x = ReeferCompartmentPoint{}
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReeferCompartmentPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReeferCompartmentPoint) ProtoMessage() {}
+
+func (x *ReeferCompartmentPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReeferCompartmentPoint) GetCompartment() int32 {
+	if x != nil {
+		return x.xxx_hidden_Compartment
+	}
+	return 0
+}
+
+func (x *ReeferCompartmentPoint) GetState() *ReeferStateValue {
+	if x != nil {
+		return x.xxx_hidden_State
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPoint) GetSupplyTemperature() *TemperatureRecord {
+	if x != nil {
+		return x.xxx_hidden_SupplyTemperature
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPoint) GetReturnTemperature() *TemperatureRecord {
+	if x != nil {
+		return x.xxx_hidden_ReturnTemperature
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPoint) GetSetpointTemperature() *TemperatureRecord {
+	if x != nil {
+		return x.xxx_hidden_SetpointTemperature
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPoint) GetCargowatchAmbientTemperature() *TemperatureRecord {
+	if x != nil {
+		return x.xxx_hidden_CargowatchAmbientTemperature
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPoint) GetCargowatchRearTemperature() *TemperatureRecord {
+	if x != nil {
+		return x.xxx_hidden_CargowatchRearTemperature
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPoint) GetCargowatchReturnTemperature() *TemperatureRecord {
+	if x != nil {
+		return x.xxx_hidden_CargowatchReturnTemperature
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPoint) SetCompartment(v int32) {
+	x.xxx_hidden_Compartment = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *ReeferCompartmentPoint) SetState(v *ReeferStateValue) {
+	x.xxx_hidden_State = v
+}
+
+func (x *ReeferCompartmentPoint) SetSupplyTemperature(v *TemperatureRecord) {
+	x.xxx_hidden_SupplyTemperature = v
+}
+
+func (x *ReeferCompartmentPoint) SetReturnTemperature(v *TemperatureRecord) {
+	x.xxx_hidden_ReturnTemperature = v
+}
+
+func (x *ReeferCompartmentPoint) SetSetpointTemperature(v *TemperatureRecord) {
+	x.xxx_hidden_SetpointTemperature = v
+}
+
+func (x *ReeferCompartmentPoint) SetCargowatchAmbientTemperature(v *TemperatureRecord) {
+	x.xxx_hidden_CargowatchAmbientTemperature = v
+}
+
+func (x *ReeferCompartmentPoint) SetCargowatchRearTemperature(v *TemperatureRecord) {
+	x.xxx_hidden_CargowatchRearTemperature = v
+}
+
+func (x *ReeferCompartmentPoint) SetCargowatchReturnTemperature(v *TemperatureRecord) {
+	x.xxx_hidden_CargowatchReturnTemperature = v
+}
+
+func (x *ReeferCompartmentPoint) HasCompartment() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ReeferCompartmentPoint) HasState() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_State != nil
+}
+
+func (x *ReeferCompartmentPoint) HasSupplyTemperature() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_SupplyTemperature != nil
+}
+
+func (x *ReeferCompartmentPoint) HasReturnTemperature() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ReturnTemperature != nil
+}
+
+func (x *ReeferCompartmentPoint) HasSetpointTemperature() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_SetpointTemperature != nil
+}
+
+func (x *ReeferCompartmentPoint) HasCargowatchAmbientTemperature() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CargowatchAmbientTemperature != nil
+}
+
+func (x *ReeferCompartmentPoint) HasCargowatchRearTemperature() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CargowatchRearTemperature != nil
+}
+
+func (x *ReeferCompartmentPoint) HasCargowatchReturnTemperature() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CargowatchReturnTemperature != nil
+}
+
+func (x *ReeferCompartmentPoint) ClearCompartment() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Compartment = 0
+}
+
+func (x *ReeferCompartmentPoint) ClearState() {
+	x.xxx_hidden_State = nil
+}
+
+func (x *ReeferCompartmentPoint) ClearSupplyTemperature() {
+	x.xxx_hidden_SupplyTemperature = nil
+}
+
+func (x *ReeferCompartmentPoint) ClearReturnTemperature() {
+	x.xxx_hidden_ReturnTemperature = nil
+}
+
+func (x *ReeferCompartmentPoint) ClearSetpointTemperature() {
+	x.xxx_hidden_SetpointTemperature = nil
+}
+
+func (x *ReeferCompartmentPoint) ClearCargowatchAmbientTemperature() {
+	x.xxx_hidden_CargowatchAmbientTemperature = nil
+}
+
+func (x *ReeferCompartmentPoint) ClearCargowatchRearTemperature() {
+	x.xxx_hidden_CargowatchRearTemperature = nil
+}
+
+func (x *ReeferCompartmentPoint) ClearCargowatchReturnTemperature() {
+	x.xxx_hidden_CargowatchReturnTemperature = nil
+}
+
+type ReeferCompartmentPoint_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Compartment number, starting from 0.
+	Compartment *int32
+	// State of the compartment.
+	State *ReeferStateValue
+	// Supply air temperature.
+	SupplyTemperature *TemperatureRecord
+	// Return air temperature.
+	ReturnTemperature *TemperatureRecord
+	// Setpoint temperature.
+	SetpointTemperature *TemperatureRecord
+	// Ambient temperature measured by the CargoWatch sensor.
+	CargowatchAmbientTemperature *TemperatureRecord
+	// Rear temperature measured by the CargoWatch sensor.
+	CargowatchRearTemperature *TemperatureRecord
+	// Return temperature measured by the CargoWatch sensor.
+	CargowatchReturnTemperature *TemperatureRecord
+}
+
+func (b0 ReeferCompartmentPoint_builder) Build() *ReeferCompartmentPoint {
+	m0 := &ReeferCompartmentPoint{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Compartment != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_Compartment = *b.Compartment
+	}
+	x.xxx_hidden_State = b.State
+	x.xxx_hidden_SupplyTemperature = b.SupplyTemperature
+	x.xxx_hidden_ReturnTemperature = b.ReturnTemperature
+	x.xxx_hidden_SetpointTemperature = b.SetpointTemperature
+	x.xxx_hidden_CargowatchAmbientTemperature = b.CargowatchAmbientTemperature
+	x.xxx_hidden_CargowatchRearTemperature = b.CargowatchRearTemperature
+	x.xxx_hidden_CargowatchReturnTemperature = b.CargowatchReturnTemperature
+	return m0
+}
+
+// ReeferHistoricPoint contains the reefer readings of a unit at a point in time.
+// Readings without data within a day of the requested time are not set.
+type ReeferHistoricPoint struct {
+	state                    protoimpl.MessageState     `protogen:"opaque.v1"`
+	xxx_hidden_Reefer        *Reefer                    `protobuf:"bytes,1,opt,name=reefer"`
+	xxx_hidden_Flags         *ReeferValue               `protobuf:"bytes,2,opt,name=flags"`
+	xxx_hidden_StandbyHours  *ReeferValue               `protobuf:"bytes,3,opt,name=standby_hours,json=standbyHours"`
+	xxx_hidden_DieselHours   *ReeferValue               `protobuf:"bytes,4,opt,name=diesel_hours,json=dieselHours"`
+	xxx_hidden_ElectricHours *ReeferValue               `protobuf:"bytes,5,opt,name=electric_hours,json=electricHours"`
+	xxx_hidden_RunMode       *ReeferValue               `protobuf:"bytes,6,opt,name=run_mode,json=runMode"`
+	xxx_hidden_SpeedMode     *ReeferValue               `protobuf:"bytes,7,opt,name=speed_mode,json=speedMode"`
+	xxx_hidden_PowerMode     *ReeferValue               `protobuf:"bytes,8,opt,name=power_mode,json=powerMode"`
+	xxx_hidden_Compartments  *[]*ReeferCompartmentPoint `protobuf:"bytes,9,rep,name=compartments"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ReeferHistoricPoint) Reset() {
+	*x = ReeferHistoricPoint{}
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReeferHistoricPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReeferHistoricPoint) ProtoMessage() {}
+
+func (x *ReeferHistoricPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReeferHistoricPoint) GetReefer() *Reefer {
+	if x != nil {
+		return x.xxx_hidden_Reefer
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPoint) GetFlags() *ReeferValue {
+	if x != nil {
+		return x.xxx_hidden_Flags
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPoint) GetStandbyHours() *ReeferValue {
+	if x != nil {
+		return x.xxx_hidden_StandbyHours
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPoint) GetDieselHours() *ReeferValue {
+	if x != nil {
+		return x.xxx_hidden_DieselHours
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPoint) GetElectricHours() *ReeferValue {
+	if x != nil {
+		return x.xxx_hidden_ElectricHours
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPoint) GetRunMode() *ReeferValue {
+	if x != nil {
+		return x.xxx_hidden_RunMode
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPoint) GetSpeedMode() *ReeferValue {
+	if x != nil {
+		return x.xxx_hidden_SpeedMode
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPoint) GetPowerMode() *ReeferValue {
+	if x != nil {
+		return x.xxx_hidden_PowerMode
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPoint) GetCompartments() []*ReeferCompartmentPoint {
+	if x != nil {
+		if x.xxx_hidden_Compartments != nil {
+			return *x.xxx_hidden_Compartments
+		}
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPoint) SetReefer(v *Reefer) {
+	x.xxx_hidden_Reefer = v
+}
+
+func (x *ReeferHistoricPoint) SetFlags(v *ReeferValue) {
+	x.xxx_hidden_Flags = v
+}
+
+func (x *ReeferHistoricPoint) SetStandbyHours(v *ReeferValue) {
+	x.xxx_hidden_StandbyHours = v
+}
+
+func (x *ReeferHistoricPoint) SetDieselHours(v *ReeferValue) {
+	x.xxx_hidden_DieselHours = v
+}
+
+func (x *ReeferHistoricPoint) SetElectricHours(v *ReeferValue) {
+	x.xxx_hidden_ElectricHours = v
+}
+
+func (x *ReeferHistoricPoint) SetRunMode(v *ReeferValue) {
+	x.xxx_hidden_RunMode = v
+}
+
+func (x *ReeferHistoricPoint) SetSpeedMode(v *ReeferValue) {
+	x.xxx_hidden_SpeedMode = v
+}
+
+func (x *ReeferHistoricPoint) SetPowerMode(v *ReeferValue) {
+	x.xxx_hidden_PowerMode = v
+}
+
+func (x *ReeferHistoricPoint) SetCompartments(v []*ReeferCompartmentPoint) {
+	x.xxx_hidden_Compartments = &v
+}
+
+func (x *ReeferHistoricPoint) HasReefer() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Reefer != nil
+}
+
+func (x *ReeferHistoricPoint) HasFlags() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Flags != nil
+}
+
+func (x *ReeferHistoricPoint) HasStandbyHours() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StandbyHours != nil
+}
+
+func (x *ReeferHistoricPoint) HasDieselHours() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DieselHours != nil
+}
+
+func (x *ReeferHistoricPoint) HasElectricHours() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ElectricHours != nil
+}
+
+func (x *ReeferHistoricPoint) HasRunMode() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RunMode != nil
+}
+
+func (x *ReeferHistoricPoint) HasSpeedMode() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_SpeedMode != nil
+}
+
+func (x *ReeferHistoricPoint) HasPowerMode() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PowerMode != nil
+}
+
+func (x *ReeferHistoricPoint) ClearReefer() {
+	x.xxx_hidden_Reefer = nil
+}
+
+func (x *ReeferHistoricPoint) ClearFlags() {
+	x.xxx_hidden_Flags = nil
+}
+
+func (x *ReeferHistoricPoint) ClearStandbyHours() {
+	x.xxx_hidden_StandbyHours = nil
+}
+
+func (x *ReeferHistoricPoint) ClearDieselHours() {
+	x.xxx_hidden_DieselHours = nil
+}
+
+func (x *ReeferHistoricPoint) ClearElectricHours() {
+	x.xxx_hidden_ElectricHours = nil
+}
+
+func (x *ReeferHistoricPoint) ClearRunMode() {
+	x.xxx_hidden_RunMode = nil
+}
+
+func (x *ReeferHistoricPoint) ClearSpeedMode() {
+	x.xxx_hidden_SpeedMode = nil
+}
+
+func (x *ReeferHistoricPoint) ClearPowerMode() {
+	x.xxx_hidden_PowerMode = nil
+}
+
+type ReeferHistoricPoint_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Static refrigerator information.
+	Reefer *Reefer
+	// Refrigerator flags bitmask.
+	Flags *ReeferValue
+	// Engine hours in standby.
+	StandbyHours *ReeferValue
+	// Engine hours on diesel.
+	DieselHours *ReeferValue
+	// Engine hours on electric power.
+	ElectricHours *ReeferValue
+	// Run mode of the refrigerator.
+	RunMode *ReeferValue
+	// Speed mode of the refrigerator.
+	SpeedMode *ReeferValue
+	// Power mode of the refrigerator.
+	PowerMode *ReeferValue
+	// Readings per compartment.
+	Compartments []*ReeferCompartmentPoint
+}
+
+func (b0 ReeferHistoricPoint_builder) Build() *ReeferHistoricPoint {
+	m0 := &ReeferHistoricPoint{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Reefer = b.Reefer
+	x.xxx_hidden_Flags = b.Flags
+	x.xxx_hidden_StandbyHours = b.StandbyHours
+	x.xxx_hidden_DieselHours = b.DieselHours
+	x.xxx_hidden_ElectricHours = b.ElectricHours
+	x.xxx_hidden_RunMode = b.RunMode
+	x.xxx_hidden_SpeedMode = b.SpeedMode
+	x.xxx_hidden_PowerMode = b.PowerMode
+	x.xxx_hidden_Compartments = &b.Compartments
+	return m0
+}
+
+// ReeferCompartmentPeriod contains the readings of a single compartment over a period.
+type ReeferCompartmentPeriod struct {
+	state                                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Compartment                   int32                  `protobuf:"varint,1,opt,name=compartment"`
+	xxx_hidden_States                        *[]*ReeferStateValue   `protobuf:"bytes,2,rep,name=states"`
+	xxx_hidden_SupplyTemperatures            *[]*TemperatureRecord  `protobuf:"bytes,3,rep,name=supply_temperatures,json=supplyTemperatures"`
+	xxx_hidden_ReturnTemperatures            *[]*TemperatureRecord  `protobuf:"bytes,4,rep,name=return_temperatures,json=returnTemperatures"`
+	xxx_hidden_SetpointTemperatures          *[]*TemperatureRecord  `protobuf:"bytes,5,rep,name=setpoint_temperatures,json=setpointTemperatures"`
+	xxx_hidden_CargowatchAmbientTemperatures *[]*TemperatureRecord  `protobuf:"bytes,6,rep,name=cargowatch_ambient_temperatures,json=cargowatchAmbientTemperatures"`
+	xxx_hidden_CargowatchRearTemperatures    *[]*TemperatureRecord  `protobuf:"bytes,7,rep,name=cargowatch_rear_temperatures,json=cargowatchRearTemperatures"`
+	xxx_hidden_CargowatchReturnTemperatures  *[]*TemperatureRecord  `protobuf:"bytes,8,rep,name=cargowatch_return_temperatures,json=cargowatchReturnTemperatures"`
+	XXX_raceDetectHookData                   protoimpl.RaceDetectHookData
+	XXX_presence                             [1]uint32
+	unknownFields                            protoimpl.UnknownFields
+	sizeCache                                protoimpl.SizeCache
+}
+
+func (x *ReeferCompartmentPeriod) Reset() {
+	*x = ReeferCompartmentPeriod{}
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReeferCompartmentPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReeferCompartmentPeriod) ProtoMessage() {}
+
+func (x *ReeferCompartmentPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReeferCompartmentPeriod) GetCompartment() int32 {
+	if x != nil {
+		return x.xxx_hidden_Compartment
+	}
+	return 0
+}
+
+func (x *ReeferCompartmentPeriod) GetStates() []*ReeferStateValue {
+	if x != nil {
+		if x.xxx_hidden_States != nil {
+			return *x.xxx_hidden_States
+		}
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPeriod) GetSupplyTemperatures() []*TemperatureRecord {
+	if x != nil {
+		if x.xxx_hidden_SupplyTemperatures != nil {
+			return *x.xxx_hidden_SupplyTemperatures
+		}
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPeriod) GetReturnTemperatures() []*TemperatureRecord {
+	if x != nil {
+		if x.xxx_hidden_ReturnTemperatures != nil {
+			return *x.xxx_hidden_ReturnTemperatures
+		}
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPeriod) GetSetpointTemperatures() []*TemperatureRecord {
+	if x != nil {
+		if x.xxx_hidden_SetpointTemperatures != nil {
+			return *x.xxx_hidden_SetpointTemperatures
+		}
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPeriod) GetCargowatchAmbientTemperatures() []*TemperatureRecord {
+	if x != nil {
+		if x.xxx_hidden_CargowatchAmbientTemperatures != nil {
+			return *x.xxx_hidden_CargowatchAmbientTemperatures
+		}
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPeriod) GetCargowatchRearTemperatures() []*TemperatureRecord {
+	if x != nil {
+		if x.xxx_hidden_CargowatchRearTemperatures != nil {
+			return *x.xxx_hidden_CargowatchRearTemperatures
+		}
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPeriod) GetCargowatchReturnTemperatures() []*TemperatureRecord {
+	if x != nil {
+		if x.xxx_hidden_CargowatchReturnTemperatures != nil {
+			return *x.xxx_hidden_CargowatchReturnTemperatures
+		}
+	}
+	return nil
+}
+
+func (x *ReeferCompartmentPeriod) SetCompartment(v int32) {
+	x.xxx_hidden_Compartment = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *ReeferCompartmentPeriod) SetStates(v []*ReeferStateValue) {
+	x.xxx_hidden_States = &v
+}
+
+func (x *ReeferCompartmentPeriod) SetSupplyTemperatures(v []*TemperatureRecord) {
+	x.xxx_hidden_SupplyTemperatures = &v
+}
+
+func (x *ReeferCompartmentPeriod) SetReturnTemperatures(v []*TemperatureRecord) {
+	x.xxx_hidden_ReturnTemperatures = &v
+}
+
+func (x *ReeferCompartmentPeriod) SetSetpointTemperatures(v []*TemperatureRecord) {
+	x.xxx_hidden_SetpointTemperatures = &v
+}
+
+func (x *ReeferCompartmentPeriod) SetCargowatchAmbientTemperatures(v []*TemperatureRecord) {
+	x.xxx_hidden_CargowatchAmbientTemperatures = &v
+}
+
+func (x *ReeferCompartmentPeriod) SetCargowatchRearTemperatures(v []*TemperatureRecord) {
+	x.xxx_hidden_CargowatchRearTemperatures = &v
+}
+
+func (x *ReeferCompartmentPeriod) SetCargowatchReturnTemperatures(v []*TemperatureRecord) {
+	x.xxx_hidden_CargowatchReturnTemperatures = &v
+}
+
+func (x *ReeferCompartmentPeriod) HasCompartment() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ReeferCompartmentPeriod) ClearCompartment() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Compartment = 0
+}
+
+type ReeferCompartmentPeriod_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Compartment number, starting from 0.
+	Compartment *int32
+	// State changes of the compartment.
+	States []*ReeferStateValue
+	// Supply air temperatures.
+	SupplyTemperatures []*TemperatureRecord
+	// Return air temperatures.
+	ReturnTemperatures []*TemperatureRecord
+	// Setpoint temperatures.
+	SetpointTemperatures []*TemperatureRecord
+	// Ambient temperatures measured by the CargoWatch sensor.
+	CargowatchAmbientTemperatures []*TemperatureRecord
+	// Rear temperatures measured by the CargoWatch sensor.
+	CargowatchRearTemperatures []*TemperatureRecord
+	// Return temperatures measured by the CargoWatch sensor.
+	CargowatchReturnTemperatures []*TemperatureRecord
+}
+
+func (b0 ReeferCompartmentPeriod_builder) Build() *ReeferCompartmentPeriod {
+	m0 := &ReeferCompartmentPeriod{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Compartment != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_Compartment = *b.Compartment
+	}
+	x.xxx_hidden_States = &b.States
+	x.xxx_hidden_SupplyTemperatures = &b.SupplyTemperatures
+	x.xxx_hidden_ReturnTemperatures = &b.ReturnTemperatures
+	x.xxx_hidden_SetpointTemperatures = &b.SetpointTemperatures
+	x.xxx_hidden_CargowatchAmbientTemperatures = &b.CargowatchAmbientTemperatures
+	x.xxx_hidden_CargowatchRearTemperatures = &b.CargowatchRearTemperatures
+	x.xxx_hidden_CargowatchReturnTemperatures = &b.CargowatchReturnTemperatures
+	return m0
+}
+
+// ReeferHistoricPeriod contains the reefer readings of a unit over a period.
+type ReeferHistoricPeriod struct {
+	state                    protoimpl.MessageState      `protogen:"opaque.v1"`
+	xxx_hidden_Reefer        *Reefer                     `protobuf:"bytes,1,opt,name=reefer"`
+	xxx_hidden_Flags         *[]*ReeferValue             `protobuf:"bytes,2,rep,name=flags"`
+	xxx_hidden_StandbyHours  *[]*ReeferValue             `protobuf:"bytes,3,rep,name=standby_hours,json=standbyHours"`
+	xxx_hidden_DieselHours   *[]*ReeferValue             `protobuf:"bytes,4,rep,name=diesel_hours,json=dieselHours"`
+	xxx_hidden_ElectricHours *[]*ReeferValue             `protobuf:"bytes,5,rep,name=electric_hours,json=electricHours"`
+	xxx_hidden_RunModes      *[]*ReeferValue             `protobuf:"bytes,6,rep,name=run_modes,json=runModes"`
+	xxx_hidden_SpeedModes    *[]*ReeferValue             `protobuf:"bytes,7,rep,name=speed_modes,json=speedModes"`
+	xxx_hidden_PowerModes    *[]*ReeferValue             `protobuf:"bytes,8,rep,name=power_modes,json=powerModes"`
+	xxx_hidden_Compartments  *[]*ReeferCompartmentPeriod `protobuf:"bytes,9,rep,name=compartments"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ReeferHistoricPeriod) Reset() {
+	*x = ReeferHistoricPeriod{}
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReeferHistoricPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReeferHistoricPeriod) ProtoMessage() {}
+
+func (x *ReeferHistoricPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReeferHistoricPeriod) GetReefer() *Reefer {
+	if x != nil {
+		return x.xxx_hidden_Reefer
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPeriod) GetFlags() []*ReeferValue {
+	if x != nil {
+		if x.xxx_hidden_Flags != nil {
+			return *x.xxx_hidden_Flags
+		}
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPeriod) GetStandbyHours() []*ReeferValue {
+	if x != nil {
+		if x.xxx_hidden_StandbyHours != nil {
+			return *x.xxx_hidden_StandbyHours
+		}
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPeriod) GetDieselHours() []*ReeferValue {
+	if x != nil {
+		if x.xxx_hidden_DieselHours != nil {
+			return *x.xxx_hidden_DieselHours
+		}
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPeriod) GetElectricHours() []*ReeferValue {
+	if x != nil {
+		if x.xxx_hidden_ElectricHours != nil {
+			return *x.xxx_hidden_ElectricHours
+		}
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPeriod) GetRunModes() []*ReeferValue {
+	if x != nil {
+		if x.xxx_hidden_RunModes != nil {
+			return *x.xxx_hidden_RunModes
+		}
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPeriod) GetSpeedModes() []*ReeferValue {
+	if x != nil {
+		if x.xxx_hidden_SpeedModes != nil {
+			return *x.xxx_hidden_SpeedModes
+		}
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPeriod) GetPowerModes() []*ReeferValue {
+	if x != nil {
+		if x.xxx_hidden_PowerModes != nil {
+			return *x.xxx_hidden_PowerModes
+		}
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPeriod) GetCompartments() []*ReeferCompartmentPeriod {
+	if x != nil {
+		if x.xxx_hidden_Compartments != nil {
+			return *x.xxx_hidden_Compartments
+		}
+	}
+	return nil
+}
+
+func (x *ReeferHistoricPeriod) SetReefer(v *Reefer) {
+	x.xxx_hidden_Reefer = v
+}
+
+func (x *ReeferHistoricPeriod) SetFlags(v []*ReeferValue) {
+	x.xxx_hidden_Flags = &v
+}
+
+func (x *ReeferHistoricPeriod) SetStandbyHours(v []*ReeferValue) {
+	x.xxx_hidden_StandbyHours = &v
+}
+
+func (x *ReeferHistoricPeriod) SetDieselHours(v []*ReeferValue) {
+	x.xxx_hidden_DieselHours = &v
+}
+
+func (x *ReeferHistoricPeriod) SetElectricHours(v []*ReeferValue) {
+	x.xxx_hidden_ElectricHours = &v
+}
+
+func (x *ReeferHistoricPeriod) SetRunModes(v []*ReeferValue) {
+	x.xxx_hidden_RunModes = &v
+}
+
+func (x *ReeferHistoricPeriod) SetSpeedModes(v []*ReeferValue) {
+	x.xxx_hidden_SpeedModes = &v
+}
+
+func (x *ReeferHistoricPeriod) SetPowerModes(v []*ReeferValue) {
+	x.xxx_hidden_PowerModes = &v
+}
+
+func (x *ReeferHistoricPeriod) SetCompartments(v []*ReeferCompartmentPeriod) {
+	x.xxx_hidden_Compartments = &v
+}
+
+func (x *ReeferHistoricPeriod) HasReefer() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Reefer != nil
+}
+
+func (x *ReeferHistoricPeriod) ClearReefer() {
+	x.xxx_hidden_Reefer = nil
+}
+
+type ReeferHistoricPeriod_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Static refrigerator information.
+	Reefer *Reefer
+	// Refrigerator flags bitmask readings.
+	Flags []*ReeferValue
+	// Engine hours in standby.
+	StandbyHours []*ReeferValue
+	// Engine hours on diesel.
+	DieselHours []*ReeferValue
+	// Engine hours on electric power.
+	ElectricHours []*ReeferValue
+	// Run mode changes of the refrigerator.
+	RunModes []*ReeferValue
+	// Speed mode changes of the refrigerator.
+	SpeedModes []*ReeferValue
+	// Power mode changes of the refrigerator.
+	PowerModes []*ReeferValue
+	// Readings per compartment.
+	Compartments []*ReeferCompartmentPeriod
+}
+
+func (b0 ReeferHistoricPeriod_builder) Build() *ReeferHistoricPeriod {
+	m0 := &ReeferHistoricPeriod{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Reefer = b.Reefer
+	x.xxx_hidden_Flags = &b.Flags
+	x.xxx_hidden_StandbyHours = &b.StandbyHours
+	x.xxx_hidden_DieselHours = &b.DieselHours
+	x.xxx_hidden_ElectricHours = &b.ElectricHours
+	x.xxx_hidden_RunModes = &b.RunModes
+	x.xxx_hidden_SpeedModes = &b.SpeedModes
+	x.xxx_hidden_PowerModes = &b.PowerModes
+	x.xxx_hidden_Compartments = &b.Compartments
+	return m0
+}
+
+// ReeferRunMode represents a refrigerator run mode.
+type ReeferRunMode struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          int32                  `protobuf:"varint,1,opt,name=id"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReeferRunMode) Reset() {
+	*x = ReeferRunMode{}
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReeferRunMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReeferRunMode) ProtoMessage() {}
+
+func (x *ReeferRunMode) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReeferRunMode) GetId() int32 {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return 0
+}
+
+func (x *ReeferRunMode) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ReeferRunMode) SetId(v int32) {
+	x.xxx_hidden_Id = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ReeferRunMode) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ReeferRunMode) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ReeferRunMode) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ReeferRunMode) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = 0
+}
+
+func (x *ReeferRunMode) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+type ReeferRunMode_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Identifier of the run mode.
+	Id *int32
+	// Name of the run mode (e.g., "Continuous", "Cycle Sentry").
+	Name *string
+}
+
+func (b0 ReeferRunMode_builder) Build() *ReeferRunMode {
+	m0 := &ReeferRunMode{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = *b.Id
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Name = b.Name
+	}
+	return m0
+}
+
+// UnitReeferRunModes contains the current and supported run modes of a unit's refrigerator.
+type UnitReeferRunModes struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UnitId      int64                  `protobuf:"varint,1,opt,name=unit_id,json=unitId"`
+	xxx_hidden_Current     *ReeferRunMode         `protobuf:"bytes,2,opt,name=current"`
+	xxx_hidden_Supported   *[]*ReeferRunMode      `protobuf:"bytes,3,rep,name=supported"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UnitReeferRunModes) Reset() {
+	*x = UnitReeferRunModes{}
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitReeferRunModes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitReeferRunModes) ProtoMessage() {}
+
+func (x *UnitReeferRunModes) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UnitReeferRunModes) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *UnitReeferRunModes) GetCurrent() *ReeferRunMode {
+	if x != nil {
+		return x.xxx_hidden_Current
+	}
+	return nil
+}
+
+func (x *UnitReeferRunModes) GetSupported() []*ReeferRunMode {
+	if x != nil {
+		if x.xxx_hidden_Supported != nil {
+			return *x.xxx_hidden_Supported
+		}
+	}
+	return nil
+}
+
+func (x *UnitReeferRunModes) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *UnitReeferRunModes) SetCurrent(v *ReeferRunMode) {
+	x.xxx_hidden_Current = v
+}
+
+func (x *UnitReeferRunModes) SetSupported(v []*ReeferRunMode) {
+	x.xxx_hidden_Supported = &v
+}
+
+func (x *UnitReeferRunModes) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UnitReeferRunModes) HasCurrent() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Current != nil
+}
+
+func (x *UnitReeferRunModes) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *UnitReeferRunModes) ClearCurrent() {
+	x.xxx_hidden_Current = nil
+}
+
+type UnitReeferRunModes_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the unit.
+	UnitId *int64
+	// Current run mode of the refrigerator.
+	Current *ReeferRunMode
+	// Run modes supported by the refrigerator.
+	Supported []*ReeferRunMode
+}
+
+func (b0 UnitReeferRunModes_builder) Build() *UnitReeferRunModes {
+	m0 := &UnitReeferRunModes{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	x.xxx_hidden_Current = b.Current
+	x.xxx_hidden_Supported = &b.Supported
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_reefer_data_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_reefer_data_proto_rawDesc = "" +
+	"\n" +
+	".wayplatform/connect/mapon/v1/reefer_data.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)wayplatform/connect/mapon/v1/reefer.proto\x1a5wayplatform/connect/mapon/v1/temperature_record.proto\"S\n" +
+	"\vReeferValue\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"X\n" +
+	"\x10ReeferStateValue\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"\x81\x06\n" +
+	"\x16ReeferCompartmentPoint\x12 \n" +
+	"\vcompartment\x18\x01 \x01(\x05R\vcompartment\x12D\n" +
+	"\x05state\x18\x02 \x01(\v2..wayplatform.connect.mapon.v1.ReeferStateValueR\x05state\x12^\n" +
+	"\x12supply_temperature\x18\x03 \x01(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x11supplyTemperature\x12^\n" +
+	"\x12return_temperature\x18\x04 \x01(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x11returnTemperature\x12b\n" +
+	"\x14setpoint_temperature\x18\x05 \x01(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x13setpointTemperature\x12u\n" +
+	"\x1ecargowatch_ambient_temperature\x18\x06 \x01(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x1ccargowatchAmbientTemperature\x12o\n" +
+	"\x1bcargowatch_rear_temperature\x18\a \x01(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x19cargowatchRearTemperature\x12s\n" +
+	"\x1dcargowatch_return_temperature\x18\b \x01(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x1bcargowatchReturnTemperature\"\xb8\x05\n" +
+	"\x13ReeferHistoricPoint\x12<\n" +
+	"\x06reefer\x18\x01 \x01(\v2$.wayplatform.connect.mapon.v1.ReeferR\x06reefer\x12?\n" +
+	"\x05flags\x18\x02 \x01(\v2).wayplatform.connect.mapon.v1.ReeferValueR\x05flags\x12N\n" +
+	"\rstandby_hours\x18\x03 \x01(\v2).wayplatform.connect.mapon.v1.ReeferValueR\fstandbyHours\x12L\n" +
+	"\fdiesel_hours\x18\x04 \x01(\v2).wayplatform.connect.mapon.v1.ReeferValueR\vdieselHours\x12P\n" +
+	"\x0eelectric_hours\x18\x05 \x01(\v2).wayplatform.connect.mapon.v1.ReeferValueR\relectricHours\x12D\n" +
+	"\brun_mode\x18\x06 \x01(\v2).wayplatform.connect.mapon.v1.ReeferValueR\arunMode\x12H\n" +
+	"\n" +
+	"speed_mode\x18\a \x01(\v2).wayplatform.connect.mapon.v1.ReeferValueR\tspeedMode\x12H\n" +
+	"\n" +
+	"power_mode\x18\b \x01(\v2).wayplatform.connect.mapon.v1.ReeferValueR\tpowerMode\x12X\n" +
+	"\fcompartments\x18\t \x03(\v24.wayplatform.connect.mapon.v1.ReeferCompartmentPointR\fcompartments\"\x90\x06\n" +
+	"\x17ReeferCompartmentPeriod\x12 \n" +
+	"\vcompartment\x18\x01 \x01(\x05R\vcompartment\x12F\n" +
+	"\x06states\x18\x02 \x03(\v2..wayplatform.connect.mapon.v1.ReeferStateValueR\x06states\x12`\n" +
+	"\x13supply_temperatures\x18\x03 \x03(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x12supplyTemperatures\x12`\n" +
+	"\x13return_temperatures\x18\x04 \x03(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x12returnTemperatures\x12d\n" +
+	"\x15setpoint_temperatures\x18\x05 \x03(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x14setpointTemperatures\x12w\n" +
+	"\x1fcargowatch_ambient_temperatures\x18\x06 \x03(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x1dcargowatchAmbientTemperatures\x12q\n" +
+	"\x1ccargowatch_rear_temperatures\x18\a \x03(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x1acargowatchRearTemperatures\x12u\n" +
+	"\x1ecargowatch_return_temperatures\x18\b \x03(\v2/.wayplatform.connect.mapon.v1.TemperatureRecordR\x1ccargowatchReturnTemperatures\"\xc0\x05\n" +
+	"\x14ReeferHistoricPeriod\x12<\n" +
+	"\x06reefer\x18\x01 \x01(\v2$.wayplatform.connect.mapon.v1.ReeferR\x06reefer\x12?\n" +
+	"\x05flags\x18\x02 \x03(\v2).wayplatform.connect.mapon.v1.ReeferValueR\x05flags\x12N\n" +
+	"\rstandby_hours\x18\x03 \x03(\v2).wayplatform.connect.mapon.v1.ReeferValueR\fstandbyHours\x12L\n" +
+	"\fdiesel_hours\x18\x04 \x03(\v2).wayplatform.connect.mapon.v1.ReeferValueR\vdieselHours\x12P\n" +
+	"\x0eelectric_hours\x18\x05 \x03(\v2).wayplatform.connect.mapon.v1.ReeferValueR\relectricHours\x12F\n" +
+	"\trun_modes\x18\x06 \x03(\v2).wayplatform.connect.mapon.v1.ReeferValueR\brunModes\x12J\n" +
+	"\vspeed_modes\x18\a \x03(\v2).wayplatform.connect.mapon.v1.ReeferValueR\n" +
+	"speedModes\x12J\n" +
+	"\vpower_modes\x18\b \x03(\v2).wayplatform.connect.mapon.v1.ReeferValueR\n" +
+	"powerModes\x12Y\n" +
+	"\fcompartments\x18\t \x03(\v25.wayplatform.connect.mapon.v1.ReeferCompartmentPeriodR\fcompartments\"3\n" +
+	"\rReeferRunMode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xbf\x01\n" +
+	"\x12UnitReeferRunModes\x12\x17\n" +
+	"\aunit_id\x18\x01 \x01(\x03R\x06unitId\x12E\n" +
+	"\acurrent\x18\x02 \x01(\v2+.wayplatform.connect.mapon.v1.ReeferRunModeR\acurrent\x12I\n" +
+	"\tsupported\x18\x03 \x03(\v2+.wayplatform.connect.mapon.v1.ReeferRunModeR\tsupported*\xdc\x01\n" +
+	"\x1cReeferHistoricPointSelection\x12/\n" +
+	"+REEFER_HISTORIC_POINT_SELECTION_UNSPECIFIED\x10\x00\x120\n" +
+	",REEFER_HISTORIC_POINT_SELECTION_UNRECOGNIZED\x10\x01\x12,\n" +
+	"(REEFER_HISTORIC_POINT_SELECTION_PREVIOUS\x10\x02\x12+\n" +
+	"'REEFER_HISTORIC_POINT_SELECTION_NEAREST\x10\x03B\x9a\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x0fReeferDataProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_reefer_data_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_wayplatform_connect_mapon_v1_reefer_data_proto_goTypes = []any{
+	(ReeferHistoricPointSelection)(0), // 0: wayplatform.connect.mapon.v1.ReeferHistoricPointSelection
+	(*ReeferValue)(nil),               // 1: wayplatform.connect.mapon.v1.ReeferValue
+	(*ReeferStateValue)(nil),          // 2: wayplatform.connect.mapon.v1.ReeferStateValue
+	(*ReeferCompartmentPoint)(nil),    // 3: wayplatform.connect.mapon.v1.ReeferCompartmentPoint
+	(*ReeferHistoricPoint)(nil),       // 4: wayplatform.connect.mapon.v1.ReeferHistoricPoint
+	(*ReeferCompartmentPeriod)(nil),   // 5: wayplatform.connect.mapon.v1.ReeferCompartmentPeriod
+	(*ReeferHistoricPeriod)(nil),      // 6: wayplatform.connect.mapon.v1.ReeferHistoricPeriod
+	(*ReeferRunMode)(nil),             // 7: wayplatform.connect.mapon.v1.ReeferRunMode
+	(*UnitReeferRunModes)(nil),        // 8: wayplatform.connect.mapon.v1.UnitReeferRunModes
+	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
+	(*TemperatureRecord)(nil),         // 10: wayplatform.connect.mapon.v1.TemperatureRecord
+	(*Reefer)(nil),                    // 11: wayplatform.connect.mapon.v1.Reefer
+}
+var file_wayplatform_connect_mapon_v1_reefer_data_proto_depIdxs = []int32{
+	9,  // 0: wayplatform.connect.mapon.v1.ReeferValue.time:type_name -> google.protobuf.Timestamp
+	9,  // 1: wayplatform.connect.mapon.v1.ReeferStateValue.time:type_name -> google.protobuf.Timestamp
+	2,  // 2: wayplatform.connect.mapon.v1.ReeferCompartmentPoint.state:type_name -> wayplatform.connect.mapon.v1.ReeferStateValue
+	10, // 3: wayplatform.connect.mapon.v1.ReeferCompartmentPoint.supply_temperature:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	10, // 4: wayplatform.connect.mapon.v1.ReeferCompartmentPoint.return_temperature:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	10, // 5: wayplatform.connect.mapon.v1.ReeferCompartmentPoint.setpoint_temperature:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	10, // 6: wayplatform.connect.mapon.v1.ReeferCompartmentPoint.cargowatch_ambient_temperature:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	10, // 7: wayplatform.connect.mapon.v1.ReeferCompartmentPoint.cargowatch_rear_temperature:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	10, // 8: wayplatform.connect.mapon.v1.ReeferCompartmentPoint.cargowatch_return_temperature:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	11, // 9: wayplatform.connect.mapon.v1.ReeferHistoricPoint.reefer:type_name -> wayplatform.connect.mapon.v1.Reefer
+	1,  // 10: wayplatform.connect.mapon.v1.ReeferHistoricPoint.flags:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	1,  // 11: wayplatform.connect.mapon.v1.ReeferHistoricPoint.standby_hours:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	1,  // 12: wayplatform.connect.mapon.v1.ReeferHistoricPoint.diesel_hours:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	1,  // 13: wayplatform.connect.mapon.v1.ReeferHistoricPoint.electric_hours:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	1,  // 14: wayplatform.connect.mapon.v1.ReeferHistoricPoint.run_mode:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	1,  // 15: wayplatform.connect.mapon.v1.ReeferHistoricPoint.speed_mode:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	1,  // 16: wayplatform.connect.mapon.v1.ReeferHistoricPoint.power_mode:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	3,  // 17: wayplatform.connect.mapon.v1.ReeferHistoricPoint.compartments:type_name -> wayplatform.connect.mapon.v1.ReeferCompartmentPoint
+	2,  // 18: wayplatform.connect.mapon.v1.ReeferCompartmentPeriod.states:type_name -> wayplatform.connect.mapon.v1.ReeferStateValue
+	10, // 19: wayplatform.connect.mapon.v1.ReeferCompartmentPeriod.supply_temperatures:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	10, // 20: wayplatform.connect.mapon.v1.ReeferCompartmentPeriod.return_temperatures:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	10, // 21: wayplatform.connect.mapon.v1.ReeferCompartmentPeriod.setpoint_temperatures:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	10, // 22: wayplatform.connect.mapon.v1.ReeferCompartmentPeriod.cargowatch_ambient_temperatures:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	10, // 23: wayplatform.connect.mapon.v1.ReeferCompartmentPeriod.cargowatch_rear_temperatures:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	10, // 24: wayplatform.connect.mapon.v1.ReeferCompartmentPeriod.cargowatch_return_temperatures:type_name -> wayplatform.connect.mapon.v1.TemperatureRecord
+	11, // 25: wayplatform.connect.mapon.v1.ReeferHistoricPeriod.reefer:type_name -> wayplatform.connect.mapon.v1.Reefer
+	1,  // 26: wayplatform.connect.mapon.v1.ReeferHistoricPeriod.flags:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	1,  // 27: wayplatform.connect.mapon.v1.ReeferHistoricPeriod.standby_hours:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	1,  // 28: wayplatform.connect.mapon.v1.ReeferHistoricPeriod.diesel_hours:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	1,  // 29: wayplatform.connect.mapon.v1.ReeferHistoricPeriod.electric_hours:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	1,  // 30: wayplatform.connect.mapon.v1.ReeferHistoricPeriod.run_modes:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	1,  // 31: wayplatform.connect.mapon.v1.ReeferHistoricPeriod.speed_modes:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	1,  // 32: wayplatform.connect.mapon.v1.ReeferHistoricPeriod.power_modes:type_name -> wayplatform.connect.mapon.v1.ReeferValue
+	5,  // 33: wayplatform.connect.mapon.v1.ReeferHistoricPeriod.compartments:type_name -> wayplatform.connect.mapon.v1.ReeferCompartmentPeriod
+	7,  // 34: wayplatform.connect.mapon.v1.UnitReeferRunModes.current:type_name -> wayplatform.connect.mapon.v1.ReeferRunMode
+	7,  // 35: wayplatform.connect.mapon.v1.UnitReeferRunModes.supported:type_name -> wayplatform.connect.mapon.v1.ReeferRunMode
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_reefer_data_proto_init() }
+func file_wayplatform_connect_mapon_v1_reefer_data_proto_init() {
+	if File_wayplatform_connect_mapon_v1_reefer_data_proto != nil {
+		return
+	}
+	file_wayplatform_connect_mapon_v1_reefer_proto_init()
+	file_wayplatform_connect_mapon_v1_temperature_record_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_reefer_data_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_reefer_data_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_reefer_data_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_reefer_data_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_reefer_data_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_reefer_data_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_reefer_data_proto = out.File
+	file_wayplatform_connect_mapon_v1_reefer_data_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_reefer_data_proto_depIdxs = nil
+}
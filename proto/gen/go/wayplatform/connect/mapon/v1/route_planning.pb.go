@@ -0,0 +1,3617 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/route_planning.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RoutePlanningOptimizationStatus represents the status of a route optimization.
+type RoutePlanningOptimizationStatus int32
+
+const (
+	// Default value, used when the status is missing or not set.
+	RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_UNSPECIFIED RoutePlanningOptimizationStatus = 0
+	// Used when the received value does not match any known enum member.
+	RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_UNRECOGNIZED RoutePlanningOptimizationStatus = 1
+	// The optimization has been queued.
+	RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_WAITING RoutePlanningOptimizationStatus = 2
+	// The optimization is running.
+	RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_RUNNING RoutePlanningOptimizationStatus = 3
+	// The optimization has finished successfully.
+	RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_FINISHED RoutePlanningOptimizationStatus = 4
+	// The optimization was canceled.
+	RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_CANCELED RoutePlanningOptimizationStatus = 5
+	// The optimization failed.
+	RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_FAILED RoutePlanningOptimizationStatus = 6
+	// No route satisfying the constraints was found.
+	RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_NO_SOLUTION RoutePlanningOptimizationStatus = 7
+	// Some of the places cannot be reached.
+	RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_UNREACHABLE_POINTS RoutePlanningOptimizationStatus = 8
+	// There was nothing to optimize.
+	RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_NOTHING_TO_OPTIMIZE RoutePlanningOptimizationStatus = 9
+)
+
+// Enum value maps for RoutePlanningOptimizationStatus.
+var (
+	RoutePlanningOptimizationStatus_name = map[int32]string{
+		0: "ROUTE_PLANNING_OPTIMIZATION_STATUS_UNSPECIFIED",
+		1: "ROUTE_PLANNING_OPTIMIZATION_STATUS_UNRECOGNIZED",
+		2: "ROUTE_PLANNING_OPTIMIZATION_STATUS_WAITING",
+		3: "ROUTE_PLANNING_OPTIMIZATION_STATUS_RUNNING",
+		4: "ROUTE_PLANNING_OPTIMIZATION_STATUS_FINISHED",
+		5: "ROUTE_PLANNING_OPTIMIZATION_STATUS_CANCELED",
+		6: "ROUTE_PLANNING_OPTIMIZATION_STATUS_FAILED",
+		7: "ROUTE_PLANNING_OPTIMIZATION_STATUS_NO_SOLUTION",
+		8: "ROUTE_PLANNING_OPTIMIZATION_STATUS_UNREACHABLE_POINTS",
+		9: "ROUTE_PLANNING_OPTIMIZATION_STATUS_NOTHING_TO_OPTIMIZE",
+	}
+	RoutePlanningOptimizationStatus_value = map[string]int32{
+		"ROUTE_PLANNING_OPTIMIZATION_STATUS_UNSPECIFIED":         0,
+		"ROUTE_PLANNING_OPTIMIZATION_STATUS_UNRECOGNIZED":        1,
+		"ROUTE_PLANNING_OPTIMIZATION_STATUS_WAITING":             2,
+		"ROUTE_PLANNING_OPTIMIZATION_STATUS_RUNNING":             3,
+		"ROUTE_PLANNING_OPTIMIZATION_STATUS_FINISHED":            4,
+		"ROUTE_PLANNING_OPTIMIZATION_STATUS_CANCELED":            5,
+		"ROUTE_PLANNING_OPTIMIZATION_STATUS_FAILED":              6,
+		"ROUTE_PLANNING_OPTIMIZATION_STATUS_NO_SOLUTION":         7,
+		"ROUTE_PLANNING_OPTIMIZATION_STATUS_UNREACHABLE_POINTS":  8,
+		"ROUTE_PLANNING_OPTIMIZATION_STATUS_NOTHING_TO_OPTIMIZE": 9,
+	}
+)
+
+func (x RoutePlanningOptimizationStatus) Enum() *RoutePlanningOptimizationStatus {
+	p := new(RoutePlanningOptimizationStatus)
+	*p = x
+	return p
+}
+
+func (x RoutePlanningOptimizationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoutePlanningOptimizationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_route_planning_proto_enumTypes[0].Descriptor()
+}
+
+func (RoutePlanningOptimizationStatus) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_route_planning_proto_enumTypes[0]
+}
+
+func (x RoutePlanningOptimizationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Type of a route planning order.
+type RoutePlanningOrder_Type int32
+
+const (
+	// Default value, used when the type is missing or not set.
+	RoutePlanningOrder_TYPE_UNSPECIFIED RoutePlanningOrder_Type = 0
+	// Used when the received value does not match any known enum member.
+	RoutePlanningOrder_TYPE_UNRECOGNIZED RoutePlanningOrder_Type = 1
+	// An order with places to visit.
+	RoutePlanningOrder_TYPE_VISIT RoutePlanningOrder_Type = 2
+	// An order with pickup and delivery places.
+	RoutePlanningOrder_TYPE_PICKUP_DELIVERY RoutePlanningOrder_Type = 3
+)
+
+// Enum value maps for RoutePlanningOrder_Type.
+var (
+	RoutePlanningOrder_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_UNRECOGNIZED",
+		2: "TYPE_VISIT",
+		3: "TYPE_PICKUP_DELIVERY",
+	}
+	RoutePlanningOrder_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":     0,
+		"TYPE_UNRECOGNIZED":    1,
+		"TYPE_VISIT":           2,
+		"TYPE_PICKUP_DELIVERY": 3,
+	}
+)
+
+func (x RoutePlanningOrder_Type) Enum() *RoutePlanningOrder_Type {
+	p := new(RoutePlanningOrder_Type)
+	*p = x
+	return p
+}
+
+func (x RoutePlanningOrder_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoutePlanningOrder_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_route_planning_proto_enumTypes[1].Descriptor()
+}
+
+func (RoutePlanningOrder_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_route_planning_proto_enumTypes[1]
+}
+
+func (x RoutePlanningOrder_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Type of a route planning place.
+type RoutePlanningPlace_Type int32
+
+const (
+	// Default value, used when the type is missing or not set.
+	RoutePlanningPlace_TYPE_UNSPECIFIED RoutePlanningPlace_Type = 0
+	// Used when the received value does not match any known enum member.
+	RoutePlanningPlace_TYPE_UNRECOGNIZED RoutePlanningPlace_Type = 1
+	// A place to visit.
+	RoutePlanningPlace_TYPE_VISIT RoutePlanningPlace_Type = 2
+	// A place to pick up cargo.
+	RoutePlanningPlace_TYPE_PICKUP RoutePlanningPlace_Type = 3
+	// A place to deliver cargo.
+	RoutePlanningPlace_TYPE_DELIVERY RoutePlanningPlace_Type = 4
+)
+
+// Enum value maps for RoutePlanningPlace_Type.
+var (
+	RoutePlanningPlace_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_UNRECOGNIZED",
+		2: "TYPE_VISIT",
+		3: "TYPE_PICKUP",
+		4: "TYPE_DELIVERY",
+	}
+	RoutePlanningPlace_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":  0,
+		"TYPE_UNRECOGNIZED": 1,
+		"TYPE_VISIT":        2,
+		"TYPE_PICKUP":       3,
+		"TYPE_DELIVERY":     4,
+	}
+)
+
+func (x RoutePlanningPlace_Type) Enum() *RoutePlanningPlace_Type {
+	p := new(RoutePlanningPlace_Type)
+	*p = x
+	return p
+}
+
+func (x RoutePlanningPlace_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoutePlanningPlace_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_route_planning_proto_enumTypes[2].Descriptor()
+}
+
+func (RoutePlanningPlace_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_route_planning_proto_enumTypes[2]
+}
+
+func (x RoutePlanningPlace_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Status of a route planning place.
+type RoutePlanningPlace_Status int32
+
+const (
+	// Default value, used when the status is missing or not set.
+	RoutePlanningPlace_STATUS_UNSPECIFIED RoutePlanningPlace_Status = 0
+	// Used when the received value does not match any known enum member.
+	RoutePlanningPlace_STATUS_UNRECOGNIZED RoutePlanningPlace_Status = 1
+	// The place has not been visited yet.
+	RoutePlanningPlace_STATUS_NOT_STARTED RoutePlanningPlace_Status = 2
+	// The driver is on the way to the place.
+	RoutePlanningPlace_STATUS_STARTED RoutePlanningPlace_Status = 3
+	// The cargo is being loaded or unloaded.
+	RoutePlanningPlace_STATUS_LOADING RoutePlanningPlace_Status = 4
+	// The place has been completed.
+	RoutePlanningPlace_STATUS_COMPLETED RoutePlanningPlace_Status = 5
+	// The place has been declined.
+	RoutePlanningPlace_STATUS_DECLINED RoutePlanningPlace_Status = 6
+)
+
+// Enum value maps for RoutePlanningPlace_Status.
+var (
+	RoutePlanningPlace_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_UNRECOGNIZED",
+		2: "STATUS_NOT_STARTED",
+		3: "STATUS_STARTED",
+		4: "STATUS_LOADING",
+		5: "STATUS_COMPLETED",
+		6: "STATUS_DECLINED",
+	}
+	RoutePlanningPlace_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED":  0,
+		"STATUS_UNRECOGNIZED": 1,
+		"STATUS_NOT_STARTED":  2,
+		"STATUS_STARTED":      3,
+		"STATUS_LOADING":      4,
+		"STATUS_COMPLETED":    5,
+		"STATUS_DECLINED":     6,
+	}
+)
+
+func (x RoutePlanningPlace_Status) Enum() *RoutePlanningPlace_Status {
+	p := new(RoutePlanningPlace_Status)
+	*p = x
+	return p
+}
+
+func (x RoutePlanningPlace_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoutePlanningPlace_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_route_planning_proto_enumTypes[3].Descriptor()
+}
+
+func (RoutePlanningPlace_Status) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_route_planning_proto_enumTypes[3]
+}
+
+func (x RoutePlanningPlace_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// RoutePlanningDeliveryWindow represents the dates and times a place can be visited.
+type RoutePlanningDeliveryWindow struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FromDate    *string                `protobuf:"bytes,1,opt,name=from_date,json=fromDate"`
+	xxx_hidden_ToDate      *string                `protobuf:"bytes,2,opt,name=to_date,json=toDate"`
+	xxx_hidden_FromTime    *string                `protobuf:"bytes,3,opt,name=from_time,json=fromTime"`
+	xxx_hidden_ToTime      *string                `protobuf:"bytes,4,opt,name=to_time,json=toTime"`
+	xxx_hidden_Continuous  bool                   `protobuf:"varint,5,opt,name=continuous"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RoutePlanningDeliveryWindow) Reset() {
+	*x = RoutePlanningDeliveryWindow{}
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutePlanningDeliveryWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutePlanningDeliveryWindow) ProtoMessage() {}
+
+func (x *RoutePlanningDeliveryWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RoutePlanningDeliveryWindow) GetFromDate() string {
+	if x != nil {
+		if x.xxx_hidden_FromDate != nil {
+			return *x.xxx_hidden_FromDate
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningDeliveryWindow) GetToDate() string {
+	if x != nil {
+		if x.xxx_hidden_ToDate != nil {
+			return *x.xxx_hidden_ToDate
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningDeliveryWindow) GetFromTime() string {
+	if x != nil {
+		if x.xxx_hidden_FromTime != nil {
+			return *x.xxx_hidden_FromTime
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningDeliveryWindow) GetToTime() string {
+	if x != nil {
+		if x.xxx_hidden_ToTime != nil {
+			return *x.xxx_hidden_ToTime
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningDeliveryWindow) GetContinuous() bool {
+	if x != nil {
+		return x.xxx_hidden_Continuous
+	}
+	return false
+}
+
+func (x *RoutePlanningDeliveryWindow) SetFromDate(v string) {
+	x.xxx_hidden_FromDate = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *RoutePlanningDeliveryWindow) SetToDate(v string) {
+	x.xxx_hidden_ToDate = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *RoutePlanningDeliveryWindow) SetFromTime(v string) {
+	x.xxx_hidden_FromTime = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *RoutePlanningDeliveryWindow) SetToTime(v string) {
+	x.xxx_hidden_ToTime = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *RoutePlanningDeliveryWindow) SetContinuous(v bool) {
+	x.xxx_hidden_Continuous = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *RoutePlanningDeliveryWindow) HasFromDate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RoutePlanningDeliveryWindow) HasToDate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RoutePlanningDeliveryWindow) HasFromTime() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *RoutePlanningDeliveryWindow) HasToTime() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *RoutePlanningDeliveryWindow) HasContinuous() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *RoutePlanningDeliveryWindow) ClearFromDate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_FromDate = nil
+}
+
+func (x *RoutePlanningDeliveryWindow) ClearToDate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ToDate = nil
+}
+
+func (x *RoutePlanningDeliveryWindow) ClearFromTime() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_FromTime = nil
+}
+
+func (x *RoutePlanningDeliveryWindow) ClearToTime() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_ToTime = nil
+}
+
+func (x *RoutePlanningDeliveryWindow) ClearContinuous() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Continuous = false
+}
+
+type RoutePlanningDeliveryWindow_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// First day of the delivery period (YYYY-MM-DD), in the timezone of the place.
+	FromDate *string
+	// Last day of the delivery period (YYYY-MM-DD), in the timezone of the place.
+	ToDate *string
+	// Start of the daily delivery time (HH:MM).
+	FromTime *string
+	// End of the daily delivery time (HH:MM). May be earlier than the start for overnight deliveries.
+	ToTime *string
+	// Whether the delivery period is one continuous interval instead of daily delivery times.
+	Continuous *bool
+}
+
+func (b0 RoutePlanningDeliveryWindow_builder) Build() *RoutePlanningDeliveryWindow {
+	m0 := &RoutePlanningDeliveryWindow{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.FromDate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_FromDate = b.FromDate
+	}
+	if b.ToDate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_ToDate = b.ToDate
+	}
+	if b.FromTime != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_FromTime = b.FromTime
+	}
+	if b.ToTime != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_ToTime = b.ToTime
+	}
+	if b.Continuous != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Continuous = *b.Continuous
+	}
+	return m0
+}
+
+// RoutePlanningPlaceInput represents a place to add to a route planning order.
+type RoutePlanningPlaceInput struct {
+	state                                    protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Address                       *string                      `protobuf:"bytes,1,opt,name=address"`
+	xxx_hidden_ClientId                      int64                        `protobuf:"varint,2,opt,name=client_id,json=clientId"`
+	xxx_hidden_GeofenceRadiusM               int32                        `protobuf:"varint,3,opt,name=geofence_radius_m,json=geofenceRadiusM"`
+	xxx_hidden_CargoType                     *string                      `protobuf:"bytes,4,opt,name=cargo_type,json=cargoType"`
+	xxx_hidden_OptimalTemperatureFromCelsius int32                        `protobuf:"varint,5,opt,name=optimal_temperature_from_celsius,json=optimalTemperatureFromCelsius"`
+	xxx_hidden_OptimalTemperatureToCelsius   int32                        `protobuf:"varint,6,opt,name=optimal_temperature_to_celsius,json=optimalTemperatureToCelsius"`
+	xxx_hidden_Capacity                      int64                        `protobuf:"varint,7,opt,name=capacity"`
+	xxx_hidden_CapacityWeightKg              int64                        `protobuf:"varint,8,opt,name=capacity_weight_kg,json=capacityWeightKg"`
+	xxx_hidden_Email                         *string                      `protobuf:"bytes,9,opt,name=email"`
+	xxx_hidden_Phone                         *string                      `protobuf:"bytes,10,opt,name=phone"`
+	xxx_hidden_Notes                         *string                      `protobuf:"bytes,11,opt,name=notes"`
+	xxx_hidden_LoadingTimeMinutes            int32                        `protobuf:"varint,12,opt,name=loading_time_minutes,json=loadingTimeMinutes"`
+	xxx_hidden_DeliveryWindow                *RoutePlanningDeliveryWindow `protobuf:"bytes,13,opt,name=delivery_window,json=deliveryWindow"`
+	xxx_hidden_SortNumber                    int32                        `protobuf:"varint,14,opt,name=sort_number,json=sortNumber"`
+	XXX_raceDetectHookData                   protoimpl.RaceDetectHookData
+	XXX_presence                             [1]uint32
+	unknownFields                            protoimpl.UnknownFields
+	sizeCache                                protoimpl.SizeCache
+}
+
+func (x *RoutePlanningPlaceInput) Reset() {
+	*x = RoutePlanningPlaceInput{}
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutePlanningPlaceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutePlanningPlaceInput) ProtoMessage() {}
+
+func (x *RoutePlanningPlaceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RoutePlanningPlaceInput) GetAddress() string {
+	if x != nil {
+		if x.xxx_hidden_Address != nil {
+			return *x.xxx_hidden_Address
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlaceInput) GetClientId() int64 {
+	if x != nil {
+		return x.xxx_hidden_ClientId
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlaceInput) GetGeofenceRadiusM() int32 {
+	if x != nil {
+		return x.xxx_hidden_GeofenceRadiusM
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlaceInput) GetCargoType() string {
+	if x != nil {
+		if x.xxx_hidden_CargoType != nil {
+			return *x.xxx_hidden_CargoType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlaceInput) GetOptimalTemperatureFromCelsius() int32 {
+	if x != nil {
+		return x.xxx_hidden_OptimalTemperatureFromCelsius
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlaceInput) GetOptimalTemperatureToCelsius() int32 {
+	if x != nil {
+		return x.xxx_hidden_OptimalTemperatureToCelsius
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlaceInput) GetCapacity() int64 {
+	if x != nil {
+		return x.xxx_hidden_Capacity
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlaceInput) GetCapacityWeightKg() int64 {
+	if x != nil {
+		return x.xxx_hidden_CapacityWeightKg
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlaceInput) GetEmail() string {
+	if x != nil {
+		if x.xxx_hidden_Email != nil {
+			return *x.xxx_hidden_Email
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlaceInput) GetPhone() string {
+	if x != nil {
+		if x.xxx_hidden_Phone != nil {
+			return *x.xxx_hidden_Phone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlaceInput) GetNotes() string {
+	if x != nil {
+		if x.xxx_hidden_Notes != nil {
+			return *x.xxx_hidden_Notes
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlaceInput) GetLoadingTimeMinutes() int32 {
+	if x != nil {
+		return x.xxx_hidden_LoadingTimeMinutes
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlaceInput) GetDeliveryWindow() *RoutePlanningDeliveryWindow {
+	if x != nil {
+		return x.xxx_hidden_DeliveryWindow
+	}
+	return nil
+}
+
+func (x *RoutePlanningPlaceInput) GetSortNumber() int32 {
+	if x != nil {
+		return x.xxx_hidden_SortNumber
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlaceInput) SetAddress(v string) {
+	x.xxx_hidden_Address = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 14)
+}
+
+func (x *RoutePlanningPlaceInput) SetClientId(v int64) {
+	x.xxx_hidden_ClientId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 14)
+}
+
+func (x *RoutePlanningPlaceInput) SetGeofenceRadiusM(v int32) {
+	x.xxx_hidden_GeofenceRadiusM = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 14)
+}
+
+func (x *RoutePlanningPlaceInput) SetCargoType(v string) {
+	x.xxx_hidden_CargoType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 14)
+}
+
+func (x *RoutePlanningPlaceInput) SetOptimalTemperatureFromCelsius(v int32) {
+	x.xxx_hidden_OptimalTemperatureFromCelsius = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 14)
+}
+
+func (x *RoutePlanningPlaceInput) SetOptimalTemperatureToCelsius(v int32) {
+	x.xxx_hidden_OptimalTemperatureToCelsius = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 14)
+}
+
+func (x *RoutePlanningPlaceInput) SetCapacity(v int64) {
+	x.xxx_hidden_Capacity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 14)
+}
+
+func (x *RoutePlanningPlaceInput) SetCapacityWeightKg(v int64) {
+	x.xxx_hidden_CapacityWeightKg = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 14)
+}
+
+func (x *RoutePlanningPlaceInput) SetEmail(v string) {
+	x.xxx_hidden_Email = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 14)
+}
+
+func (x *RoutePlanningPlaceInput) SetPhone(v string) {
+	x.xxx_hidden_Phone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 14)
+}
+
+func (x *RoutePlanningPlaceInput) SetNotes(v string) {
+	x.xxx_hidden_Notes = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 14)
+}
+
+func (x *RoutePlanningPlaceInput) SetLoadingTimeMinutes(v int32) {
+	x.xxx_hidden_LoadingTimeMinutes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 14)
+}
+
+func (x *RoutePlanningPlaceInput) SetDeliveryWindow(v *RoutePlanningDeliveryWindow) {
+	x.xxx_hidden_DeliveryWindow = v
+}
+
+func (x *RoutePlanningPlaceInput) SetSortNumber(v int32) {
+	x.xxx_hidden_SortNumber = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 14)
+}
+
+func (x *RoutePlanningPlaceInput) HasAddress() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RoutePlanningPlaceInput) HasClientId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RoutePlanningPlaceInput) HasGeofenceRadiusM() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *RoutePlanningPlaceInput) HasCargoType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *RoutePlanningPlaceInput) HasOptimalTemperatureFromCelsius() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *RoutePlanningPlaceInput) HasOptimalTemperatureToCelsius() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *RoutePlanningPlaceInput) HasCapacity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *RoutePlanningPlaceInput) HasCapacityWeightKg() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *RoutePlanningPlaceInput) HasEmail() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *RoutePlanningPlaceInput) HasPhone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *RoutePlanningPlaceInput) HasNotes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *RoutePlanningPlaceInput) HasLoadingTimeMinutes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *RoutePlanningPlaceInput) HasDeliveryWindow() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DeliveryWindow != nil
+}
+
+func (x *RoutePlanningPlaceInput) HasSortNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *RoutePlanningPlaceInput) ClearAddress() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Address = nil
+}
+
+func (x *RoutePlanningPlaceInput) ClearClientId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ClientId = 0
+}
+
+func (x *RoutePlanningPlaceInput) ClearGeofenceRadiusM() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_GeofenceRadiusM = 0
+}
+
+func (x *RoutePlanningPlaceInput) ClearCargoType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_CargoType = nil
+}
+
+func (x *RoutePlanningPlaceInput) ClearOptimalTemperatureFromCelsius() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_OptimalTemperatureFromCelsius = 0
+}
+
+func (x *RoutePlanningPlaceInput) ClearOptimalTemperatureToCelsius() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_OptimalTemperatureToCelsius = 0
+}
+
+func (x *RoutePlanningPlaceInput) ClearCapacity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Capacity = 0
+}
+
+func (x *RoutePlanningPlaceInput) ClearCapacityWeightKg() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_CapacityWeightKg = 0
+}
+
+func (x *RoutePlanningPlaceInput) ClearEmail() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Email = nil
+}
+
+func (x *RoutePlanningPlaceInput) ClearPhone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_Phone = nil
+}
+
+func (x *RoutePlanningPlaceInput) ClearNotes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_Notes = nil
+}
+
+func (x *RoutePlanningPlaceInput) ClearLoadingTimeMinutes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_LoadingTimeMinutes = 0
+}
+
+func (x *RoutePlanningPlaceInput) ClearDeliveryWindow() {
+	x.xxx_hidden_DeliveryWindow = nil
+}
+
+func (x *RoutePlanningPlaceInput) ClearSortNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_SortNumber = 0
+}
+
+type RoutePlanningPlaceInput_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Address of the place. Optional if client_id is set.
+	Address *string
+	// Identifier of the client at the place.
+	ClientId *int64
+	// Geofence radius for allowed status changes in meters (10-900, default 300).
+	GeofenceRadiusM *int32
+	// Cargo type (e.g., "frozen").
+	CargoType *string
+	// Lower bound of the optimal cargo temperature in Celsius, for frozen cargo.
+	OptimalTemperatureFromCelsius *int32
+	// Upper bound of the optimal cargo temperature in Celsius, for frozen cargo.
+	OptimalTemperatureToCelsius *int32
+	// Cargo capacity in volume, pallets, loading meters or other units.
+	Capacity *int64
+	// Cargo weight in kilograms.
+	CapacityWeightKg *int64
+	// Contact email address.
+	Email *string
+	// Contact phone number.
+	Phone *string
+	// Notes for the driver.
+	Notes *string
+	// Loading time in minutes.
+	LoadingTimeMinutes *int32
+	// Delivery window of the place.
+	DeliveryWindow *RoutePlanningDeliveryWindow
+	// Sort number of the place in the route or order.
+	SortNumber *int32
+}
+
+func (b0 RoutePlanningPlaceInput_builder) Build() *RoutePlanningPlaceInput {
+	m0 := &RoutePlanningPlaceInput{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Address != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 14)
+		x.xxx_hidden_Address = b.Address
+	}
+	if b.ClientId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 14)
+		x.xxx_hidden_ClientId = *b.ClientId
+	}
+	if b.GeofenceRadiusM != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 14)
+		x.xxx_hidden_GeofenceRadiusM = *b.GeofenceRadiusM
+	}
+	if b.CargoType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 14)
+		x.xxx_hidden_CargoType = b.CargoType
+	}
+	if b.OptimalTemperatureFromCelsius != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 14)
+		x.xxx_hidden_OptimalTemperatureFromCelsius = *b.OptimalTemperatureFromCelsius
+	}
+	if b.OptimalTemperatureToCelsius != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 14)
+		x.xxx_hidden_OptimalTemperatureToCelsius = *b.OptimalTemperatureToCelsius
+	}
+	if b.Capacity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 14)
+		x.xxx_hidden_Capacity = *b.Capacity
+	}
+	if b.CapacityWeightKg != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 14)
+		x.xxx_hidden_CapacityWeightKg = *b.CapacityWeightKg
+	}
+	if b.Email != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 14)
+		x.xxx_hidden_Email = b.Email
+	}
+	if b.Phone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 14)
+		x.xxx_hidden_Phone = b.Phone
+	}
+	if b.Notes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 14)
+		x.xxx_hidden_Notes = b.Notes
+	}
+	if b.LoadingTimeMinutes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 14)
+		x.xxx_hidden_LoadingTimeMinutes = *b.LoadingTimeMinutes
+	}
+	x.xxx_hidden_DeliveryWindow = b.DeliveryWindow
+	if b.SortNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 14)
+		x.xxx_hidden_SortNumber = *b.SortNumber
+	}
+	return m0
+}
+
+// RoutePlanningOrder represents a route planning order.
+type RoutePlanningOrder struct {
+	state                       protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_OrderId          int64                   `protobuf:"varint,1,opt,name=order_id,json=orderId"`
+	xxx_hidden_OrderNumber      *string                 `protobuf:"bytes,2,opt,name=order_number,json=orderNumber"`
+	xxx_hidden_Type             RoutePlanningOrder_Type `protobuf:"varint,3,opt,name=type,enum=wayplatform.connect.mapon.v1.RoutePlanningOrder_Type"`
+	xxx_hidden_UnrecognizedType *string                 `protobuf:"bytes,4,opt,name=unrecognized_type,json=unrecognizedType"`
+	xxx_hidden_Price            float64                 `protobuf:"fixed64,5,opt,name=price"`
+	xxx_hidden_DistanceM        int64                   `protobuf:"varint,6,opt,name=distance_m,json=distanceM"`
+	xxx_hidden_DurationS        int64                   `protobuf:"varint,7,opt,name=duration_s,json=durationS"`
+	xxx_hidden_RouteId          int64                   `protobuf:"varint,8,opt,name=route_id,json=routeId"`
+	xxx_hidden_Polyline         *string                 `protobuf:"bytes,9,opt,name=polyline"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *RoutePlanningOrder) Reset() {
+	*x = RoutePlanningOrder{}
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutePlanningOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutePlanningOrder) ProtoMessage() {}
+
+func (x *RoutePlanningOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RoutePlanningOrder) GetOrderId() int64 {
+	if x != nil {
+		return x.xxx_hidden_OrderId
+	}
+	return 0
+}
+
+func (x *RoutePlanningOrder) GetOrderNumber() string {
+	if x != nil {
+		if x.xxx_hidden_OrderNumber != nil {
+			return *x.xxx_hidden_OrderNumber
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningOrder) GetType() RoutePlanningOrder_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return RoutePlanningOrder_TYPE_UNSPECIFIED
+}
+
+func (x *RoutePlanningOrder) GetUnrecognizedType() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedType != nil {
+			return *x.xxx_hidden_UnrecognizedType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningOrder) GetPrice() float64 {
+	if x != nil {
+		return x.xxx_hidden_Price
+	}
+	return 0
+}
+
+func (x *RoutePlanningOrder) GetDistanceM() int64 {
+	if x != nil {
+		return x.xxx_hidden_DistanceM
+	}
+	return 0
+}
+
+func (x *RoutePlanningOrder) GetDurationS() int64 {
+	if x != nil {
+		return x.xxx_hidden_DurationS
+	}
+	return 0
+}
+
+func (x *RoutePlanningOrder) GetRouteId() int64 {
+	if x != nil {
+		return x.xxx_hidden_RouteId
+	}
+	return 0
+}
+
+func (x *RoutePlanningOrder) GetPolyline() string {
+	if x != nil {
+		if x.xxx_hidden_Polyline != nil {
+			return *x.xxx_hidden_Polyline
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningOrder) SetOrderId(v int64) {
+	x.xxx_hidden_OrderId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
+}
+
+func (x *RoutePlanningOrder) SetOrderNumber(v string) {
+	x.xxx_hidden_OrderNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
+}
+
+func (x *RoutePlanningOrder) SetType(v RoutePlanningOrder_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
+}
+
+func (x *RoutePlanningOrder) SetUnrecognizedType(v string) {
+	x.xxx_hidden_UnrecognizedType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
+}
+
+func (x *RoutePlanningOrder) SetPrice(v float64) {
+	x.xxx_hidden_Price = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+}
+
+func (x *RoutePlanningOrder) SetDistanceM(v int64) {
+	x.xxx_hidden_DistanceM = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
+}
+
+func (x *RoutePlanningOrder) SetDurationS(v int64) {
+	x.xxx_hidden_DurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
+}
+
+func (x *RoutePlanningOrder) SetRouteId(v int64) {
+	x.xxx_hidden_RouteId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *RoutePlanningOrder) SetPolyline(v string) {
+	x.xxx_hidden_Polyline = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+}
+
+func (x *RoutePlanningOrder) HasOrderId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RoutePlanningOrder) HasOrderNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RoutePlanningOrder) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *RoutePlanningOrder) HasUnrecognizedType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *RoutePlanningOrder) HasPrice() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *RoutePlanningOrder) HasDistanceM() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *RoutePlanningOrder) HasDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *RoutePlanningOrder) HasRouteId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *RoutePlanningOrder) HasPolyline() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *RoutePlanningOrder) ClearOrderId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_OrderId = 0
+}
+
+func (x *RoutePlanningOrder) ClearOrderNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_OrderNumber = nil
+}
+
+func (x *RoutePlanningOrder) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Type = RoutePlanningOrder_TYPE_UNSPECIFIED
+}
+
+func (x *RoutePlanningOrder) ClearUnrecognizedType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_UnrecognizedType = nil
+}
+
+func (x *RoutePlanningOrder) ClearPrice() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Price = 0
+}
+
+func (x *RoutePlanningOrder) ClearDistanceM() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_DistanceM = 0
+}
+
+func (x *RoutePlanningOrder) ClearDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_DurationS = 0
+}
+
+func (x *RoutePlanningOrder) ClearRouteId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_RouteId = 0
+}
+
+func (x *RoutePlanningOrder) ClearPolyline() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Polyline = nil
+}
+
+type RoutePlanningOrder_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the order.
+	OrderId *int64
+	// Order number.
+	OrderNumber *string
+	// Type of the order.
+	Type *RoutePlanningOrder_Type
+	// The raw string value of the type if it is not one of the known Type enum values.
+	UnrecognizedType *string
+	// Order price in the company currency.
+	Price *float64
+	// Total distance of the order in meters.
+	DistanceM *int64
+	// Total duration of the order in seconds.
+	DurationS *int64
+	// Identifier of the route the order is assigned to, 0 if none.
+	RouteId *int64
+	// Encoded polyline of the order path. Only set when requested.
+	Polyline *string
+}
+
+func (b0 RoutePlanningOrder_builder) Build() *RoutePlanningOrder {
+	m0 := &RoutePlanningOrder{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OrderId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		x.xxx_hidden_OrderId = *b.OrderId
+	}
+	if b.OrderNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
+		x.xxx_hidden_OrderNumber = b.OrderNumber
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.UnrecognizedType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
+		x.xxx_hidden_UnrecognizedType = b.UnrecognizedType
+	}
+	if b.Price != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_Price = *b.Price
+	}
+	if b.DistanceM != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
+		x.xxx_hidden_DistanceM = *b.DistanceM
+	}
+	if b.DurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
+		x.xxx_hidden_DurationS = *b.DurationS
+	}
+	if b.RouteId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		x.xxx_hidden_RouteId = *b.RouteId
+	}
+	if b.Polyline != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_Polyline = b.Polyline
+	}
+	return m0
+}
+
+// RoutePlanningFile represents a file attached to a route planning place.
+type RoutePlanningFile struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FileId      int64                  `protobuf:"varint,1,opt,name=file_id,json=fileId"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Url         *string                `protobuf:"bytes,3,opt,name=url"`
+	xxx_hidden_ThumbUrl    *string                `protobuf:"bytes,4,opt,name=thumb_url,json=thumbUrl"`
+	xxx_hidden_MimeType    *string                `protobuf:"bytes,5,opt,name=mime_type,json=mimeType"`
+	xxx_hidden_SizeBytes   int64                  `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes"`
+	xxx_hidden_Width       int32                  `protobuf:"varint,7,opt,name=width"`
+	xxx_hidden_Height      int32                  `protobuf:"varint,8,opt,name=height"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RoutePlanningFile) Reset() {
+	*x = RoutePlanningFile{}
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutePlanningFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutePlanningFile) ProtoMessage() {}
+
+func (x *RoutePlanningFile) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RoutePlanningFile) GetFileId() int64 {
+	if x != nil {
+		return x.xxx_hidden_FileId
+	}
+	return 0
+}
+
+func (x *RoutePlanningFile) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningFile) GetUrl() string {
+	if x != nil {
+		if x.xxx_hidden_Url != nil {
+			return *x.xxx_hidden_Url
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningFile) GetThumbUrl() string {
+	if x != nil {
+		if x.xxx_hidden_ThumbUrl != nil {
+			return *x.xxx_hidden_ThumbUrl
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningFile) GetMimeType() string {
+	if x != nil {
+		if x.xxx_hidden_MimeType != nil {
+			return *x.xxx_hidden_MimeType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningFile) GetSizeBytes() int64 {
+	if x != nil {
+		return x.xxx_hidden_SizeBytes
+	}
+	return 0
+}
+
+func (x *RoutePlanningFile) GetWidth() int32 {
+	if x != nil {
+		return x.xxx_hidden_Width
+	}
+	return 0
+}
+
+func (x *RoutePlanningFile) GetHeight() int32 {
+	if x != nil {
+		return x.xxx_hidden_Height
+	}
+	return 0
+}
+
+func (x *RoutePlanningFile) SetFileId(v int64) {
+	x.xxx_hidden_FileId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *RoutePlanningFile) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *RoutePlanningFile) SetUrl(v string) {
+	x.xxx_hidden_Url = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *RoutePlanningFile) SetThumbUrl(v string) {
+	x.xxx_hidden_ThumbUrl = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *RoutePlanningFile) SetMimeType(v string) {
+	x.xxx_hidden_MimeType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+}
+
+func (x *RoutePlanningFile) SetSizeBytes(v int64) {
+	x.xxx_hidden_SizeBytes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *RoutePlanningFile) SetWidth(v int32) {
+	x.xxx_hidden_Width = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *RoutePlanningFile) SetHeight(v int32) {
+	x.xxx_hidden_Height = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *RoutePlanningFile) HasFileId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RoutePlanningFile) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RoutePlanningFile) HasUrl() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *RoutePlanningFile) HasThumbUrl() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *RoutePlanningFile) HasMimeType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *RoutePlanningFile) HasSizeBytes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *RoutePlanningFile) HasWidth() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *RoutePlanningFile) HasHeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *RoutePlanningFile) ClearFileId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_FileId = 0
+}
+
+func (x *RoutePlanningFile) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *RoutePlanningFile) ClearUrl() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Url = nil
+}
+
+func (x *RoutePlanningFile) ClearThumbUrl() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_ThumbUrl = nil
+}
+
+func (x *RoutePlanningFile) ClearMimeType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_MimeType = nil
+}
+
+func (x *RoutePlanningFile) ClearSizeBytes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_SizeBytes = 0
+}
+
+func (x *RoutePlanningFile) ClearWidth() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Width = 0
+}
+
+func (x *RoutePlanningFile) ClearHeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Height = 0
+}
+
+type RoutePlanningFile_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the file.
+	FileId *int64
+	// Name of the file.
+	Name *string
+	// URL of the file.
+	Url *string
+	// URL of the file thumbnail, if any.
+	ThumbUrl *string
+	// MIME type of the file.
+	MimeType *string
+	// Size of the file in bytes.
+	SizeBytes *int64
+	// Width of the image in pixels.
+	Width *int32
+	// Height of the image in pixels.
+	Height *int32
+}
+
+func (b0 RoutePlanningFile_builder) Build() *RoutePlanningFile {
+	m0 := &RoutePlanningFile{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.FileId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_FileId = *b.FileId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Url != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_Url = b.Url
+	}
+	if b.ThumbUrl != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_ThumbUrl = b.ThumbUrl
+	}
+	if b.MimeType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		x.xxx_hidden_MimeType = b.MimeType
+	}
+	if b.SizeBytes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_SizeBytes = *b.SizeBytes
+	}
+	if b.Width != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_Width = *b.Width
+	}
+	if b.Height != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_Height = *b.Height
+	}
+	return m0
+}
+
+// RoutePlanningPlace represents a place of a route planning order.
+type RoutePlanningPlace struct {
+	state                                    protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_PlaceId                       int64                        `protobuf:"varint,1,opt,name=place_id,json=placeId"`
+	xxx_hidden_OrderId                       int64                        `protobuf:"varint,2,opt,name=order_id,json=orderId"`
+	xxx_hidden_RouteId                       int64                        `protobuf:"varint,3,opt,name=route_id,json=routeId"`
+	xxx_hidden_ClientId                      int64                        `protobuf:"varint,4,opt,name=client_id,json=clientId"`
+	xxx_hidden_SortNumber                    int32                        `protobuf:"varint,5,opt,name=sort_number,json=sortNumber"`
+	xxx_hidden_Location                      *Location                    `protobuf:"bytes,6,opt,name=location"`
+	xxx_hidden_Timezone                      *string                      `protobuf:"bytes,7,opt,name=timezone"`
+	xxx_hidden_GeofenceRadiusM               int32                        `protobuf:"varint,8,opt,name=geofence_radius_m,json=geofenceRadiusM"`
+	xxx_hidden_Type                          RoutePlanningPlace_Type      `protobuf:"varint,9,opt,name=type,enum=wayplatform.connect.mapon.v1.RoutePlanningPlace_Type"`
+	xxx_hidden_UnrecognizedType              *string                      `protobuf:"bytes,10,opt,name=unrecognized_type,json=unrecognizedType"`
+	xxx_hidden_Status                        RoutePlanningPlace_Status    `protobuf:"varint,11,opt,name=status,enum=wayplatform.connect.mapon.v1.RoutePlanningPlace_Status"`
+	xxx_hidden_UnrecognizedStatus            *string                      `protobuf:"bytes,12,opt,name=unrecognized_status,json=unrecognizedStatus"`
+	xxx_hidden_StatusChangedAt               *timestamppb.Timestamp       `protobuf:"bytes,13,opt,name=status_changed_at,json=statusChangedAt"`
+	xxx_hidden_Phone                         *string                      `protobuf:"bytes,14,opt,name=phone"`
+	xxx_hidden_Email                         *string                      `protobuf:"bytes,15,opt,name=email"`
+	xxx_hidden_CargoType                     *string                      `protobuf:"bytes,16,opt,name=cargo_type,json=cargoType"`
+	xxx_hidden_DeliveryWindow                *RoutePlanningDeliveryWindow `protobuf:"bytes,17,opt,name=delivery_window,json=deliveryWindow"`
+	xxx_hidden_OptimalTemperatureFromCelsius int32                        `protobuf:"varint,18,opt,name=optimal_temperature_from_celsius,json=optimalTemperatureFromCelsius"`
+	xxx_hidden_OptimalTemperatureToCelsius   int32                        `protobuf:"varint,19,opt,name=optimal_temperature_to_celsius,json=optimalTemperatureToCelsius"`
+	xxx_hidden_CapacityWeightKg              int64                        `protobuf:"varint,20,opt,name=capacity_weight_kg,json=capacityWeightKg"`
+	xxx_hidden_Capacity                      int64                        `protobuf:"varint,21,opt,name=capacity"`
+	xxx_hidden_StopOffTimeMinutes            int32                        `protobuf:"varint,22,opt,name=stop_off_time_minutes,json=stopOffTimeMinutes"`
+	xxx_hidden_Notes                         *string                      `protobuf:"bytes,23,opt,name=notes"`
+	xxx_hidden_FinishedAt                    *timestamppb.Timestamp       `protobuf:"bytes,24,opt,name=finished_at,json=finishedAt"`
+	xxx_hidden_DistanceToM                   int64                        `protobuf:"varint,25,opt,name=distance_to_m,json=distanceToM"`
+	xxx_hidden_DurationToS                   int64                        `protobuf:"varint,26,opt,name=duration_to_s,json=durationToS"`
+	xxx_hidden_PlannedArrivalTime            *timestamppb.Timestamp       `protobuf:"bytes,27,opt,name=planned_arrival_time,json=plannedArrivalTime"`
+	xxx_hidden_ArrivalTime                   *timestamppb.Timestamp       `protobuf:"bytes,28,opt,name=arrival_time,json=arrivalTime"`
+	xxx_hidden_DistanceLeftM                 int64                        `protobuf:"varint,29,opt,name=distance_left_m,json=distanceLeftM"`
+	xxx_hidden_PolylineTo                    *string                      `protobuf:"bytes,30,opt,name=polyline_to,json=polylineTo"`
+	xxx_hidden_Files                         *[]*RoutePlanningFile        `protobuf:"bytes,31,rep,name=files"`
+	XXX_raceDetectHookData                   protoimpl.RaceDetectHookData
+	XXX_presence                             [1]uint32
+	unknownFields                            protoimpl.UnknownFields
+	sizeCache                                protoimpl.SizeCache
+}
+
+func (x *RoutePlanningPlace) Reset() {
+	*x = RoutePlanningPlace{}
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutePlanningPlace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutePlanningPlace) ProtoMessage() {}
+
+func (x *RoutePlanningPlace) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RoutePlanningPlace) GetPlaceId() int64 {
+	if x != nil {
+		return x.xxx_hidden_PlaceId
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetOrderId() int64 {
+	if x != nil {
+		return x.xxx_hidden_OrderId
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetRouteId() int64 {
+	if x != nil {
+		return x.xxx_hidden_RouteId
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetClientId() int64 {
+	if x != nil {
+		return x.xxx_hidden_ClientId
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetSortNumber() int32 {
+	if x != nil {
+		return x.xxx_hidden_SortNumber
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetLocation() *Location {
+	if x != nil {
+		return x.xxx_hidden_Location
+	}
+	return nil
+}
+
+func (x *RoutePlanningPlace) GetTimezone() string {
+	if x != nil {
+		if x.xxx_hidden_Timezone != nil {
+			return *x.xxx_hidden_Timezone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlace) GetGeofenceRadiusM() int32 {
+	if x != nil {
+		return x.xxx_hidden_GeofenceRadiusM
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetType() RoutePlanningPlace_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 8) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return RoutePlanningPlace_TYPE_UNSPECIFIED
+}
+
+func (x *RoutePlanningPlace) GetUnrecognizedType() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedType != nil {
+			return *x.xxx_hidden_UnrecognizedType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlace) GetStatus() RoutePlanningPlace_Status {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 10) {
+			return x.xxx_hidden_Status
+		}
+	}
+	return RoutePlanningPlace_STATUS_UNSPECIFIED
+}
+
+func (x *RoutePlanningPlace) GetUnrecognizedStatus() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedStatus != nil {
+			return *x.xxx_hidden_UnrecognizedStatus
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlace) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_StatusChangedAt
+	}
+	return nil
+}
+
+func (x *RoutePlanningPlace) GetPhone() string {
+	if x != nil {
+		if x.xxx_hidden_Phone != nil {
+			return *x.xxx_hidden_Phone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlace) GetEmail() string {
+	if x != nil {
+		if x.xxx_hidden_Email != nil {
+			return *x.xxx_hidden_Email
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlace) GetCargoType() string {
+	if x != nil {
+		if x.xxx_hidden_CargoType != nil {
+			return *x.xxx_hidden_CargoType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlace) GetDeliveryWindow() *RoutePlanningDeliveryWindow {
+	if x != nil {
+		return x.xxx_hidden_DeliveryWindow
+	}
+	return nil
+}
+
+func (x *RoutePlanningPlace) GetOptimalTemperatureFromCelsius() int32 {
+	if x != nil {
+		return x.xxx_hidden_OptimalTemperatureFromCelsius
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetOptimalTemperatureToCelsius() int32 {
+	if x != nil {
+		return x.xxx_hidden_OptimalTemperatureToCelsius
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetCapacityWeightKg() int64 {
+	if x != nil {
+		return x.xxx_hidden_CapacityWeightKg
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetCapacity() int64 {
+	if x != nil {
+		return x.xxx_hidden_Capacity
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetStopOffTimeMinutes() int32 {
+	if x != nil {
+		return x.xxx_hidden_StopOffTimeMinutes
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetNotes() string {
+	if x != nil {
+		if x.xxx_hidden_Notes != nil {
+			return *x.xxx_hidden_Notes
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlace) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_FinishedAt
+	}
+	return nil
+}
+
+func (x *RoutePlanningPlace) GetDistanceToM() int64 {
+	if x != nil {
+		return x.xxx_hidden_DistanceToM
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetDurationToS() int64 {
+	if x != nil {
+		return x.xxx_hidden_DurationToS
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetPlannedArrivalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_PlannedArrivalTime
+	}
+	return nil
+}
+
+func (x *RoutePlanningPlace) GetArrivalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ArrivalTime
+	}
+	return nil
+}
+
+func (x *RoutePlanningPlace) GetDistanceLeftM() int64 {
+	if x != nil {
+		return x.xxx_hidden_DistanceLeftM
+	}
+	return 0
+}
+
+func (x *RoutePlanningPlace) GetPolylineTo() string {
+	if x != nil {
+		if x.xxx_hidden_PolylineTo != nil {
+			return *x.xxx_hidden_PolylineTo
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningPlace) GetFiles() []*RoutePlanningFile {
+	if x != nil {
+		if x.xxx_hidden_Files != nil {
+			return *x.xxx_hidden_Files
+		}
+	}
+	return nil
+}
+
+func (x *RoutePlanningPlace) SetPlaceId(v int64) {
+	x.xxx_hidden_PlaceId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 31)
+}
+
+func (x *RoutePlanningPlace) SetOrderId(v int64) {
+	x.xxx_hidden_OrderId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 31)
+}
+
+func (x *RoutePlanningPlace) SetRouteId(v int64) {
+	x.xxx_hidden_RouteId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 31)
+}
+
+func (x *RoutePlanningPlace) SetClientId(v int64) {
+	x.xxx_hidden_ClientId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 31)
+}
+
+func (x *RoutePlanningPlace) SetSortNumber(v int32) {
+	x.xxx_hidden_SortNumber = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 31)
+}
+
+func (x *RoutePlanningPlace) SetLocation(v *Location) {
+	x.xxx_hidden_Location = v
+}
+
+func (x *RoutePlanningPlace) SetTimezone(v string) {
+	x.xxx_hidden_Timezone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 31)
+}
+
+func (x *RoutePlanningPlace) SetGeofenceRadiusM(v int32) {
+	x.xxx_hidden_GeofenceRadiusM = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 31)
+}
+
+func (x *RoutePlanningPlace) SetType(v RoutePlanningPlace_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 31)
+}
+
+func (x *RoutePlanningPlace) SetUnrecognizedType(v string) {
+	x.xxx_hidden_UnrecognizedType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 31)
+}
+
+func (x *RoutePlanningPlace) SetStatus(v RoutePlanningPlace_Status) {
+	x.xxx_hidden_Status = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 31)
+}
+
+func (x *RoutePlanningPlace) SetUnrecognizedStatus(v string) {
+	x.xxx_hidden_UnrecognizedStatus = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 31)
+}
+
+func (x *RoutePlanningPlace) SetStatusChangedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_StatusChangedAt = v
+}
+
+func (x *RoutePlanningPlace) SetPhone(v string) {
+	x.xxx_hidden_Phone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 31)
+}
+
+func (x *RoutePlanningPlace) SetEmail(v string) {
+	x.xxx_hidden_Email = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 31)
+}
+
+func (x *RoutePlanningPlace) SetCargoType(v string) {
+	x.xxx_hidden_CargoType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 31)
+}
+
+func (x *RoutePlanningPlace) SetDeliveryWindow(v *RoutePlanningDeliveryWindow) {
+	x.xxx_hidden_DeliveryWindow = v
+}
+
+func (x *RoutePlanningPlace) SetOptimalTemperatureFromCelsius(v int32) {
+	x.xxx_hidden_OptimalTemperatureFromCelsius = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 17, 31)
+}
+
+func (x *RoutePlanningPlace) SetOptimalTemperatureToCelsius(v int32) {
+	x.xxx_hidden_OptimalTemperatureToCelsius = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 18, 31)
+}
+
+func (x *RoutePlanningPlace) SetCapacityWeightKg(v int64) {
+	x.xxx_hidden_CapacityWeightKg = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 19, 31)
+}
+
+func (x *RoutePlanningPlace) SetCapacity(v int64) {
+	x.xxx_hidden_Capacity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 20, 31)
+}
+
+func (x *RoutePlanningPlace) SetStopOffTimeMinutes(v int32) {
+	x.xxx_hidden_StopOffTimeMinutes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 21, 31)
+}
+
+func (x *RoutePlanningPlace) SetNotes(v string) {
+	x.xxx_hidden_Notes = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 22, 31)
+}
+
+func (x *RoutePlanningPlace) SetFinishedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FinishedAt = v
+}
+
+func (x *RoutePlanningPlace) SetDistanceToM(v int64) {
+	x.xxx_hidden_DistanceToM = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 24, 31)
+}
+
+func (x *RoutePlanningPlace) SetDurationToS(v int64) {
+	x.xxx_hidden_DurationToS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 25, 31)
+}
+
+func (x *RoutePlanningPlace) SetPlannedArrivalTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_PlannedArrivalTime = v
+}
+
+func (x *RoutePlanningPlace) SetArrivalTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ArrivalTime = v
+}
+
+func (x *RoutePlanningPlace) SetDistanceLeftM(v int64) {
+	x.xxx_hidden_DistanceLeftM = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 28, 31)
+}
+
+func (x *RoutePlanningPlace) SetPolylineTo(v string) {
+	x.xxx_hidden_PolylineTo = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 29, 31)
+}
+
+func (x *RoutePlanningPlace) SetFiles(v []*RoutePlanningFile) {
+	x.xxx_hidden_Files = &v
+}
+
+func (x *RoutePlanningPlace) HasPlaceId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RoutePlanningPlace) HasOrderId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RoutePlanningPlace) HasRouteId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *RoutePlanningPlace) HasClientId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *RoutePlanningPlace) HasSortNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *RoutePlanningPlace) HasLocation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Location != nil
+}
+
+func (x *RoutePlanningPlace) HasTimezone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *RoutePlanningPlace) HasGeofenceRadiusM() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *RoutePlanningPlace) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *RoutePlanningPlace) HasUnrecognizedType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *RoutePlanningPlace) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *RoutePlanningPlace) HasUnrecognizedStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *RoutePlanningPlace) HasStatusChangedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StatusChangedAt != nil
+}
+
+func (x *RoutePlanningPlace) HasPhone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *RoutePlanningPlace) HasEmail() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *RoutePlanningPlace) HasCargoType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *RoutePlanningPlace) HasDeliveryWindow() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DeliveryWindow != nil
+}
+
+func (x *RoutePlanningPlace) HasOptimalTemperatureFromCelsius() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 17)
+}
+
+func (x *RoutePlanningPlace) HasOptimalTemperatureToCelsius() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 18)
+}
+
+func (x *RoutePlanningPlace) HasCapacityWeightKg() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 19)
+}
+
+func (x *RoutePlanningPlace) HasCapacity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 20)
+}
+
+func (x *RoutePlanningPlace) HasStopOffTimeMinutes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 21)
+}
+
+func (x *RoutePlanningPlace) HasNotes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 22)
+}
+
+func (x *RoutePlanningPlace) HasFinishedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FinishedAt != nil
+}
+
+func (x *RoutePlanningPlace) HasDistanceToM() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 24)
+}
+
+func (x *RoutePlanningPlace) HasDurationToS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 25)
+}
+
+func (x *RoutePlanningPlace) HasPlannedArrivalTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PlannedArrivalTime != nil
+}
+
+func (x *RoutePlanningPlace) HasArrivalTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ArrivalTime != nil
+}
+
+func (x *RoutePlanningPlace) HasDistanceLeftM() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 28)
+}
+
+func (x *RoutePlanningPlace) HasPolylineTo() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 29)
+}
+
+func (x *RoutePlanningPlace) ClearPlaceId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_PlaceId = 0
+}
+
+func (x *RoutePlanningPlace) ClearOrderId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_OrderId = 0
+}
+
+func (x *RoutePlanningPlace) ClearRouteId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_RouteId = 0
+}
+
+func (x *RoutePlanningPlace) ClearClientId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_ClientId = 0
+}
+
+func (x *RoutePlanningPlace) ClearSortNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_SortNumber = 0
+}
+
+func (x *RoutePlanningPlace) ClearLocation() {
+	x.xxx_hidden_Location = nil
+}
+
+func (x *RoutePlanningPlace) ClearTimezone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Timezone = nil
+}
+
+func (x *RoutePlanningPlace) ClearGeofenceRadiusM() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_GeofenceRadiusM = 0
+}
+
+func (x *RoutePlanningPlace) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Type = RoutePlanningPlace_TYPE_UNSPECIFIED
+}
+
+func (x *RoutePlanningPlace) ClearUnrecognizedType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_UnrecognizedType = nil
+}
+
+func (x *RoutePlanningPlace) ClearStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_Status = RoutePlanningPlace_STATUS_UNSPECIFIED
+}
+
+func (x *RoutePlanningPlace) ClearUnrecognizedStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_UnrecognizedStatus = nil
+}
+
+func (x *RoutePlanningPlace) ClearStatusChangedAt() {
+	x.xxx_hidden_StatusChangedAt = nil
+}
+
+func (x *RoutePlanningPlace) ClearPhone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_Phone = nil
+}
+
+func (x *RoutePlanningPlace) ClearEmail() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_Email = nil
+}
+
+func (x *RoutePlanningPlace) ClearCargoType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_CargoType = nil
+}
+
+func (x *RoutePlanningPlace) ClearDeliveryWindow() {
+	x.xxx_hidden_DeliveryWindow = nil
+}
+
+func (x *RoutePlanningPlace) ClearOptimalTemperatureFromCelsius() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 17)
+	x.xxx_hidden_OptimalTemperatureFromCelsius = 0
+}
+
+func (x *RoutePlanningPlace) ClearOptimalTemperatureToCelsius() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 18)
+	x.xxx_hidden_OptimalTemperatureToCelsius = 0
+}
+
+func (x *RoutePlanningPlace) ClearCapacityWeightKg() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 19)
+	x.xxx_hidden_CapacityWeightKg = 0
+}
+
+func (x *RoutePlanningPlace) ClearCapacity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 20)
+	x.xxx_hidden_Capacity = 0
+}
+
+func (x *RoutePlanningPlace) ClearStopOffTimeMinutes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 21)
+	x.xxx_hidden_StopOffTimeMinutes = 0
+}
+
+func (x *RoutePlanningPlace) ClearNotes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 22)
+	x.xxx_hidden_Notes = nil
+}
+
+func (x *RoutePlanningPlace) ClearFinishedAt() {
+	x.xxx_hidden_FinishedAt = nil
+}
+
+func (x *RoutePlanningPlace) ClearDistanceToM() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 24)
+	x.xxx_hidden_DistanceToM = 0
+}
+
+func (x *RoutePlanningPlace) ClearDurationToS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 25)
+	x.xxx_hidden_DurationToS = 0
+}
+
+func (x *RoutePlanningPlace) ClearPlannedArrivalTime() {
+	x.xxx_hidden_PlannedArrivalTime = nil
+}
+
+func (x *RoutePlanningPlace) ClearArrivalTime() {
+	x.xxx_hidden_ArrivalTime = nil
+}
+
+func (x *RoutePlanningPlace) ClearDistanceLeftM() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 28)
+	x.xxx_hidden_DistanceLeftM = 0
+}
+
+func (x *RoutePlanningPlace) ClearPolylineTo() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 29)
+	x.xxx_hidden_PolylineTo = nil
+}
+
+type RoutePlanningPlace_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the place.
+	PlaceId *int64
+	// Identifier of the order the place belongs to.
+	OrderId *int64
+	// Identifier of the route the place is assigned to, 0 if none.
+	RouteId *int64
+	// Identifier of the client at the place, 0 if none.
+	ClientId *int64
+	// Sort number of the place in the route or order.
+	SortNumber *int32
+	// Location of the place.
+	Location *Location
+	// IANA timezone of the place.
+	Timezone *string
+	// Geofence radius for allowed status changes in meters.
+	GeofenceRadiusM *int32
+	// Type of the place.
+	Type *RoutePlanningPlace_Type
+	// The raw string value of the type if it is not one of the known Type enum values.
+	UnrecognizedType *string
+	// Status of the place.
+	Status *RoutePlanningPlace_Status
+	// The raw string value of the status if it is not one of the known Status enum values.
+	UnrecognizedStatus *string
+	// Timestamp of the last status change.
+	StatusChangedAt *timestamppb.Timestamp
+	// Contact phone number.
+	Phone *string
+	// Contact email address.
+	Email *string
+	// Cargo type (e.g., "frozen").
+	CargoType *string
+	// Delivery window of the place.
+	DeliveryWindow *RoutePlanningDeliveryWindow
+	// Lower bound of the optimal cargo temperature in Celsius.
+	OptimalTemperatureFromCelsius *int32
+	// Upper bound of the optimal cargo temperature in Celsius.
+	OptimalTemperatureToCelsius *int32
+	// Cargo weight in kilograms.
+	CapacityWeightKg *int64
+	// Cargo capacity in volume, pallets, loading meters or other units.
+	Capacity *int64
+	// Planned stop time at the place in minutes.
+	StopOffTimeMinutes *int32
+	// Notes for the driver.
+	Notes *string
+	// Timestamp when the place was completed.
+	FinishedAt *timestamppb.Timestamp
+	// Distance from the previous place in meters.
+	DistanceToM *int64
+	// Driving duration from the previous place in seconds.
+	DurationToS *int64
+	// Planned arrival time at the place.
+	PlannedArrivalTime *timestamppb.Timestamp
+	// Actual arrival time at the place.
+	ArrivalTime *timestamppb.Timestamp
+	// Remaining distance to the place in meters.
+	DistanceLeftM *int64
+	// Encoded polyline of the path from the previous place. Only set when requested.
+	PolylineTo *string
+	// Files attached to the place. Only set when requested.
+	Files []*RoutePlanningFile
+}
+
+func (b0 RoutePlanningPlace_builder) Build() *RoutePlanningPlace {
+	m0 := &RoutePlanningPlace{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.PlaceId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 31)
+		x.xxx_hidden_PlaceId = *b.PlaceId
+	}
+	if b.OrderId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 31)
+		x.xxx_hidden_OrderId = *b.OrderId
+	}
+	if b.RouteId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 31)
+		x.xxx_hidden_RouteId = *b.RouteId
+	}
+	if b.ClientId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 31)
+		x.xxx_hidden_ClientId = *b.ClientId
+	}
+	if b.SortNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 31)
+		x.xxx_hidden_SortNumber = *b.SortNumber
+	}
+	x.xxx_hidden_Location = b.Location
+	if b.Timezone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 31)
+		x.xxx_hidden_Timezone = b.Timezone
+	}
+	if b.GeofenceRadiusM != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 31)
+		x.xxx_hidden_GeofenceRadiusM = *b.GeofenceRadiusM
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 31)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.UnrecognizedType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 31)
+		x.xxx_hidden_UnrecognizedType = b.UnrecognizedType
+	}
+	if b.Status != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 31)
+		x.xxx_hidden_Status = *b.Status
+	}
+	if b.UnrecognizedStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 31)
+		x.xxx_hidden_UnrecognizedStatus = b.UnrecognizedStatus
+	}
+	x.xxx_hidden_StatusChangedAt = b.StatusChangedAt
+	if b.Phone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 31)
+		x.xxx_hidden_Phone = b.Phone
+	}
+	if b.Email != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 31)
+		x.xxx_hidden_Email = b.Email
+	}
+	if b.CargoType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 31)
+		x.xxx_hidden_CargoType = b.CargoType
+	}
+	x.xxx_hidden_DeliveryWindow = b.DeliveryWindow
+	if b.OptimalTemperatureFromCelsius != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 17, 31)
+		x.xxx_hidden_OptimalTemperatureFromCelsius = *b.OptimalTemperatureFromCelsius
+	}
+	if b.OptimalTemperatureToCelsius != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 18, 31)
+		x.xxx_hidden_OptimalTemperatureToCelsius = *b.OptimalTemperatureToCelsius
+	}
+	if b.CapacityWeightKg != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 19, 31)
+		x.xxx_hidden_CapacityWeightKg = *b.CapacityWeightKg
+	}
+	if b.Capacity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 20, 31)
+		x.xxx_hidden_Capacity = *b.Capacity
+	}
+	if b.StopOffTimeMinutes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 21, 31)
+		x.xxx_hidden_StopOffTimeMinutes = *b.StopOffTimeMinutes
+	}
+	if b.Notes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 22, 31)
+		x.xxx_hidden_Notes = b.Notes
+	}
+	x.xxx_hidden_FinishedAt = b.FinishedAt
+	if b.DistanceToM != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 24, 31)
+		x.xxx_hidden_DistanceToM = *b.DistanceToM
+	}
+	if b.DurationToS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 25, 31)
+		x.xxx_hidden_DurationToS = *b.DurationToS
+	}
+	x.xxx_hidden_PlannedArrivalTime = b.PlannedArrivalTime
+	x.xxx_hidden_ArrivalTime = b.ArrivalTime
+	if b.DistanceLeftM != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 28, 31)
+		x.xxx_hidden_DistanceLeftM = *b.DistanceLeftM
+	}
+	if b.PolylineTo != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 29, 31)
+		x.xxx_hidden_PolylineTo = b.PolylineTo
+	}
+	x.xxx_hidden_Files = &b.Files
+	return m0
+}
+
+// RoutePlanningTollCost represents toll costs of a planned route in a country.
+type RoutePlanningTollCost struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name           *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_CountryCode    *string                `protobuf:"bytes,2,opt,name=country_code,json=countryCode"`
+	xxx_hidden_AmountEurCents float64                `protobuf:"fixed64,3,opt,name=amount_eur_cents,json=amountEurCents"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *RoutePlanningTollCost) Reset() {
+	*x = RoutePlanningTollCost{}
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutePlanningTollCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutePlanningTollCost) ProtoMessage() {}
+
+func (x *RoutePlanningTollCost) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RoutePlanningTollCost) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningTollCost) GetCountryCode() string {
+	if x != nil {
+		if x.xxx_hidden_CountryCode != nil {
+			return *x.xxx_hidden_CountryCode
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningTollCost) GetAmountEurCents() float64 {
+	if x != nil {
+		return x.xxx_hidden_AmountEurCents
+	}
+	return 0
+}
+
+func (x *RoutePlanningTollCost) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *RoutePlanningTollCost) SetCountryCode(v string) {
+	x.xxx_hidden_CountryCode = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *RoutePlanningTollCost) SetAmountEurCents(v float64) {
+	x.xxx_hidden_AmountEurCents = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *RoutePlanningTollCost) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RoutePlanningTollCost) HasCountryCode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RoutePlanningTollCost) HasAmountEurCents() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *RoutePlanningTollCost) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *RoutePlanningTollCost) ClearCountryCode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CountryCode = nil
+}
+
+func (x *RoutePlanningTollCost) ClearAmountEurCents() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_AmountEurCents = 0
+}
+
+type RoutePlanningTollCost_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Name of the toll.
+	Name *string
+	// ISO country code (e.g. "GB", "LV").
+	CountryCode *string
+	// Toll amount in euro cents.
+	AmountEurCents *float64
+}
+
+func (b0 RoutePlanningTollCost_builder) Build() *RoutePlanningTollCost {
+	m0 := &RoutePlanningTollCost{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.CountryCode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_CountryCode = b.CountryCode
+	}
+	if b.AmountEurCents != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_AmountEurCents = *b.AmountEurCents
+	}
+	return m0
+}
+
+// RoutePlanningRoute represents a planned route with an ordered sequence of places.
+type RoutePlanningRoute struct {
+	state                          protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_RouteId             int64                     `protobuf:"varint,1,opt,name=route_id,json=routeId"`
+	xxx_hidden_Name                *string                   `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_UnitId              int64                     `protobuf:"varint,3,opt,name=unit_id,json=unitId"`
+	xxx_hidden_DriverId            int64                     `protobuf:"varint,4,opt,name=driver_id,json=driverId"`
+	xxx_hidden_DriverUnitId        int64                     `protobuf:"varint,5,opt,name=driver_unit_id,json=driverUnitId"`
+	xxx_hidden_Start               *Location                 `protobuf:"bytes,6,opt,name=start"`
+	xxx_hidden_StartTimezone       *string                   `protobuf:"bytes,7,opt,name=start_timezone,json=startTimezone"`
+	xxx_hidden_End                 *Location                 `protobuf:"bytes,8,opt,name=end"`
+	xxx_hidden_EndTimezone         *string                   `protobuf:"bytes,9,opt,name=end_timezone,json=endTimezone"`
+	xxx_hidden_DepartureAt         *timestamppb.Timestamp    `protobuf:"bytes,10,opt,name=departure_at,json=departureAt"`
+	xxx_hidden_PlannedAt           *timestamppb.Timestamp    `protobuf:"bytes,11,opt,name=planned_at,json=plannedAt"`
+	xxx_hidden_InProgress          bool                      `protobuf:"varint,12,opt,name=in_progress,json=inProgress"`
+	xxx_hidden_PlacesTotal         int32                     `protobuf:"varint,13,opt,name=places_total,json=placesTotal"`
+	xxx_hidden_PlacesCompleted     int32                     `protobuf:"varint,14,opt,name=places_completed,json=placesCompleted"`
+	xxx_hidden_DistanceM           int64                     `protobuf:"varint,15,opt,name=distance_m,json=distanceM"`
+	xxx_hidden_DurationS           int64                     `protobuf:"varint,16,opt,name=duration_s,json=durationS"`
+	xxx_hidden_CostPerKmEurCents   float64                   `protobuf:"fixed64,17,opt,name=cost_per_km_eur_cents,json=costPerKmEurCents"`
+	xxx_hidden_CostPerHourEurCents float64                   `protobuf:"fixed64,18,opt,name=cost_per_hour_eur_cents,json=costPerHourEurCents"`
+	xxx_hidden_TollCosts           *[]*RoutePlanningTollCost `protobuf:"bytes,19,rep,name=toll_costs,json=tollCosts"`
+	xxx_hidden_Polyline            *string                   `protobuf:"bytes,20,opt,name=polyline"`
+	XXX_raceDetectHookData         protoimpl.RaceDetectHookData
+	XXX_presence                   [1]uint32
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *RoutePlanningRoute) Reset() {
+	*x = RoutePlanningRoute{}
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutePlanningRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutePlanningRoute) ProtoMessage() {}
+
+func (x *RoutePlanningRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RoutePlanningRoute) GetRouteId() int64 {
+	if x != nil {
+		return x.xxx_hidden_RouteId
+	}
+	return 0
+}
+
+func (x *RoutePlanningRoute) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningRoute) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *RoutePlanningRoute) GetDriverId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DriverId
+	}
+	return 0
+}
+
+func (x *RoutePlanningRoute) GetDriverUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DriverUnitId
+	}
+	return 0
+}
+
+func (x *RoutePlanningRoute) GetStart() *Location {
+	if x != nil {
+		return x.xxx_hidden_Start
+	}
+	return nil
+}
+
+func (x *RoutePlanningRoute) GetStartTimezone() string {
+	if x != nil {
+		if x.xxx_hidden_StartTimezone != nil {
+			return *x.xxx_hidden_StartTimezone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningRoute) GetEnd() *Location {
+	if x != nil {
+		return x.xxx_hidden_End
+	}
+	return nil
+}
+
+func (x *RoutePlanningRoute) GetEndTimezone() string {
+	if x != nil {
+		if x.xxx_hidden_EndTimezone != nil {
+			return *x.xxx_hidden_EndTimezone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningRoute) GetDepartureAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_DepartureAt
+	}
+	return nil
+}
+
+func (x *RoutePlanningRoute) GetPlannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_PlannedAt
+	}
+	return nil
+}
+
+func (x *RoutePlanningRoute) GetInProgress() bool {
+	if x != nil {
+		return x.xxx_hidden_InProgress
+	}
+	return false
+}
+
+func (x *RoutePlanningRoute) GetPlacesTotal() int32 {
+	if x != nil {
+		return x.xxx_hidden_PlacesTotal
+	}
+	return 0
+}
+
+func (x *RoutePlanningRoute) GetPlacesCompleted() int32 {
+	if x != nil {
+		return x.xxx_hidden_PlacesCompleted
+	}
+	return 0
+}
+
+func (x *RoutePlanningRoute) GetDistanceM() int64 {
+	if x != nil {
+		return x.xxx_hidden_DistanceM
+	}
+	return 0
+}
+
+func (x *RoutePlanningRoute) GetDurationS() int64 {
+	if x != nil {
+		return x.xxx_hidden_DurationS
+	}
+	return 0
+}
+
+func (x *RoutePlanningRoute) GetCostPerKmEurCents() float64 {
+	if x != nil {
+		return x.xxx_hidden_CostPerKmEurCents
+	}
+	return 0
+}
+
+func (x *RoutePlanningRoute) GetCostPerHourEurCents() float64 {
+	if x != nil {
+		return x.xxx_hidden_CostPerHourEurCents
+	}
+	return 0
+}
+
+func (x *RoutePlanningRoute) GetTollCosts() []*RoutePlanningTollCost {
+	if x != nil {
+		if x.xxx_hidden_TollCosts != nil {
+			return *x.xxx_hidden_TollCosts
+		}
+	}
+	return nil
+}
+
+func (x *RoutePlanningRoute) GetPolyline() string {
+	if x != nil {
+		if x.xxx_hidden_Polyline != nil {
+			return *x.xxx_hidden_Polyline
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RoutePlanningRoute) SetRouteId(v int64) {
+	x.xxx_hidden_RouteId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 20)
+}
+
+func (x *RoutePlanningRoute) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 20)
+}
+
+func (x *RoutePlanningRoute) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 20)
+}
+
+func (x *RoutePlanningRoute) SetDriverId(v int64) {
+	x.xxx_hidden_DriverId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 20)
+}
+
+func (x *RoutePlanningRoute) SetDriverUnitId(v int64) {
+	x.xxx_hidden_DriverUnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 20)
+}
+
+func (x *RoutePlanningRoute) SetStart(v *Location) {
+	x.xxx_hidden_Start = v
+}
+
+func (x *RoutePlanningRoute) SetStartTimezone(v string) {
+	x.xxx_hidden_StartTimezone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 20)
+}
+
+func (x *RoutePlanningRoute) SetEnd(v *Location) {
+	x.xxx_hidden_End = v
+}
+
+func (x *RoutePlanningRoute) SetEndTimezone(v string) {
+	x.xxx_hidden_EndTimezone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 20)
+}
+
+func (x *RoutePlanningRoute) SetDepartureAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_DepartureAt = v
+}
+
+func (x *RoutePlanningRoute) SetPlannedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_PlannedAt = v
+}
+
+func (x *RoutePlanningRoute) SetInProgress(v bool) {
+	x.xxx_hidden_InProgress = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 20)
+}
+
+func (x *RoutePlanningRoute) SetPlacesTotal(v int32) {
+	x.xxx_hidden_PlacesTotal = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 20)
+}
+
+func (x *RoutePlanningRoute) SetPlacesCompleted(v int32) {
+	x.xxx_hidden_PlacesCompleted = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 20)
+}
+
+func (x *RoutePlanningRoute) SetDistanceM(v int64) {
+	x.xxx_hidden_DistanceM = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 20)
+}
+
+func (x *RoutePlanningRoute) SetDurationS(v int64) {
+	x.xxx_hidden_DurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 20)
+}
+
+func (x *RoutePlanningRoute) SetCostPerKmEurCents(v float64) {
+	x.xxx_hidden_CostPerKmEurCents = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 20)
+}
+
+func (x *RoutePlanningRoute) SetCostPerHourEurCents(v float64) {
+	x.xxx_hidden_CostPerHourEurCents = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 17, 20)
+}
+
+func (x *RoutePlanningRoute) SetTollCosts(v []*RoutePlanningTollCost) {
+	x.xxx_hidden_TollCosts = &v
+}
+
+func (x *RoutePlanningRoute) SetPolyline(v string) {
+	x.xxx_hidden_Polyline = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 19, 20)
+}
+
+func (x *RoutePlanningRoute) HasRouteId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RoutePlanningRoute) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RoutePlanningRoute) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *RoutePlanningRoute) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *RoutePlanningRoute) HasDriverUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *RoutePlanningRoute) HasStart() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Start != nil
+}
+
+func (x *RoutePlanningRoute) HasStartTimezone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *RoutePlanningRoute) HasEnd() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_End != nil
+}
+
+func (x *RoutePlanningRoute) HasEndTimezone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *RoutePlanningRoute) HasDepartureAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DepartureAt != nil
+}
+
+func (x *RoutePlanningRoute) HasPlannedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PlannedAt != nil
+}
+
+func (x *RoutePlanningRoute) HasInProgress() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *RoutePlanningRoute) HasPlacesTotal() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *RoutePlanningRoute) HasPlacesCompleted() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *RoutePlanningRoute) HasDistanceM() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *RoutePlanningRoute) HasDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *RoutePlanningRoute) HasCostPerKmEurCents() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 16)
+}
+
+func (x *RoutePlanningRoute) HasCostPerHourEurCents() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 17)
+}
+
+func (x *RoutePlanningRoute) HasPolyline() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 19)
+}
+
+func (x *RoutePlanningRoute) ClearRouteId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_RouteId = 0
+}
+
+func (x *RoutePlanningRoute) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *RoutePlanningRoute) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *RoutePlanningRoute) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_DriverId = 0
+}
+
+func (x *RoutePlanningRoute) ClearDriverUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_DriverUnitId = 0
+}
+
+func (x *RoutePlanningRoute) ClearStart() {
+	x.xxx_hidden_Start = nil
+}
+
+func (x *RoutePlanningRoute) ClearStartTimezone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_StartTimezone = nil
+}
+
+func (x *RoutePlanningRoute) ClearEnd() {
+	x.xxx_hidden_End = nil
+}
+
+func (x *RoutePlanningRoute) ClearEndTimezone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_EndTimezone = nil
+}
+
+func (x *RoutePlanningRoute) ClearDepartureAt() {
+	x.xxx_hidden_DepartureAt = nil
+}
+
+func (x *RoutePlanningRoute) ClearPlannedAt() {
+	x.xxx_hidden_PlannedAt = nil
+}
+
+func (x *RoutePlanningRoute) ClearInProgress() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_InProgress = false
+}
+
+func (x *RoutePlanningRoute) ClearPlacesTotal() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_PlacesTotal = 0
+}
+
+func (x *RoutePlanningRoute) ClearPlacesCompleted() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_PlacesCompleted = 0
+}
+
+func (x *RoutePlanningRoute) ClearDistanceM() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_DistanceM = 0
+}
+
+func (x *RoutePlanningRoute) ClearDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_DurationS = 0
+}
+
+func (x *RoutePlanningRoute) ClearCostPerKmEurCents() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 16)
+	x.xxx_hidden_CostPerKmEurCents = 0
+}
+
+func (x *RoutePlanningRoute) ClearCostPerHourEurCents() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 17)
+	x.xxx_hidden_CostPerHourEurCents = 0
+}
+
+func (x *RoutePlanningRoute) ClearPolyline() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 19)
+	x.xxx_hidden_Polyline = nil
+}
+
+type RoutePlanningRoute_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the route.
+	RouteId *int64
+	// Name of the route.
+	Name *string
+	// Identifier of the unit assigned to the route, when communicating with the vehicle.
+	UnitId *int64
+	// Identifier of the driver assigned to the route, when communicating with the driver.
+	DriverId *int64
+	// Identifier of the unit the driver drives the route with.
+	DriverUnitId *int64
+	// Start location of the route.
+	Start *Location
+	// IANA timezone of the start location.
+	StartTimezone *string
+	// End location of the route.
+	End *Location
+	// IANA timezone of the end location.
+	EndTimezone *string
+	// Planned departure time.
+	DepartureAt *timestamppb.Timestamp
+	// Timestamp when the route was planned.
+	PlannedAt *timestamppb.Timestamp
+	// Whether the route is in progress.
+	InProgress *bool
+	// Total number of places on the route.
+	PlacesTotal *int32
+	// Number of completed places on the route.
+	PlacesCompleted *int32
+	// Total distance of the route in meters.
+	DistanceM *int64
+	// Total duration of the route in seconds.
+	DurationS *int64
+	// Costs per kilometer in euro cents.
+	CostPerKmEurCents *float64
+	// Costs per hour in euro cents.
+	CostPerHourEurCents *float64
+	// Toll costs along the route.
+	TollCosts []*RoutePlanningTollCost
+	// Encoded polyline of the route path. Only set when requested.
+	Polyline *string
+}
+
+func (b0 RoutePlanningRoute_builder) Build() *RoutePlanningRoute {
+	m0 := &RoutePlanningRoute{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.RouteId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 20)
+		x.xxx_hidden_RouteId = *b.RouteId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 20)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 20)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 20)
+		x.xxx_hidden_DriverId = *b.DriverId
+	}
+	if b.DriverUnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 20)
+		x.xxx_hidden_DriverUnitId = *b.DriverUnitId
+	}
+	x.xxx_hidden_Start = b.Start
+	if b.StartTimezone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 20)
+		x.xxx_hidden_StartTimezone = b.StartTimezone
+	}
+	x.xxx_hidden_End = b.End
+	if b.EndTimezone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 20)
+		x.xxx_hidden_EndTimezone = b.EndTimezone
+	}
+	x.xxx_hidden_DepartureAt = b.DepartureAt
+	x.xxx_hidden_PlannedAt = b.PlannedAt
+	if b.InProgress != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 20)
+		x.xxx_hidden_InProgress = *b.InProgress
+	}
+	if b.PlacesTotal != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 20)
+		x.xxx_hidden_PlacesTotal = *b.PlacesTotal
+	}
+	if b.PlacesCompleted != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 20)
+		x.xxx_hidden_PlacesCompleted = *b.PlacesCompleted
+	}
+	if b.DistanceM != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 20)
+		x.xxx_hidden_DistanceM = *b.DistanceM
+	}
+	if b.DurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 20)
+		x.xxx_hidden_DurationS = *b.DurationS
+	}
+	if b.CostPerKmEurCents != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 20)
+		x.xxx_hidden_CostPerKmEurCents = *b.CostPerKmEurCents
+	}
+	if b.CostPerHourEurCents != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 17, 20)
+		x.xxx_hidden_CostPerHourEurCents = *b.CostPerHourEurCents
+	}
+	x.xxx_hidden_TollCosts = &b.TollCosts
+	if b.Polyline != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 19, 20)
+		x.xxx_hidden_Polyline = b.Polyline
+	}
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_route_planning_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_route_planning_proto_rawDesc = "" +
+	"\n" +
+	"1wayplatform/connect/mapon/v1/route_planning.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)wayplatform/connect/mapon/v1/common.proto\"\xa9\x01\n" +
+	"\x1bRoutePlanningDeliveryWindow\x12\x1b\n" +
+	"\tfrom_date\x18\x01 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x02 \x01(\tR\x06toDate\x12\x1b\n" +
+	"\tfrom_time\x18\x03 \x01(\tR\bfromTime\x12\x17\n" +
+	"\ato_time\x18\x04 \x01(\tR\x06toTime\x12\x1e\n" +
+	"\n" +
+	"continuous\x18\x05 \x01(\bR\n" +
+	"continuous\"\xec\x04\n" +
+	"\x17RoutePlanningPlaceInput\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\x03R\bclientId\x12*\n" +
+	"\x11geofence_radius_m\x18\x03 \x01(\x05R\x0fgeofenceRadiusM\x12\x1d\n" +
+	"\n" +
+	"cargo_type\x18\x04 \x01(\tR\tcargoType\x12G\n" +
+	" optimal_temperature_from_celsius\x18\x05 \x01(\x05R\x1doptimalTemperatureFromCelsius\x12C\n" +
+	"\x1eoptimal_temperature_to_celsius\x18\x06 \x01(\x05R\x1boptimalTemperatureToCelsius\x12\x1a\n" +
+	"\bcapacity\x18\a \x01(\x03R\bcapacity\x12,\n" +
+	"\x12capacity_weight_kg\x18\b \x01(\x03R\x10capacityWeightKg\x12\x14\n" +
+	"\x05email\x18\t \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\n" +
+	" \x01(\tR\x05phone\x12\x14\n" +
+	"\x05notes\x18\v \x01(\tR\x05notes\x120\n" +
+	"\x14loading_time_minutes\x18\f \x01(\x05R\x12loadingTimeMinutes\x12b\n" +
+	"\x0fdelivery_window\x18\r \x01(\v29.wayplatform.connect.mapon.v1.RoutePlanningDeliveryWindowR\x0edeliveryWindow\x12\x1f\n" +
+	"\vsort_number\x18\x0e \x01(\x05R\n" +
+	"sortNumber\"\xb4\x03\n" +
+	"\x12RoutePlanningOrder\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12!\n" +
+	"\forder_number\x18\x02 \x01(\tR\vorderNumber\x12I\n" +
+	"\x04type\x18\x03 \x01(\x0e25.wayplatform.connect.mapon.v1.RoutePlanningOrder.TypeR\x04type\x12+\n" +
+	"\x11unrecognized_type\x18\x04 \x01(\tR\x10unrecognizedType\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1d\n" +
+	"\n" +
+	"distance_m\x18\x06 \x01(\x03R\tdistanceM\x12\x1d\n" +
+	"\n" +
+	"duration_s\x18\a \x01(\x03R\tdurationS\x12\x19\n" +
+	"\broute_id\x18\b \x01(\x03R\arouteId\x12\x1a\n" +
+	"\bpolyline\x18\t \x01(\tR\bpolyline\"]\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TYPE_UNRECOGNIZED\x10\x01\x12\x0e\n" +
+	"\n" +
+	"TYPE_VISIT\x10\x02\x12\x18\n" +
+	"\x14TYPE_PICKUP_DELIVERY\x10\x03\"\xd9\x01\n" +
+	"\x11RoutePlanningFile\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\x03R\x06fileId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1b\n" +
+	"\tthumb_url\x18\x04 \x01(\tR\bthumbUrl\x12\x1b\n" +
+	"\tmime_type\x18\x05 \x01(\tR\bmimeType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x03R\tsizeBytes\x12\x14\n" +
+	"\x05width\x18\a \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\b \x01(\x05R\x06height\"\xf3\r\n" +
+	"\x12RoutePlanningPlace\x12\x19\n" +
+	"\bplace_id\x18\x01 \x01(\x03R\aplaceId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x19\n" +
+	"\broute_id\x18\x03 \x01(\x03R\arouteId\x12\x1b\n" +
+	"\tclient_id\x18\x04 \x01(\x03R\bclientId\x12\x1f\n" +
+	"\vsort_number\x18\x05 \x01(\x05R\n" +
+	"sortNumber\x12B\n" +
+	"\blocation\x18\x06 \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\blocation\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\x12*\n" +
+	"\x11geofence_radius_m\x18\b \x01(\x05R\x0fgeofenceRadiusM\x12I\n" +
+	"\x04type\x18\t \x01(\x0e25.wayplatform.connect.mapon.v1.RoutePlanningPlace.TypeR\x04type\x12+\n" +
+	"\x11unrecognized_type\x18\n" +
+	" \x01(\tR\x10unrecognizedType\x12O\n" +
+	"\x06status\x18\v \x01(\x0e27.wayplatform.connect.mapon.v1.RoutePlanningPlace.StatusR\x06status\x12/\n" +
+	"\x13unrecognized_status\x18\f \x01(\tR\x12unrecognizedStatus\x12F\n" +
+	"\x11status_changed_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\x12\x14\n" +
+	"\x05phone\x18\x0e \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x0f \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"cargo_type\x18\x10 \x01(\tR\tcargoType\x12b\n" +
+	"\x0fdelivery_window\x18\x11 \x01(\v29.wayplatform.connect.mapon.v1.RoutePlanningDeliveryWindowR\x0edeliveryWindow\x12G\n" +
+	" optimal_temperature_from_celsius\x18\x12 \x01(\x05R\x1doptimalTemperatureFromCelsius\x12C\n" +
+	"\x1eoptimal_temperature_to_celsius\x18\x13 \x01(\x05R\x1boptimalTemperatureToCelsius\x12,\n" +
+	"\x12capacity_weight_kg\x18\x14 \x01(\x03R\x10capacityWeightKg\x12\x1a\n" +
+	"\bcapacity\x18\x15 \x01(\x03R\bcapacity\x121\n" +
+	"\x15stop_off_time_minutes\x18\x16 \x01(\x05R\x12stopOffTimeMinutes\x12\x14\n" +
+	"\x05notes\x18\x17 \x01(\tR\x05notes\x12;\n" +
+	"\vfinished_at\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\"\n" +
+	"\rdistance_to_m\x18\x19 \x01(\x03R\vdistanceToM\x12\"\n" +
+	"\rduration_to_s\x18\x1a \x01(\x03R\vdurationToS\x12L\n" +
+	"\x14planned_arrival_time\x18\x1b \x01(\v2\x1a.google.protobuf.TimestampR\x12plannedArrivalTime\x12=\n" +
+	"\farrival_time\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampR\varrivalTime\x12&\n" +
+	"\x0fdistance_left_m\x18\x1d \x01(\x03R\rdistanceLeftM\x12\x1f\n" +
+	"\vpolyline_to\x18\x1e \x01(\tR\n" +
+	"polylineTo\x12E\n" +
+	"\x05files\x18\x1f \x03(\v2/.wayplatform.connect.mapon.v1.RoutePlanningFileR\x05files\"g\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TYPE_UNRECOGNIZED\x10\x01\x12\x0e\n" +
+	"\n" +
+	"TYPE_VISIT\x10\x02\x12\x0f\n" +
+	"\vTYPE_PICKUP\x10\x03\x12\x11\n" +
+	"\rTYPE_DELIVERY\x10\x04\"\xa4\x01\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13STATUS_UNRECOGNIZED\x10\x01\x12\x16\n" +
+	"\x12STATUS_NOT_STARTED\x10\x02\x12\x12\n" +
+	"\x0eSTATUS_STARTED\x10\x03\x12\x12\n" +
+	"\x0eSTATUS_LOADING\x10\x04\x12\x14\n" +
+	"\x10STATUS_COMPLETED\x10\x05\x12\x13\n" +
+	"\x0fSTATUS_DECLINED\x10\x06\"x\n" +
+	"\x15RoutePlanningTollCost\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fcountry_code\x18\x02 \x01(\tR\vcountryCode\x12(\n" +
+	"\x10amount_eur_cents\x18\x03 \x01(\x01R\x0eamountEurCents\"\xe0\x06\n" +
+	"\x12RoutePlanningRoute\x12\x19\n" +
+	"\broute_id\x18\x01 \x01(\x03R\arouteId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\aunit_id\x18\x03 \x01(\x03R\x06unitId\x12\x1b\n" +
+	"\tdriver_id\x18\x04 \x01(\x03R\bdriverId\x12$\n" +
+	"\x0edriver_unit_id\x18\x05 \x01(\x03R\fdriverUnitId\x12<\n" +
+	"\x05start\x18\x06 \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\x05start\x12%\n" +
+	"\x0estart_timezone\x18\a \x01(\tR\rstartTimezone\x128\n" +
+	"\x03end\x18\b \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\x03end\x12!\n" +
+	"\fend_timezone\x18\t \x01(\tR\vendTimezone\x12=\n" +
+	"\fdeparture_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vdepartureAt\x129\n" +
+	"\n" +
+	"planned_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tplannedAt\x12\x1f\n" +
+	"\vin_progress\x18\f \x01(\bR\n" +
+	"inProgress\x12!\n" +
+	"\fplaces_total\x18\r \x01(\x05R\vplacesTotal\x12)\n" +
+	"\x10places_completed\x18\x0e \x01(\x05R\x0fplacesCompleted\x12\x1d\n" +
+	"\n" +
+	"distance_m\x18\x0f \x01(\x03R\tdistanceM\x12\x1d\n" +
+	"\n" +
+	"duration_s\x18\x10 \x01(\x03R\tdurationS\x120\n" +
+	"\x15cost_per_km_eur_cents\x18\x11 \x01(\x01R\x11costPerKmEurCents\x124\n" +
+	"\x17cost_per_hour_eur_cents\x18\x12 \x01(\x01R\x13costPerHourEurCents\x12R\n" +
+	"\n" +
+	"toll_costs\x18\x13 \x03(\v23.wayplatform.connect.mapon.v1.RoutePlanningTollCostR\ttollCosts\x12\x1a\n" +
+	"\bpolyline\x18\x14 \x01(\tR\bpolyline*\xa6\x04\n" +
+	"\x1fRoutePlanningOptimizationStatus\x122\n" +
+	".ROUTE_PLANNING_OPTIMIZATION_STATUS_UNSPECIFIED\x10\x00\x123\n" +
+	"/ROUTE_PLANNING_OPTIMIZATION_STATUS_UNRECOGNIZED\x10\x01\x12.\n" +
+	"*ROUTE_PLANNING_OPTIMIZATION_STATUS_WAITING\x10\x02\x12.\n" +
+	"*ROUTE_PLANNING_OPTIMIZATION_STATUS_RUNNING\x10\x03\x12/\n" +
+	"+ROUTE_PLANNING_OPTIMIZATION_STATUS_FINISHED\x10\x04\x12/\n" +
+	"+ROUTE_PLANNING_OPTIMIZATION_STATUS_CANCELED\x10\x05\x12-\n" +
+	")ROUTE_PLANNING_OPTIMIZATION_STATUS_FAILED\x10\x06\x122\n" +
+	".ROUTE_PLANNING_OPTIMIZATION_STATUS_NO_SOLUTION\x10\a\x129\n" +
+	"5ROUTE_PLANNING_OPTIMIZATION_STATUS_UNREACHABLE_POINTS\x10\b\x12:\n" +
+	"6ROUTE_PLANNING_OPTIMIZATION_STATUS_NOTHING_TO_OPTIMIZE\x10\tB\x9d\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x12RoutePlanningProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_route_planning_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_wayplatform_connect_mapon_v1_route_planning_proto_goTypes = []any{
+	(RoutePlanningOptimizationStatus)(0), // 0: wayplatform.connect.mapon.v1.RoutePlanningOptimizationStatus
+	(RoutePlanningOrder_Type)(0),         // 1: wayplatform.connect.mapon.v1.RoutePlanningOrder.Type
+	(RoutePlanningPlace_Type)(0),         // 2: wayplatform.connect.mapon.v1.RoutePlanningPlace.Type
+	(RoutePlanningPlace_Status)(0),       // 3: wayplatform.connect.mapon.v1.RoutePlanningPlace.Status
+	(*RoutePlanningDeliveryWindow)(nil),  // 4: wayplatform.connect.mapon.v1.RoutePlanningDeliveryWindow
+	(*RoutePlanningPlaceInput)(nil),      // 5: wayplatform.connect.mapon.v1.RoutePlanningPlaceInput
+	(*RoutePlanningOrder)(nil),           // 6: wayplatform.connect.mapon.v1.RoutePlanningOrder
+	(*RoutePlanningFile)(nil),            // 7: wayplatform.connect.mapon.v1.RoutePlanningFile
+	(*RoutePlanningPlace)(nil),           // 8: wayplatform.connect.mapon.v1.RoutePlanningPlace
+	(*RoutePlanningTollCost)(nil),        // 9: wayplatform.connect.mapon.v1.RoutePlanningTollCost
+	(*RoutePlanningRoute)(nil),           // 10: wayplatform.connect.mapon.v1.RoutePlanningRoute
+	(*Location)(nil),                     // 11: wayplatform.connect.mapon.v1.Location
+	(*timestamppb.Timestamp)(nil),        // 12: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_mapon_v1_route_planning_proto_depIdxs = []int32{
+	4,  // 0: wayplatform.connect.mapon.v1.RoutePlanningPlaceInput.delivery_window:type_name -> wayplatform.connect.mapon.v1.RoutePlanningDeliveryWindow
+	1,  // 1: wayplatform.connect.mapon.v1.RoutePlanningOrder.type:type_name -> wayplatform.connect.mapon.v1.RoutePlanningOrder.Type
+	11, // 2: wayplatform.connect.mapon.v1.RoutePlanningPlace.location:type_name -> wayplatform.connect.mapon.v1.Location
+	2,  // 3: wayplatform.connect.mapon.v1.RoutePlanningPlace.type:type_name -> wayplatform.connect.mapon.v1.RoutePlanningPlace.Type
+	3,  // 4: wayplatform.connect.mapon.v1.RoutePlanningPlace.status:type_name -> wayplatform.connect.mapon.v1.RoutePlanningPlace.Status
+	12, // 5: wayplatform.connect.mapon.v1.RoutePlanningPlace.status_changed_at:type_name -> google.protobuf.Timestamp
+	4,  // 6: wayplatform.connect.mapon.v1.RoutePlanningPlace.delivery_window:type_name -> wayplatform.connect.mapon.v1.RoutePlanningDeliveryWindow
+	12, // 7: wayplatform.connect.mapon.v1.RoutePlanningPlace.finished_at:type_name -> google.protobuf.Timestamp
+	12, // 8: wayplatform.connect.mapon.v1.RoutePlanningPlace.planned_arrival_time:type_name -> google.protobuf.Timestamp
+	12, // 9: wayplatform.connect.mapon.v1.RoutePlanningPlace.arrival_time:type_name -> google.protobuf.Timestamp
+	7,  // 10: wayplatform.connect.mapon.v1.RoutePlanningPlace.files:type_name -> wayplatform.connect.mapon.v1.RoutePlanningFile
+	11, // 11: wayplatform.connect.mapon.v1.RoutePlanningRoute.start:type_name -> wayplatform.connect.mapon.v1.Location
+	11, // 12: wayplatform.connect.mapon.v1.RoutePlanningRoute.end:type_name -> wayplatform.connect.mapon.v1.Location
+	12, // 13: wayplatform.connect.mapon.v1.RoutePlanningRoute.departure_at:type_name -> google.protobuf.Timestamp
+	12, // 14: wayplatform.connect.mapon.v1.RoutePlanningRoute.planned_at:type_name -> google.protobuf.Timestamp
+	9,  // 15: wayplatform.connect.mapon.v1.RoutePlanningRoute.toll_costs:type_name -> wayplatform.connect.mapon.v1.RoutePlanningTollCost
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_route_planning_proto_init() }
+func file_wayplatform_connect_mapon_v1_route_planning_proto_init() {
+	if File_wayplatform_connect_mapon_v1_route_planning_proto != nil {
+		return
+	}
+	file_wayplatform_connect_mapon_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_route_planning_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_route_planning_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_route_planning_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_route_planning_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_route_planning_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_route_planning_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_route_planning_proto = out.File
+	file_wayplatform_connect_mapon_v1_route_planning_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_route_planning_proto_depIdxs = nil
+}
@@ -0,0 +1,698 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/tachograph.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DriverDddFile represents a DDD file downloaded from a driver's tachograph card.
+type DriverDddFile struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FileId        int64                  `protobuf:"varint,1,opt,name=file_id,json=fileId"`
+	xxx_hidden_UnitId        int64                  `protobuf:"varint,2,opt,name=unit_id,json=unitId"`
+	xxx_hidden_CardNumber    *string                `protobuf:"bytes,3,opt,name=card_number,json=cardNumber"`
+	xxx_hidden_CardName      *string                `protobuf:"bytes,4,opt,name=card_name,json=cardName"`
+	xxx_hidden_CardSurname   *string                `protobuf:"bytes,5,opt,name=card_surname,json=cardSurname"`
+	xxx_hidden_CardBirthDate *string                `protobuf:"bytes,6,opt,name=card_birth_date,json=cardBirthDate"`
+	xxx_hidden_CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Filename      *string                `protobuf:"bytes,8,opt,name=filename"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *DriverDddFile) Reset() {
+	*x = DriverDddFile{}
+	mi := &file_wayplatform_connect_mapon_v1_tachograph_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverDddFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverDddFile) ProtoMessage() {}
+
+func (x *DriverDddFile) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_tachograph_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverDddFile) GetFileId() int64 {
+	if x != nil {
+		return x.xxx_hidden_FileId
+	}
+	return 0
+}
+
+func (x *DriverDddFile) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *DriverDddFile) GetCardNumber() string {
+	if x != nil {
+		if x.xxx_hidden_CardNumber != nil {
+			return *x.xxx_hidden_CardNumber
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverDddFile) GetCardName() string {
+	if x != nil {
+		if x.xxx_hidden_CardName != nil {
+			return *x.xxx_hidden_CardName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverDddFile) GetCardSurname() string {
+	if x != nil {
+		if x.xxx_hidden_CardSurname != nil {
+			return *x.xxx_hidden_CardSurname
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverDddFile) GetCardBirthDate() string {
+	if x != nil {
+		if x.xxx_hidden_CardBirthDate != nil {
+			return *x.xxx_hidden_CardBirthDate
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverDddFile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *DriverDddFile) GetFilename() string {
+	if x != nil {
+		if x.xxx_hidden_Filename != nil {
+			return *x.xxx_hidden_Filename
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverDddFile) SetFileId(v int64) {
+	x.xxx_hidden_FileId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *DriverDddFile) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *DriverDddFile) SetCardNumber(v string) {
+	x.xxx_hidden_CardNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *DriverDddFile) SetCardName(v string) {
+	x.xxx_hidden_CardName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *DriverDddFile) SetCardSurname(v string) {
+	x.xxx_hidden_CardSurname = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+}
+
+func (x *DriverDddFile) SetCardBirthDate(v string) {
+	x.xxx_hidden_CardBirthDate = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *DriverDddFile) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *DriverDddFile) SetFilename(v string) {
+	x.xxx_hidden_Filename = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *DriverDddFile) HasFileId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverDddFile) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverDddFile) HasCardNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DriverDddFile) HasCardName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DriverDddFile) HasCardSurname() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DriverDddFile) HasCardBirthDate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *DriverDddFile) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *DriverDddFile) HasFilename() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *DriverDddFile) ClearFileId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_FileId = 0
+}
+
+func (x *DriverDddFile) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *DriverDddFile) ClearCardNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CardNumber = nil
+}
+
+func (x *DriverDddFile) ClearCardName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_CardName = nil
+}
+
+func (x *DriverDddFile) ClearCardSurname() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_CardSurname = nil
+}
+
+func (x *DriverDddFile) ClearCardBirthDate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_CardBirthDate = nil
+}
+
+func (x *DriverDddFile) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *DriverDddFile) ClearFilename() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Filename = nil
+}
+
+type DriverDddFile_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the file.
+	FileId *int64
+	// The ID of the unit the card was read in.
+	UnitId *int64
+	// Number of the driver card.
+	CardNumber *string
+	// First name of the card holder.
+	CardName *string
+	// Last name of the card holder.
+	CardSurname *string
+	// Birth date of the card holder (YYYY-MM-DD).
+	CardBirthDate *string
+	// Timestamp when the file was downloaded from the card.
+	CreatedAt *timestamppb.Timestamp
+	// Name of the file.
+	Filename *string
+}
+
+func (b0 DriverDddFile_builder) Build() *DriverDddFile {
+	m0 := &DriverDddFile{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.FileId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_FileId = *b.FileId
+	}
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.CardNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_CardNumber = b.CardNumber
+	}
+	if b.CardName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_CardName = b.CardName
+	}
+	if b.CardSurname != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		x.xxx_hidden_CardSurname = b.CardSurname
+	}
+	if b.CardBirthDate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_CardBirthDate = b.CardBirthDate
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Filename != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_Filename = b.Filename
+	}
+	return m0
+}
+
+// VehicleDddFile represents a DDD file downloaded from a vehicle unit's tachograph.
+type VehicleDddFile struct {
+	state                          protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FileId              int64                  `protobuf:"varint,1,opt,name=file_id,json=fileId"`
+	xxx_hidden_UnitId              int64                  `protobuf:"varint,2,opt,name=unit_id,json=unitId"`
+	xxx_hidden_RegistrationNumber  *string                `protobuf:"bytes,3,opt,name=registration_number,json=registrationNumber"`
+	xxx_hidden_PeriodStartDate     *string                `protobuf:"bytes,4,opt,name=period_start_date,json=periodStartDate"`
+	xxx_hidden_PeriodEndDate       *string                `protobuf:"bytes,5,opt,name=period_end_date,json=periodEndDate"`
+	xxx_hidden_NextCalibrationDate *string                `protobuf:"bytes,6,opt,name=next_calibration_date,json=nextCalibrationDate"`
+	xxx_hidden_CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Filename            *string                `protobuf:"bytes,8,opt,name=filename"`
+	XXX_raceDetectHookData         protoimpl.RaceDetectHookData
+	XXX_presence                   [1]uint32
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *VehicleDddFile) Reset() {
+	*x = VehicleDddFile{}
+	mi := &file_wayplatform_connect_mapon_v1_tachograph_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehicleDddFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleDddFile) ProtoMessage() {}
+
+func (x *VehicleDddFile) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_tachograph_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VehicleDddFile) GetFileId() int64 {
+	if x != nil {
+		return x.xxx_hidden_FileId
+	}
+	return 0
+}
+
+func (x *VehicleDddFile) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *VehicleDddFile) GetRegistrationNumber() string {
+	if x != nil {
+		if x.xxx_hidden_RegistrationNumber != nil {
+			return *x.xxx_hidden_RegistrationNumber
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleDddFile) GetPeriodStartDate() string {
+	if x != nil {
+		if x.xxx_hidden_PeriodStartDate != nil {
+			return *x.xxx_hidden_PeriodStartDate
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleDddFile) GetPeriodEndDate() string {
+	if x != nil {
+		if x.xxx_hidden_PeriodEndDate != nil {
+			return *x.xxx_hidden_PeriodEndDate
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleDddFile) GetNextCalibrationDate() string {
+	if x != nil {
+		if x.xxx_hidden_NextCalibrationDate != nil {
+			return *x.xxx_hidden_NextCalibrationDate
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleDddFile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *VehicleDddFile) GetFilename() string {
+	if x != nil {
+		if x.xxx_hidden_Filename != nil {
+			return *x.xxx_hidden_Filename
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleDddFile) SetFileId(v int64) {
+	x.xxx_hidden_FileId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *VehicleDddFile) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *VehicleDddFile) SetRegistrationNumber(v string) {
+	x.xxx_hidden_RegistrationNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *VehicleDddFile) SetPeriodStartDate(v string) {
+	x.xxx_hidden_PeriodStartDate = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *VehicleDddFile) SetPeriodEndDate(v string) {
+	x.xxx_hidden_PeriodEndDate = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+}
+
+func (x *VehicleDddFile) SetNextCalibrationDate(v string) {
+	x.xxx_hidden_NextCalibrationDate = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *VehicleDddFile) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *VehicleDddFile) SetFilename(v string) {
+	x.xxx_hidden_Filename = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *VehicleDddFile) HasFileId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *VehicleDddFile) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *VehicleDddFile) HasRegistrationNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *VehicleDddFile) HasPeriodStartDate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *VehicleDddFile) HasPeriodEndDate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *VehicleDddFile) HasNextCalibrationDate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *VehicleDddFile) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *VehicleDddFile) HasFilename() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *VehicleDddFile) ClearFileId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_FileId = 0
+}
+
+func (x *VehicleDddFile) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *VehicleDddFile) ClearRegistrationNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_RegistrationNumber = nil
+}
+
+func (x *VehicleDddFile) ClearPeriodStartDate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_PeriodStartDate = nil
+}
+
+func (x *VehicleDddFile) ClearPeriodEndDate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_PeriodEndDate = nil
+}
+
+func (x *VehicleDddFile) ClearNextCalibrationDate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_NextCalibrationDate = nil
+}
+
+func (x *VehicleDddFile) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *VehicleDddFile) ClearFilename() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Filename = nil
+}
+
+type VehicleDddFile_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the file.
+	FileId *int64
+	// The ID of the unit.
+	UnitId *int64
+	// Registration number of the vehicle.
+	RegistrationNumber *string
+	// First day of the period covered by the file (YYYY-MM-DD).
+	PeriodStartDate *string
+	// Last day of the period covered by the file (YYYY-MM-DD).
+	PeriodEndDate *string
+	// Date of the next tachograph calibration (YYYY-MM-DD).
+	NextCalibrationDate *string
+	// Timestamp when the file was downloaded from the tachograph.
+	CreatedAt *timestamppb.Timestamp
+	// Name of the file.
+	Filename *string
+}
+
+func (b0 VehicleDddFile_builder) Build() *VehicleDddFile {
+	m0 := &VehicleDddFile{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.FileId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_FileId = *b.FileId
+	}
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.RegistrationNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_RegistrationNumber = b.RegistrationNumber
+	}
+	if b.PeriodStartDate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_PeriodStartDate = b.PeriodStartDate
+	}
+	if b.PeriodEndDate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		x.xxx_hidden_PeriodEndDate = b.PeriodEndDate
+	}
+	if b.NextCalibrationDate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_NextCalibrationDate = b.NextCalibrationDate
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Filename != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_Filename = b.Filename
+	}
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_tachograph_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_tachograph_proto_rawDesc = "" +
+	"\n" +
+	"-wayplatform/connect/mapon/v1/tachograph.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa1\x02\n" +
+	"\rDriverDddFile\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\x03R\x06fileId\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\x03R\x06unitId\x12\x1f\n" +
+	"\vcard_number\x18\x03 \x01(\tR\n" +
+	"cardNumber\x12\x1b\n" +
+	"\tcard_name\x18\x04 \x01(\tR\bcardName\x12!\n" +
+	"\fcard_surname\x18\x05 \x01(\tR\vcardSurname\x12&\n" +
+	"\x0fcard_birth_date\x18\x06 \x01(\tR\rcardBirthDate\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bfilename\x18\b \x01(\tR\bfilename\"\xd2\x02\n" +
+	"\x0eVehicleDddFile\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\x03R\x06fileId\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\x03R\x06unitId\x12/\n" +
+	"\x13registration_number\x18\x03 \x01(\tR\x12registrationNumber\x12*\n" +
+	"\x11period_start_date\x18\x04 \x01(\tR\x0fperiodStartDate\x12&\n" +
+	"\x0fperiod_end_date\x18\x05 \x01(\tR\rperiodEndDate\x122\n" +
+	"\x15next_calibration_date\x18\x06 \x01(\tR\x13nextCalibrationDate\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bfilename\x18\b \x01(\tR\bfilenameB\x9a\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x0fTachographProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_tachograph_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_mapon_v1_tachograph_proto_goTypes = []any{
+	(*DriverDddFile)(nil),         // 0: wayplatform.connect.mapon.v1.DriverDddFile
+	(*VehicleDddFile)(nil),        // 1: wayplatform.connect.mapon.v1.VehicleDddFile
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_mapon_v1_tachograph_proto_depIdxs = []int32{
+	2, // 0: wayplatform.connect.mapon.v1.DriverDddFile.created_at:type_name -> google.protobuf.Timestamp
+	2, // 1: wayplatform.connect.mapon.v1.VehicleDddFile.created_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_tachograph_proto_init() }
+func file_wayplatform_connect_mapon_v1_tachograph_proto_init() {
+	if File_wayplatform_connect_mapon_v1_tachograph_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_tachograph_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_tachograph_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_tachograph_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_tachograph_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_tachograph_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_tachograph_proto = out.File
+	file_wayplatform_connect_mapon_v1_tachograph_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_tachograph_proto_depIdxs = nil
+}
@@ -0,0 +1,1540 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/task.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Type enum defining the kinds of tasks.
+type Task_Type int32
+
+const (
+	// Default value, used when the type is missing or not set.
+	Task_TYPE_UNSPECIFIED Task_Type = 0
+	// Used when the received value does not match any known enum member.
+	Task_TYPE_UNRECOGNIZED Task_Type = 1
+	// Loading cargo.
+	Task_TYPE_LOADING Task_Type = 2
+	// Unloading cargo.
+	Task_TYPE_UNLOADING Task_Type = 3
+	// Refueling the vehicle.
+	Task_TYPE_FUELING Task_Type = 4
+	// Changing the driver.
+	Task_TYPE_DRIVER_CHANGE Task_Type = 5
+	// Changing the trailer.
+	Task_TYPE_TRAILER_CHANGE Task_Type = 6
+	// Driver rest.
+	Task_TYPE_RESTING Task_Type = 7
+	// Ferry crossing.
+	Task_TYPE_FERRY Task_Type = 8
+	// Train transport.
+	Task_TYPE_TRAIN Task_Type = 9
+	// Crossing a border.
+	Task_TYPE_BORDER_CROSSING Task_Type = 10
+	// Hooking on a trailer.
+	Task_TYPE_TRAILER_HOOK_ON Task_Type = 11
+	// Hooking off a trailer.
+	Task_TYPE_TRAILER_HOOK_OFF Task_Type = 12
+)
+
+// Enum value maps for Task_Type.
+var (
+	Task_Type_name = map[int32]string{
+		0:  "TYPE_UNSPECIFIED",
+		1:  "TYPE_UNRECOGNIZED",
+		2:  "TYPE_LOADING",
+		3:  "TYPE_UNLOADING",
+		4:  "TYPE_FUELING",
+		5:  "TYPE_DRIVER_CHANGE",
+		6:  "TYPE_TRAILER_CHANGE",
+		7:  "TYPE_RESTING",
+		8:  "TYPE_FERRY",
+		9:  "TYPE_TRAIN",
+		10: "TYPE_BORDER_CROSSING",
+		11: "TYPE_TRAILER_HOOK_ON",
+		12: "TYPE_TRAILER_HOOK_OFF",
+	}
+	Task_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":      0,
+		"TYPE_UNRECOGNIZED":     1,
+		"TYPE_LOADING":          2,
+		"TYPE_UNLOADING":        3,
+		"TYPE_FUELING":          4,
+		"TYPE_DRIVER_CHANGE":    5,
+		"TYPE_TRAILER_CHANGE":   6,
+		"TYPE_RESTING":          7,
+		"TYPE_FERRY":            8,
+		"TYPE_TRAIN":            9,
+		"TYPE_BORDER_CROSSING":  10,
+		"TYPE_TRAILER_HOOK_ON":  11,
+		"TYPE_TRAILER_HOOK_OFF": 12,
+	}
+)
+
+func (x Task_Type) Enum() *Task_Type {
+	p := new(Task_Type)
+	*p = x
+	return p
+}
+
+func (x Task_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Task_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_task_proto_enumTypes[0].Descriptor()
+}
+
+func (Task_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_task_proto_enumTypes[0]
+}
+
+func (x Task_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Status enum defining the progress states of a task.
+type Task_Status int32
+
+const (
+	// Default value, used when the status is missing or not set.
+	Task_STATUS_UNSPECIFIED Task_Status = 0
+	// Used when the received value does not match any known enum member.
+	Task_STATUS_UNRECOGNIZED Task_Status = 1
+	// The task has not been started.
+	Task_STATUS_NOT_STARTED Task_Status = 2
+	// The task is in progress.
+	Task_STATUS_IN_PROGRESS Task_Status = 3
+	// The task is waiting, e.g. for the cargo to be ready.
+	Task_STATUS_WAITING Task_Status = 4
+	// The task has been completed.
+	Task_STATUS_COMPLETED Task_Status = 5
+)
+
+// Enum value maps for Task_Status.
+var (
+	Task_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_UNRECOGNIZED",
+		2: "STATUS_NOT_STARTED",
+		3: "STATUS_IN_PROGRESS",
+		4: "STATUS_WAITING",
+		5: "STATUS_COMPLETED",
+	}
+	Task_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED":  0,
+		"STATUS_UNRECOGNIZED": 1,
+		"STATUS_NOT_STARTED":  2,
+		"STATUS_IN_PROGRESS":  3,
+		"STATUS_WAITING":      4,
+		"STATUS_COMPLETED":    5,
+	}
+)
+
+func (x Task_Status) Enum() *Task_Status {
+	p := new(Task_Status)
+	*p = x
+	return p
+}
+
+func (x Task_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Task_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_task_proto_enumTypes[1].Descriptor()
+}
+
+func (Task_Status) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_task_proto_enumTypes[1]
+}
+
+func (x Task_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Task represents a job assigned to a unit, e.g. loading or unloading at a destination.
+// Tasks can be grouped into task routes, which define the order in which they are performed.
+type Task struct {
+	state                            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TaskId                int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId"`
+	xxx_hidden_UnitId                int64                  `protobuf:"varint,2,opt,name=unit_id,json=unitId"`
+	xxx_hidden_Type                  Task_Type              `protobuf:"varint,3,opt,name=type,enum=wayplatform.connect.mapon.v1.Task_Type"`
+	xxx_hidden_UnrecognizedType      *string                `protobuf:"bytes,4,opt,name=unrecognized_type,json=unrecognizedType"`
+	xxx_hidden_Status                Task_Status            `protobuf:"varint,5,opt,name=status,enum=wayplatform.connect.mapon.v1.Task_Status"`
+	xxx_hidden_UnrecognizedStatus    *string                `protobuf:"bytes,6,opt,name=unrecognized_status,json=unrecognizedStatus"`
+	xxx_hidden_PlannedArrivalTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=planned_arrival_time,json=plannedArrivalTime"`
+	xxx_hidden_Destination           *TaskDestination       `protobuf:"bytes,8,opt,name=destination"`
+	xxx_hidden_Instructions          *string                `protobuf:"bytes,9,opt,name=instructions"`
+	xxx_hidden_OrderId               *string                `protobuf:"bytes,10,opt,name=order_id,json=orderId"`
+	xxx_hidden_ClientId              *string                `protobuf:"bytes,11,opt,name=client_id,json=clientId"`
+	xxx_hidden_Client                *string                `protobuf:"bytes,12,opt,name=client"`
+	xxx_hidden_RouteId               int64                  `protobuf:"varint,13,opt,name=route_id,json=routeId"`
+	xxx_hidden_RouteSequenceNum      int32                  `protobuf:"varint,14,opt,name=route_sequence_num,json=routeSequenceNum"`
+	xxx_hidden_RouteSection          *TaskRouteSection      `protobuf:"bytes,15,opt,name=route_section,json=routeSection"`
+	xxx_hidden_TrailerId             int64                  `protobuf:"varint,16,opt,name=trailer_id,json=trailerId"`
+	xxx_hidden_RequiredDocumentTypes []string               `protobuf:"bytes,17,rep,name=required_document_types,json=requiredDocumentTypes"`
+	xxx_hidden_AttachmentUrls        []string               `protobuf:"bytes,18,rep,name=attachment_urls,json=attachmentUrls"`
+	xxx_hidden_Documents             *[]*TaskDocument       `protobuf:"bytes,19,rep,name=documents"`
+	xxx_hidden_CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt"`
+	xxx_hidden_UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_CompletedAt           *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=completed_at,json=completedAt"`
+	XXX_raceDetectHookData           protoimpl.RaceDetectHookData
+	XXX_presence                     [1]uint32
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_wayplatform_connect_mapon_v1_task_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_task_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Task) GetTaskId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TaskId
+	}
+	return 0
+}
+
+func (x *Task) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *Task) GetType() Task_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return Task_TYPE_UNSPECIFIED
+}
+
+func (x *Task) GetUnrecognizedType() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedType != nil {
+			return *x.xxx_hidden_UnrecognizedType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Task) GetStatus() Task_Status {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 4) {
+			return x.xxx_hidden_Status
+		}
+	}
+	return Task_STATUS_UNSPECIFIED
+}
+
+func (x *Task) GetUnrecognizedStatus() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedStatus != nil {
+			return *x.xxx_hidden_UnrecognizedStatus
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Task) GetPlannedArrivalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_PlannedArrivalTime
+	}
+	return nil
+}
+
+func (x *Task) GetDestination() *TaskDestination {
+	if x != nil {
+		return x.xxx_hidden_Destination
+	}
+	return nil
+}
+
+func (x *Task) GetInstructions() string {
+	if x != nil {
+		if x.xxx_hidden_Instructions != nil {
+			return *x.xxx_hidden_Instructions
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Task) GetOrderId() string {
+	if x != nil {
+		if x.xxx_hidden_OrderId != nil {
+			return *x.xxx_hidden_OrderId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Task) GetClientId() string {
+	if x != nil {
+		if x.xxx_hidden_ClientId != nil {
+			return *x.xxx_hidden_ClientId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Task) GetClient() string {
+	if x != nil {
+		if x.xxx_hidden_Client != nil {
+			return *x.xxx_hidden_Client
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Task) GetRouteId() int64 {
+	if x != nil {
+		return x.xxx_hidden_RouteId
+	}
+	return 0
+}
+
+func (x *Task) GetRouteSequenceNum() int32 {
+	if x != nil {
+		return x.xxx_hidden_RouteSequenceNum
+	}
+	return 0
+}
+
+func (x *Task) GetRouteSection() *TaskRouteSection {
+	if x != nil {
+		return x.xxx_hidden_RouteSection
+	}
+	return nil
+}
+
+func (x *Task) GetTrailerId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TrailerId
+	}
+	return 0
+}
+
+func (x *Task) GetRequiredDocumentTypes() []string {
+	if x != nil {
+		return x.xxx_hidden_RequiredDocumentTypes
+	}
+	return nil
+}
+
+func (x *Task) GetAttachmentUrls() []string {
+	if x != nil {
+		return x.xxx_hidden_AttachmentUrls
+	}
+	return nil
+}
+
+func (x *Task) GetDocuments() []*TaskDocument {
+	if x != nil {
+		if x.xxx_hidden_Documents != nil {
+			return *x.xxx_hidden_Documents
+		}
+	}
+	return nil
+}
+
+func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CompletedAt
+	}
+	return nil
+}
+
+func (x *Task) SetTaskId(v int64) {
+	x.xxx_hidden_TaskId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 22)
+}
+
+func (x *Task) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 22)
+}
+
+func (x *Task) SetType(v Task_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 22)
+}
+
+func (x *Task) SetUnrecognizedType(v string) {
+	x.xxx_hidden_UnrecognizedType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 22)
+}
+
+func (x *Task) SetStatus(v Task_Status) {
+	x.xxx_hidden_Status = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 22)
+}
+
+func (x *Task) SetUnrecognizedStatus(v string) {
+	x.xxx_hidden_UnrecognizedStatus = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 22)
+}
+
+func (x *Task) SetPlannedArrivalTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_PlannedArrivalTime = v
+}
+
+func (x *Task) SetDestination(v *TaskDestination) {
+	x.xxx_hidden_Destination = v
+}
+
+func (x *Task) SetInstructions(v string) {
+	x.xxx_hidden_Instructions = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 22)
+}
+
+func (x *Task) SetOrderId(v string) {
+	x.xxx_hidden_OrderId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 22)
+}
+
+func (x *Task) SetClientId(v string) {
+	x.xxx_hidden_ClientId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 22)
+}
+
+func (x *Task) SetClient(v string) {
+	x.xxx_hidden_Client = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 22)
+}
+
+func (x *Task) SetRouteId(v int64) {
+	x.xxx_hidden_RouteId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 22)
+}
+
+func (x *Task) SetRouteSequenceNum(v int32) {
+	x.xxx_hidden_RouteSequenceNum = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 22)
+}
+
+func (x *Task) SetRouteSection(v *TaskRouteSection) {
+	x.xxx_hidden_RouteSection = v
+}
+
+func (x *Task) SetTrailerId(v int64) {
+	x.xxx_hidden_TrailerId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 22)
+}
+
+func (x *Task) SetRequiredDocumentTypes(v []string) {
+	x.xxx_hidden_RequiredDocumentTypes = v
+}
+
+func (x *Task) SetAttachmentUrls(v []string) {
+	x.xxx_hidden_AttachmentUrls = v
+}
+
+func (x *Task) SetDocuments(v []*TaskDocument) {
+	x.xxx_hidden_Documents = &v
+}
+
+func (x *Task) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Task) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *Task) SetCompletedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CompletedAt = v
+}
+
+func (x *Task) HasTaskId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Task) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Task) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Task) HasUnrecognizedType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Task) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Task) HasUnrecognizedStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Task) HasPlannedArrivalTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PlannedArrivalTime != nil
+}
+
+func (x *Task) HasDestination() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Destination != nil
+}
+
+func (x *Task) HasInstructions() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *Task) HasOrderId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *Task) HasClientId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *Task) HasClient() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *Task) HasRouteId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *Task) HasRouteSequenceNum() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *Task) HasRouteSection() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RouteSection != nil
+}
+
+func (x *Task) HasTrailerId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *Task) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Task) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *Task) HasCompletedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CompletedAt != nil
+}
+
+func (x *Task) ClearTaskId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_TaskId = 0
+}
+
+func (x *Task) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *Task) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Type = Task_TYPE_UNSPECIFIED
+}
+
+func (x *Task) ClearUnrecognizedType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_UnrecognizedType = nil
+}
+
+func (x *Task) ClearStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Status = Task_STATUS_UNSPECIFIED
+}
+
+func (x *Task) ClearUnrecognizedStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_UnrecognizedStatus = nil
+}
+
+func (x *Task) ClearPlannedArrivalTime() {
+	x.xxx_hidden_PlannedArrivalTime = nil
+}
+
+func (x *Task) ClearDestination() {
+	x.xxx_hidden_Destination = nil
+}
+
+func (x *Task) ClearInstructions() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Instructions = nil
+}
+
+func (x *Task) ClearOrderId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_OrderId = nil
+}
+
+func (x *Task) ClearClientId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_ClientId = nil
+}
+
+func (x *Task) ClearClient() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_Client = nil
+}
+
+func (x *Task) ClearRouteId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_RouteId = 0
+}
+
+func (x *Task) ClearRouteSequenceNum() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_RouteSequenceNum = 0
+}
+
+func (x *Task) ClearRouteSection() {
+	x.xxx_hidden_RouteSection = nil
+}
+
+func (x *Task) ClearTrailerId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_TrailerId = 0
+}
+
+func (x *Task) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Task) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *Task) ClearCompletedAt() {
+	x.xxx_hidden_CompletedAt = nil
+}
+
+type Task_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the task.
+	TaskId *int64
+	// Identifier of the unit the task is assigned to.
+	UnitId *int64
+	// Type of the task.
+	Type *Task_Type
+	// The raw string value of the type if it is not one of the known Type enum values.
+	UnrecognizedType *string
+	// Status of the task.
+	Status *Task_Status
+	// The raw string value of the status if it is not one of the known Status enum values.
+	UnrecognizedStatus *string
+	// Planned arrival time at the destination.
+	// Required when creating or editing a task, not returned when listing tasks.
+	PlannedArrivalTime *timestamppb.Timestamp
+	// Destination of the task.
+	Destination *TaskDestination
+	// Instructions for the driver.
+	Instructions *string
+	// Identifier of the order associated with the task. Only used when creating or editing a task.
+	OrderId *string
+	// Identifier of the client associated with the task. Only used when creating or editing a task.
+	ClientId *string
+	// Name of the client associated with the task.
+	Client *string
+	// Identifier of the task route the task belongs to, if any.
+	RouteId *int64
+	// Position of the task within its task route, starting from 0. Not set if the task has no route.
+	RouteSequenceNum *int32
+	// Segment of the task route beginning at the location of this task.
+	RouteSection *TaskRouteSection
+	// Identifier of the trailer associated with the task.
+	TrailerId *int64
+	// Types of documents the driver must scan and submit, e.g. "cmr" or "pod".
+	RequiredDocumentTypes []string
+	// URLs of the files attached to the task.
+	AttachmentUrls []string
+	// Documents submitted by the driver.
+	Documents []*TaskDocument
+	// Timestamp when the task was created.
+	CreatedAt *timestamppb.Timestamp
+	// Timestamp when the task was last updated.
+	UpdatedAt *timestamppb.Timestamp
+	// Timestamp when the task was completed, if completed.
+	CompletedAt *timestamppb.Timestamp
+}
+
+func (b0 Task_builder) Build() *Task {
+	m0 := &Task{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.TaskId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 22)
+		x.xxx_hidden_TaskId = *b.TaskId
+	}
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 22)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 22)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.UnrecognizedType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 22)
+		x.xxx_hidden_UnrecognizedType = b.UnrecognizedType
+	}
+	if b.Status != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 22)
+		x.xxx_hidden_Status = *b.Status
+	}
+	if b.UnrecognizedStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 22)
+		x.xxx_hidden_UnrecognizedStatus = b.UnrecognizedStatus
+	}
+	x.xxx_hidden_PlannedArrivalTime = b.PlannedArrivalTime
+	x.xxx_hidden_Destination = b.Destination
+	if b.Instructions != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 22)
+		x.xxx_hidden_Instructions = b.Instructions
+	}
+	if b.OrderId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 22)
+		x.xxx_hidden_OrderId = b.OrderId
+	}
+	if b.ClientId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 22)
+		x.xxx_hidden_ClientId = b.ClientId
+	}
+	if b.Client != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 22)
+		x.xxx_hidden_Client = b.Client
+	}
+	if b.RouteId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 22)
+		x.xxx_hidden_RouteId = *b.RouteId
+	}
+	if b.RouteSequenceNum != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 22)
+		x.xxx_hidden_RouteSequenceNum = *b.RouteSequenceNum
+	}
+	x.xxx_hidden_RouteSection = b.RouteSection
+	if b.TrailerId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 22)
+		x.xxx_hidden_TrailerId = *b.TrailerId
+	}
+	x.xxx_hidden_RequiredDocumentTypes = b.RequiredDocumentTypes
+	x.xxx_hidden_AttachmentUrls = b.AttachmentUrls
+	x.xxx_hidden_Documents = &b.Documents
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	x.xxx_hidden_CompletedAt = b.CompletedAt
+	return m0
+}
+
+// TaskDestination represents the destination of a task.
+type TaskDestination struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_City        *string                `protobuf:"bytes,2,opt,name=city"`
+	xxx_hidden_CountryCode *string                `protobuf:"bytes,3,opt,name=country_code,json=countryCode"`
+	xxx_hidden_ZipCode     *string                `protobuf:"bytes,4,opt,name=zip_code,json=zipCode"`
+	xxx_hidden_Location    *Location              `protobuf:"bytes,5,opt,name=location"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TaskDestination) Reset() {
+	*x = TaskDestination{}
+	mi := &file_wayplatform_connect_mapon_v1_task_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskDestination) ProtoMessage() {}
+
+func (x *TaskDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_task_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TaskDestination) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TaskDestination) GetCity() string {
+	if x != nil {
+		if x.xxx_hidden_City != nil {
+			return *x.xxx_hidden_City
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TaskDestination) GetCountryCode() string {
+	if x != nil {
+		if x.xxx_hidden_CountryCode != nil {
+			return *x.xxx_hidden_CountryCode
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TaskDestination) GetZipCode() string {
+	if x != nil {
+		if x.xxx_hidden_ZipCode != nil {
+			return *x.xxx_hidden_ZipCode
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TaskDestination) GetLocation() *Location {
+	if x != nil {
+		return x.xxx_hidden_Location
+	}
+	return nil
+}
+
+func (x *TaskDestination) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *TaskDestination) SetCity(v string) {
+	x.xxx_hidden_City = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *TaskDestination) SetCountryCode(v string) {
+	x.xxx_hidden_CountryCode = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *TaskDestination) SetZipCode(v string) {
+	x.xxx_hidden_ZipCode = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *TaskDestination) SetLocation(v *Location) {
+	x.xxx_hidden_Location = v
+}
+
+func (x *TaskDestination) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TaskDestination) HasCity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TaskDestination) HasCountryCode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TaskDestination) HasZipCode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TaskDestination) HasLocation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Location != nil
+}
+
+func (x *TaskDestination) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *TaskDestination) ClearCity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_City = nil
+}
+
+func (x *TaskDestination) ClearCountryCode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CountryCode = nil
+}
+
+func (x *TaskDestination) ClearZipCode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_ZipCode = nil
+}
+
+func (x *TaskDestination) ClearLocation() {
+	x.xxx_hidden_Location = nil
+}
+
+type TaskDestination_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Name or address of the destination.
+	Name *string
+	// City of the destination.
+	City *string
+	// ISO country code of the destination (e.g. "US", "LV").
+	CountryCode *string
+	// Postal code of the destination.
+	ZipCode *string
+	// Coordinates of the destination.
+	Location *Location
+}
+
+func (b0 TaskDestination_builder) Build() *TaskDestination {
+	m0 := &TaskDestination{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.City != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_City = b.City
+	}
+	if b.CountryCode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_CountryCode = b.CountryCode
+	}
+	if b.ZipCode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_ZipCode = b.ZipCode
+	}
+	x.xxx_hidden_Location = b.Location
+	return m0
+}
+
+// TaskRouteSection represents the segment of a task route between two consecutive tasks.
+type TaskRouteSection struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_StartTaskId   int64                  `protobuf:"varint,1,opt,name=start_task_id,json=startTaskId"`
+	xxx_hidden_EndTaskId     int64                  `protobuf:"varint,2,opt,name=end_task_id,json=endTaskId"`
+	xxx_hidden_StartLocation *Location              `protobuf:"bytes,3,opt,name=start_location,json=startLocation"`
+	xxx_hidden_EndLocation   *Location              `protobuf:"bytes,4,opt,name=end_location,json=endLocation"`
+	xxx_hidden_DistanceM     int64                  `protobuf:"varint,5,opt,name=distance_m,json=distanceM"`
+	xxx_hidden_DurationS     int64                  `protobuf:"varint,6,opt,name=duration_s,json=durationS"`
+	xxx_hidden_Polyline      *string                `protobuf:"bytes,7,opt,name=polyline"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *TaskRouteSection) Reset() {
+	*x = TaskRouteSection{}
+	mi := &file_wayplatform_connect_mapon_v1_task_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRouteSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRouteSection) ProtoMessage() {}
+
+func (x *TaskRouteSection) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_task_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TaskRouteSection) GetStartTaskId() int64 {
+	if x != nil {
+		return x.xxx_hidden_StartTaskId
+	}
+	return 0
+}
+
+func (x *TaskRouteSection) GetEndTaskId() int64 {
+	if x != nil {
+		return x.xxx_hidden_EndTaskId
+	}
+	return 0
+}
+
+func (x *TaskRouteSection) GetStartLocation() *Location {
+	if x != nil {
+		return x.xxx_hidden_StartLocation
+	}
+	return nil
+}
+
+func (x *TaskRouteSection) GetEndLocation() *Location {
+	if x != nil {
+		return x.xxx_hidden_EndLocation
+	}
+	return nil
+}
+
+func (x *TaskRouteSection) GetDistanceM() int64 {
+	if x != nil {
+		return x.xxx_hidden_DistanceM
+	}
+	return 0
+}
+
+func (x *TaskRouteSection) GetDurationS() int64 {
+	if x != nil {
+		return x.xxx_hidden_DurationS
+	}
+	return 0
+}
+
+func (x *TaskRouteSection) GetPolyline() string {
+	if x != nil {
+		if x.xxx_hidden_Polyline != nil {
+			return *x.xxx_hidden_Polyline
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TaskRouteSection) SetStartTaskId(v int64) {
+	x.xxx_hidden_StartTaskId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *TaskRouteSection) SetEndTaskId(v int64) {
+	x.xxx_hidden_EndTaskId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *TaskRouteSection) SetStartLocation(v *Location) {
+	x.xxx_hidden_StartLocation = v
+}
+
+func (x *TaskRouteSection) SetEndLocation(v *Location) {
+	x.xxx_hidden_EndLocation = v
+}
+
+func (x *TaskRouteSection) SetDistanceM(v int64) {
+	x.xxx_hidden_DistanceM = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+}
+
+func (x *TaskRouteSection) SetDurationS(v int64) {
+	x.xxx_hidden_DurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+}
+
+func (x *TaskRouteSection) SetPolyline(v string) {
+	x.xxx_hidden_Polyline = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+}
+
+func (x *TaskRouteSection) HasStartTaskId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TaskRouteSection) HasEndTaskId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TaskRouteSection) HasStartLocation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StartLocation != nil
+}
+
+func (x *TaskRouteSection) HasEndLocation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_EndLocation != nil
+}
+
+func (x *TaskRouteSection) HasDistanceM() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *TaskRouteSection) HasDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *TaskRouteSection) HasPolyline() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *TaskRouteSection) ClearStartTaskId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_StartTaskId = 0
+}
+
+func (x *TaskRouteSection) ClearEndTaskId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EndTaskId = 0
+}
+
+func (x *TaskRouteSection) ClearStartLocation() {
+	x.xxx_hidden_StartLocation = nil
+}
+
+func (x *TaskRouteSection) ClearEndLocation() {
+	x.xxx_hidden_EndLocation = nil
+}
+
+func (x *TaskRouteSection) ClearDistanceM() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_DistanceM = 0
+}
+
+func (x *TaskRouteSection) ClearDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_DurationS = 0
+}
+
+func (x *TaskRouteSection) ClearPolyline() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Polyline = nil
+}
+
+type TaskRouteSection_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Identifier of the task at the start of the segment.
+	StartTaskId *int64
+	// Identifier of the task at the end of the segment.
+	EndTaskId *int64
+	// Location at the start of the segment.
+	StartLocation *Location
+	// Location at the end of the segment.
+	EndLocation *Location
+	// Distance of the segment in meters.
+	DistanceM *int64
+	// Estimated duration of the segment in seconds.
+	DurationS *int64
+	// Encoded polyline string representing the path geometry (Google Polyline Algorithm Format).
+	Polyline *string
+}
+
+func (b0 TaskRouteSection_builder) Build() *TaskRouteSection {
+	m0 := &TaskRouteSection{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.StartTaskId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_StartTaskId = *b.StartTaskId
+	}
+	if b.EndTaskId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_EndTaskId = *b.EndTaskId
+	}
+	x.xxx_hidden_StartLocation = b.StartLocation
+	x.xxx_hidden_EndLocation = b.EndLocation
+	if b.DistanceM != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_DistanceM = *b.DistanceM
+	}
+	if b.DurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		x.xxx_hidden_DurationS = *b.DurationS
+	}
+	if b.Polyline != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		x.xxx_hidden_Polyline = b.Polyline
+	}
+	return m0
+}
+
+// TaskDocument represents documents of a single type submitted by the driver for a task.
+type TaskDocument struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Type        *string                `protobuf:"bytes,1,opt,name=type"`
+	xxx_hidden_Urls        []string               `protobuf:"bytes,2,rep,name=urls"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TaskDocument) Reset() {
+	*x = TaskDocument{}
+	mi := &file_wayplatform_connect_mapon_v1_task_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskDocument) ProtoMessage() {}
+
+func (x *TaskDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_task_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TaskDocument) GetType() string {
+	if x != nil {
+		if x.xxx_hidden_Type != nil {
+			return *x.xxx_hidden_Type
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TaskDocument) GetUrls() []string {
+	if x != nil {
+		return x.xxx_hidden_Urls
+	}
+	return nil
+}
+
+func (x *TaskDocument) SetType(v string) {
+	x.xxx_hidden_Type = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *TaskDocument) SetUrls(v []string) {
+	x.xxx_hidden_Urls = v
+}
+
+func (x *TaskDocument) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TaskDocument) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Type = nil
+}
+
+type TaskDocument_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Type of the document, e.g. "cmr" or "pod".
+	Type *string
+	// URLs of the submitted files.
+	Urls []string
+}
+
+func (b0 TaskDocument_builder) Build() *TaskDocument {
+	m0 := &TaskDocument{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Type = b.Type
+	}
+	x.xxx_hidden_Urls = b.Urls
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_task_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_task_proto_rawDesc = "" +
+	"\n" +
+	"'wayplatform/connect/mapon/v1/task.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)wayplatform/connect/mapon/v1/common.proto\"\xfc\v\n" +
+	"\x04Task\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\x03R\x06unitId\x12;\n" +
+	"\x04type\x18\x03 \x01(\x0e2'.wayplatform.connect.mapon.v1.Task.TypeR\x04type\x12+\n" +
+	"\x11unrecognized_type\x18\x04 \x01(\tR\x10unrecognizedType\x12A\n" +
+	"\x06status\x18\x05 \x01(\x0e2).wayplatform.connect.mapon.v1.Task.StatusR\x06status\x12/\n" +
+	"\x13unrecognized_status\x18\x06 \x01(\tR\x12unrecognizedStatus\x12L\n" +
+	"\x14planned_arrival_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x12plannedArrivalTime\x12O\n" +
+	"\vdestination\x18\b \x01(\v2-.wayplatform.connect.mapon.v1.TaskDestinationR\vdestination\x12\"\n" +
+	"\finstructions\x18\t \x01(\tR\finstructions\x12\x19\n" +
+	"\border_id\x18\n" +
+	" \x01(\tR\aorderId\x12\x1b\n" +
+	"\tclient_id\x18\v \x01(\tR\bclientId\x12\x16\n" +
+	"\x06client\x18\f \x01(\tR\x06client\x12\x19\n" +
+	"\broute_id\x18\r \x01(\x03R\arouteId\x12,\n" +
+	"\x12route_sequence_num\x18\x0e \x01(\x05R\x10routeSequenceNum\x12S\n" +
+	"\rroute_section\x18\x0f \x01(\v2..wayplatform.connect.mapon.v1.TaskRouteSectionR\frouteSection\x12\x1d\n" +
+	"\n" +
+	"trailer_id\x18\x10 \x01(\x03R\ttrailerId\x126\n" +
+	"\x17required_document_types\x18\x11 \x03(\tR\x15requiredDocumentTypes\x12'\n" +
+	"\x0fattachment_urls\x18\x12 \x03(\tR\x0eattachmentUrls\x12H\n" +
+	"\tdocuments\x18\x13 \x03(\v2*.wayplatform.connect.mapon.v1.TaskDocumentR\tdocuments\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x9d\x02\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TYPE_UNRECOGNIZED\x10\x01\x12\x10\n" +
+	"\fTYPE_LOADING\x10\x02\x12\x12\n" +
+	"\x0eTYPE_UNLOADING\x10\x03\x12\x10\n" +
+	"\fTYPE_FUELING\x10\x04\x12\x16\n" +
+	"\x12TYPE_DRIVER_CHANGE\x10\x05\x12\x17\n" +
+	"\x13TYPE_TRAILER_CHANGE\x10\x06\x12\x10\n" +
+	"\fTYPE_RESTING\x10\a\x12\x0e\n" +
+	"\n" +
+	"TYPE_FERRY\x10\b\x12\x0e\n" +
+	"\n" +
+	"TYPE_TRAIN\x10\t\x12\x18\n" +
+	"\x14TYPE_BORDER_CROSSING\x10\n" +
+	"\x12\x18\n" +
+	"\x14TYPE_TRAILER_HOOK_ON\x10\v\x12\x19\n" +
+	"\x15TYPE_TRAILER_HOOK_OFF\x10\f\"\x93\x01\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13STATUS_UNRECOGNIZED\x10\x01\x12\x16\n" +
+	"\x12STATUS_NOT_STARTED\x10\x02\x12\x16\n" +
+	"\x12STATUS_IN_PROGRESS\x10\x03\x12\x12\n" +
+	"\x0eSTATUS_WAITING\x10\x04\x12\x14\n" +
+	"\x10STATUS_COMPLETED\x10\x05\"\xbb\x01\n" +
+	"\x0fTaskDestination\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12!\n" +
+	"\fcountry_code\x18\x03 \x01(\tR\vcountryCode\x12\x19\n" +
+	"\bzip_code\x18\x04 \x01(\tR\azipCode\x12B\n" +
+	"\blocation\x18\x05 \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\blocation\"\xca\x02\n" +
+	"\x10TaskRouteSection\x12\"\n" +
+	"\rstart_task_id\x18\x01 \x01(\x03R\vstartTaskId\x12\x1e\n" +
+	"\vend_task_id\x18\x02 \x01(\x03R\tendTaskId\x12M\n" +
+	"\x0estart_location\x18\x03 \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\rstartLocation\x12I\n" +
+	"\fend_location\x18\x04 \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\vendLocation\x12\x1d\n" +
+	"\n" +
+	"distance_m\x18\x05 \x01(\x03R\tdistanceM\x12\x1d\n" +
+	"\n" +
+	"duration_s\x18\x06 \x01(\x03R\tdurationS\x12\x1a\n" +
+	"\bpolyline\x18\a \x01(\tR\bpolyline\"6\n" +
+	"\fTaskDocument\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04urls\x18\x02 \x03(\tR\x04urlsB\x94\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\tTaskProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_wayplatform_connect_mapon_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_wayplatform_connect_mapon_v1_task_proto_goTypes = []any{
+	(Task_Type)(0),                // 0: wayplatform.connect.mapon.v1.Task.Type
+	(Task_Status)(0),              // 1: wayplatform.connect.mapon.v1.Task.Status
+	(*Task)(nil),                  // 2: wayplatform.connect.mapon.v1.Task
+	(*TaskDestination)(nil),       // 3: wayplatform.connect.mapon.v1.TaskDestination
+	(*TaskRouteSection)(nil),      // 4: wayplatform.connect.mapon.v1.TaskRouteSection
+	(*TaskDocument)(nil),          // 5: wayplatform.connect.mapon.v1.TaskDocument
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*Location)(nil),              // 7: wayplatform.connect.mapon.v1.Location
+}
+var file_wayplatform_connect_mapon_v1_task_proto_depIdxs = []int32{
+	0,  // 0: wayplatform.connect.mapon.v1.Task.type:type_name -> wayplatform.connect.mapon.v1.Task.Type
+	1,  // 1: wayplatform.connect.mapon.v1.Task.status:type_name -> wayplatform.connect.mapon.v1.Task.Status
+	6,  // 2: wayplatform.connect.mapon.v1.Task.planned_arrival_time:type_name -> google.protobuf.Timestamp
+	3,  // 3: wayplatform.connect.mapon.v1.Task.destination:type_name -> wayplatform.connect.mapon.v1.TaskDestination
+	4,  // 4: wayplatform.connect.mapon.v1.Task.route_section:type_name -> wayplatform.connect.mapon.v1.TaskRouteSection
+	5,  // 5: wayplatform.connect.mapon.v1.Task.documents:type_name -> wayplatform.connect.mapon.v1.TaskDocument
+	6,  // 6: wayplatform.connect.mapon.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 7: wayplatform.connect.mapon.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 8: wayplatform.connect.mapon.v1.Task.completed_at:type_name -> google.protobuf.Timestamp
+	7,  // 9: wayplatform.connect.mapon.v1.TaskDestination.location:type_name -> wayplatform.connect.mapon.v1.Location
+	7,  // 10: wayplatform.connect.mapon.v1.TaskRouteSection.start_location:type_name -> wayplatform.connect.mapon.v1.Location
+	7,  // 11: wayplatform.connect.mapon.v1.TaskRouteSection.end_location:type_name -> wayplatform.connect.mapon.v1.Location
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_task_proto_init() }
+func file_wayplatform_connect_mapon_v1_task_proto_init() {
+	if File_wayplatform_connect_mapon_v1_task_proto != nil {
+		return
+	}
+	file_wayplatform_connect_mapon_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_task_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_task_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_task_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_task_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_task_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_task_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_task_proto = out.File
+	file_wayplatform_connect_mapon_v1_task_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_task_proto_depIdxs = nil
+}
@@ -0,0 +1,711 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/tracking_link.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TrackingLink represents a public link to follow the live location of units.
+type TrackingLink struct {
+	state                           protoimpl.MessageState     `protogen:"opaque.v1"`
+	xxx_hidden_TrackingLinkId       int64                      `protobuf:"varint,1,opt,name=tracking_link_id,json=trackingLinkId"`
+	xxx_hidden_Hash                 *string                    `protobuf:"bytes,2,opt,name=hash"`
+	xxx_hidden_UnitIds              []int64                    `protobuf:"varint,3,rep,packed,name=unit_ids,json=unitIds"`
+	xxx_hidden_AvailableFrom        *timestamppb.Timestamp     `protobuf:"bytes,4,opt,name=available_from,json=availableFrom"`
+	xxx_hidden_ExpireTime           *timestamppb.Timestamp     `protobuf:"bytes,5,opt,name=expire_time,json=expireTime"`
+	xxx_hidden_ShowRoutes           bool                       `protobuf:"varint,6,opt,name=show_routes,json=showRoutes"`
+	xxx_hidden_RoutesPeriod         *TrackingLink_RoutesPeriod `protobuf:"bytes,7,opt,name=routes_period,json=routesPeriod"`
+	xxx_hidden_Comment              *string                    `protobuf:"bytes,8,opt,name=comment"`
+	xxx_hidden_CommentVisible       bool                       `protobuf:"varint,9,opt,name=comment_visible,json=commentVisible"`
+	xxx_hidden_Language             *string                    `protobuf:"bytes,10,opt,name=language"`
+	xxx_hidden_DeactivateInObjectId int64                      `protobuf:"varint,11,opt,name=deactivate_in_object_id,json=deactivateInObjectId"`
+	xxx_hidden_ActiveInObjectId     int64                      `protobuf:"varint,12,opt,name=active_in_object_id,json=activeInObjectId"`
+	xxx_hidden_UserId               int64                      `protobuf:"varint,13,opt,name=user_id,json=userId"`
+	xxx_hidden_UserName             *string                    `protobuf:"bytes,14,opt,name=user_name,json=userName"`
+	xxx_hidden_CreatedAt            *timestamppb.Timestamp     `protobuf:"bytes,15,opt,name=created_at,json=createdAt"`
+	XXX_raceDetectHookData          protoimpl.RaceDetectHookData
+	XXX_presence                    [1]uint32
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
+}
+
+func (x *TrackingLink) Reset() {
+	*x = TrackingLink{}
+	mi := &file_wayplatform_connect_mapon_v1_tracking_link_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackingLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackingLink) ProtoMessage() {}
+
+func (x *TrackingLink) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_tracking_link_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TrackingLink) GetTrackingLinkId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TrackingLinkId
+	}
+	return 0
+}
+
+func (x *TrackingLink) GetHash() string {
+	if x != nil {
+		if x.xxx_hidden_Hash != nil {
+			return *x.xxx_hidden_Hash
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TrackingLink) GetUnitIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitIds
+	}
+	return nil
+}
+
+func (x *TrackingLink) GetAvailableFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_AvailableFrom
+	}
+	return nil
+}
+
+func (x *TrackingLink) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpireTime
+	}
+	return nil
+}
+
+func (x *TrackingLink) GetShowRoutes() bool {
+	if x != nil {
+		return x.xxx_hidden_ShowRoutes
+	}
+	return false
+}
+
+func (x *TrackingLink) GetRoutesPeriod() *TrackingLink_RoutesPeriod {
+	if x != nil {
+		return x.xxx_hidden_RoutesPeriod
+	}
+	return nil
+}
+
+func (x *TrackingLink) GetComment() string {
+	if x != nil {
+		if x.xxx_hidden_Comment != nil {
+			return *x.xxx_hidden_Comment
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TrackingLink) GetCommentVisible() bool {
+	if x != nil {
+		return x.xxx_hidden_CommentVisible
+	}
+	return false
+}
+
+func (x *TrackingLink) GetLanguage() string {
+	if x != nil {
+		if x.xxx_hidden_Language != nil {
+			return *x.xxx_hidden_Language
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TrackingLink) GetDeactivateInObjectId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DeactivateInObjectId
+	}
+	return 0
+}
+
+func (x *TrackingLink) GetActiveInObjectId() int64 {
+	if x != nil {
+		return x.xxx_hidden_ActiveInObjectId
+	}
+	return 0
+}
+
+func (x *TrackingLink) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *TrackingLink) GetUserName() string {
+	if x != nil {
+		if x.xxx_hidden_UserName != nil {
+			return *x.xxx_hidden_UserName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TrackingLink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *TrackingLink) SetTrackingLinkId(v int64) {
+	x.xxx_hidden_TrackingLinkId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 15)
+}
+
+func (x *TrackingLink) SetHash(v string) {
+	x.xxx_hidden_Hash = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 15)
+}
+
+func (x *TrackingLink) SetUnitIds(v []int64) {
+	x.xxx_hidden_UnitIds = v
+}
+
+func (x *TrackingLink) SetAvailableFrom(v *timestamppb.Timestamp) {
+	x.xxx_hidden_AvailableFrom = v
+}
+
+func (x *TrackingLink) SetExpireTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpireTime = v
+}
+
+func (x *TrackingLink) SetShowRoutes(v bool) {
+	x.xxx_hidden_ShowRoutes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 15)
+}
+
+func (x *TrackingLink) SetRoutesPeriod(v *TrackingLink_RoutesPeriod) {
+	x.xxx_hidden_RoutesPeriod = v
+}
+
+func (x *TrackingLink) SetComment(v string) {
+	x.xxx_hidden_Comment = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 15)
+}
+
+func (x *TrackingLink) SetCommentVisible(v bool) {
+	x.xxx_hidden_CommentVisible = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 15)
+}
+
+func (x *TrackingLink) SetLanguage(v string) {
+	x.xxx_hidden_Language = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 15)
+}
+
+func (x *TrackingLink) SetDeactivateInObjectId(v int64) {
+	x.xxx_hidden_DeactivateInObjectId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 15)
+}
+
+func (x *TrackingLink) SetActiveInObjectId(v int64) {
+	x.xxx_hidden_ActiveInObjectId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 15)
+}
+
+func (x *TrackingLink) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 15)
+}
+
+func (x *TrackingLink) SetUserName(v string) {
+	x.xxx_hidden_UserName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 15)
+}
+
+func (x *TrackingLink) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *TrackingLink) HasTrackingLinkId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TrackingLink) HasHash() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TrackingLink) HasAvailableFrom() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_AvailableFrom != nil
+}
+
+func (x *TrackingLink) HasExpireTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpireTime != nil
+}
+
+func (x *TrackingLink) HasShowRoutes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *TrackingLink) HasRoutesPeriod() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RoutesPeriod != nil
+}
+
+func (x *TrackingLink) HasComment() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *TrackingLink) HasCommentVisible() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *TrackingLink) HasLanguage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *TrackingLink) HasDeactivateInObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *TrackingLink) HasActiveInObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *TrackingLink) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *TrackingLink) HasUserName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *TrackingLink) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *TrackingLink) ClearTrackingLinkId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_TrackingLinkId = 0
+}
+
+func (x *TrackingLink) ClearHash() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Hash = nil
+}
+
+func (x *TrackingLink) ClearAvailableFrom() {
+	x.xxx_hidden_AvailableFrom = nil
+}
+
+func (x *TrackingLink) ClearExpireTime() {
+	x.xxx_hidden_ExpireTime = nil
+}
+
+func (x *TrackingLink) ClearShowRoutes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_ShowRoutes = false
+}
+
+func (x *TrackingLink) ClearRoutesPeriod() {
+	x.xxx_hidden_RoutesPeriod = nil
+}
+
+func (x *TrackingLink) ClearComment() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Comment = nil
+}
+
+func (x *TrackingLink) ClearCommentVisible() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_CommentVisible = false
+}
+
+func (x *TrackingLink) ClearLanguage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_Language = nil
+}
+
+func (x *TrackingLink) ClearDeactivateInObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_DeactivateInObjectId = 0
+}
+
+func (x *TrackingLink) ClearActiveInObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_ActiveInObjectId = 0
+}
+
+func (x *TrackingLink) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_UserId = 0
+}
+
+func (x *TrackingLink) ClearUserName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_UserName = nil
+}
+
+func (x *TrackingLink) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+type TrackingLink_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the tracking link.
+	TrackingLinkId *int64
+	// Public token of the tracking link, returned as tracking_id when the link is created.
+	Hash *string
+	// Identifiers of the units that can be tracked.
+	UnitIds []int64
+	// Timestamp from which tracking is available.
+	AvailableFrom *timestamppb.Timestamp
+	// Timestamp until which tracking is available, after which the link expires.
+	ExpireTime *timestamppb.Timestamp
+	// Indicates if the routes driven in the period are visible.
+	ShowRoutes *bool
+	// Restriction of the visible routes, if any.
+	RoutesPeriod *TrackingLink_RoutesPeriod
+	// Comment for the tracking link.
+	Comment *string
+	// Indicates if the comment is visible on the tracking page.
+	CommentVisible *bool
+	// Language of the tracking page.
+	Language *string
+	// Identifier of the object where units are removed from the link when arriving.
+	DeactivateInObjectId *int64
+	// Identifier of the object where units must be for tracking to be active.
+	ActiveInObjectId *int64
+	// Identifier of the user who created the link.
+	UserId *int64
+	// Name of the user who created the link.
+	UserName *string
+	// Timestamp when the link was created.
+	CreatedAt *timestamppb.Timestamp
+}
+
+func (b0 TrackingLink_builder) Build() *TrackingLink {
+	m0 := &TrackingLink{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.TrackingLinkId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 15)
+		x.xxx_hidden_TrackingLinkId = *b.TrackingLinkId
+	}
+	if b.Hash != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 15)
+		x.xxx_hidden_Hash = b.Hash
+	}
+	x.xxx_hidden_UnitIds = b.UnitIds
+	x.xxx_hidden_AvailableFrom = b.AvailableFrom
+	x.xxx_hidden_ExpireTime = b.ExpireTime
+	if b.ShowRoutes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 15)
+		x.xxx_hidden_ShowRoutes = *b.ShowRoutes
+	}
+	x.xxx_hidden_RoutesPeriod = b.RoutesPeriod
+	if b.Comment != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 15)
+		x.xxx_hidden_Comment = b.Comment
+	}
+	if b.CommentVisible != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 15)
+		x.xxx_hidden_CommentVisible = *b.CommentVisible
+	}
+	if b.Language != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 15)
+		x.xxx_hidden_Language = b.Language
+	}
+	if b.DeactivateInObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 15)
+		x.xxx_hidden_DeactivateInObjectId = *b.DeactivateInObjectId
+	}
+	if b.ActiveInObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 15)
+		x.xxx_hidden_ActiveInObjectId = *b.ActiveInObjectId
+	}
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 15)
+		x.xxx_hidden_UserId = *b.UserId
+	}
+	if b.UserName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 15)
+		x.xxx_hidden_UserName = b.UserName
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	return m0
+}
+
+// Restriction of the visible routes to specific days and times.
+type TrackingLink_RoutesPeriod struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TimeFrom    *string                `protobuf:"bytes,1,opt,name=time_from,json=timeFrom"`
+	xxx_hidden_TimeTo      *string                `protobuf:"bytes,2,opt,name=time_to,json=timeTo"`
+	xxx_hidden_Weekdays    []int32                `protobuf:"varint,3,rep,packed,name=weekdays"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TrackingLink_RoutesPeriod) Reset() {
+	*x = TrackingLink_RoutesPeriod{}
+	mi := &file_wayplatform_connect_mapon_v1_tracking_link_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackingLink_RoutesPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackingLink_RoutesPeriod) ProtoMessage() {}
+
+func (x *TrackingLink_RoutesPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_tracking_link_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TrackingLink_RoutesPeriod) GetTimeFrom() string {
+	if x != nil {
+		if x.xxx_hidden_TimeFrom != nil {
+			return *x.xxx_hidden_TimeFrom
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TrackingLink_RoutesPeriod) GetTimeTo() string {
+	if x != nil {
+		if x.xxx_hidden_TimeTo != nil {
+			return *x.xxx_hidden_TimeTo
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TrackingLink_RoutesPeriod) GetWeekdays() []int32 {
+	if x != nil {
+		return x.xxx_hidden_Weekdays
+	}
+	return nil
+}
+
+func (x *TrackingLink_RoutesPeriod) SetTimeFrom(v string) {
+	x.xxx_hidden_TimeFrom = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *TrackingLink_RoutesPeriod) SetTimeTo(v string) {
+	x.xxx_hidden_TimeTo = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *TrackingLink_RoutesPeriod) SetWeekdays(v []int32) {
+	x.xxx_hidden_Weekdays = v
+}
+
+func (x *TrackingLink_RoutesPeriod) HasTimeFrom() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TrackingLink_RoutesPeriod) HasTimeTo() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TrackingLink_RoutesPeriod) ClearTimeFrom() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_TimeFrom = nil
+}
+
+func (x *TrackingLink_RoutesPeriod) ClearTimeTo() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_TimeTo = nil
+}
+
+type TrackingLink_RoutesPeriod_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Start of the daily visible period (HH:MM).
+	TimeFrom *string
+	// End of the daily visible period (HH:MM).
+	TimeTo *string
+	// Days of the week the routes are visible, from 1 (Monday) to 7 (Sunday).
+	Weekdays []int32
+}
+
+func (b0 TrackingLink_RoutesPeriod_builder) Build() *TrackingLink_RoutesPeriod {
+	m0 := &TrackingLink_RoutesPeriod{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.TimeFrom != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_TimeFrom = b.TimeFrom
+	}
+	if b.TimeTo != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_TimeTo = b.TimeTo
+	}
+	x.xxx_hidden_Weekdays = b.Weekdays
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_tracking_link_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_tracking_link_proto_rawDesc = "" +
+	"\n" +
+	"0wayplatform/connect/mapon/v1/tracking_link.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfe\x05\n" +
+	"\fTrackingLink\x12(\n" +
+	"\x10tracking_link_id\x18\x01 \x01(\x03R\x0etrackingLinkId\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x19\n" +
+	"\bunit_ids\x18\x03 \x03(\x03R\aunitIds\x12A\n" +
+	"\x0eavailable_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ravailableFrom\x12;\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12\x1f\n" +
+	"\vshow_routes\x18\x06 \x01(\bR\n" +
+	"showRoutes\x12\\\n" +
+	"\rroutes_period\x18\a \x01(\v27.wayplatform.connect.mapon.v1.TrackingLink.RoutesPeriodR\froutesPeriod\x12\x18\n" +
+	"\acomment\x18\b \x01(\tR\acomment\x12'\n" +
+	"\x0fcomment_visible\x18\t \x01(\bR\x0ecommentVisible\x12\x1a\n" +
+	"\blanguage\x18\n" +
+	" \x01(\tR\blanguage\x125\n" +
+	"\x17deactivate_in_object_id\x18\v \x01(\x03R\x14deactivateInObjectId\x12-\n" +
+	"\x13active_in_object_id\x18\f \x01(\x03R\x10activeInObjectId\x12\x17\n" +
+	"\auser_id\x18\r \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x0e \x01(\tR\buserName\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a`\n" +
+	"\fRoutesPeriod\x12\x1b\n" +
+	"\ttime_from\x18\x01 \x01(\tR\btimeFrom\x12\x17\n" +
+	"\atime_to\x18\x02 \x01(\tR\x06timeTo\x12\x1a\n" +
+	"\bweekdays\x18\x03 \x03(\x05R\bweekdaysB\x9c\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x11TrackingLinkProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_tracking_link_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_mapon_v1_tracking_link_proto_goTypes = []any{
+	(*TrackingLink)(nil),              // 0: wayplatform.connect.mapon.v1.TrackingLink
+	(*TrackingLink_RoutesPeriod)(nil), // 1: wayplatform.connect.mapon.v1.TrackingLink.RoutesPeriod
+	(*timestamppb.Timestamp)(nil),     // 2: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_mapon_v1_tracking_link_proto_depIdxs = []int32{
+	2, // 0: wayplatform.connect.mapon.v1.TrackingLink.available_from:type_name -> google.protobuf.Timestamp
+	2, // 1: wayplatform.connect.mapon.v1.TrackingLink.expire_time:type_name -> google.protobuf.Timestamp
+	1, // 2: wayplatform.connect.mapon.v1.TrackingLink.routes_period:type_name -> wayplatform.connect.mapon.v1.TrackingLink.RoutesPeriod
+	2, // 3: wayplatform.connect.mapon.v1.TrackingLink.created_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_tracking_link_proto_init() }
+func file_wayplatform_connect_mapon_v1_tracking_link_proto_init() {
+	if File_wayplatform_connect_mapon_v1_tracking_link_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_tracking_link_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_tracking_link_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_tracking_link_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_tracking_link_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_tracking_link_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_tracking_link_proto = out.File
+	file_wayplatform_connect_mapon_v1_tracking_link_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_tracking_link_proto_depIdxs = nil
+}
@@ -0,0 +1,297 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/unit_command.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Type of a unit command.
+type UnitCommand_Type int32
+
+const (
+	// Default value, used when the type is missing or not set.
+	UnitCommand_TYPE_UNSPECIFIED UnitCommand_Type = 0
+	// Used when the received value does not match any known enum member.
+	UnitCommand_TYPE_UNRECOGNIZED UnitCommand_Type = 1
+	// Unlock the doors.
+	UnitCommand_TYPE_OPEN_DOORS UnitCommand_Type = 2
+	// Lock the doors.
+	UnitCommand_TYPE_CLOSE_DOORS UnitCommand_Type = 3
+	// Open the trunk.
+	UnitCommand_TYPE_OPEN_TRUNK UnitCommand_Type = 4
+	// Open the windows.
+	UnitCommand_TYPE_OPEN_WINDOWS UnitCommand_Type = 5
+	// Close the windows.
+	UnitCommand_TYPE_CLOSE_WINDOWS UnitCommand_Type = 6
+	// Turn on the hazard lights.
+	UnitCommand_TYPE_HAZARD_LIGHTS UnitCommand_Type = 7
+)
+
+// Enum value maps for UnitCommand_Type.
+var (
+	UnitCommand_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_UNRECOGNIZED",
+		2: "TYPE_OPEN_DOORS",
+		3: "TYPE_CLOSE_DOORS",
+		4: "TYPE_OPEN_TRUNK",
+		5: "TYPE_OPEN_WINDOWS",
+		6: "TYPE_CLOSE_WINDOWS",
+		7: "TYPE_HAZARD_LIGHTS",
+	}
+	UnitCommand_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":   0,
+		"TYPE_UNRECOGNIZED":  1,
+		"TYPE_OPEN_DOORS":    2,
+		"TYPE_CLOSE_DOORS":   3,
+		"TYPE_OPEN_TRUNK":    4,
+		"TYPE_OPEN_WINDOWS":  5,
+		"TYPE_CLOSE_WINDOWS": 6,
+		"TYPE_HAZARD_LIGHTS": 7,
+	}
+)
+
+func (x UnitCommand_Type) Enum() *UnitCommand_Type {
+	p := new(UnitCommand_Type)
+	*p = x
+	return p
+}
+
+func (x UnitCommand_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnitCommand_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_unit_command_proto_enumTypes[0].Descriptor()
+}
+
+func (UnitCommand_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_unit_command_proto_enumTypes[0]
+}
+
+func (x UnitCommand_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// UnitCommand represents a remote command that can be executed on a unit.
+type UnitCommand struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_Type        UnitCommand_Type       `protobuf:"varint,2,opt,name=type,enum=wayplatform.connect.mapon.v1.UnitCommand_Type"`
+	xxx_hidden_Description *string                `protobuf:"bytes,3,opt,name=description"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UnitCommand) Reset() {
+	*x = UnitCommand{}
+	mi := &file_wayplatform_connect_mapon_v1_unit_command_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitCommand) ProtoMessage() {}
+
+func (x *UnitCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_unit_command_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UnitCommand) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UnitCommand) GetType() UnitCommand_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return UnitCommand_TYPE_UNSPECIFIED
+}
+
+func (x *UnitCommand) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UnitCommand) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *UnitCommand) SetType(v UnitCommand_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *UnitCommand) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *UnitCommand) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UnitCommand) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UnitCommand) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *UnitCommand) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *UnitCommand) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Type = UnitCommand_TYPE_UNSPECIFIED
+}
+
+func (x *UnitCommand) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Description = nil
+}
+
+type UnitCommand_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Name of the command, used to execute it (e.g. "open_doors").
+	Name *string
+	// Type of the command.
+	Type *UnitCommand_Type
+	// Human-readable description of the command.
+	// Empty for commands that are not recognized.
+	Description *string
+}
+
+func (b0 UnitCommand_builder) Build() *UnitCommand {
+	m0 := &UnitCommand{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Description = b.Description
+	}
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_unit_command_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_unit_command_proto_rawDesc = "" +
+	"\n" +
+	"/wayplatform/connect/mapon/v1/unit_command.proto\x12\x1cwayplatform.connect.mapon.v1\"\xc4\x02\n" +
+	"\vUnitCommand\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12B\n" +
+	"\x04type\x18\x02 \x01(\x0e2..wayplatform.connect.mapon.v1.UnitCommand.TypeR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\xba\x01\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TYPE_UNRECOGNIZED\x10\x01\x12\x13\n" +
+	"\x0fTYPE_OPEN_DOORS\x10\x02\x12\x14\n" +
+	"\x10TYPE_CLOSE_DOORS\x10\x03\x12\x13\n" +
+	"\x0fTYPE_OPEN_TRUNK\x10\x04\x12\x15\n" +
+	"\x11TYPE_OPEN_WINDOWS\x10\x05\x12\x16\n" +
+	"\x12TYPE_CLOSE_WINDOWS\x10\x06\x12\x16\n" +
+	"\x12TYPE_HAZARD_LIGHTS\x10\aB\x9b\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x10UnitCommandProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_unit_command_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_mapon_v1_unit_command_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_wayplatform_connect_mapon_v1_unit_command_proto_goTypes = []any{
+	(UnitCommand_Type)(0), // 0: wayplatform.connect.mapon.v1.UnitCommand.Type
+	(*UnitCommand)(nil),   // 1: wayplatform.connect.mapon.v1.UnitCommand
+}
+var file_wayplatform_connect_mapon_v1_unit_command_proto_depIdxs = []int32{
+	0, // 0: wayplatform.connect.mapon.v1.UnitCommand.type:type_name -> wayplatform.connect.mapon.v1.UnitCommand.Type
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_unit_command_proto_init() }
+func file_wayplatform_connect_mapon_v1_unit_command_proto_init() {
+	if File_wayplatform_connect_mapon_v1_unit_command_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_unit_command_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_unit_command_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_unit_command_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_unit_command_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_unit_command_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_unit_command_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_unit_command_proto = out.File
+	file_wayplatform_connect_mapon_v1_unit_command_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_unit_command_proto_depIdxs = nil
+}
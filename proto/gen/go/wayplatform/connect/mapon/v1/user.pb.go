@@ -0,0 +1,651 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/user.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Type of a user.
+type User_Type int32
+
+const (
+	// Default value, used when the type is missing or not set.
+	User_TYPE_UNSPECIFIED User_Type = 0
+	// Used when the received value does not match any known enum member.
+	User_TYPE_UNRECOGNIZED User_Type = 1
+	// Company administrator.
+	User_TYPE_ADMIN User_Type = 2
+	// User with access to all units.
+	User_TYPE_USER_ALL User_Type = 3
+	// User with access defined by a preset.
+	User_TYPE_USER User_Type = 4
+	// Driver user.
+	User_TYPE_DRIVER User_Type = 5
+)
+
+// Enum value maps for User_Type.
+var (
+	User_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_UNRECOGNIZED",
+		2: "TYPE_ADMIN",
+		3: "TYPE_USER_ALL",
+		4: "TYPE_USER",
+		5: "TYPE_DRIVER",
+	}
+	User_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":  0,
+		"TYPE_UNRECOGNIZED": 1,
+		"TYPE_ADMIN":        2,
+		"TYPE_USER_ALL":     3,
+		"TYPE_USER":         4,
+		"TYPE_DRIVER":       5,
+	}
+)
+
+func (x User_Type) Enum() *User_Type {
+	p := new(User_Type)
+	*p = x
+	return p
+}
+
+func (x User_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (User_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (User_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_user_proto_enumTypes[0]
+}
+
+func (x User_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// User represents a user of the company account.
+type User struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId"`
+	xxx_hidden_Email            *string                `protobuf:"bytes,2,opt,name=email"`
+	xxx_hidden_Name             *string                `protobuf:"bytes,3,opt,name=name"`
+	xxx_hidden_Surname          *string                `protobuf:"bytes,4,opt,name=surname"`
+	xxx_hidden_Type             User_Type              `protobuf:"varint,5,opt,name=type,enum=wayplatform.connect.mapon.v1.User_Type"`
+	xxx_hidden_UnrecognizedType *string                `protobuf:"bytes,6,opt,name=unrecognized_type,json=unrecognizedType"`
+	xxx_hidden_Preset           *Preset                `protobuf:"bytes,7,opt,name=preset"`
+	xxx_hidden_Timezone         *string                `protobuf:"bytes,8,opt,name=timezone"`
+	xxx_hidden_Language         *string                `protobuf:"bytes,9,opt,name=language"`
+	xxx_hidden_Phone            *string                `protobuf:"bytes,10,opt,name=phone"`
+	xxx_hidden_Blocked          bool                   `protobuf:"varint,11,opt,name=blocked"`
+	xxx_hidden_BlockedReason    *string                `protobuf:"bytes,12,opt,name=blocked_reason,json=blockedReason"`
+	xxx_hidden_LastLoginAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_login_at,json=lastLoginAt"`
+	xxx_hidden_CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_wayplatform_connect_mapon_v1_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *User) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		if x.xxx_hidden_Email != nil {
+			return *x.xxx_hidden_Email
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *User) GetSurname() string {
+	if x != nil {
+		if x.xxx_hidden_Surname != nil {
+			return *x.xxx_hidden_Surname
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *User) GetType() User_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 4) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return User_TYPE_UNSPECIFIED
+}
+
+func (x *User) GetUnrecognizedType() string {
+	if x != nil {
+		if x.xxx_hidden_UnrecognizedType != nil {
+			return *x.xxx_hidden_UnrecognizedType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *User) GetPreset() *Preset {
+	if x != nil {
+		return x.xxx_hidden_Preset
+	}
+	return nil
+}
+
+func (x *User) GetTimezone() string {
+	if x != nil {
+		if x.xxx_hidden_Timezone != nil {
+			return *x.xxx_hidden_Timezone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *User) GetLanguage() string {
+	if x != nil {
+		if x.xxx_hidden_Language != nil {
+			return *x.xxx_hidden_Language
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *User) GetPhone() string {
+	if x != nil {
+		if x.xxx_hidden_Phone != nil {
+			return *x.xxx_hidden_Phone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *User) GetBlocked() bool {
+	if x != nil {
+		return x.xxx_hidden_Blocked
+	}
+	return false
+}
+
+func (x *User) GetBlockedReason() string {
+	if x != nil {
+		if x.xxx_hidden_BlockedReason != nil {
+			return *x.xxx_hidden_BlockedReason
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *User) GetLastLoginAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastLoginAt
+	}
+	return nil
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *User) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 14)
+}
+
+func (x *User) SetEmail(v string) {
+	x.xxx_hidden_Email = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 14)
+}
+
+func (x *User) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 14)
+}
+
+func (x *User) SetSurname(v string) {
+	x.xxx_hidden_Surname = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 14)
+}
+
+func (x *User) SetType(v User_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 14)
+}
+
+func (x *User) SetUnrecognizedType(v string) {
+	x.xxx_hidden_UnrecognizedType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 14)
+}
+
+func (x *User) SetPreset(v *Preset) {
+	x.xxx_hidden_Preset = v
+}
+
+func (x *User) SetTimezone(v string) {
+	x.xxx_hidden_Timezone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 14)
+}
+
+func (x *User) SetLanguage(v string) {
+	x.xxx_hidden_Language = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 14)
+}
+
+func (x *User) SetPhone(v string) {
+	x.xxx_hidden_Phone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 14)
+}
+
+func (x *User) SetBlocked(v bool) {
+	x.xxx_hidden_Blocked = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 14)
+}
+
+func (x *User) SetBlockedReason(v string) {
+	x.xxx_hidden_BlockedReason = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 14)
+}
+
+func (x *User) SetLastLoginAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastLoginAt = v
+}
+
+func (x *User) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *User) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *User) HasEmail() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *User) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *User) HasSurname() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *User) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *User) HasUnrecognizedType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *User) HasPreset() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Preset != nil
+}
+
+func (x *User) HasTimezone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *User) HasLanguage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *User) HasPhone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *User) HasBlocked() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *User) HasBlockedReason() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *User) HasLastLoginAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastLoginAt != nil
+}
+
+func (x *User) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *User) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_UserId = 0
+}
+
+func (x *User) ClearEmail() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Email = nil
+}
+
+func (x *User) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *User) ClearSurname() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Surname = nil
+}
+
+func (x *User) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Type = User_TYPE_UNSPECIFIED
+}
+
+func (x *User) ClearUnrecognizedType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_UnrecognizedType = nil
+}
+
+func (x *User) ClearPreset() {
+	x.xxx_hidden_Preset = nil
+}
+
+func (x *User) ClearTimezone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Timezone = nil
+}
+
+func (x *User) ClearLanguage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Language = nil
+}
+
+func (x *User) ClearPhone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_Phone = nil
+}
+
+func (x *User) ClearBlocked() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_Blocked = false
+}
+
+func (x *User) ClearBlockedReason() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_BlockedReason = nil
+}
+
+func (x *User) ClearLastLoginAt() {
+	x.xxx_hidden_LastLoginAt = nil
+}
+
+func (x *User) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+type User_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the user.
+	UserId *int64
+	// Email address, used to log in.
+	Email *string
+	// First name of the user.
+	Name *string
+	// Last name of the user.
+	Surname *string
+	// Type of the user.
+	Type *User_Type
+	// Type of the user when it is not recognized.
+	UnrecognizedType *string
+	// Preset assigned to the user. Only the ID, title and custom flag are set.
+	Preset *Preset
+	// Timezone of the user (e.g. "UTC").
+	Timezone *string
+	// 2 letter language code (e.g. "en").
+	Language *string
+	// Contact phone number.
+	Phone *string
+	// Indicates if the user is blocked.
+	Blocked *bool
+	// Reason the user is blocked.
+	BlockedReason *string
+	// Timestamp of the last login.
+	LastLoginAt *timestamppb.Timestamp
+	// Timestamp when the user was created.
+	CreatedAt *timestamppb.Timestamp
+}
+
+func (b0 User_builder) Build() *User {
+	m0 := &User{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 14)
+		x.xxx_hidden_UserId = *b.UserId
+	}
+	if b.Email != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 14)
+		x.xxx_hidden_Email = b.Email
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 14)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Surname != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 14)
+		x.xxx_hidden_Surname = b.Surname
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 14)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.UnrecognizedType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 14)
+		x.xxx_hidden_UnrecognizedType = b.UnrecognizedType
+	}
+	x.xxx_hidden_Preset = b.Preset
+	if b.Timezone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 14)
+		x.xxx_hidden_Timezone = b.Timezone
+	}
+	if b.Language != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 14)
+		x.xxx_hidden_Language = b.Language
+	}
+	if b.Phone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 14)
+		x.xxx_hidden_Phone = b.Phone
+	}
+	if b.Blocked != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 14)
+		x.xxx_hidden_Blocked = *b.Blocked
+	}
+	if b.BlockedReason != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 14)
+		x.xxx_hidden_BlockedReason = b.BlockedReason
+	}
+	x.xxx_hidden_LastLoginAt = b.LastLoginAt
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_user_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"'wayplatform/connect/mapon/v1/user.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)wayplatform/connect/mapon/v1/preset.proto\"\x8d\x05\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\asurname\x18\x04 \x01(\tR\asurname\x12;\n" +
+	"\x04type\x18\x05 \x01(\x0e2'.wayplatform.connect.mapon.v1.User.TypeR\x04type\x12+\n" +
+	"\x11unrecognized_type\x18\x06 \x01(\tR\x10unrecognizedType\x12<\n" +
+	"\x06preset\x18\a \x01(\v2$.wayplatform.connect.mapon.v1.PresetR\x06preset\x12\x1a\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\x12\x1a\n" +
+	"\blanguage\x18\t \x01(\tR\blanguage\x12\x14\n" +
+	"\x05phone\x18\n" +
+	" \x01(\tR\x05phone\x12\x18\n" +
+	"\ablocked\x18\v \x01(\bR\ablocked\x12%\n" +
+	"\x0eblocked_reason\x18\f \x01(\tR\rblockedReason\x12>\n" +
+	"\rlast_login_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"v\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TYPE_UNRECOGNIZED\x10\x01\x12\x0e\n" +
+	"\n" +
+	"TYPE_ADMIN\x10\x02\x12\x11\n" +
+	"\rTYPE_USER_ALL\x10\x03\x12\r\n" +
+	"\tTYPE_USER\x10\x04\x12\x0f\n" +
+	"\vTYPE_DRIVER\x10\x05B\x94\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\tUserProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_mapon_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_wayplatform_connect_mapon_v1_user_proto_goTypes = []any{
+	(User_Type)(0),                // 0: wayplatform.connect.mapon.v1.User.Type
+	(*User)(nil),                  // 1: wayplatform.connect.mapon.v1.User
+	(*Preset)(nil),                // 2: wayplatform.connect.mapon.v1.Preset
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_mapon_v1_user_proto_depIdxs = []int32{
+	0, // 0: wayplatform.connect.mapon.v1.User.type:type_name -> wayplatform.connect.mapon.v1.User.Type
+	2, // 1: wayplatform.connect.mapon.v1.User.preset:type_name -> wayplatform.connect.mapon.v1.Preset
+	3, // 2: wayplatform.connect.mapon.v1.User.last_login_at:type_name -> google.protobuf.Timestamp
+	3, // 3: wayplatform.connect.mapon.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_user_proto_init() }
+func file_wayplatform_connect_mapon_v1_user_proto_init() {
+	if File_wayplatform_connect_mapon_v1_user_proto != nil {
+		return
+	}
+	file_wayplatform_connect_mapon_v1_preset_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_user_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_user_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_user_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_user_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_user_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_user_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_user_proto = out.File
+	file_wayplatform_connect_mapon_v1_user_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_user_proto_depIdxs = nil
+}
@@ -0,0 +1,1199 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/vehicle_inspection.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Status enum defining the states of an inspection.
+type VehicleInspection_Status int32
+
+const (
+	// Default value, used when the status is missing or not set.
+	VehicleInspection_STATUS_UNSPECIFIED VehicleInspection_Status = 0
+	// Used when the received value does not match any known enum member.
+	VehicleInspection_STATUS_UNRECOGNIZED VehicleInspection_Status = 1
+	// The inspection was not performed in time.
+	VehicleInspection_STATUS_DELAYED VehicleInspection_Status = 2
+	// All checklist items passed.
+	VehicleInspection_STATUS_PASS VehicleInspection_Status = 3
+	// At least one checklist item failed.
+	VehicleInspection_STATUS_FAILED VehicleInspection_Status = 4
+	// The failed items have been resolved.
+	VehicleInspection_STATUS_RESOLVED VehicleInspection_Status = 5
+)
+
+// Enum value maps for VehicleInspection_Status.
+var (
+	VehicleInspection_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_UNRECOGNIZED",
+		2: "STATUS_DELAYED",
+		3: "STATUS_PASS",
+		4: "STATUS_FAILED",
+		5: "STATUS_RESOLVED",
+	}
+	VehicleInspection_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED":  0,
+		"STATUS_UNRECOGNIZED": 1,
+		"STATUS_DELAYED":      2,
+		"STATUS_PASS":         3,
+		"STATUS_FAILED":       4,
+		"STATUS_RESOLVED":     5,
+	}
+)
+
+func (x VehicleInspection_Status) Enum() *VehicleInspection_Status {
+	p := new(VehicleInspection_Status)
+	*p = x
+	return p
+}
+
+func (x VehicleInspection_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VehicleInspection_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_enumTypes[0].Descriptor()
+}
+
+func (VehicleInspection_Status) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_enumTypes[0]
+}
+
+func (x VehicleInspection_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Status enum defining the repair states of a damage.
+type VehicleInspection_Damage_Status int32
+
+const (
+	// Default value, used when the status is missing or not set.
+	VehicleInspection_Damage_STATUS_UNSPECIFIED VehicleInspection_Damage_Status = 0
+	// Used when the received value does not match any known enum member.
+	VehicleInspection_Damage_STATUS_UNRECOGNIZED VehicleInspection_Damage_Status = 1
+	// The damage has been reported.
+	VehicleInspection_Damage_STATUS_OPEN VehicleInspection_Damage_Status = 2
+	// The repair is ongoing.
+	VehicleInspection_Damage_STATUS_ONGOING VehicleInspection_Damage_Status = 3
+	// The repair is awaiting approval.
+	VehicleInspection_Damage_STATUS_AWAITING_APPROVAL VehicleInspection_Damage_Status = 4
+	// The damage has been repaired.
+	VehicleInspection_Damage_STATUS_REPAIRED VehicleInspection_Damage_Status = 5
+)
+
+// Enum value maps for VehicleInspection_Damage_Status.
+var (
+	VehicleInspection_Damage_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_UNRECOGNIZED",
+		2: "STATUS_OPEN",
+		3: "STATUS_ONGOING",
+		4: "STATUS_AWAITING_APPROVAL",
+		5: "STATUS_REPAIRED",
+	}
+	VehicleInspection_Damage_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED":       0,
+		"STATUS_UNRECOGNIZED":      1,
+		"STATUS_OPEN":              2,
+		"STATUS_ONGOING":           3,
+		"STATUS_AWAITING_APPROVAL": 4,
+		"STATUS_REPAIRED":          5,
+	}
+)
+
+func (x VehicleInspection_Damage_Status) Enum() *VehicleInspection_Damage_Status {
+	p := new(VehicleInspection_Damage_Status)
+	*p = x
+	return p
+}
+
+func (x VehicleInspection_Damage_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VehicleInspection_Damage_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_enumTypes[1].Descriptor()
+}
+
+func (VehicleInspection_Damage_Status) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_enumTypes[1]
+}
+
+func (x VehicleInspection_Damage_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// VehicleInspection represents a vehicle inspection performed with a checklist, e.g. a pre-trip inspection.
+type VehicleInspection struct {
+	state                            protoimpl.MessageState     `protogen:"opaque.v1"`
+	xxx_hidden_InspectionId          int64                      `protobuf:"varint,1,opt,name=inspection_id,json=inspectionId"`
+	xxx_hidden_Title                 *string                    `protobuf:"bytes,2,opt,name=title"`
+	xxx_hidden_UnitId                int64                      `protobuf:"varint,3,opt,name=unit_id,json=unitId"`
+	xxx_hidden_Location              *Location                  `protobuf:"bytes,4,opt,name=location"`
+	xxx_hidden_Status                VehicleInspection_Status   `protobuf:"varint,5,opt,name=status,enum=wayplatform.connect.mapon.v1.VehicleInspection_Status"`
+	xxx_hidden_StatusChangedAt       *timestamppb.Timestamp     `protobuf:"bytes,6,opt,name=status_changed_at,json=statusChangedAt"`
+	xxx_hidden_Number                *string                    `protobuf:"bytes,7,opt,name=number"`
+	xxx_hidden_InspectedAt           *timestamppb.Timestamp     `protobuf:"bytes,8,opt,name=inspected_at,json=inspectedAt"`
+	xxx_hidden_OdometerKm            int64                      `protobuf:"varint,9,opt,name=odometer_km,json=odometerKm"`
+	xxx_hidden_PerformerName         *string                    `protobuf:"bytes,10,opt,name=performer_name,json=performerName"`
+	xxx_hidden_PerformerSignatureUrl *string                    `protobuf:"bytes,11,opt,name=performer_signature_url,json=performerSignatureUrl"`
+	xxx_hidden_TotalTimeSpentS       int64                      `protobuf:"varint,12,opt,name=total_time_spent_s,json=totalTimeSpentS"`
+	xxx_hidden_Items                 *[]*VehicleInspection_Item `protobuf:"bytes,13,rep,name=items"`
+	XXX_raceDetectHookData           protoimpl.RaceDetectHookData
+	XXX_presence                     [1]uint32
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *VehicleInspection) Reset() {
+	*x = VehicleInspection{}
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehicleInspection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleInspection) ProtoMessage() {}
+
+func (x *VehicleInspection) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VehicleInspection) GetInspectionId() int64 {
+	if x != nil {
+		return x.xxx_hidden_InspectionId
+	}
+	return 0
+}
+
+func (x *VehicleInspection) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleInspection) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *VehicleInspection) GetLocation() *Location {
+	if x != nil {
+		return x.xxx_hidden_Location
+	}
+	return nil
+}
+
+func (x *VehicleInspection) GetStatus() VehicleInspection_Status {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 4) {
+			return x.xxx_hidden_Status
+		}
+	}
+	return VehicleInspection_STATUS_UNSPECIFIED
+}
+
+func (x *VehicleInspection) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_StatusChangedAt
+	}
+	return nil
+}
+
+func (x *VehicleInspection) GetNumber() string {
+	if x != nil {
+		if x.xxx_hidden_Number != nil {
+			return *x.xxx_hidden_Number
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleInspection) GetInspectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_InspectedAt
+	}
+	return nil
+}
+
+func (x *VehicleInspection) GetOdometerKm() int64 {
+	if x != nil {
+		return x.xxx_hidden_OdometerKm
+	}
+	return 0
+}
+
+func (x *VehicleInspection) GetPerformerName() string {
+	if x != nil {
+		if x.xxx_hidden_PerformerName != nil {
+			return *x.xxx_hidden_PerformerName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleInspection) GetPerformerSignatureUrl() string {
+	if x != nil {
+		if x.xxx_hidden_PerformerSignatureUrl != nil {
+			return *x.xxx_hidden_PerformerSignatureUrl
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleInspection) GetTotalTimeSpentS() int64 {
+	if x != nil {
+		return x.xxx_hidden_TotalTimeSpentS
+	}
+	return 0
+}
+
+func (x *VehicleInspection) GetItems() []*VehicleInspection_Item {
+	if x != nil {
+		if x.xxx_hidden_Items != nil {
+			return *x.xxx_hidden_Items
+		}
+	}
+	return nil
+}
+
+func (x *VehicleInspection) SetInspectionId(v int64) {
+	x.xxx_hidden_InspectionId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 13)
+}
+
+func (x *VehicleInspection) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 13)
+}
+
+func (x *VehicleInspection) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 13)
+}
+
+func (x *VehicleInspection) SetLocation(v *Location) {
+	x.xxx_hidden_Location = v
+}
+
+func (x *VehicleInspection) SetStatus(v VehicleInspection_Status) {
+	x.xxx_hidden_Status = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 13)
+}
+
+func (x *VehicleInspection) SetStatusChangedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_StatusChangedAt = v
+}
+
+func (x *VehicleInspection) SetNumber(v string) {
+	x.xxx_hidden_Number = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 13)
+}
+
+func (x *VehicleInspection) SetInspectedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_InspectedAt = v
+}
+
+func (x *VehicleInspection) SetOdometerKm(v int64) {
+	x.xxx_hidden_OdometerKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 13)
+}
+
+func (x *VehicleInspection) SetPerformerName(v string) {
+	x.xxx_hidden_PerformerName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 13)
+}
+
+func (x *VehicleInspection) SetPerformerSignatureUrl(v string) {
+	x.xxx_hidden_PerformerSignatureUrl = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 13)
+}
+
+func (x *VehicleInspection) SetTotalTimeSpentS(v int64) {
+	x.xxx_hidden_TotalTimeSpentS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 13)
+}
+
+func (x *VehicleInspection) SetItems(v []*VehicleInspection_Item) {
+	x.xxx_hidden_Items = &v
+}
+
+func (x *VehicleInspection) HasInspectionId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *VehicleInspection) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *VehicleInspection) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *VehicleInspection) HasLocation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Location != nil
+}
+
+func (x *VehicleInspection) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *VehicleInspection) HasStatusChangedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StatusChangedAt != nil
+}
+
+func (x *VehicleInspection) HasNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *VehicleInspection) HasInspectedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_InspectedAt != nil
+}
+
+func (x *VehicleInspection) HasOdometerKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *VehicleInspection) HasPerformerName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *VehicleInspection) HasPerformerSignatureUrl() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *VehicleInspection) HasTotalTimeSpentS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *VehicleInspection) ClearInspectionId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_InspectionId = 0
+}
+
+func (x *VehicleInspection) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *VehicleInspection) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *VehicleInspection) ClearLocation() {
+	x.xxx_hidden_Location = nil
+}
+
+func (x *VehicleInspection) ClearStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Status = VehicleInspection_STATUS_UNSPECIFIED
+}
+
+func (x *VehicleInspection) ClearStatusChangedAt() {
+	x.xxx_hidden_StatusChangedAt = nil
+}
+
+func (x *VehicleInspection) ClearNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Number = nil
+}
+
+func (x *VehicleInspection) ClearInspectedAt() {
+	x.xxx_hidden_InspectedAt = nil
+}
+
+func (x *VehicleInspection) ClearOdometerKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_OdometerKm = 0
+}
+
+func (x *VehicleInspection) ClearPerformerName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_PerformerName = nil
+}
+
+func (x *VehicleInspection) ClearPerformerSignatureUrl() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_PerformerSignatureUrl = nil
+}
+
+func (x *VehicleInspection) ClearTotalTimeSpentS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_TotalTimeSpentS = 0
+}
+
+type VehicleInspection_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the inspection.
+	InspectionId *int64
+	// Title of the inspection checklist, e.g. "Daily inspection".
+	Title *string
+	// Identifier of the inspected unit.
+	UnitId *int64
+	// Location where the inspection was performed.
+	Location *Location
+	// Status of the inspection.
+	Status *VehicleInspection_Status
+	// Timestamp when the status was last changed.
+	StatusChangedAt *timestamppb.Timestamp
+	// Number of the inspection.
+	Number *string
+	// Timestamp when the inspection was performed.
+	InspectedAt *timestamppb.Timestamp
+	// Odometer reading of the unit in kilometers.
+	OdometerKm *int64
+	// Full name of the driver or user who performed the inspection.
+	PerformerName *string
+	// URL of the signature image of the performer.
+	PerformerSignatureUrl *string
+	// Total time spent on the inspection in seconds.
+	TotalTimeSpentS *int64
+	// Checklist items of the inspection. Only set when requested.
+	Items []*VehicleInspection_Item
+}
+
+func (b0 VehicleInspection_builder) Build() *VehicleInspection {
+	m0 := &VehicleInspection{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.InspectionId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 13)
+		x.xxx_hidden_InspectionId = *b.InspectionId
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 13)
+		x.xxx_hidden_Title = b.Title
+	}
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 13)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	x.xxx_hidden_Location = b.Location
+	if b.Status != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 13)
+		x.xxx_hidden_Status = *b.Status
+	}
+	x.xxx_hidden_StatusChangedAt = b.StatusChangedAt
+	if b.Number != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 13)
+		x.xxx_hidden_Number = b.Number
+	}
+	x.xxx_hidden_InspectedAt = b.InspectedAt
+	if b.OdometerKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 13)
+		x.xxx_hidden_OdometerKm = *b.OdometerKm
+	}
+	if b.PerformerName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 13)
+		x.xxx_hidden_PerformerName = b.PerformerName
+	}
+	if b.PerformerSignatureUrl != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 13)
+		x.xxx_hidden_PerformerSignatureUrl = b.PerformerSignatureUrl
+	}
+	if b.TotalTimeSpentS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 13)
+		x.xxx_hidden_TotalTimeSpentS = *b.TotalTimeSpentS
+	}
+	x.xxx_hidden_Items = &b.Items
+	return m0
+}
+
+// Item represents a checklist item of an inspection.
+type VehicleInspection_Item struct {
+	state                        protoimpl.MessageState           `protogen:"opaque.v1"`
+	xxx_hidden_ItemId            int64                            `protobuf:"varint,1,opt,name=item_id,json=itemId"`
+	xxx_hidden_Name              *string                          `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Notes             *string                          `protobuf:"bytes,3,opt,name=notes"`
+	xxx_hidden_Passed            bool                             `protobuf:"varint,4,opt,name=passed"`
+	xxx_hidden_TimeSpentS        int64                            `protobuf:"varint,5,opt,name=time_spent_s,json=timeSpentS"`
+	xxx_hidden_HistoricalDamages *[]*VehicleInspection_Damage     `protobuf:"bytes,6,rep,name=historical_damages,json=historicalDamages"`
+	xxx_hidden_Attachments       *[]*VehicleInspection_Attachment `protobuf:"bytes,7,rep,name=attachments"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *VehicleInspection_Item) Reset() {
+	*x = VehicleInspection_Item{}
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehicleInspection_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleInspection_Item) ProtoMessage() {}
+
+func (x *VehicleInspection_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VehicleInspection_Item) GetItemId() int64 {
+	if x != nil {
+		return x.xxx_hidden_ItemId
+	}
+	return 0
+}
+
+func (x *VehicleInspection_Item) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleInspection_Item) GetNotes() string {
+	if x != nil {
+		if x.xxx_hidden_Notes != nil {
+			return *x.xxx_hidden_Notes
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleInspection_Item) GetPassed() bool {
+	if x != nil {
+		return x.xxx_hidden_Passed
+	}
+	return false
+}
+
+func (x *VehicleInspection_Item) GetTimeSpentS() int64 {
+	if x != nil {
+		return x.xxx_hidden_TimeSpentS
+	}
+	return 0
+}
+
+func (x *VehicleInspection_Item) GetHistoricalDamages() []*VehicleInspection_Damage {
+	if x != nil {
+		if x.xxx_hidden_HistoricalDamages != nil {
+			return *x.xxx_hidden_HistoricalDamages
+		}
+	}
+	return nil
+}
+
+func (x *VehicleInspection_Item) GetAttachments() []*VehicleInspection_Attachment {
+	if x != nil {
+		if x.xxx_hidden_Attachments != nil {
+			return *x.xxx_hidden_Attachments
+		}
+	}
+	return nil
+}
+
+func (x *VehicleInspection_Item) SetItemId(v int64) {
+	x.xxx_hidden_ItemId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *VehicleInspection_Item) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *VehicleInspection_Item) SetNotes(v string) {
+	x.xxx_hidden_Notes = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+}
+
+func (x *VehicleInspection_Item) SetPassed(v bool) {
+	x.xxx_hidden_Passed = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+}
+
+func (x *VehicleInspection_Item) SetTimeSpentS(v int64) {
+	x.xxx_hidden_TimeSpentS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+}
+
+func (x *VehicleInspection_Item) SetHistoricalDamages(v []*VehicleInspection_Damage) {
+	x.xxx_hidden_HistoricalDamages = &v
+}
+
+func (x *VehicleInspection_Item) SetAttachments(v []*VehicleInspection_Attachment) {
+	x.xxx_hidden_Attachments = &v
+}
+
+func (x *VehicleInspection_Item) HasItemId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *VehicleInspection_Item) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *VehicleInspection_Item) HasNotes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *VehicleInspection_Item) HasPassed() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *VehicleInspection_Item) HasTimeSpentS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *VehicleInspection_Item) ClearItemId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ItemId = 0
+}
+
+func (x *VehicleInspection_Item) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *VehicleInspection_Item) ClearNotes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Notes = nil
+}
+
+func (x *VehicleInspection_Item) ClearPassed() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Passed = false
+}
+
+func (x *VehicleInspection_Item) ClearTimeSpentS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_TimeSpentS = 0
+}
+
+type VehicleInspection_Item_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the item.
+	ItemId *int64
+	// Name of the checklist item, e.g. "Tyre check".
+	Name *string
+	// Notes of the performer.
+	Notes *string
+	// Indicates if the item passed the inspection.
+	Passed *bool
+	// Time spent on the item in seconds.
+	TimeSpentS *int64
+	// Damages reported for the item in earlier inspections.
+	HistoricalDamages []*VehicleInspection_Damage
+	// Photos and files attached to the item.
+	Attachments []*VehicleInspection_Attachment
+}
+
+func (b0 VehicleInspection_Item_builder) Build() *VehicleInspection_Item {
+	m0 := &VehicleInspection_Item{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ItemId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_ItemId = *b.ItemId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Notes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_Notes = b.Notes
+	}
+	if b.Passed != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_Passed = *b.Passed
+	}
+	if b.TimeSpentS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_TimeSpentS = *b.TimeSpentS
+	}
+	x.xxx_hidden_HistoricalDamages = &b.HistoricalDamages
+	x.xxx_hidden_Attachments = &b.Attachments
+	return m0
+}
+
+// Damage represents a damage reported for a checklist item.
+type VehicleInspection_Damage struct {
+	state                  protoimpl.MessageState           `protogen:"opaque.v1"`
+	xxx_hidden_DamageId    int64                            `protobuf:"varint,1,opt,name=damage_id,json=damageId"`
+	xxx_hidden_Notes       *string                          `protobuf:"bytes,2,opt,name=notes"`
+	xxx_hidden_Status      VehicleInspection_Damage_Status  `protobuf:"varint,3,opt,name=status,enum=wayplatform.connect.mapon.v1.VehicleInspection_Damage_Status"`
+	xxx_hidden_Attachments *[]*VehicleInspection_Attachment `protobuf:"bytes,4,rep,name=attachments"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *VehicleInspection_Damage) Reset() {
+	*x = VehicleInspection_Damage{}
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehicleInspection_Damage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleInspection_Damage) ProtoMessage() {}
+
+func (x *VehicleInspection_Damage) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VehicleInspection_Damage) GetDamageId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DamageId
+	}
+	return 0
+}
+
+func (x *VehicleInspection_Damage) GetNotes() string {
+	if x != nil {
+		if x.xxx_hidden_Notes != nil {
+			return *x.xxx_hidden_Notes
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleInspection_Damage) GetStatus() VehicleInspection_Damage_Status {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Status
+		}
+	}
+	return VehicleInspection_Damage_STATUS_UNSPECIFIED
+}
+
+func (x *VehicleInspection_Damage) GetAttachments() []*VehicleInspection_Attachment {
+	if x != nil {
+		if x.xxx_hidden_Attachments != nil {
+			return *x.xxx_hidden_Attachments
+		}
+	}
+	return nil
+}
+
+func (x *VehicleInspection_Damage) SetDamageId(v int64) {
+	x.xxx_hidden_DamageId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *VehicleInspection_Damage) SetNotes(v string) {
+	x.xxx_hidden_Notes = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *VehicleInspection_Damage) SetStatus(v VehicleInspection_Damage_Status) {
+	x.xxx_hidden_Status = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *VehicleInspection_Damage) SetAttachments(v []*VehicleInspection_Attachment) {
+	x.xxx_hidden_Attachments = &v
+}
+
+func (x *VehicleInspection_Damage) HasDamageId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *VehicleInspection_Damage) HasNotes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *VehicleInspection_Damage) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *VehicleInspection_Damage) ClearDamageId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DamageId = 0
+}
+
+func (x *VehicleInspection_Damage) ClearNotes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Notes = nil
+}
+
+func (x *VehicleInspection_Damage) ClearStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Status = VehicleInspection_Damage_STATUS_UNSPECIFIED
+}
+
+type VehicleInspection_Damage_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the damage.
+	DamageId *int64
+	// Notes describing the damage.
+	Notes *string
+	// Repair status of the damage.
+	Status *VehicleInspection_Damage_Status
+	// Photos and files attached to the damage.
+	Attachments []*VehicleInspection_Attachment
+}
+
+func (b0 VehicleInspection_Damage_builder) Build() *VehicleInspection_Damage {
+	m0 := &VehicleInspection_Damage{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DamageId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_DamageId = *b.DamageId
+	}
+	if b.Notes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Notes = b.Notes
+	}
+	if b.Status != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Status = *b.Status
+	}
+	x.xxx_hidden_Attachments = &b.Attachments
+	return m0
+}
+
+// Attachment represents a photo or file attached to an inspection.
+type VehicleInspection_Attachment struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_AttachmentId int64                  `protobuf:"varint,1,opt,name=attachment_id,json=attachmentId"`
+	xxx_hidden_Url          *string                `protobuf:"bytes,2,opt,name=url"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *VehicleInspection_Attachment) Reset() {
+	*x = VehicleInspection_Attachment{}
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehicleInspection_Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleInspection_Attachment) ProtoMessage() {}
+
+func (x *VehicleInspection_Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VehicleInspection_Attachment) GetAttachmentId() int64 {
+	if x != nil {
+		return x.xxx_hidden_AttachmentId
+	}
+	return 0
+}
+
+func (x *VehicleInspection_Attachment) GetUrl() string {
+	if x != nil {
+		if x.xxx_hidden_Url != nil {
+			return *x.xxx_hidden_Url
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehicleInspection_Attachment) SetAttachmentId(v int64) {
+	x.xxx_hidden_AttachmentId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *VehicleInspection_Attachment) SetUrl(v string) {
+	x.xxx_hidden_Url = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *VehicleInspection_Attachment) HasAttachmentId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *VehicleInspection_Attachment) HasUrl() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *VehicleInspection_Attachment) ClearAttachmentId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_AttachmentId = 0
+}
+
+func (x *VehicleInspection_Attachment) ClearUrl() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Url = nil
+}
+
+type VehicleInspection_Attachment_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier for the attachment.
+	AttachmentId *int64
+	// URL to download the attachment.
+	Url *string
+}
+
+func (b0 VehicleInspection_Attachment_builder) Build() *VehicleInspection_Attachment {
+	m0 := &VehicleInspection_Attachment{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.AttachmentId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_AttachmentId = *b.AttachmentId
+	}
+	if b.Url != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Url = b.Url
+	}
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_vehicle_inspection_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_rawDesc = "" +
+	"\n" +
+	"5wayplatform/connect/mapon/v1/vehicle_inspection.proto\x12\x1cwayplatform.connect.mapon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)wayplatform/connect/mapon/v1/common.proto\"\xb3\f\n" +
+	"\x11VehicleInspection\x12#\n" +
+	"\rinspection_id\x18\x01 \x01(\x03R\finspectionId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x17\n" +
+	"\aunit_id\x18\x03 \x01(\x03R\x06unitId\x12B\n" +
+	"\blocation\x18\x04 \x01(\v2&.wayplatform.connect.mapon.v1.LocationR\blocation\x12N\n" +
+	"\x06status\x18\x05 \x01(\x0e26.wayplatform.connect.mapon.v1.VehicleInspection.StatusR\x06status\x12F\n" +
+	"\x11status_changed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\x12\x16\n" +
+	"\x06number\x18\a \x01(\tR\x06number\x12=\n" +
+	"\finspected_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vinspectedAt\x12\x1f\n" +
+	"\vodometer_km\x18\t \x01(\x03R\n" +
+	"odometerKm\x12%\n" +
+	"\x0eperformer_name\x18\n" +
+	" \x01(\tR\rperformerName\x126\n" +
+	"\x17performer_signature_url\x18\v \x01(\tR\x15performerSignatureUrl\x12+\n" +
+	"\x12total_time_spent_s\x18\f \x01(\x03R\x0ftotalTimeSpentS\x12J\n" +
+	"\x05items\x18\r \x03(\v24.wayplatform.connect.mapon.v1.VehicleInspection.ItemR\x05items\x1a\xc8\x02\n" +
+	"\x04Item\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x03R\x06itemId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x16\n" +
+	"\x06passed\x18\x04 \x01(\bR\x06passed\x12 \n" +
+	"\ftime_spent_s\x18\x05 \x01(\x03R\n" +
+	"timeSpentS\x12e\n" +
+	"\x12historical_damages\x18\x06 \x03(\v26.wayplatform.connect.mapon.v1.VehicleInspection.DamageR\x11historicalDamages\x12\\\n" +
+	"\vattachments\x18\a \x03(\v2:.wayplatform.connect.mapon.v1.VehicleInspection.AttachmentR\vattachments\x1a\x84\x03\n" +
+	"\x06Damage\x12\x1b\n" +
+	"\tdamage_id\x18\x01 \x01(\x03R\bdamageId\x12\x14\n" +
+	"\x05notes\x18\x02 \x01(\tR\x05notes\x12U\n" +
+	"\x06status\x18\x03 \x01(\x0e2=.wayplatform.connect.mapon.v1.VehicleInspection.Damage.StatusR\x06status\x12\\\n" +
+	"\vattachments\x18\x04 \x03(\v2:.wayplatform.connect.mapon.v1.VehicleInspection.AttachmentR\vattachments\"\x91\x01\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13STATUS_UNRECOGNIZED\x10\x01\x12\x0f\n" +
+	"\vSTATUS_OPEN\x10\x02\x12\x12\n" +
+	"\x0eSTATUS_ONGOING\x10\x03\x12\x1c\n" +
+	"\x18STATUS_AWAITING_APPROVAL\x10\x04\x12\x13\n" +
+	"\x0fSTATUS_REPAIRED\x10\x05\x1aC\n" +
+	"\n" +
+	"Attachment\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\x03R\fattachmentId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\x86\x01\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13STATUS_UNRECOGNIZED\x10\x01\x12\x12\n" +
+	"\x0eSTATUS_DELAYED\x10\x02\x12\x0f\n" +
+	"\vSTATUS_PASS\x10\x03\x12\x11\n" +
+	"\rSTATUS_FAILED\x10\x04\x12\x13\n" +
+	"\x0fSTATUS_RESOLVED\x10\x05B\xa1\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x16VehicleInspectionProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_goTypes = []any{
+	(VehicleInspection_Status)(0),        // 0: wayplatform.connect.mapon.v1.VehicleInspection.Status
+	(VehicleInspection_Damage_Status)(0), // 1: wayplatform.connect.mapon.v1.VehicleInspection.Damage.Status
+	(*VehicleInspection)(nil),            // 2: wayplatform.connect.mapon.v1.VehicleInspection
+	(*VehicleInspection_Item)(nil),       // 3: wayplatform.connect.mapon.v1.VehicleInspection.Item
+	(*VehicleInspection_Damage)(nil),     // 4: wayplatform.connect.mapon.v1.VehicleInspection.Damage
+	(*VehicleInspection_Attachment)(nil), // 5: wayplatform.connect.mapon.v1.VehicleInspection.Attachment
+	(*Location)(nil),                     // 6: wayplatform.connect.mapon.v1.Location
+	(*timestamppb.Timestamp)(nil),        // 7: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_depIdxs = []int32{
+	6, // 0: wayplatform.connect.mapon.v1.VehicleInspection.location:type_name -> wayplatform.connect.mapon.v1.Location
+	0, // 1: wayplatform.connect.mapon.v1.VehicleInspection.status:type_name -> wayplatform.connect.mapon.v1.VehicleInspection.Status
+	7, // 2: wayplatform.connect.mapon.v1.VehicleInspection.status_changed_at:type_name -> google.protobuf.Timestamp
+	7, // 3: wayplatform.connect.mapon.v1.VehicleInspection.inspected_at:type_name -> google.protobuf.Timestamp
+	3, // 4: wayplatform.connect.mapon.v1.VehicleInspection.items:type_name -> wayplatform.connect.mapon.v1.VehicleInspection.Item
+	4, // 5: wayplatform.connect.mapon.v1.VehicleInspection.Item.historical_damages:type_name -> wayplatform.connect.mapon.v1.VehicleInspection.Damage
+	5, // 6: wayplatform.connect.mapon.v1.VehicleInspection.Item.attachments:type_name -> wayplatform.connect.mapon.v1.VehicleInspection.Attachment
+	1, // 7: wayplatform.connect.mapon.v1.VehicleInspection.Damage.status:type_name -> wayplatform.connect.mapon.v1.VehicleInspection.Damage.Status
+	5, // 8: wayplatform.connect.mapon.v1.VehicleInspection.Damage.attachments:type_name -> wayplatform.connect.mapon.v1.VehicleInspection.Attachment
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_init() }
+func file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_init() {
+	if File_wayplatform_connect_mapon_v1_vehicle_inspection_proto != nil {
+		return
+	}
+	file_wayplatform_connect_mapon_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_vehicle_inspection_proto = out.File
+	file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_vehicle_inspection_proto_depIdxs = nil
+}
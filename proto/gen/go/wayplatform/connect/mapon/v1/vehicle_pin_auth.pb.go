@@ -0,0 +1,653 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/mapon/v1/vehicle_pin_auth.proto
+
+package maponv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// VehiclePinDeviceMapping represents the mapping of a PIN keypad device to a unit.
+type VehiclePinDeviceMapping struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UnitId      int64                  `protobuf:"varint,1,opt,name=unit_id,json=unitId"`
+	xxx_hidden_DeviceId    *string                `protobuf:"bytes,2,opt,name=device_id,json=deviceId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *VehiclePinDeviceMapping) Reset() {
+	*x = VehiclePinDeviceMapping{}
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehiclePinDeviceMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehiclePinDeviceMapping) ProtoMessage() {}
+
+func (x *VehiclePinDeviceMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VehiclePinDeviceMapping) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *VehiclePinDeviceMapping) GetDeviceId() string {
+	if x != nil {
+		if x.xxx_hidden_DeviceId != nil {
+			return *x.xxx_hidden_DeviceId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehiclePinDeviceMapping) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *VehiclePinDeviceMapping) SetDeviceId(v string) {
+	x.xxx_hidden_DeviceId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *VehiclePinDeviceMapping) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *VehiclePinDeviceMapping) HasDeviceId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *VehiclePinDeviceMapping) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *VehiclePinDeviceMapping) ClearDeviceId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_DeviceId = nil
+}
+
+type VehiclePinDeviceMapping_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Identifier of the unit.
+	UnitId *int64
+	// Identifier of the PIN keypad device (max 255 characters).
+	DeviceId *string
+}
+
+func (b0 VehiclePinDeviceMapping_builder) Build() *VehiclePinDeviceMapping {
+	m0 := &VehiclePinDeviceMapping{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.DeviceId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_DeviceId = b.DeviceId
+	}
+	return m0
+}
+
+// DriverPin represents the PIN code a driver uses to log in on a vehicle keypad.
+type DriverPin struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverId    int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId"`
+	xxx_hidden_Pin         *string                `protobuf:"bytes,2,opt,name=pin"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DriverPin) Reset() {
+	*x = DriverPin{}
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverPin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverPin) ProtoMessage() {}
+
+func (x *DriverPin) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverPin) GetDriverId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DriverId
+	}
+	return 0
+}
+
+func (x *DriverPin) GetPin() string {
+	if x != nil {
+		if x.xxx_hidden_Pin != nil {
+			return *x.xxx_hidden_Pin
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverPin) SetDriverId(v int64) {
+	x.xxx_hidden_DriverId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *DriverPin) SetPin(v string) {
+	x.xxx_hidden_Pin = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *DriverPin) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverPin) HasPin() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverPin) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = 0
+}
+
+func (x *DriverPin) ClearPin() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Pin = nil
+}
+
+type DriverPin_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Identifier of the driver.
+	DriverId *int64
+	// PIN code of exactly 4 digits.
+	Pin *string
+}
+
+func (b0 DriverPin_builder) Build() *DriverPin {
+	m0 := &DriverPin{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_DriverId = *b.DriverId
+	}
+	if b.Pin != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Pin = b.Pin
+	}
+	return m0
+}
+
+// VehiclePinAuthSummary represents the summary of a batch vehicle PIN authentication operation.
+type VehiclePinAuthSummary struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Total       int32                  `protobuf:"varint,1,opt,name=total"`
+	xxx_hidden_Successful  int32                  `protobuf:"varint,2,opt,name=successful"`
+	xxx_hidden_Failed      int32                  `protobuf:"varint,3,opt,name=failed"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *VehiclePinAuthSummary) Reset() {
+	*x = VehiclePinAuthSummary{}
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehiclePinAuthSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehiclePinAuthSummary) ProtoMessage() {}
+
+func (x *VehiclePinAuthSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VehiclePinAuthSummary) GetTotal() int32 {
+	if x != nil {
+		return x.xxx_hidden_Total
+	}
+	return 0
+}
+
+func (x *VehiclePinAuthSummary) GetSuccessful() int32 {
+	if x != nil {
+		return x.xxx_hidden_Successful
+	}
+	return 0
+}
+
+func (x *VehiclePinAuthSummary) GetFailed() int32 {
+	if x != nil {
+		return x.xxx_hidden_Failed
+	}
+	return 0
+}
+
+func (x *VehiclePinAuthSummary) SetTotal(v int32) {
+	x.xxx_hidden_Total = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *VehiclePinAuthSummary) SetSuccessful(v int32) {
+	x.xxx_hidden_Successful = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *VehiclePinAuthSummary) SetFailed(v int32) {
+	x.xxx_hidden_Failed = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *VehiclePinAuthSummary) HasTotal() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *VehiclePinAuthSummary) HasSuccessful() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *VehiclePinAuthSummary) HasFailed() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *VehiclePinAuthSummary) ClearTotal() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Total = 0
+}
+
+func (x *VehiclePinAuthSummary) ClearSuccessful() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Successful = 0
+}
+
+func (x *VehiclePinAuthSummary) ClearFailed() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Failed = 0
+}
+
+type VehiclePinAuthSummary_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Total number of operations.
+	Total *int32
+	// Number of successful operations.
+	Successful *int32
+	// Number of failed operations.
+	Failed *int32
+}
+
+func (b0 VehiclePinAuthSummary_builder) Build() *VehiclePinAuthSummary {
+	m0 := &VehiclePinAuthSummary{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Total != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Total = *b.Total
+	}
+	if b.Successful != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Successful = *b.Successful
+	}
+	if b.Failed != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Failed = *b.Failed
+	}
+	return m0
+}
+
+// VehiclePinAuthResult represents the result of a single operation in a batch.
+type VehiclePinAuthResult struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UnitId      int64                  `protobuf:"varint,1,opt,name=unit_id,json=unitId"`
+	xxx_hidden_DeviceId    *string                `protobuf:"bytes,2,opt,name=device_id,json=deviceId"`
+	xxx_hidden_DriverId    int64                  `protobuf:"varint,3,opt,name=driver_id,json=driverId"`
+	xxx_hidden_Success     bool                   `protobuf:"varint,4,opt,name=success"`
+	xxx_hidden_Message     *string                `protobuf:"bytes,5,opt,name=message"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *VehiclePinAuthResult) Reset() {
+	*x = VehiclePinAuthResult{}
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehiclePinAuthResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehiclePinAuthResult) ProtoMessage() {}
+
+func (x *VehiclePinAuthResult) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VehiclePinAuthResult) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *VehiclePinAuthResult) GetDeviceId() string {
+	if x != nil {
+		if x.xxx_hidden_DeviceId != nil {
+			return *x.xxx_hidden_DeviceId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehiclePinAuthResult) GetDriverId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DriverId
+	}
+	return 0
+}
+
+func (x *VehiclePinAuthResult) GetSuccess() bool {
+	if x != nil {
+		return x.xxx_hidden_Success
+	}
+	return false
+}
+
+func (x *VehiclePinAuthResult) GetMessage() string {
+	if x != nil {
+		if x.xxx_hidden_Message != nil {
+			return *x.xxx_hidden_Message
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VehiclePinAuthResult) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *VehiclePinAuthResult) SetDeviceId(v string) {
+	x.xxx_hidden_DeviceId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *VehiclePinAuthResult) SetDriverId(v int64) {
+	x.xxx_hidden_DriverId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *VehiclePinAuthResult) SetSuccess(v bool) {
+	x.xxx_hidden_Success = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *VehiclePinAuthResult) SetMessage(v string) {
+	x.xxx_hidden_Message = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *VehiclePinAuthResult) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *VehiclePinAuthResult) HasDeviceId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *VehiclePinAuthResult) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *VehiclePinAuthResult) HasSuccess() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *VehiclePinAuthResult) HasMessage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *VehiclePinAuthResult) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_UnitId = 0
+}
+
+func (x *VehiclePinAuthResult) ClearDeviceId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_DeviceId = nil
+}
+
+func (x *VehiclePinAuthResult) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_DriverId = 0
+}
+
+func (x *VehiclePinAuthResult) ClearSuccess() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Success = false
+}
+
+func (x *VehiclePinAuthResult) ClearMessage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Message = nil
+}
+
+type VehiclePinAuthResult_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Identifier of the unit, for device mapping operations.
+	UnitId *int64
+	// Identifier of the device, for device mapping operations.
+	DeviceId *string
+	// Identifier of the driver, for driver PIN operations.
+	DriverId *int64
+	// Indicates if the operation succeeded.
+	Success *bool
+	// Reason of the failure, e.g. "Driver with existing PIN".
+	Message *string
+}
+
+func (b0 VehiclePinAuthResult_builder) Build() *VehiclePinAuthResult {
+	m0 := &VehiclePinAuthResult{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	if b.DeviceId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_DeviceId = b.DeviceId
+	}
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_DriverId = *b.DriverId
+	}
+	if b.Success != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Success = *b.Success
+	}
+	if b.Message != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Message = b.Message
+	}
+	return m0
+}
+
+var File_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_rawDesc = "" +
+	"\n" +
+	"3wayplatform/connect/mapon/v1/vehicle_pin_auth.proto\x12\x1cwayplatform.connect.mapon.v1\"O\n" +
+	"\x17VehiclePinDeviceMapping\x12\x17\n" +
+	"\aunit_id\x18\x01 \x01(\x03R\x06unitId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\":\n" +
+	"\tDriverPin\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12\x10\n" +
+	"\x03pin\x18\x02 \x01(\tR\x03pin\"e\n" +
+	"\x15VehiclePinAuthSummary\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x1e\n" +
+	"\n" +
+	"successful\x18\x02 \x01(\x05R\n" +
+	"successful\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"\x9d\x01\n" +
+	"\x14VehiclePinAuthResult\x12\x17\n" +
+	"\aunit_id\x18\x01 \x01(\x03R\x06unitId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12\x1b\n" +
+	"\tdriver_id\x18\x03 \x01(\x03R\bdriverId\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessageB\x9e\x02\n" +
+	" com.wayplatform.connect.mapon.v1B\x13VehiclePinAuthProtoP\x01ZRgithub.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1;maponv1\xa2\x02\x03WCM\xaa\x02\x1cWayplatform.Connect.Mapon.V1\xca\x02\x1cWayplatform\\Connect\\Mapon\\V1\xe2\x02(Wayplatform\\Connect\\Mapon\\V1\\GPBMetadata\xea\x02\x1fWayplatform::Connect::Mapon::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_goTypes = []any{
+	(*VehiclePinDeviceMapping)(nil), // 0: wayplatform.connect.mapon.v1.VehiclePinDeviceMapping
+	(*DriverPin)(nil),               // 1: wayplatform.connect.mapon.v1.DriverPin
+	(*VehiclePinAuthSummary)(nil),   // 2: wayplatform.connect.mapon.v1.VehiclePinAuthSummary
+	(*VehiclePinAuthResult)(nil),    // 3: wayplatform.connect.mapon.v1.VehiclePinAuthResult
+}
+var file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_init() }
+func file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_init() {
+	if File_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_rawDesc), len(file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto = out.File
+	file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_goTypes = nil
+	file_wayplatform_connect_mapon_v1_vehicle_pin_auth_proto_depIdxs = nil
+}
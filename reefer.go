@@ -0,0 +1,302 @@
+package mapon
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reefer historic data responses group values by category (flags, hours, mode, state, temperature).
+// Categories without data are returned as an empty JSON array instead of an object,
+// and state and temperature values are further keyed by "compartment_<n>".
+
+type jsonReeferInfo struct {
+	RefrigeratorType              string      `json:"refrigerator_type"`
+	RefrigeratorCompartmentCount  interface{} `json:"refrigerator_compartment_count"` // String or number
+	RefrigeratorCommunicationType string      `json:"refrigerator_communication_type"`
+}
+
+type jsonReeferValue struct {
+	Value interface{} `json:"value"` // String or number
+	GMT   string      `json:"gmt"`
+}
+
+// jsonReeferHistoricData is the response data of both historic period and historic point requests.
+// Period responses contain arrays of values where point responses contain single values.
+type jsonReeferHistoricData struct {
+	jsonReeferInfo
+	Flags       json.RawMessage `json:"flags"`
+	Hours       json.RawMessage `json:"hours"`
+	Mode        json.RawMessage `json:"mode"`
+	State       json.RawMessage `json:"state"`
+	Temperature json.RawMessage `json:"temperature"`
+}
+
+// unmarshalReeferCategory decodes a reefer data category, leaving v untouched when the category is empty.
+func unmarshalReeferCategory(data json.RawMessage, v any) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] == '[' || bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+// parseReeferCompartment parses the compartment number from a "compartment_<n>" key.
+func parseReeferCompartment(key string) (int32, bool) {
+	n, err := strconv.ParseInt(strings.TrimPrefix(key, "compartment_"), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(n), true
+}
+
+// parseReeferTime parses reefer timestamps, which are either RFC 3339 or "2006-01-02 15:04:05" in UTC.
+func parseReeferTime(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339, time.DateTime} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func mapJSONReeferInfoToProto(j jsonReeferInfo) *maponv1.Reefer {
+	r := &maponv1.Reefer{}
+	r.SetRefrigeratorType(j.RefrigeratorType)
+	if count, err := parseFloat(j.RefrigeratorCompartmentCount); err == nil {
+		r.SetRefrigeratorCompartmentCount(int32(count))
+	}
+	r.SetRefrigeratorCommunicationType(j.RefrigeratorCommunicationType)
+	return r
+}
+
+func mapJSONReeferValueToProto(j jsonReeferValue) *maponv1.ReeferValue {
+	v := &maponv1.ReeferValue{}
+	if t, ok := parseReeferTime(j.GMT); ok {
+		v.SetTime(timestamppb.New(t))
+	}
+	if value, err := parseFloat(j.Value); err == nil {
+		v.SetValue(value)
+	}
+	return v
+}
+
+func mapJSONReeferValuesToProto(values []jsonReeferValue) []*maponv1.ReeferValue {
+	result := make([]*maponv1.ReeferValue, 0, len(values))
+	for _, v := range values {
+		result = append(result, mapJSONReeferValueToProto(v))
+	}
+	return result
+}
+
+func mapJSONReeferStateToProto(j jsonReeferValue) *maponv1.ReeferStateValue {
+	v := &maponv1.ReeferStateValue{}
+	if t, ok := parseReeferTime(j.GMT); ok {
+		v.SetTime(timestamppb.New(t))
+	}
+	if state, ok := j.Value.(string); ok {
+		v.SetState(state)
+	}
+	return v
+}
+
+func mapJSONReeferTemperatureToProto(j jsonReeferValue) *maponv1.TemperatureRecord {
+	r := &maponv1.TemperatureRecord{}
+	if t, ok := parseReeferTime(j.GMT); ok {
+		r.SetTime(timestamppb.New(t))
+	}
+	if value, err := parseFloat(j.Value); err == nil {
+		r.SetValueCelsius(value)
+	}
+	return r
+}
+
+func mapJSONReeferTemperaturesToProto(values []jsonReeferValue) []*maponv1.TemperatureRecord {
+	result := make([]*maponv1.TemperatureRecord, 0, len(values))
+	for _, v := range values {
+		result = append(result, mapJSONReeferTemperatureToProto(v))
+	}
+	return result
+}
+
+func mapJSONReeferHistoricPeriodToProto(j jsonReeferHistoricData) (*maponv1.ReeferHistoricPeriod, error) {
+	var flags []jsonReeferValue
+	if err := unmarshalReeferCategory(j.Flags, &flags); err != nil {
+		return nil, err
+	}
+	var hours, mode map[string][]jsonReeferValue
+	if err := unmarshalReeferCategory(j.Hours, &hours); err != nil {
+		return nil, err
+	}
+	if err := unmarshalReeferCategory(j.Mode, &mode); err != nil {
+		return nil, err
+	}
+	var state map[string][]jsonReeferValue
+	if err := unmarshalReeferCategory(j.State, &state); err != nil {
+		return nil, err
+	}
+	var temperature map[string]map[string][]jsonReeferValue
+	if err := unmarshalReeferCategory(j.Temperature, &temperature); err != nil {
+		return nil, err
+	}
+	p := &maponv1.ReeferHistoricPeriod{}
+	p.SetReefer(mapJSONReeferInfoToProto(j.jsonReeferInfo))
+	p.SetFlags(mapJSONReeferValuesToProto(flags))
+	p.SetStandbyHours(mapJSONReeferValuesToProto(hours["standby"]))
+	p.SetDieselHours(mapJSONReeferValuesToProto(hours["diesel"]))
+	p.SetElectricHours(mapJSONReeferValuesToProto(hours["electric"]))
+	p.SetRunModes(mapJSONReeferValuesToProto(mode["run"]))
+	p.SetSpeedModes(mapJSONReeferValuesToProto(mode["speed"]))
+	p.SetPowerModes(mapJSONReeferValuesToProto(mode["power"]))
+	compartments := make(map[int32]*maponv1.ReeferCompartmentPeriod)
+	compartment := func(key string) *maponv1.ReeferCompartmentPeriod {
+		n, ok := parseReeferCompartment(key)
+		if !ok {
+			return nil
+		}
+		c, ok := compartments[n]
+		if !ok {
+			c = &maponv1.ReeferCompartmentPeriod{}
+			c.SetCompartment(n)
+			compartments[n] = c
+		}
+		return c
+	}
+	for key, values := range state {
+		if c := compartment(key); c != nil {
+			states := make([]*maponv1.ReeferStateValue, 0, len(values))
+			for _, v := range values {
+				states = append(states, mapJSONReeferStateToProto(v))
+			}
+			c.SetStates(states)
+		}
+	}
+	for key, temps := range temperature {
+		if c := compartment(key); c != nil {
+			c.SetSupplyTemperatures(mapJSONReeferTemperaturesToProto(temps["supply"]))
+			c.SetReturnTemperatures(mapJSONReeferTemperaturesToProto(temps["return"]))
+			c.SetSetpointTemperatures(mapJSONReeferTemperaturesToProto(temps["setpoint"]))
+			c.SetCargowatchAmbientTemperatures(mapJSONReeferTemperaturesToProto(temps["cargowatch_ambient"]))
+			c.SetCargowatchRearTemperatures(mapJSONReeferTemperaturesToProto(temps["cargowatch_rear"]))
+			c.SetCargowatchReturnTemperatures(mapJSONReeferTemperaturesToProto(temps["cargowatch_return"]))
+		}
+	}
+	p.SetCompartments(sortedReeferCompartments(compartments))
+	return p, nil
+}
+
+func mapJSONReeferHistoricPointToProto(j jsonReeferHistoricData) (*maponv1.ReeferHistoricPoint, error) {
+	var flags *jsonReeferValue
+	if err := unmarshalReeferCategory(j.Flags, &flags); err != nil {
+		return nil, err
+	}
+	var hours, mode map[string]jsonReeferValue
+	if err := unmarshalReeferCategory(j.Hours, &hours); err != nil {
+		return nil, err
+	}
+	if err := unmarshalReeferCategory(j.Mode, &mode); err != nil {
+		return nil, err
+	}
+	var state map[string]jsonReeferValue
+	if err := unmarshalReeferCategory(j.State, &state); err != nil {
+		return nil, err
+	}
+	var temperature map[string]map[string]jsonReeferValue
+	if err := unmarshalReeferCategory(j.Temperature, &temperature); err != nil {
+		return nil, err
+	}
+	p := &maponv1.ReeferHistoricPoint{}
+	p.SetReefer(mapJSONReeferInfoToProto(j.jsonReeferInfo))
+	if flags != nil {
+		p.SetFlags(mapJSONReeferValueToProto(*flags))
+	}
+	if v, ok := hours["standby"]; ok {
+		p.SetStandbyHours(mapJSONReeferValueToProto(v))
+	}
+	if v, ok := hours["diesel"]; ok {
+		p.SetDieselHours(mapJSONReeferValueToProto(v))
+	}
+	if v, ok := hours["electric"]; ok {
+		p.SetElectricHours(mapJSONReeferValueToProto(v))
+	}
+	if v, ok := mode["run"]; ok {
+		p.SetRunMode(mapJSONReeferValueToProto(v))
+	}
+	if v, ok := mode["speed"]; ok {
+		p.SetSpeedMode(mapJSONReeferValueToProto(v))
+	}
+	if v, ok := mode["power"]; ok {
+		p.SetPowerMode(mapJSONReeferValueToProto(v))
+	}
+	compartments := make(map[int32]*maponv1.ReeferCompartmentPoint)
+	compartment := func(key string) *maponv1.ReeferCompartmentPoint {
+		n, ok := parseReeferCompartment(key)
+		if !ok {
+			return nil
+		}
+		c, ok := compartments[n]
+		if !ok {
+			c = &maponv1.ReeferCompartmentPoint{}
+			c.SetCompartment(n)
+			compartments[n] = c
+		}
+		return c
+	}
+	for key, v := range state {
+		if c := compartment(key); c != nil {
+			c.SetState(mapJSONReeferStateToProto(v))
+		}
+	}
+	for key, temps := range temperature {
+		c := compartment(key)
+		if c == nil {
+			continue
+		}
+		if v, ok := temps["supply"]; ok {
+			c.SetSupplyTemperature(mapJSONReeferTemperatureToProto(v))
+		}
+		if v, ok := temps["return"]; ok {
+			c.SetReturnTemperature(mapJSONReeferTemperatureToProto(v))
+		}
+		if v, ok := temps["setpoint"]; ok {
+			c.SetSetpointTemperature(mapJSONReeferTemperatureToProto(v))
+		}
+		if v, ok := temps["cargowatch_ambient"]; ok {
+			c.SetCargowatchAmbientTemperature(mapJSONReeferTemperatureToProto(v))
+		}
+		if v, ok := temps["cargowatch_rear"]; ok {
+			c.SetCargowatchRearTemperature(mapJSONReeferTemperatureToProto(v))
+		}
+		if v, ok := temps["cargowatch_return"]; ok {
+			c.SetCargowatchReturnTemperature(mapJSONReeferTemperatureToProto(v))
+		}
+	}
+	p.SetCompartments(sortedReeferCompartments(compartments))
+	return p, nil
+}
+
+// sortedReeferCompartments returns the compartments ordered by compartment number.
+func sortedReeferCompartments[T interface{ GetCompartment() int32 }](compartments map[int32]T) []T {
+	result := make([]T, 0, len(compartments))
+	for _, c := range compartments {
+		result = append(result, c)
+	}
+	slices.SortFunc(result, func(a, b T) int {
+		return int(a.GetCompartment() - b.GetCompartment())
+	})
+	return result
+}
+
+type jsonReeferStatusResponse struct {
+	Data struct {
+		Status  string `json:"status"`
+		AlertID int64  `json:"alertId"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
@@ -0,0 +1,217 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetReeferHistoricPeriod(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reefer/historic_period.json" {
+			t.Errorf("expected /reefer/historic_period.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("from"); got != "2020-05-26T00:00:00Z" {
+			t.Errorf("expected from 2020-05-26T00:00:00Z, got %s", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"refrigerator_type": "CARRIER",
+				"refrigerator_compartment_count": "2",
+				"refrigerator_communication_type": "1",
+				"flags": [],
+				"hours": {
+					"diesel": [
+						{"value": 14237, "gmt": "2020-05-26T15:13:01Z"},
+						{"value": 14238, "gmt": "2020-05-26T16:13:09Z"}
+					]
+				},
+				"mode": [],
+				"state": {
+					"compartment_1": [{"value": "off", "gmt": "2020-05-27T13:02:16Z"}],
+					"compartment_0": [{"value": "on", "gmt": "2020-05-27T09:41:16Z"}]
+				},
+				"temperature": {
+					"compartment_0": {
+						"supply": [{"value": -13.8, "gmt": "2020-05-26T14:35:56Z"}],
+						"setpoint": [{"value": -7, "gmt": "2020-05-26T14:35:56Z"}]
+					}
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.GetReeferHistoricPeriod(context.Background(), maponv1.GetReeferHistoricPeriodRequest_builder{
+		UnitId:   new(int64(1)),
+		FromTime: timestamppb.New(time.Date(2020, 5, 26, 0, 0, 0, 0, time.UTC)),
+		ToTime:   timestamppb.New(time.Date(2020, 5, 28, 0, 0, 0, 0, time.UTC)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data := resp.GetData()
+	if got := data.GetReefer().GetRefrigeratorCompartmentCount(); got != 2 {
+		t.Errorf("expected 2 compartments, got %d", got)
+	}
+	if len(data.GetFlags()) != 0 || len(data.GetRunModes()) != 0 {
+		t.Errorf("expected empty flags and run modes")
+	}
+	if got := len(data.GetDieselHours()); got != 2 {
+		t.Fatalf("expected 2 diesel hour values, got %d", got)
+	}
+	if got := data.GetDieselHours()[1].GetValue(); got != 14238 {
+		t.Errorf("expected 14238 diesel hours, got %v", got)
+	}
+	compartments := data.GetCompartments()
+	if len(compartments) != 2 {
+		t.Fatalf("expected 2 compartments, got %d", len(compartments))
+	}
+	if compartments[0].GetCompartment() != 0 || compartments[1].GetCompartment() != 1 {
+		t.Errorf("expected compartments ordered 0, 1")
+	}
+	if got := compartments[0].GetSupplyTemperatures()[0].GetValueCelsius(); got != -13.8 {
+		t.Errorf("expected supply temperature -13.8, got %v", got)
+	}
+	if got := compartments[1].GetStates()[0].GetState(); got != "off" {
+		t.Errorf("expected compartment 1 state off, got %s", got)
+	}
+}
+
+func TestGetReeferHistoricPoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("select"); got != "nearest" {
+			t.Errorf("expected select nearest, got %s", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"refrigerator_type": "CARRIER",
+				"refrigerator_compartment_count": "1",
+				"refrigerator_communication_type": "1",
+				"flags": {"value": 7, "gmt": "2020-05-26T14:16:17Z"},
+				"hours": [],
+				"mode": {"run": {"value": 1, "gmt": "2020-05-26T14:16:20Z"}},
+				"state": [],
+				"temperature": {
+					"compartment_0": {
+						"return": {"value": -11.3, "gmt": "2020-05-26T14:59:56Z"}
+					}
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.GetReeferHistoricPoint(context.Background(), maponv1.GetReeferHistoricPointRequest_builder{
+		UnitId:    new(int64(1)),
+		Time:      timestamppb.New(time.Date(2020, 5, 26, 15, 0, 0, 0, time.UTC)),
+		Selection: maponv1.ReeferHistoricPointSelection_REEFER_HISTORIC_POINT_SELECTION_NEAREST.Enum(),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data := resp.GetData()
+	if got := data.GetFlags().GetValue(); got != 7 {
+		t.Errorf("expected flags 7, got %v", got)
+	}
+	if data.HasDieselHours() {
+		t.Errorf("expected no diesel hours")
+	}
+	if got := data.GetRunMode().GetValue(); got != 1 {
+		t.Errorf("expected run mode 1, got %v", got)
+	}
+	if len(data.GetCompartments()) != 1 {
+		t.Fatalf("expected 1 compartment, got %d", len(data.GetCompartments()))
+	}
+	if got := data.GetCompartments()[0].GetReturnTemperature().GetValueCelsius(); got != -11.3 {
+		t.Errorf("expected return temperature -11.3, got %v", got)
+	}
+}
+
+func TestListReeferAlerts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"alerts": [
+					{
+						"alert_id": 1,
+						"car_id": 2,
+						"settings": {
+							"runmode": "1",
+							"setpoint_temp": "5",
+							"over_setpoint_temp": "3",
+							"under_setpoint_temp": "3",
+							"gmt_from": "2016-03-31T09:27:00Z",
+							"gmt_to": "2016-04-30T09:27:00Z",
+							"user_id": "1",
+							"compartment": "0",
+							"cargo_cooling": 1,
+							"cargo_cooling_range": 4,
+							"channels": ["sms"],
+							"notes": ""
+						},
+						"last_data_gmt": "2016-04-11 10:29:10",
+						"current_setpoint_temp": 11.6,
+						"current_setpoint_temp_gmt": "2016-04-03 17:28:49",
+						"current_compartment_state": "off",
+						"current_compartment_state_gmt": "2016-04-09 16:17:02"
+					}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListReeferAlerts(context.Background(), &maponv1.ListReeferAlertsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetAlerts()) != 1 {
+		t.Fatalf("expected 1 alert, got %d", len(resp.GetAlerts()))
+	}
+	alert := resp.GetAlerts()[0]
+	if alert.GetUnitId() != 2 {
+		t.Errorf("expected unit_id 2, got %d", alert.GetUnitId())
+	}
+	if alert.GetSetpointCelsius() != 5 {
+		t.Errorf("expected setpoint 5, got %v", alert.GetSetpointCelsius())
+	}
+	if !alert.GetCargoCooling() {
+		t.Errorf("expected cargo cooling to be enabled")
+	}
+	if got := alert.GetCurrentSetpointTemperature().GetValueCelsius(); got != 11.6 {
+		t.Errorf("expected current setpoint 11.6, got %v", got)
+	}
+	if alert.HasCurrentFlags() {
+		t.Errorf("expected no current flags")
+	}
+	if got := alert.GetCurrentCompartmentState().GetState(); got != "off" {
+		t.Errorf("expected compartment state off, got %s", got)
+	}
+}
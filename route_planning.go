@@ -0,0 +1,502 @@
+package mapon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// routePlanningTimeLayout is the datetime format of route planning timestamps in UTC.
+const routePlanningTimeLayout = "2006-01-02T15:04:05"
+
+// routePlanningIncludes returns the value of the comma separated "includes" parameter.
+func routePlanningIncludes(includes map[string]bool) string {
+	var result []string
+	for _, name := range []string{"polyline", "polyline_to", "files"} {
+		if includes[name] {
+			result = append(result, name)
+		}
+	}
+	return strings.Join(result, ",")
+}
+
+// parseRoutePlanningTime parses a nullable "2006-01-02 15:04:05" UTC timestamp.
+func parseRoutePlanningTime(s *string) *timestamppb.Timestamp {
+	if s == nil {
+		return nil
+	}
+	t, err := time.Parse(time.DateTime, *s)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// jsonRoutePlanningPlaceInput is the request representation of a route planning place.
+type jsonRoutePlanningPlaceInput struct {
+	Address                string                   `json:"address,omitempty"`
+	ClientID               int64                    `json:"client_id,omitempty"`
+	Geofence               int32                    `json:"geofence,omitempty"`
+	CargoType              string                   `json:"cargo_type,omitempty"`
+	OptimalTemperatureFrom *int32                   `json:"optimal_temperature_from,omitempty"`
+	OptimalTemperatureTo   *int32                   `json:"optimal_temperature_to,omitempty"`
+	Capacity               int64                    `json:"capacity,omitempty"`
+	CapacityWeight         int64                    `json:"capacity_weight,omitempty"`
+	Email                  string                   `json:"email,omitempty"`
+	Phone                  string                   `json:"phone,omitempty"`
+	Notes                  string                   `json:"notes,omitempty"`
+	LoadingTime            int32                    `json:"loading_time,omitempty"`
+	DeliveryDates          *jsonRoutePlanningFromTo `json:"delivery_dates,omitempty"`
+	DeliveryTimes          *jsonRoutePlanningFromTo `json:"delivery_times,omitempty"`
+	DeliveryInterval       *int                     `json:"delivery_interval,omitempty"`
+	SortNo                 *int32                   `json:"sort_no,omitempty"`
+}
+
+type jsonRoutePlanningFromTo struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+func mapRoutePlanningPlaceInputsToJSON(places []*maponv1.RoutePlanningPlaceInput) []jsonRoutePlanningPlaceInput {
+	result := make([]jsonRoutePlanningPlaceInput, 0, len(places))
+	for _, p := range places {
+		j := jsonRoutePlanningPlaceInput{
+			Address:        p.GetAddress(),
+			ClientID:       p.GetClientId(),
+			Geofence:       p.GetGeofenceRadiusM(),
+			CargoType:      p.GetCargoType(),
+			Capacity:       p.GetCapacity(),
+			CapacityWeight: p.GetCapacityWeightKg(),
+			Email:          p.GetEmail(),
+			Phone:          p.GetPhone(),
+			Notes:          p.GetNotes(),
+			LoadingTime:    p.GetLoadingTimeMinutes(),
+		}
+		if p.HasOptimalTemperatureFromCelsius() {
+			j.OptimalTemperatureFrom = new(p.GetOptimalTemperatureFromCelsius())
+		}
+		if p.HasOptimalTemperatureToCelsius() {
+			j.OptimalTemperatureTo = new(p.GetOptimalTemperatureToCelsius())
+		}
+		if p.HasSortNumber() {
+			j.SortNo = new(p.GetSortNumber())
+		}
+		if w := p.GetDeliveryWindow(); w != nil {
+			if w.GetFromDate() != "" || w.GetToDate() != "" {
+				j.DeliveryDates = &jsonRoutePlanningFromTo{From: w.GetFromDate(), To: w.GetToDate()}
+				if w.GetContinuous() {
+					j.DeliveryInterval = new(1)
+				}
+			}
+			if w.GetFromTime() != "" || w.GetToTime() != "" {
+				j.DeliveryTimes = &jsonRoutePlanningFromTo{From: w.GetFromTime(), To: w.GetToTime()}
+			}
+		}
+		result = append(result, j)
+	}
+	return result
+}
+
+type jsonRoutePlanningOrder struct {
+	ID       int64   `json:"id"`
+	OrderNo  string  `json:"order_no"`
+	Type     string  `json:"type"`
+	Price    float64 `json:"price"`
+	Distance int64   `json:"distance"`
+	Duration int64   `json:"duration"`
+	RouteID  *int64  `json:"route_id"`
+	Polyline *string `json:"polyline"`
+}
+
+func mapJSONRoutePlanningOrderToProto(j jsonRoutePlanningOrder) *maponv1.RoutePlanningOrder {
+	o := &maponv1.RoutePlanningOrder{}
+	o.SetOrderId(j.ID)
+	o.SetOrderNumber(j.OrderNo)
+	switch j.Type {
+	case "visit":
+		o.SetType(maponv1.RoutePlanningOrder_TYPE_VISIT)
+	case "pickup_delivery":
+		o.SetType(maponv1.RoutePlanningOrder_TYPE_PICKUP_DELIVERY)
+	case "":
+	default:
+		o.SetType(maponv1.RoutePlanningOrder_TYPE_UNRECOGNIZED)
+		o.SetUnrecognizedType(j.Type)
+	}
+	o.SetPrice(j.Price)
+	o.SetDistanceM(j.Distance)
+	o.SetDurationS(j.Duration)
+	if j.RouteID != nil {
+		o.SetRouteId(*j.RouteID)
+	}
+	if j.Polyline != nil {
+		o.SetPolyline(*j.Polyline)
+	}
+	return o
+}
+
+type jsonRoutePlanningPlace struct {
+	ID                     int64                    `json:"id"`
+	OrderID                int64                    `json:"order_id"`
+	RouteID                *int64                   `json:"route_id"`
+	ClientID               *int64                   `json:"client_id"`
+	SortNo                 int32                    `json:"sort_no"`
+	Address                string                   `json:"address"`
+	AddressLat             float64                  `json:"address_lat"`
+	AddressLng             float64                  `json:"address_lng"`
+	AddressTimezone        string                   `json:"address_timezone"`
+	Geofence               int32                    `json:"geofence"`
+	Type                   string                   `json:"type"`
+	Status                 string                   `json:"status"`
+	StatusChangedAt        *string                  `json:"status_changed_at"`
+	Phone                  *string                  `json:"phone"`
+	Email                  *string                  `json:"email"`
+	CargoType              *string                  `json:"cargo_type"`
+	DeliveryDates          *jsonRoutePlanningFromTo `json:"delivery_dates"`
+	DeliveryTimes          *jsonRoutePlanningFromTo `json:"delivery_times"`
+	DeliveryInterval       bool                     `json:"delivery_interval"`
+	OptimalTemperatureFrom *int32                   `json:"optimal_temperature_from"`
+	OptimalTemperatureTo   *int32                   `json:"optimal_temperature_to"`
+	CapacityWeight         int64                    `json:"capacity_weight"`
+	Capacity               int64                    `json:"capacity"`
+	StopOffTime            int32                    `json:"stop_off_time"`
+	Notes                  string                   `json:"notes"`
+	FinishedAt             *string                  `json:"finished_at"`
+	DistanceTo             int64                    `json:"distance_to"`
+	DurationTo             int64                    `json:"duration_to"`
+	PlannedArrivalTime     *string                  `json:"planned_arrival_time"`
+	ArrivalTime            *string                  `json:"arrival_time"`
+	DistanceLeft           *int64                   `json:"distance_left"`
+	PolylineTo             string                   `json:"polyline_to"`
+	Files                  []struct {
+		ID       int64   `json:"id"`
+		Name     string  `json:"name"`
+		URL      string  `json:"url"`
+		ThumbURL *string `json:"thumb_url"`
+		Mime     string  `json:"mime"`
+		Size     int64   `json:"size"`
+		Width    *int32  `json:"width"`
+		Height   *int32  `json:"height"`
+	} `json:"files"`
+}
+
+var routePlanningPlaceTypes = map[string]maponv1.RoutePlanningPlace_Type{
+	"visit":    maponv1.RoutePlanningPlace_TYPE_VISIT,
+	"pickup":   maponv1.RoutePlanningPlace_TYPE_PICKUP,
+	"delivery": maponv1.RoutePlanningPlace_TYPE_DELIVERY,
+}
+
+var routePlanningPlaceStatuses = map[string]maponv1.RoutePlanningPlace_Status{
+	"not_started": maponv1.RoutePlanningPlace_STATUS_NOT_STARTED,
+	"started":     maponv1.RoutePlanningPlace_STATUS_STARTED,
+	"loading":     maponv1.RoutePlanningPlace_STATUS_LOADING,
+	"completed":   maponv1.RoutePlanningPlace_STATUS_COMPLETED,
+	"declined":    maponv1.RoutePlanningPlace_STATUS_DECLINED,
+}
+
+func mapJSONRoutePlanningPlaceToProto(j jsonRoutePlanningPlace) *maponv1.RoutePlanningPlace {
+	p := &maponv1.RoutePlanningPlace{}
+	p.SetPlaceId(j.ID)
+	p.SetOrderId(j.OrderID)
+	if j.RouteID != nil {
+		p.SetRouteId(*j.RouteID)
+	}
+	if j.ClientID != nil {
+		p.SetClientId(*j.ClientID)
+	}
+	p.SetSortNumber(j.SortNo)
+	loc := &maponv1.Location{}
+	loc.SetLatitude(j.AddressLat)
+	loc.SetLongitude(j.AddressLng)
+	loc.SetAddress(j.Address)
+	p.SetLocation(loc)
+	p.SetTimezone(j.AddressTimezone)
+	p.SetGeofenceRadiusM(j.Geofence)
+	if t, ok := routePlanningPlaceTypes[j.Type]; ok {
+		p.SetType(t)
+	} else if j.Type != "" {
+		p.SetType(maponv1.RoutePlanningPlace_TYPE_UNRECOGNIZED)
+		p.SetUnrecognizedType(j.Type)
+	}
+	if s, ok := routePlanningPlaceStatuses[j.Status]; ok {
+		p.SetStatus(s)
+	} else if j.Status != "" {
+		p.SetStatus(maponv1.RoutePlanningPlace_STATUS_UNRECOGNIZED)
+		p.SetUnrecognizedStatus(j.Status)
+	}
+	if t := parseRoutePlanningTime(j.StatusChangedAt); t != nil {
+		p.SetStatusChangedAt(t)
+	}
+	if j.Phone != nil {
+		p.SetPhone(*j.Phone)
+	}
+	if j.Email != nil {
+		p.SetEmail(strings.TrimSpace(*j.Email))
+	}
+	if j.CargoType != nil {
+		p.SetCargoType(*j.CargoType)
+	}
+	if j.DeliveryDates != nil || j.DeliveryTimes != nil {
+		w := &maponv1.RoutePlanningDeliveryWindow{}
+		if j.DeliveryDates != nil {
+			w.SetFromDate(j.DeliveryDates.From)
+			w.SetToDate(j.DeliveryDates.To)
+		}
+		if j.DeliveryTimes != nil {
+			w.SetFromTime(j.DeliveryTimes.From)
+			w.SetToTime(j.DeliveryTimes.To)
+		}
+		w.SetContinuous(j.DeliveryInterval)
+		p.SetDeliveryWindow(w)
+	}
+	if j.OptimalTemperatureFrom != nil {
+		p.SetOptimalTemperatureFromCelsius(*j.OptimalTemperatureFrom)
+	}
+	if j.OptimalTemperatureTo != nil {
+		p.SetOptimalTemperatureToCelsius(*j.OptimalTemperatureTo)
+	}
+	p.SetCapacityWeightKg(j.CapacityWeight)
+	p.SetCapacity(j.Capacity)
+	p.SetStopOffTimeMinutes(j.StopOffTime)
+	p.SetNotes(j.Notes)
+	if t := parseRoutePlanningTime(j.FinishedAt); t != nil {
+		p.SetFinishedAt(t)
+	}
+	p.SetDistanceToM(j.DistanceTo)
+	p.SetDurationToS(j.DurationTo)
+	if t := parseRoutePlanningTime(j.PlannedArrivalTime); t != nil {
+		p.SetPlannedArrivalTime(t)
+	}
+	if t := parseRoutePlanningTime(j.ArrivalTime); t != nil {
+		p.SetArrivalTime(t)
+	}
+	if j.DistanceLeft != nil {
+		p.SetDistanceLeftM(*j.DistanceLeft)
+	}
+	p.SetPolylineTo(j.PolylineTo)
+	files := make([]*maponv1.RoutePlanningFile, 0, len(j.Files))
+	for _, jf := range j.Files {
+		f := &maponv1.RoutePlanningFile{}
+		f.SetFileId(jf.ID)
+		f.SetName(jf.Name)
+		f.SetUrl(jf.URL)
+		if jf.ThumbURL != nil {
+			f.SetThumbUrl(*jf.ThumbURL)
+		}
+		f.SetMimeType(jf.Mime)
+		f.SetSizeBytes(jf.Size)
+		if jf.Width != nil {
+			f.SetWidth(*jf.Width)
+		}
+		if jf.Height != nil {
+			f.SetHeight(*jf.Height)
+		}
+		files = append(files, f)
+	}
+	p.SetFiles(files)
+	return p
+}
+
+type jsonRoutePlanningRoute struct {
+	ID              int64    `json:"id"`
+	Name            string   `json:"name"`
+	UnitID          *int64   `json:"unit_id"`
+	DriverID        *int64   `json:"driver_id"`
+	DriverUnitID    *int64   `json:"driver_unit_id"`
+	StartAddress    *string  `json:"start_address"`
+	StartLat        *float64 `json:"start_lat"`
+	StartLng        *float64 `json:"start_lng"`
+	StartTimezone   *string  `json:"start_timezone"`
+	EndAddress      *string  `json:"end_address"`
+	EndLat          *float64 `json:"end_lat"`
+	EndLng          *float64 `json:"end_lng"`
+	EndTimezone     *string  `json:"end_timezone"`
+	DepartureAt     *string  `json:"departure_at"`
+	PlannedAt       *string  `json:"planned_at"`
+	IsInProgress    bool     `json:"is_in_progress"`
+	PlacesTotal     int32    `json:"places_total"`
+	PlacesCompleted int32    `json:"places_completed"`
+	Distance        int64    `json:"distance"`
+	Duration        int64    `json:"duration"`
+	CostsPerKm      float64  `json:"costs_per_km"`
+	CostsPerH       *float64 `json:"costs_per_h"`
+	CostPerH        *float64 `json:"cost_per_h"` // Documented as costs_per_h, returned as cost_per_h
+	TollCosts       []struct {
+		Name        string      `json:"name"`
+		Country     string      `json:"country"`
+		CountryCode string      `json:"country_code"`
+		Amount      interface{} `json:"amount"` // String or number
+	} `json:"toll_costs"`
+	Polyline *string `json:"polyline"`
+}
+
+func mapJSONRoutePlanningRouteToProto(j jsonRoutePlanningRoute) *maponv1.RoutePlanningRoute {
+	r := &maponv1.RoutePlanningRoute{}
+	r.SetRouteId(j.ID)
+	r.SetName(j.Name)
+	if j.UnitID != nil {
+		r.SetUnitId(*j.UnitID)
+	}
+	if j.DriverID != nil {
+		r.SetDriverId(*j.DriverID)
+	}
+	if j.DriverUnitID != nil {
+		r.SetDriverUnitId(*j.DriverUnitID)
+	}
+	if j.StartAddress != nil || j.StartLat != nil || j.StartLng != nil {
+		r.SetStart(newRoutePlanningLocation(j.StartAddress, j.StartLat, j.StartLng))
+	}
+	if j.StartTimezone != nil {
+		r.SetStartTimezone(*j.StartTimezone)
+	}
+	if j.EndAddress != nil || j.EndLat != nil || j.EndLng != nil {
+		r.SetEnd(newRoutePlanningLocation(j.EndAddress, j.EndLat, j.EndLng))
+	}
+	if j.EndTimezone != nil {
+		r.SetEndTimezone(*j.EndTimezone)
+	}
+	if t := parseRoutePlanningTime(j.DepartureAt); t != nil {
+		r.SetDepartureAt(t)
+	}
+	if t := parseRoutePlanningTime(j.PlannedAt); t != nil {
+		r.SetPlannedAt(t)
+	}
+	r.SetInProgress(j.IsInProgress)
+	r.SetPlacesTotal(j.PlacesTotal)
+	r.SetPlacesCompleted(j.PlacesCompleted)
+	r.SetDistanceM(j.Distance)
+	r.SetDurationS(j.Duration)
+	r.SetCostPerKmEurCents(j.CostsPerKm)
+	if j.CostsPerH != nil {
+		r.SetCostPerHourEurCents(*j.CostsPerH)
+	} else if j.CostPerH != nil {
+		r.SetCostPerHourEurCents(*j.CostPerH)
+	}
+	tolls := make([]*maponv1.RoutePlanningTollCost, 0, len(j.TollCosts))
+	for _, jt := range j.TollCosts {
+		t := &maponv1.RoutePlanningTollCost{}
+		t.SetName(jt.Name)
+		if jt.CountryCode != "" {
+			t.SetCountryCode(jt.CountryCode)
+		} else {
+			t.SetCountryCode(jt.Country)
+		}
+		if amount, err := parseFloat(jt.Amount); err == nil {
+			t.SetAmountEurCents(amount)
+		}
+		tolls = append(tolls, t)
+	}
+	r.SetTollCosts(tolls)
+	if j.Polyline != nil {
+		r.SetPolyline(*j.Polyline)
+	}
+	return r
+}
+
+func newRoutePlanningLocation(address *string, lat, lng *float64) *maponv1.Location {
+	loc := &maponv1.Location{}
+	if address != nil {
+		loc.SetAddress(*address)
+	}
+	if lat != nil {
+		loc.SetLatitude(*lat)
+	}
+	if lng != nil {
+		loc.SetLongitude(*lng)
+	}
+	return loc
+}
+
+var routePlanningOptimizationStatuses = map[string]maponv1.RoutePlanningOptimizationStatus{
+	"waiting":             maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_WAITING,
+	"running":             maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_RUNNING,
+	"finished":            maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_FINISHED,
+	"canceled":            maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_CANCELED,
+	"failed":              maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_FAILED,
+	"no_solution":         maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_NO_SOLUTION,
+	"unreachable_points":  maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_UNREACHABLE_POINTS,
+	"nothing_to_optimize": maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_NOTHING_TO_OPTIMIZE,
+}
+
+func mapRoutePlanningOptimizationStatus(s string) maponv1.RoutePlanningOptimizationStatus {
+	if status, ok := routePlanningOptimizationStatuses[s]; ok {
+		return status
+	}
+	if s == "" {
+		return maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_UNSPECIFIED
+	}
+	return maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_UNRECOGNIZED
+}
+
+type jsonRoutePlanningDeleteResponse struct {
+	Data struct {
+		Deleted bool `json:"deleted"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+type jsonRoutePlanningStatusResponse struct {
+	Data struct {
+		Status string `json:"status"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+// OptimizeRouteAndWait starts the optimization of a planned route and polls its progress
+// until the optimization has finished or ctx is done. The progress is checked at the interval
+// set with [WithPollInterval].
+// It returns an error if the optimization fails, finds no solution or reports an unknown status.
+func (c *Client) OptimizeRouteAndWait(
+	ctx context.Context,
+	request *maponv1.OptimizeRoutePlanningRouteRequest,
+) (*maponv1.GetRoutePlanningOptimizationProgressResponse, error) {
+	optimization, err := c.OptimizeRoutePlanningRoute(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if optimization.GetStatus() == maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_FAILED {
+		return nil, fmt.Errorf("mapon: optimize route %d: optimization failed to start", request.GetRouteId())
+	}
+	if optimization.GetOptimizationId() == 0 {
+		return nil, fmt.Errorf("mapon: optimize route %d: no optimization ID returned", request.GetRouteId())
+	}
+	progressRequest := &maponv1.GetRoutePlanningOptimizationProgressRequest{}
+	progressRequest.SetOptimizationId(optimization.GetOptimizationId())
+	ticker := time.NewTicker(c.config.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("mapon: optimize route %d: %w", request.GetRouteId(), ctx.Err())
+		case <-ticker.C:
+		}
+		progress, err := c.GetRoutePlanningOptimizationProgress(ctx, progressRequest)
+		if err != nil {
+			return nil, err
+		}
+		switch progress.GetStatus() {
+		case maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_WAITING,
+			maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_RUNNING:
+			continue
+		case maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_FINISHED,
+			maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_NOTHING_TO_OPTIMIZE:
+			return progress, nil
+		case maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_CANCELED,
+			maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_FAILED,
+			maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_NO_SOLUTION,
+			maponv1.RoutePlanningOptimizationStatus_ROUTE_PLANNING_OPTIMIZATION_STATUS_UNREACHABLE_POINTS:
+			return nil, fmt.Errorf(
+				"mapon: optimize route %d: optimization %d ended with status %s",
+				request.GetRouteId(), optimization.GetOptimizationId(), progress.GetStatus(),
+			)
+		default:
+			return nil, fmt.Errorf(
+				"mapon: optimize route %d: optimization %d has unknown status %s",
+				request.GetRouteId(), optimization.GetOptimizationId(), progress.GetStatus(),
+			)
+		}
+	}
+}
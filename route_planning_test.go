@@ -0,0 +1,273 @@
+package mapon
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+func TestListRoutePlanningPlaces(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/routeplanning_places/list.json" {
+			t.Errorf("expected /routeplanning_places/list.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("route_ids"); got != "21,22" {
+			t.Errorf("expected route_ids 21,22, got %q", got)
+		}
+		if got := r.URL.Query().Get("includes"); got != "polyline_to,files" {
+			t.Errorf("expected includes polyline_to,files, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [
+				{
+					"id": 7,
+					"order_id": 3,
+					"route_id": 21,
+					"client_id": null,
+					"sort_no": 2,
+					"address": "Riga, Latvia",
+					"address_lat": 56.9496,
+					"address_lng": 24.1052,
+					"address_timezone": "Europe/Riga",
+					"geofence": 300,
+					"type": "delivery",
+					"status": "completed",
+					"status_changed_at": "2024-03-01 10:15:00",
+					"delivery_dates": {"from": "2024-03-01", "to": "2024-03-02"},
+					"delivery_times": {"from": "08:00", "to": "17:00"},
+					"delivery_interval": true,
+					"optimal_temperature_from": -18,
+					"optimal_temperature_to": null,
+					"capacity_weight": 1200,
+					"finished_at": null,
+					"distance_to": 15000,
+					"duration_to": 1200,
+					"polyline_to": "abc",
+					"files": [{"id": 1, "name": "proof.jpg", "url": "https://example.com/proof.jpg", "mime": "image/jpeg", "size": 1024}]
+				},
+				{
+					"id": 8,
+					"order_id": 3,
+					"type": "teleport",
+					"status": "unknown"
+				}
+			],
+			"_meta": {"total": 2, "totalPages": 1, "perPage": 150, "page": 1}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListRoutePlanningPlaces(context.Background(), maponv1.ListRoutePlanningPlacesRequest_builder{
+		RouteIds:        []int64{21, 22},
+		IncludePolyline: new(true),
+		IncludeFiles:    new(true),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetPlaces()) != 2 {
+		t.Fatalf("expected 2 places, got %d", len(resp.GetPlaces()))
+	}
+	place := resp.GetPlaces()[0]
+	if place.GetType() != maponv1.RoutePlanningPlace_TYPE_DELIVERY {
+		t.Errorf("expected TYPE_DELIVERY, got %v", place.GetType())
+	}
+	if place.GetStatus() != maponv1.RoutePlanningPlace_STATUS_COMPLETED {
+		t.Errorf("expected STATUS_COMPLETED, got %v", place.GetStatus())
+	}
+	if place.GetLocation().GetLatitude() != 56.9496 {
+		t.Errorf("expected latitude 56.9496, got %f", place.GetLocation().GetLatitude())
+	}
+	if want := time.Date(2024, 3, 1, 10, 15, 0, 0, time.UTC); !place.GetStatusChangedAt().AsTime().Equal(want) {
+		t.Errorf("expected status_changed_at %v, got %v", want, place.GetStatusChangedAt().AsTime())
+	}
+	if place.HasFinishedAt() {
+		t.Errorf("expected finished_at to be unset")
+	}
+	if !place.GetDeliveryWindow().GetContinuous() || place.GetDeliveryWindow().GetToTime() != "17:00" {
+		t.Errorf("unexpected delivery window: %v", place.GetDeliveryWindow())
+	}
+	if !place.HasOptimalTemperatureFromCelsius() || place.GetOptimalTemperatureFromCelsius() != -18 {
+		t.Errorf("expected optimal_temperature_from_celsius -18, got %d", place.GetOptimalTemperatureFromCelsius())
+	}
+	if place.HasOptimalTemperatureToCelsius() {
+		t.Errorf("expected optimal_temperature_to_celsius to be unset")
+	}
+	if len(place.GetFiles()) != 1 || place.GetFiles()[0].GetMimeType() != "image/jpeg" {
+		t.Errorf("unexpected files: %v", place.GetFiles())
+	}
+	unknown := resp.GetPlaces()[1]
+	if unknown.GetType() != maponv1.RoutePlanningPlace_TYPE_UNRECOGNIZED || unknown.GetUnrecognizedType() != "teleport" {
+		t.Errorf("expected unrecognized type teleport, got %v %q", unknown.GetType(), unknown.GetUnrecognizedType())
+	}
+	if unknown.GetStatus() != maponv1.RoutePlanningPlace_STATUS_UNRECOGNIZED || unknown.GetUnrecognizedStatus() != "unknown" {
+		t.Errorf("expected unrecognized status unknown, got %v %q", unknown.GetStatus(), unknown.GetUnrecognizedStatus())
+	}
+	if resp.GetPagination().GetTotal() != 2 || resp.GetPagination().GetPerPage() != 150 {
+		t.Errorf("unexpected pagination: %v", resp.GetPagination())
+	}
+}
+
+func TestCreateRoutePlanningOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/routeplanning_orders/create.json" {
+			t.Errorf("expected /routeplanning_orders/create.json, got %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("failed to read body: %v", err)
+		}
+		var body struct {
+			Key       string `json:"key"`
+			RouteName string `json:"route_name"`
+			Places    []struct {
+				Address          string                   `json:"address"`
+				DeliveryDates    *jsonRoutePlanningFromTo `json:"delivery_dates"`
+				DeliveryInterval *int                     `json:"delivery_interval"`
+				SortNo           *int32                   `json:"sort_no"`
+			} `json:"places"`
+		}
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if body.Key != "test-key" || body.RouteName != "Morning" {
+			t.Errorf("unexpected body: %s", data)
+		}
+		if len(body.Places) != 1 {
+			t.Fatalf("expected 1 place, got %d", len(body.Places))
+		}
+		place := body.Places[0]
+		if place.DeliveryDates == nil || place.DeliveryDates.From != "2024-03-01" {
+			t.Errorf("unexpected delivery dates: %v", place.DeliveryDates)
+		}
+		if place.DeliveryInterval == nil || *place.DeliveryInterval != 1 {
+			t.Errorf("expected delivery_interval 1, got %v", place.DeliveryInterval)
+		}
+		if place.SortNo == nil || *place.SortNo != 0 {
+			t.Errorf("expected sort_no 0, got %v", place.SortNo)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"order_id": 5, "place_ids": [10], "route_id": null}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.CreateRoutePlanningOrder(context.Background(), maponv1.CreateRoutePlanningOrderRequest_builder{
+		RouteName:      new("Morning"),
+		AssigneeUnitId: new(int64(199)),
+		Places: []*maponv1.RoutePlanningPlaceInput{
+			maponv1.RoutePlanningPlaceInput_builder{
+				Address:    new("Riga, Latvia"),
+				SortNumber: new(int32(0)),
+				DeliveryWindow: maponv1.RoutePlanningDeliveryWindow_builder{
+					FromDate:   new("2024-03-01"),
+					ToDate:     new("2024-03-02"),
+					Continuous: new(true),
+				}.Build(),
+			}.Build(),
+		},
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetOrderId() != 5 || len(resp.GetPlaceIds()) != 1 || resp.GetRouteId() != 0 {
+		t.Errorf("unexpected response: %v", resp)
+	}
+}
+
+func TestOptimizeRouteAndWait(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		optimizationID string
+		statuses       []string
+		wantErr        bool
+	}{
+		{name: "finished", statuses: []string{"waiting", "running", "finished"}},
+		{name: "nothing to optimize", statuses: []string{"nothing_to_optimize"}},
+		{name: "no solution", statuses: []string{"running", "no_solution"}, wantErr: true},
+		{name: "unknown status", statuses: []string{"running", "paused"}, wantErr: true},
+		{name: "missing optimization ID", optimizationID: "null", statuses: []string{"finished"}, wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var checks int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/routeplanning_routes/optimize.json":
+					_ = r.ParseForm()
+					if r.PostForm.Get("id") != "21" || r.PostForm.Get("truck_restrictions_enabled") != "1" {
+						t.Errorf("unexpected form: %v", r.PostForm)
+					}
+					_, _ = w.Write([]byte(`{"data": {"status": "waiting", "optimization_id": ` + cmp.Or(tt.optimizationID, "42") + `}}`))
+				case "/routeplanning_routes/optimize_check_progress.json":
+					if got := r.URL.Query().Get("optimization_id"); got != "42" {
+						t.Errorf("expected optimization_id 42, got %q", got)
+					}
+					status := tt.statuses[min(checks, len(tt.statuses)-1)]
+					checks++
+					_, _ = w.Write([]byte(`{"data": {"status": "` + status + `", "progress": 50}}`))
+				default:
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			client, err := NewClient(context.Background(), WithAPIKey("test-key"), WithPollInterval(time.Millisecond))
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			client.baseURL = server.URL
+
+			resp, err := client.OptimizeRouteAndWait(context.Background(), maponv1.OptimizeRoutePlanningRouteRequest_builder{
+				RouteId:                  new(int64(21)),
+				TruckRestrictionsEnabled: new(true),
+			}.Build())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", resp)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if checks != len(tt.statuses) {
+				t.Errorf("expected %d progress checks, got %d", len(tt.statuses), checks)
+			}
+			if resp.GetStatus() != mapRoutePlanningOptimizationStatus(tt.statuses[len(tt.statuses)-1]) {
+				t.Errorf("unexpected status %v", resp.GetStatus())
+			}
+		})
+	}
+}
+
+func TestWithPollInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		client, err := NewClient(context.Background(), WithPollInterval(interval))
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		if client.config.pollInterval != 2*time.Second {
+			t.Errorf("expected default poll interval for %v, got %v", interval, client.config.pollInterval)
+		}
+	}
+}
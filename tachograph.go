@@ -0,0 +1,64 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// downloadDDD downloads a binary DDD file from a tachograph download endpoint.
+// Errors are returned by the API as JSON instead of file contents.
+func (c *Client) downloadDDD(ctx context.Context, path string, fileID int64) (content []byte, filename string, err error) {
+	params := url.Values{}
+	params.Add("id", strconv.FormatInt(fileID, 10))
+
+	requestURL, err := url.Parse(c.baseURL + path)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid request URL: %w", err)
+	}
+	requestURL.RawQuery = params.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+
+	httpResponse, err := c.httpClient(c.config).Do(httpRequest)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, "", newResponseError(httpResponse)
+	}
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(httpResponse.Header.Get("Content-Type")); mediaType == "application/json" {
+		var responseBody struct {
+			Error *jsonError `json:"error"`
+		}
+		if err := json.Unmarshal(data, &responseBody); err != nil {
+			return nil, "", err
+		}
+		if responseBody.Error != nil {
+			return nil, "", fmt.Errorf("api error %d: %s", responseBody.Error.Code, responseBody.Error.Msg)
+		}
+		return nil, "", fmt.Errorf("unexpected JSON response")
+	}
+
+	if _, dispositionParams, err := mime.ParseMediaType(httpResponse.Header.Get("Content-Disposition")); err == nil {
+		filename = dispositionParams["filename"]
+	}
+	return data, filename, nil
+}
@@ -0,0 +1,112 @@
+package mapon
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListVehicleDddFiles(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tachograph/list_ddd_vehicle.json" {
+			t.Errorf("expected /tachograph/list_ddd_vehicle.json, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"files": [
+					{
+						"id": 1,
+						"car_id": 2,
+						"registration_number": "XX 1234",
+						"period_start": "2017-12-19",
+						"period_end": "2017-12-29",
+						"next_calibration": "2018-06-23",
+						"created": "2017-12-30 00:53:24",
+						"filename": "1_merged.ddd"
+					}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListVehicleDddFiles(context.Background(), maponv1.ListVehicleDddFilesRequest_builder{
+		FromTime: timestamppb.New(time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC)),
+		ToTime:   timestamppb.New(time.Date(2017, 12, 31, 0, 0, 0, 0, time.UTC)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetFiles()) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(resp.GetFiles()))
+	}
+	file := resp.GetFiles()[0]
+	if file.GetUnitId() != 2 {
+		t.Errorf("expected unit_id 2, got %d", file.GetUnitId())
+	}
+	if file.GetPeriodEndDate() != "2017-12-29" {
+		t.Errorf("expected period end 2017-12-29, got %s", file.GetPeriodEndDate())
+	}
+	if want := time.Date(2017, 12, 30, 0, 53, 24, 0, time.UTC); !file.GetCreatedAt().AsTime().Equal(want) {
+		t.Errorf("expected created_at %v, got %v", want, file.GetCreatedAt().AsTime())
+	}
+}
+
+func TestDownloadDriverDdd(t *testing.T) {
+	content := []byte{0x76, 0x01, 0x00, 0x02}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tachograph/download_ddd_driver.json" {
+			t.Errorf("expected /tachograph/download_ddd_driver.json, got %s", r.URL.Path)
+		}
+		switch r.URL.Query().Get("id") {
+		case "1":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Disposition", `attachment; filename="1_00_20180101_1.ddd"`)
+			_, _ = w.Write(content)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"error": {"code": 2, "msg": "File not found"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.DownloadDriverDdd(context.Background(), maponv1.DownloadDriverDddRequest_builder{
+		FileId: new(int64(1)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(resp.GetContent(), content) {
+		t.Errorf("expected content %x, got %x", content, resp.GetContent())
+	}
+	if resp.GetFilename() != "1_00_20180101_1.ddd" {
+		t.Errorf("expected filename 1_00_20180101_1.ddd, got %s", resp.GetFilename())
+	}
+
+	_, err = client.DownloadDriverDdd(context.Background(), maponv1.DownloadDriverDddRequest_builder{
+		FileId: new(int64(2)),
+	}.Build())
+	if err == nil || !strings.Contains(err.Error(), "File not found") {
+		t.Errorf("expected file not found error, got %v", err)
+	}
+}
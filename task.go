@@ -0,0 +1,251 @@
+package mapon
+
+import (
+	"fmt"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type jsonTask struct {
+	ID               int64   `json:"id"`
+	UnitID           int64   `json:"unit_id"`
+	Type             string  `json:"type"`
+	Status           string  `json:"status"`
+	CreatedAt        *string `json:"created_at"` // "2024-01-15T08:00:00Z"
+	UpdatedAt        *string `json:"updated_at"`
+	CompletedAt      *string `json:"completed_at"`
+	Instruction      string  `json:"instruction"`
+	RouteSequenceNum *int32  `json:"route_sequence_num"` // Null if the task has no route
+	Client           string  `json:"client"`
+	Destination      *struct {
+		City        string         `json:"city"`
+		CountryCode string         `json:"country_code"`
+		Name        string         `json:"name"`
+		Point       *jsonTaskPoint `json:"point"`
+		ZipCode     string         `json:"zip_code"`
+	} `json:"destination"`
+	RouteSection *struct {
+		DistanceInMeters  int64          `json:"distance_in_meters"`
+		DurationInSeconds int64          `json:"duration_in_seconds"`
+		StartPoint        *jsonTaskPoint `json:"start_point"`
+		EndPoint          *jsonTaskPoint `json:"end_point"`
+		Polyline          string         `json:"polyline"`
+		StartTaskID       int64          `json:"start_task_id"`
+		EndTaskID         int64          `json:"end_task_id"`
+	} `json:"route_section"`
+	TrailerID             *int64   `json:"trailer_id"`
+	RequiredDocumentTypes []string `json:"required_document_types"`
+	Attachments           []string `json:"attachments"`
+	Documents             []struct {
+		Type  string   `json:"type"`
+		Links []string `json:"links"`
+	} `json:"documents"`
+	RouteID *int64 `json:"route_id"`
+}
+
+type jsonTaskPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+type jsonTaskStatusResponse struct {
+	Data struct {
+		ID      int64 `json:"id"`
+		Success bool  `json:"success"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+var taskTypes = map[string]maponv1.Task_Type{
+	"loading":          maponv1.Task_TYPE_LOADING,
+	"unloading":        maponv1.Task_TYPE_UNLOADING,
+	"fueling":          maponv1.Task_TYPE_FUELING,
+	"driver_change":    maponv1.Task_TYPE_DRIVER_CHANGE,
+	"trailer_change":   maponv1.Task_TYPE_TRAILER_CHANGE,
+	"resting":          maponv1.Task_TYPE_RESTING,
+	"ferry":            maponv1.Task_TYPE_FERRY,
+	"train":            maponv1.Task_TYPE_TRAIN,
+	"border_crossing":  maponv1.Task_TYPE_BORDER_CROSSING,
+	"trailer_hook_on":  maponv1.Task_TYPE_TRAILER_HOOK_ON,
+	"trailer_hook_off": maponv1.Task_TYPE_TRAILER_HOOK_OFF,
+}
+
+var taskStatuses = map[string]maponv1.Task_Status{
+	"not_started": maponv1.Task_STATUS_NOT_STARTED,
+	"in_progress": maponv1.Task_STATUS_IN_PROGRESS,
+	"waiting":     maponv1.Task_STATUS_WAITING,
+	"completed":   maponv1.Task_STATUS_COMPLETED,
+}
+
+// ParseTaskType returns the task type with the given API name, e.g. "loading".
+func ParseTaskType(name string) (maponv1.Task_Type, bool) {
+	t, ok := taskTypes[name]
+	return t, ok
+}
+
+// ParseTaskStatus returns the task status with the given API name, e.g. "in_progress".
+func ParseTaskStatus(name string) (maponv1.Task_Status, bool) {
+	s, ok := taskStatuses[name]
+	return s, ok
+}
+
+// parseTaskTime parses an optional RFC 3339 task timestamp.
+func parseTaskTime(s *string) *timestamppb.Timestamp {
+	if s == nil {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func mapJSONTaskPointToProto(j *jsonTaskPoint) *maponv1.Location {
+	l := &maponv1.Location{}
+	l.SetLatitude(j.Lat)
+	l.SetLongitude(j.Lng)
+	return l
+}
+
+func mapJSONTaskToProto(j jsonTask) *maponv1.Task {
+	t := &maponv1.Task{}
+	t.SetTaskId(j.ID)
+	t.SetUnitId(j.UnitID)
+	if v, ok := taskTypes[j.Type]; ok {
+		t.SetType(v)
+	} else if j.Type != "" {
+		t.SetType(maponv1.Task_TYPE_UNRECOGNIZED)
+		t.SetUnrecognizedType(j.Type)
+	}
+	if v, ok := taskStatuses[j.Status]; ok {
+		t.SetStatus(v)
+	} else if j.Status != "" {
+		t.SetStatus(maponv1.Task_STATUS_UNRECOGNIZED)
+		t.SetUnrecognizedStatus(j.Status)
+	}
+	if j.Destination != nil {
+		d := &maponv1.TaskDestination{}
+		d.SetName(j.Destination.Name)
+		d.SetCity(j.Destination.City)
+		d.SetCountryCode(j.Destination.CountryCode)
+		d.SetZipCode(j.Destination.ZipCode)
+		if j.Destination.Point != nil {
+			d.SetLocation(mapJSONTaskPointToProto(j.Destination.Point))
+		}
+		t.SetDestination(d)
+	}
+	t.SetInstructions(j.Instruction)
+	t.SetClient(j.Client)
+	if j.RouteID != nil {
+		t.SetRouteId(*j.RouteID)
+	}
+	if j.RouteSequenceNum != nil {
+		t.SetRouteSequenceNum(*j.RouteSequenceNum)
+	}
+	if j.RouteSection != nil {
+		s := &maponv1.TaskRouteSection{}
+		s.SetStartTaskId(j.RouteSection.StartTaskID)
+		s.SetEndTaskId(j.RouteSection.EndTaskID)
+		if j.RouteSection.StartPoint != nil {
+			s.SetStartLocation(mapJSONTaskPointToProto(j.RouteSection.StartPoint))
+		}
+		if j.RouteSection.EndPoint != nil {
+			s.SetEndLocation(mapJSONTaskPointToProto(j.RouteSection.EndPoint))
+		}
+		s.SetDistanceM(j.RouteSection.DistanceInMeters)
+		s.SetDurationS(j.RouteSection.DurationInSeconds)
+		s.SetPolyline(j.RouteSection.Polyline)
+		t.SetRouteSection(s)
+	}
+	if j.TrailerID != nil {
+		t.SetTrailerId(*j.TrailerID)
+	}
+	t.SetRequiredDocumentTypes(j.RequiredDocumentTypes)
+	t.SetAttachmentUrls(j.Attachments)
+	documents := make([]*maponv1.TaskDocument, 0, len(j.Documents))
+	for _, jd := range j.Documents {
+		d := &maponv1.TaskDocument{}
+		d.SetType(jd.Type)
+		d.SetUrls(jd.Links)
+		documents = append(documents, d)
+	}
+	t.SetDocuments(documents)
+	if ts := parseTaskTime(j.CreatedAt); ts != nil {
+		t.SetCreatedAt(ts)
+	}
+	if ts := parseTaskTime(j.UpdatedAt); ts != nil {
+		t.SetUpdatedAt(ts)
+	}
+	if ts := parseTaskTime(j.CompletedAt); ts != nil {
+		t.SetCompletedAt(ts)
+	}
+	return t
+}
+
+// taskRequestBody returns the JSON request body with the settings of a task, except for its status.
+// Instructions, order ID and client ID are sent when set, so that they can be cleared.
+func taskRequestBody(t *maponv1.Task) (map[string]any, error) {
+	if t.GetUnitId() == 0 {
+		return nil, fmt.Errorf("no unit ID")
+	}
+	if !t.HasPlannedArrivalTime() {
+		return nil, fmt.Errorf("planned arrival time is required")
+	}
+	location := t.GetDestination().GetLocation()
+	if location == nil {
+		return nil, fmt.Errorf("destination location is required")
+	}
+	body := map[string]any{
+		"unit_id":              t.GetUnitId(),
+		"planned_arrival_time": t.GetPlannedArrivalTime().AsTime().UTC().Format(time.DateTime),
+		"destination_lat":      location.GetLatitude(),
+		"destination_lng":      location.GetLongitude(),
+	}
+	if t.GetType() != maponv1.Task_TYPE_UNSPECIFIED {
+		name, ok := taskTypeName(t.GetType())
+		if !ok {
+			return nil, fmt.Errorf("unsupported task type %s", t.GetType())
+		}
+		body["type"] = name
+	}
+	if t.HasInstructions() {
+		body["instructions"] = t.GetInstructions()
+	}
+	if t.HasOrderId() {
+		body["order_id"] = t.GetOrderId()
+	}
+	if t.HasClientId() {
+		body["client_id"] = t.GetClientId()
+	}
+	if t.GetRouteId() != 0 {
+		body["route_id"] = t.GetRouteId()
+	}
+	if t.GetTrailerId() != 0 {
+		body["trailer_id"] = t.GetTrailerId()
+	}
+	if len(t.GetRequiredDocumentTypes()) > 0 {
+		body["required_document_types"] = t.GetRequiredDocumentTypes()
+	}
+	return body, nil
+}
+
+func taskTypeName(t maponv1.Task_Type) (string, bool) {
+	for name, value := range taskTypes {
+		if value == t {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func taskStatusName(s maponv1.Task_Status) (string, bool) {
+	for name, value := range taskStatuses {
+		if value == s {
+			return name, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,224 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListTasks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tasks/list.json" {
+			t.Errorf("expected /tasks/list.json, got %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("unit_id") != "123" || query.Get("get_since") != "2024-01-15T00:00:00Z" {
+			t.Errorf("unexpected query: %v", query)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"tasks": [
+					{
+						"id": 45678,
+						"unit_id": 123,
+						"type": "unloading",
+						"status": "in_progress",
+						"created_at": "2024-01-15T08:00:00Z",
+						"updated_at": "2024-01-15T10:45:00Z",
+						"completed_at": null,
+						"instruction": "Deliver package to reception",
+						"route_sequence_num": 0,
+						"client": "ABC Corporation",
+						"destination": {
+							"city": "New York",
+							"country_code": "US",
+							"name": "123 Main Street, New York, NY",
+							"point": {"lat": 40.7128, "lng": -74.006},
+							"zip_code": "10001"
+						},
+						"route_section": {
+							"distance_in_meters": 5432,
+							"duration_in_seconds": 900,
+							"start_point": {"lat": 40.7, "lng": -74.01},
+							"end_point": {"lat": 40.7128, "lng": -74.006},
+							"polyline": "abc",
+							"start_task_id": 45677,
+							"end_task_id": 45678
+						},
+						"trailer_id": null,
+						"required_document_types": ["cmr", "pod"],
+						"attachments": ["https://example.com/file1"],
+						"documents": [{"type": "cmr", "links": ["https://example.com/file3"]}],
+						"route_id": 33982
+					},
+					{
+						"id": 45679,
+						"unit_id": 123,
+						"type": "teleporting",
+						"status": "pending",
+						"route_sequence_num": null,
+						"route_id": null
+					}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListTasks(context.Background(), maponv1.ListTasksRequest_builder{
+		UnitId:       new(int64(123)),
+		UpdatedSince: timestamppb.New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetTasks()) != 2 {
+		t.Fatalf("expected 2 tasks, got %d", len(resp.GetTasks()))
+	}
+	task := resp.GetTasks()[0]
+	if task.GetType() != maponv1.Task_TYPE_UNLOADING || task.GetStatus() != maponv1.Task_STATUS_IN_PROGRESS {
+		t.Errorf("unexpected type or status: %v", task)
+	}
+	if task.GetRouteId() != 33982 || !task.HasRouteSequenceNum() || task.GetRouteSequenceNum() != 0 {
+		t.Errorf("unexpected route: %v", task)
+	}
+	if task.HasCompletedAt() || task.HasTrailerId() {
+		t.Errorf("expected no completion time and trailer, got %v", task)
+	}
+	if task.GetDestination().GetLocation().GetLatitude() != 40.7128 || task.GetDestination().GetCountryCode() != "US" {
+		t.Errorf("unexpected destination: %v", task.GetDestination())
+	}
+	if section := task.GetRouteSection(); section.GetDistanceM() != 5432 || section.GetStartTaskId() != 45677 {
+		t.Errorf("unexpected route section: %v", section)
+	}
+	if len(task.GetDocuments()) != 1 || task.GetDocuments()[0].GetUrls()[0] != "https://example.com/file3" {
+		t.Errorf("unexpected documents: %v", task.GetDocuments())
+	}
+	unknown := resp.GetTasks()[1]
+	if unknown.GetType() != maponv1.Task_TYPE_UNRECOGNIZED || unknown.GetUnrecognizedType() != "teleporting" {
+		t.Errorf("expected unrecognized type, got %v", unknown)
+	}
+	if unknown.GetStatus() != maponv1.Task_STATUS_UNRECOGNIZED || unknown.GetUnrecognizedStatus() != "pending" {
+		t.Errorf("expected unrecognized status, got %v", unknown)
+	}
+	if unknown.HasRouteId() || unknown.HasRouteSequenceNum() {
+		t.Errorf("expected no route, got %v", unknown)
+	}
+}
+
+func TestCreateTask(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tasks/create.json" {
+			t.Errorf("expected /tasks/create.json, got %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("expected JSON request, got %q", got)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("invalid request body: %v", err)
+		}
+		if body["key"] != "test-key" || body["unit_id"] != 123.0 || body["planned_arrival_time"] != "2024-01-15 10:00:00" {
+			t.Errorf("unexpected body: %v", body)
+		}
+		if body["destination_lat"] != 56.9 || body["destination_lng"] != 24.1 {
+			t.Errorf("unexpected destination: %v", body)
+		}
+		if body["type"] != "loading" || body["route_id"] != 33982.0 {
+			t.Errorf("unexpected type or route: %v", body)
+		}
+		if types, _ := body["required_document_types"].([]any); len(types) != 2 || types[1] != "pod" {
+			t.Errorf("unexpected document types: %v", body["required_document_types"])
+		}
+		if _, ok := body["instructions"]; ok {
+			t.Errorf("expected unset instructions not to be sent")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"id": 12345}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.CreateTask(context.Background(), maponv1.CreateTaskRequest_builder{
+		Task: maponv1.Task_builder{
+			UnitId:             new(int64(123)),
+			Type:               new(maponv1.Task_TYPE_LOADING),
+			PlannedArrivalTime: timestamppb.New(time.Date(2024, 1, 15, 12, 0, 0, 0, time.FixedZone("EET", 2*60*60))),
+			Destination: maponv1.TaskDestination_builder{
+				Location: maponv1.Location_builder{Latitude: new(56.9), Longitude: new(24.1)}.Build(),
+			}.Build(),
+			RouteId:               new(int64(33982)),
+			RequiredDocumentTypes: []string{"cmr", "pod"},
+		}.Build(),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetTaskId() != 12345 {
+		t.Errorf("expected task ID 12345, got %d", resp.GetTaskId())
+	}
+}
+
+func TestEditTask(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tasks/edit.json" {
+			t.Errorf("expected /tasks/edit.json, got %s", r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("invalid request body: %v", err)
+		}
+		if body["id"] != 45678.0 || body["status"] != "completed" {
+			t.Errorf("unexpected body: %v", body)
+		}
+		if instructions, ok := body["instructions"]; !ok || instructions != "" {
+			t.Errorf("expected empty instructions, got %v", body)
+		}
+		if types, ok := body["required_document_types"].([]any); !ok || len(types) != 0 {
+			t.Errorf("expected empty document types, got %v", body["required_document_types"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"success": true}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	if _, err := client.EditTask(context.Background(), maponv1.EditTaskRequest_builder{
+		Task: maponv1.Task_builder{
+			TaskId:             new(int64(45678)),
+			UnitId:             new(int64(123)),
+			Status:             new(maponv1.Task_STATUS_COMPLETED),
+			PlannedArrivalTime: timestamppb.New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)),
+			Destination: maponv1.TaskDestination_builder{
+				Location: maponv1.Location_builder{Latitude: new(56.9), Longitude: new(24.1)}.Build(),
+			}.Build(),
+			Instructions: new(""),
+		}.Build(),
+		ClearRequiredDocumentTypes: new(true),
+	}.Build()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package mapon
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type jsonTrackingLink struct {
+	ID                   string          `json:"id"`  // API returns string "48249"
+	GMT                  string          `json:"gmt"` // Creation time "2017-07-07 11:16:23"
+	CarID                []string        `json:"car_id"`
+	From                 string          `json:"from"` // Unix timestamp as string
+	Till                 string          `json:"till"`
+	Routes               bool            `json:"routes"`
+	Comment              string          `json:"comment"`
+	Hash                 string          `json:"hash"`
+	DeactiveObjectID     string          `json:"deactive_object_id"`
+	UserName             string          `json:"user_name"`
+	UserID               string          `json:"user_id"`
+	RestrictRoutesPeriod json.RawMessage `json:"restrict_routes_period"` // Empty array or object
+	CommentVisibility    bool            `json:"comment_visibility"`
+}
+
+type jsonTrackingStatusResponse struct {
+	Data struct {
+		Status     string `json:"status"`
+		TrackingID string `json:"tracking_id"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+func mapJSONTrackingLinkToProto(j jsonTrackingLink) (*maponv1.TrackingLink, error) {
+	id, err := strconv.ParseInt(j.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tracking link ID %q: %w", j.ID, err)
+	}
+	l := &maponv1.TrackingLink{}
+	l.SetTrackingLinkId(id)
+	l.SetHash(j.Hash)
+	unitIDs := make([]int64, 0, len(j.CarID))
+	for _, s := range j.CarID {
+		unitID, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid unit ID %q: %w", s, err)
+		}
+		unitIDs = append(unitIDs, unitID)
+	}
+	l.SetUnitIds(unitIDs)
+	if from, err := strconv.ParseInt(j.From, 10, 64); err == nil {
+		l.SetAvailableFrom(timestamppb.New(time.Unix(from, 0)))
+	}
+	if till, err := strconv.ParseInt(j.Till, 10, 64); err == nil {
+		l.SetExpireTime(timestamppb.New(time.Unix(till, 0)))
+	}
+	l.SetShowRoutes(j.Routes)
+	var period map[string]any
+	if err := unmarshalCustomFieldObject(j.RestrictRoutesPeriod, &period); err != nil {
+		return nil, fmt.Errorf("tracking link %d routes period: %w", id, err)
+	}
+	if len(period) > 0 {
+		p := &maponv1.TrackingLink_RoutesPeriod{}
+		p.SetTimeFrom(formatCustomFieldValue(period["time_from"]))
+		p.SetTimeTo(formatCustomFieldValue(period["time_to"]))
+		var weekdays []int32
+		for day := int32(1); day <= 7; day++ {
+			if v := formatCustomFieldValue(period["d"+strconv.Itoa(int(day))]); v != "" && v != "0" && v != "false" {
+				weekdays = append(weekdays, day)
+			}
+		}
+		p.SetWeekdays(weekdays)
+		l.SetRoutesPeriod(p)
+	}
+	l.SetComment(j.Comment)
+	l.SetCommentVisible(j.CommentVisibility)
+	if objectID, err := strconv.ParseInt(j.DeactiveObjectID, 10, 64); err == nil {
+		l.SetDeactivateInObjectId(objectID)
+	}
+	if userID, err := strconv.ParseInt(j.UserID, 10, 64); err == nil {
+		l.SetUserId(userID)
+	}
+	l.SetUserName(j.UserName)
+	if t, err := time.Parse(time.DateTime, j.GMT); err == nil {
+		l.SetCreatedAt(timestamppb.New(t))
+	}
+	return l, nil
+}
+
+// addTrackingLinkParams adds the settings of a tracking link as form parameters.
+func addTrackingLinkParams(params url.Values, l *maponv1.TrackingLink) error {
+	if len(l.GetUnitIds()) == 0 {
+		return fmt.Errorf("no unit IDs")
+	}
+	if !l.HasAvailableFrom() || !l.HasExpireTime() {
+		return fmt.Errorf("available from and expire time are required")
+	}
+	params.Add("from", strconv.FormatInt(l.GetAvailableFrom().AsTime().Unix(), 10))
+	params.Add("till", strconv.FormatInt(l.GetExpireTime().AsTime().Unix(), 10))
+	params.Add("routes", formatBoolInt(l.GetShowRoutes()))
+	for _, unitID := range l.GetUnitIds() {
+		params.Add("car_id[]", strconv.FormatInt(unitID, 10))
+	}
+	if l.HasRoutesPeriod() {
+		period := l.GetRoutesPeriod()
+		if len(period.GetWeekdays()) == 0 {
+			return fmt.Errorf("routes period without weekdays")
+		}
+		if period.GetTimeFrom() != "" {
+			params.Add("restrict_routes_period[time_from]", period.GetTimeFrom())
+		}
+		if period.GetTimeTo() != "" {
+			params.Add("restrict_routes_period[time_to]", period.GetTimeTo())
+		}
+		for _, day := range period.GetWeekdays() {
+			if day < 1 || day > 7 {
+				return fmt.Errorf("invalid routes period weekday %d", day)
+			}
+			params.Add("restrict_routes_period[d"+strconv.Itoa(int(day))+"]", "1")
+		}
+	}
+	if l.GetComment() != "" {
+		params.Add("comment", l.GetComment())
+		params.Add("comment_visibility", formatBoolInt(l.GetCommentVisible()))
+	}
+	if l.GetLanguage() != "" {
+		params.Add("language", l.GetLanguage())
+	}
+	if l.GetDeactivateInObjectId() != 0 {
+		params.Add("deactive_object_id", strconv.FormatInt(l.GetDeactivateInObjectId(), 10))
+	}
+	if l.GetActiveInObjectId() != 0 {
+		params.Add("active_in_object_id", strconv.FormatInt(l.GetActiveInObjectId(), 10))
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListTrackingLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tracking/list.json" {
+			t.Errorf("expected /tracking/list.json, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("from") != "2017-07-01T00:00:00Z" {
+			t.Errorf("unexpected from: %s", r.URL.Query().Get("from"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [
+				{
+					"id": "48249",
+					"gmt": "2017-07-07 11:16:23",
+					"car_id": ["199", "200"],
+					"from": "1499425800",
+					"till": "1499512200",
+					"routes": true,
+					"comment": "Delivery",
+					"hash": "a8Kd2l",
+					"deactive_object_id": "0",
+					"user_name": "demo",
+					"user_id": "12",
+					"restrict_routes_period": {"time_from": "08:00", "time_to": "17:00", "d1": "1", "d5": "1"},
+					"comment_visibility": true
+				},
+				{
+					"id": "48250",
+					"car_id": ["201"],
+					"from": "1499425800",
+					"till": "1499512200",
+					"routes": false,
+					"hash": "b9Le3m",
+					"restrict_routes_period": []
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListTrackingLinks(context.Background(), maponv1.ListTrackingLinksRequest_builder{
+		FromTime: timestamppb.New(time.Date(2017, 7, 1, 0, 0, 0, 0, time.UTC)),
+		ToTime:   timestamppb.New(time.Date(2017, 7, 31, 0, 0, 0, 0, time.UTC)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetLinks()) != 2 {
+		t.Fatalf("expected 2 links, got %d", len(resp.GetLinks()))
+	}
+	link := resp.GetLinks()[0]
+	if link.GetTrackingLinkId() != 48249 || link.GetHash() != "a8Kd2l" {
+		t.Errorf("unexpected link: %v", link)
+	}
+	if got := link.GetUnitIds(); len(got) != 2 || got[0] != 199 || got[1] != 200 {
+		t.Errorf("unexpected unit IDs: %v", got)
+	}
+	if !link.GetExpireTime().AsTime().Equal(time.Unix(1499512200, 0)) {
+		t.Errorf("unexpected expire time: %v", link.GetExpireTime().AsTime())
+	}
+	period := link.GetRoutesPeriod()
+	if period.GetTimeFrom() != "08:00" || len(period.GetWeekdays()) != 2 || period.GetWeekdays()[1] != 5 {
+		t.Errorf("unexpected routes period: %v", period)
+	}
+	if resp.GetLinks()[1].HasRoutesPeriod() {
+		t.Errorf("expected no routes period, got %v", resp.GetLinks()[1].GetRoutesPeriod())
+	}
+}
+
+func TestCreateTrackingLink(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tracking/create.json" {
+			t.Errorf("expected /tracking/create.json, got %s", r.URL.Path)
+		}
+		_ = r.ParseForm()
+		if r.PostForm.Get("key") != "test-key" || r.PostForm.Get("from") != "1499425800" ||
+			r.PostForm.Get("till") != "1499512200" || r.PostForm.Get("routes") != "1" {
+			t.Errorf("unexpected form: %v", r.PostForm)
+		}
+		if got := r.PostForm["car_id[]"]; len(got) != 2 || got[0] != "199" || got[1] != "200" {
+			t.Errorf("unexpected car_id[]: %v", got)
+		}
+		if r.PostForm.Get("restrict_routes_period[d3]") != "1" || r.PostForm.Has("restrict_routes_period[d1]") {
+			t.Errorf("unexpected routes period: %v", r.PostForm)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"status": "ok", "tracking_id": "a8Kd2l"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.CreateTrackingLink(context.Background(), maponv1.CreateTrackingLinkRequest_builder{
+		Link: maponv1.TrackingLink_builder{
+			UnitIds:       []int64{199, 200},
+			AvailableFrom: timestamppb.New(time.Unix(1499425800, 0)),
+			ExpireTime:    timestamppb.New(time.Unix(1499512200, 0)),
+			ShowRoutes:    new(true),
+			RoutesPeriod: maponv1.TrackingLink_RoutesPeriod_builder{
+				Weekdays: []int32{3},
+			}.Build(),
+		}.Build(),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetLink().GetHash() != "a8Kd2l" {
+		t.Errorf("unexpected link: %v", resp.GetLink())
+	}
+}
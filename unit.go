@@ -961,3 +961,40 @@ func mapMovementStatus(s string) maponv1.MovementStatus {
 
 	}
 }
+
+type jsonUnitStatusResponse struct {
+	Data struct {
+		Status string `json:"status"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+// formatFuelType returns the API code of a fuel type.
+func formatFuelType(t maponv1.FuelType) (string, bool) {
+	switch t {
+	case maponv1.FuelType_PETROL:
+		return "P", true
+	case maponv1.FuelType_DIESEL:
+		return "D", true
+	case maponv1.FuelType_LPG:
+		return "G", true
+	case maponv1.FuelType_ELECTRIC:
+		return "E", true
+	case maponv1.FuelType_PROPANE:
+		return "PROPANE", true
+	case maponv1.FuelType_LNG:
+		return "LNG", true
+	case maponv1.FuelType_CNG:
+		return "CNG", true
+	case maponv1.FuelType_ETHANOL:
+		return "ETHANOL", true
+	case maponv1.FuelType_HYDROGEN:
+		return "HYDROGEN", true
+	case maponv1.FuelType_HYBRID:
+		return "HYBRID", true
+	case maponv1.FuelType_AGRICULTURE_FUEL:
+		return "L", true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,31 @@
+package mapon
+
+import (
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// unitCommands is the catalog of documented unit commands, keyed by command name.
+// None of the documented commands take parameters.
+var unitCommands = map[string]struct {
+	commandType maponv1.UnitCommand_Type
+	description string
+}{
+	"open_doors":    {maponv1.UnitCommand_TYPE_OPEN_DOORS, "Unlock the doors"},
+	"close_doors":   {maponv1.UnitCommand_TYPE_CLOSE_DOORS, "Lock the doors"},
+	"open_trunk":    {maponv1.UnitCommand_TYPE_OPEN_TRUNK, "Open the trunk"},
+	"open_windows":  {maponv1.UnitCommand_TYPE_OPEN_WINDOWS, "Open the windows"},
+	"close_windows": {maponv1.UnitCommand_TYPE_CLOSE_WINDOWS, "Close the windows"},
+	"hazard_lights": {maponv1.UnitCommand_TYPE_HAZARD_LIGHTS, "Turn on the hazard lights"},
+}
+
+func mapUnitCommandToProto(name string) *maponv1.UnitCommand {
+	c := &maponv1.UnitCommand{}
+	c.SetName(name)
+	if info, ok := unitCommands[name]; ok {
+		c.SetType(info.commandType)
+		c.SetDescription(info.description)
+	} else {
+		c.SetType(maponv1.UnitCommand_TYPE_UNRECOGNIZED)
+	}
+	return c
+}
@@ -0,0 +1,92 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+func TestGetAvailableUnitCommands(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/unit_commands/get_available.json" {
+			t.Errorf("expected /unit_commands/get_available.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("unit_id"); got != "199" {
+			t.Errorf("expected unit_id 199, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": ["open_doors", "hazard_lights", "start_engine"]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.GetAvailableUnitCommands(context.Background(), maponv1.GetAvailableUnitCommandsRequest_builder{
+		UnitId: new(int64(199)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	commands := resp.GetCommands()
+	if len(commands) != 3 {
+		t.Fatalf("expected 3 commands, got %d", len(commands))
+	}
+	if commands[0].GetType() != maponv1.UnitCommand_TYPE_OPEN_DOORS || commands[0].GetDescription() == "" {
+		t.Errorf("unexpected command: %v", commands[0])
+	}
+	if commands[2].GetType() != maponv1.UnitCommand_TYPE_UNRECOGNIZED || commands[2].GetName() != "start_engine" {
+		t.Errorf("expected unrecognized command start_engine, got %v", commands[2])
+	}
+}
+
+func TestExecuteUnitCommand(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		status     string
+		parameters map[string]string
+		wantErr    bool
+	}{
+		{name: "ok", status: "ok", parameters: map[string]string{"duration": "30"}},
+		{name: "failed", status: "failed", wantErr: true},
+		{name: "reserved parameter", status: "ok", parameters: map[string]string{"unit_id": "1"}, wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.ParseForm()
+				if r.PostForm.Get("unit_id") != "199" || r.PostForm.Get("command") != "open_doors" {
+					t.Errorf("unexpected form: %v", r.PostForm)
+				}
+				for name, value := range tt.parameters {
+					if r.PostForm.Get(name) != value {
+						t.Errorf("expected parameter %s=%s, got %v", name, value, r.PostForm)
+					}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data": {"status": "` + tt.status + `"}}`))
+			}))
+			defer server.Close()
+
+			client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			client.baseURL = server.URL
+
+			_, err = client.ExecuteUnitCommand(context.Background(), maponv1.ExecuteUnitCommandRequest_builder{
+				UnitId:     new(int64(199)),
+				Command:    new("open_doors"),
+				Parameters: tt.parameters,
+			}.Build())
+			if tt.wantErr != (err != nil) {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
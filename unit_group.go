@@ -0,0 +1,71 @@
+package mapon
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+// SyncUnitGroupMembership makes the units of a group match desiredUnitIDs.
+// It compares the desired units with the current members of the group and only
+// attaches missing units and detaches extra units. The attached and detached unit IDs
+// are returned in ascending order, including when an attach or detach call fails.
+func (c *Client) SyncUnitGroupMembership(
+	ctx context.Context,
+	groupID int64,
+	desiredUnitIDs []int64,
+) (attached, detached []int64, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("mapon: sync unit group %d membership: %w", groupID, err)
+		}
+	}()
+	current, err := c.ListUnitsInGroup(ctx, maponv1.ListUnitsInGroupRequest_builder{
+		GroupId: new(groupID),
+	}.Build())
+	if err != nil {
+		return nil, nil, err
+	}
+	currentUnitIDs := make(map[int64]bool, len(current.GetUnitIds()))
+	for _, id := range current.GetUnitIds() {
+		currentUnitIDs[id] = true
+	}
+	desired := make(map[int64]bool, len(desiredUnitIDs))
+	for _, id := range desiredUnitIDs {
+		desired[id] = true
+	}
+	var toAttach, toDetach []int64
+	for id := range desired {
+		if !currentUnitIDs[id] {
+			toAttach = append(toAttach, id)
+		}
+	}
+	for id := range currentUnitIDs {
+		if !desired[id] {
+			toDetach = append(toDetach, id)
+		}
+	}
+	slices.Sort(toAttach)
+	slices.Sort(toDetach)
+	for _, unitID := range toAttach {
+		if _, err := c.AttachUnitToGroup(ctx, maponv1.AttachUnitToGroupRequest_builder{
+			GroupId: new(groupID),
+			UnitId:  new(unitID),
+		}.Build()); err != nil {
+			return attached, detached, err
+		}
+		attached = append(attached, unitID)
+	}
+	for _, unitID := range toDetach {
+		if _, err := c.DetachUnitFromGroup(ctx, maponv1.DetachUnitFromGroupRequest_builder{
+			GroupId: new(groupID),
+			UnitId:  new(unitID),
+		}.Build()); err != nil {
+			return attached, detached, err
+		}
+		detached = append(detached, unitID)
+	}
+	return attached, detached, nil
+}
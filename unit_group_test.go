@@ -0,0 +1,96 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+func TestSyncUnitGroupMembership(t *testing.T) {
+	var attachedForm, detachedForm []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/unit_groups/list_units.json":
+			if got := r.URL.Query().Get("id"); got != "9" {
+				t.Errorf("expected id 9, got %q", got)
+			}
+			_, _ = w.Write([]byte(`{"data": {"units": [{"id": 1}, {"id": 2}, {"id": 3}]}}`))
+		case "/unit_groups/attach_unit.json", "/unit_groups/detach_unit.json":
+			_ = r.ParseForm()
+			if r.PostForm.Get("id") != "9" {
+				t.Errorf("expected group id 9, got %v", r.PostForm)
+			}
+			if r.URL.Path == "/unit_groups/attach_unit.json" {
+				attachedForm = append(attachedForm, r.PostForm.Get("unit_id"))
+			} else {
+				detachedForm = append(detachedForm, r.PostForm.Get("unit_id"))
+			}
+			_, _ = w.Write([]byte(`{"data": {"status": "ok"}}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	attached, detached, err := client.SyncUnitGroupMembership(context.Background(), 9, []int64{5, 2, 4, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(attached, []int64{4, 5}) || !slices.Equal(attachedForm, []string{"4", "5"}) {
+		t.Errorf("expected units 4 and 5 to be attached, got %v %v", attached, attachedForm)
+	}
+	if !slices.Equal(detached, []int64{1, 3}) || !slices.Equal(detachedForm, []string{"1", "3"}) {
+		t.Errorf("expected units 1 and 3 to be detached, got %v %v", detached, detachedForm)
+	}
+}
+
+func TestSaveUnitGroup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/unit_groups/save.json" {
+			t.Errorf("expected /unit_groups/save.json, got %s", r.URL.Path)
+		}
+		_ = r.ParseForm()
+		if r.PostForm.Get("id") != "9" || r.PostForm.Get("name") != "Trucks" {
+			t.Errorf("unexpected form: %v", r.PostForm)
+		}
+		if !r.PostForm.Has("parent_id") || r.PostForm.Get("parent_id") != "0" {
+			t.Errorf("expected parent_id 0, got %v", r.PostForm)
+		}
+		if !r.PostForm.Has("disable_edit") || r.PostForm.Get("disable_edit") != "0" {
+			t.Errorf("expected disable_edit 0, got %v", r.PostForm)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"status": "successful", "group_id": 9}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.SaveUnitGroup(context.Background(), maponv1.SaveUnitGroupRequest_builder{
+		GroupId:     new(int64(9)),
+		Name:        new("Trucks"),
+		ParentId:    new(int64(0)),
+		DisableEdit: new(false),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetGroupId() != 9 {
+		t.Errorf("expected group ID 9, got %d", resp.GetGroupId())
+	}
+}
@@ -2,8 +2,11 @@ package mapon
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"flag"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
@@ -131,3 +134,88 @@ func isGoldenFile(filename string) bool {
 		len(filename) > len(".golden.json") &&
 		filename[len(filename)-len(".golden.json"):] == ".golden.json"
 }
+
+func TestEditUnit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/unit/edit.json" {
+			t.Errorf("expected /unit/edit.json, got %s", r.URL.Path)
+		}
+		_ = r.ParseForm()
+		if r.PostForm.Get("unit_id") != "199" || r.PostForm.Get("label") != "VOLVO FH" {
+			t.Errorf("unexpected form: %v", r.PostForm)
+		}
+		if r.PostForm.Get("fuel_type") != "D" || r.PostForm.Get("fuel_tank_1") != "400" {
+			t.Errorf("unexpected form: %v", r.PostForm)
+		}
+		if got, ok := r.PostForm["driver_id"]; !ok || got[0] != "0" {
+			t.Errorf("expected driver_id 0 to remove the driver, got %v", got)
+		}
+		for _, key := range []string{"vin", "number", "codriver_id", "odometer"} {
+			if _, ok := r.PostForm[key]; ok {
+				t.Errorf("expected unset %s not to be sent", key)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"status": "ok"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	if _, err := client.EditUnit(context.Background(), maponv1.EditUnitRequest_builder{
+		UnitId:     new(int64(199)),
+		Label:      new("VOLVO FH"),
+		FuelType:   new(maponv1.FuelType_DIESEL),
+		FuelTank1L: new(int32(400)),
+		DriverId:   new(int64(0)),
+	}.Build()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestListUnitCustomFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/unit/custom_fields.json" {
+			t.Errorf("expected /unit/custom_fields.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("unit_id"); got != "199" {
+			t.Errorf("expected unit_id 199, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [
+				{"id": 1, "label": "Tyre size", "type": "select", "settings": {"options": {"5ae07f0120141": "R14", "5ae07f012017a": "R15"}}, "value": "5ae07f012017a"},
+				{"id": 3, "label": "Trailer no.", "type": "text", "settings": [], "value": "AAA 0000"},
+				{"id": 6, "label": "Documents", "type": "file", "settings": [], "value": null}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListUnitCustomFields(context.Background(), maponv1.ListUnitCustomFieldsRequest_builder{
+		UnitId: new(int64(199)),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fields := resp.GetFields()
+	if len(fields) != 3 {
+		t.Fatalf("expected 3 fields, got %d", len(fields))
+	}
+	if fields[0].GetType() != maponv1.CustomField_TYPE_SELECT || fields[0].GetOptions()[fields[0].GetValue()] != "R15" {
+		t.Errorf("unexpected select field: %v", fields[0])
+	}
+	if fields[2].GetType() != maponv1.CustomField_TYPE_FILE || fields[2].GetValue() != "" {
+		t.Errorf("unexpected file field: %v", fields[2])
+	}
+}
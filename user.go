@@ -0,0 +1,87 @@
+package mapon
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type jsonUser struct {
+	ID      string `json:"id"` // API returns string "1"
+	Email   string `json:"email"`
+	Name    string `json:"name"`
+	Surname string `json:"surname"`
+	Preset  *struct {
+		ID       int64  `json:"id"`
+		Title    string `json:"title"`
+		IsCustom bool   `json:"is_custom"`
+	} `json:"preset"` // Null depending on user type
+	Timezone    string `json:"timezone"`
+	Language    string `json:"language"`
+	Phone       string `json:"phone"`
+	Type        string `json:"type"`
+	Blocked     string `json:"blocked"` // "N" or "Y"
+	BlockedText string `json:"blocked_text_fe"`
+	LastLogin   string `json:"last_login"` // "2016-08-11 14:54:56"
+	Created     string `json:"created"`
+}
+
+type jsonUserStatusResponse struct {
+	Error *jsonError `json:"error"`
+}
+
+var userTypes = map[string]maponv1.User_Type{
+	"admin":    maponv1.User_TYPE_ADMIN,
+	"user_all": maponv1.User_TYPE_USER_ALL,
+	"user":     maponv1.User_TYPE_USER,
+	"driver":   maponv1.User_TYPE_DRIVER,
+}
+
+func userTypeName(t maponv1.User_Type) (string, bool) {
+	for name, value := range userTypes {
+		if value == t {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func mapJSONUserToProto(j jsonUser) (*maponv1.User, error) {
+	id, err := strconv.ParseInt(j.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID %q: %w", j.ID, err)
+	}
+	u := &maponv1.User{}
+	u.SetUserId(id)
+	u.SetEmail(j.Email)
+	u.SetName(j.Name)
+	u.SetSurname(j.Surname)
+	if t, ok := userTypes[j.Type]; ok {
+		u.SetType(t)
+	} else if j.Type != "" {
+		u.SetType(maponv1.User_TYPE_UNRECOGNIZED)
+		u.SetUnrecognizedType(j.Type)
+	}
+	if j.Preset != nil {
+		p := &maponv1.Preset{}
+		p.SetPresetId(j.Preset.ID)
+		p.SetTitle(j.Preset.Title)
+		p.SetIsCustom(j.Preset.IsCustom)
+		u.SetPreset(p)
+	}
+	u.SetTimezone(j.Timezone)
+	u.SetLanguage(j.Language)
+	u.SetPhone(j.Phone)
+	u.SetBlocked(j.Blocked == "Y")
+	u.SetBlockedReason(j.BlockedText)
+	if t, err := time.Parse(time.DateTime, j.LastLogin); err == nil {
+		u.SetLastLoginAt(timestamppb.New(t))
+	}
+	if t, err := time.Parse(time.DateTime, j.Created); err == nil {
+		u.SetCreatedAt(timestamppb.New(t))
+	}
+	return u, nil
+}
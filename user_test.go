@@ -0,0 +1,111 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+func TestListUsers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/list.json" {
+			t.Errorf("expected /user/list.json, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("type"); got != "user" {
+			t.Errorf("expected type user, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"users": [
+					{
+						"id": "1",
+						"email": "john@example.com",
+						"name": "John",
+						"surname": "Smith",
+						"preset": {"id": 1, "title": "Preset X", "is_custom": false},
+						"timezone": "UTC",
+						"language": "en",
+						"phone": "00000000",
+						"type": "user",
+						"blocked": "Y",
+						"blocked_text_fe": "Left the company",
+						"last_login": "2016-08-11 14:54:56",
+						"created": "2016-08-10 12:50:56"
+					},
+					{"id": "2", "name": "Jane", "preset": null, "type": "viewer", "blocked": "N", "last_login": null}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListUsers(context.Background(), maponv1.ListUsersRequest_builder{
+		Type: new(maponv1.User_TYPE_USER),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetUsers()) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(resp.GetUsers()))
+	}
+	john := resp.GetUsers()[0]
+	if john.GetUserId() != 1 || john.GetType() != maponv1.User_TYPE_USER || john.GetPreset().GetTitle() != "Preset X" {
+		t.Errorf("unexpected user: %v", john)
+	}
+	if !john.GetBlocked() || john.GetBlockedReason() != "Left the company" {
+		t.Errorf("expected blocked user, got %v", john)
+	}
+	if !john.GetLastLoginAt().AsTime().Equal(time.Date(2016, 8, 11, 14, 54, 56, 0, time.UTC)) {
+		t.Errorf("unexpected last login: %v", john.GetLastLoginAt().AsTime())
+	}
+	jane := resp.GetUsers()[1]
+	if jane.HasPreset() || jane.HasLastLoginAt() {
+		t.Errorf("expected no preset and last login, got %v", jane)
+	}
+	if jane.GetType() != maponv1.User_TYPE_UNRECOGNIZED || jane.GetUnrecognizedType() != "viewer" {
+		t.Errorf("expected unrecognized type viewer, got %v %q", jane.GetType(), jane.GetUnrecognizedType())
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/update.json" {
+			t.Errorf("expected /user/update.json, got %s", r.URL.Path)
+		}
+		_ = r.ParseForm()
+		if r.PostForm.Get("user_id") != "5" || r.PostForm.Get("blocked") != "1" || r.PostForm.Get("blocked_text") != "Left" {
+			t.Errorf("unexpected form: %v", r.PostForm)
+		}
+		if _, ok := r.PostForm["name"]; ok {
+			t.Errorf("expected unset name not to be sent")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status": "successful"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	if _, err := client.UpdateUser(context.Background(), maponv1.UpdateUserRequest_builder{
+		UserId:        new(int64(5)),
+		Blocked:       new(true),
+		BlockedReason: new("Left"),
+	}.Build()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
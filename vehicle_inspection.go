@@ -0,0 +1,202 @@
+package mapon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Inspection times are in GMT, formatted as "2006-01-02 15:04:05".
+
+type jsonVehicleInspection struct {
+	ID                             int64                       `json:"id"`
+	Title                          string                      `json:"title"`
+	UnitID                         jsonOptionalInt64           `json:"unit_id"` // API returns string "2"
+	Lat                            *float64                    `json:"lat"`
+	Lng                            *float64                    `json:"lng"`
+	Address                        string                      `json:"address"`
+	Status                         int                         `json:"status"`
+	StatusAt                       *string                     `json:"status_at"`
+	Number                         string                      `json:"number"`
+	InspectedAt                    *string                     `json:"inspected_at"`
+	Odometer                       jsonOptionalInt64           `json:"odometer"` // API returns string "123456"
+	PerformerFullName              *string                     `json:"performer_full_name"`
+	PerformerSignatureImageFileURL *string                     `json:"performer_signature_image_file_url"`
+	TotalTimeSpent                 *int64                      `json:"total_time_spent"`
+	Items                          []jsonVehicleInspectionItem `json:"items"`
+}
+
+// jsonOptionalInt64 is an integer sent as a number or a numeric string.
+// Null and the empty string leave it unset.
+type jsonOptionalInt64 struct {
+	Value int64
+	Valid bool
+}
+
+func (n *jsonOptionalInt64) UnmarshalJSON(data []byte) error {
+	*n = jsonOptionalInt64{}
+	if s := string(bytes.TrimSpace(data)); s == "null" || s == `""` {
+		return nil
+	}
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return err
+	}
+	value, err := number.Int64()
+	if err != nil {
+		return fmt.Errorf("invalid integer %s: %w", data, err)
+	}
+	*n = jsonOptionalInt64{Value: value, Valid: true}
+	return nil
+}
+
+type jsonVehicleInspectionItem struct {
+	ID                    int64                             `json:"id"`
+	Name                  string                            `json:"name"`
+	Notes                 string                            `json:"notes"`
+	Status                int                               `json:"status"` // 0 - fail, 1 - pass
+	TimeSpent             int64                             `json:"time_spent"`
+	HistoricalDamageItems []jsonVehicleInspectionDamage     `json:"historical_damage_items"`
+	Attachments           []jsonVehicleInspectionAttachment `json:"attachments"`
+}
+
+type jsonVehicleInspectionDamage struct {
+	ID          int64                             `json:"id"`
+	Notes       string                            `json:"notes"`
+	Status      int                               `json:"status"`
+	Attachments []jsonVehicleInspectionAttachment `json:"attachments"`
+}
+
+type jsonVehicleInspectionAttachment struct {
+	ID  int64  `json:"id"`
+	URL string `json:"url"`
+}
+
+var vehicleInspectionStatuses = map[int]maponv1.VehicleInspection_Status{
+	1: maponv1.VehicleInspection_STATUS_DELAYED,
+	2: maponv1.VehicleInspection_STATUS_PASS,
+	3: maponv1.VehicleInspection_STATUS_FAILED,
+	4: maponv1.VehicleInspection_STATUS_RESOLVED,
+}
+
+var vehicleInspectionDamageStatuses = map[int]maponv1.VehicleInspection_Damage_Status{
+	0: maponv1.VehicleInspection_Damage_STATUS_OPEN,
+	1: maponv1.VehicleInspection_Damage_STATUS_ONGOING,
+	2: maponv1.VehicleInspection_Damage_STATUS_AWAITING_APPROVAL,
+	3: maponv1.VehicleInspection_Damage_STATUS_REPAIRED,
+}
+
+// formatVehicleInspectionStatuses returns the statuses as a comma separated list of API values.
+func formatVehicleInspectionStatuses(statuses []maponv1.VehicleInspection_Status) (string, error) {
+	values := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		var value int
+		for v, s := range vehicleInspectionStatuses {
+			if s == status {
+				value = v
+				break
+			}
+		}
+		if value == 0 {
+			return "", fmt.Errorf("unsupported inspection status %s", status)
+		}
+		values = append(values, strconv.Itoa(value))
+	}
+	return strings.Join(values, ","), nil
+}
+
+// parseVehicleInspectionTime parses an optional inspection time in GMT.
+func parseVehicleInspectionTime(s *string) *timestamppb.Timestamp {
+	if s == nil {
+		return nil
+	}
+	t, err := time.Parse(time.DateTime, *s)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func mapJSONVehicleInspectionAttachmentsToProto(j []jsonVehicleInspectionAttachment) []*maponv1.VehicleInspection_Attachment {
+	attachments := make([]*maponv1.VehicleInspection_Attachment, 0, len(j))
+	for _, ja := range j {
+		a := &maponv1.VehicleInspection_Attachment{}
+		a.SetAttachmentId(ja.ID)
+		a.SetUrl(ja.URL)
+		attachments = append(attachments, a)
+	}
+	return attachments
+}
+
+func mapJSONVehicleInspectionToProto(j *jsonVehicleInspection) *maponv1.VehicleInspection {
+	v := &maponv1.VehicleInspection{}
+	v.SetInspectionId(j.ID)
+	v.SetTitle(j.Title)
+	if j.UnitID.Valid {
+		v.SetUnitId(j.UnitID.Value)
+	}
+	if j.Lat != nil && j.Lng != nil {
+		location := &maponv1.Location{}
+		location.SetLatitude(*j.Lat)
+		location.SetLongitude(*j.Lng)
+		location.SetAddress(j.Address)
+		v.SetLocation(location)
+	}
+	if status, ok := vehicleInspectionStatuses[j.Status]; ok {
+		v.SetStatus(status)
+	} else {
+		v.SetStatus(maponv1.VehicleInspection_STATUS_UNRECOGNIZED)
+	}
+	if t := parseVehicleInspectionTime(j.StatusAt); t != nil {
+		v.SetStatusChangedAt(t)
+	}
+	v.SetNumber(j.Number)
+	if t := parseVehicleInspectionTime(j.InspectedAt); t != nil {
+		v.SetInspectedAt(t)
+	}
+	if j.Odometer.Valid {
+		v.SetOdometerKm(j.Odometer.Value)
+	}
+	if j.PerformerFullName != nil {
+		v.SetPerformerName(*j.PerformerFullName)
+	}
+	if j.PerformerSignatureImageFileURL != nil {
+		v.SetPerformerSignatureUrl(*j.PerformerSignatureImageFileURL)
+	}
+	if j.TotalTimeSpent != nil {
+		v.SetTotalTimeSpentS(*j.TotalTimeSpent)
+	}
+	items := make([]*maponv1.VehicleInspection_Item, 0, len(j.Items))
+	for _, ji := range j.Items {
+		item := &maponv1.VehicleInspection_Item{}
+		item.SetItemId(ji.ID)
+		item.SetName(ji.Name)
+		item.SetNotes(ji.Notes)
+		item.SetPassed(ji.Status == 1)
+		item.SetTimeSpentS(ji.TimeSpent)
+		damages := make([]*maponv1.VehicleInspection_Damage, 0, len(ji.HistoricalDamageItems))
+		for _, jd := range ji.HistoricalDamageItems {
+			damage := &maponv1.VehicleInspection_Damage{}
+			damage.SetDamageId(jd.ID)
+			damage.SetNotes(jd.Notes)
+			if status, ok := vehicleInspectionDamageStatuses[jd.Status]; ok {
+				damage.SetStatus(status)
+			} else {
+				damage.SetStatus(maponv1.VehicleInspection_Damage_STATUS_UNRECOGNIZED)
+			}
+			damage.SetAttachments(mapJSONVehicleInspectionAttachmentsToProto(jd.Attachments))
+			damages = append(damages, damage)
+		}
+		item.SetHistoricalDamages(damages)
+		item.SetAttachments(mapJSONVehicleInspectionAttachmentsToProto(ji.Attachments))
+		items = append(items, item)
+	}
+	v.SetItems(items)
+	return v
+}
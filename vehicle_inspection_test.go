@@ -0,0 +1,138 @@
+package mapon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListVehicleInspections(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vehicle_inspections/list.json" {
+			t.Errorf("expected /vehicle_inspections/list.json, got %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("status") != "3" || query.Get("includes") != "items" ||
+			query.Get("inspection_date_from") != "2024-05-01 00:00:00" || query.Get("inspection_date_to") != "2024-05-02 00:00:00" {
+			t.Errorf("unexpected query: %v", query)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [
+				{
+					"id": 1,
+					"title": "Daily inspection",
+					"unit_id": "2",
+					"lat": 28.45721,
+					"lng": -43.2719,
+					"address": "123 Main Street",
+					"status": 3,
+					"status_at": "2024-05-01 06:10:00",
+					"number": "ABC123456",
+					"inspected_at": "2024-05-01 06:00:00",
+					"odometer": "123456",
+					"performer_full_name": "John Smith",
+					"performer_signature_image_file_url": null,
+					"total_time_spent": 600,
+					"items": [
+						{
+							"id": 1,
+							"name": "Tyre check",
+							"notes": "Flat tyre",
+							"status": 0,
+							"time_spent": 30,
+							"historical_damage_items": [
+								{"id": 4, "notes": "Scratch", "status": 3, "attachments": null}
+							],
+							"attachments": [{"id": 5, "url": "https://sample.org/file.php?hash=54321"}]
+						},
+						{"id": 2, "name": "Lights", "notes": "", "status": 1, "time_spent": 10, "historical_damage_items": null, "attachments": null}
+					]
+				}
+			],
+			"_meta": {"total": 1, "totalPages": 1, "perPage": 50, "page": 1}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListVehicleInspections(context.Background(), maponv1.ListVehicleInspectionsRequest_builder{
+		Statuses:     []maponv1.VehicleInspection_Status{maponv1.VehicleInspection_STATUS_FAILED},
+		FromTime:     timestamppb.New(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)),
+		ToTime:       timestamppb.New(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)),
+		IncludeItems: new(true),
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetInspections()) != 1 || resp.GetPagination().GetTotal() != 1 {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	inspection := resp.GetInspections()[0]
+	if inspection.GetUnitId() != 2 || inspection.GetOdometerKm() != 123456 || inspection.GetStatus() != maponv1.VehicleInspection_STATUS_FAILED {
+		t.Errorf("unexpected inspection: %v", inspection)
+	}
+	if !inspection.GetInspectedAt().AsTime().Equal(time.Date(2024, 5, 1, 6, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected inspected at: %v", inspection.GetInspectedAt().AsTime())
+	}
+	if inspection.GetPerformerName() != "John Smith" || inspection.HasPerformerSignatureUrl() {
+		t.Errorf("unexpected performer: %v", inspection)
+	}
+	if len(inspection.GetItems()) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(inspection.GetItems()))
+	}
+	failed := inspection.GetItems()[0]
+	if failed.GetPassed() || len(failed.GetAttachments()) != 1 || failed.GetAttachments()[0].GetUrl() != "https://sample.org/file.php?hash=54321" {
+		t.Errorf("unexpected failed item: %v", failed)
+	}
+	if damages := failed.GetHistoricalDamages(); len(damages) != 1 || damages[0].GetStatus() != maponv1.VehicleInspection_Damage_STATUS_REPAIRED {
+		t.Errorf("unexpected damages: %v", damages)
+	}
+	if !inspection.GetItems()[1].GetPassed() {
+		t.Errorf("expected second item to pass")
+	}
+}
+
+func TestListVehicleInspectionsEmptyNumbers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [
+				{"id": 1, "title": "Unassigned", "unit_id": "", "status": 1, "odometer": ""},
+				{"id": 2, "title": "Numeric", "unit_id": 3, "status": 2, "odometer": null}
+			],
+			"_meta": {"total": 2, "totalPages": 1, "perPage": 50, "page": 1}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.ListVehicleInspections(context.Background(), &maponv1.ListVehicleInspectionsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetInspections()) != 2 {
+		t.Fatalf("expected 2 inspections, got %d", len(resp.GetInspections()))
+	}
+	if unassigned := resp.GetInspections()[0]; unassigned.HasUnitId() || unassigned.HasOdometerKm() {
+		t.Errorf("expected unit ID and odometer to be unset: %v", unassigned)
+	}
+	if numeric := resp.GetInspections()[1]; numeric.GetUnitId() != 3 || numeric.HasOdometerKm() {
+		t.Errorf("unexpected inspection: %v", numeric)
+	}
+}
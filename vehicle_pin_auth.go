@@ -0,0 +1,78 @@
+package mapon
+
+import (
+	"fmt"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+type jsonVehiclePinAuthResponse struct {
+	Data struct {
+		Summary struct {
+			Total      int32 `json:"total"`
+			Successful int32 `json:"successful"`
+			Failed     int32 `json:"failed"`
+		} `json:"summary"`
+		Results []struct {
+			Status   string `json:"status"` // "success" or "failed"
+			UnitID   int64  `json:"unitId"`
+			DeviceID string `json:"deviceId"`
+			DriverID int64  `json:"driverId"`
+			Message  string `json:"message"`
+		} `json:"results"`
+	} `json:"data"`
+	Error *jsonError `json:"error"`
+}
+
+// mapJSONVehiclePinAuthResponseToProto returns the summary and results of a batch operation.
+func mapJSONVehiclePinAuthResponseToProto(
+	j *jsonVehiclePinAuthResponse,
+) (*maponv1.VehiclePinAuthSummary, []*maponv1.VehiclePinAuthResult) {
+	summary := &maponv1.VehiclePinAuthSummary{}
+	summary.SetTotal(j.Data.Summary.Total)
+	summary.SetSuccessful(j.Data.Summary.Successful)
+	summary.SetFailed(j.Data.Summary.Failed)
+	results := make([]*maponv1.VehiclePinAuthResult, 0, len(j.Data.Results))
+	for _, jr := range j.Data.Results {
+		r := &maponv1.VehiclePinAuthResult{}
+		r.SetUnitId(jr.UnitID)
+		r.SetDeviceId(jr.DeviceID)
+		r.SetDriverId(jr.DriverID)
+		r.SetSuccess(jr.Status == "success")
+		r.SetMessage(jr.Message)
+		results = append(results, r)
+	}
+	return summary, results
+}
+
+// driverPinsRequestBody returns the JSON request body fields for creating or updating driver PINs.
+func driverPinsRequestBody(pins []*maponv1.DriverPin) (map[string]any, error) {
+	if len(pins) == 0 {
+		return nil, fmt.Errorf("no driver PINs")
+	}
+	items := make([]map[string]any, 0, len(pins))
+	for _, p := range pins {
+		if err := validateDriverPin(p.GetPin()); err != nil {
+			return nil, fmt.Errorf("driver %d: %w", p.GetDriverId(), err)
+		}
+		items = append(items, map[string]any{
+			"driverId": p.GetDriverId(),
+			"pin":      p.GetPin(),
+		})
+	}
+	return map[string]any{"pins": items}, nil
+}
+
+// validateDriverPin returns an error if the PIN is not exactly 4 digits.
+// An invalid PIN makes the API reject the whole batch.
+func validateDriverPin(pin string) error {
+	if len(pin) != 4 {
+		return fmt.Errorf("invalid PIN: must be exactly 4 digits")
+	}
+	for _, r := range pin {
+		if r < '0' || r > '9' {
+			return fmt.Errorf("invalid PIN: must be exactly 4 digits")
+		}
+	}
+	return nil
+}
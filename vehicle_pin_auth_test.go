@@ -0,0 +1,85 @@
+package mapon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	maponv1 "github.com/way-platform/mapon-go/proto/gen/go/wayplatform/connect/mapon/v1"
+)
+
+func TestCreateDriverPins(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vehicle_pin_auth/driver_pins_create.json" {
+			t.Errorf("expected /vehicle_pin_auth/driver_pins_create.json, got %s", r.URL.Path)
+		}
+		var body struct {
+			Key  string `json:"key"`
+			Pins []struct {
+				DriverID int64  `json:"driverId"`
+				Pin      string `json:"pin"`
+			} `json:"pins"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if body.Key != "test-key" || len(body.Pins) != 2 || body.Pins[0].DriverID != 456 || body.Pins[0].Pin != "0123" {
+			t.Errorf("unexpected body: %+v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"summary": {"total": 2, "successful": 1, "failed": 1},
+				"results": [
+					{"status": "success", "driverId": 456},
+					{"status": "failed", "driverId": 457, "message": "Driver with existing PIN"}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = server.URL
+
+	resp, err := client.CreateDriverPins(context.Background(), maponv1.CreateDriverPinsRequest_builder{
+		Pins: []*maponv1.DriverPin{
+			maponv1.DriverPin_builder{DriverId: new(int64(456)), Pin: new("0123")}.Build(),
+			maponv1.DriverPin_builder{DriverId: new(int64(457)), Pin: new("9876")}.Build(),
+		},
+	}.Build())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetSummary().GetFailed() != 1 || len(resp.GetResults()) != 2 {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	if !resp.GetResults()[0].GetSuccess() {
+		t.Errorf("expected first PIN to succeed: %v", resp.GetResults()[0])
+	}
+	if failed := resp.GetResults()[1]; failed.GetSuccess() || failed.GetDriverId() != 457 || failed.GetMessage() != "Driver with existing PIN" {
+		t.Errorf("unexpected failed result: %v", failed)
+	}
+}
+
+func TestCreateDriverPinsInvalidPin(t *testing.T) {
+	client, err := NewClient(context.Background(), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.baseURL = "http://127.0.0.1:0"
+
+	for _, pin := range []string{"123", "12345", "12a4"} {
+		_, err := client.CreateDriverPins(context.Background(), maponv1.CreateDriverPinsRequest_builder{
+			Pins: []*maponv1.DriverPin{maponv1.DriverPin_builder{DriverId: new(int64(1)), Pin: new(pin)}.Build()},
+		}.Build())
+		if err == nil {
+			t.Errorf("expected error for PIN %q", pin)
+		}
+	}
+}
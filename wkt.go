@@ -0,0 +1,217 @@
+package mapon
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Objects and custom layer geometries are exchanged as WKT (Well-Known Text).
+// The API expects latitude first for points unless the request says otherwise,
+// while standard WKT puts longitude first.
+
+// wktNode is a coordinate or a parenthesized list of nodes in a WKT geometry.
+type wktNode struct {
+	coord    []float64
+	children []wktNode
+}
+
+type wktGeometry struct {
+	typ  string
+	root wktNode
+}
+
+type wktParser struct {
+	s   string
+	pos int
+}
+
+func (p *wktParser) skipSpace() {
+	for p.pos < len(p.s) && strings.ContainsRune(" \t\r\n", rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *wktParser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.s) {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *wktParser) expect(c byte) error {
+	if p.peek() != c {
+		return fmt.Errorf("expected %q at offset %d", c, p.pos)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *wktParser) word() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && !strings.ContainsRune(" \t\r\n(),", rune(p.s[p.pos])) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+func (p *wktParser) list() (wktNode, error) {
+	if err := p.expect('('); err != nil {
+		return wktNode{}, err
+	}
+	var node wktNode
+	for {
+		if p.peek() == '(' {
+			child, err := p.list()
+			if err != nil {
+				return wktNode{}, err
+			}
+			node.children = append(node.children, child)
+		} else {
+			var coord []float64
+			for p.peek() != ',' && p.peek() != ')' && p.peek() != 0 {
+				w := p.word()
+				v, err := strconv.ParseFloat(w, 64)
+				if err != nil {
+					return wktNode{}, fmt.Errorf("invalid number %q", w)
+				}
+				coord = append(coord, v)
+			}
+			if len(coord) != 2 {
+				return wktNode{}, fmt.Errorf("expected coordinate with 2 values, got %d", len(coord))
+			}
+			node.children = append(node.children, wktNode{coord: coord})
+		}
+		if p.peek() == ')' {
+			p.pos++
+			return node, nil
+		}
+		if err := p.expect(','); err != nil {
+			return wktNode{}, err
+		}
+	}
+}
+
+// parseWKT parses a WKT geometry of type POINT, LINESTRING, POLYGON, MULTIPOINT,
+// MULTILINESTRING or MULTIPOLYGON and validates its structure.
+func parseWKT(s string) (*wktGeometry, error) {
+	p := &wktParser{s: s}
+	typ := strings.ToUpper(p.word())
+	root, err := p.list()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != 0 {
+		return nil, fmt.Errorf("unexpected %q at offset %d", p.s[p.pos:], p.pos)
+	}
+	var check func(wktNode) error
+	switch typ {
+	case "POINT":
+		check = checkWKTPoint
+	case "LINESTRING":
+		check = checkWKTLineString
+	case "POLYGON":
+		check = checkWKTPolygon
+	case "MULTIPOINT":
+		check = checkWKTMulti(func(n wktNode) error {
+			if n.coord != nil {
+				return nil
+			}
+			return checkWKTPoint(n)
+		})
+	case "MULTILINESTRING":
+		check = checkWKTMulti(checkWKTLineString)
+	case "MULTIPOLYGON":
+		check = checkWKTMulti(checkWKTPolygon)
+	default:
+		return nil, fmt.Errorf("unsupported geometry type %q", typ)
+	}
+	if err := check(root); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", typ, err)
+	}
+	return &wktGeometry{typ: typ, root: root}, nil
+}
+
+func checkWKTPoint(n wktNode) error {
+	if len(n.children) != 1 || n.children[0].coord == nil {
+		return fmt.Errorf("expected a single coordinate")
+	}
+	return nil
+}
+
+func checkWKTLineString(n wktNode) error {
+	if len(n.children) < 2 {
+		return fmt.Errorf("expected at least 2 coordinates, got %d", len(n.children))
+	}
+	for _, c := range n.children {
+		if c.coord == nil {
+			return fmt.Errorf("expected coordinates")
+		}
+	}
+	return nil
+}
+
+func checkWKTPolygon(n wktNode) error {
+	if len(n.children) == 0 {
+		return fmt.Errorf("expected at least 1 ring")
+	}
+	for i, ring := range n.children {
+		if len(ring.children) < 4 {
+			return fmt.Errorf("ring %d: expected at least 4 coordinates, got %d", i, len(ring.children))
+		}
+		if err := checkWKTLineString(ring); err != nil {
+			return fmt.Errorf("ring %d: %w", i, err)
+		}
+		first, last := ring.children[0].coord, ring.children[len(ring.children)-1].coord
+		if first[0] != last[0] || first[1] != last[1] {
+			return fmt.Errorf("ring %d: must start and end at the same coordinate", i)
+		}
+	}
+	return nil
+}
+
+func checkWKTMulti(check func(wktNode) error) func(wktNode) error {
+	return func(n wktNode) error {
+		if len(n.children) == 0 {
+			return fmt.Errorf("expected at least 1 geometry")
+		}
+		for i, child := range n.children {
+			if err := check(child); err != nil {
+				return fmt.Errorf("geometry %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+}
+
+// coords returns all coordinates of the node in order.
+func (n wktNode) coords() [][]float64 {
+	if n.coord != nil {
+		return [][]float64{n.coord}
+	}
+	var result [][]float64
+	for _, child := range n.children {
+		result = append(result, child.coords()...)
+	}
+	return result
+}
+
+// validateWKT checks that s is a well-formed WKT geometry with WGS84 coordinates.
+func validateWKT(s string, lonFirst bool) error {
+	g, err := parseWKT(s)
+	if err != nil {
+		return fmt.Errorf("invalid WKT: %w", err)
+	}
+	for _, c := range g.root.coords() {
+		lat, lon := c[0], c[1]
+		if lonFirst {
+			lat, lon = lon, lat
+		}
+		if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+			return fmt.Errorf("invalid WKT: coordinate (%v %v) is out of WGS84 bounds", c[0], c[1])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package mapon
+
+import (
+	"testing"
+)
+
+func TestValidateWKT(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		wkt      string
+		lonFirst bool
+		wantErr  bool
+	}{
+		{name: "point", wkt: "POINT(56.9496 24.1052)"},
+		{name: "polygon", wkt: "POLYGON((56.92 24.01,56.92 24.02,56.93 24.02,56.92 24.01))"},
+		{name: "polygon with hole", wkt: "POLYGON ((0 0, 0 10, 10 10, 0 0), (1 1, 1 2, 2 2, 1 1))"},
+		{name: "multipolygon", wkt: "MULTIPOLYGON(((0 0,0 1,1 1,0 0)),((5 5,5 6,6 6,5 5)))"},
+		{name: "multipoint without parentheses", wkt: "MULTIPOINT(1 2, 3 4)"},
+		{name: "multipoint with parentheses", wkt: "MULTIPOINT((1 2), (3 4))"},
+		{name: "linestring", wkt: "linestring(1 2, 3 4)"},
+		{name: "lon first", wkt: "POINT(120 45)", lonFirst: true},
+		{name: "lat out of bounds", wkt: "POINT(120 45)", wantErr: true},
+		{name: "open ring", wkt: "POLYGON((0 0,0 1,1 1,1 0))", wantErr: true},
+		{name: "short ring", wkt: "POLYGON((0 0,0 1,0 0))", wantErr: true},
+		{name: "3d coordinate", wkt: "POINT(1 2 3)", wantErr: true},
+		{name: "invalid number", wkt: "POINT(1 x)", wantErr: true},
+		{name: "unbalanced", wkt: "POLYGON((0 0,0 1,1 1,0 0)", wantErr: true},
+		{name: "trailing data", wkt: "POINT(1 2) POINT(3 4)", wantErr: true},
+		{name: "unsupported type", wkt: "GEOMETRYCOLLECTION(POINT(1 2))", wantErr: true},
+		{name: "empty", wkt: "", wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateWKT(tt.wkt, tt.lonFirst)
+			if tt.wantErr != (err != nil) {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}